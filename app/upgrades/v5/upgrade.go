@@ -33,6 +33,7 @@ import (
 	rollappkeeper "github.com/dymensionxyz/dymension/v3/x/rollapp/keeper"
 	rollappmoduletypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 	sequencerkeeper "github.com/dymensionxyz/dymension/v3/x/sequencer/keeper"
+	sequencertypes "github.com/dymensionxyz/dymension/v3/x/sequencer/types"
 	sponsorshipkeeper "github.com/dymensionxyz/dymension/v3/x/sponsorship/keeper"
 	sponsorshiptypes "github.com/dymensionxyz/dymension/v3/x/sponsorship/types"
 	streamermoduletypes "github.com/dymensionxyz/dymension/v3/x/streamer/types"
@@ -86,6 +87,7 @@ func CreateUpgradeHandler(
 
 		// IRO module params update
 		updateIROParams(ctx, keepers.IROKeeper)
+		setIROSettlementDeadlines(ctx, keepers.IROKeeper)
 
 		// GAMM module params update
 		updateGAMMParams(ctx, keepers.GAMMKeeper)
@@ -97,7 +99,9 @@ func CreateUpgradeHandler(
 
 		updateSequencerParams(ctx, keepers.SequencerKeeper)
 
-		migrateSequencers(ctx, keepers.SequencerKeeper)
+		if err := migrateSequencers(ctx, keepers.SequencerKeeper); err != nil {
+			return nil, fmt.Errorf("migrate sequencers: %w", err)
+		}
 
 		// Set up rate limiting parameters for existing channels
 		err = setupRateLimitingParams(ctx, keepers.RateLimitingKeeper)
@@ -169,10 +173,37 @@ func updateIROParams(ctx sdk.Context, k *irokeeper.Keeper) {
 	params.MinLiquidityPart = defParams.MinLiquidityPart                                     // default: at least 40% goes to the liquidity pool
 	params.MinVestingDuration = defParams.MinVestingDuration                                 // default: min 7 days
 	params.MinVestingStartTimeAfterSettlement = defParams.MinVestingStartTimeAfterSettlement // default: no enforced minimum by default
+	params.MaxCurveBreakpoints = defParams.MaxCurveBreakpoints                               // default: up to 16 breakpoints
+	params.SettlementGracePeriod = defParams.SettlementGracePeriod                           // default: 90 days after pre-launch to settle
+	params.CandleRetention = defParams.CandleRetention                                       // default: a day of minute candles
+	params.MaxPoolWeight = defParams.MaxPoolWeight                                           // default: up to 80/20 pools
+	params.MinPoolSwapFee = defParams.MinPoolSwapFee                                         // default: min 0.1% custom swap fee
+	params.MaxPoolSwapFee = defParams.MaxPoolSwapFee                                         // default: max 5% custom swap fee
+	params.ReferralFeeShare = defParams.ReferralFeeShare                                     // default: 20% of the taker fee goes to the referrer
 
 	k.SetParams(ctx, params)
 }
 
+// setIROSettlementDeadlines sets the settlement deadline of the plans already trading. The grace period is
+// counted from the upgrade at the earliest, so plans past their pre-launch time don't fail right away.
+func setIROSettlementDeadlines(ctx sdk.Context, k *irokeeper.Keeper) {
+	gracePeriod := k.GetParams(ctx).SettlementGracePeriod
+	if gracePeriod == 0 {
+		return
+	}
+	minDeadline := ctx.BlockTime().Add(gracePeriod)
+	for _, plan := range k.GetAllPlans(ctx, false) {
+		if !plan.TradingEnabled || plan.IsSettled() || !plan.SettlementDeadline.IsZero() {
+			continue
+		}
+		plan.SetSettlementDeadline(gracePeriod)
+		if plan.SettlementDeadline.Before(minDeadline) {
+			plan.SettlementDeadline = minDeadline
+		}
+		k.SetPlan(ctx, plan)
+	}
+}
+
 func updateGovParams(ctx sdk.Context, k *govkeeper.Keeper) {
 	params, err := k.Params.Get(ctx)
 	if err != nil {
@@ -284,6 +315,8 @@ func migrateDeprecatedParamsKeeperSubspaces(ctx sdk.Context, keepers *upgrades.U
 			SellOrderDuration:      dymnsParams.Misc.SellOrderDuration,
 			EnableTradingName:      dymnsParams.Misc.EnableTradingName,
 			EnableTradingAlias:     dymnsParams.Misc.EnableTradingAlias,
+			/* ------------------------------- new params ------------------------------- */
+			OwnershipTransferDuration: dymnstypes.DefaultMiscParams().OwnershipTransferDuration,
 		},
 	))
 
@@ -298,6 +331,10 @@ func migrateDeprecatedParamsKeeperSubspaces(ctx sdk.Context, keepers *upgrades.U
 		rollappParams.LivenessSlashInterval,
 		rollappParams.AppRegistrationFee,
 		rollappParams.MinSequencerBondGlobal,
+		rollappmoduletypes.DefaultOwnershipTransferExpiry,
+		rollappmoduletypes.DefaultSunsetWithdrawalWindow,
+		rollappmoduletypes.DefaultMaintenanceWindowMaxBlocks,
+		rollappmoduletypes.DefaultMaintenanceWindowMinInterval,
 	))
 
 	// Streamer module
@@ -363,17 +400,28 @@ func updateSequencerParams(ctx sdk.Context, k *sequencerkeeper.Keeper) {
 	params.SetPenaltyLiveness(newPenaltyLiveness)
 	params.SetPenaltyKickThreshold(NewPenaltyKickThreshold)
 	params.SetPenaltyReductionStateUpdate(newPenaltyReductionStateUpdate)
+	params.DelegationUnbondingPeriod = sequencertypes.DefaultDelegationUnbondingPeriod
+	params.MinSelfBondFraction = sequencertypes.DefaultMinSelfBondFraction
+	params.UnbondingPeriod = sequencertypes.DefaultUnbondingPeriod
+	params.MaxCommissionChangeRate = sequencertypes.DefaultMaxCommissionChangeRate
+	params.CommissionChangeInterval = sequencertypes.DefaultCommissionChangeInterval
+	params.RewardDenoms = sequencertypes.DefaultRewardDenoms
 	k.SetParams(ctx, params)
 }
 
-func migrateSequencers(ctx sdk.Context, k *sequencerkeeper.Keeper) {
+// migrateSequencers clamps the penalties and indexes the snapshots already advertised in the metadata
+func migrateSequencers(ctx sdk.Context, k *sequencerkeeper.Keeper) error {
 	sequencers := k.AllSequencers(ctx)
 	for _, s := range sequencers {
 		if NewPenaltyKickThreshold < s.GetPenalty() {
 			s.SetPenalty(NewPenaltyKickThreshold)
 			k.SetSequencer(ctx, s)
 		}
+		if err := k.IndexSnapshots(ctx, s, nil); err != nil {
+			return fmt.Errorf("index snapshots: sequencer %s: %w", s.Address, err)
+		}
 	}
+	return nil
 }
 
 // migrateLockTimestamps sets UpdatedAt on all locks if not set
@@ -76,6 +76,7 @@ func (s *UpgradeTestSuite) TestUpgrade() {
 			preUpgrade: func() error {
 				s.setLockupParams()
 				s.setIROParams()
+				s.populateIROPlans()
 				s.populateSequencers(s.Ctx, s.App.SequencerKeeper)
 				s.populateLivenessEvents(s.Ctx, s.App.RollappKeeper)
 				s.populateIBCChannels()
@@ -116,6 +117,7 @@ func (s *UpgradeTestSuite) TestUpgrade() {
 				if err = s.validateIROParamsMigration(); err != nil {
 					return
 				}
+				s.validateIROPlansMigration()
 
 				if err = s.validateLivenessEventsMigration(s.Ctx, s.App.RollappKeeper); err != nil {
 					return
@@ -174,6 +176,13 @@ func (s *UpgradeTestSuite) setIROParams() {
 	oldParams.MinLiquidityPart = math.LegacyDec{}
 	oldParams.MinVestingDuration = 0
 	oldParams.MinVestingStartTimeAfterSettlement = 0
+	oldParams.MaxCurveBreakpoints = 0
+	oldParams.SettlementGracePeriod = 0
+	oldParams.CandleRetention = 0
+	oldParams.MaxPoolWeight = math.LegacyDec{}
+	oldParams.MinPoolSwapFee = math.LegacyDec{}
+	oldParams.MaxPoolSwapFee = math.LegacyDec{}
+	oldParams.ReferralFeeShare = math.LegacyDec{}
 
 	s.App.IROKeeper.SetParams(s.Ctx, oldParams)
 }
@@ -190,9 +199,54 @@ func (s *UpgradeTestSuite) validateIROParamsMigration() error {
 		return fmt.Errorf("min vesting duration or start time after settlement not set correctly")
 	}
 
+	if params.MaxCurveBreakpoints != expected.MaxCurveBreakpoints {
+		return fmt.Errorf("max curve breakpoints not set correctly")
+	}
+
+	if params.SettlementGracePeriod != expected.SettlementGracePeriod {
+		return fmt.Errorf("settlement grace period not set correctly")
+	}
+
+	if params.CandleRetention != expected.CandleRetention {
+		return fmt.Errorf("candle retention not set correctly")
+	}
+
+	if !params.MaxPoolWeight.Equal(expected.MaxPoolWeight) || !params.MinPoolSwapFee.Equal(expected.MinPoolSwapFee) || !params.MaxPoolSwapFee.Equal(expected.MaxPoolSwapFee) {
+		return fmt.Errorf("settlement pool params bounds not set correctly")
+	}
+
+	if !params.ReferralFeeShare.Equal(expected.ReferralFeeShare) {
+		return fmt.Errorf("referral fee share not set correctly")
+	}
+
 	return nil
 }
 
+// populateIROPlans sets a plan trading since long before the upgrade, and a settled one
+func (s *UpgradeTestSuite) populateIROPlans() {
+	allocation := sdk.NewCoin("foo", math.NewInt(100_000).MulRaw(1e18))
+	for id := uint64(1); id <= 2; id++ {
+		plan, err := irotypes.NewPlan(id, fmt.Sprintf("rollapp_%d-1", id), "adym", allocation, irotypes.DefaultBondingCurve(), time.Hour, irotypes.DefaultIncentivePlanParams(), math.LegacyOneDec(), 0, 0)
+		s.Require().NoError(err)
+		plan.EnableTradingWithStartTime(s.Ctx.BlockTime().Add(-365 * 24 * time.Hour))
+		if id == 2 {
+			plan.SettledDenom = "ibc/settled"
+		}
+		s.App.IROKeeper.SetPlan(s.Ctx, plan)
+	}
+}
+
+func (s *UpgradeTestSuite) validateIROPlansMigration() {
+	// the grace period is counted from the upgrade
+	plan := s.App.IROKeeper.MustGetPlan(s.Ctx, "1")
+	s.Require().Equal(s.Ctx.BlockTime().Add(irotypes.DefaultSettlementGracePeriod), plan.SettlementDeadline)
+	s.Require().False(plan.IsFailed(s.Ctx.BlockTime()))
+
+	// settled plans don't need a deadline
+	plan = s.App.IROKeeper.MustGetPlan(s.Ctx, "2")
+	s.Require().True(plan.SettlementDeadline.IsZero())
+}
+
 var livenessEventsBlocks = []int64{0, 100, 200, 300}
 
 func (s *UpgradeTestSuite) populateLivenessEvents(ctx sdk.Context, k *rollappkeeper.Keeper) {
@@ -229,16 +283,45 @@ func (s *UpgradeTestSuite) validateLivenessEventsMigration(ctx sdk.Context, k *r
 	return nil
 }
 
+const snapshotRollapp = "snapshots_1-1"
+
 func (s *UpgradeTestSuite) populateSequencers(ctx sdk.Context, k *sequencerkeeper.Keeper) {
+	addr := "dym19pas0pqwje540u5ptwnffjxeamdxc9tajmdrfa"
 	k.SetSequencer(ctx, sequencertypes.Sequencer{
-		Address:  "dym19pas0pqwje540u5ptwnffjxeamdxc9tajmdrfa",
-		Status:   sequencertypes.Bonded,
-		Dishonor: v5.NewPenaltyKickThreshold + 1,
+		Address:   addr,
+		RollappId: snapshotRollapp,
+		Status:    sequencertypes.Bonded,
+		Dishonor:  v5.NewPenaltyKickThreshold + 1,
+		Metadata: sequencertypes.SequencerMetadata{
+			Snapshots: []*sequencertypes.SnapshotInfo{{SnapshotUrl: "https://snapshots.example/5", Height: 5, Checksum: "abc"}},
+		},
 	})
+
+	// a finalized state covering the advertised snapshot
+	rk := s.App.RollappKeeper
+	rk.SetRollapp(ctx, rollapptypes.Rollapp{RollappId: snapshotRollapp})
+	stateInfo := rollapptypes.StateInfo{
+		StateInfoIndex: rollapptypes.StateInfoIndex{RollappId: snapshotRollapp, Index: 1},
+		Sequencer:      addr,
+		StartHeight:    1,
+		NumBlocks:      10,
+		Status:         types.Status_FINALIZED,
+	}
+	for h := uint64(1); h <= 10; h++ {
+		stateInfo.BDs.BD = append(stateInfo.BDs.BD, rollapptypes.BlockDescriptor{Height: h, StateRoot: []byte{byte(h)}})
+	}
+	rk.SetStateInfo(ctx, stateInfo)
+	rk.SetLatestStateInfoIndex(ctx, stateInfo.StateInfoIndex)
 }
 
 func (s *UpgradeTestSuite) validateSequencersMigration(ctx sdk.Context, k *sequencerkeeper.Keeper) {
 	sequencers := k.AllSequencers(ctx)
 	s.Require().Equal(len(sequencers), 1)
 	s.Require().Equal(v5.NewPenaltyKickThreshold, sequencers[0].GetPenalty())
+
+	// the snapshot advertised before the upgrade is indexed
+	res, err := k.LatestVerifiedSnapshot(ctx, snapshotRollapp)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(5), res.Snapshot.Height)
+	s.Require().Equal([]byte{5}, res.StateRoot)
 }
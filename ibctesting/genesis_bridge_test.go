@@ -19,6 +19,7 @@ import (
 	"github.com/dymensionxyz/dymension/v3/app/apptesting"
 	appparams "github.com/dymensionxyz/dymension/v3/app/params"
 	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	irokeeper "github.com/dymensionxyz/dymension/v3/x/iro/keeper"
 	irotypes "github.com/dymensionxyz/dymension/v3/x/iro/types"
 	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 )
@@ -145,7 +146,7 @@ func (s *GenesisBridgeSuite) TestIRO() {
 
 	// create IRO plan
 	rollapp := s.hubApp().RollappKeeper.MustGetRollapp(s.hubCtx(), rollappChainID())
-	_, err := s.hubApp().IROKeeper.CreatePlan(s.hubCtx(), "adym", amt, 0, time.Time{}, true, rollapp, irotypes.DefaultBondingCurve(), irotypes.DefaultIncentivePlanParams(), irotypes.DefaultParams().MinLiquidityPart, time.Hour, 0)
+	_, err := s.hubApp().IROKeeper.CreatePlan(s.hubCtx(), "adym", amt, 0, time.Time{}, true, rollapp, irotypes.DefaultBondingCurve(), irotypes.DefaultIncentivePlanParams(), irotypes.DefaultParams().MinLiquidityPart, time.Hour, 0, irokeeper.CreatePlanOpts{})
 	s.Require().NoError(err)
 
 	// register the sequencer
@@ -182,6 +183,75 @@ func (s *GenesisBridgeSuite) TestIRO() {
 	s.Require().Equal(plan.SettledDenom, expectedIBCdenom)
 }
 
+// TestIRO_Failed tests the genesis bridge is completed for a plan which failed by its settlement deadline.
+// The plan is not settled, its rollapp tokens are burned and the buyers can still refund.
+func (s *GenesisBridgeSuite) TestIRO_Failed() {
+	// fund the rollapp owner account for iro creation fee
+	iroFee := sdk.NewCoin(appparams.BaseDenom, s.hubApp().IROKeeper.GetParams(s.hubCtx()).CreationFee)
+	apptesting.FundAccount(s.hubApp(), s.hubCtx(), s.hubChain().SenderAccount.GetAddress(), sdk.NewCoins(iroFee))
+
+	amt := math.NewIntFromUint64(1_000_000).MulRaw(1e18)
+
+	// Add the iro module to the genesis accounts
+	gAddr := s.hubApp().IROKeeper.GetModuleAccountAddress()
+	s.addGenesisAccounts([]rollapptypes.GenesisAccount{{Address: gAddr, Amount: amt}})
+
+	// create IRO plan
+	rollapp := s.hubApp().RollappKeeper.MustGetRollapp(s.hubCtx(), rollappChainID())
+	planId, err := s.hubApp().IROKeeper.CreatePlan(s.hubCtx(), "adym", amt, 0, time.Time{}, true, rollapp, irotypes.DefaultBondingCurve(), irotypes.DefaultIncentivePlanParams(), irotypes.DefaultParams().MinLiquidityPart, time.Hour, 0, irokeeper.CreatePlanOpts{})
+	s.Require().NoError(err)
+
+	// buy some tokens
+	buyer := sample.Acc()
+	apptesting.FundAccount(s.hubApp(), s.hubCtx(), buyer, sdk.NewCoins(sdk.NewCoin(appparams.BaseDenom, math.NewInt(1_000_000).MulRaw(1e18))))
+	err = s.hubApp().IROKeeper.Buy(s.hubCtx(), planId, buyer, math.NewInt(1_000).MulRaw(1e18), math.NewInt(1_000_000).MulRaw(1e18), nil)
+	s.Require().NoError(err)
+
+	// the plan fails before the genesis bridge is completed
+	plan := s.hubApp().IROKeeper.MustGetPlan(s.hubCtx(), planId)
+	plan.SettlementDeadline = s.hubCtx().BlockTime()
+	s.hubApp().IROKeeper.SetPlan(s.hubCtx(), plan)
+
+	// register the sequencer
+	s.registerSequencer()
+
+	// create the expected genesis bridge packet
+	rollapp = s.hubApp().RollappKeeper.MustGetRollapp(s.hubCtx(), rollappChainID())
+	packet := s.genesisBridgePacket(rollapp.GenesisInfo)
+
+	// send the packet on the rollapp chain
+	seq, err := s.path.EndpointB.SendPacket(packet.TimeoutHeight, packet.TimeoutTimestamp, packet.Data)
+	s.Require().NoError(err)
+	packet.Sequence = seq
+
+	// submit rollapp's state update
+	s.updateRollappState(uint64(s.rollappChain().App.LastBlockHeight()))
+
+	_, err = s.path.EndpointA.RecvPacketWithResult(packet)
+	s.Require().NoError(err)
+
+	// assert the ack succeeded and the transfers are enabled
+	ack, found := s.hubApp().IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(s.hubCtx(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	s.Require().True(found)
+	s.Require().Equal(successAck, ack)
+	rollapp = s.hubApp().RollappKeeper.MustGetRollapp(s.hubCtx(), rollappChainID())
+	s.Require().True(rollapp.GenesisState.IsTransferEnabled())
+
+	// the plan is not settled and its rollapp tokens are burned
+	plan = s.hubApp().IROKeeper.MustGetPlan(s.hubCtx(), planId)
+	s.Require().False(plan.IsSettled())
+	ibcDenom := types.ParseDenomTrace(types.GetPrefixedDenom(s.path.EndpointB.ChannelConfig.PortID, s.path.EndpointB.ChannelID, rollapp.GenesisInfo.NativeDenom.Base)).IBCDenom()
+	s.Require().True(s.hubApp().BankKeeper.GetBalance(s.hubCtx(), s.hubApp().AccountKeeper.GetModuleAddress(irotypes.ModuleName), ibcDenom).IsZero())
+
+	// the buyer gets the raised liquidity back
+	before := s.hubApp().BankKeeper.GetBalance(s.hubCtx(), buyer, appparams.BaseDenom)
+	err = s.hubApp().IROKeeper.Refund(s.hubCtx(), planId, buyer)
+	s.Require().NoError(err)
+	after := s.hubApp().BankKeeper.GetBalance(s.hubCtx(), buyer, appparams.BaseDenom)
+	s.Require().True(after.Amount.GT(before.Amount))
+	s.Require().True(s.hubApp().BankKeeper.GetBalance(s.hubCtx(), buyer, plan.TotalAllocation.Denom).IsZero())
+}
+
 // TestInvalidGenesisInfo tests an invalid genesis info
 func (s *GenesisBridgeSuite) TestInvalidGenesisInfo() {
 	rollapp := s.hubApp().RollappKeeper.MustGetRollapp(s.hubCtx(), rollappChainID())
@@ -3,11 +3,17 @@ package ibctesting_test
 import (
 	"slices"
 	"testing"
+	"time"
 
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	common "github.com/dymensionxyz/dymension/v3/x/common/types"
 	lightclientkeeper "github.com/dymensionxyz/dymension/v3/x/lightclient/keeper"
 	"github.com/dymensionxyz/dymension/v3/x/lightclient/types"
 	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
@@ -197,6 +203,172 @@ func (s *lightClientSuite) TestSetCanonicalClient_Succeeds() {
 	s.Equal(s.path.EndpointA.ClientID, canonClientID)
 }
 
+// TestSetCanonicalClient_ClientParams tests that the canonical client must have the client params set for the rollapp
+func (s *lightClientSuite) TestSetCanonicalClient_ClientParams() {
+	s.createRollapp(false, nil)
+	s.registerSequencer()
+	owner := s.hubChain().SenderAccount.GetAddress().String()
+
+	// the client must stay trusted for the dispute period
+	raParams := s.hubApp().RollappKeeper.GetParams(s.hubCtx())
+	s.hubApp().RollappKeeper.SetParams(s.hubCtx(), raParams.WithDisputePeriodInBlocks(100))
+	msg := &types.MsgSetClientParams{Signer: owner, RollappId: rollappChainID(), UnbondingPeriod: time.Minute, MaxClockDrift: time.Second}
+	_, err := s.lightclientMsgServer().SetClientParams(s.hubCtx(), msg)
+	utest.IsErr(s.Require(), err, gerrc.ErrInvalidArgument)
+
+	msg.UnbondingPeriod = time.Hour * 24
+	_, err = s.lightclientMsgServer().SetClientParams(s.hubCtx(), msg)
+	s.Require().NoError(err)
+	exp := msg.ClientParams().ExpectedClient()
+
+	// a client with the default params
+	s.createCompatibleClient()
+	defaultClientID := s.path.EndpointA.ClientID
+
+	currentHeader := s.rollappChain().CurrentHeader
+	startHeight := uint64(currentHeader.Height)
+	bd := rollapptypes.BlockDescriptor{Height: startHeight, StateRoot: currentHeader.AppHash, Timestamp: currentHeader.Time}
+
+	s.createClient(&ibctesting.TendermintConfig{
+		TrustLevel:      exp.TrustLevel,
+		TrustingPeriod:  exp.TrustingPeriod,
+		UnbondingPeriod: exp.UnbondingPeriod,
+		MaxClockDrift:   exp.MaxClockDrift,
+	})
+	clientID := s.path.EndpointA.ClientID
+
+	currentHeader = s.rollappChain().CurrentHeader
+	bdNext := rollapptypes.BlockDescriptor{Height: uint64(currentHeader.Height), StateRoot: currentHeader.AppHash, Timestamp: currentHeader.Time}
+
+	msgUpdateState := rollapptypes.NewMsgUpdateState(
+		owner,
+		rollappChainID(),
+		"mock-da-path",
+		startHeight,
+		2,
+		2, // revision
+		&rollapptypes.BlockDescriptors{BD: []rollapptypes.BlockDescriptor{bd, bdNext}},
+	)
+	_, err = s.rollappMsgServer().UpdateState(s.hubCtx(), msgUpdateState)
+	s.Require().NoError(err)
+
+	_, err = s.lightclientMsgServer().SetCanonicalClient(s.hubCtx(), &types.MsgSetCanonicalClient{Signer: owner, ClientId: defaultClientID})
+	utest.IsErr(s.Require(), err, lightclientkeeper.ErrParamsMismatch)
+
+	_, err = s.lightclientMsgServer().SetCanonicalClient(s.hubCtx(), &types.MsgSetCanonicalClient{Signer: owner, ClientId: clientID})
+	s.Require().NoError(err)
+	canonClientID, found := s.hubApp().LightClientKeeper.GetCanonicalClient(s.hubCtx(), rollappChainID())
+	s.Require().True(found)
+	s.Require().Equal(clientID, canonClientID)
+}
+
+// TestDiscoverCanonicalClient tests that a candidate client becomes canonical once it matches a state update
+func (s *lightClientSuite) TestDiscoverCanonicalClient() {
+	s.createRollapp(false, nil)
+	s.registerSequencer()
+
+	currentHeader := s.rollappChain().CurrentHeader
+	startHeight := uint64(currentHeader.Height)
+	bd := rollapptypes.BlockDescriptor{Height: startHeight, StateRoot: currentHeader.AppHash, Timestamp: currentHeader.Time}
+
+	s.createCompatibleClient()
+	k := &s.hubApp().LightClientKeeper
+	s.Require().NoError(k.AddCandidate(s.hubCtx(), rollappChainID(), s.path.EndpointA.ClientID))
+
+	currentHeader = s.rollappChain().CurrentHeader
+	bdNext := rollapptypes.BlockDescriptor{Height: uint64(currentHeader.Height), StateRoot: currentHeader.AppHash, Timestamp: currentHeader.Time}
+
+	// no state update, the candidate is kept
+	s.Require().NoError(k.DiscoverCanonicalClients(s.hubCtx()))
+	_, found := k.GetCanonicalClient(s.hubCtx(), rollappChainID())
+	s.Require().False(found)
+	candidates, err := k.AllCandidates(s.hubCtx())
+	s.Require().NoError(err)
+	s.Require().Len(candidates, 1)
+
+	msgUpdateState := rollapptypes.NewMsgUpdateState(
+		s.hubChain().SenderAccount.GetAddress().String(),
+		rollappChainID(),
+		"mock-da-path",
+		startHeight,
+		2,
+		2, // revision
+		&rollapptypes.BlockDescriptors{BD: []rollapptypes.BlockDescriptor{bd, bdNext}},
+	)
+	_, err = s.rollappMsgServer().UpdateState(s.hubCtx(), msgUpdateState)
+	s.Require().NoError(err)
+
+	ctx := s.hubCtx().WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(k.DiscoverCanonicalClients(ctx))
+	canonClientID, found := k.GetCanonicalClient(ctx, rollappChainID())
+	s.Require().True(found)
+	s.Require().Equal(s.path.EndpointA.ClientID, canonClientID)
+	s.Require().True(slices.ContainsFunc(ctx.EventManager().Events(), func(e sdk.Event) bool {
+		return e.Type == "dymensionxyz.dymension.lightclient.EventCanonicalClientDiscovered"
+	}))
+	candidates, err = k.AllCandidates(ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(candidates)
+}
+
+// TestAddCandidate_Limited tests that only clients with the expected params are candidates, up to the limit
+func (s *lightClientSuite) TestAddCandidate_Limited() {
+	s.createRollapp(false, nil)
+	s.registerSequencer()
+	k := &s.hubApp().LightClientKeeper
+
+	// the default testing client does not have the expected params
+	s.path = s.newTransferPath(s.hubChain(), s.rollappChain())
+	s.coordinator.SetupClients(s.path)
+	s.Require().NoError(k.AddCandidate(s.hubCtx(), rollappChainID(), s.path.EndpointA.ClientID))
+	candidates, err := k.AllCandidates(s.hubCtx())
+	s.Require().NoError(err)
+	s.Require().Empty(candidates)
+
+	for range lightclientkeeper.MaxCandidatesPerRollapp + 1 {
+		s.createCompatibleClient()
+		s.Require().NoError(k.AddCandidate(s.hubCtx(), rollappChainID(), s.path.EndpointA.ClientID))
+	}
+	candidates, err = k.AllCandidates(s.hubCtx())
+	s.Require().NoError(err)
+	s.Require().Len(candidates, lightclientkeeper.MaxCandidatesPerRollapp)
+	s.Require().NotContains(candidates, collections.Join(rollappChainID(), s.path.EndpointA.ClientID))
+}
+
+// TestDiscoverCanonicalClient_DropsStaleCandidates tests that clients which never match a state update do not
+// hold the candidate slots of the rollapp forever
+func (s *lightClientSuite) TestDiscoverCanonicalClient_DropsStaleCandidates() {
+	s.createRollapp(false, nil)
+	s.registerSequencer()
+	k := &s.hubApp().LightClientKeeper
+
+	// fill the slots with clients which are never committed by a state update
+	for range lightclientkeeper.MaxCandidatesPerRollapp {
+		s.createCompatibleClient()
+		s.Require().NoError(k.AddCandidate(s.hubCtx(), rollappChainID(), s.path.EndpointA.ClientID))
+	}
+	s.createCompatibleClient()
+	s.Require().NoError(k.AddCandidate(s.hubCtx(), rollappChainID(), s.path.EndpointA.ClientID))
+	candidates, err := k.AllCandidates(s.hubCtx())
+	s.Require().NoError(err)
+	s.Require().NotContains(candidates, collections.Join(rollappChainID(), s.path.EndpointA.ClientID))
+
+	// the end blocker already tried some of them while the clients were created
+	ctx := s.hubCtx()
+	for range lightclientkeeper.MaxCandidateAttempts {
+		s.Require().NoError(k.DiscoverCanonicalClients(ctx))
+	}
+	candidates, err = k.AllCandidates(ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(candidates)
+
+	// the slots are free for a later client
+	s.Require().NoError(k.AddCandidate(ctx, rollappChainID(), s.path.EndpointA.ClientID))
+	candidates, err = k.AllCandidates(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]collections.Pair[string, string]{collections.Join(rollappChainID(), s.path.EndpointA.ClientID)}, candidates)
+}
+
 func (s *lightClientSuite) TestSetCanonicalClient_MultipleClients_Succeeds() {
 	s.createRollapp(false, nil)
 	s.registerSequencer()
@@ -710,9 +882,117 @@ func (s *lightClientSuite) TestAfterUpdateState_AutoUpdateIBCClient() {
 	s.Require().True(found)
 }
 
+// TestRecoverCanonicalClient tests that a frozen canonical client can be replaced by a client matching the finalized state
+func (s *lightClientSuite) TestRecoverCanonicalClient() {
+	channelID := "channel-0"
+	s.createRollapp(false, &channelID)
+	s.registerSequencer()
+	owner := s.hubChain().SenderAccount.GetAddress().String()
+
+	s.createCompatibleClient()
+	oldClientID := s.path.EndpointA.ClientID
+	s.setRollappLightClientID(rollappChainID(), oldClientID)
+	seq := s.hubApp().SequencerKeeper.GetProposer(s.hubCtx(), rollappChainID())
+	s.Require().NoError(s.hubApp().LightClientKeeper.SaveSigner(s.hubCtx(), seq.Address, oldClientID, 100))
+
+	currentHeader := s.rollappChain().CurrentHeader
+	startHeight := uint64(currentHeader.Height)
+	bd := rollapptypes.BlockDescriptor{Height: startHeight, StateRoot: currentHeader.AppHash, Timestamp: currentHeader.Time}
+
+	s.createCompatibleClient()
+	clientID := s.path.EndpointA.ClientID
+
+	currentHeader = s.rollappChain().CurrentHeader
+	bdNext := rollapptypes.BlockDescriptor{Height: uint64(currentHeader.Height), StateRoot: currentHeader.AppHash, Timestamp: currentHeader.Time}
+
+	msgUpdateState := rollapptypes.NewMsgUpdateState(
+		owner,
+		rollappChainID(),
+		"mock-da-path",
+		startHeight,
+		2,
+		2, // revision
+		&rollapptypes.BlockDescriptors{BD: []rollapptypes.BlockDescriptor{bd, bdNext}},
+	)
+	_, err := s.rollappMsgServer().UpdateState(s.hubCtx(), msgUpdateState)
+	s.Require().NoError(err)
+
+	s.openTransferChannel(oldClientID, "connection-old", "channel-old")
+	s.openTransferChannel(clientID, "connection-new", "channel-new")
+	recoverMsg := &types.MsgRecoverCanonicalClient{Signer: owner, RollappId: rollappChainID(), ClientId: clientID, ChannelId: "channel-new"}
+
+	// the canonical client is still active
+	_, err = s.lightclientMsgServer().RecoverCanonicalClient(s.hubCtx(), recoverMsg)
+	utest.IsErr(s.Require(), err, gerrc.ErrFailedPrecondition)
+
+	cs, ok := s.hubApp().IBCKeeper.ClientKeeper.GetClientState(s.hubCtx(), oldClientID)
+	s.Require().True(ok)
+	tmCS := cs.(*ibctm.ClientState)
+	tmCS.FrozenHeight = clienttypes.NewHeight(0, 1)
+	s.hubApp().IBCKeeper.ClientKeeper.SetClientState(s.hubCtx(), oldClientID, tmCS)
+
+	// only the owner or gov can recover
+	_, err = s.lightclientMsgServer().RecoverCanonicalClient(s.hubCtx(), &types.MsgRecoverCanonicalClient{
+		Signer: s.cosmosChain().SenderAccount.GetAddress().String(), RollappId: rollappChainID(), ClientId: clientID, ChannelId: "channel-new",
+	})
+	utest.IsErr(s.Require(), err, gerrc.ErrPermissionDenied)
+
+	// the state is not finalized yet
+	_, err = s.lightclientMsgServer().RecoverCanonicalClient(s.hubCtx(), recoverMsg)
+	utest.IsErr(s.Require(), err, gerrc.ErrFailedPrecondition)
+
+	sInfo, ok := s.hubApp().RollappKeeper.GetLatestStateInfo(s.hubCtx(), rollappChainID())
+	s.Require().True(ok)
+	sInfo.Status = common.Status_FINALIZED
+	s.hubApp().RollappKeeper.SetStateInfo(s.hubCtx(), sInfo)
+	s.hubApp().RollappKeeper.SetLatestFinalizedStateIndex(s.hubCtx(), sInfo.StateInfoIndex)
+
+	// the channel must exist and be over the new client
+	for ch, expErr := range map[string]error{"channel-9": gerrc.ErrNotFound, "channel-old": gerrc.ErrInvalidArgument} {
+		_, err = s.lightclientMsgServer().RecoverCanonicalClient(s.hubCtx(), &types.MsgRecoverCanonicalClient{
+			Signer: owner, RollappId: rollappChainID(), ClientId: clientID, ChannelId: ch,
+		})
+		utest.IsErr(s.Require(), err, expErr)
+	}
+
+	_, err = s.lightclientMsgServer().RecoverCanonicalClient(s.hubCtx(), recoverMsg)
+	s.Require().NoError(err)
+
+	canonClientID, found := s.hubApp().LightClientKeeper.GetCanonicalClient(s.hubCtx(), rollappChainID())
+	s.Require().True(found)
+	s.Require().Equal(clientID, canonClientID)
+	_, found = s.hubApp().LightClientKeeper.GetRollappForClientID(s.hubCtx(), oldClientID)
+	s.Require().False(found)
+
+	// the channel becomes canonical and the unverified header is attributed to the new client
+	ra := s.hubApp().RollappKeeper.MustGetRollapp(s.hubCtx(), rollappChainID())
+	s.Require().Equal("channel-new", ra.ChannelId)
+	signer, err := s.hubApp().LightClientKeeper.GetSigner(s.hubCtx(), clientID, 100)
+	s.Require().NoError(err)
+	s.Require().Equal(seq.Address, signer)
+	_, err = s.hubApp().LightClientKeeper.GetSigner(s.hubCtx(), oldClientID, 100)
+	s.Require().Error(err)
+}
+
+// openTransferChannel writes an open transfer channel over the client to the hub
+func (s *lightClientSuite) openTransferChannel(clientID, connectionID, channelID string) {
+	s.hubApp().IBCKeeper.ConnectionKeeper.SetConnection(s.hubCtx(), connectionID, connectiontypes.ConnectionEnd{
+		ClientId: clientID,
+		State:    connectiontypes.OPEN,
+	})
+	s.hubApp().IBCKeeper.ChannelKeeper.SetChannel(s.hubCtx(), transfertypes.PortID, channelID, channeltypes.Channel{
+		State:          channeltypes.OPEN,
+		ConnectionHops: []string{connectionID},
+	})
+}
+
 func (s *lightClientSuite) createCompatibleClient() {
 	// create a custom tm client which matches the trust requirements of a canonical client
-	endpointA := ibctesting.NewEndpoint(s.hubChain(), &canonicalClientConfig, ibctesting.NewConnectionConfig(), ibctesting.NewChannelConfig())
+	s.createClient(&canonicalClientConfig)
+}
+
+func (s *lightClientSuite) createClient(cfg *ibctesting.TendermintConfig) {
+	endpointA := ibctesting.NewEndpoint(s.hubChain(), cfg, ibctesting.NewConnectionConfig(), ibctesting.NewChannelConfig())
 	endpointB := ibctesting.NewEndpoint(s.rollappChain(), ibctesting.NewTendermintConfig(), ibctesting.NewConnectionConfig(), ibctesting.NewChannelConfig())
 	endpointA.Counterparty = endpointB
 	endpointB.Counterparty = endpointA
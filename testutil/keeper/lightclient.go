@@ -7,6 +7,7 @@ import (
 	"time"
 
 	storetypes "cosmossdk.io/store/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/integration"
@@ -17,6 +18,7 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	"github.com/dymensionxyz/dymension/v3/app/params"
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
 	"github.com/dymensionxyz/dymension/v3/x/lightclient/keeper"
 	"github.com/dymensionxyz/dymension/v3/x/lightclient/types"
 	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
@@ -90,6 +92,7 @@ func LightClientKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		nil,
 		mockSequencerKeeper,
 		mockRollappKeeper,
+		sample.AccAddress(),
 	)
 
 	ctx := sdk.NewContext(stateStore, cometbftproto.Header{}, false, log.NewNopLogger())
@@ -132,6 +135,10 @@ func (m *MockIBCCLientKeeper) GetClientState(ctx sdk.Context, clientID string) (
 	return cs, ok
 }
 
+func (m *MockIBCCLientKeeper) GetClientStatus(ctx sdk.Context, clientState exported.ClientState, clientID string) exported.Status {
+	return exported.Active
+}
+
 func (m *MockIBCCLientKeeper) IterateClientStates(ctx sdk.Context, prefix []byte, cb func(clientID string, cs exported.ClientState) bool) {
 	for clientID, cs := range m.clientStates {
 		if cb(clientID, cs) {
@@ -151,6 +158,22 @@ func (m *MockIBCCLientKeeper) ConsensusStateHeights(c context.Context, req *ibcc
 
 type MockSequencerKeeper struct {
 	sequencers map[string]*sequencertypes.Sequencer
+	// rotations are the dymint key rotations of each sequencer, by increasing effective height
+	rotations map[string][]sequencertypes.KeyRotation
+	// Punished records the sequencers punished for equivocation
+	Punished []string
+}
+
+// RotateKey makes the sequencer sign with pk from the effective height on
+func (m *MockSequencerKeeper) RotateKey(addr string, pk *codectypes.Any, effectiveHeight uint64) {
+	seq := m.sequencers[addr]
+	m.rotations[addr] = append(m.rotations[addr], sequencertypes.KeyRotation{
+		Sequencer:            addr,
+		PreviousDymintPubKey: seq.DymintPubKey,
+		DymintPubKey:         pk,
+		EffectiveHeight:      effectiveHeight,
+	})
+	seq.DymintPubKey = pk
 }
 
 func (m *MockSequencerKeeper) SequencerByDymintAddr(ctx sdk.Context, addr cryptotypes.Address) (sequencertypes.Sequencer, error) {
@@ -158,6 +181,12 @@ func (m *MockSequencerKeeper) SequencerByDymintAddr(ctx sdk.Context, addr crypto
 		if bytes.Equal(s.MustProposerAddr(), addr) {
 			return *s, nil
 		}
+		for _, r := range m.rotations[s.Address] {
+			prev, err := sequencertypes.PubKeyAddr(r.PreviousDymintPubKey)
+			if err == nil && bytes.Equal(prev, addr) {
+				return *s, nil
+			}
+		}
 	}
 	return sequencertypes.Sequencer{}, gerrc.ErrNotFound
 }
@@ -170,6 +199,30 @@ func (m *MockSequencerKeeper) RealSequencer(ctx sdk.Context, addr string) (seque
 	return *seq, nil
 }
 
+func (m *MockSequencerKeeper) SequencerAtHeight(ctx sdk.Context, addr string, h uint64) (sequencertypes.Sequencer, error) {
+	seq, err := m.RealSequencer(ctx, addr)
+	if err != nil {
+		return sequencertypes.Sequencer{}, err
+	}
+	rotations := m.rotations[addr]
+	if len(rotations) == 0 {
+		return seq, nil
+	}
+	seq.DymintPubKey = rotations[0].PreviousDymintPubKey
+	for _, r := range rotations {
+		if h < r.EffectiveHeight {
+			break
+		}
+		seq.DymintPubKey = r.DymintPubKey
+	}
+	return seq, nil
+}
+
+func (m *MockSequencerKeeper) PunishEquivocation(ctx sdk.Context, seqAddr string, rewardee sdk.AccAddress) error {
+	m.Punished = append(m.Punished, seqAddr)
+	return nil
+}
+
 func (m *MockSequencerKeeper) RollappSequencers(ctx sdk.Context, rollappId string) (list []sequencertypes.Sequencer) {
 	seqs := make([]sequencertypes.Sequencer, 0, len(m.sequencers))
 	for _, seq := range m.sequencers {
@@ -186,6 +239,7 @@ func (m *MockSequencerKeeper) GetProposer(ctx sdk.Context, rollappId string) (va
 func NewMockSequencerKeeper(sequencers map[string]*sequencertypes.Sequencer) *MockSequencerKeeper {
 	return &MockSequencerKeeper{
 		sequencers: sequencers,
+		rotations:  make(map[string][]sequencertypes.KeyRotation),
 	}
 }
 
@@ -209,6 +263,16 @@ func (m *MockRollappKeeper) GetLatestStateInfo(ctx sdk.Context, rollappId string
 	panic("unimplemented")
 }
 
+// GetLatestFinalizedStateInfo implements types.RollappKeeperExpected.
+func (m *MockRollappKeeper) GetLatestFinalizedStateInfo(ctx sdk.Context, rollappId string) (rollapptypes.StateInfo, bool) {
+	panic("unimplemented")
+}
+
+// DisputePeriodInBlocks implements types.RollappKeeperExpected.
+func (m *MockRollappKeeper) DisputePeriodInBlocks(ctx sdk.Context) uint64 {
+	panic("unimplemented")
+}
+
 func NewMockRollappKeeper() *MockRollappKeeper {
 	return &MockRollappKeeper{}
 }
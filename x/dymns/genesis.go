@@ -27,6 +27,9 @@ func InitGenesis(ctx sdk.Context, k dymnskeeper.Keeper, genState dymnstypes.Gene
 			mustNoError(k.SetAliasForRollAppId(ctx, aliasesOfRollApp.ChainId, alias))
 		}
 	}
+	for _, transfer := range genState.OwnershipTransfers {
+		mustNoError(k.SetDymNameOwnershipTransfer(ctx, transfer))
+	}
 }
 
 // mustNoError is used when an action, which returns an error, must be run successfully without error.
@@ -83,11 +86,21 @@ func ExportGenesis(ctx sdk.Context, k dymnskeeper.Keeper) *dymnstypes.GenesisSta
 	// Collect aliases of RollApps so that we can add back later.
 	aliasesOfRollApps := k.GetAllRollAppsWithAliases(ctx)
 
+	// Collect non-expired pending ownership transfers so that they can still be accepted.
+	var ownershipTransfers []dymnstypes.DymNameOwnershipTransfer
+	for _, transfer := range k.GetAllDymNameOwnershipTransfers(ctx) {
+		if transfer.IsExpiredAtCtx(ctx) {
+			continue
+		}
+		ownershipTransfers = append(ownershipTransfers, transfer)
+	}
+
 	return &dymnstypes.GenesisState{
-		Params:            params,
-		DymNames:          nonExpiredDymNameAndWithinGracePeriod,
-		SellOrderBids:     nonRefundedBids,
-		BuyOrders:         nonRefundedBuyOrders,
-		AliasesOfRollapps: aliasesOfRollApps,
+		Params:             params,
+		DymNames:           nonExpiredDymNameAndWithinGracePeriod,
+		SellOrderBids:      nonRefundedBids,
+		BuyOrders:          nonRefundedBuyOrders,
+		AliasesOfRollapps:  aliasesOfRollApps,
+		OwnershipTransfers: ownershipTransfers,
 	}
 }
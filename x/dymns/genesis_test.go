@@ -219,6 +219,22 @@ func TestExportThenInitGenesis(t *testing.T) {
 		}
 	}
 
+	transfer1 := dymnstypes.DymNameOwnershipTransfer{
+		Name:     dymName1.Name,
+		Owner:    owner1,
+		NewOwner: anotherAccount,
+		ExpireAt: now.Add(time.Hour).Unix(),
+	}
+	require.NoError(t, oldKeeper.SetDymNameOwnershipTransfer(oldCtx, transfer1))
+
+	transfer2Expired := dymnstypes.DymNameOwnershipTransfer{
+		Name:     dymName2.Name,
+		Owner:    owner2,
+		NewOwner: anotherAccount,
+		ExpireAt: now.Add(-time.Hour).Unix(),
+	}
+	require.NoError(t, oldKeeper.SetDymNameOwnershipTransfer(oldCtx, transfer2Expired))
+
 	// Export genesis state
 	genState := dymns.ExportGenesis(oldCtx, oldKeeper)
 
@@ -270,6 +286,11 @@ func TestExportThenInitGenesis(t *testing.T) {
 		})
 	})
 
+	t.Run("pending ownership transfers should be exported correctly", func(t *testing.T) {
+		// expired transfer should not be exported
+		require.Equal(t, []dymnstypes.DymNameOwnershipTransfer{transfer1}, genState.OwnershipTransfers)
+	})
+
 	// Init genesis state
 
 	genState.Params.Misc.EndEpochHookIdentifier = "week" // Change the epoch identifier to test if it is imported correctly
@@ -338,6 +359,11 @@ func TestExportThenInitGenesis(t *testing.T) {
 		require.Empty(t, names, 0)
 	})
 
+	t.Run("pending ownership transfers should be imported correctly", func(t *testing.T) {
+		require.Equal(t, &transfer1, newDymNsKeeper.GetDymNameOwnershipTransfer(newCtx, dymName1.Name))
+		require.Nil(t, newDymNsKeeper.GetDymNameOwnershipTransfer(newCtx, dymName2.Name))
+	})
+
 	t.Run("sell orders's non-refunded bids should be refunded correctly", func(t *testing.T) {
 		require.Equal(t,
 			testCoin(200),
@@ -224,6 +224,9 @@ func (k Keeper) PruneDymName(ctx sdk.Context, name string) error {
 		k.DeleteSellOrder(ctx, name, dymnstypes.TypeName)
 	}
 
+	// pending ownership transfer is no longer valid
+	k.DeleteDymNameOwnershipTransfer(ctx, name)
+
 	dymName := k.GetDymName(ctx, name)
 	if dymName == nil {
 		return nil
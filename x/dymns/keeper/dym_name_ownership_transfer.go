@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dymnstypes "github.com/dymensionxyz/dymension/v3/x/dymns/types"
+)
+
+// SetDymNameOwnershipTransfer stores a pending ownership transfer of a Dym-Name into the KVStore.
+// Any existing pending transfer of the same Dym-Name will be replaced.
+func (k Keeper) SetDymNameOwnershipTransfer(ctx sdk.Context, transfer dymnstypes.DymNameOwnershipTransfer) error {
+	if err := transfer.Validate(); err != nil {
+		return err
+	}
+
+	// remove the expiry index of the replaced transfer
+	k.DeleteDymNameOwnershipTransfer(ctx, transfer.Name)
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&transfer)
+	store.Set(dymnstypes.DymNameOwnershipTransferKey(transfer.Name), bz)
+	store.Set(dymnstypes.DymNameOwnershipTransferExpiryKey(transfer.ExpireAt, transfer.Name), []byte{})
+
+	return nil
+}
+
+// GetDymNameOwnershipTransfer retrieves the pending ownership transfer of the Dym-Name from the KVStore.
+// If there is no pending transfer, nil is returned.
+func (k Keeper) GetDymNameOwnershipTransfer(ctx sdk.Context, name string) *dymnstypes.DymNameOwnershipTransfer {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(dymnstypes.DymNameOwnershipTransferKey(name))
+	if bz == nil {
+		return nil
+	}
+
+	var transfer dymnstypes.DymNameOwnershipTransfer
+	k.cdc.MustUnmarshal(bz, &transfer)
+
+	return &transfer
+}
+
+// GetAllDymNameOwnershipTransfers returns all pending ownership transfers of Dym-Names from the KVStore.
+// Store iterator is expensive so usage should be considered carefully.
+func (k Keeper) GetAllDymNameOwnershipTransfers(ctx sdk.Context) (list []dymnstypes.DymNameOwnershipTransfer) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, dymnstypes.KeyPrefixDymNameOwnershipTransfer)
+	defer func() {
+		_ = iterator.Close()
+	}()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var transfer dymnstypes.DymNameOwnershipTransfer
+		k.cdc.MustUnmarshal(iterator.Value(), &transfer)
+		list = append(list, transfer)
+	}
+
+	return list
+}
+
+// DeleteDymNameOwnershipTransfer deletes the pending ownership transfer of the Dym-Name from the KVStore.
+func (k Keeper) DeleteDymNameOwnershipTransfer(ctx sdk.Context, name string) {
+	transfer := k.GetDymNameOwnershipTransfer(ctx, name)
+	if transfer == nil {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(dymnstypes.DymNameOwnershipTransferKey(name))
+	store.Delete(dymnstypes.DymNameOwnershipTransferExpiryKey(transfer.ExpireAt, name))
+}
+
+// PruneExpiredDymNameOwnershipTransfers deletes the pending ownership transfers which are expired at the context time.
+// Only the expired records are visited, using the expiry index.
+func (k Keeper) PruneExpiredDymNameOwnershipTransfers(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	// a transfer is expired when its expiry is strictly before the block time
+	iterator := store.Iterator(
+		dymnstypes.KeyPrefixDymNameOwnershipTransferExpiry,
+		dymnstypes.DymNameOwnershipTransferExpiryPrefix(ctx.BlockTime().Unix()),
+	)
+
+	var names []string
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		names = append(names, string(key[len(dymnstypes.KeyPrefixDymNameOwnershipTransferExpiry)+8:]))
+	}
+	_ = iterator.Close()
+
+	for _, name := range names {
+		k.DeleteDymNameOwnershipTransfer(ctx, name)
+	}
+}
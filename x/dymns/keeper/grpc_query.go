@@ -46,6 +46,19 @@ func (q queryServer) DymName(goCtx context.Context, req *dymnstypes.QueryDymName
 	return &dymnstypes.QueryDymNameResponse{DymName: dymName}, nil
 }
 
+// DymNameOwnershipTransfer queries the pending ownership transfer of a Dym-Name.
+func (q queryServer) DymNameOwnershipTransfer(goCtx context.Context, req *dymnstypes.QueryDymNameOwnershipTransferRequest) (*dymnstypes.QueryDymNameOwnershipTransferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	transfer := q.GetDymNameOwnershipTransfer(ctx, req.DymName)
+
+	return &dymnstypes.QueryDymNameOwnershipTransferResponse{Transfer: transfer}, nil
+}
+
 // ResolveDymNameAddresses resolves multiple Dym-Name Addresses to account address of each pointing to.
 //
 // For example:
@@ -11,34 +11,99 @@ import (
 )
 
 // TransferDymNameOwnership is message handler,
-// handles transfer of ownership of a Dym-Name, performed by the owner.
+// handles proposing a transfer of ownership of a Dym-Name, performed by the owner.
+// The ownership is only transferred when the new owner accepts it,
+// any existing pending transfer of the Dym-Name will be replaced.
 func (k msgServer) TransferDymNameOwnership(goCtx context.Context, msg *dymnstypes.MsgTransferDymNameOwnership) (*dymnstypes.MsgTransferDymNameOwnershipResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	dymName, err := k.validateTransferDymNameOwnership(ctx, msg)
-	if err != nil {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.validateTransferDymNameOwnership(ctx, msg.Name, msg.Owner); err != nil {
 		return nil, err
 	}
 
-	if err := k.transferDymNameOwnership(ctx, *dymName, msg.NewOwner); err != nil {
+	transferDuration := k.MiscParams(ctx).OwnershipTransferDuration
+	if err := k.SetDymNameOwnershipTransfer(ctx, dymnstypes.DymNameOwnershipTransfer{
+		Name:     msg.Name,
+		Owner:    msg.Owner,
+		NewOwner: msg.NewOwner,
+		ExpireAt: ctx.BlockTime().Add(transferDuration).Unix(),
+	}); err != nil {
 		return nil, err
 	}
 
 	return &dymnstypes.MsgTransferDymNameOwnershipResponse{}, nil
 }
 
-// validateTransferDymNameOwnership handles validation for message handled by TransferDymNameOwnership
-func (k msgServer) validateTransferDymNameOwnership(ctx sdk.Context, msg *dymnstypes.MsgTransferDymNameOwnership) (*dymnstypes.DymName, error) {
+// AcceptDymNameOwnership is message handler,
+// handles accepting a pending transfer of ownership of a Dym-Name, performed by the new owner.
+func (k msgServer) AcceptDymNameOwnership(goCtx context.Context, msg *dymnstypes.MsgAcceptDymNameOwnership) (*dymnstypes.MsgAcceptDymNameOwnershipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	transfer := k.GetDymNameOwnershipTransfer(ctx, msg.Name)
+	if transfer == nil {
+		return nil, errorsmod.Wrapf(gerrc.ErrNotFound, "ownership transfer of Dym-Name: %s", msg.Name)
+	}
+
+	if transfer.NewOwner != msg.NewOwner {
+		return nil, errorsmod.Wrap(gerrc.ErrPermissionDenied, "not the new owner of the ownership transfer")
+	}
+
+	if transfer.IsExpiredAtCtx(ctx) {
+		return nil, errorsmod.Wrap(gerrc.ErrFailedPrecondition, "ownership transfer is already expired")
+	}
+
+	// the Dym-Name state might have changed since the transfer was proposed, so re-validate
+	dymName, err := k.validateTransferDymNameOwnership(ctx, msg.Name, transfer.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.transferDymNameOwnership(ctx, *dymName, transfer.NewOwner); err != nil {
+		return nil, err
+	}
+
+	return &dymnstypes.MsgAcceptDymNameOwnershipResponse{}, nil
+}
+
+// CancelDymNameOwnershipTransfer is message handler,
+// handles canceling a pending transfer of ownership of a Dym-Name, performed by the owner.
+func (k msgServer) CancelDymNameOwnershipTransfer(goCtx context.Context, msg *dymnstypes.MsgCancelDymNameOwnershipTransfer) (*dymnstypes.MsgCancelDymNameOwnershipTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
 	if err := msg.ValidateBasic(); err != nil {
 		return nil, err
 	}
 
-	dymName := k.GetDymName(ctx, msg.Name)
+	transfer := k.GetDymNameOwnershipTransfer(ctx, msg.Name)
+	if transfer == nil {
+		return nil, errorsmod.Wrapf(gerrc.ErrNotFound, "ownership transfer of Dym-Name: %s", msg.Name)
+	}
+
+	if transfer.Owner != msg.Owner {
+		return nil, errorsmod.Wrap(gerrc.ErrPermissionDenied, "not the owner of the Dym-Name")
+	}
+
+	k.DeleteDymNameOwnershipTransfer(ctx, msg.Name)
+
+	return &dymnstypes.MsgCancelDymNameOwnershipTransferResponse{}, nil
+}
+
+// validateTransferDymNameOwnership ensures the Dym-Name can be transferred by the given owner.
+func (k msgServer) validateTransferDymNameOwnership(ctx sdk.Context, name, owner string) (*dymnstypes.DymName, error) {
+	dymName := k.GetDymName(ctx, name)
 	if dymName == nil {
-		return nil, errorsmod.Wrapf(gerrc.ErrNotFound, "Dym-Name: %s", msg.Name)
+		return nil, errorsmod.Wrapf(gerrc.ErrNotFound, "Dym-Name: %s", name)
 	}
 
-	if dymName.Owner != msg.Owner {
+	if dymName.Owner != owner {
 		return nil, errorsmod.Wrap(gerrc.ErrPermissionDenied, "not the owner of the Dym-Name")
 	}
 
@@ -46,7 +111,7 @@ func (k msgServer) validateTransferDymNameOwnership(ctx sdk.Context, msg *dymnst
 		return nil, errorsmod.Wrap(gerrc.ErrUnauthenticated, "Dym-Name is already expired")
 	}
 
-	so := k.GetSellOrder(ctx, msg.Name, dymnstypes.TypeName)
+	so := k.GetSellOrder(ctx, name, dymnstypes.TypeName)
 	if so != nil {
 		// by ignoring SO, can fall into case that SO not completed/lost funds of bidder,...
 
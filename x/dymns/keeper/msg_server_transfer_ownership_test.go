@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/dymensionxyz/sdk-utils/utils/uptr"
 
@@ -234,6 +235,28 @@ func (s *KeeperTestSuite) Test_msgServer_TransferDymNameOwnership() {
 
 			s.Require().NotNil(resp)
 
+			s.Require().Equal(*tt.dymName, *laterDymName, "Dym-Name should not be changed until accepted")
+
+			transfer := s.dymNsKeeper.GetDymNameOwnershipTransfer(s.ctx, recordName)
+			s.Require().NotNil(transfer, "pending ownership transfer should be created")
+			s.Require().Equal(dymnstypes.DymNameOwnershipTransfer{
+				Name:     recordName,
+				Owner:    ownerA,
+				NewOwner: useNewOwner,
+				ExpireAt: s.now.Add(s.dymNsKeeper.MiscParams(s.ctx).OwnershipTransferDuration).Unix(),
+			}, *transfer)
+
+			acceptResp, err := dymnskeeper.NewMsgServerImpl(s.dymNsKeeper).AcceptDymNameOwnership(s.ctx, &dymnstypes.MsgAcceptDymNameOwnership{
+				Name:     recordName,
+				NewOwner: useNewOwner,
+			})
+			s.Require().NoError(err)
+			s.Require().NotNil(acceptResp)
+
+			s.Require().Nil(s.dymNsKeeper.GetDymNameOwnershipTransfer(s.ctx, recordName), "pending ownership transfer should be removed")
+
+			laterDymName = s.dymNsKeeper.GetDymName(s.ctx, recordName)
+
 			previousOwner := ownerA
 
 			s.Require().NotNil(laterDymName)
@@ -282,3 +305,208 @@ func (s *KeeperTestSuite) Test_msgServer_TransferDymNameOwnership() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) Test_msgServer_AcceptDymNameOwnership() {
+	s.Run("reject if message not pass validate basic", func() {
+		_, err := dymnskeeper.NewMsgServerImpl(s.dymNsKeeper).AcceptDymNameOwnership(s.ctx, &dymnstypes.MsgAcceptDymNameOwnership{})
+		s.Require().ErrorContains(err, gerrc.ErrInvalidArgument.Error())
+	})
+
+	ownerA := testAddr(1).bech32()
+	newOwnerA := testAddr(2).bech32()
+	anotherA := testAddr(3).bech32()
+
+	const recordName = "my-name"
+
+	tests := []struct {
+		name            string
+		noTransfer      bool
+		malleate        func()
+		signer          string
+		wantErr         bool
+		wantErrContains string
+	}{
+		{
+			name:   "pass - can accept ownership",
+			signer: newOwnerA,
+		},
+		{
+			name:            "fail - no pending transfer",
+			noTransfer:      true,
+			signer:          newOwnerA,
+			wantErr:         true,
+			wantErrContains: "not found",
+		},
+		{
+			name:            "fail - reject if not the new owner",
+			signer:          anotherA,
+			wantErr:         true,
+			wantErrContains: "not the new owner of the ownership transfer",
+		},
+		{
+			name:   "fail - reject if transfer expired",
+			signer: newOwnerA,
+			malleate: func() {
+				s.ctx = s.ctx.WithBlockTime(s.now.Add(s.dymNsKeeper.MiscParams(s.ctx).OwnershipTransferDuration + time.Second))
+			},
+			wantErr:         true,
+			wantErrContains: "ownership transfer is already expired",
+		},
+		{
+			name:   "fail - reject if Sell Order placed after proposal",
+			signer: newOwnerA,
+			malleate: func() {
+				s.Require().NoError(s.dymNsKeeper.SetSellOrder(s.ctx, dymnstypes.SellOrder{
+					AssetId:   recordName,
+					AssetType: dymnstypes.TypeName,
+					ExpireAt:  s.now.Unix() + 100,
+					MinPrice:  s.coin(100),
+				}))
+			},
+			wantErr:         true,
+			wantErrContains: "can not transfer ownership while there is an active Sell Order",
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.RefreshContext()
+
+			s.setDymNameWithFunctionsAfter(dymnstypes.DymName{
+				Name:       recordName,
+				Owner:      ownerA,
+				Controller: ownerA,
+				ExpireAt:   s.now.Unix() + 100,
+			})
+
+			if !tt.noTransfer {
+				_, err := dymnskeeper.NewMsgServerImpl(s.dymNsKeeper).TransferDymNameOwnership(s.ctx, &dymnstypes.MsgTransferDymNameOwnership{
+					Name:     recordName,
+					Owner:    ownerA,
+					NewOwner: newOwnerA,
+				})
+				s.Require().NoError(err)
+			}
+
+			if tt.malleate != nil {
+				tt.malleate()
+			}
+
+			resp, err := dymnskeeper.NewMsgServerImpl(s.dymNsKeeper).AcceptDymNameOwnership(s.ctx, &dymnstypes.MsgAcceptDymNameOwnership{
+				Name:     recordName,
+				NewOwner: tt.signer,
+			})
+
+			laterDymName := s.dymNsKeeper.GetDymName(s.ctx, recordName)
+			s.Require().NotNil(laterDymName)
+
+			if tt.wantErr {
+				s.Require().NotEmpty(tt.wantErrContains, "mis-configured test case")
+				s.Require().ErrorContains(err, tt.wantErrContains)
+				s.Require().Nil(resp)
+				s.Require().Equal(ownerA, laterDymName.Owner, "ownership should not be changed")
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().NotNil(resp)
+			s.Require().Equal(newOwnerA, laterDymName.Owner)
+			s.Require().Equal(newOwnerA, laterDymName.Controller)
+			s.Require().Nil(s.dymNsKeeper.GetDymNameOwnershipTransfer(s.ctx, recordName))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) Test_msgServer_CancelDymNameOwnershipTransfer() {
+	s.Run("reject if message not pass validate basic", func() {
+		_, err := dymnskeeper.NewMsgServerImpl(s.dymNsKeeper).CancelDymNameOwnershipTransfer(s.ctx, &dymnstypes.MsgCancelDymNameOwnershipTransfer{})
+		s.Require().ErrorContains(err, gerrc.ErrInvalidArgument.Error())
+	})
+
+	ownerA := testAddr(1).bech32()
+	newOwnerA := testAddr(2).bech32()
+
+	const recordName = "my-name"
+
+	s.RefreshContext()
+
+	s.setDymNameWithFunctionsAfter(dymnstypes.DymName{
+		Name:       recordName,
+		Owner:      ownerA,
+		Controller: ownerA,
+		ExpireAt:   s.now.Unix() + 100,
+	})
+
+	msgServer := dymnskeeper.NewMsgServerImpl(s.dymNsKeeper)
+
+	_, err := msgServer.CancelDymNameOwnershipTransfer(s.ctx, &dymnstypes.MsgCancelDymNameOwnershipTransfer{
+		Name:  recordName,
+		Owner: ownerA,
+	})
+	s.Require().ErrorContains(err, "not found")
+
+	_, err = msgServer.TransferDymNameOwnership(s.ctx, &dymnstypes.MsgTransferDymNameOwnership{
+		Name:     recordName,
+		Owner:    ownerA,
+		NewOwner: newOwnerA,
+	})
+	s.Require().NoError(err)
+
+	_, err = msgServer.CancelDymNameOwnershipTransfer(s.ctx, &dymnstypes.MsgCancelDymNameOwnershipTransfer{
+		Name:  recordName,
+		Owner: newOwnerA,
+	})
+	s.Require().ErrorContains(err, "not the owner of the Dym-Name")
+
+	_, err = msgServer.CancelDymNameOwnershipTransfer(s.ctx, &dymnstypes.MsgCancelDymNameOwnershipTransfer{
+		Name:  recordName,
+		Owner: ownerA,
+	})
+	s.Require().NoError(err)
+	s.Require().Nil(s.dymNsKeeper.GetDymNameOwnershipTransfer(s.ctx, recordName))
+
+	_, err = msgServer.AcceptDymNameOwnership(s.ctx, &dymnstypes.MsgAcceptDymNameOwnership{
+		Name:     recordName,
+		NewOwner: newOwnerA,
+	})
+	s.Require().ErrorContains(err, "not found")
+}
+
+func (s *KeeperTestSuite) Test_PruneExpiredDymNameOwnershipTransfers() {
+	ownerA := testAddr(1).bech32()
+	newOwnerA := testAddr(2).bech32()
+
+	s.RefreshContext()
+
+	expired := dymnstypes.DymNameOwnershipTransfer{
+		Name:     "expired",
+		Owner:    ownerA,
+		NewOwner: newOwnerA,
+		ExpireAt: s.now.Unix() - 1,
+	}
+	expiresNow := dymnstypes.DymNameOwnershipTransfer{
+		Name:     "expires-now",
+		Owner:    ownerA,
+		NewOwner: newOwnerA,
+		ExpireAt: s.now.Unix(),
+	}
+	replaced := dymnstypes.DymNameOwnershipTransfer{
+		Name:     "replaced",
+		Owner:    ownerA,
+		NewOwner: newOwnerA,
+		ExpireAt: s.now.Unix() - 1,
+	}
+	for _, transfer := range []dymnstypes.DymNameOwnershipTransfer{expired, expiresNow, replaced} {
+		s.Require().NoError(s.dymNsKeeper.SetDymNameOwnershipTransfer(s.ctx, transfer))
+	}
+
+	// replacing a transfer moves its expiry
+	replaced.ExpireAt = s.now.Unix() + 100
+	s.Require().NoError(s.dymNsKeeper.SetDymNameOwnershipTransfer(s.ctx, replaced))
+
+	s.dymNsKeeper.PruneExpiredDymNameOwnershipTransfers(s.ctx)
+
+	s.Require().Nil(s.dymNsKeeper.GetDymNameOwnershipTransfer(s.ctx, expired.Name))
+	s.Require().Equal(&expiresNow, s.dymNsKeeper.GetDymNameOwnershipTransfer(s.ctx, expiresNow.Name))
+	s.Require().Equal(&replaced, s.dymNsKeeper.GetDymNameOwnershipTransfer(s.ctx, replaced.Name))
+	s.Require().Len(s.dymNsKeeper.GetAllDymNameOwnershipTransfers(s.ctx), 2)
+}
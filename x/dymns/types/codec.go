@@ -12,6 +12,8 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRegisterName{}, "dymns/RegisterName", nil)
 	cdc.RegisterConcrete(&MsgRegisterAlias{}, "dymns/RegisterAlias", nil)
 	cdc.RegisterConcrete(&MsgTransferDymNameOwnership{}, "dymns/TransferDymNameOwnership", nil)
+	cdc.RegisterConcrete(&MsgAcceptDymNameOwnership{}, "dymns/AcceptDymNameOwnership", nil)
+	cdc.RegisterConcrete(&MsgCancelDymNameOwnershipTransfer{}, "dymns/CancelDymNameOwnershipTransfer", nil)
 	cdc.RegisterConcrete(&MsgSetController{}, "dymns/SetController", nil)
 	cdc.RegisterConcrete(&MsgUpdateResolveAddress{}, "dymns/UpdateResolveAddress", nil)
 	cdc.RegisterConcrete(&MsgUpdateDetails{}, "dymns/UpdateDetails", nil)
@@ -33,6 +35,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgRegisterName{},
 		&MsgRegisterAlias{},
 		&MsgTransferDymNameOwnership{},
+		&MsgAcceptDymNameOwnership{},
+		&MsgCancelDymNameOwnershipTransfer{},
 		&MsgSetController{},
 		&MsgUpdateResolveAddress{},
 		&MsgUpdateDetails{},
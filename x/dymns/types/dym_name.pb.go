@@ -282,11 +282,88 @@ func (m *ReverseLookupDymNames) GetDymNames() []string {
 	return nil
 }
 
+// DymNameOwnershipTransfer defines a pending transfer of ownership of a
+// Dym-Name. Ownership is only moved once the new owner accepts it.
+type DymNameOwnershipTransfer struct {
+	// name is the Dym-Name to be transferred ownership.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// owner is the account address of the owner who proposed the transfer.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// new_owner is the account address of the account which is going to accept
+	// the ownership.
+	NewOwner string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	// expire_at is the UTC epoch represent the last effective date of the
+	// transfer, after which it can no longer be accepted.
+	ExpireAt int64 `protobuf:"varint,4,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+}
+
+func (m *DymNameOwnershipTransfer) Reset()         { *m = DymNameOwnershipTransfer{} }
+func (m *DymNameOwnershipTransfer) String() string { return proto.CompactTextString(m) }
+func (*DymNameOwnershipTransfer) ProtoMessage()    {}
+func (*DymNameOwnershipTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_463436600bef60e6, []int{3}
+}
+func (m *DymNameOwnershipTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DymNameOwnershipTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DymNameOwnershipTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DymNameOwnershipTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DymNameOwnershipTransfer.Merge(m, src)
+}
+func (m *DymNameOwnershipTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *DymNameOwnershipTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_DymNameOwnershipTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DymNameOwnershipTransfer proto.InternalMessageInfo
+
+func (m *DymNameOwnershipTransfer) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DymNameOwnershipTransfer) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *DymNameOwnershipTransfer) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
+func (m *DymNameOwnershipTransfer) GetExpireAt() int64 {
+	if m != nil {
+		return m.ExpireAt
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("dymensionxyz.dymension.dymns.DymNameConfigType", DymNameConfigType_name, DymNameConfigType_value)
 	proto.RegisterType((*DymName)(nil), "dymensionxyz.dymension.dymns.DymName")
 	proto.RegisterType((*DymNameConfig)(nil), "dymensionxyz.dymension.dymns.DymNameConfig")
 	proto.RegisterType((*ReverseLookupDymNames)(nil), "dymensionxyz.dymension.dymns.ReverseLookupDymNames")
+	proto.RegisterType((*DymNameOwnershipTransfer)(nil), "dymensionxyz.dymension.dymns.DymNameOwnershipTransfer")
 }
 
 func init() {
@@ -294,35 +371,37 @@ func init() {
 }
 
 var fileDescriptor_463436600bef60e6 = []byte{
-	// 436 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xdd, 0x6e, 0xd3, 0x30,
-	0x14, 0x8e, 0x69, 0xb7, 0xb6, 0x1e, 0x3f, 0xc3, 0x1a, 0x92, 0x19, 0xc8, 0x54, 0xbd, 0x8a, 0x98,
-	0x14, 0x6b, 0x19, 0x2f, 0xb0, 0x75, 0x5c, 0xa0, 0x8d, 0x20, 0x45, 0x43, 0x48, 0xdc, 0x44, 0x4e,
-	0x62, 0xd2, 0x88, 0xc6, 0x8e, 0x62, 0x37, 0x34, 0x3c, 0x05, 0xb7, 0xbc, 0xd1, 0x2e, 0x77, 0x07,
-	0x57, 0x08, 0xb5, 0x2f, 0x82, 0xec, 0xa4, 0x53, 0x11, 0x02, 0x89, 0x9b, 0xe8, 0x7c, 0xdf, 0x39,
-	0x9f, 0xcf, 0x39, 0x5f, 0x0e, 0x3c, 0x4a, 0x9b, 0x82, 0x0b, 0x95, 0x4b, 0xb1, 0x6c, 0x3e, 0xd3,
-	0x5b, 0x60, 0x22, 0xa1, 0xcc, 0x37, 0x12, 0xac, 0xe0, 0x5e, 0x59, 0x49, 0x2d, 0xd1, 0xd3, 0xed,
-	0x62, 0xef, 0x16, 0x78, 0xb6, 0xf8, 0xf0, 0x20, 0x93, 0x99, 0xb4, 0x85, 0xd4, 0x44, 0xad, 0xe6,
-	0x90, 0x24, 0x52, 0x15, 0x52, 0xd1, 0x98, 0x29, 0x4e, 0xeb, 0xe3, 0x98, 0x6b, 0x76, 0x4c, 0x13,
-	0x99, 0x8b, 0x36, 0x3f, 0xf9, 0x06, 0xe0, 0xe0, 0xbc, 0x29, 0x02, 0x56, 0x70, 0x84, 0x60, 0xdf,
-	0x74, 0xc3, 0x60, 0x0c, 0xdc, 0x51, 0x68, 0x63, 0x74, 0x00, 0x77, 0xe4, 0x27, 0xc1, 0x2b, 0x7c,
-	0xc7, 0x92, 0x2d, 0x40, 0x04, 0xc2, 0x44, 0x0a, 0x5d, 0xc9, 0xf9, 0x9c, 0x57, 0xb8, 0x67, 0x53,
-	0x5b, 0x0c, 0x7a, 0x02, 0x47, 0x7c, 0x59, 0xe6, 0x15, 0x8f, 0x98, 0xc6, 0xfd, 0x31, 0x70, 0x7b,
-	0xe1, 0xb0, 0x25, 0x4e, 0x35, 0xba, 0x80, 0x83, 0x44, 0x8a, 0x0f, 0x79, 0xa6, 0xf0, 0xce, 0xb8,
-	0xe7, 0xee, 0xf9, 0x47, 0xde, 0xbf, 0x16, 0xf3, 0xba, 0xf1, 0xa6, 0x56, 0x73, 0xd6, 0xbf, 0xfe,
-	0xf1, 0xcc, 0x09, 0x37, 0x2f, 0x20, 0x6c, 0x1f, 0xd3, 0x2c, 0xd1, 0x78, 0xd7, 0x8e, 0xb1, 0x81,
-	0x93, 0xaf, 0x00, 0xde, 0xfb, 0x4d, 0x8a, 0xa6, 0xb0, 0xaf, 0x9b, 0xb2, 0xdd, 0xef, 0xbe, 0x4f,
-	0xff, 0xa3, 0xeb, 0x55, 0x53, 0xf2, 0xd0, 0x8a, 0xd1, 0x63, 0x38, 0x4c, 0x66, 0x2c, 0x17, 0x51,
-	0x9e, 0x76, 0x9e, 0x0c, 0x2c, 0x7e, 0x95, 0x1a, 0xff, 0x4a, 0xa6, 0x67, 0x9d, 0x1f, 0x36, 0x36,
-	0xfe, 0xd5, 0x6c, 0xbe, 0xe0, 0xd6, 0x85, 0x51, 0xd8, 0x82, 0xc9, 0x0b, 0xf8, 0x28, 0xe4, 0x35,
-	0xaf, 0x14, 0xbf, 0x94, 0xf2, 0xe3, 0xa2, 0xec, 0x9a, 0x29, 0x63, 0xdc, 0xe6, 0xa7, 0x2b, 0x0c,
-	0xc6, 0x3d, 0x77, 0x14, 0x0e, 0xd3, 0x2e, 0xf9, 0xdc, 0x87, 0x0f, 0xff, 0x98, 0x0a, 0x3d, 0x80,
-	0x7b, 0xe7, 0xd3, 0xab, 0xe8, 0x6d, 0x70, 0x11, 0xbc, 0x79, 0x17, 0xec, 0x3b, 0xe8, 0x2e, 0x1c,
-	0x1a, 0x22, 0x38, 0x7d, 0xfd, 0x72, 0x1f, 0x9c, 0x5d, 0x5e, 0xaf, 0x08, 0xb8, 0x59, 0x11, 0xf0,
-	0x73, 0x45, 0xc0, 0x97, 0x35, 0x71, 0x6e, 0xd6, 0xc4, 0xf9, 0xbe, 0x26, 0xce, 0x7b, 0x3f, 0xcb,
-	0xf5, 0x6c, 0x11, 0x7b, 0x89, 0x2c, 0xe8, 0x5f, 0xae, 0xb0, 0x3e, 0xa1, 0xcb, 0xee, 0x14, 0xcd,
-	0xee, 0x2a, 0xde, 0xb5, 0x47, 0x73, 0xf2, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x28, 0x32, 0x1e, 0x91,
-	0xb7, 0x02, 0x00, 0x00,
+	// 472 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6a, 0xdb, 0x40,
+	0x10, 0xd6, 0xd6, 0x4e, 0x2c, 0x6d, 0xfa, 0x93, 0x2e, 0x29, 0x6c, 0x93, 0xa2, 0x1a, 0x9f, 0x4c,
+	0x03, 0x12, 0x71, 0xfa, 0x02, 0x89, 0xd3, 0x43, 0x49, 0x2a, 0x83, 0x70, 0x29, 0xf4, 0x22, 0xd6,
+	0xf2, 0xc4, 0x16, 0xb5, 0x76, 0x85, 0x76, 0xfd, 0xa3, 0x1e, 0xfa, 0x0c, 0xbd, 0xf6, 0x8d, 0x72,
+	0xcc, 0xad, 0x3d, 0x95, 0x62, 0xbf, 0x48, 0xd9, 0x95, 0x14, 0x52, 0x4a, 0x02, 0xb9, 0x88, 0xf9,
+	0xe6, 0x9b, 0xd9, 0x99, 0xfd, 0x3e, 0x2d, 0x3e, 0x1c, 0x17, 0x29, 0x70, 0x99, 0x08, 0xbe, 0x2a,
+	0xbe, 0xfa, 0x37, 0x40, 0x47, 0x5c, 0xea, 0x6f, 0xc4, 0x59, 0x0a, 0x5e, 0x96, 0x0b, 0x25, 0xc8,
+	0xab, 0xdb, 0xc5, 0xde, 0x0d, 0xf0, 0x4c, 0xf1, 0xfe, 0xde, 0x44, 0x4c, 0x84, 0x29, 0xf4, 0x75,
+	0x54, 0xf6, 0xec, 0xbb, 0xb1, 0x90, 0xa9, 0x90, 0xfe, 0x88, 0x49, 0xf0, 0x17, 0x47, 0x23, 0x50,
+	0xec, 0xc8, 0x8f, 0x45, 0xc2, 0x4b, 0xbe, 0xf3, 0x13, 0xe1, 0xd6, 0x59, 0x91, 0x06, 0x2c, 0x05,
+	0x42, 0x70, 0x53, 0x4f, 0xa3, 0xa8, 0x8d, 0xba, 0x4e, 0x68, 0x62, 0xb2, 0x87, 0xb7, 0xc4, 0x92,
+	0x43, 0x4e, 0x1f, 0x99, 0x64, 0x09, 0x88, 0x8b, 0x71, 0x2c, 0xb8, 0xca, 0xc5, 0x6c, 0x06, 0x39,
+	0x6d, 0x18, 0xea, 0x56, 0x86, 0x1c, 0x60, 0x07, 0x56, 0x59, 0x92, 0x43, 0xc4, 0x14, 0x6d, 0xb6,
+	0x51, 0xb7, 0x11, 0xda, 0x65, 0xe2, 0x44, 0x91, 0x73, 0xdc, 0x8a, 0x05, 0xbf, 0x4c, 0x26, 0x92,
+	0x6e, 0xb5, 0x1b, 0xdd, 0x9d, 0xde, 0xa1, 0x77, 0xdf, 0xc5, 0xbc, 0x6a, 0xbd, 0xbe, 0xe9, 0x39,
+	0x6d, 0x5e, 0xfd, 0x7e, 0x6d, 0x85, 0xf5, 0x09, 0x84, 0x9a, 0xc3, 0x14, 0x8b, 0x15, 0xdd, 0x36,
+	0x6b, 0xd4, 0xb0, 0xf3, 0x03, 0xe1, 0x27, 0xff, 0xb4, 0x92, 0x3e, 0x6e, 0xaa, 0x22, 0x2b, 0xef,
+	0xf7, 0xb4, 0xe7, 0x3f, 0x60, 0xea, 0xb0, 0xc8, 0x20, 0x34, 0xcd, 0xe4, 0x25, 0xb6, 0xe3, 0x29,
+	0x4b, 0x78, 0x94, 0x8c, 0x2b, 0x4d, 0x5a, 0x06, 0xbf, 0x1f, 0x6b, 0xfd, 0x32, 0xa6, 0xa6, 0x95,
+	0x1e, 0x26, 0xd6, 0xfa, 0x2d, 0xd8, 0x6c, 0x0e, 0x46, 0x05, 0x27, 0x2c, 0x41, 0xe7, 0x2d, 0x7e,
+	0x11, 0xc2, 0x02, 0x72, 0x09, 0x17, 0x42, 0x7c, 0x99, 0x67, 0xd5, 0x30, 0xa9, 0x85, 0xab, 0x4d,
+	0x97, 0x14, 0xb5, 0x1b, 0x5d, 0x27, 0xb4, 0xc7, 0x15, 0xd9, 0xf9, 0x86, 0x69, 0x55, 0x38, 0xd0,
+	0x2e, 0xc8, 0x69, 0x92, 0x0d, 0x73, 0xc6, 0xe5, 0x25, 0xe4, 0x0f, 0xf0, 0xee, 0x00, 0x3b, 0x1c,
+	0x96, 0x51, 0xc9, 0x94, 0xab, 0xda, 0x1c, 0x96, 0x83, 0x9a, 0xbc, 0xd3, 0xb8, 0x37, 0x3d, 0xfc,
+	0xfc, 0x3f, 0x55, 0xc8, 0x33, 0xbc, 0x73, 0xd6, 0x1f, 0x46, 0x1f, 0x83, 0xf3, 0x60, 0xf0, 0x29,
+	0xd8, 0xb5, 0xc8, 0x63, 0x6c, 0xeb, 0x44, 0x70, 0xf2, 0xe1, 0xdd, 0x2e, 0x3a, 0xbd, 0xb8, 0x5a,
+	0xbb, 0xe8, 0x7a, 0xed, 0xa2, 0x3f, 0x6b, 0x17, 0x7d, 0xdf, 0xb8, 0xd6, 0xf5, 0xc6, 0xb5, 0x7e,
+	0x6d, 0x5c, 0xeb, 0x73, 0x6f, 0x92, 0xa8, 0xe9, 0x7c, 0xe4, 0xc5, 0x22, 0xf5, 0xef, 0x78, 0x05,
+	0x8b, 0x63, 0x7f, 0x55, 0x3d, 0x05, 0xad, 0xbd, 0x1c, 0x6d, 0x9b, 0x9f, 0xf6, 0xf8, 0xef, 0x00,
+	0x69, 0x3b, 0xcd, 0x71, 0x37, 0x03, 0x00, 0x00,
 }
 
 func (m *DymName) Marshal() (dAtA []byte, err error) {
@@ -476,6 +555,55 @@ func (m *ReverseLookupDymNames) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DymNameOwnershipTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DymNameOwnershipTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DymNameOwnershipTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpireAt != 0 {
+		i = encodeVarintDymName(dAtA, i, uint64(m.ExpireAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintDymName(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintDymName(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDymName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDymName(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDymName(v)
 	base := offset
@@ -560,6 +688,30 @@ func (m *ReverseLookupDymNames) Size() (n int) {
 	return n
 }
 
+func (m *DymNameOwnershipTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDymName(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovDymName(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovDymName(uint64(l))
+	}
+	if m.ExpireAt != 0 {
+		n += 1 + sovDymName(uint64(m.ExpireAt))
+	}
+	return n
+}
+
 func sovDymName(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1044,6 +1196,171 @@ func (m *ReverseLookupDymNames) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DymNameOwnershipTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDymName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DymNameOwnershipTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DymNameOwnershipTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDymName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDymName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDymName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDymName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDymName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDymName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDymName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDymName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDymName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireAt", wireType)
+			}
+			m.ExpireAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDymName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDymName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDymName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDymName(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
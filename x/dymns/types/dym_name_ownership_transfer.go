@@ -0,0 +1,41 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	dymnsutils "github.com/dymensionxyz/dymension/v3/x/dymns/utils"
+)
+
+// Validate checks if the DymNameOwnershipTransfer record is valid.
+func (m *DymNameOwnershipTransfer) Validate() error {
+	if m == nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "ownership transfer is nil")
+	}
+	if !dymnsutils.IsValidDymName(m.Name) {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "name is not a valid dym name")
+	}
+	if !dymnsutils.IsValidBech32AccountAddress(m.Owner, true) {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "owner is not a valid bech32 account address")
+	}
+	if !dymnsutils.IsValidBech32AccountAddress(m.NewOwner, true) {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "new owner is not a valid bech32 account address")
+	}
+	if strings.EqualFold(m.NewOwner, m.Owner) {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "new owner must be different from the current owner")
+	}
+	if m.ExpireAt == 0 {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "expiry is empty")
+	}
+
+	return nil
+}
+
+// IsExpiredAtCtx returns true if the ownership transfer is expired at the given context.
+// It compares the expiry with the block time in context.
+func (m DymNameOwnershipTransfer) IsExpiredAtCtx(ctx sdk.Context) bool {
+	return m.ExpireAt < ctx.BlockTime().Unix()
+}
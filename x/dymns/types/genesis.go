@@ -49,5 +49,16 @@ func (m GenesisState) Validate() error {
 		return errorsmod.Wrapf(errors.Join(gerrc.ErrInvalidArgument, err), "alias of chain-id")
 	}
 
+	uniqueTransfers := make(map[string]struct{})
+	for _, transfer := range m.OwnershipTransfers {
+		if err := transfer.Validate(); err != nil {
+			return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "ownership transfer of '%s': %v", transfer.Name, err)
+		}
+		if _, duplicated := uniqueTransfers[transfer.Name]; duplicated {
+			return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "ownership transfer of '%s': duplicate name", transfer.Name)
+		}
+		uniqueTransfers[transfer.Name] = struct{}{}
+	}
+
 	return nil
 }
@@ -37,6 +37,8 @@ type GenesisState struct {
 	BuyOrders []BuyOrder `protobuf:"bytes,4,rep,name=buy_orders,json=buyOrders,proto3" json:"buy_orders"`
 	// aliases_of_rollapps defines all the aliases of all RollApps.
 	AliasesOfRollapps []AliasesOfChainId `protobuf:"bytes,5,rep,name=aliases_of_rollapps,json=aliasesOfRollapps,proto3" json:"aliases_of_rollapps" yaml:"aliases_of_rollapps"`
+	// ownership_transfers defines the pending ownership transfers of Dym-Names.
+	OwnershipTransfers []DymNameOwnershipTransfer `protobuf:"bytes,6,rep,name=ownership_transfers,json=ownershipTransfers,proto3" json:"ownership_transfers" yaml:"ownership_transfers"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -107,6 +109,13 @@ func (m *GenesisState) GetAliasesOfRollapps() []AliasesOfChainId {
 	return nil
 }
 
+func (m *GenesisState) GetOwnershipTransfers() []DymNameOwnershipTransfer {
+	if m != nil {
+		return m.OwnershipTransfers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dymensionxyz.dymension.dymns.GenesisState")
 }
@@ -116,32 +125,34 @@ func init() {
 }
 
 var fileDescriptor_3a8fb43714238c1e = []byte{
-	// 387 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0xd2, 0xb1, 0x6a, 0xdb, 0x40,
-	0x18, 0x07, 0x70, 0xa9, 0x76, 0x4d, 0x7d, 0x6e, 0x29, 0x55, 0x3b, 0x08, 0x51, 0x64, 0x23, 0xda,
-	0xe2, 0xba, 0x20, 0x81, 0xbd, 0x75, 0xab, 0x1a, 0x48, 0x42, 0x42, 0x1c, 0xec, 0x25, 0x64, 0x11,
-	0xa7, 0xe8, 0x2c, 0x8b, 0xdc, 0xe9, 0x84, 0x3e, 0x39, 0xf8, 0x32, 0xe6, 0x09, 0xf2, 0x58, 0x1e,
-	0x3d, 0x66, 0x32, 0xc1, 0x7e, 0x83, 0x3c, 0x41, 0x90, 0x4e, 0x36, 0x1e, 0x12, 0x91, 0xed, 0xbe,
-	0xe3, 0xff, 0xff, 0x49, 0x07, 0x1f, 0xea, 0x05, 0x82, 0x91, 0x18, 0x22, 0x1e, 0xcf, 0xc5, 0xad,
-	0xb3, 0x1b, 0xf2, 0x53, 0x0c, 0x4e, 0x48, 0x62, 0x02, 0x11, 0xd8, 0x49, 0xca, 0x33, 0xae, 0x7d,
-	0xdf, 0xcf, 0xda, 0xbb, 0xc1, 0x2e, 0xb2, 0xc6, 0xb7, 0x90, 0x87, 0xbc, 0x08, 0x3a, 0xf9, 0x49,
-	0x76, 0x8c, 0xdf, 0x95, 0x7e, 0x82, 0x53, 0xcc, 0x4a, 0xde, 0xf8, 0x53, 0x19, 0x0d, 0x04, 0xf3,
-	0x62, 0xcc, 0xc8, 0x9b, 0x5c, 0x86, 0xd3, 0x6b, 0x92, 0xc9, 0xa8, 0xb5, 0xa8, 0xa1, 0x8f, 0x87,
-	0xf2, 0x21, 0xe3, 0x0c, 0x67, 0x44, 0x73, 0x51, 0x43, 0x7e, 0x58, 0x57, 0x3b, 0x6a, 0xb7, 0xd5,
-	0xff, 0x61, 0x57, 0x3d, 0xcc, 0x3e, 0x2f, 0xb2, 0x6e, 0x7d, 0xb1, 0x6a, 0x2b, 0xa3, 0xb2, 0xa9,
-	0x1d, 0xa1, 0xe6, 0xf6, 0x8f, 0x40, 0x7f, 0xd7, 0xa9, 0x75, 0x5b, 0xfd, 0x9f, 0xd5, 0xcc, 0x81,
-	0x60, 0x67, 0x98, 0x91, 0xd2, 0xf9, 0x10, 0xc8, 0x11, 0xb4, 0x0b, 0xf4, 0x19, 0x08, 0xa5, 0x1e,
-	0x4f, 0x03, 0x92, 0x7a, 0x7e, 0x14, 0x80, 0x5e, 0x2b, 0xbc, 0x5e, 0xb5, 0x37, 0x26, 0x94, 0x0e,
-	0xf3, 0x8e, 0x1b, 0x05, 0x25, 0xfa, 0x09, 0xf6, 0xee, 0x40, 0x3b, 0x41, 0xc8, 0x9f, 0x09, 0x09,
-	0x83, 0x5e, 0x2f, 0xd0, 0x5f, 0xd5, 0xa8, 0x3b, 0x13, 0xb2, 0x2f, 0xc1, 0xa6, 0x5f, 0xce, 0xa0,
-	0xdd, 0xa9, 0xe8, 0x2b, 0xa6, 0x11, 0x06, 0x02, 0x1e, 0x9f, 0x78, 0x29, 0xa7, 0x14, 0x27, 0x09,
-	0xe8, 0xef, 0x0b, 0xd6, 0xae, 0x66, 0xff, 0xc9, 0xe2, 0x70, 0xf2, 0x7f, 0x8a, 0xa3, 0xf8, 0x38,
-	0x70, 0xad, 0x9c, 0x7f, 0x5a, 0xb5, 0x0d, 0x81, 0x19, 0xfd, 0x6b, 0xbd, 0x00, 0x5b, 0xa3, 0x2f,
-	0x78, 0xdb, 0x1a, 0x95, 0x77, 0xee, 0xe9, 0x62, 0x6d, 0xaa, 0xcb, 0xb5, 0xa9, 0x3e, 0xae, 0x4d,
-	0xf5, 0x7e, 0x63, 0x2a, 0xcb, 0x8d, 0xa9, 0x3c, 0x6c, 0x4c, 0xe5, 0xb2, 0x1f, 0x46, 0xd9, 0x74,
-	0xe6, 0xdb, 0x57, 0x9c, 0x39, 0xaf, 0xac, 0xc6, 0xcd, 0xc0, 0x99, 0x97, 0xfb, 0x91, 0x89, 0x84,
-	0x80, 0xdf, 0x28, 0xf6, 0x63, 0xf0, 0x1c, 0x00, 0x00, 0xff, 0xff, 0x1c, 0x41, 0xcc, 0x1f, 0x04,
-	0x03, 0x00, 0x00,
+	// 427 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x37, 0x76, 0x5d, 0xec, 0x54, 0x11, 0xa7, 0x1e, 0xc2, 0x22, 0x69, 0x09, 0x2a, 0xb5,
+	0x42, 0x02, 0x5b, 0xf0, 0xe0, 0xcd, 0x28, 0xa8, 0x28, 0xae, 0x6c, 0x3d, 0x88, 0x97, 0x30, 0x31,
+	0xb3, 0xd9, 0xc1, 0xf9, 0x11, 0xe6, 0x65, 0xb5, 0xe3, 0xd1, 0xab, 0x17, 0xff, 0xac, 0x1e, 0x7b,
+	0x12, 0x4f, 0x45, 0x76, 0xff, 0x03, 0xff, 0x02, 0xc9, 0xcc, 0x6c, 0x29, 0xa5, 0x0d, 0x7b, 0x9b,
+	0x37, 0x7c, 0x3f, 0x9f, 0x79, 0x8f, 0x79, 0x68, 0xbf, 0x34, 0x82, 0x4a, 0x60, 0x4a, 0x1e, 0x99,
+	0xef, 0xe9, 0x59, 0xd1, 0x9e, 0x24, 0xa4, 0x15, 0x95, 0x14, 0x18, 0x24, 0xb5, 0x56, 0x8d, 0xc2,
+	0xf7, 0xce, 0x67, 0x93, 0xb3, 0x22, 0xb1, 0xd9, 0xe1, 0xdd, 0x4a, 0x55, 0xca, 0x06, 0xd3, 0xf6,
+	0xe4, 0x98, 0xe1, 0xa3, 0x4e, 0x7f, 0x4d, 0x34, 0x11, 0x5e, 0x3f, 0x7c, 0xdc, 0x19, 0x2d, 0x8d,
+	0xc8, 0x25, 0x11, 0x74, 0x2d, 0xaf, 0x20, 0xfa, 0x0b, 0x6d, 0x5c, 0x34, 0xfe, 0xdd, 0x47, 0x37,
+	0x5f, 0xba, 0x41, 0x0e, 0x1b, 0xd2, 0x50, 0x9c, 0xa1, 0x81, 0x7b, 0x38, 0x0c, 0x76, 0x83, 0xbd,
+	0xad, 0xd1, 0xfd, 0xa4, 0x6b, 0xb0, 0xe4, 0xbd, 0xcd, 0x66, 0xfd, 0xe3, 0xd3, 0x9d, 0xde, 0xc4,
+	0x93, 0xf8, 0x15, 0xda, 0x5c, 0x75, 0x04, 0xe1, 0xb5, 0xdd, 0x8d, 0xbd, 0xad, 0xd1, 0x83, 0x6e,
+	0xcd, 0x0b, 0x23, 0xde, 0x11, 0x41, 0xbd, 0xe7, 0x46, 0xe9, 0x4a, 0xc0, 0x1f, 0xd1, 0x6d, 0xa0,
+	0x9c, 0xe7, 0x4a, 0x97, 0x54, 0xe7, 0x05, 0x2b, 0x21, 0xdc, 0xb0, 0xbe, 0xfd, 0x6e, 0xdf, 0x21,
+	0xe5, 0x7c, 0xdc, 0x32, 0x19, 0x2b, 0xbd, 0xf4, 0x16, 0x9c, 0xbb, 0x03, 0xfc, 0x06, 0xa1, 0x62,
+	0x6e, 0x9c, 0x18, 0xc2, 0xbe, 0x95, 0x3e, 0xec, 0x96, 0x66, 0x73, 0xe3, 0x78, 0x27, 0xdc, 0x2c,
+	0x7c, 0x0d, 0xf8, 0x47, 0x80, 0xb6, 0x09, 0x67, 0x04, 0x28, 0xe4, 0x6a, 0x9a, 0x6b, 0xc5, 0x39,
+	0xa9, 0x6b, 0x08, 0xaf, 0x5b, 0x6d, 0xd2, 0xad, 0x7d, 0xe6, 0xc0, 0xf1, 0xf4, 0xf9, 0x8c, 0x30,
+	0xf9, 0xba, 0xcc, 0xe2, 0x56, 0xff, 0xef, 0x74, 0x67, 0x68, 0x88, 0xe0, 0x4f, 0xe3, 0x4b, 0xc4,
+	0xf1, 0xe4, 0x0e, 0x59, 0x51, 0x13, 0x7f, 0x87, 0x7f, 0x06, 0x68, 0x5b, 0x7d, 0x93, 0x54, 0xc3,
+	0x8c, 0xd5, 0x79, 0xa3, 0x89, 0x84, 0x69, 0x3b, 0xdb, 0xc0, 0x36, 0xf1, 0x64, 0xad, 0x0f, 0x18,
+	0xaf, 0xf8, 0x0f, 0x1e, 0xbf, 0xd8, 0xcc, 0x25, 0x0f, 0xc4, 0x13, 0xac, 0x2e, 0x62, 0x90, 0xbd,
+	0x3d, 0x5e, 0x44, 0xc1, 0xc9, 0x22, 0x0a, 0xfe, 0x2e, 0xa2, 0xe0, 0xd7, 0x32, 0xea, 0x9d, 0x2c,
+	0xa3, 0xde, 0x9f, 0x65, 0xd4, 0xfb, 0x34, 0xaa, 0x58, 0x33, 0x9b, 0x17, 0xc9, 0x67, 0x25, 0xd2,
+	0x2b, 0x16, 0xf5, 0xeb, 0x41, 0x7a, 0xe4, 0xb7, 0xb5, 0x31, 0x35, 0x85, 0x62, 0x60, 0xb7, 0xf5,
+	0xe0, 0xff, 0x00, 0xc7, 0xba, 0x14, 0x71, 0x92, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -164,6 +175,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OwnershipTransfers) > 0 {
+		for iNdEx := len(m.OwnershipTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OwnershipTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.AliasesOfRollapps) > 0 {
 		for iNdEx := len(m.AliasesOfRollapps) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -276,6 +301,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.OwnershipTransfers) > 0 {
+		for _, e := range m.OwnershipTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -483,6 +514,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnershipTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnershipTransfers = append(m.OwnershipTransfers, DymNameOwnershipTransfer{})
+			if err := m.OwnershipTransfers[len(m.OwnershipTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
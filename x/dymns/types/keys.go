@@ -32,6 +32,8 @@ const (
 	prefixRvlAssetIdToBuyOrderIds // reverse lookup store
 	prefixRollAppIdToAliases
 	prefixRvlAliasToRollAppId // reverse lookup store
+	prefixDymNameOwnershipTransfer
+	prefixDymNameOwnershipTransferExpiry
 )
 
 const (
@@ -84,6 +86,12 @@ var (
 
 	// KeyPrefixRvlAliasToRollAppId is the key prefix for the reverse lookup for Alias to Roll-App ID records
 	KeyPrefixRvlAliasToRollAppId = []byte{prefixRvlAliasToRollAppId}
+
+	// KeyPrefixDymNameOwnershipTransfer is the key prefix for the pending ownership transfer records of Dym-Names
+	KeyPrefixDymNameOwnershipTransfer = []byte{prefixDymNameOwnershipTransfer}
+
+	// KeyPrefixDymNameOwnershipTransferExpiry is the key prefix for the pending ownership transfers of Dym-Names, ordered by expiry
+	KeyPrefixDymNameOwnershipTransferExpiry = []byte{prefixDymNameOwnershipTransferExpiry}
 )
 
 // KeyCountBuyOrders is the key for the count of all-time buy orders
@@ -150,3 +158,18 @@ func RollAppIdToAliasesKey(rollAppId string) []byte {
 func AliasToRollAppIdRvlKey(alias string) []byte {
 	return append(KeyPrefixRvlAliasToRollAppId, []byte(alias)...)
 }
+
+// DymNameOwnershipTransferKey returns a key for the pending ownership transfer of the Dym-Name
+func DymNameOwnershipTransferKey(name string) []byte {
+	return append(KeyPrefixDymNameOwnershipTransfer, []byte(name)...)
+}
+
+// DymNameOwnershipTransferExpiryPrefix returns the key prefix of the pending ownership transfers expiring at the given epoch
+func DymNameOwnershipTransferExpiryPrefix(expireAt int64) []byte {
+	return append(KeyPrefixDymNameOwnershipTransferExpiry, sdk.Uint64ToBigEndian(uint64(expireAt))...) // nolint:gosec
+}
+
+// DymNameOwnershipTransferExpiryKey returns a key for the expiry index of the pending ownership transfer of the Dym-Name
+func DymNameOwnershipTransferExpiryKey(expireAt int64, name string) []byte {
+	return append(DymNameOwnershipTransferExpiryPrefix(expireAt), []byte(name)...)
+}
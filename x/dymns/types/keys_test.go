@@ -22,6 +22,8 @@ func TestStorePrefixes(t *testing.T) {
 		require.Equal(t, []byte{0x0A, partialStoreAssetTypeAlias}, KeyPrefixRvlAliasToBuyOrderIds, "do not change it, will break the app")
 		require.Equal(t, []byte{0x0B}, KeyPrefixRollAppIdToAliases, "do not change it, will break the app")
 		require.Equal(t, []byte{0x0C}, KeyPrefixRvlAliasToRollAppId, "do not change it, will break the app")
+		require.Equal(t, []byte{0x0D}, KeyPrefixDymNameOwnershipTransfer, "do not change it, will break the app")
+		require.Equal(t, []byte{0x0E}, KeyPrefixDymNameOwnershipTransferExpiry, "do not change it, will break the app")
 	})
 
 	t.Run("ensure keys are not mistakenly modified", func(t *testing.T) {
@@ -41,6 +43,8 @@ func TestKeys(t *testing.T) {
 			require.Equal(t, append(KeyPrefixDymName, []byte(dymName)...), DymNameKey(dymName))
 			require.Equal(t, append(KeyPrefixDymNameSellOrder, []byte(dymName)...), SellOrderKey(dymName, TypeName))
 			require.Equal(t, append(KeyPrefixRvlDymNameToBuyOrderIds, []byte(dymName)...), DymNameToBuyOrderIdsRvlKey(dymName))
+			require.Equal(t, append(KeyPrefixDymNameOwnershipTransfer, []byte(dymName)...), DymNameOwnershipTransferKey(dymName))
+			require.Equal(t, append(append(KeyPrefixDymNameOwnershipTransferExpiry, sdk.Uint64ToBigEndian(1)...), []byte(dymName)...), DymNameOwnershipTransferExpiryKey(1, dymName))
 		})
 	}
 
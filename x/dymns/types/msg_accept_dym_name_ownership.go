@@ -0,0 +1,24 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	dymnsutils "github.com/dymensionxyz/dymension/v3/x/dymns/utils"
+)
+
+var _ sdk.Msg = &MsgAcceptDymNameOwnership{}
+
+// ValidateBasic performs basic validation for the MsgAcceptDymNameOwnership.
+func (m *MsgAcceptDymNameOwnership) ValidateBasic() error {
+	if !dymnsutils.IsValidDymName(m.Name) {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "name is not a valid dym name")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.NewOwner); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "new owner is not a valid bech32 account address")
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgAcceptDymNameOwnership_ValidateBasic(t *testing.T) {
+	//goland:noinspection SpellCheckingInspection
+	tests := []struct {
+		name            string
+		dymName         string
+		newOwner        string
+		wantErr         bool
+		wantErrContains string
+	}{
+		{
+			name:     "pass - valid",
+			dymName:  "a",
+			newOwner: "dym1fl48vsnmsdzcv85q5d2q4z5ajdha8yu38x9fue",
+		},
+		{
+			name:            "fail - missing name",
+			dymName:         "",
+			newOwner:        "dym1fl48vsnmsdzcv85q5d2q4z5ajdha8yu38x9fue",
+			wantErr:         true,
+			wantErrContains: "name is not a valid dym name",
+		},
+		{
+			name:            "fail - invalid name",
+			dymName:         "-a",
+			newOwner:        "dym1fl48vsnmsdzcv85q5d2q4z5ajdha8yu38x9fue",
+			wantErr:         true,
+			wantErrContains: "name is not a valid dym name",
+		},
+		{
+			name:            "fail - missing new owner",
+			dymName:         "a",
+			newOwner:        "",
+			wantErr:         true,
+			wantErrContains: "new owner is not a valid bech32 account address",
+		},
+		{
+			name:            "fail - invalid new owner",
+			dymName:         "a",
+			newOwner:        "dym1fl48vsnmsdzcv85q5d2",
+			wantErr:         true,
+			wantErrContains: "new owner is not a valid bech32 account address",
+		},
+		{
+			name:            "fail - new owner must be dym1",
+			dymName:         "a",
+			newOwner:        "nim1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3pklgjx",
+			wantErr:         true,
+			wantErrContains: "new owner is not a valid bech32 account address",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &MsgAcceptDymNameOwnership{
+				Name:     tt.dymName,
+				NewOwner: tt.newOwner,
+			}
+
+			err := m.ValidateBasic()
+			if tt.wantErr {
+				require.NotEmpty(t, tt.wantErrContains, "mis-configured test case")
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErrContains)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	dymnsutils "github.com/dymensionxyz/dymension/v3/x/dymns/utils"
+)
+
+var _ sdk.Msg = &MsgCancelDymNameOwnershipTransfer{}
+
+// ValidateBasic performs basic validation for the MsgCancelDymNameOwnershipTransfer.
+func (m *MsgCancelDymNameOwnershipTransfer) ValidateBasic() error {
+	if !dymnsutils.IsValidDymName(m.Name) {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "name is not a valid dym name")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "owner is not a valid bech32 account address")
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCancelDymNameOwnershipTransfer_ValidateBasic(t *testing.T) {
+	//goland:noinspection SpellCheckingInspection
+	tests := []struct {
+		name            string
+		dymName         string
+		owner           string
+		wantErr         bool
+		wantErrContains string
+	}{
+		{
+			name:    "pass - valid",
+			dymName: "a",
+			owner:   "dym1fl48vsnmsdzcv85q5d2q4z5ajdha8yu38x9fue",
+		},
+		{
+			name:            "fail - missing name",
+			dymName:         "",
+			owner:           "dym1fl48vsnmsdzcv85q5d2q4z5ajdha8yu38x9fue",
+			wantErr:         true,
+			wantErrContains: "name is not a valid dym name",
+		},
+		{
+			name:            "fail - invalid name",
+			dymName:         "-a",
+			owner:           "dym1fl48vsnmsdzcv85q5d2q4z5ajdha8yu38x9fue",
+			wantErr:         true,
+			wantErrContains: "name is not a valid dym name",
+		},
+		{
+			name:            "fail - missing owner",
+			dymName:         "a",
+			owner:           "",
+			wantErr:         true,
+			wantErrContains: "owner is not a valid bech32 account address",
+		},
+		{
+			name:            "fail - invalid owner",
+			dymName:         "a",
+			owner:           "dym1fl48vsnmsdzcv85q5d2",
+			wantErr:         true,
+			wantErrContains: "owner is not a valid bech32 account address",
+		},
+		{
+			name:            "fail - owner must be dym1",
+			dymName:         "a",
+			owner:           "nim1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3pklgjx",
+			wantErr:         true,
+			wantErrContains: "owner is not a valid bech32 account address",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &MsgCancelDymNameOwnershipTransfer{
+				Name:  tt.dymName,
+				Owner: tt.owner,
+			}
+
+			err := m.ValidateBasic()
+			if tt.wantErr {
+				require.NotEmpty(t, tt.wantErrContains, "mis-configured test case")
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErrContains)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
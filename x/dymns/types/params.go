@@ -132,11 +132,12 @@ func DefaultChainsParams() ChainsParams {
 // DefaultMiscParams returns a default set of misc parameters
 func DefaultMiscParams() MiscParams {
 	return MiscParams{
-		EndEpochHookIdentifier: defaultEndEpochHookIdentifier,
-		GracePeriodDuration:    30 * 24 * time.Hour,
-		SellOrderDuration:      3 * 24 * time.Hour,
-		EnableTradingName:      true,
-		EnableTradingAlias:     true,
+		EndEpochHookIdentifier:    defaultEndEpochHookIdentifier,
+		GracePeriodDuration:       30 * 24 * time.Hour,
+		SellOrderDuration:         3 * 24 * time.Hour,
+		EnableTradingName:         true,
+		EnableTradingAlias:        true,
+		OwnershipTransferDuration: 7 * 24 * time.Hour,
 	}
 }
 
@@ -409,5 +410,13 @@ func validateMiscParams(i interface{}) error {
 		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "Sell Orders duration cannot be more than: %s", maxSellOrderDuration)
 	}
 
+	const maxOwnershipTransferDuration = 30 * // number of days
+		24 * time.Hour // hours per day
+	if m.OwnershipTransferDuration <= 0 {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "ownership transfer duration can not be zero")
+	} else if m.OwnershipTransferDuration > maxOwnershipTransferDuration {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "ownership transfer duration cannot be more than: %s", maxOwnershipTransferDuration)
+	}
+
 	return nil
 }
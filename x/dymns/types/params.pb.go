@@ -294,6 +294,9 @@ type MiscParams struct {
 	// enable_trading_alias is the flag to enable trading of Alias.
 	// To be used to stop trading of Alias when needed.
 	EnableTradingAlias bool `protobuf:"varint,5,opt,name=enable_trading_alias,json=enableTradingAlias,proto3" json:"enable_trading_alias,omitempty"`
+	// ownership_transfer_duration is the amount of time the new owner has to
+	// accept a pending ownership transfer of a Dym-Name.
+	OwnershipTransferDuration time.Duration `protobuf:"bytes,6,opt,name=ownership_transfer_duration,json=ownershipTransferDuration,proto3,stdduration" json:"ownership_transfer_duration" yaml:"ownership_transfer_duration"`
 }
 
 func (m *MiscParams) Reset()         { *m = MiscParams{} }
@@ -364,6 +367,13 @@ func (m *MiscParams) GetEnableTradingAlias() bool {
 	return false
 }
 
+func (m *MiscParams) GetOwnershipTransferDuration() time.Duration {
+	if m != nil {
+		return m.OwnershipTransferDuration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.dymns.Params")
 	proto.RegisterType((*PriceParams)(nil), "dymensionxyz.dymension.dymns.PriceParams")
@@ -377,60 +387,62 @@ func init() {
 }
 
 var fileDescriptor_6097ac65688a2490 = []byte{
-	// 846 bytes of a gzipped FileDescriptorProto
+	// 880 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0x4f, 0x6f, 0x23, 0x35,
-	0x14, 0xef, 0xb4, 0xdd, 0x6e, 0xeb, 0xb4, 0xdb, 0xad, 0x93, 0x96, 0xb4, 0xac, 0x32, 0xd5, 0x80,
-	0x50, 0x16, 0xc1, 0x0c, 0xdb, 0x3d, 0x20, 0x71, 0x63, 0x76, 0x0b, 0x44, 0x02, 0x5a, 0x06, 0x38,
-	0xc0, 0xc5, 0x72, 0xc6, 0x4e, 0x62, 0x35, 0x63, 0x0f, 0xe3, 0xe9, 0xd2, 0x72, 0xe6, 0x8a, 0xc4,
-	0x05, 0x89, 0x0f, 0xc2, 0x8d, 0x2f, 0xb0, 0xc7, 0x15, 0x27, 0xc4, 0x21, 0xa0, 0xf6, 0xc0, 0x3d,
-	0x9f, 0x00, 0xf9, 0xd9, 0xd3, 0x4e, 0x43, 0xff, 0xdc, 0xf2, 0xf2, 0xfb, 0x67, 0xfb, 0x3d, 0x7b,
-	0xd0, 0x63, 0x76, 0x9a, 0x71, 0xa9, 0x85, 0x92, 0x27, 0xa7, 0x3f, 0x44, 0x17, 0x85, 0xf9, 0x25,
-	0x75, 0x94, 0xd3, 0x82, 0x66, 0x3a, 0xcc, 0x0b, 0x55, 0x2a, 0xfc, 0xa8, 0x4e, 0x0d, 0x2f, 0x8a,
-	0x10, 0xa8, 0x3b, 0xad, 0xa1, 0x1a, 0x2a, 0x20, 0x46, 0xe6, 0x97, 0xd5, 0xec, 0x6c, 0xa7, 0x4a,
-	0x67, 0x4a, 0x13, 0x0b, 0xd8, 0xc2, 0x41, 0x1d, 0x5b, 0x45, 0x7d, 0xaa, 0x79, 0xf4, 0xe2, 0x49,
-	0x9f, 0x97, 0xf4, 0x49, 0x94, 0x2a, 0x21, 0x2b, 0x7c, 0xa8, 0xd4, 0x70, 0xcc, 0x23, 0xa8, 0xfa,
-	0xc7, 0x83, 0x88, 0x1d, 0x17, 0xb4, 0x34, 0x81, 0xf0, 0x4f, 0xf0, 0xd3, 0x3c, 0x5a, 0x3a, 0x84,
-	0xf5, 0xe1, 0xaf, 0xd1, 0xbd, 0xbc, 0x10, 0x29, 0x6f, 0x7b, 0xbb, 0x5e, 0xb7, 0xb1, 0xf7, 0x38,
-	0xbc, 0x6d, 0xa5, 0xe1, 0xa1, 0xa1, 0x5a, 0x65, 0xdc, 0x7a, 0x39, 0xf1, 0xe7, 0xa6, 0x13, 0x7f,
-	0xf5, 0x94, 0x66, 0xe3, 0x0f, 0x02, 0x70, 0x09, 0x12, 0xeb, 0x86, 0xbf, 0x41, 0x4b, 0xe9, 0x88,
-	0x0a, 0xa9, 0xdb, 0xf3, 0xe0, 0xfb, 0xf6, 0xed, 0xbe, 0xcf, 0x80, 0xeb, 0x8c, 0x37, 0x9d, 0xf1,
-	0x9a, 0x35, 0xb6, 0x3e, 0x41, 0xe2, 0x0c, 0xf1, 0x17, 0x68, 0x31, 0x13, 0x3a, 0x6d, 0x2f, 0x80,
-	0x71, 0xf7, 0x76, 0xe3, 0xcf, 0x84, 0x4e, 0x9d, 0x6d, 0xd3, 0xd9, 0x36, 0xac, 0xad, 0xf1, 0x08,
-	0x12, 0xb0, 0x0a, 0xfe, 0x5d, 0x44, 0x8d, 0xda, 0xd6, 0x70, 0x8e, 0x1e, 0x4a, 0x9a, 0x71, 0x02,
-	0x7b, 0x21, 0xba, 0xe4, 0xb9, 0x6e, 0x7b, 0xbb, 0x0b, 0xdd, 0x95, 0xf8, 0x23, 0x63, 0xf2, 0xd7,
-	0xc4, 0xdf, 0xb4, 0x1d, 0xd0, 0xec, 0x28, 0x14, 0x2a, 0xca, 0x68, 0x39, 0x0a, 0x7b, 0xb2, 0x9c,
-	0x4e, 0xfc, 0xd7, 0xac, 0xfb, 0xac, 0x3c, 0xf8, 0xe3, 0xb7, 0x77, 0x91, 0xeb, 0x61, 0x4f, 0x96,
-	0xc9, 0x03, 0x43, 0x80, 0xc8, 0x2f, 0x0d, 0x8c, 0x35, 0xda, 0xa0, 0x63, 0x41, 0xf5, 0x95, 0xc8,
-	0x79, 0x88, 0xfc, 0xf8, 0xae, 0xc8, 0xb6, 0x8d, 0xfc, 0x9f, 0x7e, 0x36, 0x73, 0x1d, 0x18, 0xb5,
-	0xd0, 0x11, 0x5a, 0xb3, 0x74, 0x7e, 0x52, 0x72, 0xc9, 0x34, 0x1c, 0xe9, 0x4a, 0xfc, 0xec, 0xae,
-	0xc0, 0x56, 0xad, 0xe3, 0x95, 0x76, 0x36, 0x6c, 0x15, 0xd0, 0x7d, 0x0b, 0xe2, 0xf7, 0x51, 0xc3,
-	0xb2, 0x19, 0x97, 0x2a, 0x6b, 0x2f, 0x42, 0xce, 0xd6, 0x74, 0xe2, 0xe3, 0xba, 0x15, 0x80, 0x41,
-	0x82, 0xa0, 0x7a, 0x6e, 0x0a, 0x9c, 0xa1, 0xf5, 0x4c, 0x48, 0xa2, 0x06, 0x03, 0x5e, 0xd8, 0xbd,
-	0xb5, 0xef, 0x81, 0x78, 0xff, 0xae, 0x45, 0x6e, 0x55, 0x6d, 0xbe, 0xa2, 0x9e, 0x5d, 0xe6, 0x5a,
-	0x26, 0xe4, 0x81, 0x81, 0xe1, 0x58, 0x30, 0x41, 0xdb, 0x46, 0xd0, 0x17, 0x8c, 0x08, 0x99, 0x16,
-	0x3c, 0xe3, 0xb2, 0x24, 0x39, 0x2f, 0x52, 0x2e, 0xcb, 0xf6, 0xd2, 0xae, 0xd7, 0x5d, 0x8b, 0xdf,
-	0x9c, 0x4e, 0xfc, 0xdd, 0x4b, 0xef, 0x6b, 0xa9, 0x41, 0xb2, 0x95, 0x09, 0x19, 0x0b, 0xd6, 0xab,
-	0x90, 0x43, 0x07, 0xfc, 0xe2, 0xa1, 0xd5, 0xfa, 0xb0, 0xe3, 0x1f, 0x3d, 0xd4, 0x82, 0xbe, 0x70,
-	0x4d, 0xd4, 0x80, 0xc0, 0x8c, 0x13, 0xc1, 0xec, 0xbc, 0x35, 0xf6, 0xc2, 0xdb, 0xc7, 0xfb, 0x43,
-	0xab, 0x3c, 0x18, 0x80, 0x67, 0x8f, 0xc5, 0x6f, 0xb8, 0x21, 0x7f, 0xbd, 0x36, 0x13, 0x33, 0xce,
-	0x41, 0xb2, 0x41, 0x67, 0x64, 0x3a, 0xc8, 0xd1, 0xc3, 0x59, 0x2f, 0x1c, 0xa2, 0xe5, 0x4a, 0x04,
-	0xaf, 0xc3, 0x4a, 0xdc, 0x9c, 0x4e, 0xfc, 0xf5, 0xda, 0xad, 0x24, 0x82, 0x05, 0xc9, 0xfd, 0xd4,
-	0xf1, 0xdf, 0x41, 0xf7, 0x9d, 0xb1, 0x9b, 0x5c, 0x3c, 0x9d, 0xf8, 0x0f, 0xae, 0x2c, 0x24, 0x48,
-	0x2a, 0x4a, 0xf0, 0xfb, 0x02, 0x42, 0x97, 0xb7, 0xd3, 0x9c, 0x3c, 0x97, 0x8c, 0xf0, 0x5c, 0xa5,
-	0x23, 0x32, 0x52, 0xea, 0x88, 0x08, 0xc6, 0x65, 0x29, 0x06, 0x82, 0x17, 0x2e, 0xbd, 0x76, 0xf2,
-	0x37, 0x52, 0x83, 0x64, 0x8b, 0x4b, 0xb6, 0x6f, 0xa0, 0x4f, 0x94, 0x3a, 0xea, 0x5d, 0x00, 0xf8,
-	0x7b, 0xb4, 0x39, 0x2c, 0x68, 0xca, 0x4d, 0x8f, 0x84, 0x62, 0xa4, 0x7a, 0x12, 0xdd, 0x03, 0xb5,
-	0x1d, 0xda, 0x37, 0x33, 0xac, 0xde, 0xcc, 0xf0, 0xb9, 0x23, 0xc4, 0x5d, 0x77, 0xa6, 0x8f, 0x6c,
-	0xf6, 0xb5, 0x2e, 0xc1, 0xaf, 0x7f, 0xfb, 0x5e, 0xd2, 0x04, 0xec, 0x10, 0xa0, 0x4a, 0x8e, 0xbf,
-	0x43, 0x4d, 0xcd, 0xc7, 0x63, 0xa2, 0x0a, 0xc6, 0x8b, 0xcb, 0xd8, 0x85, 0xbb, 0x62, 0xdf, 0x72,
-	0xb1, 0x3b, 0x36, 0xf6, 0x1a, 0x0f, 0x1b, 0xba, 0x61, 0x90, 0x03, 0x03, 0x5c, 0x44, 0x86, 0xa8,
-	0xc9, 0x25, 0xed, 0x8f, 0x39, 0x29, 0x0b, 0xca, 0x84, 0x1c, 0x12, 0xf3, 0xdc, 0xc0, 0xb5, 0x5b,
-	0x4e, 0x36, 0x2c, 0xf4, 0x95, 0x45, 0x3e, 0xa7, 0x19, 0xc7, 0xef, 0xa1, 0xd6, 0x0c, 0x1f, 0xba,
-	0x04, 0x57, 0x6d, 0x39, 0xc1, 0x57, 0x04, 0x30, 0x26, 0xf1, 0xa7, 0x2f, 0xcf, 0x3a, 0xde, 0xab,
-	0xb3, 0x8e, 0xf7, 0xcf, 0x59, 0xc7, 0xfb, 0xf9, 0xbc, 0x33, 0xf7, 0xea, 0xbc, 0x33, 0xf7, 0xe7,
-	0x79, 0x67, 0xee, 0xdb, 0xbd, 0xa1, 0x28, 0x47, 0xc7, 0xfd, 0x30, 0x55, 0x59, 0x74, 0xc3, 0x07,
-	0xf2, 0xc5, 0xd3, 0xe8, 0xc4, 0x7d, 0x25, 0xcb, 0xd3, 0x9c, 0xeb, 0xfe, 0x12, 0xec, 0xfe, 0xe9,
-	0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xed, 0xe2, 0x98, 0x9f, 0x52, 0x07, 0x00, 0x00,
+	0x14, 0xef, 0xb4, 0xdd, 0x6c, 0xeb, 0xb4, 0xdb, 0xad, 0x93, 0x96, 0xb4, 0xbb, 0xca, 0x54, 0x06,
+	0xa1, 0x2c, 0x82, 0x19, 0xb6, 0x7b, 0x40, 0xe2, 0x46, 0x76, 0x0b, 0x44, 0x02, 0x5a, 0x86, 0xe5,
+	0x00, 0x17, 0x6b, 0x32, 0xe3, 0x24, 0x56, 0x33, 0xf6, 0x30, 0x9e, 0xee, 0xb6, 0x9c, 0xb9, 0x70,
+	0x40, 0xe2, 0x82, 0xc4, 0x07, 0xe1, 0x3b, 0xb0, 0xc7, 0x15, 0x27, 0xc4, 0x21, 0xa0, 0xf6, 0xc0,
+	0x3d, 0x9f, 0x00, 0xf9, 0xd9, 0x93, 0x4e, 0x42, 0x9b, 0xdc, 0xe2, 0xfc, 0xfe, 0xf9, 0xf9, 0x3d,
+	0x7b, 0xd0, 0xa3, 0xf8, 0x22, 0x61, 0x42, 0x71, 0x29, 0xce, 0x2f, 0xbe, 0xf7, 0x27, 0x0b, 0xfd,
+	0x4b, 0x28, 0x3f, 0x0d, 0xb3, 0x30, 0x51, 0x5e, 0x9a, 0xc9, 0x5c, 0xe2, 0x87, 0x65, 0xaa, 0x37,
+	0x59, 0x78, 0x40, 0xdd, 0xaf, 0xf7, 0x65, 0x5f, 0x02, 0xd1, 0xd7, 0xbf, 0x8c, 0x66, 0x7f, 0x2f,
+	0x92, 0x2a, 0x91, 0x8a, 0x1a, 0xc0, 0x2c, 0x2c, 0xd4, 0x34, 0x2b, 0xbf, 0x1b, 0x2a, 0xe6, 0xbf,
+	0x78, 0xdc, 0x65, 0x79, 0xf8, 0xd8, 0x8f, 0x24, 0x17, 0x05, 0xde, 0x97, 0xb2, 0x3f, 0x64, 0x3e,
+	0xac, 0xba, 0x67, 0x3d, 0x3f, 0x3e, 0xcb, 0xc2, 0x5c, 0x07, 0xc2, 0x3f, 0xe4, 0xa7, 0x65, 0x54,
+	0x39, 0x81, 0xfd, 0xe1, 0xaf, 0xd1, 0x9d, 0x34, 0xe3, 0x11, 0x6b, 0x38, 0x07, 0x4e, 0xab, 0x7a,
+	0xf8, 0xc8, 0x9b, 0xb7, 0x53, 0xef, 0x44, 0x53, 0x8d, 0xb2, 0x5d, 0x7f, 0x35, 0x72, 0x97, 0xc6,
+	0x23, 0x77, 0xe3, 0x22, 0x4c, 0x86, 0x1f, 0x12, 0x70, 0x21, 0x81, 0x71, 0xc3, 0xdf, 0xa0, 0x4a,
+	0x34, 0x08, 0xb9, 0x50, 0x8d, 0x65, 0xf0, 0x7d, 0x67, 0xbe, 0xef, 0x53, 0xe0, 0x5a, 0xe3, 0x1d,
+	0x6b, 0xbc, 0x69, 0x8c, 0x8d, 0x0f, 0x09, 0xac, 0x21, 0xfe, 0x12, 0xad, 0x26, 0x5c, 0x45, 0x8d,
+	0x15, 0x30, 0x6e, 0xcd, 0x37, 0xfe, 0x9c, 0xab, 0xc8, 0xda, 0xd6, 0xac, 0x6d, 0xd5, 0xd8, 0x6a,
+	0x0f, 0x12, 0x80, 0x15, 0xf9, 0x77, 0x15, 0x55, 0x4b, 0xa5, 0xe1, 0x14, 0xdd, 0x17, 0x61, 0xc2,
+	0x28, 0xd4, 0x42, 0x55, 0xce, 0x52, 0xd5, 0x70, 0x0e, 0x56, 0x5a, 0xeb, 0xed, 0x8f, 0xb5, 0xc9,
+	0x5f, 0x23, 0x77, 0xc7, 0x74, 0x40, 0xc5, 0xa7, 0x1e, 0x97, 0x7e, 0x12, 0xe6, 0x03, 0xaf, 0x23,
+	0xf2, 0xf1, 0xc8, 0x7d, 0xc3, 0xb8, 0xcf, 0xca, 0xc9, 0x1f, 0xbf, 0xbd, 0x87, 0x6c, 0x0f, 0x3b,
+	0x22, 0x0f, 0xee, 0x69, 0x02, 0x44, 0x7e, 0xa5, 0x61, 0xac, 0xd0, 0x76, 0x38, 0xe4, 0xa1, 0x9a,
+	0x8a, 0x5c, 0x86, 0xc8, 0x4f, 0x16, 0x45, 0x36, 0x4c, 0xe4, 0xff, 0xf4, 0xb3, 0x99, 0x5b, 0xc0,
+	0x28, 0x85, 0x0e, 0xd0, 0xa6, 0xa1, 0xb3, 0xf3, 0x9c, 0x89, 0x58, 0xc1, 0x91, 0xae, 0xb7, 0x9f,
+	0x2e, 0x0a, 0xac, 0x97, 0x3a, 0x5e, 0x68, 0x67, 0xc3, 0x36, 0x00, 0x3d, 0x32, 0x20, 0xfe, 0x00,
+	0x55, 0x0d, 0x3b, 0x66, 0x42, 0x26, 0x8d, 0x55, 0xc8, 0xd9, 0x1d, 0x8f, 0x5c, 0x5c, 0xb6, 0x02,
+	0x90, 0x04, 0x08, 0x56, 0xcf, 0xf4, 0x02, 0x27, 0x68, 0x2b, 0xe1, 0x82, 0xca, 0x5e, 0x8f, 0x65,
+	0xa6, 0xb6, 0xc6, 0x1d, 0x10, 0x1f, 0x2d, 0xda, 0xe4, 0x6e, 0xd1, 0xe6, 0x29, 0xf5, 0xec, 0x36,
+	0x37, 0x13, 0x2e, 0x8e, 0x35, 0x0c, 0xc7, 0x82, 0x29, 0xda, 0xd3, 0x82, 0x2e, 0x8f, 0x29, 0x17,
+	0x51, 0xc6, 0x12, 0x26, 0x72, 0x9a, 0xb2, 0x2c, 0x62, 0x22, 0x6f, 0x54, 0x0e, 0x9c, 0xd6, 0x66,
+	0xfb, 0xad, 0xf1, 0xc8, 0x3d, 0xb8, 0xf6, 0xbe, 0x91, 0x4a, 0x82, 0xdd, 0x84, 0x8b, 0x36, 0x8f,
+	0x3b, 0x05, 0x72, 0x62, 0x81, 0x5f, 0x1c, 0xb4, 0x51, 0x1e, 0x76, 0xfc, 0x83, 0x83, 0xea, 0xd0,
+	0x17, 0xa6, 0xa8, 0xec, 0x51, 0x98, 0x71, 0xca, 0x63, 0x33, 0x6f, 0xd5, 0x43, 0x6f, 0xfe, 0x78,
+	0x7f, 0x64, 0x94, 0xc7, 0x3d, 0xf0, 0xec, 0xc4, 0xed, 0x37, 0xed, 0x90, 0x3f, 0x28, 0xcd, 0xc4,
+	0x8c, 0x33, 0x09, 0xb6, 0xc3, 0x19, 0x99, 0x22, 0x29, 0xba, 0x3f, 0xeb, 0x85, 0x3d, 0xb4, 0x56,
+	0x88, 0xe0, 0x75, 0x58, 0x6f, 0xd7, 0xc6, 0x23, 0x77, 0xab, 0x74, 0x2b, 0x29, 0x8f, 0x49, 0x70,
+	0x37, 0xb2, 0xfc, 0x77, 0xd1, 0x5d, 0x6b, 0x6c, 0x27, 0x17, 0x8f, 0x47, 0xee, 0xbd, 0xa9, 0x8d,
+	0x90, 0xa0, 0xa0, 0x90, 0xdf, 0x57, 0x11, 0xba, 0xbe, 0x9d, 0xfa, 0xe4, 0x99, 0x88, 0x29, 0x4b,
+	0x65, 0x34, 0xa0, 0x03, 0x29, 0x4f, 0x29, 0x8f, 0x99, 0xc8, 0x79, 0x8f, 0xb3, 0xcc, 0xa6, 0x97,
+	0x4e, 0xfe, 0x56, 0x2a, 0x09, 0x76, 0x99, 0x88, 0x8f, 0x34, 0xf4, 0xa9, 0x94, 0xa7, 0x9d, 0x09,
+	0x80, 0x5f, 0xa2, 0x9d, 0x7e, 0x16, 0x46, 0x4c, 0xf7, 0x88, 0xcb, 0x98, 0x16, 0x4f, 0xa2, 0x7d,
+	0xa0, 0xf6, 0x3c, 0xf3, 0x66, 0x7a, 0xc5, 0x9b, 0xe9, 0x3d, 0xb3, 0x84, 0x76, 0xcb, 0x9e, 0xe9,
+	0x43, 0x93, 0x7d, 0xa3, 0x0b, 0xf9, 0xf5, 0x6f, 0xd7, 0x09, 0x6a, 0x80, 0x9d, 0x00, 0x54, 0xc8,
+	0xf1, 0x77, 0xa8, 0xa6, 0xd8, 0x70, 0x48, 0x65, 0x16, 0xb3, 0xec, 0x3a, 0x76, 0x65, 0x51, 0xec,
+	0xdb, 0x36, 0x76, 0xdf, 0xc4, 0xde, 0xe0, 0x61, 0x42, 0xb7, 0x35, 0x72, 0xac, 0x81, 0x49, 0xa4,
+	0x87, 0x6a, 0x4c, 0x84, 0xdd, 0x21, 0xa3, 0x79, 0x16, 0xc6, 0x5c, 0xf4, 0xa9, 0x7e, 0x6e, 0xe0,
+	0xda, 0xad, 0x05, 0xdb, 0x06, 0x7a, 0x6e, 0x90, 0x2f, 0xc2, 0x84, 0xe1, 0xf7, 0x51, 0x7d, 0x86,
+	0x0f, 0x5d, 0x82, 0xab, 0xb6, 0x16, 0xe0, 0x29, 0x01, 0x8c, 0x09, 0xfe, 0xd1, 0x41, 0x0f, 0xe4,
+	0x4b, 0xc1, 0x32, 0x35, 0xe0, 0xa9, 0x56, 0x09, 0xd5, 0x2b, 0x57, 0x57, 0x59, 0x54, 0x9d, 0x67,
+	0xab, 0x23, 0xa6, 0xba, 0x39, 0x5e, 0xa6, 0xca, 0xbd, 0x09, 0xe3, 0xb9, 0x25, 0x4c, 0xac, 0x3e,
+	0x7b, 0x75, 0xd9, 0x74, 0x5e, 0x5f, 0x36, 0x9d, 0x7f, 0x2e, 0x9b, 0xce, 0xcf, 0x57, 0xcd, 0xa5,
+	0xd7, 0x57, 0xcd, 0xa5, 0x3f, 0xaf, 0x9a, 0x4b, 0xdf, 0x1e, 0xf6, 0x79, 0x3e, 0x38, 0xeb, 0x7a,
+	0x91, 0x4c, 0xfc, 0x5b, 0x3e, 0xd6, 0x2f, 0x9e, 0xf8, 0xe7, 0xf6, 0x8b, 0x9d, 0x5f, 0xa4, 0x4c,
+	0x75, 0x2b, 0xb0, 0xd7, 0x27, 0xff, 0x0d, 0x00, 0xb9, 0xf1, 0xa0, 0xde, 0xde, 0x07, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -665,6 +677,14 @@ func (m *MiscParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.OwnershipTransferDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.OwnershipTransferDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintParams(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x32
 	if m.EnableTradingAlias {
 		i--
 		if m.EnableTradingAlias {
@@ -685,21 +705,21 @@ func (m *MiscParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x20
 	}
-	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SellOrderDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SellOrderDuration):])
-	if err4 != nil {
-		return 0, err4
-	}
-	i -= n4
-	i = encodeVarintParams(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x1a
-	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.GracePeriodDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.GracePeriodDuration):])
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SellOrderDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SellOrderDuration):])
 	if err5 != nil {
 		return 0, err5
 	}
 	i -= n5
 	i = encodeVarintParams(dAtA, i, uint64(n5))
 	i--
+	dAtA[i] = 0x1a
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.GracePeriodDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.GracePeriodDuration):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintParams(dAtA, i, uint64(n6))
+	i--
 	dAtA[i] = 0x12
 	if len(m.EndEpochHookIdentifier) > 0 {
 		i -= len(m.EndEpochHookIdentifier)
@@ -823,6 +843,8 @@ func (m *MiscParams) Size() (n int) {
 	if m.EnableTradingAlias {
 		n += 2
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.OwnershipTransferDuration)
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -1587,6 +1609,39 @@ func (m *MiscParams) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableTradingAlias = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnershipTransferDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.OwnershipTransferDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -510,6 +510,24 @@ func TestMiscParams_Validate(t *testing.T) {
 			wantErr:         true,
 			wantErrContains: "Sell Orders duration can not be zero",
 		},
+		{
+			name: "fail - ownership transfer duration can not be zero",
+			modifier: func(p MiscParams) MiscParams {
+				p.OwnershipTransferDuration = 0
+				return p
+			},
+			wantErr:         true,
+			wantErrContains: "ownership transfer duration can not be zero",
+		},
+		{
+			name: "fail - ownership transfer duration can not be greater than 30 days",
+			modifier: func(p MiscParams) MiscParams {
+				p.OwnershipTransferDuration = 30*24*time.Hour + time.Second
+				return p
+			},
+			wantErr:         true,
+			wantErrContains: "ownership transfer duration cannot be more than",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -205,6 +205,100 @@ func (m *QueryDymNameResponse) GetDymName() *DymName {
 	return nil
 }
 
+// QueryDymNameOwnershipTransferRequest is the request type for the
+// Query/DymNameOwnershipTransfer RPC method.
+type QueryDymNameOwnershipTransferRequest struct {
+	// dym_name is the name of the Dym-Name to query.
+	DymName string `protobuf:"bytes,1,opt,name=dym_name,json=dymName,proto3" json:"dym_name,omitempty"`
+}
+
+func (m *QueryDymNameOwnershipTransferRequest) Reset()         { *m = QueryDymNameOwnershipTransferRequest{} }
+func (m *QueryDymNameOwnershipTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDymNameOwnershipTransferRequest) ProtoMessage()    {}
+func (*QueryDymNameOwnershipTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9fbab881fb7aa6c, []int{4}
+}
+func (m *QueryDymNameOwnershipTransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDymNameOwnershipTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDymNameOwnershipTransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDymNameOwnershipTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDymNameOwnershipTransferRequest.Merge(m, src)
+}
+func (m *QueryDymNameOwnershipTransferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDymNameOwnershipTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDymNameOwnershipTransferRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDymNameOwnershipTransferRequest proto.InternalMessageInfo
+
+func (m *QueryDymNameOwnershipTransferRequest) GetDymName() string {
+	if m != nil {
+		return m.DymName
+	}
+	return ""
+}
+
+// QueryDymNameOwnershipTransferResponse is the response type for the
+// Query/DymNameOwnershipTransfer RPC method.
+type QueryDymNameOwnershipTransferResponse struct {
+	// transfer is the pending ownership transfer of the Dym-Name, if any.
+	Transfer *DymNameOwnershipTransfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+}
+
+func (m *QueryDymNameOwnershipTransferResponse) Reset()         { *m = QueryDymNameOwnershipTransferResponse{} }
+func (m *QueryDymNameOwnershipTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDymNameOwnershipTransferResponse) ProtoMessage()    {}
+func (*QueryDymNameOwnershipTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9fbab881fb7aa6c, []int{5}
+}
+func (m *QueryDymNameOwnershipTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDymNameOwnershipTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDymNameOwnershipTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDymNameOwnershipTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDymNameOwnershipTransferResponse.Merge(m, src)
+}
+func (m *QueryDymNameOwnershipTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDymNameOwnershipTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDymNameOwnershipTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDymNameOwnershipTransferResponse proto.InternalMessageInfo
+
+func (m *QueryDymNameOwnershipTransferResponse) GetTransfer() *DymNameOwnershipTransfer {
+	if m != nil {
+		return m.Transfer
+	}
+	return nil
+}
+
 // QueryAliasRequest is the request type for the Query/QueryAlias RPC method.
 type QueryAliasRequest struct {
 	// alias to query
@@ -215,7 +309,7 @@ func (m *QueryAliasRequest) Reset()         { *m = QueryAliasRequest{} }
 func (m *QueryAliasRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAliasRequest) ProtoMessage()    {}
 func (*QueryAliasRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9fbab881fb7aa6c, []int{4}
+	return fileDescriptor_c9fbab881fb7aa6c, []int{6}
 }
 func (m *QueryAliasRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -268,7 +362,7 @@ func (m *QueryAliasResponse) Reset()         { *m = QueryAliasResponse{} }
 func (m *QueryAliasResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAliasResponse) ProtoMessage()    {}
 func (*QueryAliasResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9fbab881fb7aa6c, []int{5}
+	return fileDescriptor_c9fbab881fb7aa6c, []int{7}
 }
 func (m *QueryAliasResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -334,7 +428,7 @@ func (m *QueryAliasesRequest) Reset()         { *m = QueryAliasesRequest{} }
 func (m *QueryAliasesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAliasesRequest) ProtoMessage()    {}
 func (*QueryAliasesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9fbab881fb7aa6c, []int{6}
+	return fileDescriptor_c9fbab881fb7aa6c, []int{8}
 }
 func (m *QueryAliasesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -379,7 +473,7 @@ func (m *QueryAliasesResponse) Reset()         { *m = QueryAliasesResponse{} }
 func (m *QueryAliasesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAliasesResponse) ProtoMessage()    {}
 func (*QueryAliasesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9fbab881fb7aa6c, []int{7}
+	return fileDescriptor_c9fbab881fb7aa6c, []int{9}
 }
 func (m *QueryAliasesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -426,7 +520,7 @@ func (m *ResolveDymNameAddressesRequest) Reset()         { *m = ResolveDymNameAd
 func (m *ResolveDymNameAddressesRequest) String() string { return proto.CompactTextString(m) }
 func (*ResolveDymNameAddressesRequest) ProtoMessage()    {}
 func (*ResolveDymNameAddressesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9fbab881fb7aa6c, []int{8}
+	return fileDescriptor_c9fbab881fb7aa6c, []int{10}
 }
 func (m *ResolveDymNameAddressesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -477,7 +571,7 @@ func (m *ResultDymNameAddress) Reset()         { *m = ResultDymNameAddress{} }
 func (m *ResultDymNameAddress) String() string { return proto.CompactTextString(m) }
 func (*ResultDymNameAddress) ProtoMessage()    {}
 func (*ResultDymNameAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9fbab881fb7aa6c, []int{9}
+	return fileDescriptor_c9fbab881fb7aa6c, []int{11}
 }
 func (m *ResultDymNameAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -539,7 +633,7 @@ func (m *ResolveDymNameAddressesResponse) Reset()         { *m = ResolveDymNameA
 func (m *ResolveDymNameAddressesResponse) String() string { return proto.CompactTextString(m) }
 func (*ResolveDymNameAddressesResponse) ProtoMessage()    {}
 func (*ResolveDymNameAddressesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9fbab881fb7aa6c, []int{10}
+	return fileDescriptor_c9fbab881fb7aa6c, []int{12}
 }
 func (m *ResolveDymNameAddressesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -586,7 +680,7 @@ func (m *QueryDymNamesOwnedByAccountRequest) Reset()         { *m = QueryDymName
 func (m *QueryDymNamesOwnedByAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDymNamesOwnedByAccountRequest) ProtoMessage()    {}
 func (*QueryDymNamesOwnedByAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9fbab881fb7aa6c, []int{11}
+	return fileDescriptor_c9fbab881fb7aa6c, []int{13}
 }
 func (m *QueryDymNamesOwnedByAccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -633,7 +727,7 @@ func (m *QueryDymNamesOwnedByAccountResponse) Reset()         { *m = QueryDymNam
 func (m *QueryDymNamesOwnedByAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDymNamesOwnedByAccountResponse) ProtoMessage()    {}
 func (*QueryDymNamesOwnedByAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9fbab881fb7aa6c, []int{12}
+	return fileDescriptor_c9fbab881fb7aa6c, []int{14}
 }
 func (m *QueryDymNamesOwnedByAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -681,7 +775,7 @@ func (m *QuerySellOrderRequest) Reset()         { *m = QuerySellOrderRequest{} }
 func (m *QuerySellOrderRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySellOrderRequest) ProtoMessage()    {}
 func (*QuerySellOrderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9fbab881fb7aa6c, []int{13}
+	return fileDescriptor_c9fbab881fb7aa6c, []int{15}
 }
 func (m *QuerySellOrderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -735,7 +829,7 @@ func (m *QuerySellOrderResponse) Reset()         { *m = QuerySellOrderResponse{}
 func (m *QuerySellOrderResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySellOrderResponse) ProtoMessage()    {}
 func (*QuerySellOrderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9fbab881fb7aa6c, []int{14}
+	return fileDescriptor_c9fbab881fb7aa6c, []int{16}
 }
 func (m *QuerySellOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -786,7 +880,7 @@ func (m *EstimateRegisterNameRequest) Reset()         { *m = EstimateRegisterNam
 func (m *EstimateRegisterNameRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimateRegisterNameRequest) ProtoMessage()    {}
 func (*EstimateRegisterNameRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9fbab881fb7aa6c, []int{15}
+	return fileDescriptor_c9fbab881fb7aa6c, []int{17}
 }
 func (m *EstimateRegisterNameRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -853,7 +947,7 @@ func (m *EstimateRegisterNameResponse) Reset()         { *m = EstimateRegisterNa
 func (m *EstimateRegisterNameResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateRegisterNameResponse) ProtoMessage()    {}
 func (*EstimateRegisterNameResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9fbab881fb7aa6c, []int{16}
+	return fileDescriptor_c9fbab881fb7aa6c, []int{18}
 }
 func (m *EstimateRegisterNameResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -918,7 +1012,7 @@ func (m *EstimateRegisterAliasRequest) Reset()         { *m = EstimateRegisterAl
 func (m *EstimateRegisterAliasRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimateRegisterAliasRequest) ProtoMessage()    {}
 func (*EstimateRegisterAliasRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9fbab881fb7aa6c, []int{17}
+	return fileDescriptor_c9fbab881fb7aa6c, []int{19}
 }
 func (m *EstimateRegisterAliasRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -979,7 +1073,7 @@ func (m *EstimateRegisterAliasResponse) Reset()         { *m = EstimateRegisterA
 func (m *EstimateRegisterAliasResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateRegisterAliasResponse) ProtoMessage()    {}
 func (*EstimateRegisterAliasResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9fbab881fb7aa6c, []int{18}
+	return fileDescriptor_c9fbab881fb7aa6c, []int{20}
 }
 func (m *EstimateRegisterAliasResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1030,7 +1124,7 @@ func (m *ReverseResolveAddressRequest) Reset()         { *m = ReverseResolveAddr
 func (m *ReverseResolveAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*ReverseResolveAddressRequest) ProtoMessage()    {}
 func (*ReverseResolveAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9fbab881fb7aa6c, []int{19}
+	return fileDescriptor_c9fbab881fb7aa6c, []int{21}
 }
 func (m *ReverseResolveAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1086,7 +1180,7 @@ func (m *ReverseResolveAddressResponse) Reset()         { *m = ReverseResolveAdd
 func (m *ReverseResolveAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*ReverseResolveAddressResponse) ProtoMessage()    {}
 func (*ReverseResolveAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9fbab881fb7aa6c, []int{20}
+	return fileDescriptor_c9fbab881fb7aa6c, []int{22}
 }
 func (m *ReverseResolveAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1141,7 +1235,7 @@ func (m *ReverseResolveAddressResult) Reset()         { *m = ReverseResolveAddre
 func (m *ReverseResolveAddressResult) String() string { return proto.CompactTextString(m) }
 func (*ReverseResolveAddressResult) ProtoMessage()    {}
 func (*ReverseResolveAddressResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9fbab881fb7aa6c, []int{21}
+	return fileDescriptor_c9fbab881fb7aa6c, []int{23}
 }
 func (m *ReverseResolveAddressResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1199,7 +1293,7 @@ func (m *QueryTranslateAliasOrChainIdToChainIdRequest) String() string {
 }
 func (*QueryTranslateAliasOrChainIdToChainIdRequest) ProtoMessage() {}
 func (*QueryTranslateAliasOrChainIdToChainIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9fbab881fb7aa6c, []int{22}
+	return fileDescriptor_c9fbab881fb7aa6c, []int{24}
 }
 func (m *QueryTranslateAliasOrChainIdToChainIdRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1250,7 +1344,7 @@ func (m *QueryTranslateAliasOrChainIdToChainIdResponse) String() string {
 }
 func (*QueryTranslateAliasOrChainIdToChainIdResponse) ProtoMessage() {}
 func (*QueryTranslateAliasOrChainIdToChainIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9fbab881fb7aa6c, []int{23}
+	return fileDescriptor_c9fbab881fb7aa6c, []int{25}
 }
 func (m *QueryTranslateAliasOrChainIdToChainIdResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1297,7 +1391,7 @@ func (m *QueryBuyOrderByIdRequest) Reset()         { *m = QueryBuyOrderByIdReque
 func (m *QueryBuyOrderByIdRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBuyOrderByIdRequest) ProtoMessage()    {}
 func (*QueryBuyOrderByIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9fbab881fb7aa6c, []int{24}
+	return fileDescriptor_c9fbab881fb7aa6c, []int{26}
 }
 func (m *QueryBuyOrderByIdRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1344,7 +1438,7 @@ func (m *QueryBuyOrderByIdResponse) Reset()         { *m = QueryBuyOrderByIdResp
 func (m *QueryBuyOrderByIdResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBuyOrderByIdResponse) ProtoMessage()    {}
 func (*QueryBuyOrderByIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9fbab881fb7aa6c, []int{25}
+	return fileDescriptor_c9fbab881fb7aa6c, []int{27}
 }
 func (m *QueryBuyOrderByIdResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1391,7 +1485,7 @@ func (m *QueryBuyOrdersPlacedByAccountRequest) Reset()         { *m = QueryBuyOr
 func (m *QueryBuyOrdersPlacedByAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBuyOrdersPlacedByAccountRequest) ProtoMessage()    {}
 func (*QueryBuyOrdersPlacedByAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9fbab881fb7aa6c, []int{26}
+	return fileDescriptor_c9fbab881fb7aa6c, []int{28}
 }
 func (m *QueryBuyOrdersPlacedByAccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1438,7 +1532,7 @@ func (m *QueryBuyOrdersPlacedByAccountResponse) Reset()         { *m = QueryBuyO
 func (m *QueryBuyOrdersPlacedByAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBuyOrdersPlacedByAccountResponse) ProtoMessage()    {}
 func (*QueryBuyOrdersPlacedByAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9fbab881fb7aa6c, []int{27}
+	return fileDescriptor_c9fbab881fb7aa6c, []int{29}
 }
 func (m *QueryBuyOrdersPlacedByAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1485,7 +1579,7 @@ func (m *QueryBuyOrdersByDymNameRequest) Reset()         { *m = QueryBuyOrdersBy
 func (m *QueryBuyOrdersByDymNameRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBuyOrdersByDymNameRequest) ProtoMessage()    {}
 func (*QueryBuyOrdersByDymNameRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9fbab881fb7aa6c, []int{28}
+	return fileDescriptor_c9fbab881fb7aa6c, []int{30}
 }
 func (m *QueryBuyOrdersByDymNameRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1532,7 +1626,7 @@ func (m *QueryBuyOrdersByDymNameResponse) Reset()         { *m = QueryBuyOrdersB
 func (m *QueryBuyOrdersByDymNameResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBuyOrdersByDymNameResponse) ProtoMessage()    {}
 func (*QueryBuyOrdersByDymNameResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9fbab881fb7aa6c, []int{29}
+	return fileDescriptor_c9fbab881fb7aa6c, []int{31}
 }
 func (m *QueryBuyOrdersByDymNameResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1584,7 +1678,7 @@ func (m *QueryBuyOrdersOfDymNamesOwnedByAccountRequest) String() string {
 }
 func (*QueryBuyOrdersOfDymNamesOwnedByAccountRequest) ProtoMessage() {}
 func (*QueryBuyOrdersOfDymNamesOwnedByAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9fbab881fb7aa6c, []int{30}
+	return fileDescriptor_c9fbab881fb7aa6c, []int{32}
 }
 func (m *QueryBuyOrdersOfDymNamesOwnedByAccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1635,7 +1729,7 @@ func (m *QueryBuyOrdersOfDymNamesOwnedByAccountResponse) String() string {
 }
 func (*QueryBuyOrdersOfDymNamesOwnedByAccountResponse) ProtoMessage() {}
 func (*QueryBuyOrdersOfDymNamesOwnedByAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9fbab881fb7aa6c, []int{31}
+	return fileDescriptor_c9fbab881fb7aa6c, []int{33}
 }
 func (m *QueryBuyOrdersOfDymNamesOwnedByAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1682,7 +1776,7 @@ func (m *QueryBuyOrdersByAliasRequest) Reset()         { *m = QueryBuyOrdersByAl
 func (m *QueryBuyOrdersByAliasRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBuyOrdersByAliasRequest) ProtoMessage()    {}
 func (*QueryBuyOrdersByAliasRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9fbab881fb7aa6c, []int{32}
+	return fileDescriptor_c9fbab881fb7aa6c, []int{34}
 }
 func (m *QueryBuyOrdersByAliasRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1729,7 +1823,7 @@ func (m *QueryBuyOrdersByAliasResponse) Reset()         { *m = QueryBuyOrdersByA
 func (m *QueryBuyOrdersByAliasResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBuyOrdersByAliasResponse) ProtoMessage()    {}
 func (*QueryBuyOrdersByAliasResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9fbab881fb7aa6c, []int{33}
+	return fileDescriptor_c9fbab881fb7aa6c, []int{35}
 }
 func (m *QueryBuyOrdersByAliasResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1781,7 +1875,7 @@ func (m *QueryBuyOrdersOfAliasesLinkedToRollAppRequest) String() string {
 }
 func (*QueryBuyOrdersOfAliasesLinkedToRollAppRequest) ProtoMessage() {}
 func (*QueryBuyOrdersOfAliasesLinkedToRollAppRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9fbab881fb7aa6c, []int{34}
+	return fileDescriptor_c9fbab881fb7aa6c, []int{36}
 }
 func (m *QueryBuyOrdersOfAliasesLinkedToRollAppRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1833,7 +1927,7 @@ func (m *QueryBuyOrdersOfAliasesLinkedToRollAppResponse) String() string {
 }
 func (*QueryBuyOrdersOfAliasesLinkedToRollAppResponse) ProtoMessage() {}
 func (*QueryBuyOrdersOfAliasesLinkedToRollAppResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9fbab881fb7aa6c, []int{35}
+	return fileDescriptor_c9fbab881fb7aa6c, []int{37}
 }
 func (m *QueryBuyOrdersOfAliasesLinkedToRollAppResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1874,6 +1968,8 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "dymensionxyz.dymension.dymns.QueryParamsResponse")
 	proto.RegisterType((*QueryDymNameRequest)(nil), "dymensionxyz.dymension.dymns.QueryDymNameRequest")
 	proto.RegisterType((*QueryDymNameResponse)(nil), "dymensionxyz.dymension.dymns.QueryDymNameResponse")
+	proto.RegisterType((*QueryDymNameOwnershipTransferRequest)(nil), "dymensionxyz.dymension.dymns.QueryDymNameOwnershipTransferRequest")
+	proto.RegisterType((*QueryDymNameOwnershipTransferResponse)(nil), "dymensionxyz.dymension.dymns.QueryDymNameOwnershipTransferResponse")
 	proto.RegisterType((*QueryAliasRequest)(nil), "dymensionxyz.dymension.dymns.QueryAliasRequest")
 	proto.RegisterType((*QueryAliasResponse)(nil), "dymensionxyz.dymension.dymns.QueryAliasResponse")
 	proto.RegisterType((*QueryAliasesRequest)(nil), "dymensionxyz.dymension.dymns.QueryAliasesRequest")
@@ -1915,126 +2011,130 @@ func init() {
 }
 
 var fileDescriptor_c9fbab881fb7aa6c = []byte{
-	// 1899 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcf, 0x73, 0x1b, 0x49,
-	0x15, 0xf6, 0xc8, 0x71, 0x62, 0x3d, 0x2d, 0xc6, 0xe9, 0x75, 0x16, 0x47, 0xeb, 0x28, 0x61, 0x48,
-	0x76, 0x1d, 0x36, 0xd6, 0x24, 0x72, 0x12, 0x48, 0x42, 0x0a, 0x5b, 0x4e, 0x96, 0x78, 0x13, 0xe2,
-	0xa0, 0x75, 0xc1, 0x66, 0x2f, 0x53, 0x23, 0x4d, 0x5b, 0x3b, 0x95, 0xd1, 0xb4, 0x32, 0x3d, 0x72,
-	0x32, 0xa8, 0x74, 0xe1, 0x40, 0x01, 0x27, 0xaa, 0xb8, 0x50, 0x70, 0x80, 0x13, 0x97, 0x3d, 0x52,
-	0xfc, 0x09, 0x14, 0x7b, 0xa2, 0xb6, 0x8a, 0xe2, 0xc7, 0x05, 0x8a, 0x4a, 0x38, 0x70, 0xa3, 0xf8,
-	0x0f, 0xb6, 0xa6, 0xe7, 0xf5, 0x68, 0x46, 0x19, 0xcd, 0x8c, 0xb2, 0x9b, 0x93, 0xbb, 0x7b, 0xfa,
-	0xbd, 0xfe, 0xbe, 0xd7, 0xdd, 0xef, 0xf5, 0x27, 0xc3, 0xba, 0xe9, 0xf7, 0xa8, 0xc3, 0x2d, 0xe6,
-	0x3c, 0xf5, 0x7f, 0xa8, 0x45, 0x9d, 0xa0, 0xe5, 0x70, 0xed, 0xf1, 0x80, 0xba, 0x7e, 0xbd, 0xef,
-	0x32, 0x8f, 0x91, 0xb5, 0xf8, 0xcc, 0x7a, 0xd4, 0xa9, 0x8b, 0x99, 0xd5, 0x95, 0x2e, 0xeb, 0x32,
-	0x31, 0x51, 0x0b, 0x5a, 0xa1, 0x4d, 0x75, 0xad, 0xcb, 0x58, 0xd7, 0xa6, 0x9a, 0xd1, 0xb7, 0x34,
-	0xc3, 0x71, 0x98, 0x67, 0x78, 0x16, 0x73, 0x38, 0x7e, 0xad, 0x75, 0x18, 0xef, 0x31, 0xae, 0xb5,
-	0x0d, 0x4e, 0xb5, 0xc3, 0x4b, 0x6d, 0xea, 0x19, 0x97, 0xb4, 0x0e, 0xb3, 0x1c, 0xfc, 0x7e, 0x3e,
-	0x13, 0x5b, 0xdf, 0x70, 0x8d, 0x9e, 0x74, 0xf5, 0x4e, 0xe6, 0x54, 0xd3, 0xef, 0xe9, 0x8e, 0xd1,
-	0xa3, 0x85, 0xfc, 0xf6, 0x0c, 0xf7, 0x11, 0xf5, 0x70, 0x6a, 0x76, 0x78, 0x0c, 0xdb, 0x32, 0x10,
-	0x81, 0xba, 0x02, 0xe4, 0x7b, 0x41, 0xb4, 0x1e, 0x08, 0x58, 0x2d, 0xfa, 0x78, 0x40, 0xb9, 0xa7,
-	0x3e, 0x84, 0xd7, 0x13, 0xa3, 0xbc, 0xcf, 0x1c, 0x4e, 0x49, 0x13, 0x8e, 0x86, 0xf0, 0x57, 0x95,
-	0x33, 0xca, 0x7a, 0xa5, 0x71, 0xb6, 0x9e, 0x15, 0xdc, 0x7a, 0x68, 0xdd, 0x3c, 0xf2, 0xc9, 0xbf,
-	0x4e, 0xcf, 0xb5, 0xd0, 0x52, 0xbd, 0x8a, 0xae, 0x6f, 0xf9, 0xbd, 0xfb, 0x46, 0x8f, 0xe2, 0x8a,
-	0xe4, 0x24, 0x2c, 0x4a, 0xba, 0xc2, 0x79, 0xb9, 0x75, 0xcc, 0x0c, 0x67, 0x5c, 0x3f, 0xf2, 0xdf,
-	0xdf, 0x9e, 0x9e, 0x53, 0x3f, 0x80, 0x95, 0xa4, 0x1d, 0x62, 0xda, 0x9a, 0x30, 0xac, 0x34, 0xce,
-	0x65, 0xa3, 0x92, 0x0e, 0xa4, 0x7f, 0x55, 0x83, 0xe3, 0xc2, 0xf3, 0x76, 0x10, 0x16, 0x89, 0x67,
-	0x05, 0x16, 0x44, 0x98, 0x10, 0x4c, 0xd8, 0x41, 0x28, 0x1f, 0x2b, 0x18, 0x34, 0xb4, 0x40, 0x24,
-	0x27, 0x61, 0xb1, 0xf3, 0x91, 0x61, 0x39, 0xba, 0x65, 0x4a, 0x0a, 0xa2, 0xbf, 0x6b, 0x92, 0x75,
-	0x58, 0x3e, 0x60, 0x03, 0xc7, 0xd4, 0x39, 0xb5, 0x6d, 0x9d, 0xb9, 0x26, 0x75, 0x57, 0x4b, 0x67,
-	0x94, 0xf5, 0xc5, 0xd6, 0x92, 0x18, 0x7f, 0x9f, 0xda, 0xf6, 0x5e, 0x30, 0x4a, 0x54, 0xf8, 0x52,
-	0x7b, 0xe0, 0x87, 0x53, 0x74, 0xcb, 0xe4, 0xab, 0xf3, 0x67, 0xe6, 0xd7, 0xcb, 0xad, 0x4a, 0x7b,
-	0xe0, 0x8b, 0x09, 0xbb, 0x26, 0x27, 0x17, 0x80, 0x70, 0xa3, 0x47, 0xf5, 0x70, 0x35, 0x81, 0x8c,
-	0xf2, 0xd5, 0x23, 0x62, 0xe2, 0x72, 0xf0, 0x65, 0x27, 0xf8, 0xb0, 0x1d, 0x8e, 0x47, 0x01, 0xc7,
-	0x7e, 0x2c, 0xe0, 0x53, 0xd0, 0x22, 0xcb, 0x9f, 0x96, 0x30, 0xe2, 0x91, 0x21, 0xf2, 0x1c, 0xc1,
-	0xeb, 0xb8, 0xa6, 0xde, 0xf6, 0xf5, 0x98, 0x93, 0xf9, 0xf5, 0x4a, 0xe3, 0x4e, 0x76, 0xf0, 0xd3,
-	0x1c, 0xd6, 0xb1, 0xdf, 0xf4, 0x77, 0x42, 0x00, 0xb7, 0x1d, 0xcf, 0xf5, 0xf1, 0xd8, 0x2c, 0x1b,
-	0x13, 0x1f, 0xab, 0x2e, 0x9c, 0x48, 0x35, 0x20, 0xcb, 0x30, 0xff, 0x88, 0xfa, 0x48, 0x26, 0x68,
-	0x92, 0x1d, 0x58, 0x38, 0x34, 0xec, 0x01, 0x15, 0xb1, 0xae, 0x34, 0x36, 0xb2, 0xb1, 0x7d, 0x77,
-	0x60, 0x7b, 0x56, 0xdf, 0xa6, 0x12, 0x5e, 0x68, 0x7b, 0xbd, 0xf4, 0x4d, 0x45, 0xbd, 0x05, 0xb5,
-	0x16, 0xe5, 0xcc, 0x3e, 0xa4, 0x78, 0x7a, 0xb6, 0x4d, 0xd3, 0xa5, 0x3c, 0x16, 0xce, 0x35, 0x28,
-	0x1b, 0x72, 0x4c, 0x84, 0xa2, 0xdc, 0x1a, 0x0f, 0x60, 0x44, 0x1f, 0xc3, 0x4a, 0x8b, 0xf2, 0x81,
-	0xed, 0x25, 0x9d, 0x90, 0x55, 0x38, 0x86, 0x53, 0xe5, 0x4e, 0x60, 0x97, 0x9c, 0x87, 0x65, 0x37,
-	0x5c, 0xd7, 0xd4, 0xe5, 0x94, 0x92, 0x98, 0xf2, 0x65, 0x39, 0x2e, 0x9d, 0xac, 0xc0, 0x02, 0x75,
-	0x5d, 0xe6, 0xae, 0xce, 0x87, 0x07, 0x56, 0x74, 0xd4, 0x9f, 0x29, 0x70, 0x7a, 0x2a, 0x72, 0xdc,
-	0xcf, 0x2e, 0x90, 0xc9, 0x45, 0x90, 0x43, 0xa5, 0xd1, 0xc8, 0x0e, 0x59, 0x1a, 0x1d, 0xdc, 0xb8,
-	0xe3, 0x13, 0x00, 0x29, 0x57, 0xb7, 0x40, 0x8d, 0x5f, 0x61, 0xbe, 0xf7, 0xc4, 0xa1, 0x66, 0xd3,
-	0xdf, 0xee, 0x74, 0xd8, 0xc0, 0xf1, 0x62, 0x37, 0x8f, 0x3d, 0x71, 0xa8, 0x2b, 0x6f, 0x9e, 0xe8,
-	0x60, 0x04, 0x19, 0x7c, 0x2d, 0xd3, 0x03, 0x32, 0xba, 0x03, 0x65, 0x99, 0x13, 0x24, 0x91, 0x62,
-	0x49, 0x01, 0xb1, 0x2f, 0x62, 0x6a, 0xe0, 0xea, 0x0f, 0xe0, 0x84, 0x58, 0x30, 0xba, 0xa0, 0xb1,
-	0xeb, 0x63, 0x70, 0x4e, 0xbd, 0xd8, 0xf5, 0x11, 0xfd, 0x5d, 0x93, 0x9c, 0x02, 0x08, 0x3f, 0x79,
-	0x7e, 0x9f, 0xe2, 0x76, 0x95, 0xc5, 0xc8, 0xbe, 0xdf, 0x97, 0xe9, 0x4c, 0x87, 0x37, 0x26, 0x1d,
-	0x23, 0xf8, 0xdb, 0x70, 0xd4, 0x15, 0x61, 0xc5, 0x74, 0xf6, 0x76, 0x36, 0xf2, 0xc8, 0x81, 0xcc,
-	0xb3, 0xa1, 0xb1, 0x6a, 0xc1, 0x9b, 0xb7, 0xb9, 0x67, 0xf5, 0x0c, 0x8f, 0xb6, 0x68, 0xd7, 0xe2,
-	0x1e, 0x75, 0xe3, 0xf9, 0x96, 0xc0, 0x91, 0x58, 0xae, 0x15, 0x6d, 0x52, 0x85, 0x45, 0x73, 0xe0,
-	0x8a, 0x5a, 0x27, 0x60, 0xcf, 0xb7, 0xa2, 0xfe, 0x78, 0x57, 0xe6, 0x5f, 0xdc, 0x95, 0xff, 0x29,
-	0xb0, 0x96, 0xbe, 0x16, 0x52, 0xda, 0x85, 0xe5, 0x03, 0xcb, 0xe5, 0x9e, 0xee, 0x53, 0xc3, 0xd5,
-	0xfb, 0xae, 0xd5, 0x91, 0xb9, 0xfa, 0x64, 0x3d, 0x2c, 0xa6, 0xf5, 0xa0, 0x98, 0xd6, 0xb1, 0x98,
-	0xd6, 0x77, 0x98, 0xe5, 0x20, 0x9d, 0x25, 0x61, 0xf8, 0x90, 0x1a, 0xee, 0x83, 0xc0, 0x8c, 0x34,
-	0xe1, 0x35, 0xfa, 0xd4, 0xa3, 0x8e, 0x89, 0x6e, 0x4a, 0xc5, 0xdc, 0x54, 0x42, 0xa3, 0xd0, 0xc7,
-	0x16, 0x54, 0x3c, 0xe6, 0x19, 0x36, 0xba, 0x98, 0x2f, 0xe6, 0x02, 0x84, 0x8d, 0xf0, 0xa0, 0xb2,
-	0x17, 0x09, 0xe7, 0x57, 0x8f, 0xe0, 0x60, 0xb8, 0xcc, 0xb6, 0x8d, 0x7e, 0x3f, 0x38, 0x35, 0x78,
-	0x30, 0x70, 0x64, 0xd7, 0xcc, 0x0c, 0xf1, 0xf7, 0xe1, 0xd4, 0x94, 0x05, 0x31, 0xc4, 0x57, 0x60,
-	0x61, 0xa6, 0xb8, 0x86, 0xb3, 0xd5, 0x03, 0x58, 0x6b, 0xd1, 0x43, 0xea, 0x72, 0x8a, 0x59, 0x02,
-	0x6f, 0x6b, 0xa1, 0xb4, 0x16, 0x94, 0xb5, 0x27, 0xcc, 0x7d, 0x64, 0x39, 0xdd, 0x71, 0x19, 0x08,
-	0x69, 0x2d, 0xe1, 0x38, 0x26, 0x68, 0xf5, 0x77, 0x25, 0x38, 0x35, 0x65, 0x21, 0x24, 0x40, 0x63,
-	0xc7, 0x3e, 0xb8, 0xb0, 0xdf, 0xc9, 0xcb, 0x3c, 0x19, 0xce, 0x30, 0x2f, 0xc5, 0xeb, 0x08, 0x3a,
-	0x2f, 0x0e, 0xb9, 0xea, 0x41, 0x25, 0xe6, 0x26, 0xa5, 0xba, 0xec, 0x25, 0xab, 0xcb, 0xb5, 0x97,
-	0x03, 0x3c, 0xb0, 0xbd, 0x78, 0xa5, 0x79, 0x1f, 0xde, 0xcc, 0x98, 0x49, 0x6a, 0x00, 0x1d, 0xc3,
-	0x31, 0x2d, 0xd3, 0xf0, 0xa2, 0x0d, 0x89, 0x8d, 0x8c, 0xab, 0x40, 0x29, 0x5e, 0x05, 0x1e, 0xc2,
-	0x05, 0x91, 0x6c, 0xf6, 0x5d, 0xc3, 0xe1, 0xb6, 0xe1, 0x85, 0x25, 0x6e, 0xcf, 0x45, 0xaa, 0xfb,
-	0x0c, 0x1b, 0x72, 0xd7, 0xcf, 0xc3, 0x71, 0x71, 0x62, 0x75, 0xe6, 0xea, 0x13, 0x8f, 0x84, 0x25,
-	0x23, 0x61, 0xaa, 0xbe, 0x07, 0x1b, 0x05, 0x5d, 0xe7, 0xbe, 0x92, 0xd4, 0xaf, 0xc3, 0xaa, 0xf0,
-	0xd5, 0xc4, 0xb7, 0x4e, 0xd3, 0x1f, 0x43, 0x5a, 0x82, 0x52, 0x64, 0x50, 0xb2, 0x4c, 0xf5, 0x00,
-	0x4e, 0xa6, 0xcc, 0x8d, 0xf2, 0x4d, 0x39, 0x7a, 0x44, 0xe1, 0x85, 0x78, 0x2b, 0x7b, 0x77, 0x22,
-	0x37, 0x58, 0x00, 0xe4, 0x73, 0x4b, 0xdd, 0x82, 0xb3, 0x89, 0x75, 0xf8, 0x03, 0xdb, 0xe8, 0xa4,
-	0x54, 0xad, 0xa0, 0x86, 0x87, 0x23, 0x51, 0x39, 0x08, 0xbb, 0xaa, 0x07, 0xe7, 0x72, 0x3c, 0x20,
-	0xea, 0xbb, 0x00, 0x11, 0x6a, 0x59, 0xb6, 0x66, 0x83, 0x5d, 0x96, 0xb0, 0xb9, 0x7a, 0x19, 0x6a,
-	0xc9, 0x55, 0x9b, 0x93, 0x2f, 0xee, 0x94, 0x0a, 0xa0, 0x3a, 0x70, 0x7a, 0xaa, 0xd5, 0xab, 0x40,
-	0xb9, 0x8b, 0xa7, 0x27, 0x5a, 0x6f, 0xef, 0x20, 0xfb, 0x71, 0x30, 0x3d, 0xcc, 0x23, 0xa8, 0x17,
-	0x75, 0xf5, 0x6a, 0xe2, 0xbd, 0x36, 0x19, 0xb9, 0xfc, 0x8a, 0xa0, 0xda, 0x70, 0x6a, 0x8a, 0xd5,
-	0xab, 0xc0, 0x78, 0xff, 0xc5, 0x68, 0xe3, 0x5b, 0xf7, 0x9e, 0xe5, 0x3c, 0xa2, 0xe6, 0x3e, 0x6b,
-	0x31, 0xdb, 0xde, 0xee, 0xf7, 0x25, 0xe8, 0x64, 0xc1, 0x52, 0x26, 0x0a, 0x56, 0x5a, 0xc8, 0xa7,
-	0xf9, 0x7b, 0x05, 0x74, 0x1a, 0x3f, 0x59, 0x83, 0x05, 0xb1, 0x3e, 0xf9, 0xb5, 0x02, 0x47, 0x43,
-	0xb1, 0x49, 0x2e, 0x16, 0xd0, 0x1f, 0x09, 0xad, 0x5b, 0xbd, 0x34, 0x83, 0x45, 0x48, 0x43, 0xbd,
-	0xf0, 0xa3, 0xbf, 0xfc, 0xe7, 0x17, 0xa5, 0xb7, 0xc8, 0x59, 0xad, 0x80, 0xd4, 0x27, 0x1f, 0x2b,
-	0x70, 0x0c, 0x8f, 0x22, 0x29, 0xb2, 0x58, 0xf2, 0x9e, 0x56, 0x1b, 0xb3, 0x98, 0x20, 0xc0, 0x6b,
-	0x02, 0xe0, 0x26, 0xb9, 0xa4, 0x15, 0xfa, 0x81, 0x41, 0x1b, 0xca, 0xd6, 0x88, 0xfc, 0x46, 0x81,
-	0x05, 0xb1, 0x8b, 0x44, 0x2b, 0x2a, 0xe5, 0x24, 0xd2, 0x8b, 0xc5, 0x0d, 0x10, 0xe7, 0xa6, 0xc0,
-	0xb9, 0x41, 0xde, 0xd1, 0xf2, 0x7f, 0xb0, 0xd0, 0x86, 0xe2, 0x8f, 0x40, 0x78, 0x0c, 0xcf, 0x59,
-	0xa1, 0x78, 0x26, 0x85, 0x6f, 0xa1, 0x78, 0x4e, 0x28, 0x54, 0x75, 0x43, 0xe0, 0x7c, 0x9b, 0x9c,
-	0x2b, 0x80, 0x93, 0x72, 0xf2, 0x47, 0x05, 0xbe, 0x32, 0x45, 0x75, 0x91, 0x6f, 0xe5, 0x2a, 0xaa,
-	0x0c, 0x99, 0x59, 0xbd, 0xf9, 0x92, 0xd6, 0xb3, 0xf1, 0x40, 0xe9, 0x46, 0xfe, 0xaa, 0xc0, 0x1b,
-	0xe9, 0x49, 0x94, 0x6c, 0x15, 0x3f, 0x95, 0xe9, 0xa9, 0xbc, 0xba, 0xfd, 0x39, 0x3c, 0x20, 0x9d,
-	0xab, 0x82, 0xce, 0x45, 0x52, 0xcf, 0xa6, 0x13, 0x3c, 0xa4, 0x4d, 0xbd, 0xed, 0x6b, 0x43, 0xf1,
-	0xa4, 0x1e, 0x91, 0xdf, 0x2b, 0x50, 0x1e, 0xff, 0xe4, 0xb2, 0x59, 0x00, 0xc8, 0xa4, 0xfe, 0xab,
-	0x5e, 0x9e, 0xcd, 0x08, 0x01, 0xdf, 0x10, 0x80, 0xaf, 0x90, 0xcd, 0x6c, 0xc0, 0xe3, 0x5f, 0x89,
-	0xb4, 0xa1, 0x54, 0x99, 0x23, 0xf2, 0x4f, 0x05, 0x56, 0xd2, 0x64, 0x16, 0xc9, 0x79, 0x79, 0x66,
-	0xc8, 0xc0, 0xea, 0xf5, 0x97, 0x31, 0x45, 0x32, 0xf7, 0x05, 0x99, 0x3b, 0xe4, 0xdd, 0x6c, 0x32,
-	0x14, 0x7d, 0xe8, 0x2e, 0x3a, 0xc1, 0x94, 0x23, 0xd2, 0x8d, 0x36, 0x94, 0x0a, 0x73, 0x44, 0xfe,
-	0xae, 0xc0, 0x89, 0x54, 0x91, 0x43, 0x66, 0x44, 0x99, 0x48, 0x4a, 0x37, 0x5e, 0xca, 0x16, 0x29,
-	0xde, 0x16, 0x14, 0xbf, 0x4d, 0x6e, 0xce, 0x4a, 0x31, 0x99, 0xb1, 0xfe, 0xa4, 0xc0, 0x89, 0xd4,
-	0x57, 0x7d, 0x1e, 0xb3, 0x2c, 0x6d, 0x96, 0xc7, 0x2c, 0x53, 0x21, 0xa9, 0x57, 0x04, 0x33, 0x8d,
-	0x6c, 0xe4, 0x65, 0x02, 0xe1, 0x44, 0x97, 0x19, 0xe1, 0xc7, 0x25, 0x38, 0x93, 0xf7, 0xd4, 0x27,
-	0xef, 0x15, 0xb8, 0x1b, 0x05, 0xa5, 0x48, 0xf5, 0xee, 0x17, 0xe2, 0x0b, 0x49, 0xef, 0x0a, 0xd2,
-	0x3b, 0x64, 0x3b, 0x9b, 0xb4, 0x27, 0xfd, 0x25, 0xb6, 0x31, 0x2e, 0x86, 0x46, 0xe4, 0x0f, 0x0a,
-	0xbc, 0x16, 0xd7, 0x1e, 0xe4, 0x6a, 0x01, 0xa0, 0x29, 0xc2, 0xa6, 0xfa, 0x8d, 0x99, 0xed, 0x90,
-	0xcc, 0x65, 0x41, 0xa6, 0x4e, 0x2e, 0x64, 0x93, 0x89, 0xde, 0x5b, 0xda, 0x30, 0xc0, 0xfd, 0x7f,
-	0x05, 0x56, 0xa7, 0x29, 0x11, 0xd2, 0x9c, 0x01, 0xcb, 0x14, 0x21, 0x54, 0xdd, 0xf9, 0x5c, 0x3e,
-	0x90, 0xdb, 0x3d, 0xc1, 0xed, 0x5d, 0x72, 0xab, 0x20, 0x37, 0xae, 0xf7, 0x85, 0x27, 0xbd, 0xed,
-	0xeb, 0x28, 0x08, 0xb4, 0x21, 0x36, 0x46, 0xe4, 0x6f, 0x0a, 0x90, 0x17, 0x15, 0x4d, 0x5e, 0x25,
-	0xce, 0x96, 0x4f, 0x79, 0x95, 0x38, 0x47, 0x46, 0xa9, 0x3b, 0x82, 0xe1, 0x4d, 0x72, 0xa3, 0x30,
-	0xc3, 0xb6, 0xaf, 0x8f, 0xdf, 0x6b, 0xe1, 0x5b, 0xed, 0x97, 0x25, 0xf8, 0x6a, 0xae, 0xde, 0x21,
-	0x77, 0x67, 0x41, 0x9a, 0x23, 0xc0, 0xaa, 0xf7, 0xbe, 0x18, 0x67, 0x18, 0x85, 0x0f, 0x44, 0x14,
-	0x5a, 0xe4, 0x41, 0xe1, 0x28, 0xb0, 0x83, 0x28, 0x0a, 0x5c, 0x97, 0x85, 0x3d, 0x65, 0xcf, 0xff,
-	0xac, 0xc0, 0xf2, 0xa4, 0xaa, 0xca, 0xcb, 0xb6, 0x59, 0x02, 0x2e, 0x2f, 0xdb, 0x66, 0xca, 0x38,
-	0x75, 0x5b, 0xf0, 0xbc, 0x41, 0xae, 0xcd, 0xb2, 0xdb, 0xc9, 0x1a, 0xf2, 0xab, 0xe4, 0x5e, 0xa7,
-	0x0b, 0xad, 0x59, 0xf7, 0x3a, 0x53, 0xfe, 0xcd, 0xba, 0xd7, 0xd9, 0xda, 0x4f, 0xfd, 0x50, 0xc4,
-	0x60, 0x9f, 0xb4, 0x66, 0xd9, 0x6b, 0xf9, 0x8f, 0x26, 0x5b, 0x38, 0xd5, 0x3d, 0xa6, 0xa3, 0xfc,
-	0xd4, 0x86, 0x63, 0x65, 0x3a, 0x6a, 0xde, 0xfb, 0xe4, 0x59, 0x4d, 0xf9, 0xf4, 0x59, 0x4d, 0xf9,
-	0xf7, 0xb3, 0x9a, 0xf2, 0xf3, 0xe7, 0xb5, 0xb9, 0x4f, 0x9f, 0xd7, 0xe6, 0xfe, 0xf1, 0xbc, 0x36,
-	0xf7, 0x61, 0xa3, 0x6b, 0x79, 0x1f, 0x0d, 0xda, 0xf5, 0x0e, 0xeb, 0x4d, 0x5b, 0xf7, 0x70, 0x53,
-	0x7b, 0x2a, 0x33, 0xbf, 0xdf, 0xa7, 0xbc, 0x7d, 0x54, 0xfc, 0x6b, 0x74, 0xf3, 0xb3, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x97, 0xbd, 0xfa, 0x07, 0x65, 0x1e, 0x00, 0x00,
+	// 1965 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcf, 0x6f, 0xdb, 0xc8,
+	0x15, 0x36, 0xe5, 0x38, 0xb1, 0x9e, 0xb6, 0xae, 0x33, 0xeb, 0x6c, 0x15, 0xae, 0xad, 0xa4, 0x6c,
+	0xb2, 0xeb, 0x74, 0x63, 0x31, 0x91, 0x93, 0xb4, 0x89, 0x37, 0xa8, 0x2d, 0x27, 0xbb, 0xf1, 0xc6,
+	0x8d, 0x53, 0xad, 0xd1, 0x6e, 0xf6, 0x42, 0x50, 0xe2, 0xd8, 0x21, 0x42, 0x91, 0x0a, 0x87, 0xf2,
+	0x86, 0x15, 0x74, 0xe9, 0xa1, 0x40, 0x7b, 0x2a, 0xd0, 0x4b, 0xd1, 0x1e, 0xda, 0x53, 0x2f, 0x7b,
+	0x2c, 0xfa, 0x27, 0x14, 0xdd, 0x53, 0xb1, 0x40, 0xd1, 0x1f, 0x97, 0x16, 0x45, 0xd2, 0x43, 0x6e,
+	0x45, 0xd1, 0x7f, 0xa0, 0xe0, 0xf0, 0x8d, 0x44, 0xca, 0x14, 0x45, 0x3a, 0xf1, 0x49, 0x9c, 0xe1,
+	0xbc, 0x6f, 0xbe, 0xef, 0xcd, 0xcc, 0x7b, 0xf3, 0x28, 0x58, 0x36, 0xfc, 0x36, 0xb5, 0x99, 0xe9,
+	0xd8, 0xcf, 0xfc, 0x1f, 0xaa, 0x83, 0x46, 0xf0, 0x64, 0x33, 0xf5, 0x69, 0x97, 0xba, 0x7e, 0xb5,
+	0xe3, 0x3a, 0x9e, 0x43, 0x16, 0xa3, 0x23, 0xab, 0x83, 0x46, 0x95, 0x8f, 0x94, 0x17, 0xf6, 0x9d,
+	0x7d, 0x87, 0x0f, 0x54, 0x83, 0xa7, 0xd0, 0x46, 0x5e, 0xdc, 0x77, 0x9c, 0x7d, 0x8b, 0xaa, 0x7a,
+	0xc7, 0x54, 0x75, 0xdb, 0x76, 0x3c, 0xdd, 0x33, 0x1d, 0x9b, 0xe1, 0xdb, 0x4a, 0xcb, 0x61, 0x6d,
+	0x87, 0xa9, 0x4d, 0x9d, 0x51, 0xf5, 0xe0, 0x6a, 0x93, 0x7a, 0xfa, 0x55, 0xb5, 0xe5, 0x98, 0x36,
+	0xbe, 0xbf, 0x94, 0xca, 0xad, 0xa3, 0xbb, 0x7a, 0x5b, 0x40, 0xbd, 0x97, 0x3a, 0xd4, 0xf0, 0xdb,
+	0x9a, 0xad, 0xb7, 0x69, 0x26, 0xdc, 0xb6, 0xee, 0x3e, 0xa1, 0x1e, 0x0e, 0x4d, 0x77, 0x8f, 0x6e,
+	0x99, 0x3a, 0x32, 0x50, 0x16, 0x80, 0x7c, 0x2f, 0xf0, 0xd6, 0x43, 0x4e, 0xab, 0x41, 0x9f, 0x76,
+	0x29, 0xf3, 0x94, 0x47, 0xf0, 0x66, 0xac, 0x97, 0x75, 0x1c, 0x9b, 0x51, 0x52, 0x87, 0x93, 0x21,
+	0xfd, 0xb2, 0x74, 0x5e, 0x5a, 0x2e, 0xd5, 0x2e, 0x54, 0xd3, 0x9c, 0x5b, 0x0d, 0xad, 0xeb, 0x27,
+	0xbe, 0xf8, 0xe7, 0xb9, 0xa9, 0x06, 0x5a, 0x2a, 0x37, 0x10, 0xfa, 0x8e, 0xdf, 0x7e, 0xa0, 0xb7,
+	0x29, 0xce, 0x48, 0xce, 0xc2, 0xac, 0x90, 0xcb, 0xc1, 0x8b, 0x8d, 0x53, 0x46, 0x38, 0xe2, 0xd6,
+	0x89, 0x97, 0xbf, 0x39, 0x37, 0xa5, 0x7c, 0x02, 0x0b, 0x71, 0x3b, 0xe4, 0xb4, 0x3e, 0x62, 0x58,
+	0xaa, 0x5d, 0x4c, 0x67, 0x25, 0x00, 0x04, 0xbe, 0xf2, 0x21, 0x5c, 0x88, 0x22, 0xef, 0x7c, 0x66,
+	0x53, 0x97, 0x3d, 0x36, 0x3b, 0xbb, 0xae, 0x6e, 0xb3, 0x3d, 0xea, 0x66, 0xa6, 0xd8, 0x83, 0x8b,
+	0x13, 0x80, 0x90, 0x73, 0x03, 0x66, 0x3d, 0xec, 0x43, 0xce, 0x37, 0x32, 0x71, 0x3e, 0x8c, 0x38,
+	0xc0, 0x51, 0x54, 0x38, 0xcd, 0x27, 0xdf, 0x08, 0x16, 0x57, 0x50, 0x5e, 0x80, 0x19, 0xbe, 0xd8,
+	0xc8, 0x37, 0x6c, 0x20, 0xdb, 0xcf, 0x25, 0x20, 0x51, 0x0b, 0xe4, 0x76, 0x16, 0x66, 0x5b, 0x8f,
+	0x75, 0xd3, 0xd6, 0x4c, 0x43, 0xa8, 0xe4, 0xed, 0x2d, 0x83, 0x2c, 0xc3, 0xfc, 0x9e, 0xd3, 0xb5,
+	0x0d, 0x8d, 0x51, 0xcb, 0xd2, 0x1c, 0xd7, 0xa0, 0x6e, 0xb9, 0x70, 0x5e, 0x5a, 0x9e, 0x6d, 0xcc,
+	0xf1, 0xfe, 0x8f, 0xa9, 0x65, 0xed, 0x04, 0xbd, 0x44, 0x81, 0xaf, 0x34, 0xbb, 0x7e, 0x38, 0x44,
+	0x33, 0x0d, 0x56, 0x9e, 0x3e, 0x3f, 0xbd, 0x5c, 0x6c, 0x94, 0x9a, 0x5d, 0x9f, 0x0f, 0xd8, 0x32,
+	0x18, 0xb9, 0x0c, 0x84, 0xe9, 0x6d, 0xaa, 0x85, 0xb3, 0x71, 0x66, 0x94, 0x95, 0x4f, 0xf0, 0x81,
+	0xf3, 0xc1, 0x9b, 0xcd, 0xe0, 0xc5, 0x46, 0xd8, 0x3f, 0xd8, 0x36, 0xd8, 0x8e, 0xac, 0xc9, 0x18,
+	0xb6, 0xa8, 0xf2, 0x27, 0x05, 0x58, 0x88, 0x1b, 0xa2, 0xce, 0x3e, 0xbc, 0x89, 0x73, 0x6a, 0x4d,
+	0x5f, 0x8b, 0x80, 0x4c, 0x2f, 0x97, 0x6a, 0xf7, 0xd2, 0x97, 0x23, 0x09, 0xb0, 0x8a, 0xed, 0xba,
+	0xbf, 0x19, 0x12, 0xb8, 0x6b, 0x7b, 0xae, 0x8f, 0x9b, 0x7f, 0x5e, 0x1f, 0x79, 0x29, 0xbb, 0x70,
+	0x26, 0xd1, 0x80, 0xcc, 0xc3, 0xf4, 0x13, 0xea, 0xa3, 0x98, 0xe0, 0x91, 0x6c, 0xc2, 0xcc, 0x81,
+	0x6e, 0x75, 0x29, 0xf7, 0x75, 0xa9, 0xb6, 0x92, 0xce, 0xed, 0xbb, 0x5d, 0xcb, 0x33, 0x3b, 0x16,
+	0x15, 0xf4, 0x42, 0xdb, 0x5b, 0x85, 0x6f, 0x4b, 0xca, 0x1d, 0xa8, 0x34, 0x28, 0x73, 0xac, 0x03,
+	0x8a, 0xfb, 0x69, 0xc3, 0x30, 0x5c, 0xca, 0x22, 0xee, 0x5c, 0x84, 0xa2, 0x2e, 0xfa, 0xb8, 0x2b,
+	0x8a, 0x8d, 0x61, 0x07, 0x7a, 0xf4, 0x29, 0x2c, 0x34, 0x28, 0xeb, 0x5a, 0x5e, 0x1c, 0x84, 0x94,
+	0xe1, 0x14, 0x0e, 0x15, 0x2b, 0x81, 0x4d, 0x72, 0x09, 0xe6, 0xdd, 0x70, 0x5e, 0x43, 0x13, 0x43,
+	0x0a, 0x7c, 0xc8, 0x57, 0x45, 0xbf, 0x00, 0x59, 0x80, 0x19, 0xea, 0xba, 0x8e, 0x5b, 0x9e, 0x0e,
+	0x37, 0x2c, 0x6f, 0x28, 0x3f, 0x95, 0xe0, 0xdc, 0x58, 0xe6, 0xb8, 0x9e, 0xfb, 0x40, 0x46, 0x27,
+	0x41, 0x0d, 0xa5, 0x5a, 0x2d, 0xdd, 0x65, 0x49, 0x72, 0x70, 0xe1, 0x4e, 0x8f, 0x10, 0xa4, 0x4c,
+	0x59, 0x07, 0x25, 0x7a, 0xca, 0x59, 0x70, 0x28, 0x8d, 0xba, 0xbf, 0xd1, 0x6a, 0x39, 0x5d, 0xdb,
+	0x8b, 0x9c, 0x3c, 0x27, 0x38, 0xad, 0xe2, 0xe4, 0xf1, 0x06, 0x7a, 0xd0, 0x81, 0x6f, 0xa4, 0x22,
+	0xa0, 0xa2, 0x7b, 0x50, 0x14, 0xf1, 0x46, 0x08, 0xc9, 0x16, 0xda, 0x90, 0xfb, 0x2c, 0x46, 0x27,
+	0xa6, 0xfc, 0x00, 0xce, 0xf0, 0x09, 0x07, 0x07, 0x34, 0x72, 0x7c, 0x74, 0xc6, 0xa8, 0x17, 0x39,
+	0x3e, 0xbc, 0xbd, 0x65, 0x90, 0x25, 0x80, 0xf0, 0x95, 0xe7, 0x77, 0x28, 0x2e, 0x57, 0x91, 0xf7,
+	0xec, 0xfa, 0x1d, 0x11, 0xf1, 0x34, 0x78, 0x6b, 0x14, 0x18, 0xc9, 0xdf, 0x85, 0x93, 0x2e, 0x77,
+	0x2b, 0x06, 0xb8, 0x77, 0xd3, 0x99, 0x0f, 0x00, 0x44, 0xb6, 0x08, 0x8d, 0x15, 0x13, 0xde, 0xbe,
+	0xcb, 0x3c, 0xb3, 0xad, 0x7b, 0xb4, 0x41, 0xf7, 0x4d, 0xe6, 0x51, 0x37, 0x9a, 0x35, 0x08, 0x9c,
+	0x88, 0x84, 0x63, 0xfe, 0x4c, 0x64, 0x98, 0x35, 0xba, 0x2e, 0xcf, 0xd8, 0x9c, 0xf6, 0x74, 0x63,
+	0xd0, 0x1e, 0xae, 0xca, 0xf4, 0xe1, 0x55, 0xf9, 0x8f, 0x04, 0x8b, 0xc9, 0x73, 0xa1, 0xa4, 0x2d,
+	0x98, 0xdf, 0x33, 0x5d, 0xe6, 0x69, 0x3e, 0xd5, 0x5d, 0xad, 0xe3, 0x9a, 0x2d, 0x91, 0x71, 0xce,
+	0x56, 0xc3, 0x2b, 0x41, 0x35, 0xb8, 0x12, 0x54, 0xf1, 0x4a, 0x50, 0xdd, 0x74, 0x4c, 0x1b, 0xe5,
+	0xcc, 0x71, 0xc3, 0x47, 0x54, 0x77, 0x1f, 0x06, 0x66, 0xa4, 0x0e, 0x6f, 0xd0, 0x67, 0x1e, 0xb5,
+	0x0d, 0x84, 0x29, 0x64, 0x83, 0x29, 0x85, 0x46, 0x21, 0xc6, 0x3a, 0x94, 0x3c, 0xc7, 0xd3, 0x2d,
+	0x84, 0x98, 0xce, 0x06, 0x01, 0xdc, 0x86, 0x23, 0x28, 0xce, 0x61, 0xc1, 0x93, 0xb3, 0x47, 0xb0,
+	0x31, 0x5c, 0xc7, 0xb2, 0xf4, 0x4e, 0x27, 0xd8, 0x35, 0xb8, 0x31, 0xb0, 0x67, 0xcb, 0x48, 0x75,
+	0xf1, 0xf7, 0x61, 0x69, 0xcc, 0x84, 0xe8, 0xe2, 0xeb, 0x30, 0x93, 0xcb, 0xaf, 0xe1, 0x68, 0x65,
+	0x0f, 0x16, 0x1b, 0xf4, 0x80, 0xba, 0x8c, 0x62, 0x94, 0xc0, 0xd3, 0x9a, 0x29, 0xac, 0x05, 0x69,
+	0xed, 0x33, 0xc7, 0x7d, 0x62, 0xda, 0xfb, 0xc3, 0x34, 0x10, 0xca, 0x9a, 0xc3, 0x7e, 0x0c, 0xd0,
+	0xca, 0x6f, 0x0b, 0xb0, 0x34, 0x66, 0x22, 0x14, 0x40, 0x23, 0xdb, 0x3e, 0x38, 0xb0, 0x1f, 0x4e,
+	0x8a, 0x3c, 0x29, 0x60, 0x18, 0x97, 0xa2, 0x79, 0x04, 0xc1, 0xb3, 0x53, 0x96, 0x3d, 0x28, 0x45,
+	0x60, 0x12, 0xb2, 0xcb, 0x4e, 0x3c, 0xbb, 0xdc, 0x3c, 0x1a, 0xe1, 0xae, 0xe5, 0x45, 0x33, 0xcd,
+	0xc7, 0xf0, 0x76, 0xca, 0x48, 0x52, 0x01, 0x68, 0xe9, 0xb6, 0x61, 0x1a, 0xba, 0x37, 0x58, 0x90,
+	0x48, 0xcf, 0x30, 0x0b, 0x14, 0xa2, 0x59, 0xe0, 0x11, 0x5c, 0xe6, 0xc1, 0x86, 0x5f, 0x7e, 0x2c,
+	0xdd, 0x0b, 0x53, 0xdc, 0x8e, 0x8b, 0x52, 0x77, 0x1d, 0x7c, 0x10, 0xab, 0x7e, 0x09, 0x4e, 0xf3,
+	0x1d, 0xab, 0x39, 0xae, 0x36, 0x72, 0x49, 0x98, 0xd3, 0x63, 0xa6, 0xca, 0x47, 0xb0, 0x92, 0x11,
+	0x7a, 0xe2, 0x2d, 0x49, 0xf9, 0x26, 0x94, 0x39, 0x56, 0x1d, 0xef, 0x3a, 0x75, 0x7f, 0x48, 0x69,
+	0x0e, 0x0a, 0x03, 0x83, 0x82, 0x69, 0x28, 0x7b, 0x70, 0x36, 0x61, 0xec, 0x20, 0xde, 0x14, 0x07,
+	0x97, 0x28, 0x3c, 0x10, 0xef, 0xa4, 0xaf, 0xce, 0x00, 0x06, 0x13, 0x80, 0xb8, 0x6e, 0x29, 0xeb,
+	0x78, 0xc5, 0x15, 0x03, 0xd8, 0x43, 0x4b, 0x6f, 0x25, 0x64, 0xad, 0x20, 0x87, 0x87, 0x3d, 0x83,
+	0x74, 0x10, 0x36, 0x15, 0x0f, 0x2e, 0x4e, 0x40, 0x40, 0xd6, 0xf7, 0x01, 0x06, 0xac, 0x45, 0xda,
+	0xca, 0x47, 0xbb, 0x28, 0x68, 0x33, 0xe5, 0x1a, 0x54, 0xe2, 0xb3, 0xd6, 0x47, 0xeb, 0x86, 0x84,
+	0x0c, 0xa0, 0xd8, 0x70, 0x6e, 0xac, 0xd5, 0x71, 0xb0, 0xdc, 0xc2, 0xdd, 0x33, 0x98, 0x6f, 0x67,
+	0x2f, 0xfd, 0x72, 0x30, 0xde, 0xcd, 0x7d, 0xa8, 0x66, 0x85, 0x3a, 0x1e, 0x7f, 0x2f, 0x8e, 0x7a,
+	0x6e, 0x72, 0x46, 0x50, 0x2c, 0x58, 0x1a, 0x63, 0x75, 0x1c, 0x1c, 0x1f, 0x1c, 0xf6, 0x36, 0xde,
+	0x75, 0xb7, 0x4d, 0xfb, 0x09, 0x35, 0x76, 0x9d, 0x86, 0x63, 0x59, 0x1b, 0x9d, 0x8e, 0x20, 0x1d,
+	0x4f, 0x58, 0xd2, 0x48, 0xc2, 0x4a, 0x72, 0xf9, 0x38, 0xbc, 0x63, 0x90, 0x53, 0xfb, 0xdf, 0x12,
+	0xcc, 0xf0, 0xf9, 0xc9, 0xaf, 0x24, 0x38, 0x19, 0x96, 0xcc, 0xe4, 0x4a, 0x86, 0xfa, 0x23, 0x56,
+	0xb1, 0xcb, 0x57, 0x73, 0x58, 0x84, 0x32, 0x94, 0xcb, 0x3f, 0xfa, 0xf3, 0xbf, 0x7f, 0x5e, 0x78,
+	0x87, 0x5c, 0x50, 0x33, 0x7c, 0xb0, 0x20, 0x9f, 0x4b, 0x70, 0x0a, 0xb7, 0x22, 0xc9, 0x32, 0x59,
+	0xfc, 0x9c, 0xca, 0xb5, 0x3c, 0x26, 0x48, 0xf0, 0x26, 0x27, 0xb8, 0x4a, 0xae, 0xaa, 0x99, 0x3e,
+	0x93, 0xa8, 0x3d, 0xf1, 0xd4, 0x27, 0x2f, 0x25, 0x28, 0x8f, 0x2b, 0x9a, 0x49, 0x3d, 0x3b, 0x97,
+	0x71, 0x1f, 0x03, 0xe4, 0xcd, 0x57, 0xc2, 0x40, 0x81, 0x9b, 0x5c, 0xe0, 0x6d, 0xb2, 0x96, 0x2e,
+	0xd0, 0x11, 0x00, 0x9a, 0xa8, 0xf6, 0xa3, 0x52, 0x7f, 0x2d, 0xc1, 0x0c, 0xdf, 0xb0, 0x44, 0xcd,
+	0x5a, 0xb5, 0x0a, 0x11, 0x57, 0xb2, 0x1b, 0x20, 0xe3, 0x55, 0xce, 0x78, 0x85, 0xbc, 0xa7, 0x4e,
+	0xfe, 0xc2, 0xa4, 0xf6, 0xf8, 0x0f, 0x67, 0x78, 0x0a, 0x8f, 0x54, 0xa6, 0xad, 0x13, 0xaf, 0xf1,
+	0xe5, 0x5a, 0x1e, 0x13, 0xe4, 0xb9, 0xc2, 0x79, 0xbe, 0x4b, 0x2e, 0x66, 0xe0, 0x49, 0x19, 0xf9,
+	0x83, 0x04, 0x5f, 0x1b, 0x53, 0x60, 0x92, 0xf7, 0x27, 0x16, 0x8f, 0x29, 0x15, 0xb5, 0x7c, 0xfb,
+	0x88, 0xd6, 0xf9, 0x74, 0x60, 0x95, 0x4a, 0xfe, 0x22, 0xc1, 0x5b, 0xc9, 0xf9, 0x82, 0xac, 0x67,
+	0xdf, 0xb0, 0xc9, 0x59, 0x4b, 0xde, 0x78, 0x05, 0x04, 0x94, 0x73, 0x83, 0xcb, 0xb9, 0x42, 0xaa,
+	0x93, 0x37, 0xbc, 0xa1, 0x35, 0x7d, 0xb5, 0x17, 0x3c, 0xb9, 0x7d, 0xf2, 0x3b, 0x09, 0x8a, 0xc3,
+	0xaf, 0x4b, 0xab, 0x19, 0x88, 0x8c, 0x96, 0xba, 0xf2, 0xb5, 0x7c, 0x46, 0x48, 0x78, 0x8d, 0x13,
+	0xbe, 0x4e, 0x56, 0xd3, 0x09, 0x0f, 0x3f, 0x88, 0xa9, 0x3d, 0x51, 0x50, 0xf7, 0xc9, 0x3f, 0x24,
+	0x58, 0x48, 0xaa, 0x28, 0xc9, 0x84, 0x4b, 0x76, 0x4a, 0xc5, 0x2b, 0xdf, 0x3a, 0x8a, 0x29, 0x8a,
+	0x79, 0xc0, 0xc5, 0xdc, 0x23, 0x1f, 0xa4, 0x8b, 0xa1, 0x88, 0xa1, 0xb9, 0x08, 0x82, 0xd1, 0x95,
+	0x87, 0x1b, 0xb5, 0x27, 0x8a, 0xe9, 0x3e, 0xf9, 0x9b, 0x04, 0x67, 0x12, 0xeb, 0x39, 0x92, 0x93,
+	0x65, 0x2c, 0x28, 0xad, 0x1d, 0xc9, 0x16, 0x25, 0xde, 0xe5, 0x12, 0xbf, 0x43, 0x6e, 0xe7, 0x95,
+	0x18, 0x8f, 0x58, 0x7f, 0x94, 0xe0, 0x4c, 0x62, 0x01, 0x33, 0x49, 0x59, 0x5a, 0x19, 0x2a, 0xaf,
+	0x1d, 0xc9, 0x16, 0x95, 0x5d, 0xe7, 0xca, 0x54, 0xb2, 0x32, 0x29, 0x12, 0x70, 0x10, 0x4d, 0x44,
+	0x84, 0x1f, 0x17, 0xe0, 0xfc, 0xa4, 0xaa, 0x86, 0x7c, 0x94, 0xe1, 0x6c, 0x64, 0xac, 0xba, 0xe4,
+	0xfb, 0xaf, 0x05, 0x0b, 0x45, 0x6f, 0x71, 0xd1, 0x9b, 0x64, 0x23, 0x5d, 0xb4, 0x27, 0xf0, 0x62,
+	0xcb, 0x18, 0xad, 0xfb, 0xfa, 0xe4, 0xf7, 0x12, 0xbc, 0x11, 0x2d, 0xb3, 0xc8, 0x8d, 0x0c, 0x44,
+	0x13, 0x6a, 0x38, 0xf9, 0x5b, 0xb9, 0xed, 0x50, 0xcc, 0x35, 0x2e, 0xa6, 0x4a, 0x2e, 0xa7, 0x8b,
+	0x19, 0x5c, 0x2d, 0xd5, 0x5e, 0xc0, 0xfb, 0xbf, 0x12, 0x94, 0xc7, 0x15, 0x5d, 0x99, 0x6e, 0x32,
+	0x13, 0x6a, 0x3e, 0x79, 0xf3, 0x95, 0x30, 0x50, 0xdb, 0x36, 0xd7, 0xf6, 0x01, 0xb9, 0x93, 0x51,
+	0x1b, 0xd3, 0x3a, 0x1c, 0x29, 0xf8, 0xf6, 0x8e, 0xb5, 0x8f, 0xda, 0xc3, 0x87, 0x3e, 0xf9, 0xab,
+	0x04, 0xe4, 0x70, 0xf1, 0x46, 0xde, 0xcf, 0xc3, 0x74, 0xb4, 0x52, 0x94, 0x6f, 0x1f, 0xd1, 0x3a,
+	0xdf, 0x5d, 0x2d, 0xa2, 0xb0, 0xe9, 0x6b, 0xc3, 0xab, 0x69, 0x78, 0x57, 0xfb, 0x45, 0x01, 0xbe,
+	0x3e, 0xb1, 0xb4, 0x23, 0xf7, 0xf3, 0x30, 0x9d, 0x50, 0x6b, 0xca, 0xdb, 0xaf, 0x07, 0x0c, 0xbd,
+	0xf0, 0x09, 0xf7, 0x42, 0x83, 0x3c, 0xcc, 0xec, 0x05, 0x67, 0x6f, 0xe0, 0x05, 0xa6, 0x89, 0xc4,
+	0x9e, 0xb0, 0xe6, 0x7f, 0x92, 0x60, 0x7e, 0xb4, 0x80, 0x24, 0xb7, 0xf2, 0x90, 0x8f, 0xd7, 0xaa,
+	0xf2, 0xda, 0x91, 0x6c, 0x51, 0xe7, 0x06, 0xd7, 0xb9, 0x46, 0x6e, 0xe6, 0x59, 0xed, 0x78, 0x0e,
+	0xf9, 0x65, 0x7c, 0xad, 0x93, 0x6b, 0xca, 0xbc, 0x6b, 0x9d, 0x5a, 0xe9, 0xca, 0xdb, 0xaf, 0x07,
+	0x0c, 0x7d, 0xf0, 0x29, 0xf7, 0xc1, 0x2e, 0x69, 0xe4, 0x59, 0x6b, 0xf1, 0x9f, 0x9a, 0xc5, 0x41,
+	0x35, 0xcf, 0xd1, 0xb0, 0xd2, 0x56, 0x7b, 0xc3, 0x22, 0xbc, 0x5f, 0xdf, 0xfe, 0xe2, 0x79, 0x45,
+	0xfa, 0xf2, 0x79, 0x45, 0xfa, 0xd7, 0xf3, 0x8a, 0xf4, 0xb3, 0x17, 0x95, 0xa9, 0x2f, 0x5f, 0x54,
+	0xa6, 0xfe, 0xfe, 0xa2, 0x32, 0xf5, 0x69, 0x6d, 0xdf, 0xf4, 0x1e, 0x77, 0x9b, 0xd5, 0x96, 0xd3,
+	0x1e, 0x37, 0xef, 0xc1, 0xaa, 0xfa, 0x4c, 0x44, 0x7e, 0xbf, 0x43, 0x59, 0xf3, 0x24, 0xff, 0x2f,
+	0x7b, 0xf5, 0xff, 0x03, 0x00, 0xad, 0x40, 0xb4, 0x50, 0x16, 0x20, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2053,6 +2153,9 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// DymName queries a Dym-Name by its name.
 	DymName(ctx context.Context, in *QueryDymNameRequest, opts ...grpc.CallOption) (*QueryDymNameResponse, error)
+	// DymNameOwnershipTransfer queries the pending ownership transfer of a
+	// Dym-Name.
+	DymNameOwnershipTransfer(ctx context.Context, in *QueryDymNameOwnershipTransferRequest, opts ...grpc.CallOption) (*QueryDymNameOwnershipTransferResponse, error)
 	// Alias queries the chain_id associated as well as the Sell-Order and
 	// Buy-Order IDs relates to the alias.
 	Alias(ctx context.Context, in *QueryAliasRequest, opts ...grpc.CallOption) (*QueryAliasResponse, error)
@@ -2130,6 +2233,15 @@ func (c *queryClient) DymName(ctx context.Context, in *QueryDymNameRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) DymNameOwnershipTransfer(ctx context.Context, in *QueryDymNameOwnershipTransferRequest, opts ...grpc.CallOption) (*QueryDymNameOwnershipTransferResponse, error) {
+	out := new(QueryDymNameOwnershipTransferResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.dymns.Query/DymNameOwnershipTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Alias(ctx context.Context, in *QueryAliasRequest, opts ...grpc.CallOption) (*QueryAliasResponse, error) {
 	out := new(QueryAliasResponse)
 	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.dymns.Query/Alias", in, out, opts...)
@@ -2271,6 +2383,9 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// DymName queries a Dym-Name by its name.
 	DymName(context.Context, *QueryDymNameRequest) (*QueryDymNameResponse, error)
+	// DymNameOwnershipTransfer queries the pending ownership transfer of a
+	// Dym-Name.
+	DymNameOwnershipTransfer(context.Context, *QueryDymNameOwnershipTransferRequest) (*QueryDymNameOwnershipTransferResponse, error)
 	// Alias queries the chain_id associated as well as the Sell-Order and
 	// Buy-Order IDs relates to the alias.
 	Alias(context.Context, *QueryAliasRequest) (*QueryAliasResponse, error)
@@ -2332,6 +2447,9 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) DymName(ctx context.Context, req *QueryDymNameRequest) (*QueryDymNameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DymName not implemented")
 }
+func (*UnimplementedQueryServer) DymNameOwnershipTransfer(ctx context.Context, req *QueryDymNameOwnershipTransferRequest) (*QueryDymNameOwnershipTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DymNameOwnershipTransfer not implemented")
+}
 func (*UnimplementedQueryServer) Alias(ctx context.Context, req *QueryAliasRequest) (*QueryAliasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Alias not implemented")
 }
@@ -2418,6 +2536,24 @@ func _Query_DymName_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DymNameOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDymNameOwnershipTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DymNameOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.dymns.Query/DymNameOwnershipTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DymNameOwnershipTransfer(ctx, req.(*QueryDymNameOwnershipTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Alias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAliasRequest)
 	if err := dec(in); err != nil {
@@ -2700,6 +2836,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DymName",
 			Handler:    _Query_DymName_Handler,
 		},
+		{
+			MethodName: "DymNameOwnershipTransfer",
+			Handler:    _Query_DymNameOwnershipTransfer_Handler,
+		},
 		{
 			MethodName: "Alias",
 			Handler:    _Query_Alias_Handler,
@@ -2886,6 +3026,71 @@ func (m *QueryDymNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDymNameOwnershipTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDymNameOwnershipTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDymNameOwnershipTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DymName) > 0 {
+		i -= len(m.DymName)
+		copy(dAtA[i:], m.DymName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DymName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDymNameOwnershipTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDymNameOwnershipTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDymNameOwnershipTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Transfer != nil {
+		{
+			size, err := m.Transfer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryAliasRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4120,6 +4325,32 @@ func (m *QueryDymNameResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDymNameOwnershipTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DymName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDymNameOwnershipTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Transfer != nil {
+		l = m.Transfer.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryAliasRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4923,6 +5154,174 @@ func (m *QueryDymNameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDymNameOwnershipTransferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDymNameOwnershipTransferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDymNameOwnershipTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DymName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DymName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDymNameOwnershipTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDymNameOwnershipTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDymNameOwnershipTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transfer == nil {
+				m.Transfer = &DymNameOwnershipTransfer{}
+			}
+			if err := m.Transfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryAliasRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -266,8 +266,9 @@ func (m *MsgRegisterAliasResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRegisterAliasResponse proto.InternalMessageInfo
 
-// MsgTransferDymNameOwnership defines the message used for user to transfer
-// ownership of a Dym-Name.
+// MsgTransferDymNameOwnership defines the message used for user to propose a
+// transfer of ownership of a Dym-Name. The new owner must accept the transfer
+// using MsgAcceptDymNameOwnership.
 type MsgTransferDymNameOwnership struct {
 	// name is the Dym-Name to be transferred ownership.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -371,6 +372,200 @@ func (m *MsgTransferDymNameOwnershipResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgTransferDymNameOwnershipResponse proto.InternalMessageInfo
 
+// MsgAcceptDymNameOwnership defines the message used for user to accept a
+// pending transfer of ownership of a Dym-Name.
+type MsgAcceptDymNameOwnership struct {
+	// name is the Dym-Name to accept ownership of.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// new_owner is the account address of the account which was proposed to be
+	// the new owner of the Dym-Name.
+	NewOwner string `protobuf:"bytes,2,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+}
+
+func (m *MsgAcceptDymNameOwnership) Reset()         { *m = MsgAcceptDymNameOwnership{} }
+func (m *MsgAcceptDymNameOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptDymNameOwnership) ProtoMessage()    {}
+func (*MsgAcceptDymNameOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88dd2f81468013c2, []int{6}
+}
+func (m *MsgAcceptDymNameOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptDymNameOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptDymNameOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptDymNameOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptDymNameOwnership.Merge(m, src)
+}
+func (m *MsgAcceptDymNameOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptDymNameOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptDymNameOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptDymNameOwnership proto.InternalMessageInfo
+
+func (m *MsgAcceptDymNameOwnership) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgAcceptDymNameOwnership) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
+// MsgAcceptDymNameOwnershipResponse defines the response for accepting the
+// name transfer.
+type MsgAcceptDymNameOwnershipResponse struct {
+}
+
+func (m *MsgAcceptDymNameOwnershipResponse) Reset()         { *m = MsgAcceptDymNameOwnershipResponse{} }
+func (m *MsgAcceptDymNameOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptDymNameOwnershipResponse) ProtoMessage()    {}
+func (*MsgAcceptDymNameOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88dd2f81468013c2, []int{7}
+}
+func (m *MsgAcceptDymNameOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptDymNameOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptDymNameOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptDymNameOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptDymNameOwnershipResponse.Merge(m, src)
+}
+func (m *MsgAcceptDymNameOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptDymNameOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptDymNameOwnershipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptDymNameOwnershipResponse proto.InternalMessageInfo
+
+// MsgCancelDymNameOwnershipTransfer defines the message used for user to
+// cancel a pending transfer of ownership of a Dym-Name.
+type MsgCancelDymNameOwnershipTransfer struct {
+	// name is the Dym-Name to cancel the pending transfer of.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// owner is the account address of the account which is currently owner of
+	// the Dym-Name.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *MsgCancelDymNameOwnershipTransfer) Reset()         { *m = MsgCancelDymNameOwnershipTransfer{} }
+func (m *MsgCancelDymNameOwnershipTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDymNameOwnershipTransfer) ProtoMessage()    {}
+func (*MsgCancelDymNameOwnershipTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88dd2f81468013c2, []int{8}
+}
+func (m *MsgCancelDymNameOwnershipTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDymNameOwnershipTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDymNameOwnershipTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelDymNameOwnershipTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDymNameOwnershipTransfer.Merge(m, src)
+}
+func (m *MsgCancelDymNameOwnershipTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDymNameOwnershipTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDymNameOwnershipTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDymNameOwnershipTransfer proto.InternalMessageInfo
+
+func (m *MsgCancelDymNameOwnershipTransfer) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgCancelDymNameOwnershipTransfer) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// MsgCancelDymNameOwnershipTransferResponse defines the response for canceling
+// the name transfer.
+type MsgCancelDymNameOwnershipTransferResponse struct {
+}
+
+func (m *MsgCancelDymNameOwnershipTransferResponse) Reset() {
+	*m = MsgCancelDymNameOwnershipTransferResponse{}
+}
+func (m *MsgCancelDymNameOwnershipTransferResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgCancelDymNameOwnershipTransferResponse) ProtoMessage() {}
+func (*MsgCancelDymNameOwnershipTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88dd2f81468013c2, []int{9}
+}
+func (m *MsgCancelDymNameOwnershipTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDymNameOwnershipTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDymNameOwnershipTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelDymNameOwnershipTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDymNameOwnershipTransferResponse.Merge(m, src)
+}
+func (m *MsgCancelDymNameOwnershipTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDymNameOwnershipTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDymNameOwnershipTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDymNameOwnershipTransferResponse proto.InternalMessageInfo
+
 // MsgSetController defines the message used for user to set a controller for a
 // Dym-Name.
 type MsgSetController struct {
@@ -388,7 +583,7 @@ func (m *MsgSetController) Reset()         { *m = MsgSetController{} }
 func (m *MsgSetController) String() string { return proto.CompactTextString(m) }
 func (*MsgSetController) ProtoMessage()    {}
 func (*MsgSetController) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88dd2f81468013c2, []int{6}
+	return fileDescriptor_88dd2f81468013c2, []int{10}
 }
 func (m *MsgSetController) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -447,7 +642,7 @@ func (m *MsgSetControllerResponse) Reset()         { *m = MsgSetControllerRespon
 func (m *MsgSetControllerResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSetControllerResponse) ProtoMessage()    {}
 func (*MsgSetControllerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88dd2f81468013c2, []int{7}
+	return fileDescriptor_88dd2f81468013c2, []int{11}
 }
 func (m *MsgSetControllerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -497,7 +692,7 @@ func (m *MsgUpdateResolveAddress) Reset()         { *m = MsgUpdateResolveAddress
 func (m *MsgUpdateResolveAddress) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateResolveAddress) ProtoMessage()    {}
 func (*MsgUpdateResolveAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88dd2f81468013c2, []int{8}
+	return fileDescriptor_88dd2f81468013c2, []int{12}
 }
 func (m *MsgUpdateResolveAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -570,7 +765,7 @@ func (m *MsgUpdateResolveAddressResponse) Reset()         { *m = MsgUpdateResolv
 func (m *MsgUpdateResolveAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateResolveAddressResponse) ProtoMessage()    {}
 func (*MsgUpdateResolveAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88dd2f81468013c2, []int{9}
+	return fileDescriptor_88dd2f81468013c2, []int{13}
 }
 func (m *MsgUpdateResolveAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -618,7 +813,7 @@ func (m *MsgUpdateDetails) Reset()         { *m = MsgUpdateDetails{} }
 func (m *MsgUpdateDetails) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateDetails) ProtoMessage()    {}
 func (*MsgUpdateDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88dd2f81468013c2, []int{10}
+	return fileDescriptor_88dd2f81468013c2, []int{14}
 }
 func (m *MsgUpdateDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -683,7 +878,7 @@ func (m *MsgUpdateDetailsResponse) Reset()         { *m = MsgUpdateDetailsRespon
 func (m *MsgUpdateDetailsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateDetailsResponse) ProtoMessage()    {}
 func (*MsgUpdateDetailsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88dd2f81468013c2, []int{11}
+	return fileDescriptor_88dd2f81468013c2, []int{15}
 }
 func (m *MsgUpdateDetailsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -733,7 +928,7 @@ func (m *MsgPlaceSellOrder) Reset()         { *m = MsgPlaceSellOrder{} }
 func (m *MsgPlaceSellOrder) String() string { return proto.CompactTextString(m) }
 func (*MsgPlaceSellOrder) ProtoMessage()    {}
 func (*MsgPlaceSellOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88dd2f81468013c2, []int{12}
+	return fileDescriptor_88dd2f81468013c2, []int{16}
 }
 func (m *MsgPlaceSellOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -805,7 +1000,7 @@ func (m *MsgPlaceSellOrderResponse) Reset()         { *m = MsgPlaceSellOrderResp
 func (m *MsgPlaceSellOrderResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgPlaceSellOrderResponse) ProtoMessage()    {}
 func (*MsgPlaceSellOrderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88dd2f81468013c2, []int{13}
+	return fileDescriptor_88dd2f81468013c2, []int{17}
 }
 func (m *MsgPlaceSellOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -849,7 +1044,7 @@ func (m *MsgCancelSellOrder) Reset()         { *m = MsgCancelSellOrder{} }
 func (m *MsgCancelSellOrder) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelSellOrder) ProtoMessage()    {}
 func (*MsgCancelSellOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88dd2f81468013c2, []int{14}
+	return fileDescriptor_88dd2f81468013c2, []int{18}
 }
 func (m *MsgCancelSellOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -908,7 +1103,7 @@ func (m *MsgCancelSellOrderResponse) Reset()         { *m = MsgCancelSellOrderRe
 func (m *MsgCancelSellOrderResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelSellOrderResponse) ProtoMessage()    {}
 func (*MsgCancelSellOrderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88dd2f81468013c2, []int{15}
+	return fileDescriptor_88dd2f81468013c2, []int{19}
 }
 func (m *MsgCancelSellOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -954,7 +1149,7 @@ func (m *MsgCompleteSellOrder) Reset()         { *m = MsgCompleteSellOrder{} }
 func (m *MsgCompleteSellOrder) String() string { return proto.CompactTextString(m) }
 func (*MsgCompleteSellOrder) ProtoMessage()    {}
 func (*MsgCompleteSellOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88dd2f81468013c2, []int{16}
+	return fileDescriptor_88dd2f81468013c2, []int{20}
 }
 func (m *MsgCompleteSellOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1013,7 +1208,7 @@ func (m *MsgCompleteSellOrderResponse) Reset()         { *m = MsgCompleteSellOrd
 func (m *MsgCompleteSellOrderResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCompleteSellOrderResponse) ProtoMessage()    {}
 func (*MsgCompleteSellOrderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88dd2f81468013c2, []int{17}
+	return fileDescriptor_88dd2f81468013c2, []int{21}
 }
 func (m *MsgCompleteSellOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1065,7 +1260,7 @@ func (m *MsgPurchaseOrder) Reset()         { *m = MsgPurchaseOrder{} }
 func (m *MsgPurchaseOrder) String() string { return proto.CompactTextString(m) }
 func (*MsgPurchaseOrder) ProtoMessage()    {}
 func (*MsgPurchaseOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88dd2f81468013c2, []int{18}
+	return fileDescriptor_88dd2f81468013c2, []int{22}
 }
 func (m *MsgPurchaseOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1137,7 +1332,7 @@ func (m *MsgPurchaseOrderResponse) Reset()         { *m = MsgPurchaseOrderRespon
 func (m *MsgPurchaseOrderResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgPurchaseOrderResponse) ProtoMessage()    {}
 func (*MsgPurchaseOrderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88dd2f81468013c2, []int{19}
+	return fileDescriptor_88dd2f81468013c2, []int{23}
 }
 func (m *MsgPurchaseOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1192,7 +1387,7 @@ func (m *MsgPlaceBuyOrder) Reset()         { *m = MsgPlaceBuyOrder{} }
 func (m *MsgPlaceBuyOrder) String() string { return proto.CompactTextString(m) }
 func (*MsgPlaceBuyOrder) ProtoMessage()    {}
 func (*MsgPlaceBuyOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88dd2f81468013c2, []int{20}
+	return fileDescriptor_88dd2f81468013c2, []int{24}
 }
 func (m *MsgPlaceBuyOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1273,7 +1468,7 @@ func (m *MsgPlaceBuyOrderResponse) Reset()         { *m = MsgPlaceBuyOrderRespon
 func (m *MsgPlaceBuyOrderResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgPlaceBuyOrderResponse) ProtoMessage()    {}
 func (*MsgPlaceBuyOrderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88dd2f81468013c2, []int{21}
+	return fileDescriptor_88dd2f81468013c2, []int{25}
 }
 func (m *MsgPlaceBuyOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1322,7 +1517,7 @@ func (m *MsgCancelBuyOrder) Reset()         { *m = MsgCancelBuyOrder{} }
 func (m *MsgCancelBuyOrder) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelBuyOrder) ProtoMessage()    {}
 func (*MsgCancelBuyOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88dd2f81468013c2, []int{22}
+	return fileDescriptor_88dd2f81468013c2, []int{26}
 }
 func (m *MsgCancelBuyOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1374,7 +1569,7 @@ func (m *MsgCancelBuyOrderResponse) Reset()         { *m = MsgCancelBuyOrderResp
 func (m *MsgCancelBuyOrderResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelBuyOrderResponse) ProtoMessage()    {}
 func (*MsgCancelBuyOrderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88dd2f81468013c2, []int{23}
+	return fileDescriptor_88dd2f81468013c2, []int{27}
 }
 func (m *MsgCancelBuyOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1421,7 +1616,7 @@ func (m *MsgAcceptBuyOrder) Reset()         { *m = MsgAcceptBuyOrder{} }
 func (m *MsgAcceptBuyOrder) String() string { return proto.CompactTextString(m) }
 func (*MsgAcceptBuyOrder) ProtoMessage()    {}
 func (*MsgAcceptBuyOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88dd2f81468013c2, []int{24}
+	return fileDescriptor_88dd2f81468013c2, []int{28}
 }
 func (m *MsgAcceptBuyOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1481,7 +1676,7 @@ func (m *MsgAcceptBuyOrderResponse) Reset()         { *m = MsgAcceptBuyOrderResp
 func (m *MsgAcceptBuyOrderResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAcceptBuyOrderResponse) ProtoMessage()    {}
 func (*MsgAcceptBuyOrderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88dd2f81468013c2, []int{25}
+	return fileDescriptor_88dd2f81468013c2, []int{29}
 }
 func (m *MsgAcceptBuyOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1535,7 +1730,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88dd2f81468013c2, []int{26}
+	return fileDescriptor_88dd2f81468013c2, []int{30}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1599,7 +1794,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88dd2f81468013c2, []int{27}
+	return fileDescriptor_88dd2f81468013c2, []int{31}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1642,7 +1837,7 @@ func (m *MsgMigrateChainIds) Reset()         { *m = MsgMigrateChainIds{} }
 func (m *MsgMigrateChainIds) String() string { return proto.CompactTextString(m) }
 func (*MsgMigrateChainIds) ProtoMessage()    {}
 func (*MsgMigrateChainIds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88dd2f81468013c2, []int{28}
+	return fileDescriptor_88dd2f81468013c2, []int{32}
 }
 func (m *MsgMigrateChainIds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1694,7 +1889,7 @@ func (m *MsgMigrateChainIdsResponse) Reset()         { *m = MsgMigrateChainIdsRe
 func (m *MsgMigrateChainIdsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMigrateChainIdsResponse) ProtoMessage()    {}
 func (*MsgMigrateChainIdsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88dd2f81468013c2, []int{29}
+	return fileDescriptor_88dd2f81468013c2, []int{33}
 }
 func (m *MsgMigrateChainIdsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1739,7 +1934,7 @@ func (m *MsgUpdateAliases) Reset()         { *m = MsgUpdateAliases{} }
 func (m *MsgUpdateAliases) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateAliases) ProtoMessage()    {}
 func (*MsgUpdateAliases) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88dd2f81468013c2, []int{30}
+	return fileDescriptor_88dd2f81468013c2, []int{34}
 }
 func (m *MsgUpdateAliases) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1798,7 +1993,7 @@ func (m *MsgUpdateAliasesResponse) Reset()         { *m = MsgUpdateAliasesRespon
 func (m *MsgUpdateAliasesResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateAliasesResponse) ProtoMessage()    {}
 func (*MsgUpdateAliasesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88dd2f81468013c2, []int{31}
+	return fileDescriptor_88dd2f81468013c2, []int{35}
 }
 func (m *MsgUpdateAliasesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1839,7 +2034,7 @@ func (m *MigrateChainId) Reset()         { *m = MigrateChainId{} }
 func (m *MigrateChainId) String() string { return proto.CompactTextString(m) }
 func (*MigrateChainId) ProtoMessage()    {}
 func (*MigrateChainId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88dd2f81468013c2, []int{32}
+	return fileDescriptor_88dd2f81468013c2, []int{36}
 }
 func (m *MigrateChainId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1895,7 +2090,7 @@ func (m *UpdateAlias) Reset()         { *m = UpdateAlias{} }
 func (m *UpdateAlias) String() string { return proto.CompactTextString(m) }
 func (*UpdateAlias) ProtoMessage()    {}
 func (*UpdateAlias) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88dd2f81468013c2, []int{33}
+	return fileDescriptor_88dd2f81468013c2, []int{37}
 }
 func (m *UpdateAlias) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1945,6 +2140,10 @@ func init() {
 	proto.RegisterType((*MsgRegisterAliasResponse)(nil), "dymensionxyz.dymension.dymns.MsgRegisterAliasResponse")
 	proto.RegisterType((*MsgTransferDymNameOwnership)(nil), "dymensionxyz.dymension.dymns.MsgTransferDymNameOwnership")
 	proto.RegisterType((*MsgTransferDymNameOwnershipResponse)(nil), "dymensionxyz.dymension.dymns.MsgTransferDymNameOwnershipResponse")
+	proto.RegisterType((*MsgAcceptDymNameOwnership)(nil), "dymensionxyz.dymension.dymns.MsgAcceptDymNameOwnership")
+	proto.RegisterType((*MsgAcceptDymNameOwnershipResponse)(nil), "dymensionxyz.dymension.dymns.MsgAcceptDymNameOwnershipResponse")
+	proto.RegisterType((*MsgCancelDymNameOwnershipTransfer)(nil), "dymensionxyz.dymension.dymns.MsgCancelDymNameOwnershipTransfer")
+	proto.RegisterType((*MsgCancelDymNameOwnershipTransferResponse)(nil), "dymensionxyz.dymension.dymns.MsgCancelDymNameOwnershipTransferResponse")
 	proto.RegisterType((*MsgSetController)(nil), "dymensionxyz.dymension.dymns.MsgSetController")
 	proto.RegisterType((*MsgSetControllerResponse)(nil), "dymensionxyz.dymension.dymns.MsgSetControllerResponse")
 	proto.RegisterType((*MsgUpdateResolveAddress)(nil), "dymensionxyz.dymension.dymns.MsgUpdateResolveAddress")
@@ -1980,105 +2179,110 @@ func init() {
 }
 
 var fileDescriptor_88dd2f81468013c2 = []byte{
-	// 1562 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x5d, 0x6f, 0x1b, 0x45,
-	0x17, 0xf6, 0xda, 0x49, 0x6a, 0x9f, 0xa4, 0x71, 0xb2, 0x8a, 0xde, 0x3a, 0xdb, 0xbe, 0xae, 0x71,
-	0x55, 0x91, 0x46, 0xd4, 0xa6, 0xa9, 0xd2, 0x96, 0x08, 0x2a, 0x25, 0xa9, 0x80, 0x48, 0x98, 0x5a,
-	0x4e, 0xe0, 0x82, 0x0b, 0xac, 0xf1, 0xee, 0xc4, 0x59, 0xe1, 0xfd, 0xd0, 0xce, 0xda, 0xae, 0x11,
-	0x08, 0x84, 0xc4, 0x0d, 0x17, 0xa8, 0xe2, 0x02, 0x09, 0xfe, 0x03, 0x52, 0x05, 0xfc, 0x07, 0x7a,
-	0x59, 0x71, 0xd5, 0x2b, 0x84, 0x5a, 0x89, 0xfe, 0x0d, 0x34, 0x1f, 0x5e, 0xef, 0x6c, 0xec, 0xec,
-	0xda, 0x42, 0x85, 0xab, 0x78, 0x66, 0xce, 0xc7, 0x73, 0x9e, 0x39, 0x73, 0xf6, 0x9c, 0xc0, 0x55,
-	0x63, 0x60, 0x61, 0x9b, 0x98, 0x8e, 0xfd, 0x60, 0xf0, 0x69, 0x35, 0x58, 0xd0, 0x5f, 0x36, 0xa9,
-	0xfa, 0x0f, 0x2a, 0xae, 0xe7, 0xf8, 0x8e, 0x7a, 0x29, 0x2c, 0x56, 0x09, 0x16, 0x15, 0x26, 0xa6,
-	0xad, 0xb5, 0x9d, 0xb6, 0xc3, 0x04, 0xab, 0xf4, 0x17, 0xd7, 0xd1, 0x8a, 0xba, 0x43, 0x2c, 0x87,
-	0x54, 0x5b, 0x88, 0xe0, 0x6a, 0xef, 0x46, 0x0b, 0xfb, 0xe8, 0x46, 0x55, 0x77, 0x4c, 0x5b, 0x9c,
-	0x5f, 0x10, 0xe7, 0x16, 0x69, 0x57, 0x7b, 0x37, 0xe8, 0x1f, 0x71, 0xb0, 0xce, 0x0f, 0x9a, 0xdc,
-	0x22, 0x5f, 0x88, 0xa3, 0x6b, 0x67, 0xc2, 0xb5, 0x90, 0xf7, 0x09, 0xf6, 0x13, 0x89, 0xba, 0xc8,
-	0x43, 0x96, 0xb0, 0x5a, 0xfe, 0x4d, 0x81, 0x7c, 0x8d, 0xb4, 0x1b, 0xb8, 0x6d, 0x12, 0x1f, 0x7b,
-	0xef, 0x23, 0x0b, 0xab, 0x2a, 0xcc, 0xd9, 0xc8, 0xc2, 0x05, 0xa5, 0xa4, 0x6c, 0xe4, 0x1a, 0xec,
-	0xb7, 0xba, 0x06, 0xf3, 0x4e, 0xdf, 0xc6, 0x5e, 0x21, 0xcd, 0x36, 0xf9, 0x42, 0xd5, 0x20, 0x6b,
-	0x74, 0x3d, 0xe4, 0x9b, 0x8e, 0x5d, 0xc8, 0x94, 0x94, 0x8d, 0x4c, 0x23, 0x58, 0xab, 0xef, 0x42,
-	0x5e, 0x77, 0xec, 0x63, 0xd3, 0xb3, 0x9a, 0x2e, 0xa2, 0x10, 0xfc, 0xc2, 0x5c, 0x49, 0xd9, 0x58,
-	0xdc, 0x5a, 0xaf, 0x88, 0xb8, 0x28, 0x3b, 0x15, 0xc1, 0x4e, 0x65, 0xdf, 0x31, 0xed, 0xbd, 0xb9,
-	0xc7, 0x7f, 0x5c, 0x4e, 0x35, 0x96, 0x85, 0x5e, 0x9d, 0xab, 0xa9, 0x05, 0x38, 0xa7, 0x3b, 0xb6,
-	0x8f, 0x74, 0xbf, 0x30, 0xcf, 0xbc, 0x0f, 0x97, 0x3b, 0xf0, 0xd5, 0x8b, 0x47, 0x9b, 0x1c, 0x4b,
-	0x79, 0x1d, 0x2e, 0x44, 0x02, 0x69, 0x60, 0xe2, 0x3a, 0x36, 0xc1, 0xe5, 0x5f, 0x14, 0x58, 0x09,
-	0x9d, 0xed, 0x76, 0x4c, 0x44, 0x68, 0x44, 0x88, 0xfe, 0x10, 0x61, 0xf2, 0x85, 0xfa, 0x7f, 0x00,
-	0xcf, 0xe9, 0x74, 0x90, 0xeb, 0x36, 0x4d, 0x43, 0x04, 0x9b, 0x13, 0x3b, 0x07, 0xc6, 0x88, 0x86,
-	0x4c, 0x98, 0x86, 0x7f, 0x2c, 0x54, 0x29, 0x20, 0x0d, 0x0a, 0x51, 0xd0, 0x41, 0x44, 0x2e, 0x5c,
-	0xac, 0x91, 0xf6, 0x91, 0x87, 0x6c, 0x72, 0x8c, 0xbd, 0x7b, 0x03, 0x8b, 0xc6, 0x7b, 0x9f, 0xaa,
-	0x91, 0x13, 0xd3, 0x9d, 0xe2, 0x06, 0x2f, 0x42, 0xce, 0xc6, 0xfd, 0x66, 0x38, 0xa8, 0xac, 0x8d,
-	0xfb, 0xcc, 0x94, 0x84, 0xe6, 0x2a, 0x5c, 0x39, 0xc3, 0x63, 0x00, 0xec, 0x84, 0x31, 0x7d, 0x88,
-	0xfd, 0x7d, 0xc7, 0xf6, 0x29, 0x6f, 0xd8, 0x9b, 0x02, 0x4d, 0x11, 0x40, 0x0f, 0xf4, 0x04, 0x9c,
-	0xd0, 0xce, 0x18, 0x7a, 0x24, 0x4f, 0xe1, 0x0b, 0xa7, 0xc9, 0xf0, 0x81, 0x6b, 0x20, 0x9f, 0xa6,
-	0x81, 0xd3, 0xe9, 0xe1, 0x5d, 0xc3, 0xf0, 0x30, 0x21, 0x63, 0xd1, 0xc8, 0x7e, 0xd3, 0x51, 0xbf,
-	0xea, 0x3a, 0x64, 0xf5, 0x13, 0x64, 0xda, 0x34, 0x27, 0x32, 0x22, 0x05, 0xe9, 0xfa, 0xc0, 0xa0,
-	0x47, 0xa4, 0xdb, 0x6a, 0x32, 0x93, 0x73, 0xfc, 0x88, 0x74, 0x5b, 0xec, 0x1d, 0xd1, 0x5c, 0xe2,
-	0xbe, 0x9b, 0xbe, 0x23, 0x52, 0x37, 0x27, 0x76, 0x8e, 0x9c, 0x9d, 0x3c, 0x0d, 0x26, 0xe4, 0xa5,
-	0xfc, 0x0a, 0x5c, 0x9e, 0x00, 0x3a, 0x08, 0xec, 0x7b, 0x9e, 0xc9, 0x5c, 0xe6, 0x1e, 0xf6, 0x91,
-	0xd9, 0x99, 0x2d, 0xa2, 0xd0, 0x9b, 0xca, 0x48, 0x6f, 0x4a, 0xbd, 0x02, 0xe7, 0xf5, 0x0e, 0x46,
-	0x5e, 0x93, 0xa5, 0x66, 0x9b, 0xb0, 0xa8, 0xb2, 0x8d, 0x25, 0xb6, 0xb9, 0xcf, 0xf7, 0x4e, 0x63,
-	0xe7, 0xb7, 0x21, 0xe1, 0x0a, 0x40, 0x3f, 0x4c, 0xc3, 0x6a, 0x8d, 0xb4, 0xeb, 0x1d, 0xa4, 0xe3,
-	0x43, 0xdc, 0xe9, 0xdc, 0xf7, 0x0c, 0xce, 0x29, 0x22, 0x04, 0xfb, 0x94, 0x53, 0x8e, 0xfc, 0x1c,
-	0x5b, 0x1f, 0x18, 0xea, 0xdb, 0x00, 0xfc, 0xc8, 0x1f, 0xb8, 0x98, 0x81, 0x5f, 0xde, 0x7a, 0xb5,
-	0x72, 0x56, 0x1d, 0xae, 0xec, 0x52, 0xf9, 0xa3, 0x81, 0x8b, 0x1b, 0x39, 0x34, 0xfc, 0x39, 0xe1,
-	0xb5, 0xbe, 0x09, 0x39, 0xcb, 0xb4, 0x9b, 0xae, 0x67, 0xea, 0x38, 0xe9, 0x3b, 0xcd, 0x5a, 0xa6,
-	0x5d, 0xa7, 0x0a, 0xea, 0x1d, 0x00, 0x82, 0x3b, 0x1d, 0xa1, 0x3e, 0x1f, 0xa3, 0xde, 0xc8, 0x51,
-	0x61, 0xa6, 0x29, 0x25, 0xef, 0x45, 0x58, 0x3f, 0xc5, 0x48, 0xc0, 0xd7, 0x0f, 0x0a, 0xa8, 0x35,
-	0xd2, 0xde, 0x47, 0xb6, 0x8e, 0x3b, 0xff, 0x3e, 0x61, 0x12, 0xf0, 0x4b, 0xa0, 0x9d, 0x86, 0x16,
-	0x20, 0xff, 0x49, 0x81, 0x35, 0x7a, 0xec, 0x58, 0x6e, 0x07, 0xfb, 0x2f, 0xf7, 0xb2, 0x4b, 0xb0,
-	0xe8, 0x22, 0xcf, 0x37, 0x75, 0xd3, 0x45, 0xf6, 0x30, 0xab, 0xc3, 0x5b, 0x3b, 0x2b, 0x34, 0x8e,
-	0xf0, 0x4e, 0xb9, 0x08, 0x97, 0xc6, 0xc1, 0x0d, 0xe2, 0xf9, 0x8b, 0x3f, 0xb7, 0x7a, 0xd7, 0xd3,
-	0x4f, 0x10, 0xc1, 0x2f, 0x2d, 0x96, 0xff, 0xc1, 0x02, 0xff, 0x4a, 0x17, 0x32, 0xa5, 0xcc, 0x46,
-	0xae, 0x21, 0x56, 0xf4, 0x7e, 0x5a, 0xdd, 0x01, 0xf6, 0x44, 0xa5, 0xe1, 0x0b, 0x75, 0x1b, 0xe6,
-	0x9d, 0xe3, 0x63, 0xec, 0xc5, 0x66, 0xa3, 0x48, 0x66, 0x2e, 0x2d, 0xae, 0x95, 0x99, 0x10, 0xcf,
-	0x57, 0x8a, 0x33, 0x20, 0xe1, 0xbb, 0x34, 0x27, 0x81, 0x26, 0xeb, 0x5e, 0x77, 0xf0, 0x1f, 0x25,
-	0x61, 0x13, 0x56, 0x69, 0x39, 0x32, 0xed, 0x2e, 0x6e, 0x3a, 0x14, 0x22, 0x45, 0xc6, 0x6b, 0x6e,
-	0x7e, 0x78, 0xc0, 0xa0, 0x1f, 0x18, 0x23, 0xc2, 0x16, 0x66, 0x26, 0x6c, 0x9b, 0x13, 0x16, 0xe6,
-	0x64, 0x48, 0x18, 0xe5, 0x26, 0x40, 0x20, 0xb8, 0x71, 0xb8, 0xe7, 0x72, 0x9d, 0x55, 0x42, 0xfe,
-	0x7c, 0xc2, 0x5c, 0x4e, 0x90, 0x1f, 0xc5, 0x9a, 0x0e, 0xc5, 0x2a, 0x01, 0xe1, 0x95, 0x44, 0xb6,
-	0x38, 0xaa, 0xbc, 0x0a, 0xf3, 0xb7, 0xab, 0xeb, 0xd8, 0xf5, 0x13, 0xfa, 0x1b, 0xf3, 0x59, 0xbe,
-	0x0b, 0x40, 0x2b, 0x26, 0x62, 0x66, 0xd8, 0xcb, 0x4a, 0x40, 0x1a, 0x2d, 0xb2, 0xdc, 0xb1, 0x54,
-	0x40, 0x6e, 0x33, 0xbc, 0x32, 0xa2, 0x80, 0x39, 0x0d, 0xb2, 0xdc, 0x09, 0xe6, 0xc8, 0xb2, 0x8d,
-	0x60, 0x5d, 0x7e, 0x9a, 0x66, 0x9d, 0x2a, 0xff, 0xc4, 0xd4, 0x79, 0x2a, 0xdc, 0x82, 0x1c, 0xea,
-	0xfa, 0x27, 0x8e, 0x67, 0xfa, 0x03, 0x1e, 0xca, 0x5e, 0xe1, 0xf7, 0x5f, 0xaf, 0xaf, 0x09, 0x68,
-	0xe2, 0xeb, 0x79, 0xe8, 0x7b, 0xa6, 0xdd, 0x6e, 0x8c, 0x44, 0xd5, 0x43, 0x58, 0xa1, 0x5d, 0x0f,
-	0xab, 0xe1, 0x4d, 0x91, 0x64, 0x69, 0x16, 0xd6, 0xb5, 0xb3, 0x13, 0x95, 0x55, 0x72, 0xee, 0xbc,
-	0xb1, 0x6c, 0xe3, 0x7e, 0x68, 0xad, 0x7e, 0x08, 0xab, 0xd4, 0x28, 0x6b, 0x0c, 0x48, 0x33, 0x48,
-	0x5d, 0x6a, 0x75, 0xf3, 0x6c, 0xab, 0xfb, 0x4c, 0x45, 0x98, 0xcd, 0xdb, 0xb8, 0x1f, 0xde, 0x50,
-	0xeb, 0x40, 0xb7, 0x9a, 0x96, 0x49, 0xf4, 0xa1, 0x55, 0xfe, 0xd5, 0xda, 0x38, 0xdb, 0x6a, 0xcd,
-	0x24, 0xba, 0xb0, 0x79, 0xde, 0xc6, 0xfd, 0xd1, 0x72, 0x67, 0x99, 0xde, 0xc7, 0x88, 0x0e, 0xd1,
-	0x3a, 0x87, 0x99, 0x0d, 0x32, 0xe8, 0x67, 0xfe, 0x2d, 0xaa, 0x99, 0x6d, 0x0f, 0xf9, 0x78, 0x9f,
-	0x37, 0x3d, 0xb3, 0x13, 0x7f, 0x04, 0x8b, 0x1e, 0x76, 0xe9, 0xab, 0x61, 0x5d, 0x72, 0xba, 0x94,
-	0xd9, 0x58, 0xdc, 0x7a, 0x2d, 0x2e, 0x8e, 0xb0, 0x6f, 0x91, 0x5d, 0x61, 0x33, 0xa7, 0xe2, 0xe1,
-	0x1f, 0xa9, 0x08, 0xe6, 0x68, 0x51, 0xe7, 0xe1, 0xb2, 0xb6, 0x1a, 0xcf, 0x1e, 0xd0, 0x2e, 0x64,
-	0x90, 0x61, 0x88, 0x40, 0x62, 0x92, 0x27, 0xe4, 0x51, 0x44, 0x41, 0x75, 0xd5, 0x77, 0x60, 0xc1,
-	0xc3, 0x96, 0xd3, 0xc3, 0xac, 0xce, 0xcd, 0x60, 0x45, 0xa8, 0x9f, 0xa2, 0x21, 0xdc, 0x93, 0x89,
-	0x38, 0x03, 0x12, 0x3e, 0x86, 0x65, 0x99, 0x1f, 0x5a, 0x40, 0x5d, 0x0f, 0xf7, 0x4c, 0xa7, 0x4b,
-	0x9a, 0x41, 0xb3, 0xcb, 0xcb, 0x43, 0x7e, 0x78, 0x30, 0x94, 0x2d, 0xc1, 0x52, 0x90, 0xea, 0xa3,
-	0x39, 0x09, 0x86, 0x99, 0x7b, 0x60, 0x94, 0xef, 0xc2, 0x62, 0xc8, 0xb1, 0xd4, 0x40, 0x2b, 0x72,
-	0x03, 0x1d, 0xcc, 0x61, 0xe9, 0xd0, 0x1c, 0xb6, 0xf5, 0xcd, 0x0a, 0x64, 0x6a, 0xa4, 0xad, 0xf6,
-	0x60, 0x49, 0x9a, 0x4d, 0xaf, 0xc7, 0xe4, 0x8a, 0x3c, 0x01, 0x6a, 0xdb, 0x53, 0x89, 0x07, 0xec,
-	0xa4, 0xd4, 0x01, 0x9c, 0x97, 0xc7, 0xc5, 0x4a, 0x62, 0x4b, 0x4c, 0x5e, 0xbb, 0x35, 0x9d, 0x7c,
-	0xc8, 0xf5, 0x8f, 0x0a, 0x14, 0x26, 0x4e, 0x76, 0x6f, 0xc4, 0x9a, 0x9d, 0xa4, 0xaa, 0xed, 0xce,
-	0xac, 0x2a, 0xf3, 0x22, 0x0f, 0x77, 0xf1, 0xbc, 0x48, 0xf2, 0x09, 0x78, 0x19, 0x3f, 0xd2, 0xa5,
-	0xd4, 0x6f, 0x15, 0x58, 0x1b, 0x3b, 0xd1, 0xc5, 0x5f, 0xf2, 0x38, 0x35, 0xed, 0xad, 0x99, 0xd4,
-	0x64, 0x2e, 0xe4, 0x41, 0xac, 0x92, 0xd0, 0xa2, 0x90, 0x4f, 0xc0, 0xc5, 0xf8, 0x81, 0x2a, 0xa5,
-	0x7e, 0x06, 0xcb, 0x91, 0x71, 0xaa, 0x1a, 0x6b, 0x4b, 0x56, 0xd0, 0x6e, 0x4f, 0xa9, 0x10, 0xf2,
-	0xfe, 0x05, 0xe4, 0xa3, 0xc3, 0xc9, 0xeb, 0xb1, 0xd6, 0x22, 0x1a, 0xda, 0x9d, 0x69, 0x35, 0x42,
-	0x00, 0xbe, 0x56, 0x60, 0xf5, 0xf4, 0x90, 0xb1, 0x15, 0x6f, 0x31, 0xaa, 0xa3, 0xed, 0x4c, 0xaf,
-	0x23, 0x67, 0x80, 0x3c, 0x1b, 0xc4, 0x67, 0x80, 0x24, 0x9f, 0x20, 0x03, 0xc6, 0xf7, 0xe4, 0xdc,
-	0xb5, 0xd4, 0x91, 0x57, 0x92, 0xdd, 0xe7, 0x50, 0x3e, 0x89, 0xeb, 0x71, 0xdd, 0x2d, 0x4f, 0xbe,
-	0x48, 0x07, 0x5b, 0x4d, 0x78, 0x97, 0x81, 0xf3, 0xdb, 0x53, 0x2a, 0xc8, 0xde, 0x23, 0xfd, 0x6c,
-	0xbc, 0x77, 0x59, 0x21, 0x81, 0xf7, 0xf1, 0xfd, 0x69, 0x39, 0xa5, 0xfa, 0xb0, 0x24, 0x75, 0xa0,
-	0xd7, 0x13, 0x3e, 0x61, 0x2e, 0xae, 0x6d, 0x4f, 0x25, 0x1e, 0xf4, 0xc5, 0x9f, 0x43, 0x3e, 0xda,
-	0x81, 0xc5, 0x3f, 0xb8, 0x88, 0x46, 0x82, 0x07, 0x37, 0xa1, 0x63, 0x52, 0xfb, 0xc3, 0x42, 0x37,
-	0xec, 0x96, 0x92, 0x16, 0x3a, 0x21, 0x9f, 0xb8, 0xd0, 0x45, 0xba, 0x14, 0x6d, 0xfe, 0xcb, 0x17,
-	0x8f, 0x36, 0x95, 0xbd, 0xf7, 0x1e, 0x3f, 0x2b, 0x2a, 0x4f, 0x9e, 0x15, 0x95, 0x3f, 0x9f, 0x15,
-	0x95, 0x87, 0xcf, 0x8b, 0xa9, 0x27, 0xcf, 0x8b, 0xa9, 0xa7, 0xcf, 0x8b, 0xa9, 0x8f, 0xb6, 0xda,
-	0xa6, 0x7f, 0xd2, 0x6d, 0x55, 0x74, 0xc7, 0xaa, 0x4e, 0xf8, 0xa7, 0x77, 0xef, 0x66, 0xf5, 0xc1,
-	0xf0, 0x7f, 0xfa, 0x03, 0x17, 0x93, 0xd6, 0x02, 0xfb, 0xcf, 0xf7, 0xcd, 0xbf, 0x03, 0x00, 0x00,
-	0xff, 0xff, 0xcf, 0x8a, 0x22, 0xda, 0x00, 0x18, 0x00, 0x00,
+	// 1633 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x5f, 0x6f, 0x1b, 0xc5,
+	0x16, 0xcf, 0xda, 0x49, 0x1a, 0x9f, 0xa4, 0x71, 0xb2, 0x8a, 0x5a, 0x67, 0xdb, 0xeb, 0xa6, 0xae,
+	0xaa, 0x9b, 0xe6, 0xde, 0xda, 0x34, 0x55, 0x92, 0x12, 0x41, 0x51, 0x92, 0x8a, 0x12, 0x89, 0xd0,
+	0xc8, 0x09, 0x48, 0x20, 0x84, 0x35, 0xd9, 0x9d, 0x38, 0x2b, 0xbc, 0x7f, 0xb4, 0xb3, 0x4e, 0x6a,
+	0x04, 0x02, 0x21, 0xf1, 0x8a, 0x2a, 0x84, 0x90, 0xe0, 0x33, 0x80, 0x54, 0x01, 0xdf, 0x81, 0x3e,
+	0x56, 0x3c, 0xf5, 0x09, 0xa1, 0x56, 0xa2, 0x5f, 0x03, 0xcd, 0xce, 0xec, 0x78, 0x67, 0x63, 0x7b,
+	0x77, 0x0d, 0x2a, 0x3c, 0x79, 0x67, 0xe6, 0xfc, 0xf9, 0x9d, 0xdf, 0x9e, 0x73, 0x76, 0x8e, 0x0c,
+	0x57, 0x8d, 0x8e, 0x85, 0x6d, 0x62, 0x3a, 0xf6, 0xfd, 0xce, 0x47, 0x35, 0xb1, 0xa0, 0x4f, 0x36,
+	0xa9, 0xf9, 0xf7, 0xab, 0xae, 0xe7, 0xf8, 0x8e, 0x7a, 0x31, 0x2a, 0x56, 0x15, 0x8b, 0x6a, 0x20,
+	0xa6, 0xcd, 0x35, 0x9d, 0xa6, 0x13, 0x08, 0xd6, 0xe8, 0x13, 0xd3, 0xd1, 0xca, 0xba, 0x43, 0x2c,
+	0x87, 0xd4, 0x0e, 0x10, 0xc1, 0xb5, 0xe3, 0x1b, 0x07, 0xd8, 0x47, 0x37, 0x6a, 0xba, 0x63, 0xda,
+	0xfc, 0xfc, 0x3c, 0x3f, 0xb7, 0x48, 0xb3, 0x76, 0x7c, 0x83, 0xfe, 0xf0, 0x83, 0x79, 0x76, 0xd0,
+	0x60, 0x16, 0xd9, 0x82, 0x1f, 0x5d, 0x1b, 0x08, 0xd7, 0x42, 0xde, 0x87, 0xd8, 0x4f, 0x25, 0xea,
+	0x22, 0x0f, 0x59, 0xdc, 0x6a, 0xe5, 0x17, 0x05, 0x8a, 0x3b, 0xa4, 0x59, 0xc7, 0x4d, 0x93, 0xf8,
+	0xd8, 0x7b, 0x0b, 0x59, 0x58, 0x55, 0x61, 0xd4, 0x46, 0x16, 0x2e, 0x29, 0x0b, 0xca, 0x62, 0xa1,
+	0x1e, 0x3c, 0xab, 0x73, 0x30, 0xe6, 0x9c, 0xd8, 0xd8, 0x2b, 0xe5, 0x82, 0x4d, 0xb6, 0x50, 0x35,
+	0x98, 0x30, 0xda, 0x1e, 0xf2, 0x4d, 0xc7, 0x2e, 0xe5, 0x17, 0x94, 0xc5, 0x7c, 0x5d, 0xac, 0xd5,
+	0x37, 0xa0, 0xa8, 0x3b, 0xf6, 0xa1, 0xe9, 0x59, 0x0d, 0x17, 0x51, 0x08, 0x7e, 0x69, 0x74, 0x41,
+	0x59, 0x9c, 0x5c, 0x9e, 0xaf, 0xf2, 0xb8, 0x28, 0x3b, 0x55, 0xce, 0x4e, 0x75, 0xcb, 0x31, 0xed,
+	0xcd, 0xd1, 0x47, 0xbf, 0x5d, 0x1a, 0xa9, 0x4f, 0x73, 0xbd, 0x5d, 0xa6, 0xa6, 0x96, 0xe0, 0x8c,
+	0xee, 0xd8, 0x3e, 0xd2, 0xfd, 0xd2, 0x58, 0xe0, 0x3d, 0x5c, 0xae, 0xc3, 0xe7, 0xcf, 0x1f, 0x2e,
+	0x31, 0x2c, 0x95, 0x79, 0x38, 0x1f, 0x0b, 0xa4, 0x8e, 0x89, 0xeb, 0xd8, 0x04, 0x57, 0x7e, 0x52,
+	0x60, 0x26, 0x72, 0xb6, 0xd1, 0x32, 0x11, 0xa1, 0x11, 0x21, 0xfa, 0xc0, 0xc3, 0x64, 0x0b, 0xf5,
+	0x3f, 0x00, 0x9e, 0xd3, 0x6a, 0x21, 0xd7, 0x6d, 0x98, 0x06, 0x0f, 0xb6, 0xc0, 0x77, 0xb6, 0x8d,
+	0x2e, 0x0d, 0xf9, 0x28, 0x0d, 0x7f, 0x5b, 0xa8, 0x52, 0x40, 0x1a, 0x94, 0xe2, 0xa0, 0x45, 0x44,
+	0x2e, 0x5c, 0xd8, 0x21, 0xcd, 0x7d, 0x0f, 0xd9, 0xe4, 0x10, 0x7b, 0x77, 0x3a, 0x16, 0x8d, 0xf7,
+	0x1e, 0x55, 0x23, 0x47, 0xa6, 0x9b, 0xe1, 0x0d, 0x5e, 0x80, 0x82, 0x8d, 0x4f, 0x1a, 0xd1, 0xa0,
+	0x26, 0x6c, 0x7c, 0x12, 0x98, 0x92, 0xd0, 0x5c, 0x85, 0x2b, 0x03, 0x3c, 0x0a, 0x60, 0xef, 0xc3,
+	0xfc, 0x0e, 0x69, 0x6e, 0xe8, 0x3a, 0x76, 0xfd, 0x54, 0xb0, 0x24, 0x00, 0xb9, 0x18, 0x80, 0x69,
+	0x0a, 0xa0, 0x7b, 0x5e, 0xb9, 0x02, 0x97, 0xfb, 0x5a, 0x17, 0x10, 0xde, 0x0d, 0x84, 0xb6, 0x90,
+	0xad, 0xe3, 0x56, 0x5c, 0x28, 0xc4, 0x9f, 0x9e, 0x21, 0x89, 0x84, 0xff, 0xc1, 0xb5, 0x44, 0xd3,
+	0x02, 0xc7, 0x51, 0x90, 0x74, 0x7b, 0xd8, 0xdf, 0x72, 0x6c, 0x9f, 0xa6, 0x50, 0x16, 0xb7, 0x6a,
+	0x19, 0x40, 0x17, 0x7a, 0xfc, 0xcd, 0x44, 0x76, 0x7a, 0x64, 0x8a, 0xe4, 0x29, 0x9a, 0xfb, 0xb4,
+	0x2e, 0xde, 0x76, 0x0d, 0xe4, 0xd3, 0x8a, 0x70, 0x5a, 0xc7, 0x78, 0xc3, 0x30, 0x3c, 0x4c, 0x48,
+	0x4f, 0x34, 0xb2, 0xdf, 0x5c, 0xdc, 0xaf, 0x3a, 0x0f, 0x13, 0xfa, 0x11, 0x32, 0x6d, 0x5a, 0x1e,
+	0x79, 0x5e, 0x8d, 0x74, 0xbd, 0x6d, 0xd0, 0x23, 0xd2, 0x3e, 0x68, 0x04, 0x26, 0x47, 0xd9, 0x11,
+	0x69, 0x1f, 0x04, 0x2d, 0x85, 0x96, 0x15, 0xf3, 0xdd, 0xf0, 0x1d, 0x5e, 0xc5, 0x05, 0xbe, 0xb3,
+	0xef, 0xac, 0x17, 0x69, 0x30, 0x11, 0x2f, 0x95, 0xcb, 0x70, 0xa9, 0x0f, 0x68, 0x11, 0xd8, 0x37,
+	0xac, 0xa8, 0x99, 0xcc, 0x1d, 0xec, 0x23, 0xb3, 0x35, 0x5c, 0x44, 0x91, 0xf6, 0x92, 0x97, 0xda,
+	0x8b, 0x7a, 0x05, 0xce, 0xea, 0x2d, 0x8c, 0xbc, 0x46, 0x50, 0xa5, 0x4d, 0x12, 0x44, 0x35, 0x51,
+	0x9f, 0x0a, 0x36, 0xb7, 0xd8, 0xde, 0x69, 0xec, 0xec, 0x6d, 0x48, 0xb8, 0x04, 0xe8, 0x07, 0x39,
+	0x98, 0xdd, 0x21, 0xcd, 0xdd, 0x16, 0xd2, 0xf1, 0x1e, 0x6e, 0xb5, 0xee, 0x79, 0x06, 0xe3, 0x14,
+	0x11, 0x82, 0x7d, 0xca, 0x29, 0x43, 0x7e, 0x26, 0x58, 0x6f, 0x1b, 0xea, 0xeb, 0x00, 0xec, 0xc8,
+	0xef, 0xb8, 0x38, 0x00, 0x3f, 0xbd, 0xfc, 0xdf, 0xea, 0xa0, 0x4f, 0x52, 0x75, 0x83, 0xca, 0xef,
+	0x77, 0x5c, 0x5c, 0x2f, 0xa0, 0xf0, 0xb1, 0x4f, 0xe3, 0x7a, 0x05, 0x0a, 0x96, 0x69, 0x37, 0x5c,
+	0xcf, 0xd4, 0x71, 0xda, 0x96, 0x35, 0x61, 0x99, 0xf6, 0x2e, 0x55, 0x50, 0x6f, 0x01, 0x10, 0xdc,
+	0x6a, 0x71, 0xf5, 0xb1, 0x04, 0xf5, 0x7a, 0x81, 0x0a, 0x07, 0x9a, 0x52, 0xf2, 0x5e, 0x80, 0xf9,
+	0x53, 0x8c, 0x08, 0xbe, 0xbe, 0x55, 0x40, 0x15, 0x15, 0xf7, 0xcf, 0x13, 0x26, 0x01, 0xbf, 0x08,
+	0xda, 0x69, 0x68, 0x02, 0xf9, 0x0f, 0x0a, 0xcc, 0xd1, 0x63, 0xc7, 0x72, 0x5b, 0xd8, 0x7f, 0xb1,
+	0x2f, 0x7b, 0x01, 0x26, 0x5d, 0xe4, 0xf9, 0xa6, 0x6e, 0xba, 0xc8, 0x0e, 0xb3, 0x3a, 0xba, 0xb5,
+	0x3e, 0x43, 0xe3, 0x88, 0xee, 0x54, 0xca, 0x70, 0xb1, 0x17, 0x5c, 0x11, 0xcf, 0x1f, 0xac, 0xdc,
+	0x76, 0xdb, 0x9e, 0x7e, 0x84, 0x08, 0x7e, 0x61, 0xb1, 0x9c, 0x83, 0x71, 0x76, 0x61, 0x29, 0xe5,
+	0x17, 0xf2, 0x8b, 0x85, 0x3a, 0x5f, 0xd1, 0xf7, 0x73, 0xd0, 0xee, 0x60, 0x8f, 0x77, 0x1a, 0xb6,
+	0x50, 0x57, 0x60, 0xcc, 0x39, 0x3c, 0xc4, 0x5e, 0x69, 0x2c, 0x5d, 0x32, 0x33, 0x69, 0xfe, 0x5a,
+	0x03, 0x13, 0xbc, 0x7c, 0xa5, 0x38, 0x05, 0x09, 0x5f, 0xe5, 0x60, 0x26, 0x4c, 0xd6, 0xcd, 0x76,
+	0xe7, 0x5f, 0x4a, 0xc2, 0x12, 0xcc, 0xd2, 0x76, 0x64, 0xda, 0x6d, 0xdc, 0x70, 0x28, 0x44, 0x8a,
+	0x8c, 0xf5, 0xdc, 0x62, 0x78, 0x10, 0x40, 0xdf, 0x36, 0xba, 0x84, 0x8d, 0x0f, 0x4d, 0xd8, 0x0a,
+	0x94, 0xe2, 0x9c, 0x84, 0x84, 0x51, 0x6e, 0x04, 0x02, 0xce, 0x8d, 0xc3, 0x3c, 0x57, 0x76, 0x61,
+	0x56, 0x94, 0x4f, 0x94, 0xcb, 0x3e, 0xf2, 0xdd, 0x58, 0x73, 0x91, 0x58, 0x25, 0x20, 0xac, 0x93,
+	0xc8, 0x16, 0xbb, 0x9d, 0x57, 0x81, 0x59, 0x71, 0x77, 0x48, 0xe9, 0xaf, 0xc7, 0x67, 0xf9, 0x36,
+	0x00, 0xed, 0x98, 0x28, 0x30, 0x53, 0xca, 0xa7, 0x23, 0x8d, 0x36, 0x59, 0xe6, 0x58, 0x6a, 0x20,
+	0x6b, 0x30, 0x7f, 0x0a, 0x91, 0x60, 0x4e, 0x83, 0x09, 0xe6, 0x04, 0x33, 0x64, 0x13, 0x75, 0xb1,
+	0xae, 0x3c, 0xc9, 0x41, 0x51, 0x7c, 0x62, 0x76, 0x59, 0x2a, 0xac, 0x42, 0x01, 0xb5, 0xfd, 0x23,
+	0xc7, 0x33, 0xfd, 0x0e, 0x0b, 0x65, 0xb3, 0xf4, 0xeb, 0xcf, 0xd7, 0xe7, 0x38, 0x34, 0xfe, 0xf5,
+	0xdc, 0xf3, 0x3d, 0xd3, 0x6e, 0xd6, 0xbb, 0xa2, 0xea, 0x1e, 0xcc, 0xd0, 0xfb, 0x55, 0xd0, 0xc3,
+	0x1b, 0x3c, 0xc9, 0x72, 0x41, 0x58, 0xd7, 0x06, 0x27, 0x6a, 0xd0, 0xc9, 0x99, 0xf3, 0xfa, 0xb4,
+	0x8d, 0x4f, 0x22, 0x6b, 0xf5, 0x1d, 0x98, 0xa5, 0x46, 0x83, 0x8b, 0x01, 0x69, 0x88, 0xd4, 0xa5,
+	0x56, 0x97, 0x06, 0x5b, 0xdd, 0x0a, 0x54, 0xb8, 0xd9, 0xa2, 0x8d, 0x4f, 0xa2, 0x1b, 0xea, 0x2e,
+	0xd0, 0xad, 0x86, 0x65, 0x12, 0x3d, 0xb4, 0xca, 0xbe, 0x5a, 0x8b, 0x83, 0xad, 0xee, 0x98, 0x44,
+	0xe7, 0x36, 0xcf, 0xda, 0xf8, 0xa4, 0xbb, 0xe4, 0x37, 0x4c, 0x41, 0x07, 0x9f, 0x22, 0xa2, 0xcc,
+	0x8a, 0x0c, 0xfa, 0x91, 0x7d, 0x8b, 0x76, 0xcc, 0xa6, 0x87, 0x7c, 0xbc, 0xc5, 0x2e, 0x3d, 0xc3,
+	0x13, 0xbf, 0x0f, 0x93, 0x1e, 0x76, 0x69, 0xd5, 0x04, 0x03, 0x43, 0x6e, 0x21, 0xbf, 0x38, 0xb9,
+	0xfc, 0xff, 0xa4, 0x38, 0xa2, 0xbe, 0x79, 0x76, 0x45, 0xcd, 0x9c, 0x8a, 0x87, 0x7d, 0xa4, 0x62,
+	0x98, 0xe3, 0x4d, 0x9d, 0x85, 0x1b, 0x4c, 0x18, 0x78, 0xf8, 0x80, 0x36, 0x20, 0x8f, 0x0c, 0x83,
+	0x07, 0x92, 0x90, 0x3c, 0x11, 0x8f, 0x3c, 0x0a, 0xaa, 0xab, 0xde, 0x85, 0x71, 0x0f, 0x5b, 0xce,
+	0x31, 0x2e, 0xe5, 0x87, 0xb3, 0xc2, 0xd5, 0x4f, 0xd1, 0x10, 0xbd, 0x93, 0xf1, 0x38, 0x05, 0x09,
+	0x1f, 0xc0, 0xb4, 0xcc, 0x0f, 0x6d, 0xa0, 0xae, 0x87, 0x8f, 0x4d, 0xa7, 0x4d, 0x1a, 0xe2, 0xb2,
+	0xcb, 0xda, 0x43, 0x31, 0x3c, 0x08, 0x65, 0x17, 0x60, 0x4a, 0xa4, 0x7a, 0x77, 0x64, 0x84, 0x30,
+	0x73, 0xb7, 0x8d, 0xca, 0x6d, 0x98, 0x8c, 0x38, 0x96, 0x2e, 0xd0, 0x8a, 0x7c, 0x81, 0x16, 0x23,
+	0x69, 0x2e, 0x32, 0x92, 0x2e, 0x3f, 0x56, 0x21, 0xbf, 0x43, 0x9a, 0xea, 0x31, 0x4c, 0x49, 0x63,
+	0xfa, 0xf5, 0x84, 0x5c, 0x91, 0x87, 0x61, 0x6d, 0x25, 0x93, 0xb8, 0x60, 0x67, 0x44, 0xed, 0xc0,
+	0x59, 0x79, 0x72, 0xae, 0xa6, 0xb6, 0x14, 0xc8, 0x6b, 0xab, 0xd9, 0xe4, 0x23, 0xae, 0xbf, 0x53,
+	0xa0, 0xd4, 0x77, 0xc8, 0x7d, 0x39, 0xd1, 0x6c, 0x3f, 0x55, 0x6d, 0x63, 0x68, 0xd5, 0x08, 0xb8,
+	0xaf, 0x15, 0x38, 0xd7, 0x67, 0xd0, 0x5d, 0x4b, 0xb4, 0xdf, 0x5b, 0x51, 0x7b, 0x6d, 0x48, 0xc5,
+	0x08, 0xac, 0xef, 0x15, 0x28, 0x27, 0x0c, 0xbf, 0xc9, 0x5e, 0x06, 0x1b, 0xd0, 0xee, 0xfe, 0x45,
+	0x03, 0x72, 0x76, 0xc9, 0x23, 0x72, 0x72, 0x76, 0x49, 0xf2, 0xda, 0x6a, 0x36, 0xf9, 0x88, 0xeb,
+	0x2f, 0x15, 0x98, 0xeb, 0x39, 0x17, 0x27, 0x97, 0x4a, 0x2f, 0x35, 0xed, 0xd5, 0xa1, 0xd4, 0x64,
+	0x2e, 0xe4, 0x71, 0xb6, 0x9a, 0xd2, 0x22, 0x97, 0xd7, 0x56, 0xb3, 0xc9, 0x47, 0x5c, 0x7f, 0x0c,
+	0xd3, 0xb1, 0xa1, 0xb4, 0x96, 0x68, 0x4b, 0x56, 0xd0, 0xd6, 0x32, 0x2a, 0x44, 0xbc, 0x7f, 0x0a,
+	0xc5, 0xf8, 0x88, 0xf7, 0x52, 0xca, 0x14, 0xeb, 0xfa, 0xbf, 0x95, 0x55, 0x23, 0x02, 0xe0, 0x0b,
+	0x05, 0x66, 0x4f, 0x8f, 0x6a, 0xcb, 0xc9, 0x16, 0xe3, 0x3a, 0xda, 0x7a, 0x76, 0x1d, 0x39, 0x03,
+	0xe4, 0x09, 0x2b, 0x39, 0x03, 0x24, 0x79, 0x6d, 0x35, 0x9b, 0x7c, 0xcc, 0xb5, 0x34, 0xd7, 0x54,
+	0xd3, 0xbd, 0xcf, 0x50, 0x5e, 0x5b, 0xcd, 0x26, 0x2f, 0x27, 0x5f, 0x6c, 0x0e, 0xa8, 0xa5, 0x7c,
+	0x97, 0xc2, 0xf9, 0x5a, 0x46, 0x05, 0xd9, 0x7b, 0x6c, 0x2a, 0xa8, 0xa5, 0xec, 0xc2, 0x19, 0xbc,
+	0xf7, 0xbe, 0xe5, 0x57, 0x46, 0x54, 0x1f, 0xa6, 0xa4, 0x7b, 0xfc, 0xf5, 0x94, 0x25, 0xcc, 0xc4,
+	0xb5, 0x95, 0x4c, 0xe2, 0x62, 0xba, 0xf8, 0x04, 0x8a, 0xf1, 0x7b, 0x6c, 0x72, 0xc1, 0xc5, 0x34,
+	0xb4, 0x5b, 0x59, 0x35, 0x84, 0xfb, 0x93, 0xb0, 0xd1, 0x85, 0x77, 0xce, 0xb4, 0x8d, 0x8e, 0xcb,
+	0x6b, 0xab, 0xd9, 0xe4, 0x43, 0xc7, 0xda, 0xd8, 0x67, 0xcf, 0x1f, 0x2e, 0x29, 0x9b, 0x6f, 0x3e,
+	0x7a, 0x5a, 0x56, 0x1e, 0x3f, 0x2d, 0x2b, 0xbf, 0x3f, 0x2d, 0x2b, 0x0f, 0x9e, 0x95, 0x47, 0x1e,
+	0x3f, 0x2b, 0x8f, 0x3c, 0x79, 0x56, 0x1e, 0x79, 0x6f, 0xb9, 0x69, 0xfa, 0x47, 0xed, 0x83, 0xaa,
+	0xee, 0x58, 0xb5, 0x3e, 0xff, 0xa2, 0x1c, 0xdf, 0xac, 0xdd, 0x0f, 0xff, 0x24, 0xea, 0xb8, 0x98,
+	0x1c, 0x8c, 0x07, 0x7f, 0xa5, 0xdc, 0xfc, 0x73, 0x00, 0x08, 0x41, 0xa3, 0x29, 0x51, 0x1a, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2100,8 +2304,17 @@ type MsgClient interface {
 	// an existing RollApp.
 	RegisterAlias(ctx context.Context, in *MsgRegisterAlias, opts ...grpc.CallOption) (*MsgRegisterAliasResponse, error)
 	// TransferDymNameOwnership is message handler,
-	// handles transfer of ownership of a Dym-Name, performed by the owner.
+	// handles proposing a transfer of ownership of a Dym-Name, performed by the
+	// owner.
 	TransferDymNameOwnership(ctx context.Context, in *MsgTransferDymNameOwnership, opts ...grpc.CallOption) (*MsgTransferDymNameOwnershipResponse, error)
+	// AcceptDymNameOwnership is message handler,
+	// handles accepting a pending transfer of ownership of a Dym-Name, performed
+	// by the new owner.
+	AcceptDymNameOwnership(ctx context.Context, in *MsgAcceptDymNameOwnership, opts ...grpc.CallOption) (*MsgAcceptDymNameOwnershipResponse, error)
+	// CancelDymNameOwnershipTransfer is message handler,
+	// handles canceling a pending transfer of ownership of a Dym-Name, performed
+	// by the owner.
+	CancelDymNameOwnershipTransfer(ctx context.Context, in *MsgCancelDymNameOwnershipTransfer, opts ...grpc.CallOption) (*MsgCancelDymNameOwnershipTransferResponse, error)
 	// SetController is message handler,
 	// handles setting a controller for a Dym-Name, performed by the owner.
 	SetController(ctx context.Context, in *MsgSetController, opts ...grpc.CallOption) (*MsgSetControllerResponse, error)
@@ -2187,6 +2400,24 @@ func (c *msgClient) TransferDymNameOwnership(ctx context.Context, in *MsgTransfe
 	return out, nil
 }
 
+func (c *msgClient) AcceptDymNameOwnership(ctx context.Context, in *MsgAcceptDymNameOwnership, opts ...grpc.CallOption) (*MsgAcceptDymNameOwnershipResponse, error) {
+	out := new(MsgAcceptDymNameOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.dymns.Msg/AcceptDymNameOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelDymNameOwnershipTransfer(ctx context.Context, in *MsgCancelDymNameOwnershipTransfer, opts ...grpc.CallOption) (*MsgCancelDymNameOwnershipTransferResponse, error) {
+	out := new(MsgCancelDymNameOwnershipTransferResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.dymns.Msg/CancelDymNameOwnershipTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SetController(ctx context.Context, in *MsgSetController, opts ...grpc.CallOption) (*MsgSetControllerResponse, error) {
 	out := new(MsgSetControllerResponse)
 	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.dymns.Msg/SetController", in, out, opts...)
@@ -2313,8 +2544,17 @@ type MsgServer interface {
 	// an existing RollApp.
 	RegisterAlias(context.Context, *MsgRegisterAlias) (*MsgRegisterAliasResponse, error)
 	// TransferDymNameOwnership is message handler,
-	// handles transfer of ownership of a Dym-Name, performed by the owner.
+	// handles proposing a transfer of ownership of a Dym-Name, performed by the
+	// owner.
 	TransferDymNameOwnership(context.Context, *MsgTransferDymNameOwnership) (*MsgTransferDymNameOwnershipResponse, error)
+	// AcceptDymNameOwnership is message handler,
+	// handles accepting a pending transfer of ownership of a Dym-Name, performed
+	// by the new owner.
+	AcceptDymNameOwnership(context.Context, *MsgAcceptDymNameOwnership) (*MsgAcceptDymNameOwnershipResponse, error)
+	// CancelDymNameOwnershipTransfer is message handler,
+	// handles canceling a pending transfer of ownership of a Dym-Name, performed
+	// by the owner.
+	CancelDymNameOwnershipTransfer(context.Context, *MsgCancelDymNameOwnershipTransfer) (*MsgCancelDymNameOwnershipTransferResponse, error)
 	// SetController is message handler,
 	// handles setting a controller for a Dym-Name, performed by the owner.
 	SetController(context.Context, *MsgSetController) (*MsgSetControllerResponse, error)
@@ -2378,6 +2618,12 @@ func (*UnimplementedMsgServer) RegisterAlias(ctx context.Context, req *MsgRegist
 func (*UnimplementedMsgServer) TransferDymNameOwnership(ctx context.Context, req *MsgTransferDymNameOwnership) (*MsgTransferDymNameOwnershipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TransferDymNameOwnership not implemented")
 }
+func (*UnimplementedMsgServer) AcceptDymNameOwnership(ctx context.Context, req *MsgAcceptDymNameOwnership) (*MsgAcceptDymNameOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptDymNameOwnership not implemented")
+}
+func (*UnimplementedMsgServer) CancelDymNameOwnershipTransfer(ctx context.Context, req *MsgCancelDymNameOwnershipTransfer) (*MsgCancelDymNameOwnershipTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDymNameOwnershipTransfer not implemented")
+}
 func (*UnimplementedMsgServer) SetController(ctx context.Context, req *MsgSetController) (*MsgSetControllerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetController not implemented")
 }
@@ -2476,6 +2722,42 @@ func _Msg_TransferDymNameOwnership_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AcceptDymNameOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptDymNameOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptDymNameOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.dymns.Msg/AcceptDymNameOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptDymNameOwnership(ctx, req.(*MsgAcceptDymNameOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelDymNameOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelDymNameOwnershipTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelDymNameOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.dymns.Msg/CancelDymNameOwnershipTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelDymNameOwnershipTransfer(ctx, req.(*MsgCancelDymNameOwnershipTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SetController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSetController)
 	if err := dec(in); err != nil {
@@ -2726,6 +3008,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TransferDymNameOwnership",
 			Handler:    _Msg_TransferDymNameOwnership_Handler,
 		},
+		{
+			MethodName: "AcceptDymNameOwnership",
+			Handler:    _Msg_AcceptDymNameOwnership_Handler,
+		},
+		{
+			MethodName: "CancelDymNameOwnershipTransfer",
+			Handler:    _Msg_CancelDymNameOwnershipTransfer_Handler,
+		},
 		{
 			MethodName: "SetController",
 			Handler:    _Msg_SetController_Handler,
@@ -3009,7 +3299,7 @@ func (m *MsgTransferDymNameOwnershipResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetController) Marshal() (dAtA []byte, err error) {
+func (m *MsgAcceptDymNameOwnership) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3019,27 +3309,20 @@ func (m *MsgSetController) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetController) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAcceptDymNameOwnership) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetController) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAcceptDymNameOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Controller) > 0 {
-		i -= len(m.Controller)
-		copy(dAtA[i:], m.Controller)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Controller)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -3053,7 +3336,7 @@ func (m *MsgSetController) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetControllerResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAcceptDymNameOwnershipResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3063,12 +3346,12 @@ func (m *MsgSetControllerResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetControllerResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAcceptDymNameOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAcceptDymNameOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3076,7 +3359,7 @@ func (m *MsgSetControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateResolveAddress) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelDymNameOwnershipTransfer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3086,29 +3369,156 @@ func (m *MsgUpdateResolveAddress) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateResolveAddress) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelDymNameOwnershipTransfer) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateResolveAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelDymNameOwnershipTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ResolveTo) > 0 {
-		i -= len(m.ResolveTo)
-		copy(dAtA[i:], m.ResolveTo)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ResolveTo)))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x12
 	}
-	if len(m.SubName) > 0 {
-		i -= len(m.SubName)
-		copy(dAtA[i:], m.SubName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SubName)))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDymNameOwnershipTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDymNameOwnershipTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDymNameOwnershipTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetController) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetController) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetController) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetControllerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetControllerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateResolveAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateResolveAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateResolveAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ResolveTo) > 0 {
+		i -= len(m.ResolveTo)
+		copy(dAtA[i:], m.ResolveTo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ResolveTo)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SubName) > 0 {
+		i -= len(m.SubName)
+		copy(dAtA[i:], m.SubName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SubName)))
+		i--
+		dAtA[i] = 0x22
 	}
 	if len(m.ChainId) > 0 {
 		i -= len(m.ChainId)
@@ -4192,6 +4602,58 @@ func (m *MsgTransferDymNameOwnershipResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgAcceptDymNameOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptDymNameOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelDymNameOwnershipTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelDymNameOwnershipTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgSetController) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5334,6 +5796,334 @@ func (m *MsgTransferDymNameOwnershipResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgAcceptDymNameOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptDymNameOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptDymNameOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptDymNameOwnershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptDymNameOwnershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptDymNameOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelDymNameOwnershipTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDymNameOwnershipTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDymNameOwnershipTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelDymNameOwnershipTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDymNameOwnershipTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDymNameOwnershipTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgSetController) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -245,4 +245,11 @@ func (suite *KeeperTestSuite) TransferRollappOwnership(currentOwner, newOwner sd
 	})
 	suite.Require().NoError(err)
 	suite.Require().NotNil(resp)
+
+	acceptResp, err := rollappMsgServer.AcceptOwnership(suite.Ctx, &rollapptypes.MsgAcceptOwnership{
+		NewOwner:  newOwner.String(),
+		RollappId: rollappID,
+	})
+	suite.Require().NoError(err)
+	suite.Require().NotNil(acceptResp)
 }
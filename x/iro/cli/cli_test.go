@@ -126,6 +126,14 @@ func TestParseBondingCurve(t *testing.T) {
 		{"Negative values M", "-1.2,0.4,0", true},
 		{"Negative values N", "1.2,-0.4,0", true},
 		{"Negative values C", "1.2,0.4,-1", true},
+		{"Valid exponential", "exp:0.001,0.0001,0.01", false},
+		{"Invalid exponential params count", "exp:0.001,0.0001", true},
+		{"Valid logistic", "logistic:0.0001,0.01,1,100000", false},
+		{"Invalid logistic max price", "logistic:0.0001,1,0.5,100000", true},
+		{"Valid piecewise", "piecewise:0:0.01,1000:0.02,5000:0.1", false},
+		{"Invalid piecewise breakpoint", "piecewise:0:0.01,1000", true},
+		{"Invalid piecewise order", "piecewise:0:0.01,1000:0.02,500:0.1", true},
+		{"Unknown curve type", "cubic:1,2,3", true},
 	}
 
 	for _, tt := range tests {
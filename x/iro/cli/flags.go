@@ -18,6 +18,20 @@ const (
 	FlagVestingDuration                        = "vesting-duration"
 	FlagVestingStartTimeAfterSettlement        = "vesting-start-time"
 	FlagTradingDisabled                        = "trading-disabled"
+	FlagPresaleDuration                        = "presale-duration"
+	FlagPresaleAllowlist                       = "presale-allowlist"
+	FlagPresaleMerkleRoot                      = "presale-merkle-root"
+	FlagPresaleMaxPerAddress                   = "presale-max-per-address"
+	FlagPresaleMaxSold                         = "presale-max-sold"
+	FlagMaxPerAddress                          = "max-per-address"
+	FlagMaxPerBlock                            = "max-per-block"
+	FlagRampHours                              = "ramp-hours"
+	FlagPoolRollappWeight                      = "pool-rollapp-weight"
+	FlagPoolSwapFee                            = "pool-swap-fee"
+	FlagTeamAllocation                         = "team-allocation"
+	FlagPresaleCap                             = "presale-cap"
+	FlagPresaleProof                           = "presale-proof"
+	FlagReferrer                               = "referrer"
 )
 
 // FIXME: add plan duration
@@ -41,6 +55,50 @@ func FlagSetCreatePlan() *flag.FlagSet {
 	fs.Float64(FlagLiquidityPart, defaultLiquidityPart, "The part of the total liquidity to allocate to the plan.")
 	fs.Duration(FlagVestingDuration, defaultVestingDuration, "The duration of the vesting period.")
 	fs.Duration(FlagVestingStartTimeAfterSettlement, defaultVestingStartTime, "The start time of the vesting period after the plan is settled.")
+	fs.Duration(FlagPresaleDuration, 0, "The duration of the presale phase after the plan starts. Zero disables the presale.")
+	fs.StringSlice(FlagPresaleAllowlist, nil, "Comma-separated addresses allowed to buy during the presale.")
+	fs.String(FlagPresaleMerkleRoot, "", "Hex encoded merkle root of sha256(0x00 || \"<address>:<cap>\") leaves allowed to buy during the presale. Inner nodes are sha256(0x01 || min(a, b) || max(a, b)).")
+	fs.String(FlagPresaleMaxPerAddress, "0", "The maximum amount each allowlisted address can buy during the presale.")
+	fs.String(FlagPresaleMaxSold, "0", "The sold amount the presale can reach. Zero means no bound.")
+	fs.String(FlagMaxPerAddress, "0", "The maximum amount of tokens a single address can buy. Zero means no limit.")
+	fs.String(FlagMaxPerBlock, "0", "The maximum amount of tokens that can be bought in a single block. Zero means no limit.")
+	fs.Uint64(FlagRampHours, 0, "Number of hours over which the per-address limit ramps up linearly after the plan start.")
+	fs.String(FlagPoolRollappWeight, "", "The weight of the rollapp token in the pool bootstrapped on settlement (e.g. 0.8 for a 80/20 pool). Default is equal weights.")
+	fs.String(FlagPoolSwapFee, "", "The swap fee of the pool bootstrapped on settlement. Default is the global swap fee.")
+	fs.StringArray(FlagTeamAllocation, nil, "A team allocation in the format \"<address>:<amount>:<cliff>:<vesting-duration>\". Can be repeated.")
+
+	return fs
+}
+
+// FlagSetUpdatePlan returns flags for updating a plan.
+func FlagSetUpdatePlan() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagBondingCurve, "", "The bonding curve parameters.")
+	fs.Duration(FlagIncentivesStartDurationAfterSettlement, defaultIncentivePlanParams_start, "The duration after the plan is settled to start the incentives.")
+	fs.Uint64(FlagIncentivesEpochs, defaultIncentivePlanParams_epochs, "The number of epochs for the incentives.")
+	fs.Float64(FlagLiquidityPart, defaultLiquidityPart, "The part of the total liquidity to allocate to the plan.")
+	fs.Duration(FlagVestingDuration, defaultVestingDuration, "The duration of the vesting period.")
+	fs.Duration(FlagVestingStartTimeAfterSettlement, defaultVestingStartTime, "The start time of the vesting period after the plan is settled.")
+
+	return fs
+}
+
+// FlagSetBuy returns flags for buying.
+func FlagSetBuy() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagPresaleCap, "", "The presale cap of the buyer in the presale merkle tree.")
+	fs.StringSlice(FlagPresaleProof, nil, "Comma-separated hex encoded sibling hashes proving the presale cap.")
+
+	return fs
+}
+
+// FlagSetTrade returns flags for buying and selling.
+func FlagSetTrade() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagReferrer, "", "The address that referred the trade. It earns a share of the taker fee.")
 
 	return fs
 }
@@ -29,8 +29,12 @@ func GetQueryCmd() *cobra.Command {
 		CmdQueryPlan(),
 		CmdQueryPlanByRollapp(),
 		CmdQuerySpotPrice(),
+		CmdQueryCandles(),
 		CmdQueryCost(),
 		CmdQueryClaimed(),
+		CmdQueryPurchased(),
+		CmdQueryTeamAllocations(),
+		CmdQueryReferrals(),
 	)
 
 	return iroQueryCmd
@@ -223,3 +227,147 @@ func CmdQueryClaimed() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdQueryPurchased() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "purchased [plan-id] [address]",
+		Short: "Query the amount an address bought from a plan and its current purchase cap",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryPurchased(cmd.Context(), &types.QueryPurchasedRequest{PlanId: args[0], Address: args[1]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryCandles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "candles [plan-id] [resolution]",
+		Short: "Query the OHLCV price candles of a plan, oldest first",
+		Long:  "Query the OHLCV price candles of a plan, oldest first. Resolution is one of 1m, 1h or 1d.",
+		Example: `
+  dymd query iro candles 1 1h
+  # Query the hourly candles of plan 1`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			resolution, err := ParseCandleResolution(args[1])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryCandles(cmd.Context(), &types.QueryCandlesRequest{
+				PlanId:     args[0],
+				Resolution: resolution,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// ParseCandleResolution parses a candle resolution given as 1m, 1h or 1d
+func ParseCandleResolution(s string) (types.CandleResolution, error) {
+	switch s {
+	case "1m":
+		return types.CANDLE_RESOLUTION_MINUTE, nil
+	case "1h":
+		return types.CANDLE_RESOLUTION_HOUR, nil
+	case "1d":
+		return types.CANDLE_RESOLUTION_DAY, nil
+	default:
+		return types.CANDLE_RESOLUTION_UNSPECIFIED, fmt.Errorf("invalid candle resolution: %s: expected 1m, 1h or 1d", s)
+	}
+}
+
+func CmdQueryTeamAllocations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "team-allocations [plan-id]",
+		Short: "Query the vested, claimed and locked amounts of the team allocations of a plan",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryTeamAllocations(cmd.Context(), &types.QueryTeamAllocationsRequest{PlanId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryReferrals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "referrals [plan-id] [referrer]",
+		Short: "Query the volume and earnings of the referrers of a plan, or of a single referrer",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryReferralsRequest{PlanId: args[0]}
+			if len(args) == 2 {
+				req.Referrer = args[1]
+			}
+
+			req.Pagination, err = client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryReferrals(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
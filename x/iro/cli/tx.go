@@ -20,9 +20,13 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdCreateIRO())
+	cmd.AddCommand(CmdUpdatePlan())
 	cmd.AddCommand(CmdBuy())
+	cmd.AddCommand(CmdBuyWithSwap())
 	cmd.AddCommand(CmdSell())
 	cmd.AddCommand(CmdClaim())
+	cmd.AddCommand(CmdRefund())
+	cmd.AddCommand(CmdClaimTeamAllocation())
 
 	return cmd
 }
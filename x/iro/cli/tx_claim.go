@@ -68,3 +68,33 @@ func CmdClaimVested() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdClaimTeamAllocation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-team-allocation [plan-id]",
+		Short: "Claim the vested tokens of a team allocation after the plan is settled",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			planID := args[0]
+
+			msg := types.MsgClaimTeamAllocation{
+				Beneficiary: clientCtx.GetFromAddress().String(),
+				PlanId:      planID,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
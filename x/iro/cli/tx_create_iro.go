@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strconv"
@@ -30,6 +31,10 @@ Parameters:
 
 Required Flags:
   --curve           : The bonding curve parameters in the format "M,N,C" where the curve is defined as p(x) = M * x^N + C.
+                      Other curve families:
+                        "exp:M,K,C"                         p(x) = M * e^(K*x) + C
+                        "logistic:K,C,MaxPrice,Midpoint"    p(x) = C + (MaxPrice - C) / (1 + e^(-K*(x - Midpoint)))
+                        "piecewise:S0:P0,S1:P1,..."         p(x) interpolated linearly between (supply, price) breakpoints
 
 Optional Flags:
   --start-time      : The time when the IRO will start. Can be Unix timestamp or RFC3339 format (e.g., "2023-10-01T00:00:00Z").
@@ -46,11 +51,33 @@ Optional Flags:
                       Default: 0m
   --trading-disabled: Disables trading for the plan. Will require MsgEnableTrading to be executed later on.
                       Default: false
+  --presale-duration: The duration of the presale phase after the plan starts, during which only allowed addresses can buy.
+                      Default: 0 (no presale)
+  --presale-allowlist: Comma-separated addresses allowed to buy up to --presale-max-per-address during the presale.
+  --presale-merkle-root: Hex encoded merkle root of sha256(0x00 || "<address>:<cap>") leaves allowed to buy up to their cap during the presale.
+                         Inner nodes are sha256(0x01 || min(a, b) || max(a, b)).
+  --presale-max-sold: The sold amount the presale can reach, bounding the presale price range.
+                      Default: 0 (no bound)
+  --max-per-address : The maximum amount of tokens a single address can buy.
+                      Default: 0 (no limit)
+  --max-per-block   : The maximum amount of tokens that can be bought in a single block.
+                      Default: 0 (no limit)
+  --ramp-hours      : The per-address limit grows linearly over this many hours after the plan start.
+                      Default: 0 (no ramp)
+  --pool-rollapp-weight: The weight of the rollapp token in the pool bootstrapped on settlement (e.g. 0.8 for a 80/20 pool).
+                      Default: equal weights
+  --pool-swap-fee   : The swap fee of the pool bootstrapped on settlement.
+                      Default: the global swap fee
+  --team-allocation : A team allocation taken out of the allocation, in the format "<address>:<amount>:<cliff>:<vesting-duration>".
+                      The tokens vest linearly over the vesting duration after settlement, with nothing vested before the cliff.
+                      Can be repeated.
 
 Examples:
   dymd tx iro create-iro myrollapp1 1000000000 24h --curve "1.2,0.4,0" --from mykey
   dymd tx iro create-iro myrollapp2 500000000 30m --curve "1.5,0.5,100" --start-time "2023-10-01T00:00:00Z" --incentives-start 24h --incentives-epochs 3000 --from mykey
   dymd tx iro create-iro myrollapp3 2000000000 48h --curve "1.3,0.3,50" --trading-disabled=true --from mykey
+  dymd tx iro create-iro myrollapp4 2000000000 48h --curve "1.3,0.3,50" --presale-duration 1h --presale-allowlist dym1...,dym1... --presale-max-per-address 1000 --from mykey
+  dymd tx iro create-iro myrollapp5 2000000000 48h --curve "1.3,0.3,50" --team-allocation dym1...:100000000:2160h:8760h --from mykey
 `,
 		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
@@ -131,6 +158,26 @@ Examples:
 				return err
 			}
 
+			presale, err := parsePresale(cmd)
+			if err != nil {
+				return err
+			}
+
+			limits, err := parseTradingLimits(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolParams, err := parsePoolParams(cmd)
+			if err != nil {
+				return err
+			}
+
+			team, err := parseTeamAllocations(cmd)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -152,6 +199,10 @@ Examples:
 				VestingDuration:                 vestingDuration,
 				VestingStartTimeAfterSettlement: vestingStartTimeAfterSettlement,
 				TradingEnabled:                  !tradingDisabled,
+				Presale:                         presale,
+				TradingLimits:                   limits,
+				PoolParams:                      poolParams,
+				TeamAllocations:                 team,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -169,29 +220,215 @@ Examples:
 
 // ParseBondingCurve parses the bonding curve string into a BondingCurve struct
 // expected format: "M,N,C" for p(x) = M * x^N + C
+// or one of "exp:M,K,C", "logistic:K,C,MaxPrice,Midpoint", "piecewise:S0:P0,S1:P1,..." for other curve families
 func ParseBondingCurve(curveStr string) (types.BondingCurve, error) {
 	var curve types.BondingCurve
 
-	curveParams := strings.Split(curveStr, ",")
-	if len(curveParams) != 3 {
-		return curve, errors.New("invalid bonding curve parameters")
+	kind, paramsStr, found := strings.Cut(curveStr, ":")
+	if !found {
+		kind, paramsStr = "", curveStr
+	}
+
+	if kind == "piecewise" {
+		var breakpoints []types.CurveBreakpoint
+		for _, bp := range strings.Split(paramsStr, ",") {
+			supplyStr, priceStr, ok := strings.Cut(bp, ":")
+			if !ok {
+				return curve, fmt.Errorf("invalid breakpoint: %s", bp)
+			}
+			supply, err := math.LegacyNewDecFromStr(supplyStr)
+			if err != nil {
+				return curve, fmt.Errorf("invalid breakpoint supply: %s", supplyStr)
+			}
+			price, err := math.LegacyNewDecFromStr(priceStr)
+			if err != nil {
+				return curve, fmt.Errorf("invalid breakpoint price: %s", priceStr)
+			}
+			breakpoints = append(breakpoints, types.CurveBreakpoint{Supply: supply, Price: price})
+		}
+		curve = types.NewPiecewiseLinearBondingCurve(breakpoints, 18, 18)
+		return curve, curve.ValidateBasic()
+	}
+
+	params, err := parseDecs(paramsStr)
+	if err != nil {
+		return curve, err
+	}
+
+	switch kind {
+	case "":
+		if len(params) != 3 {
+			return curve, errors.New("invalid bonding curve parameters")
+		}
+		curve = types.NewBondingCurve(params[0], params[1], params[2], 18, 18)
+	case "exp":
+		if len(params) != 3 {
+			return curve, errors.New("invalid exponential curve parameters")
+		}
+		curve = types.NewExponentialBondingCurve(params[0], params[1], params[2], 18, 18)
+	case "logistic":
+		if len(params) != 4 {
+			return curve, errors.New("invalid logistic curve parameters")
+		}
+		curve = types.NewLogisticBondingCurve(params[0], params[1], params[2], params[3], 18, 18)
+	default:
+		return curve, fmt.Errorf("unknown curve type: %s", kind)
+	}
+
+	return curve, curve.ValidateBasic()
+}
+
+// parseDecs parses a comma separated list of decimals
+func parseDecs(s string) ([]math.LegacyDec, error) {
+	var decs []math.LegacyDec
+	for i, p := range strings.Split(s, ",") {
+		d, err := math.LegacyNewDecFromStr(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid curve parameter %d: %s", i, p)
+		}
+		decs = append(decs, d)
 	}
+	return decs, nil
+}
 
-	M, err := math.LegacyNewDecFromStr(curveParams[0])
+// parsePresale parses the presale flags into a Presale struct
+func parsePresale(cmd *cobra.Command) (types.Presale, error) {
+	var presale types.Presale
+
+	duration, err := cmd.Flags().GetDuration(FlagPresaleDuration)
 	if err != nil {
-		return curve, errors.New("invalid M parameter")
+		return presale, err
 	}
 
-	N, err := math.LegacyNewDecFromStr(curveParams[1])
+	allowlist, err := cmd.Flags().GetStringSlice(FlagPresaleAllowlist)
 	if err != nil {
-		return curve, errors.New("invalid N parameter")
+		return presale, err
 	}
 
-	C, err := math.LegacyNewDecFromStr(curveParams[2])
+	rootStr, err := cmd.Flags().GetString(FlagPresaleMerkleRoot)
+	if err != nil {
+		return presale, err
+	}
+	root, err := hex.DecodeString(rootStr)
 	if err != nil {
-		return curve, errors.New("invalid C parameter")
+		return presale, fmt.Errorf("invalid presale merkle root: %w", err)
 	}
 
-	curve = types.NewBondingCurve(M, N, C, 18, 18)
-	return curve, curve.ValidateBasic()
+	maxPerAddressStr, err := cmd.Flags().GetString(FlagPresaleMaxPerAddress)
+	if err != nil {
+		return presale, err
+	}
+	maxPerAddress, ok := math.NewIntFromString(maxPerAddressStr)
+	if !ok {
+		return presale, fmt.Errorf("invalid presale max per address: %s", maxPerAddressStr)
+	}
+
+	maxSoldStr, err := cmd.Flags().GetString(FlagPresaleMaxSold)
+	if err != nil {
+		return presale, err
+	}
+	maxSold, ok := math.NewIntFromString(maxSoldStr)
+	if !ok {
+		return presale, fmt.Errorf("invalid presale max sold amount: %s", maxSoldStr)
+	}
+
+	return types.Presale{
+		Allowlist:     allowlist,
+		MerkleRoot:    root,
+		MaxPerAddress: maxPerAddress,
+		MaxSoldAmt:    maxSold,
+		Duration:      duration,
+	}, nil
+}
+
+// parseTradingLimits parses the trading limit flags into a TradingLimits struct
+func parseTradingLimits(cmd *cobra.Command) (types.TradingLimits, error) {
+	var limits types.TradingLimits
+
+	maxPerAddressStr, err := cmd.Flags().GetString(FlagMaxPerAddress)
+	if err != nil {
+		return limits, err
+	}
+	maxPerAddress, ok := math.NewIntFromString(maxPerAddressStr)
+	if !ok {
+		return limits, fmt.Errorf("invalid max per address: %s", maxPerAddressStr)
+	}
+
+	maxPerBlockStr, err := cmd.Flags().GetString(FlagMaxPerBlock)
+	if err != nil {
+		return limits, err
+	}
+	maxPerBlock, ok := math.NewIntFromString(maxPerBlockStr)
+	if !ok {
+		return limits, fmt.Errorf("invalid max per block: %s", maxPerBlockStr)
+	}
+
+	rampHours, err := cmd.Flags().GetUint64(FlagRampHours)
+	if err != nil {
+		return limits, err
+	}
+
+	return types.NewTradingLimits(maxPerAddress, maxPerBlock, rampHours), nil
+}
+
+// parsePoolParams parses the settlement pool flags into a SettlementPoolParams struct
+func parsePoolParams(cmd *cobra.Command) (types.SettlementPoolParams, error) {
+	var poolParams types.SettlementPoolParams
+
+	weightStr, err := cmd.Flags().GetString(FlagPoolRollappWeight)
+	if err != nil {
+		return poolParams, err
+	}
+	if weightStr != "" {
+		weight, err := math.LegacyNewDecFromStr(weightStr)
+		if err != nil {
+			return poolParams, fmt.Errorf("invalid pool rollapp weight: %s", weightStr)
+		}
+		poolParams.RollappTokenWeight = &weight
+	}
+
+	swapFeeStr, err := cmd.Flags().GetString(FlagPoolSwapFee)
+	if err != nil {
+		return poolParams, err
+	}
+	if swapFeeStr != "" {
+		swapFee, err := math.LegacyNewDecFromStr(swapFeeStr)
+		if err != nil {
+			return poolParams, fmt.Errorf("invalid pool swap fee: %s", swapFeeStr)
+		}
+		poolParams.SwapFee = &swapFee
+	}
+
+	return poolParams, poolParams.ValidateBasic()
+}
+
+// parseTeamAllocations parses the team allocation flags, each in the format "<address>:<amount>:<cliff>:<vesting-duration>"
+func parseTeamAllocations(cmd *cobra.Command) ([]types.TeamAllocation, error) {
+	values, err := cmd.Flags().GetStringArray(FlagTeamAllocation)
+	if err != nil {
+		return nil, err
+	}
+
+	team := make([]types.TeamAllocation, 0, len(values))
+	for _, v := range values {
+		parts := strings.Split(v, ":")
+		if len(parts) != 4 {
+			return nil, fmt.Errorf("invalid team allocation: %s: expected <address>:<amount>:<cliff>:<vesting-duration>", v)
+		}
+		amount, ok := math.NewIntFromString(parts[1])
+		if !ok {
+			return nil, fmt.Errorf("invalid team allocation amount: %s", parts[1])
+		}
+		cliff, err := time.ParseDuration(parts[2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid team allocation cliff: %w", err)
+		}
+		vestingDuration, err := time.ParseDuration(parts[3])
+		if err != nil {
+			return nil, fmt.Errorf("invalid team allocation vesting duration: %w", err)
+		}
+		team = append(team, types.NewTeamAllocation(parts[0], amount, cliff, vestingDuration))
+	}
+
+	return team, types.ValidateTeamAllocations(team)
 }
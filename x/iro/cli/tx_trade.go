@@ -1,7 +1,10 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -54,13 +57,24 @@ func createBuySellCmd(use string, short string, isBuy bool) *cobra.Command {
 				return fmt.Errorf("invalid expected out amount: %s", argExpectedAmount)
 			}
 
+			referrer, err := cmd.Flags().GetString(FlagReferrer)
+			if err != nil {
+				return err
+			}
+
 			var msg sdk.Msg
 			if isBuy {
+				proof, err := parsePresaleProof(cmd)
+				if err != nil {
+					return err
+				}
 				msg = &types.MsgBuy{
 					Buyer:         clientCtx.GetFromAddress().String(),
 					PlanId:        planID,
 					Amount:        amount,
 					MaxCostAmount: expectedAmount,
+					PresaleProof:  proof,
+					Referrer:      referrer,
 				}
 			} else {
 				msg = &types.MsgSell{
@@ -68,6 +82,7 @@ func createBuySellCmd(use string, short string, isBuy bool) *cobra.Command {
 					PlanId:          planID,
 					Amount:          amount,
 					MinIncomeAmount: expectedAmount,
+					Referrer:        referrer,
 				}
 			}
 
@@ -76,9 +91,124 @@ func createBuySellCmd(use string, short string, isBuy bool) *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().AddFlagSet(FlagSetTrade())
+	if isBuy {
+		cmd.Flags().AddFlagSet(FlagSetBuy())
+	}
 	return cmd
 }
 
+func CmdBuyWithSwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "buy-with-swap [plan-id] [token-in] [routes] [min-out-tokens]",
+		Short:   "Buy allocation from an IRO plan paying with any token swapped through gamm pools",
+		Long:    "Routes are a comma-separated list of pool-id:token-out-denom hops. The last hop must output the plan's liquidity denom.",
+		Example: "dymd tx iro buy-with-swap 1 1000000uatom 1:adym 50000000000000000000",
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			planID := args[0]
+
+			tokenIn, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid token in: %w", err)
+			}
+
+			routes, err := parseSwapRoutes(args[2])
+			if err != nil {
+				return err
+			}
+
+			minOut, ok := math.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("invalid min out tokens amount: %s", args[3])
+			}
+
+			proof, err := parsePresaleProof(cmd)
+			if err != nil {
+				return err
+			}
+
+			referrer, err := cmd.Flags().GetString(FlagReferrer)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgBuyExactSpendWithSwap{
+				Buyer:              clientCtx.GetFromAddress().String(),
+				PlanId:             planID,
+				TokenIn:            tokenIn,
+				Routes:             routes,
+				MinOutTokensAmount: minOut,
+				PresaleProof:       proof,
+				Referrer:           referrer,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().AddFlagSet(FlagSetTrade())
+	cmd.Flags().AddFlagSet(FlagSetBuy())
+	return cmd
+}
+
+// parseSwapRoutes parses routes in the format pool-id:token-out-denom[,pool-id:token-out-denom...]
+func parseSwapRoutes(s string) ([]types.SwapRoute, error) {
+	var routes []types.SwapRoute
+	for _, hop := range strings.Split(s, ",") {
+		parts := strings.Split(hop, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid swap route, expected pool-id:token-out-denom: %s", hop)
+		}
+		poolID, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pool id: %s: %w", parts[0], err)
+		}
+		routes = append(routes, types.SwapRoute{PoolId: poolID, TokenOutDenom: parts[1]})
+	}
+	return routes, nil
+}
+
+// parsePresaleProof parses the presale proof flags. Returns nil if no cap is given.
+func parsePresaleProof(cmd *cobra.Command) (*types.PresaleProof, error) {
+	capStr, err := cmd.Flags().GetString(FlagPresaleCap)
+	if err != nil {
+		return nil, err
+	}
+	if capStr == "" {
+		return nil, nil
+	}
+	presaleCap, ok := math.NewIntFromString(capStr)
+	if !ok {
+		return nil, fmt.Errorf("invalid presale cap: %s", capStr)
+	}
+
+	siblings, err := cmd.Flags().GetStringSlice(FlagPresaleProof)
+	if err != nil {
+		return nil, err
+	}
+	proof := make([][]byte, 0, len(siblings))
+	for _, s := range siblings {
+		b, err := hex.DecodeString(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid presale proof: %w", err)
+		}
+		proof = append(proof, b)
+	}
+
+	return &types.PresaleProof{Cap: presaleCap, Proof: proof}, nil
+}
+
 func CmdEnableTrading() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "enable-trading [plan-id]",
@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+func CmdUpdatePlan() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-plan [plan-id] [duration]",
+		Short: "Update an IRO plan before trading is enabled",
+		Long: `Update the bonding curve, duration, liquidity part, incentives and vesting of an IRO plan.
+Only the rollapp owner can update the plan, and only before trading is enabled.
+All settings are replaced: omitted flags fall back to their defaults, not to the current plan values.
+
+Required Flags:
+  --curve           : The bonding curve parameters, in the same format as create-iro.
+
+Optional Flags:
+  --incentives-start, --incentives-epochs, --liquidity-part, --vesting-duration, --vesting-start-time
+                    : Same as create-iro.
+
+Examples:
+  dymd tx iro update-plan 1 48h --curve "1.2,0.4,0" --vesting-duration 4800h --from mykey
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			planID := args[0]
+
+			planDuration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			curveStr, err := cmd.Flags().GetString(FlagBondingCurve)
+			if err != nil {
+				return err
+			}
+			curve, err := ParseBondingCurve(curveStr)
+			if err != nil {
+				return errors.Join(types.ErrInvalidBondingCurve, err)
+			}
+
+			incentivesStart, err := cmd.Flags().GetDuration(FlagIncentivesStartDurationAfterSettlement)
+			if err != nil {
+				return err
+			}
+
+			incentivesEpochs, err := cmd.Flags().GetUint64(FlagIncentivesEpochs)
+			if err != nil {
+				return err
+			}
+
+			liquidityPart, err := cmd.Flags().GetFloat64(FlagLiquidityPart)
+			if err != nil {
+				return err
+			}
+
+			vestingDuration, err := cmd.Flags().GetDuration(FlagVestingDuration)
+			if err != nil {
+				return err
+			}
+
+			vestingStartTimeAfterSettlement, err := cmd.Flags().GetDuration(FlagVestingStartTimeAfterSettlement)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgUpdatePlan{
+				Owner:           clientCtx.GetFromAddress().String(),
+				PlanId:          planID,
+				BondingCurve:    curve,
+				IroPlanDuration: planDuration,
+				IncentivePlanParams: types.IncentivePlanParams{
+					StartTimeAfterSettlement: incentivesStart,
+					NumEpochsPaidOver:        incentivesEpochs,
+				},
+				LiquidityPart:                   math.LegacyMustNewDecFromStr(fmt.Sprintf("%f", liquidityPart)),
+				VestingDuration:                 vestingDuration,
+				VestingStartTimeAfterSettlement: vestingStartTimeAfterSettlement,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().AddFlagSet(FlagSetUpdatePlan())
+
+	return cmd
+}
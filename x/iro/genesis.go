@@ -27,6 +27,22 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		}
 	}
 	k.SetLastPlanId(ctx, lastPlanId)
+
+	for _, a := range genState.PresaleAllocations {
+		k.SetPresaleAllocation(ctx, a)
+	}
+
+	for _, p := range genState.Purchases {
+		k.SetPurchase(ctx, p)
+	}
+
+	for _, c := range genState.Candles {
+		k.SetCandle(ctx, c)
+	}
+
+	for _, r := range genState.Referrals {
+		k.SetReferral(ctx, r)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
@@ -34,6 +50,10 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.GenesisState{}
 	genesis.Params = k.GetParams(ctx)
 	genesis.Plans = append(genesis.Plans, k.GetAllPlans(ctx, false)...)
+	genesis.PresaleAllocations = k.GetAllPresaleAllocations(ctx)
+	genesis.Purchases = k.GetAllPurchases(ctx)
+	genesis.Candles = k.GetAllCandles(ctx)
+	genesis.Referrals = k.GetAllReferrals(ctx)
 
 	return &genesis
 }
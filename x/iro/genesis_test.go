@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	keepertest "github.com/dymensionxyz/dymension/v3/testutil/keeper"
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
 	"github.com/dymensionxyz/dymension/v3/x/iro"
 	"github.com/dymensionxyz/dymension/v3/x/iro/types"
 )
@@ -22,14 +23,34 @@ var (
 )
 
 var plans = []types.Plan{
-	types.NewPlan(1, "rollapp1", "adym", fooCoin, defaultCurve, time.Hour, defaultIncentives, defaultLiquidityPart, defaultDuration, 0),
-	types.NewPlan(2, "rollapp2", "adym", fooCoin, defaultCurve, time.Hour, defaultIncentives, defaultLiquidityPart, defaultDuration, 0),
+	newPlan(1, "rollapp1"),
+	newPlan(2, "rollapp2"),
+}
+
+func newPlan(id uint64, rollappId string) types.Plan {
+	plan, err := types.NewPlan(id, rollappId, "adym", fooCoin, defaultCurve, time.Hour, defaultIncentives, defaultLiquidityPart, defaultDuration, 0)
+	if err != nil {
+		panic(err)
+	}
+	return plan
 }
 
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
 		Plans:  plans,
+		PresaleAllocations: []types.PresaleAllocation{
+			{PlanId: "1", Address: sample.AccAddress(), Cap: math.NewInt(10), Bought: math.NewInt(5)},
+		},
+		Purchases: []types.Purchase{
+			{PlanId: "2", Address: sample.AccAddress(), Amount: math.NewInt(7)},
+		},
+		Candles: []types.Candle{
+			types.NewCandle("1", types.CANDLE_RESOLUTION_HOUR, time.Unix(1_700_000_000, 0).Truncate(time.Hour).UTC(), math.LegacyNewDec(2)),
+		},
+		Referrals: []types.Referral{
+			{PlanId: "1", Referrer: sample.AccAddress(), Volume: math.NewInt(100), Earnings: math.NewInt(2)},
+		},
 	}
 
 	k, ctx := keepertest.IROKeeper(t)
@@ -48,4 +69,8 @@ func TestGenesis(t *testing.T) {
 	for i := range genesisState.Plans {
 		require.Equal(t, genesisState.Plans[i], got.Plans[i])
 	}
+	require.Equal(t, genesisState.PresaleAllocations, got.PresaleAllocations)
+	require.Equal(t, genesisState.Purchases, got.Purchases)
+	require.Equal(t, genesisState.Candles, got.Candles)
+	require.Equal(t, genesisState.Referrals, got.Referrals)
 }
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+// SetCandle sets a price candle
+func (k Keeper) SetCandle(ctx sdk.Context, c types.Candle) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CandleKey(c.PlanId, c.Resolution, c.StartTime), k.cdc.MustMarshal(&c))
+}
+
+// GetCandle returns the price candle of a plan at a resolution for the bucket starting at start
+func (k Keeper) GetCandle(ctx sdk.Context, planId string, resolution types.CandleResolution, start time.Time) (val types.Candle, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.CandleKey(planId, resolution, start))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllCandles returns the price candles of all plans
+func (k Keeper) GetAllCandles(ctx sdk.Context) (list []types.Candle) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CandleKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Candle
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetCandlesPaginated returns the price candles of a plan at a resolution, oldest first
+func (k Keeper) GetCandlesPaginated(ctx sdk.Context, planId string, resolution types.CandleResolution, pageReq *query.PageRequest) (list []types.Candle, pageRes *query.PageResponse, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CandlesKeyPrefix(planId, resolution))
+
+	pageRes, err = query.Paginate(store, pageReq, func(key []byte, value []byte) error {
+		var val types.Candle
+		if er := k.cdc.Unmarshal(value, &val); er != nil {
+			return er
+		}
+
+		list = append(list, val)
+		return nil
+	})
+
+	return
+}
+
+// recordCandles adds a trade of the given amount to the plan's candles at all resolutions.
+// openPrice is the spot price before the trade, and the plan is expected to be already updated.
+func (k Keeper) recordCandles(ctx sdk.Context, plan types.Plan, openPrice math.LegacyDec, amt math.Int) {
+	retention := k.GetParams(ctx).CandleRetention
+	if retention == 0 {
+		return
+	}
+
+	planId := fmt.Sprintf("%d", plan.Id)
+	closePrice := plan.SpotPrice()
+	for _, resolution := range types.CandleResolutions {
+		start := resolution.BucketStart(ctx.BlockTime())
+		candle, found := k.GetCandle(ctx, planId, resolution, start)
+		if !found {
+			candle = types.NewCandle(planId, resolution, start, openPrice)
+			// a new bucket is opened, so drop the buckets out of the retention window
+			k.pruneCandles(ctx, planId, resolution, start.Add(-time.Duration(retention-1)*resolution.Duration()))
+		}
+		candle.AddTrade(closePrice, amt)
+		k.SetCandle(ctx, candle)
+	}
+}
+
+// pruneCandles deletes the candles of a plan at a resolution starting before the given time
+func (k Keeper) pruneCandles(ctx sdk.Context, planId string, resolution types.CandleResolution, before time.Time) {
+	if before.Unix() <= 0 {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CandlesKeyPrefix(planId, resolution))
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(before.Unix())))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close() // nolint: errcheck
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
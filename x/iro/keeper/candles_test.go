@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	"github.com/dymensionxyz/dymension/v3/x/iro/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+func (s *KeeperTestSuite) TestCandles() {
+	rollappId := s.CreateDefaultRollapp()
+	k := s.App.IROKeeper
+
+	params := k.GetParams(s.Ctx)
+	params.CandleRetention = 2
+	k.SetParams(s.Ctx, params)
+
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	amt := math.NewInt(1_000_000).MulRaw(1e18)
+	rollapp, _ := s.App.RollappKeeper.GetRollapp(s.Ctx, rollappId)
+	planId, err := k.CreatePlan(s.Ctx, "adym", amt, time.Hour, startTime, true, rollapp, types.DefaultBondingCurve(), types.DefaultIncentivePlanParams(), types.DefaultParams().MinLiquidityPart, time.Hour, 0, keeper.CreatePlanOpts{})
+	s.Require().NoError(err)
+
+	buyer := sample.Acc()
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(10 * time.Second))
+	open := k.MustGetPlan(s.Ctx, planId).SpotPrice()
+	s.BuySomeTokens(planId, buyer, math.NewInt(1_000).MulRaw(1e18))
+	high := k.MustGetPlan(s.Ctx, planId).SpotPrice()
+
+	// sell in the same minute
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(50 * time.Second))
+	err = k.Sell(s.Ctx, planId, buyer, math.NewInt(400).MulRaw(1e18), math.OneInt(), nil)
+	s.Require().NoError(err)
+	closePrice := k.MustGetPlan(s.Ctx, planId).SpotPrice()
+
+	candle, found := k.GetCandle(s.Ctx, planId, types.CANDLE_RESOLUTION_MINUTE, startTime)
+	s.Require().True(found)
+	s.Require().Equal(open, candle.Open)
+	s.Require().Equal(high, candle.High)
+	s.Require().Equal(open, candle.Low)
+	s.Require().Equal(closePrice, candle.Close)
+	s.Require().Equal(math.NewInt(1_400).MulRaw(1e18), candle.Volume)
+	s.Require().NoError(candle.ValidateBasic())
+
+	// trades in the next minutes open new minute candles, and the hour candle keeps aggregating
+	for i := 1; i <= 2; i++ {
+		s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Duration(i) * time.Minute))
+		s.BuySomeTokens(planId, buyer, math.NewInt(100).MulRaw(1e18))
+	}
+
+	res, err := k.QueryCandles(s.Ctx, &types.QueryCandlesRequest{PlanId: planId, Resolution: types.CANDLE_RESOLUTION_MINUTE})
+	s.Require().NoError(err)
+	s.Require().Len(res.Candles, 2, "oldest minute candle should be pruned")
+	s.Require().True(startTime.Add(time.Minute).Equal(res.Candles[0].StartTime))
+	s.Require().True(startTime.Add(2 * time.Minute).Equal(res.Candles[1].StartTime))
+	s.Require().Equal(res.Candles[0].Close, res.Candles[1].Open)
+
+	res, err = k.QueryCandles(s.Ctx, &types.QueryCandlesRequest{PlanId: planId, Resolution: types.CANDLE_RESOLUTION_HOUR})
+	s.Require().NoError(err)
+	s.Require().Len(res.Candles, 1)
+	s.Require().Equal(open, res.Candles[0].Open)
+	s.Require().Equal(k.MustGetPlan(s.Ctx, planId).SpotPrice(), res.Candles[0].Close)
+	s.Require().Equal(math.NewInt(1_600).MulRaw(1e18), res.Candles[0].Volume)
+
+	_, err = k.QueryCandles(s.Ctx, &types.QueryCandlesRequest{PlanId: planId})
+	s.Require().Error(err)
+}
@@ -115,3 +115,58 @@ func (k Keeper) ClaimVested(ctx sdk.Context, planId string, claimer sdk.AccAddre
 
 	return nil
 }
+
+// ClaimTeamAllocation allows a team allocation beneficiary to claim the vested rollapp tokens.
+// The function performs the following checks and operations:
+// - Verifies that the plan exists and is settled.
+// - Ensures the claimer is a team allocation beneficiary.
+// - Checks if there are any vested tokens not claimed yet.
+// - Transfers the vested tokens from the plan's module account to the beneficiary.
+// - Updates the team allocation to reflect the claimed amount.
+func (k Keeper) ClaimTeamAllocation(ctx sdk.Context, planId string, beneficiary sdk.AccAddress) error {
+	plan, found := k.GetPlan(ctx, planId)
+	if !found {
+		return types.ErrPlanNotFound
+	}
+
+	if !plan.IsSettled() {
+		return types.ErrPlanNotSettled
+	}
+
+	i, found := plan.GetTeamAllocation(beneficiary.String())
+	if !found {
+		return errorsmod.Wrapf(types.ErrTeamAllocationNotFound, "beneficiary: %s", beneficiary)
+	}
+	allocation := plan.TeamAllocations[i]
+
+	// check for vested tokens
+	amt := allocation.ClaimableAmt(ctx.BlockTime())
+	if !amt.IsPositive() {
+		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "no vested tokens")
+	}
+
+	// send the vested tokens to the beneficiary
+	claim := sdk.NewCoin(plan.SettledDenom, amt)
+	err := k.BK.SendCoins(ctx, plan.GetAddress(), beneficiary, sdk.NewCoins(claim))
+	if err != nil {
+		return err
+	}
+
+	// update the team allocation
+	allocation.Claimed = allocation.Claimed.Add(amt)
+	plan.TeamAllocations[i] = allocation
+	k.SetPlan(ctx, plan)
+
+	err = uevent.EmitTypedEvent(ctx, &types.EventClaimTeamAllocation{
+		Beneficiary: beneficiary.String(),
+		PlanId:      planId,
+		RollappId:   plan.RollappId,
+		Claim:       claim,
+		Unvested:    sdk.NewCoin(plan.SettledDenom, allocation.Amount.Sub(allocation.VestedAmt(ctx.BlockTime()))),
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
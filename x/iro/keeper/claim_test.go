@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	"github.com/dymensionxyz/dymension/v3/x/iro/keeper"
 	"github.com/dymensionxyz/dymension/v3/x/iro/types"
 )
 
@@ -26,7 +27,7 @@ func (s *KeeperTestSuite) TestClaim() {
 	amt := math.NewInt(1_000_000).MulRaw(1e18)
 
 	rollapp, _ := s.App.RollappKeeper.GetRollapp(s.Ctx, rollappId)
-	planId, err := k.CreatePlan(s.Ctx, "adym", amt, time.Hour, startTime, true, rollapp, curve, incentives, liquidityPart, time.Hour, 0)
+	planId, err := k.CreatePlan(s.Ctx, "adym", amt, time.Hour, startTime, true, rollapp, curve, incentives, liquidityPart, time.Hour, 0, keeper.CreatePlanOpts{})
 	s.Require().NoError(err)
 	planDenom := k.MustGetPlan(s.Ctx, planId).TotalAllocation.Denom
 	balance := s.App.BankKeeper.GetBalance(s.Ctx, k.AK.GetModuleAddress(types.ModuleName), planDenom)
@@ -74,7 +75,7 @@ func (s *KeeperTestSuite) TestClaimVested() {
 	liquidityPart := types.DefaultParams().MinLiquidityPart
 
 	rollapp := s.App.RollappKeeper.MustGetRollapp(s.Ctx, rollappId)
-	planId, err := k.CreatePlan(s.Ctx, "adym", amt, time.Hour, startTime, true, rollapp, curve, incentives, liquidityPart, time.Hour, 0)
+	planId, err := k.CreatePlan(s.Ctx, "adym", amt, time.Hour, startTime, true, rollapp, curve, incentives, liquidityPart, time.Hour, 0, keeper.CreatePlanOpts{})
 	s.Require().NoError(err)
 	planDenom := k.MustGetPlan(s.Ctx, planId).TotalAllocation.Denom
 	balance := s.App.BankKeeper.GetBalance(s.Ctx, k.AK.GetModuleAddress(types.ModuleName), planDenom)
@@ -115,3 +116,95 @@ func (s *KeeperTestSuite) TestClaimVested() {
 	balance = s.App.BankKeeper.GetBalance(s.Ctx, owner, plan.LiquidityDenom)
 	s.Require().Equal(expectedOwnerFunds.QuoRaw(2).String(), balance.Amount.Sub(balanceBefore.Amount).String())
 }
+
+// TestClaimTeamAllocation tests that team allocations are carved out of the allocation,
+// locked on settlement and claimable by their beneficiaries as they vest.
+func (s *KeeperTestSuite) TestClaimTeamAllocation() {
+	rollappId := s.CreateDefaultRollapp()
+	k := s.App.IROKeeper
+	curve := types.DefaultBondingCurve()
+	incentives := types.DefaultIncentivePlanParams()
+
+	startTime := time.Now()
+	amt := math.NewInt(1_000_000).MulRaw(1e18)
+	rollappDenom := "dasdasdasdasdsa"
+	liquidityPart := types.DefaultParams().MinLiquidityPart
+
+	alice := sample.Acc()
+	bob := sample.Acc()
+	aliceAmt := math.NewInt(100_000).MulRaw(1e18)
+	bobAmt := math.NewInt(50_000).MulRaw(1e18)
+	team := []types.TeamAllocation{
+		types.NewTeamAllocation(alice.String(), aliceAmt, time.Hour, 4*time.Hour),
+		types.NewTeamAllocation(bob.String(), bobAmt, 0, 2*time.Hour),
+	}
+
+	rollapp := s.App.RollappKeeper.MustGetRollapp(s.Ctx, rollappId)
+	planId, err := k.CreatePlan(s.Ctx, "adym", amt, time.Hour, startTime, true, rollapp, curve, incentives, liquidityPart, time.Hour, 0, keeper.CreatePlanOpts{TeamAllocations: team})
+	s.Require().NoError(err)
+
+	// the team allocations are not sold
+	plan := k.MustGetPlan(s.Ctx, planId)
+	eq, err := types.FindEquilibrium(curve, amt.Sub(aliceAmt).Sub(bobAmt), liquidityPart)
+	s.Require().NoError(err)
+	s.Require().Equal(eq, plan.MaxAmountToSell)
+
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute))
+	s.BuySomeTokens(planId, sample.Acc(), math.NewInt(1_000).MulRaw(1e18))
+
+	// claim should fail as not settled
+	err = k.ClaimTeamAllocation(s.Ctx, planId, bob)
+	s.Require().ErrorIs(err, types.ErrPlanNotSettled)
+
+	// settle locks the team allocations in the plan's module account
+	s.FundModuleAcc(types.ModuleName, sdk.NewCoins(sdk.NewCoin(rollappDenom, amt)))
+	err = k.Settle(s.Ctx, rollappId, rollappDenom)
+	s.Require().NoError(err)
+
+	plan = k.MustGetPlan(s.Ctx, planId)
+	s.Require().Equal(aliceAmt.Add(bobAmt), s.App.BankKeeper.GetBalance(s.Ctx, plan.GetAddress(), rollappDenom).Amount)
+	err = keeper.InvariantAccounting(*k)(s.Ctx)
+	s.Require().NoError(err)
+
+	// not a beneficiary
+	err = k.ClaimTeamAllocation(s.Ctx, planId, sample.Acc())
+	s.Require().ErrorIs(err, types.ErrTeamAllocationNotFound)
+
+	// nothing vested yet
+	err = k.ClaimTeamAllocation(s.Ctx, planId, bob)
+	s.Require().Error(err)
+
+	// alice is before the cliff, bob vested a quarter
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(30 * time.Minute))
+	err = k.ClaimTeamAllocation(s.Ctx, planId, alice)
+	s.Require().Error(err)
+	err = k.ClaimTeamAllocation(s.Ctx, planId, bob)
+	s.Require().NoError(err)
+	s.Require().Equal(bobAmt.QuoRaw(4), s.App.BankKeeper.GetBalance(s.Ctx, bob, rollappDenom).Amount)
+
+	// alice vested half, bob vested all
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(90 * time.Minute))
+	res, err := k.QueryTeamAllocations(s.Ctx, &types.QueryTeamAllocationsRequest{PlanId: planId})
+	s.Require().NoError(err)
+	s.Require().Len(res.Allocations, 2)
+	s.Require().Equal(aliceAmt.QuoRaw(2), res.Allocations[0].Vested)
+	s.Require().Equal(aliceAmt.QuoRaw(2), res.Allocations[0].Locked)
+	s.Require().True(res.Allocations[0].Claimed.IsZero())
+	s.Require().Equal(bobAmt, res.Allocations[1].Vested)
+	s.Require().Equal(bobAmt.QuoRaw(4), res.Allocations[1].Claimed)
+	s.Require().True(res.Allocations[1].Locked.IsZero())
+
+	err = k.ClaimTeamAllocation(s.Ctx, planId, alice)
+	s.Require().NoError(err)
+	s.Require().Equal(aliceAmt.QuoRaw(2), s.App.BankKeeper.GetBalance(s.Ctx, alice, rollappDenom).Amount)
+	err = k.ClaimTeamAllocation(s.Ctx, planId, bob)
+	s.Require().NoError(err)
+	s.Require().Equal(bobAmt, s.App.BankKeeper.GetBalance(s.Ctx, bob, rollappDenom).Amount)
+
+	// bob claimed everything
+	err = k.ClaimTeamAllocation(s.Ctx, planId, bob)
+	s.Require().Error(err)
+
+	err = keeper.InvariantAccounting(*k)(s.Ctx)
+	s.Require().NoError(err)
+}
@@ -42,31 +42,20 @@ func (m msgServer) CreatePlan(goCtx context.Context, req *types.MsgCreatePlan) (
 
 	params := m.Keeper.GetParams(ctx)
 
-	// check minimal plan duration
-	if req.IroPlanDuration < params.MinPlanDuration {
-		return nil, errors.Join(gerrc.ErrFailedPrecondition, types.ErrInvalidEndTime)
-	}
-
-	// check minimal liquidity part
-	if req.LiquidityPart.LT(params.MinLiquidityPart) {
-		return nil, errorsmod.Wrapf(gerrc.ErrInvalidArgument, "liquidity part must be at least %s", params.MinLiquidityPart)
-	}
-
-	// check vesting params
-	if req.VestingDuration < params.MinVestingDuration {
-		return nil, errorsmod.Wrapf(gerrc.ErrInvalidArgument, "vesting duration must be at least %s", params.MinVestingDuration)
+	err := validatePlanSettings(params, req.IroPlanDuration, req.BondingCurve, req.IncentivePlanParams, req.LiquidityPart, req.VestingDuration, req.VestingStartTimeAfterSettlement)
+	if err != nil {
+		return nil, err
 	}
 
-	if req.VestingStartTimeAfterSettlement < params.MinVestingStartTimeAfterSettlement {
-		return nil, errorsmod.Wrapf(gerrc.ErrInvalidArgument, "vesting start time after settlement must be at least %s", params.MinVestingStartTimeAfterSettlement)
+	if err := req.PoolParams.ValidateParams(params); err != nil {
+		return nil, errors.Join(gerrc.ErrInvalidArgument, types.ErrInvalidPoolParams, err)
 	}
 
-	// validate incentive plan params
-	if req.IncentivePlanParams.NumEpochsPaidOver < params.IncentivesMinNumEpochsPaidOver {
-		return nil, errors.Join(gerrc.ErrInvalidArgument, errorsmod.Wrap(types.ErrInvalidIncentivePlanParams, "num epochs paid over"))
-	}
-	if req.IncentivePlanParams.StartTimeAfterSettlement < params.IncentivesMinStartTimeAfterSettlement {
-		return nil, errors.Join(gerrc.ErrInvalidArgument, errorsmod.Wrap(types.ErrInvalidIncentivePlanParams, "start time after settlement"))
+	// team allocations vest like the owner's raised liquidity, so they share its minimal vesting duration
+	for _, a := range req.TeamAllocations {
+		if a.VestingDuration < params.MinVestingDuration {
+			return nil, errorsmod.Wrapf(gerrc.ErrInvalidArgument, "team allocation vesting duration must be at least %s", params.MinVestingDuration)
+		}
 	}
 
 	// Check if the plan already exists
@@ -89,19 +78,8 @@ func (m msgServer) CreatePlan(goCtx context.Context, req *types.MsgCreatePlan) (
 		return nil, errorsmod.Wrap(gerrc.ErrFailedPrecondition, "no genesis account for iro module account")
 	}
 
-	// validate rollapp decimals is correct
-	if req.BondingCurve.RollappDenomDecimals != uint64(rollapp.GenesisInfo.NativeDenom.Exponent) {
-		return nil, errorsmod.Wrapf(gerrc.ErrInvalidArgument, "rollapp decimals must be %d", rollapp.GenesisInfo.NativeDenom.Exponent)
-	}
-
-	// validate the liquidity denom is registered and curve decimals are correct
-	liqToken, ok := m.BK.GetDenomMetaData(ctx, req.LiquidityDenom)
-	if !ok {
-		return nil, errorsmod.Wrapf(gerrc.ErrInvalidArgument, "denom %s not registered", req.LiquidityDenom)
-	}
-	exponent := liqToken.DenomUnits[len(liqToken.DenomUnits)-1].Exponent
-	if req.BondingCurve.LiquidityDenomDecimals != uint64(exponent) {
-		return nil, errorsmod.Wrapf(gerrc.ErrInvalidArgument, "liquidity denom decimals must be %d", exponent)
+	if err := m.Keeper.validateCurveDecimals(ctx, rollapp, req.LiquidityDenom, req.BondingCurve); err != nil {
+		return nil, err
 	}
 
 	// check liquidity denom is allowed
@@ -109,7 +87,12 @@ func (m msgServer) CreatePlan(goCtx context.Context, req *types.MsgCreatePlan) (
 		return nil, errorsmod.Wrap(gerrc.ErrFailedPrecondition, "denom not allowed")
 	}
 
-	planId, err := m.Keeper.CreatePlan(ctx, req.LiquidityDenom, req.AllocatedAmount, req.IroPlanDuration, req.StartTime, req.TradingEnabled, rollapp, req.BondingCurve, req.IncentivePlanParams, req.LiquidityPart, req.VestingDuration, req.VestingStartTimeAfterSettlement)
+	planId, err := m.Keeper.CreatePlan(ctx, req.LiquidityDenom, req.AllocatedAmount, req.IroPlanDuration, req.StartTime, req.TradingEnabled, rollapp, req.BondingCurve, req.IncentivePlanParams, req.LiquidityPart, req.VestingDuration, req.VestingStartTimeAfterSettlement, CreatePlanOpts{
+		Presale:         req.Presale,
+		TradingLimits:   req.TradingLimits,
+		PoolParams:      req.PoolParams,
+		TeamAllocations: req.TeamAllocations,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -119,6 +102,68 @@ func (m msgServer) CreatePlan(goCtx context.Context, req *types.MsgCreatePlan) (
 	}, nil
 }
 
+// validatePlanSettings checks the plan settings which can be changed until trading is enabled against the module params
+func validatePlanSettings(params types.Params, planDuration time.Duration, curve types.BondingCurve, incentivesParams types.IncentivePlanParams, liquidityPart math.LegacyDec, vestingDuration, vestingStartTimeAfterSettlement time.Duration) error {
+	// check minimal plan duration
+	if planDuration < params.MinPlanDuration {
+		return errors.Join(gerrc.ErrFailedPrecondition, types.ErrInvalidEndTime)
+	}
+
+	if err := curve.ValidateParams(params); err != nil {
+		return errors.Join(gerrc.ErrInvalidArgument, err)
+	}
+
+	// check minimal liquidity part
+	if liquidityPart.LT(params.MinLiquidityPart) {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "liquidity part must be at least %s", params.MinLiquidityPart)
+	}
+
+	// check vesting params
+	if vestingDuration < params.MinVestingDuration {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "vesting duration must be at least %s", params.MinVestingDuration)
+	}
+
+	if vestingStartTimeAfterSettlement < params.MinVestingStartTimeAfterSettlement {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "vesting start time after settlement must be at least %s", params.MinVestingStartTimeAfterSettlement)
+	}
+
+	// validate incentive plan params
+	if incentivesParams.NumEpochsPaidOver < params.IncentivesMinNumEpochsPaidOver {
+		return errors.Join(gerrc.ErrInvalidArgument, errorsmod.Wrap(types.ErrInvalidIncentivePlanParams, "num epochs paid over"))
+	}
+	if incentivesParams.StartTimeAfterSettlement < params.IncentivesMinStartTimeAfterSettlement {
+		return errors.Join(gerrc.ErrInvalidArgument, errorsmod.Wrap(types.ErrInvalidIncentivePlanParams, "start time after settlement"))
+	}
+	return nil
+}
+
+// validateCurveDecimals checks the curve decimals match the rollapp and the liquidity denoms
+func (k Keeper) validateCurveDecimals(ctx sdk.Context, rollapp rollapptypes.Rollapp, liquidityDenom string, curve types.BondingCurve) error {
+	// validate rollapp decimals is correct
+	if curve.RollappDenomDecimals != uint64(rollapp.GenesisInfo.NativeDenom.Exponent) {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "rollapp decimals must be %d", rollapp.GenesisInfo.NativeDenom.Exponent)
+	}
+
+	// validate the liquidity denom is registered and curve decimals are correct
+	liqToken, ok := k.BK.GetDenomMetaData(ctx, liquidityDenom)
+	if !ok {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "denom %s not registered", liquidityDenom)
+	}
+	exponent := liqToken.DenomUnits[len(liqToken.DenomUnits)-1].Exponent
+	if curve.LiquidityDenomDecimals != uint64(exponent) {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "liquidity denom decimals must be %d", exponent)
+	}
+	return nil
+}
+
+// CreatePlanOpts are the optional settings of a new plan. The zero value leaves all of them disabled.
+type CreatePlanOpts struct {
+	Presale         types.Presale
+	TradingLimits   types.TradingLimits
+	PoolParams      types.SettlementPoolParams
+	TeamAllocations []types.TeamAllocation
+}
+
 // CreatePlan creates a new IRO plan for a rollapp
 // This function performs the following steps:
 // 1. Sets the IRO plan to the rollapp with the specified pre-launch time.
@@ -127,13 +172,30 @@ func (m msgServer) CreatePlan(goCtx context.Context, req *types.MsgCreatePlan) (
 // 4. Creates a new module account for the IRO plan.
 // 5. Charges the creation fee from the rollapp owner to the plan's module account.
 // 6. Stores the plan in the keeper.
-func (k Keeper) CreatePlan(ctx sdk.Context, liquidityDenom string, allocatedAmount math.Int, planDuration time.Duration, startTime time.Time, tradingEnabled bool, rollapp rollapptypes.Rollapp, curve types.BondingCurve, incentivesParams types.IncentivePlanParams, liquidityPart math.LegacyDec, vestingDuration, vestingStartTimeAfterSettlement time.Duration) (string, error) {
+func (k Keeper) CreatePlan(ctx sdk.Context, liquidityDenom string, allocatedAmount math.Int, planDuration time.Duration, startTime time.Time, tradingEnabled bool, rollapp rollapptypes.Rollapp, curve types.BondingCurve, incentivesParams types.IncentivePlanParams, liquidityPart math.LegacyDec, vestingDuration, vestingStartTimeAfterSettlement time.Duration, opts CreatePlanOpts) (string, error) {
 	allocation, err := k.MintAllocation(ctx, allocatedAmount, rollapp.RollappId, rollapp.GenesisInfo.NativeDenom.Display, uint64(rollapp.GenesisInfo.NativeDenom.Exponent))
 	if err != nil {
 		return "", err
 	}
 
-	plan := types.NewPlan(k.GetNextPlanIdAndIncrement(ctx), rollapp.RollappId, liquidityDenom, allocation, curve, planDuration, incentivesParams, liquidityPart, vestingDuration, vestingStartTimeAfterSettlement)
+	plan, err := types.NewPlan(k.GetNextPlanIdAndIncrement(ctx), rollapp.RollappId, liquidityDenom, allocation, curve, planDuration, incentivesParams, liquidityPart, vestingDuration, vestingStartTimeAfterSettlement)
+	if err != nil {
+		return "", errors.Join(gerrc.ErrInvalidArgument, err)
+	}
+	if err := plan.SetPoolParams(opts.PoolParams); err != nil {
+		return "", errors.Join(gerrc.ErrInvalidArgument, err)
+	}
+	if len(opts.TeamAllocations) > 0 {
+		if err := plan.SetTeamAllocations(opts.TeamAllocations); err != nil {
+			return "", errors.Join(gerrc.ErrInvalidArgument, err)
+		}
+	}
+	if opts.Presale.IsEnabled() {
+		plan.Presale = opts.Presale
+	}
+	if limits := opts.TradingLimits; limits.HasMaxPerAddress() || limits.HasMaxPerBlock() {
+		plan.TradingLimits = types.NewTradingLimits(limits.MaxPerAddress, limits.MaxPerBlock, limits.RampHours)
+	}
 
 	// if trading enabled initially, set start time and pre-launch time
 	if tradingEnabled {
@@ -141,6 +203,7 @@ func (k Keeper) CreatePlan(ctx sdk.Context, liquidityDenom string, allocatedAmou
 			startTime = ctx.BlockTime()
 		}
 		plan.EnableTradingWithStartTime(startTime)
+		plan.SetSettlementDeadline(k.GetParams(ctx).SettlementGracePeriod)
 	}
 
 	if err := plan.ValidateBasic(); err != nil {
@@ -174,6 +237,17 @@ func (k Keeper) CreatePlan(ctx sdk.Context, liquidityDenom string, allocatedAmou
 	plan.SoldAmt = feeAmt
 	plan.ClaimedAmt = feeAmt // set fee as claimed, as it's not claimable
 
+	// the allowlist is kept as presale allocations rather than in the plan
+	for _, addr := range plan.Presale.Allowlist {
+		k.SetPresaleAllocation(ctx, types.PresaleAllocation{
+			PlanId:  fmt.Sprintf("%d", plan.Id),
+			Address: addr,
+			Cap:     plan.Presale.MaxPerAddress,
+			Bought:  math.ZeroInt(),
+		})
+	}
+	plan.Presale.Allowlist = nil
+
 	// Set the plan in the store
 	k.SetPlan(ctx, plan)
 
@@ -6,6 +6,7 @@ import (
 
 	"cosmossdk.io/math"
 
+	"github.com/dymensionxyz/dymension/v3/x/iro/keeper"
 	"github.com/dymensionxyz/dymension/v3/x/iro/types"
 )
 
@@ -29,7 +30,7 @@ func (s *KeeperTestSuite) TestValidateRollappPreconditions() {
 		rollapp.GenesisInfo.GenesisChecksum = ""
 		s.App.RollappKeeper.SetRollapp(s.Ctx, rollapp)
 
-		_, err := k.CreatePlan(s.Ctx, "adym", allocation, time.Hour, time.Now(), true, rollapp, curve, incentives, liquidityPart, time.Hour, 0)
+		_, err := k.CreatePlan(s.Ctx, "adym", allocation, time.Hour, time.Now(), true, rollapp, curve, incentives, liquidityPart, time.Hour, 0, keeper.CreatePlanOpts{})
 		s.Require().Error(err)
 	})
 
@@ -43,7 +44,7 @@ func (s *KeeperTestSuite) TestValidateRollappPreconditions() {
 		rollapp.Launched = true
 		s.App.RollappKeeper.SetRollapp(s.Ctx, rollapp)
 
-		_, err := k.CreatePlan(s.Ctx, "adym", allocation, time.Hour, time.Now(), true, rollapp, curve, incentives, liquidityPart, time.Hour, 0)
+		_, err := k.CreatePlan(s.Ctx, "adym", allocation, time.Hour, time.Now(), true, rollapp, curve, incentives, liquidityPart, time.Hour, 0, keeper.CreatePlanOpts{})
 		s.Require().Error(err)
 	})
 
@@ -57,7 +58,7 @@ func (s *KeeperTestSuite) TestValidateRollappPreconditions() {
 		rollapp.Launched = false
 		s.App.RollappKeeper.SetRollapp(s.Ctx, rollapp)
 
-		_, err := k.CreatePlan(s.Ctx, "adym", allocation, time.Hour, time.Now(), true, rollapp, curve, incentives, liquidityPart, time.Hour, 0)
+		_, err := k.CreatePlan(s.Ctx, "adym", allocation, time.Hour, time.Now(), true, rollapp, curve, incentives, liquidityPart, time.Hour, 0, keeper.CreatePlanOpts{})
 		s.Require().NoError(err)
 	})
 }
@@ -81,16 +82,16 @@ func (s *KeeperTestSuite) TestCreatePlan() {
 	liquidityPart := types.DefaultParams().MinLiquidityPart
 
 	rollapp, _ := s.App.RollappKeeper.GetRollapp(s.Ctx, rollappId)
-	planId, err := k.CreatePlan(s.Ctx, "adym", allocation, time.Hour, time.Now(), true, rollapp, curve, incentives, liquidityPart, time.Hour, 0)
+	planId, err := k.CreatePlan(s.Ctx, "adym", allocation, time.Hour, time.Now(), true, rollapp, curve, incentives, liquidityPart, time.Hour, 0, keeper.CreatePlanOpts{})
 	s.Require().NoError(err)
 
 	// creating a plan for same rollapp should fail
-	_, err = k.CreatePlan(s.Ctx, "adym", allocation, time.Hour, time.Now(), true, rollapp, curve, incentives, liquidityPart, time.Hour, 0)
+	_, err = k.CreatePlan(s.Ctx, "adym", allocation, time.Hour, time.Now(), true, rollapp, curve, incentives, liquidityPart, time.Hour, 0, keeper.CreatePlanOpts{})
 	s.Require().Error(err)
 
 	// create plan for different rollappID. test last planId increases
 	rollapp2, _ := s.App.RollappKeeper.GetRollapp(s.Ctx, rollappId2)
-	planId2, err := k.CreatePlan(s.Ctx, "adym", allocation, time.Hour, time.Now(), true, rollapp2, curve, incentives, liquidityPart, time.Hour, 0)
+	planId2, err := k.CreatePlan(s.Ctx, "adym", allocation, time.Hour, time.Now(), true, rollapp2, curve, incentives, liquidityPart, time.Hour, 0, keeper.CreatePlanOpts{})
 	s.Require().NoError(err)
 	s.Require().Greater(planId2, planId)
 
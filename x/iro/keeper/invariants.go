@@ -103,6 +103,17 @@ func InvariantAccounting(k Keeper) uinv.Func {
 					errs = append(errs, fmt.Errorf("incorrect founder funds: planID: %d, expected: %s, available: %s",
 						plan.Id, expectedFunds, founderFunds.Amount))
 				}
+
+				// the plan's module account should hold the unclaimed team allocations
+				teamFunds := k.BK.GetBalance(ctx, plan.GetAddress(), plan.SettledDenom)
+				expectedTeamFunds := plan.TeamAllocationsTotal()
+				for _, a := range plan.TeamAllocations {
+					expectedTeamFunds = expectedTeamFunds.Sub(a.Claimed)
+				}
+				if !teamFunds.Amount.Equal(expectedTeamFunds) {
+					errs = append(errs, fmt.Errorf("incorrect team funds: planID: %d, expected: %s, available: %s",
+						plan.Id, expectedTeamFunds, teamFunds.Amount))
+				}
 			}
 		}
 
@@ -52,6 +52,6 @@ func (suite *KeeperTestSuite) SetupTest() {
 func (suite *KeeperTestSuite) BuySomeTokens(planId string, buyer sdk.AccAddress, amt math.Int) {
 	maxAmt := math.NewInt(1_000_000_000).MulRaw(1e18)
 	suite.FundAcc(buyer, sdk.NewCoins(sdk.NewCoin("adym", amt.MulRaw(10)))) // 10 times the amount to buy, for buffer and fees
-	err := suite.App.IROKeeper.Buy(suite.Ctx, planId, buyer, amt, maxAmt)
+	err := suite.App.IROKeeper.Buy(suite.Ctx, planId, buyer, amt, maxAmt, nil)
 	suite.Require().NoError(err)
 }
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+// SetPurchase sets the amount an address bought from a plan
+func (k Keeper) SetPurchase(ctx sdk.Context, p types.Purchase) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PurchaseKey(p.PlanId, p.Address), k.cdc.MustMarshal(&p))
+}
+
+// GetPurchased returns the amount an address bought from a plan
+func (k Keeper) GetPurchased(ctx sdk.Context, planId, address string) math.Int {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.PurchaseKey(planId, address))
+	if b == nil {
+		return math.ZeroInt()
+	}
+
+	var val types.Purchase
+	k.cdc.MustUnmarshal(b, &val)
+	return val.Amount
+}
+
+// GetAllPurchases returns purchases of all plans
+func (k Keeper) GetAllPurchases(ctx sdk.Context) (list []types.Purchase) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PurchaseKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Purchase
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// chargeTradingLimits enforces the plan's anti-sniping limits on a buy:
+// - the buyer's total purchases must not exceed the (possibly ramping) per-address cap
+// - the tokens bought in the current block must not exceed the per-block limit
+// The per-block state is updated on the plan, which the caller stores.
+// The rollapp owner is not limited.
+func (k Keeper) chargeTradingLimits(ctx sdk.Context, plan *types.Plan, buyer sdk.AccAddress, amt math.Int) error {
+	limits := plan.TradingLimits
+	if !limits.HasMaxPerAddress() && !limits.HasMaxPerBlock() {
+		return nil
+	}
+
+	owner := k.rk.MustGetRollappOwner(ctx, plan.RollappId)
+	if owner.Equals(buyer) {
+		return nil
+	}
+
+	planId := fmt.Sprintf("%d", plan.Id)
+	purchased := k.GetPurchased(ctx, planId, buyer.String()).Add(amt)
+	if limits.HasMaxPerAddress() {
+		addrCap := limits.AddressCap(plan.StartTime, ctx.BlockTime())
+		if purchased.GT(addrCap) {
+			return errorsmod.Wrapf(types.ErrTradingLimitExceeded, "per address: cap: %s, purchased: %s", addrCap, purchased)
+		}
+	}
+
+	bought := limits.BoughtInBlock(ctx.BlockHeight()).Add(amt)
+	if limits.HasMaxPerBlock() && bought.GT(limits.MaxPerBlock) {
+		return errorsmod.Wrapf(types.ErrTradingLimitExceeded, "per block: max: %s, bought: %s", limits.MaxPerBlock, bought)
+	}
+
+	if limits.HasMaxPerAddress() {
+		k.SetPurchase(ctx, types.Purchase{PlanId: planId, Address: buyer.String(), Amount: purchased})
+	}
+	if limits.HasMaxPerBlock() {
+		plan.TradingLimits.BlockHeight = ctx.BlockHeight()
+		plan.TradingLimits.BlockBought = bought
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	"github.com/dymensionxyz/dymension/v3/x/iro/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+func (s *KeeperTestSuite) TestTradingLimits() {
+	rollappId := s.CreateDefaultRollapp()
+	k := s.App.IROKeeper
+
+	alice := sample.Acc()
+	bob := sample.Acc()
+	for _, acc := range []sdk.AccAddress{alice, bob} {
+		s.FundAcc(acc, sdk.NewCoins(sdk.NewCoin("adym", math.NewInt(100_000).MulRaw(1e18))))
+	}
+
+	unit := math.NewInt(1e18)
+	limits := types.NewTradingLimits(unit.MulRaw(100), unit.MulRaw(150), 4)
+
+	startTime := time.Now()
+	maxAmt := math.NewInt(1_000_000_000).MulRaw(1e18)
+	totalAllocation := math.NewInt(1_000_000).MulRaw(1e18)
+	rollapp, _ := s.App.RollappKeeper.GetRollapp(s.Ctx, rollappId)
+	planId, err := k.CreatePlan(s.Ctx, "adym", totalAllocation, time.Hour, startTime, true, rollapp, types.DefaultBondingCurve(), types.DefaultIncentivePlanParams(), types.DefaultParams().MinLiquidityPart, time.Hour, 0, keeper.CreatePlanOpts{TradingLimits: limits})
+	s.Require().NoError(err)
+
+	// first hour of the ramp: the cap is a quarter of the max per address
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute)).WithBlockHeight(10)
+	err = k.Buy(s.Ctx, planId, alice, unit.MulRaw(26), maxAmt, nil)
+	s.Require().ErrorIs(err, types.ErrTradingLimitExceeded)
+	err = k.Buy(s.Ctx, planId, alice, unit.MulRaw(25), maxAmt, nil)
+	s.Require().NoError(err)
+
+	res, err := k.QueryPurchased(s.Ctx, &types.QueryPurchasedRequest{PlanId: planId, Address: alice.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(unit.MulRaw(25), res.Purchased)
+	s.Require().Equal(unit.MulRaw(25), res.Cap)
+
+	// after the ramp, the full cap applies
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(4 * time.Hour)).WithBlockHeight(11)
+	err = k.Buy(s.Ctx, planId, alice, unit.MulRaw(75), maxAmt, nil)
+	s.Require().NoError(err)
+	err = k.Buy(s.Ctx, planId, alice, unit, maxAmt, nil)
+	s.Require().ErrorIs(err, types.ErrTradingLimitExceeded)
+
+	// alice's buys in this block count toward the per block limit
+	err = k.Buy(s.Ctx, planId, bob, unit.MulRaw(76), maxAmt, nil)
+	s.Require().ErrorIs(err, types.ErrTradingLimitExceeded)
+	err = k.Buy(s.Ctx, planId, bob, unit.MulRaw(75), maxAmt, nil)
+	s.Require().NoError(err)
+
+	plan := k.MustGetPlan(s.Ctx, planId)
+	s.Require().Equal(int64(11), plan.TradingLimits.BlockHeight)
+	s.Require().Equal(unit.MulRaw(150), plan.TradingLimits.BlockBought)
+
+	// the per block limit resets in the next block
+	s.Ctx = s.Ctx.WithBlockHeight(12)
+	err = k.Buy(s.Ctx, planId, bob, unit, maxAmt, nil)
+	s.Require().NoError(err)
+}
@@ -18,7 +18,8 @@ func (s *KeeperTestSuite) setV1IRO() {
 	curve.LiquidityDenomDecimals = 0
 	curve.RollappDenomDecimals = 0
 
-	plan := types.NewPlan(1, "rollapp1", "", fooCoin, curve, 0, defaultIncentives, math.LegacyOneDec(), 0, 0)
+	plan, err := types.NewPlan(1, "rollapp1", "", fooCoin, curve, 0, defaultIncentives, math.LegacyOneDec(), 0, 0)
+	s.Require().NoError(err)
 	plan.MaxAmountToSell = math.ZeroInt()
 	plan.LiquidityPart = math.LegacyDec{}
 	plan.VestingPlan = types.IROVestingPlan{}
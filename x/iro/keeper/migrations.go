@@ -49,7 +49,10 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 		plan.LiquidityPart = math.LegacyOneDec()
 
 		// max amount to sell is calculated from bonding curve
-		eq := types.FindEquilibrium(plan.BondingCurve, plan.TotalAllocation.Amount, plan.LiquidityPart)
+		eq, err := types.FindEquilibrium(plan.BondingCurve, plan.TotalAllocation.Amount, plan.LiquidityPart)
+		if err != nil {
+			return fmt.Errorf("plan %d: %w", plan.Id, err)
+		}
 		plan.MaxAmountToSell = math.MaxInt(plan.SoldAmt, eq)
 
 		plan.TradingEnabled = true
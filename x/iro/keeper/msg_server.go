@@ -41,7 +41,20 @@ func (m msgServer) Buy(ctx context.Context, req *types.MsgBuy) (*types.MsgBuyRes
 		return nil, err
 	}
 
-	err = m.Keeper.Buy(sdk.UnwrapSDKContext(ctx), req.PlanId, buyer, req.Amount, req.MaxCostAmount)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if req.PresaleProof != nil {
+		err = m.Keeper.ProvePresaleAllocation(sdkCtx, req.PlanId, buyer, req.PresaleProof.Cap, req.PresaleProof.Proof)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	referrer, err := parseReferrer(req.Referrer)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.Keeper.Buy(sdkCtx, req.PlanId, buyer, req.Amount, req.MaxCostAmount, referrer)
 	if err != nil {
 		return nil, err
 	}
@@ -56,7 +69,48 @@ func (m msgServer) BuyExactSpend(ctx context.Context, req *types.MsgBuyExactSpen
 		return nil, err
 	}
 
-	err = m.Keeper.BuyExactSpend(sdk.UnwrapSDKContext(ctx), req.PlanId, buyer, req.Spend, req.MinOutTokensAmount)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if req.PresaleProof != nil {
+		err = m.Keeper.ProvePresaleAllocation(sdkCtx, req.PlanId, buyer, req.PresaleProof.Cap, req.PresaleProof.Proof)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	referrer, err := parseReferrer(req.Referrer)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.Keeper.BuyExactSpend(sdkCtx, req.PlanId, buyer, req.Spend, req.MinOutTokensAmount, referrer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBuyResponse{}, nil
+}
+
+// BuyExactSpendWithSwap implements types.MsgServer.
+func (m msgServer) BuyExactSpendWithSwap(ctx context.Context, req *types.MsgBuyExactSpendWithSwap) (*types.MsgBuyResponse, error) {
+	buyer, err := sdk.AccAddressFromBech32(req.Buyer)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if req.PresaleProof != nil {
+		err = m.Keeper.ProvePresaleAllocation(sdkCtx, req.PlanId, buyer, req.PresaleProof.Cap, req.PresaleProof.Proof)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	referrer, err := parseReferrer(req.Referrer)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.Keeper.BuyExactSpendWithSwap(sdkCtx, req.PlanId, buyer, req.TokenIn, req.Routes, req.MinOutTokensAmount, referrer)
 	if err != nil {
 		return nil, err
 	}
@@ -70,7 +124,12 @@ func (m msgServer) Sell(ctx context.Context, req *types.MsgSell) (*types.MsgSell
 	if err != nil {
 		return nil, err
 	}
-	err = m.Keeper.Sell(sdk.UnwrapSDKContext(ctx), req.PlanId, seller, req.Amount, req.MinIncomeAmount)
+	referrer, err := parseReferrer(req.Referrer)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.Keeper.Sell(sdk.UnwrapSDKContext(ctx), req.PlanId, seller, req.Amount, req.MinIncomeAmount, referrer)
 	if err != nil {
 		return nil, err
 	}
@@ -98,3 +157,33 @@ func (m msgServer) ClaimVested(ctx context.Context, req *types.MsgClaimVested) (
 
 	return &types.MsgClaimVestedResponse{}, nil
 }
+
+// Refund implements types.MsgServer.
+func (m msgServer) Refund(ctx context.Context, req *types.MsgRefund) (*types.MsgRefundResponse, error) {
+	refunderAddr := sdk.MustAccAddressFromBech32(req.Refunder)
+	err := m.Keeper.Refund(sdk.UnwrapSDKContext(ctx), req.PlanId, refunderAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRefundResponse{}, nil
+}
+
+// ClaimTeamAllocation implements types.MsgServer.
+func (m msgServer) ClaimTeamAllocation(ctx context.Context, req *types.MsgClaimTeamAllocation) (*types.MsgClaimTeamAllocationResponse, error) {
+	beneficiaryAddr := sdk.MustAccAddressFromBech32(req.Beneficiary)
+	err := m.Keeper.ClaimTeamAllocation(sdk.UnwrapSDKContext(ctx), req.PlanId, beneficiaryAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimTeamAllocationResponse{}, nil
+}
+
+// parseReferrer parses the optional referrer of a trade
+func parseReferrer(referrer string) (sdk.AccAddress, error) {
+	if referrer == "" {
+		return nil, nil
+	}
+	return sdk.AccAddressFromBech32(referrer)
+}
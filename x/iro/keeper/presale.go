@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+// SetPresaleAllocation sets the presale allocation of an address
+func (k Keeper) SetPresaleAllocation(ctx sdk.Context, a types.PresaleAllocation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PresaleAllocationKey(a.PlanId, a.Address), k.cdc.MustMarshal(&a))
+}
+
+// GetPresaleAllocation returns the presale allocation of an address
+func (k Keeper) GetPresaleAllocation(ctx sdk.Context, planId, address string) (val types.PresaleAllocation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.PresaleAllocationKey(planId, address))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllPresaleAllocations returns presale allocations of all plans
+func (k Keeper) GetAllPresaleAllocations(ctx sdk.Context) (list []types.PresaleAllocation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PresaleAllocationKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PresaleAllocation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// ProvePresaleAllocation admits the buyer to the presale of the plan with the cap proven against the
+// plan's merkle root. Proving again is a no-op, so clients can attach the proof to every presale buy.
+func (k Keeper) ProvePresaleAllocation(ctx sdk.Context, planId string, buyer sdk.AccAddress, cap math.Int, proof [][]byte) error {
+	plan, found := k.GetPlan(ctx, planId)
+	if !found {
+		return types.ErrPlanNotFound
+	}
+
+	if len(plan.Presale.MerkleRoot) == 0 {
+		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "plan has no presale merkle root")
+	}
+	if !plan.Presale.IsActive(ctx.BlockTime()) {
+		return errorsmod.Wrapf(gerrc.ErrFailedPrecondition, "presale is not active: ends at %s", plan.Presale.EndTime)
+	}
+
+	if _, found := k.GetPresaleAllocation(ctx, planId, buyer.String()); found {
+		return nil
+	}
+
+	if !types.VerifyPresaleProof(plan.Presale.MerkleRoot, buyer.String(), cap, proof) {
+		return errorsmod.Wrap(gerrc.ErrPermissionDenied, "invalid presale proof")
+	}
+
+	k.SetPresaleAllocation(ctx, types.PresaleAllocation{
+		PlanId:  planId,
+		Address: buyer.String(),
+		Cap:     cap,
+		Bought:  math.ZeroInt(),
+	})
+	return nil
+}
+
+// chargePresaleAllocation enforces the presale restrictions on a buy while the presale is active:
+// - the buyer must have an allocation, from the allowlist or from a cap proven against the merkle root
+// - the buyer's total presale buys must not exceed its cap
+// - the plan must not be sold beyond the presale curve segment
+// The rollapp owner is not restricted.
+func (k Keeper) chargePresaleAllocation(ctx sdk.Context, plan types.Plan, buyer sdk.AccAddress, amt math.Int) error {
+	if !plan.Presale.IsActive(ctx.BlockTime()) {
+		return nil
+	}
+
+	owner := k.rk.MustGetRollappOwner(ctx, plan.RollappId)
+	if owner.Equals(buyer) {
+		return nil
+	}
+
+	planId := fmt.Sprintf("%d", plan.Id)
+	allocation, found := k.GetPresaleAllocation(ctx, planId, buyer.String())
+	if !found {
+		return errorsmod.Wrapf(types.ErrPresaleNotAllowed, "presale ends at %s", plan.Presale.EndTime)
+	}
+
+	if plan.Presale.HasMaxSoldAmt() && plan.SoldAmt.Add(amt).GT(plan.Presale.MaxSoldAmt) {
+		return errorsmod.Wrapf(types.ErrInsufficientTokens, "presale max sold amount: %s", plan.Presale.MaxSoldAmt)
+	}
+
+	allocation.Bought = allocation.Bought.Add(amt)
+	if allocation.Bought.GT(allocation.Cap) {
+		return errorsmod.Wrapf(types.ErrPresaleNotAllowed, "exceeds presale cap: cap: %s, bought: %s", allocation.Cap, allocation.Bought)
+	}
+
+	k.SetPresaleAllocation(ctx, allocation)
+	return nil
+}
@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	"github.com/dymensionxyz/dymension/v3/x/iro/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+func (s *KeeperTestSuite) TestPresale() {
+	rollappId := s.CreateDefaultRollapp()
+	owner := s.App.RollappKeeper.MustGetRollappOwner(s.Ctx, rollappId)
+	k := s.App.IROKeeper
+
+	allowed := sample.Acc()
+	proven := sample.Acc()
+	stranger := sample.Acc()
+	for _, acc := range []sdk.AccAddress{allowed, proven, stranger} {
+		s.FundAcc(acc, sdk.NewCoins(sdk.NewCoin("adym", math.NewInt(100_000).MulRaw(1e18))))
+	}
+
+	// merkle tree with two leaves: proven's and another address
+	provenCap := math.NewInt(120).MulRaw(1e18)
+	provenLeaf := types.PresaleLeaf(proven.String(), provenCap)
+	otherLeaf := types.PresaleLeaf(sample.AccAddress(), math.NewInt(1).MulRaw(1e18))
+	root := types.PresaleNode(provenLeaf, otherLeaf)
+
+	maxPerAddress := math.NewInt(100).MulRaw(1e18)
+	presale := types.Presale{
+		Allowlist:     []string{allowed.String()},
+		MerkleRoot:    root,
+		MaxPerAddress: maxPerAddress,
+		MaxSoldAmt:    math.ZeroInt(),
+		Duration:      10 * time.Minute,
+	}
+
+	startTime := time.Now()
+	maxAmt := math.NewInt(1_000_000_000).MulRaw(1e18)
+	totalAllocation := math.NewInt(1_000_000).MulRaw(1e18)
+	rollapp, _ := s.App.RollappKeeper.GetRollapp(s.Ctx, rollappId)
+	planId, err := k.CreatePlan(s.Ctx, "adym", totalAllocation, time.Hour, startTime, true, rollapp, types.DefaultBondingCurve(), types.DefaultIncentivePlanParams(), types.DefaultParams().MinLiquidityPart, time.Hour, 0, keeper.CreatePlanOpts{Presale: presale})
+	s.Require().NoError(err)
+	plan := k.MustGetPlan(s.Ctx, planId)
+	s.Require().True(startTime.Add(presale.Duration).Equal(plan.Presale.EndTime))
+
+	// the allowlist is kept as presale allocations
+	s.Require().Empty(plan.Presale.Allowlist)
+	allocation, found := k.GetPresaleAllocation(s.Ctx, planId, allowed.String())
+	s.Require().True(found)
+	s.Require().Equal(maxPerAddress, allocation.Cap)
+
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute))
+	buyAmt := math.NewInt(60).MulRaw(1e18)
+
+	// not allowed address can't buy
+	err = k.Buy(s.Ctx, planId, stranger, buyAmt, maxAmt, nil)
+	s.Require().ErrorIs(err, types.ErrPresaleNotAllowed)
+
+	// owner is not restricted
+	s.FundAcc(owner, sdk.NewCoins(sdk.NewCoin("adym", math.NewInt(100_000).MulRaw(1e18))))
+	err = k.Buy(s.Ctx, planId, owner, buyAmt, maxAmt, nil)
+	s.Require().NoError(err)
+
+	// allowlisted address can buy up to the max per address
+	err = k.Buy(s.Ctx, planId, allowed, buyAmt, maxAmt, nil)
+	s.Require().NoError(err)
+	err = k.Buy(s.Ctx, planId, allowed, buyAmt, maxAmt, nil)
+	s.Require().ErrorIs(err, types.ErrPresaleNotAllowed)
+
+	// an inner node is not a leaf
+	s.Require().False(types.VerifyPresaleProof(types.PresaleNode(root, otherLeaf), proven.String(), provenCap, nil))
+
+	// a wrong cap does not prove membership
+	_, err = s.msgServer.Buy(s.Ctx, &types.MsgBuy{
+		Buyer:         proven.String(),
+		PlanId:        planId,
+		Amount:        buyAmt,
+		MaxCostAmount: maxAmt,
+		PresaleProof:  &types.PresaleProof{Cap: provenCap.AddRaw(1), Proof: [][]byte{otherLeaf}},
+	})
+	s.Require().Error(err)
+
+	// proven address can buy up to its own cap, and the proof is only needed once
+	_, err = s.msgServer.Buy(s.Ctx, &types.MsgBuy{
+		Buyer:         proven.String(),
+		PlanId:        planId,
+		Amount:        buyAmt,
+		MaxCostAmount: maxAmt,
+		PresaleProof:  &types.PresaleProof{Cap: provenCap, Proof: [][]byte{otherLeaf}},
+	})
+	s.Require().NoError(err)
+	err = k.Buy(s.Ctx, planId, proven, buyAmt, maxAmt, nil)
+	s.Require().NoError(err)
+	err = k.Buy(s.Ctx, planId, proven, buyAmt, maxAmt, nil)
+	s.Require().ErrorIs(err, types.ErrPresaleNotAllowed)
+
+	allocation, found = k.GetPresaleAllocation(s.Ctx, planId, proven.String())
+	s.Require().True(found)
+	s.Require().Equal(provenCap, allocation.Cap)
+	s.Require().Equal(buyAmt.MulRaw(2), allocation.Bought)
+
+	// after the presale, everyone can buy, but proofs are rejected
+	s.Ctx = s.Ctx.WithBlockTime(plan.Presale.EndTime)
+	err = k.ProvePresaleAllocation(s.Ctx, planId, stranger, provenCap, [][]byte{otherLeaf})
+	s.Require().ErrorIs(err, gerrc.ErrFailedPrecondition)
+	err = k.Buy(s.Ctx, planId, stranger, buyAmt, maxAmt, nil)
+	s.Require().NoError(err)
+	err = k.Buy(s.Ctx, planId, allowed, buyAmt, maxAmt, nil)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestPresaleMaxSold() {
+	rollappId := s.CreateDefaultRollapp()
+	k := s.App.IROKeeper
+
+	buyer := sample.Acc()
+	s.FundAcc(buyer, sdk.NewCoins(sdk.NewCoin("adym", math.NewInt(100_000).MulRaw(1e18))))
+
+	startTime := time.Now()
+	maxAmt := math.NewInt(1_000_000_000).MulRaw(1e18)
+	totalAllocation := math.NewInt(1_000_000).MulRaw(1e18)
+	rollapp, _ := s.App.RollappKeeper.GetRollapp(s.Ctx, rollappId)
+	presale := types.Presale{
+		Allowlist:     []string{buyer.String()},
+		MaxPerAddress: math.NewInt(1_000).MulRaw(1e18),
+		MaxSoldAmt:    math.ZeroInt(),
+		Duration:      10 * time.Minute,
+	}
+	planId, err := k.CreatePlan(s.Ctx, "adym", totalAllocation, time.Hour, startTime, true, rollapp, types.DefaultBondingCurve(), types.DefaultIncentivePlanParams(), types.DefaultParams().MinLiquidityPart, time.Hour, 0, keeper.CreatePlanOpts{Presale: presale})
+	s.Require().NoError(err)
+
+	// bound the presale to 100 tokens past the reserved creation fee
+	plan := k.MustGetPlan(s.Ctx, planId)
+	plan.Presale.MaxSoldAmt = plan.SoldAmt.Add(math.NewInt(100).MulRaw(1e18))
+	k.SetPlan(s.Ctx, plan)
+
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute))
+	err = k.Buy(s.Ctx, planId, buyer, math.NewInt(101).MulRaw(1e18), maxAmt, nil)
+	s.Require().ErrorIs(err, types.ErrInsufficientTokens)
+	err = k.Buy(s.Ctx, planId, buyer, math.NewInt(100).MulRaw(1e18), maxAmt, nil)
+	s.Require().NoError(err)
+}
@@ -146,6 +146,28 @@ func (k Keeper) QuerySpotPrice(goCtx context.Context, req *types.QuerySpotPriceR
 	}, nil
 }
 
+// QueryCandles implements types.QueryServer.
+func (k Keeper) QueryCandles(goCtx context.Context, req *types.QueryCandlesRequest) (*types.QueryCandlesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if !req.Resolution.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "invalid resolution")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetPlan(ctx, req.PlanId); !found {
+		return nil, status.Error(codes.NotFound, "plan not found")
+	}
+
+	candles, pageRes, err := k.GetCandlesPaginated(ctx, req.PlanId, req.Resolution, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCandlesResponse{Candles: candles, Pagination: pageRes}, nil
+}
+
 // QueryVesting implements types.QueryServer.
 func (k Keeper) QueryVesting(goCtx context.Context, req *types.QueryVestingRequest) (*types.QueryVestingResponse, error) {
 	if req == nil {
@@ -170,3 +192,67 @@ func (k Keeper) QueryVesting(goCtx context.Context, req *types.QueryVestingReque
 	}
 	return response, nil
 }
+
+// QueryPurchased implements types.QueryServer.
+func (k Keeper) QueryPurchased(goCtx context.Context, req *types.QueryPurchasedRequest) (*types.QueryPurchasedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	plan, found := k.GetPlan(ctx, req.PlanId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "plan not found")
+	}
+
+	return &types.QueryPurchasedResponse{
+		Purchased: k.GetPurchased(ctx, req.PlanId, req.Address),
+		Cap:       plan.TradingLimits.AddressCap(plan.StartTime, ctx.BlockTime()),
+	}, nil
+}
+
+// QueryTeamAllocations implements types.QueryServer.
+func (k Keeper) QueryTeamAllocations(goCtx context.Context, req *types.QueryTeamAllocationsRequest) (*types.QueryTeamAllocationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	plan, found := k.GetPlan(ctx, req.PlanId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "plan not found")
+	}
+
+	allocations := make([]types.TeamAllocationStatus, 0, len(plan.TeamAllocations))
+	for _, a := range plan.TeamAllocations {
+		allocations = append(allocations, a.Status(ctx.BlockTime()))
+	}
+	return &types.QueryTeamAllocationsResponse{Allocations: allocations}, nil
+}
+
+// QueryReferrals implements types.QueryServer.
+func (k Keeper) QueryReferrals(goCtx context.Context, req *types.QueryReferralsRequest) (*types.QueryReferralsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetPlan(ctx, req.PlanId); !found {
+		return nil, status.Error(codes.NotFound, "plan not found")
+	}
+
+	if req.Referrer != "" {
+		referral, found := k.GetReferral(ctx, req.PlanId, req.Referrer)
+		if !found {
+			return nil, status.Error(codes.NotFound, "referral not found")
+		}
+		return &types.QueryReferralsResponse{Referrals: []types.Referral{referral}}, nil
+	}
+
+	referrals, pageRes, err := k.GetReferralsPaginated(ctx, req.PlanId, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryReferralsResponse{Referrals: referrals, Pagination: pageRes}, nil
+}
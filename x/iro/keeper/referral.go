@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+// SetReferral sets the referral stats of a referrer in a plan
+func (k Keeper) SetReferral(ctx sdk.Context, r types.Referral) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ReferralKey(r.PlanId, r.Referrer), k.cdc.MustMarshal(&r))
+}
+
+// GetReferral returns the referral stats of a referrer in a plan
+func (k Keeper) GetReferral(ctx sdk.Context, planId, referrer string) (val types.Referral, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.ReferralKey(planId, referrer))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllReferrals returns the referrals of all plans
+func (k Keeper) GetAllReferrals(ctx sdk.Context) (list []types.Referral) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ReferralKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Referral
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetReferralsPaginated returns the referrals of a plan
+func (k Keeper) GetReferralsPaginated(ctx sdk.Context, planId string, pageReq *query.PageRequest) (list []types.Referral, pageRes *query.PageResponse, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ReferralsKeyPrefix(planId))
+
+	pageRes, err = query.Paginate(store, pageReq, func(key []byte, value []byte) error {
+		var val types.Referral
+		if er := k.cdc.Unmarshal(value, &val); er != nil {
+			return er
+		}
+
+		list = append(list, val)
+		return nil
+	})
+
+	return
+}
+
+// payReferralFee pays the referrer's share of the taker fee from the trader, and records the referred volume.
+// It returns the taker fee left to charge. A nil referrer leaves the taker fee as is.
+//
+// Any address can be a referrer. Only referring the trader itself is rejected, as a second address of the
+// trader can't be told apart from another user: this is accepted, and gives the trader a discount of at most
+// the referral fee share of the taker fee.
+func (k Keeper) payReferralFee(ctx sdk.Context, plan types.Plan, trader, referrer sdk.AccAddress, takerFee sdk.Coin, volume math.Int) (sdk.Coin, error) {
+	if referrer.Empty() {
+		return takerFee, nil
+	}
+	if referrer.Equals(trader) {
+		return takerFee, types.ErrSelfReferral
+	}
+
+	feeAmt := math.ZeroInt()
+	if share := k.GetParams(ctx).ReferralFeeShare; !share.IsNil() {
+		feeAmt = share.MulInt(takerFee.Amount).TruncateInt()
+	}
+	fee := sdk.NewCoin(takerFee.Denom, feeAmt)
+	if fee.IsPositive() {
+		err := k.BK.SendCoins(ctx, trader, referrer, sdk.NewCoins(fee))
+		if err != nil {
+			return takerFee, err
+		}
+	}
+
+	planId := fmt.Sprintf("%d", plan.Id)
+	referral, found := k.GetReferral(ctx, planId, referrer.String())
+	if !found {
+		referral = types.NewReferral(planId, referrer.String())
+	}
+	referral.Volume = referral.Volume.Add(volume)
+	referral.Earnings = referral.Earnings.Add(feeAmt)
+	k.SetReferral(ctx, referral)
+
+	err := uevent.EmitTypedEvent(ctx, &types.EventReferral{
+		Referrer:  referrer.String(),
+		Trader:    trader.String(),
+		PlanId:    planId,
+		RollappId: plan.RollappId,
+		Volume:    sdk.NewCoin(takerFee.Denom, volume),
+		Fee:       fee,
+	})
+	if err != nil {
+		return takerFee, err
+	}
+
+	return takerFee.Sub(fee), nil
+}
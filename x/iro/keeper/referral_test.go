@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	"github.com/dymensionxyz/dymension/v3/x/iro/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+// TestReferral tests that referrers earn their share of the taker fee of the trades they refer,
+// and that their volume and earnings are recorded per plan.
+func (s *KeeperTestSuite) TestReferral() {
+	rollappId := s.CreateDefaultRollapp()
+	k := s.App.IROKeeper
+	// Bonding curve with fixed price (1 token = 1 adym)
+	curve := types.BondingCurve{
+		M:                      math.LegacyMustNewDecFromStr("0"),
+		N:                      math.LegacyMustNewDecFromStr("1"),
+		C:                      math.LegacyMustNewDecFromStr("1"),
+		RollappDenomDecimals:   18,
+		LiquidityDenomDecimals: 18,
+	}
+
+	startTime := time.Now()
+	rollapp, _ := s.App.RollappKeeper.GetRollapp(s.Ctx, rollappId)
+	planId, err := k.CreatePlan(s.Ctx, "adym", math.NewInt(1_000_000).MulRaw(1e18), time.Hour, startTime, true, rollapp, curve, types.DefaultIncentivePlanParams(), types.DefaultParams().MinLiquidityPart, time.Hour, 0, keeper.CreatePlanOpts{})
+	s.Require().NoError(err)
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute))
+
+	buyer := sample.Acc()
+	referrer := sample.Acc()
+	s.FundAcc(buyer, sdk.NewCoins(sdk.NewCoin("adym", math.NewInt(100_000).MulRaw(1e18))))
+	params := k.GetParams(s.Ctx)
+
+	// self referral is rejected
+	buyAmt := math.NewInt(1_000).MulRaw(1e18)
+	err = k.Buy(s.Ctx, planId, buyer, buyAmt, buyAmt.MulRaw(2), buyer)
+	s.Require().ErrorIs(err, types.ErrSelfReferral)
+
+	// the referrer gets its share of the taker fee
+	err = k.Buy(s.Ctx, planId, buyer, buyAmt, buyAmt.MulRaw(2), referrer)
+	s.Require().NoError(err)
+	buyFee := params.ReferralFeeShare.MulInt(s.TakerFeeAmtAfterBuy()).TruncateInt()
+	s.Require().True(buyFee.IsPositive())
+	s.Require().Equal(buyFee, s.App.BankKeeper.GetBalance(s.Ctx, referrer, "adym").Amount)
+
+	sellAmt := math.NewInt(500).MulRaw(1e18)
+	err = k.Sell(s.Ctx, planId, buyer, sellAmt, math.OneInt(), referrer)
+	s.Require().NoError(err)
+	sellFee := params.ReferralFeeShare.MulInt(s.TakerFeeAmtAfterSell()).TruncateInt()
+	s.Require().Equal(buyFee.Add(sellFee), s.App.BankKeeper.GetBalance(s.Ctx, referrer, "adym").Amount)
+
+	// the volume and earnings are recorded
+	res, err := k.QueryReferrals(s.Ctx, &types.QueryReferralsRequest{PlanId: planId, Referrer: referrer.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.Referrals, 1)
+	s.Require().Equal(buyAmt.Add(sellAmt), res.Referrals[0].Volume)
+	s.Require().Equal(buyFee.Add(sellFee), res.Referrals[0].Earnings)
+
+	// trades without referrer are not recorded
+	err = k.Buy(s.Ctx, planId, buyer, buyAmt, buyAmt.MulRaw(2), nil)
+	s.Require().NoError(err)
+	res, err = k.QueryReferrals(s.Ctx, &types.QueryReferralsRequest{PlanId: planId})
+	s.Require().NoError(err)
+	s.Require().Len(res.Referrals, 1)
+
+	// no referral fee when disabled, but the volume is still recorded
+	params.ReferralFeeShare = math.LegacyZeroDec()
+	k.SetParams(s.Ctx, params)
+	err = k.Buy(s.Ctx, planId, buyer, buyAmt, buyAmt.MulRaw(2), referrer)
+	s.Require().NoError(err)
+	s.Require().Equal(buyFee.Add(sellFee), s.App.BankKeeper.GetBalance(s.Ctx, referrer, "adym").Amount)
+	referral, found := k.GetReferral(s.Ctx, planId, referrer.String())
+	s.Require().True(found)
+	s.Require().Equal(buyAmt.MulRaw(2).Add(sellAmt), referral.Volume)
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+// Refund refunds the IRO tokens of a failed plan for the raised liquidity
+//
+// This function allows a user to get their liquidity back once the plan was not settled by its deadline.
+// It burns *all* the IRO tokens the refunder has, and sends the pro-rata share of the raised liquidity
+// in return. Taker fees already paid are not refunded, and the liquidity paid by the owner for the
+// creation fee is shared among the buyers.
+// The first refund also burns the unsold allocation left in the module account.
+func (k Keeper) Refund(ctx sdk.Context, planId string, refunder sdk.AccAddress) error {
+	plan, found := k.GetPlan(ctx, planId)
+	if !found {
+		return types.ErrPlanNotFound
+	}
+
+	if !plan.IsFailed(ctx.BlockTime()) {
+		return errorsmod.Wrapf(types.ErrPlanNotFailed, "deadline: %s", plan.SettlementDeadline)
+	}
+
+	err := k.burnUnsoldAllocation(ctx, plan)
+	if err != nil {
+		return err
+	}
+
+	availableTokens := k.BK.GetBalance(ctx, refunder, plan.TotalAllocation.Denom)
+	if availableTokens.IsZero() {
+		return types.ErrNoTokensToRefund
+	}
+
+	// all the tokens not claimed yet are held by buyers, and share the raised liquidity pro-rata
+	outstanding := plan.SoldAmt.Sub(plan.ClaimedAmt)
+	if availableTokens.Amount.GT(outstanding) {
+		return errorsmod.Wrapf(gerrc.ErrInternal, "refunded tokens exceed outstanding tokens: refunded: %s, outstanding: %s", availableTokens.Amount, outstanding)
+	}
+	raisedLiquidityAmt := k.BK.GetBalance(ctx, plan.GetAddress(), plan.LiquidityDenom).Amount
+	refundAmt := raisedLiquidityAmt.Mul(availableTokens.Amount).Quo(outstanding)
+
+	// Burn all the IRO tokens the user have
+	err = k.BK.SendCoinsFromAccountToModule(ctx, refunder, types.ModuleName, sdk.NewCoins(availableTokens))
+	if err != nil {
+		return err
+	}
+	err = k.BK.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(availableTokens))
+	if err != nil {
+		return err
+	}
+
+	// Give the user the share of the raised liquidity
+	refund := sdk.NewCoin(plan.LiquidityDenom, refundAmt)
+	err = k.BK.SendCoins(ctx, plan.GetAddress(), refunder, sdk.NewCoins(refund))
+	if err != nil {
+		return err
+	}
+
+	// Update the plan. Refunded tokens are accounted as claimed.
+	plan.ClaimedAmt = plan.ClaimedAmt.Add(availableTokens.Amount)
+	k.SetPlan(ctx, plan)
+
+	// Emit event
+	err = uevent.EmitTypedEvent(ctx, &types.EventRefund{
+		Refunder:  refunder.String(),
+		PlanId:    planId,
+		RollappId: plan.RollappId,
+		Burned:    availableTokens,
+		Refund:    refund,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// burnUnsoldAllocation burns the IRO tokens left in the module account for a failed plan.
+// Trading is closed once the plan failed, so the balance is only positive on the first call.
+func (k Keeper) burnUnsoldAllocation(ctx sdk.Context, plan types.Plan) error {
+	unsold := k.BK.GetBalance(ctx, k.AK.GetModuleAddress(types.ModuleName), plan.TotalAllocation.Denom)
+	if unsold.IsZero() {
+		return nil
+	}
+
+	err := k.BK.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(unsold))
+	if err != nil {
+		return err
+	}
+
+	return uevent.EmitTypedEvent(ctx, &types.EventPlanFailed{
+		PlanId:    fmt.Sprintf("%d", plan.Id),
+		RollappId: plan.RollappId,
+		Burned:    unsold,
+	})
+}
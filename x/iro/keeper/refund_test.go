@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	"github.com/dymensionxyz/dymension/v3/x/iro/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+// TestRefund tests that buyers of a plan that was not settled by its deadline
+// get the raised liquidity back pro-rata, and the plan can't be traded or settled anymore.
+// Enabling the transfers of the rollapp doesn't fail, but burns the rollapp tokens of the plan.
+func (s *KeeperTestSuite) TestRefund() {
+	rollappId := s.CreateDefaultRollapp()
+	owner := s.App.RollappKeeper.MustGetRollappOwner(s.Ctx, rollappId)
+	k := s.App.IROKeeper
+	rollappDenom := "dasdasdasdasdsa"
+
+	startTime := time.Now()
+	amt := math.NewInt(1_000_000).MulRaw(1e18)
+	rollapp, _ := s.App.RollappKeeper.GetRollapp(s.Ctx, rollappId)
+	planId, err := k.CreatePlan(s.Ctx, "adym", amt, time.Hour, startTime, true, rollapp, types.DefaultBondingCurve(), types.DefaultIncentivePlanParams(), types.DefaultParams().MinLiquidityPart, time.Hour, 0, keeper.CreatePlanOpts{})
+	s.Require().NoError(err)
+	plan := k.MustGetPlan(s.Ctx, planId)
+	s.Require().Equal(plan.PreLaunchTime.Add(types.DefaultSettlementGracePeriod), plan.SettlementDeadline)
+	planDenom := plan.TotalAllocation.Denom
+
+	alice := sample.Acc()
+	bob := sample.Acc()
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute))
+	s.BuySomeTokens(planId, alice, math.NewInt(1_000).MulRaw(1e18))
+	s.BuySomeTokens(planId, bob, math.NewInt(1_500).MulRaw(1e18))
+
+	// refund should fail before the deadline
+	err = k.Refund(s.Ctx, planId, alice)
+	s.Require().ErrorIs(err, types.ErrPlanNotFailed)
+
+	// past the deadline, the plan can't be traded
+	s.Ctx = s.Ctx.WithBlockTime(plan.SettlementDeadline)
+	err = k.Buy(s.Ctx, planId, alice, math.NewInt(1).MulRaw(1e18), math.NewInt(1_000_000).MulRaw(1e18), nil)
+	s.Require().ErrorIs(err, types.ErrPlanFailed)
+	err = k.Sell(s.Ctx, planId, alice, math.NewInt(1).MulRaw(1e18), math.OneInt(), nil)
+	s.Require().ErrorIs(err, types.ErrPlanFailed)
+
+	// the transfers are enabled, but the plan is not settled
+	s.FundModuleAcc(types.ModuleName, sdk.NewCoins(sdk.NewCoin(rollappDenom, amt)))
+	err = k.AfterTransfersEnabled(s.Ctx, rollappId, rollappDenom)
+	s.Require().NoError(err)
+	s.Require().False(k.MustGetPlan(s.Ctx, planId).IsSettled())
+	s.Require().True(s.App.BankKeeper.GetSupply(s.Ctx, rollappDenom).IsZero())
+
+	// refund gives a pro-rata share of the raised liquidity and burns the unsold allocation
+	plan = k.MustGetPlan(s.Ctx, planId)
+	raised := s.App.BankKeeper.GetBalance(s.Ctx, plan.GetAddress(), "adym").Amount
+	aliceTokens := s.App.BankKeeper.GetBalance(s.Ctx, alice, planDenom).Amount
+	aliceLiquidity := s.App.BankKeeper.GetBalance(s.Ctx, alice, "adym").Amount
+
+	err = k.Refund(s.Ctx, planId, alice)
+	s.Require().NoError(err)
+
+	expected := raised.Mul(aliceTokens).Quo(plan.SoldAmt.Sub(plan.ClaimedAmt))
+	s.Require().Equal(aliceLiquidity.Add(expected), s.App.BankKeeper.GetBalance(s.Ctx, alice, "adym").Amount)
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, alice, planDenom).IsZero())
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, k.AK.GetModuleAddress(types.ModuleName), planDenom).IsZero())
+
+	// nothing left to refund
+	err = k.Refund(s.Ctx, planId, alice)
+	s.Require().ErrorIs(err, types.ErrNoTokensToRefund)
+
+	// the owner's creation fee is not refundable
+	err = k.Refund(s.Ctx, planId, owner)
+	s.Require().ErrorIs(err, types.ErrNoTokensToRefund)
+
+	// once everyone refunded, the raised liquidity is fully returned
+	err = k.Refund(s.Ctx, planId, bob)
+	s.Require().NoError(err)
+
+	plan = k.MustGetPlan(s.Ctx, planId)
+	s.Require().Equal(plan.SoldAmt, plan.ClaimedAmt)
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, plan.GetAddress(), "adym").IsZero())
+	s.Require().True(s.App.BankKeeper.GetSupply(s.Ctx, planDenom).IsZero())
+}
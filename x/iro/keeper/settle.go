@@ -18,17 +18,41 @@ import (
 
 // AfterTransfersEnabled called by the genesis transfer IBC module when a transfer is handled
 // This is a rollapp module hook
+//
+// A plan which failed by its settlement deadline is not settled, as its buyers are refunded instead.
+// The hook must not fail in that case, as it would fail the genesis transfer and leave the bridge closed,
+// so the rollapp tokens of the plan are burned.
 func (k Keeper) AfterTransfersEnabled(ctx sdk.Context, rollappId, rollappIBCDenom string) error {
+	plan, found := k.GetPlanByRollapp(ctx, rollappId)
+	if found && plan.IsFailed(ctx.BlockTime()) {
+		return k.burnFailedPlanAllocation(ctx, plan, rollappIBCDenom)
+	}
 	return k.Settle(ctx, rollappId, rollappIBCDenom)
 }
 
+// burnFailedPlanAllocation burns the rollapp tokens transferred for a failed plan, which has nothing left to back
+func (k Keeper) burnFailedPlanAllocation(ctx sdk.Context, plan types.Plan, rollappIBCDenom string) error {
+	balance := k.BK.GetBalance(ctx, k.AK.GetModuleAddress(types.ModuleName), rollappIBCDenom)
+	burned := sdk.NewCoin(rollappIBCDenom, math.MinInt(balance.Amount, plan.TotalAllocation.Amount))
+	if burned.IsZero() {
+		return nil
+	}
+	if err := k.BK.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(burned)); err != nil {
+		return err
+	}
+	k.Logger(ctx).Info("Burned the allocation of a failed plan.", "planId", plan.Id, "rollappId", plan.RollappId, "burned", burned)
+	return nil
+}
+
 // Settle settles the iro plan with the given rollappId
 //
 // This function performs the following steps:
+// - Validates that the plan has not failed to settle by its deadline.
 // - Validates that the "TotalAllocation.Amount" of the RA token are available in the module account.
 // - Burns any unsold FUT tokens in the module account.
 // - Marks the plan as settled, allowing users to claim tokens.
 // - Starts the vesting schedule for the owner tokens.
+// - Locks the team allocations in the plan's module account and starts their vesting schedule.
 // - Uses the raised liquidity and unsold tokens to bootstrap the rollapp's liquidity pool.
 func (k Keeper) Settle(ctx sdk.Context, rollappId, rollappIBCDenom string) error {
 	plan, found := k.GetPlanByRollapp(ctx, rollappId)
@@ -40,6 +64,11 @@ func (k Keeper) Settle(ctx sdk.Context, rollappId, rollappIBCDenom string) error
 		return errorsmod.Wrapf(errors.Join(gerrc.ErrInternal, types.ErrPlanSettled), "rollappId: %s", rollappId)
 	}
 
+	// buyers may already be refunding, so a failed plan can't be settled anymore
+	if plan.IsFailed(ctx.BlockTime()) {
+		return errorsmod.Wrapf(types.ErrPlanFailed, "rollappId: %s, deadline: %s", rollappId, plan.SettlementDeadline)
+	}
+
 	// validate the required funds are available in the module account
 	// funds expected as it's validated in the genesis transfer handler
 	balance := k.BK.GetBalance(ctx, k.AK.GetModuleAddress(types.ModuleName), rollappIBCDenom)
@@ -63,6 +92,18 @@ func (k Keeper) Settle(ctx sdk.Context, rollappId, rollappIBCDenom string) error
 	plan.VestingPlan.StartTime = ctx.BlockHeader().Time.Add(plan.VestingPlan.StartTimeAfterSettlement)
 	plan.VestingPlan.EndTime = plan.VestingPlan.StartTime.Add(plan.VestingPlan.VestingDuration)
 
+	// lock the team allocations in the plan's module account, vesting from now
+	teamAmt := plan.TeamAllocationsTotal()
+	if teamAmt.IsPositive() {
+		err = k.BK.SendCoinsFromModuleToAccount(ctx, types.ModuleName, plan.GetAddress(), sdk.NewCoins(sdk.NewCoin(rollappIBCDenom, teamAmt)))
+		if err != nil {
+			return err
+		}
+	}
+	for i := range plan.TeamAllocations {
+		plan.TeamAllocations[i].StartTime = ctx.BlockTime()
+	}
+
 	// mark the plan as `settled`, allowing users to claim tokens
 	plan.SettledDenom = rollappIBCDenom
 	k.SetPlan(ctx, plan)
@@ -100,8 +141,8 @@ func (k Keeper) bootstrapLiquidityPool(ctx sdk.Context, plan types.Plan, poolTok
 	// claimable amount is kept in the module account and used for user's claims
 	claimableAmt := plan.SoldAmt.Sub(plan.ClaimedAmt)
 
-	// the remaining tokens are used to bootstrap the liquidity pool
-	unallocatedTokens := plan.TotalAllocation.Amount.Sub(claimableAmt)
+	// the remaining tokens, apart from the team allocations, are used to bootstrap the liquidity pool
+	unallocatedTokens := plan.SellableAllocation().Sub(claimableAmt)
 
 	// send the raised liquidity token to the iro module as it will be used as the pool creator
 	err = k.BK.SendCoinsFromAccountToModule(ctx, plan.GetAddress(), types.ModuleName, sdk.NewCoins(sdk.NewCoin(plan.LiquidityDenom, poolTokens)))
@@ -110,21 +151,26 @@ func (k Keeper) bootstrapLiquidityPool(ctx sdk.Context, plan types.Plan, poolTok
 	}
 
 	// find the raTokens needed to bootstrap the pool, to fulfill last price
-	raTokens, liquidityTokens := types.CalcLiquidityPoolTokens(unallocatedTokens, poolTokens, plan.SpotPrice())
+	rollappWeight, liquidityWeight := plan.PoolParams.Weights()
+	raTokens, liquidityTokens := types.CalcLiquidityPoolTokens(unallocatedTokens, poolTokens, plan.SpotPrice(), rollappWeight, liquidityWeight)
 	rollappLiquidityCoin := sdk.NewCoin(plan.SettledDenom, raTokens)
 	baseLiquidityCoin := sdk.NewCoin(plan.LiquidityDenom, liquidityTokens)
 
-	// create pool
+	// create pool, with the plan's swap fee if set
 	gammGlobalParams := k.gk.GetParams(ctx).GlobalFees
-	poolParams := balancer.NewPoolParams(gammGlobalParams.SwapFee, gammGlobalParams.ExitFee, nil)
+	swapFee := gammGlobalParams.SwapFee
+	if plan.PoolParams.HasCustomSwapFee() {
+		swapFee = *plan.PoolParams.SwapFee
+	}
+	poolParams := balancer.NewPoolParams(swapFee, gammGlobalParams.ExitFee, nil)
 	balancerPool := balancer.NewMsgCreateBalancerPool(k.AK.GetModuleAddress(types.ModuleName), poolParams, []balancer.PoolAsset{
 		{
 			Token:  baseLiquidityCoin,
-			Weight: math.OneInt(),
+			Weight: liquidityWeight,
 		},
 		{
 			Token:  rollappLiquidityCoin,
-			Weight: math.OneInt(),
+			Weight: rollappWeight,
 		},
 	}, "")
 
@@ -10,6 +10,7 @@ import (
 	"github.com/dymensionxyz/dymension/v3/app/apptesting"
 	"github.com/dymensionxyz/dymension/v3/testutil/sample"
 	incentivestypes "github.com/dymensionxyz/dymension/v3/x/incentives/types"
+	"github.com/dymensionxyz/dymension/v3/x/iro/keeper"
 	"github.com/dymensionxyz/dymension/v3/x/iro/types"
 )
 
@@ -25,7 +26,7 @@ func (s *KeeperTestSuite) TestSettle() {
 	liquidityPart := types.DefaultParams().MinLiquidityPart
 
 	rollapp := s.App.RollappKeeper.MustGetRollapp(s.Ctx, rollappId)
-	planId, err := k.CreatePlan(s.Ctx, "adym", amt, time.Hour, startTime, true, rollapp, curve, incentives, liquidityPart, time.Hour, 0)
+	planId, err := k.CreatePlan(s.Ctx, "adym", amt, time.Hour, startTime, true, rollapp, curve, incentives, liquidityPart, time.Hour, 0, keeper.CreatePlanOpts{})
 	s.Require().NoError(err)
 	planDenom := k.MustGetPlan(s.Ctx, planId).TotalAllocation.Denom
 
@@ -73,7 +74,8 @@ func (s *KeeperTestSuite) TestBootstrapLiquidityPool() {
 	allocation := math.NewInt(1_000_000).MulRaw(1e18)
 	rollappDenom := "dasdasdasdasdsa"
 	liquidityPart := types.DefaultParams().MinLiquidityPart
-	maxToSell := types.FindEquilibrium(curve, allocation, liquidityPart)
+	maxToSell, err := types.FindEquilibrium(curve, allocation, liquidityPart)
+	s.Require().NoError(err)
 
 	testCases := []struct {
 		name           string
@@ -118,7 +120,7 @@ func (s *KeeperTestSuite) TestBootstrapLiquidityPool() {
 			// Create IRO plan
 			planDenom := "adym"
 			apptesting.FundAccount(s.App, s.Ctx, sdk.MustAccAddressFromBech32(rollapp.Owner), sdk.NewCoins(sdk.NewCoin(planDenom, k.GetParams(s.Ctx).CreationFee)))
-			planId, err := k.CreatePlan(s.Ctx, "adym", allocation, time.Hour, startTime, true, rollapp, curve, types.DefaultIncentivePlanParams(), liquidityPart, time.Hour, 0)
+			planId, err := k.CreatePlan(s.Ctx, "adym", allocation, time.Hour, startTime, true, rollapp, curve, types.DefaultIncentivePlanParams(), liquidityPart, time.Hour, 0, keeper.CreatePlanOpts{})
 			s.Require().NoError(err)
 			reservedTokens := k.MustGetPlan(s.Ctx, planId).SoldAmt
 
@@ -180,3 +182,59 @@ func (s *KeeperTestSuite) TestBootstrapLiquidityPool() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestBootstrapWeightedLiquidityPool() {
+	curve := types.BondingCurve{
+		M:                      math.LegacyMustNewDecFromStr("0"),
+		N:                      math.LegacyMustNewDecFromStr("1"),
+		C:                      math.LegacyMustNewDecFromStr("0.1"), // each token costs 0.1 DYM
+		RollappDenomDecimals:   18,
+		LiquidityDenomDecimals: 18,
+	}
+
+	rollappId := s.CreateDefaultRollapp()
+	rollapp := s.App.RollappKeeper.MustGetRollapp(s.Ctx, rollappId)
+	k := s.App.IROKeeper
+
+	startTime := time.Now()
+	allocation := math.NewInt(1_000_000).MulRaw(1e18)
+	rollappDenom := "dasdasdasdasdsa"
+	liquidityPart := types.DefaultParams().MinLiquidityPart
+
+	weight := math.LegacyMustNewDecFromStr("0.8")
+	swapFee := math.LegacyMustNewDecFromStr("0.01")
+	poolParams := types.SettlementPoolParams{RollappTokenWeight: &weight, SwapFee: &swapFee}
+	planId, err := k.CreatePlan(s.Ctx, "adym", allocation, time.Hour, startTime, true, rollapp, curve, types.DefaultIncentivePlanParams(), liquidityPart, time.Hour, 0, keeper.CreatePlanOpts{PoolParams: poolParams})
+	s.Require().NoError(err)
+
+	// the pool needs a quarter of the liquidity per token compared to equal weights, so less tokens are sold
+	plan := k.MustGetPlan(s.Ctx, planId)
+	equalWeights, err := types.FindEquilibrium(curve, allocation, liquidityPart)
+	s.Require().NoError(err)
+	s.Require().True(plan.MaxAmountToSell.LT(equalWeights))
+	scaled, err := types.FindEquilibrium(curve, allocation, liquidityPart.MulInt64(4))
+	s.Require().NoError(err)
+	s.Require().Equal(scaled, plan.MaxAmountToSell)
+
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute))
+	s.BuySomeTokens(planId, sample.Acc(), math.NewInt(999).MulRaw(1e18))
+	plan = k.MustGetPlan(s.Ctx, planId)
+
+	s.FundModuleAcc(types.ModuleName, sdk.NewCoins(sdk.NewCoin(rollappDenom, allocation)))
+	err = k.Settle(s.Ctx, rollappId, rollappDenom)
+	s.Require().NoError(err)
+
+	pool, err := s.App.GAMMKeeper.GetPool(s.Ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(swapFee, pool.GetSwapFee(s.Ctx))
+
+	// the pool keeps the last price of the plan
+	price, err := pool.SpotPrice(s.Ctx, "adym", rollappDenom)
+	s.Require().NoError(err)
+	s.Require().True(price.Sub(plan.SpotPrice()).Abs().LTE(math.LegacyNewDecWithPrec(1, 12)), "pool price: %s, plan price: %s", price, plan.SpotPrice())
+
+	// all the pool liquidity is used
+	poolCoins := pool.GetTotalPoolLiquidity(s.Ctx)
+	expectedDYMInPool := math.NewInt(100).MulRaw(1e18).ToLegacyDec().Mul(liquidityPart).TruncateInt()
+	s.Require().Equal(expectedDYMInPool, poolCoins.AmountOf("adym"))
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+// BuyExactSpendWithSwap swaps tokenIn into the plan's liquidity denom through the given routes
+// and spends the whole swap output on the curve.
+// The swap and the buy are atomic: either both succeed or no state is changed.
+// minTokensAmt is the single slippage guard, covering both the swap and the curve price.
+// The swap is charged the gamm taker fee on tokenIn, same as a swap through x/gamm.
+func (k Keeper) BuyExactSpendWithSwap(ctx sdk.Context, planId string, buyer sdk.AccAddress, tokenIn sdk.Coin, routes []types.SwapRoute, minTokensAmt math.Int, referrer sdk.AccAddress) error {
+	plan, found := k.GetPlan(ctx, planId)
+	if !found {
+		return types.ErrPlanNotFound
+	}
+
+	if tokenIn.Denom == plan.LiquidityDenom {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "token in is already the liquidity denom: %s", tokenIn.Denom)
+	}
+	if err := types.ValidateSwapRoutes(routes, tokenIn.Denom); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, err.Error())
+	}
+	if denomOut := routes[len(routes)-1].TokenOutDenom; denomOut != plan.LiquidityDenom {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "swap routes must end in the liquidity denom: expected %s, got %s", plan.LiquidityDenom, denomOut)
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+
+	tokenInAfterTakerFee, takerFee := k.gk.SubTakerFee(tokenIn, k.gk.GetParams(ctx).TakerFee)
+
+	// slippage is checked on the amount of tokens bought, so any positive swap output is accepted here
+	outAmt, err := k.pm.RouteExactAmountIn(cacheCtx, buyer, types.ToPoolManagerRoutes(routes), tokenInAfterTakerFee, math.OneInt())
+	if err != nil {
+		return errorsmod.Wrap(err, "swap to liquidity denom")
+	}
+
+	err = k.chargeTakerFee(cacheCtx, takerFee, buyer, k.swapTakerFeeBeneficiary(ctx, tokenIn.Denom, plan.LiquidityDenom))
+	if err != nil {
+		return err
+	}
+
+	err = uevent.EmitTypedEvent(cacheCtx, &types.EventSwapForBuy{
+		Buyer:     buyer.String(),
+		PlanId:    planId,
+		RollappId: plan.RollappId,
+		TokenIn:   tokenIn,
+		TokenOut:  sdk.NewCoin(plan.LiquidityDenom, outAmt),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = k.BuyExactSpend(cacheCtx, planId, buyer, outAmt, minTokensAmt, referrer)
+	if err != nil {
+		return err
+	}
+
+	write()
+	return nil
+}
+
+// swapTakerFeeBeneficiary returns the owner of the rollapp of the token in, or else of the token out, who
+// gets a share of the swap taker fee. It is nil if neither token is a rollapp token, same as in x/gamm.
+func (k Keeper) swapTakerFeeBeneficiary(ctx sdk.Context, inDenom, outDenom string) *sdk.AccAddress {
+	for _, denom := range []string{inDenom, outDenom} {
+		if owner, err := k.rk.GetRollappOwnerByDenom(ctx, denom); err == nil {
+			return &owner
+		}
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	txfeestypes "github.com/osmosis-labs/osmosis/v15/x/txfees/types"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	"github.com/dymensionxyz/dymension/v3/x/iro/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+func (s *KeeperTestSuite) TestBuyExactSpendWithSwap() {
+	poolId := s.PreparePoolWithCoins(sdk.NewCoins(
+		sdk.NewCoin("foo", math.NewInt(1_000_000).MulRaw(1e18)),
+		sdk.NewCoin("adym", math.NewInt(1_000_000).MulRaw(1e18)),
+	))
+
+	rollappId := s.CreateDefaultRollapp()
+	k := s.App.IROKeeper
+	// fixed price curve (1 token = 1 adym)
+	curve := types.BondingCurve{
+		M:                      math.LegacyMustNewDecFromStr("0"),
+		N:                      math.LegacyMustNewDecFromStr("1"),
+		C:                      math.LegacyMustNewDecFromStr("1"),
+		RollappDenomDecimals:   18,
+		LiquidityDenomDecimals: 18,
+	}
+	incentives := types.DefaultIncentivePlanParams()
+
+	startTime := time.Now()
+	totalAllocation := math.NewInt(1_000_000).MulRaw(1e18)
+
+	rollapp, _ := s.App.RollappKeeper.GetRollapp(s.Ctx, rollappId)
+	planId, err := k.CreatePlan(s.Ctx, "adym", totalAllocation, time.Hour, startTime, true, rollapp, curve, incentives, types.DefaultParams().MinLiquidityPart, time.Hour, 0, keeper.CreatePlanOpts{})
+	s.Require().NoError(err)
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute))
+	plan := k.MustGetPlan(s.Ctx, planId)
+
+	buyer := sample.Acc()
+	buyersFunds := sdk.NewCoins(
+		sdk.NewCoin("foo", math.NewInt(1_000).MulRaw(1e18)),
+		sdk.NewCoin("bar", math.NewInt(1_000).MulRaw(1e18)),
+	)
+	s.FundAcc(buyer, buyersFunds)
+
+	tokenIn := sdk.NewCoin("foo", math.NewInt(100).MulRaw(1e18))
+	routes := []types.SwapRoute{{PoolId: poolId, TokenOutDenom: "adym"}}
+
+	// routes must end in the liquidity denom
+	err = k.BuyExactSpendWithSwap(s.Ctx, planId, buyer, sdk.NewCoin("bar", tokenIn.Amount), []types.SwapRoute{{PoolId: poolId, TokenOutDenom: "foo"}}, math.OneInt(), nil)
+	s.Require().ErrorIs(err, gerrc.ErrInvalidArgument)
+
+	// paying with the liquidity denom is not a swap
+	err = k.BuyExactSpendWithSwap(s.Ctx, planId, buyer, sdk.NewCoin("adym", tokenIn.Amount), routes, math.OneInt(), nil)
+	s.Require().ErrorIs(err, gerrc.ErrInvalidArgument)
+
+	// slippage guard covers the swap: 100 foo can't buy 100 tokens after the swap price impact and taker fee
+	err = k.BuyExactSpendWithSwap(s.Ctx, planId, buyer, tokenIn, routes, math.NewInt(100).MulRaw(1e18), nil)
+	s.Require().ErrorIs(err, types.ErrInvalidMinCost)
+	// nothing was swapped
+	s.Require().Equal(buyersFunds, s.App.BankKeeper.GetAllBalances(s.Ctx, buyer))
+
+	// successful buy
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	minOut := math.NewInt(90).MulRaw(1e18)
+	err = k.BuyExactSpendWithSwap(s.Ctx, planId, buyer, tokenIn, routes, minOut, nil)
+	s.Require().NoError(err)
+
+	// both the gamm taker fee of the swap and the IRO taker fee of the buy are charged
+	_, swapTakerFee := s.App.GAMMKeeper.SubTakerFee(tokenIn, s.App.GAMMKeeper.GetParams(s.Ctx).TakerFee)
+	s.Require().True(swapTakerFee.IsPositive())
+	charged := 0
+	for _, e := range s.Ctx.EventManager().Events() {
+		if e.Type != proto.MessageName(new(txfeestypes.EventChargeFee)) {
+			continue
+		}
+		for _, attr := range e.Attributes {
+			if attr.Key == "payer" && attr.Value == buyer.String() {
+				charged++
+			}
+		}
+	}
+	s.Require().Equal(2, charged)
+
+	balances := s.App.BankKeeper.GetAllBalances(s.Ctx, buyer)
+	s.Require().Equal(buyersFunds.AmountOf("foo").Sub(tokenIn.Amount), balances.AmountOf("foo"))
+	// the whole swap output is spent on the curve
+	s.Require().True(balances.AmountOf("adym").IsZero())
+
+	bought := balances.AmountOf(plan.GetIRODenom())
+	s.Require().True(bought.GTE(minOut))
+	s.Require().True(bought.LT(tokenIn.Amount))
+	s.Require().Equal(plan.SoldAmt.Add(bought), k.MustGetPlan(s.Ctx, planId).SoldAmt)
+}
@@ -41,25 +41,43 @@ func (k Keeper) EnableTrading(ctx sdk.Context, planId string, submitter sdk.AccA
 		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "plan already settled")
 	}
 
+	if rollapp.IsSunset() {
+		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "rollapp is sunset")
+	}
+
 	plan.EnableTradingWithStartTime(ctx.BlockTime())
+	plan.SetSettlementDeadline(k.GetParams(ctx).SettlementGracePeriod)
 	k.SetPlan(ctx, plan)
 
 	k.rk.SetPreLaunchTime(ctx, &rollapp, plan.PreLaunchTime)
 	return nil
 }
 
-// Buy buys fixed amount of allocation with price according to the price curve
-func (k Keeper) Buy(ctx sdk.Context, planId string, buyer sdk.AccAddress, amountTokensToBuy, maxCostAmt math.Int) error {
+// Buy buys fixed amount of allocation with price according to the price curve.
+// If set, the referrer earns a share of the taker fee.
+func (k Keeper) Buy(ctx sdk.Context, planId string, buyer sdk.AccAddress, amountTokensToBuy, maxCostAmt math.Int, referrer sdk.AccAddress) error {
 	plan, err := k.GetTradeableIRO(ctx, planId, buyer)
 	if err != nil {
 		return err
 	}
 
+	if err := k.validateRollappNotSunset(ctx, plan.RollappId); err != nil {
+		return err
+	}
+
 	// validate the IRO have enough tokens to sell
 	if plan.SoldAmt.Add(amountTokensToBuy).GT(plan.MaxAmountToSell) {
 		return types.ErrInsufficientTokens
 	}
 
+	if err := k.chargePresaleAllocation(ctx, *plan, buyer, amountTokensToBuy); err != nil {
+		return err
+	}
+
+	if err := k.chargeTradingLimits(ctx, plan, buyer, amountTokensToBuy); err != nil {
+		return err
+	}
+
 	// Calculate costAmt for buying amountTokensToBuy over the price curve
 	costAmt := plan.BondingCurve.Cost(plan.SoldAmt, plan.SoldAmt.Add(amountTokensToBuy))
 	costPlusTakerFeeAmt, takerFeeAmt, err := k.ApplyTakerFee(costAmt, k.GetParams(ctx).TakerFee, true)
@@ -72,10 +90,14 @@ func (k Keeper) Buy(ctx sdk.Context, planId string, buyer sdk.AccAddress, amount
 		return errorsmod.Wrapf(types.ErrInvalidExpectedOutAmount, "maxCost: %s, cost: %s, fee: %s", maxCostAmt.String(), costAmt.String(), takerFeeAmt.String())
 	}
 
-	// Charge taker fee
+	// Charge taker fee, paying the referrer's share first
 	takerFee := sdk.NewCoin(plan.LiquidityDenom, takerFeeAmt)
+	remainingFee, err := k.payReferralFee(ctx, *plan, buyer, referrer, takerFee, costAmt)
+	if err != nil {
+		return err
+	}
 	owner := k.rk.MustGetRollappOwner(ctx, plan.RollappId)
-	err = k.chargeTakerFee(ctx, takerFee, buyer, &owner)
+	err = k.chargeTakerFee(ctx, remainingFee, buyer, &owner)
 	if err != nil {
 		return err
 	}
@@ -94,8 +116,10 @@ func (k Keeper) Buy(ctx sdk.Context, planId string, buyer sdk.AccAddress, amount
 	}
 
 	// Update plan
+	openPrice := plan.SpotPrice()
 	plan.SoldAmt = plan.SoldAmt.Add(amountTokensToBuy)
 	k.SetPlan(ctx, *plan)
+	k.recordCandles(ctx, *plan, openPrice, amountTokensToBuy)
 
 	// Emit event
 	err = uevent.EmitTypedEvent(ctx, &types.EventBuy{
@@ -114,13 +138,18 @@ func (k Keeper) Buy(ctx sdk.Context, planId string, buyer sdk.AccAddress, amount
 	return nil
 }
 
-// BuyExactSpend uses exact amount of liquidity to buy tokens on the curve
-func (k Keeper) BuyExactSpend(ctx sdk.Context, planId string, buyer sdk.AccAddress, amountToSpend, minTokensAmt math.Int) error {
+// BuyExactSpend uses exact amount of liquidity to buy tokens on the curve.
+// If set, the referrer earns a share of the taker fee.
+func (k Keeper) BuyExactSpend(ctx sdk.Context, planId string, buyer sdk.AccAddress, amountToSpend, minTokensAmt math.Int, referrer sdk.AccAddress) error {
 	plan, err := k.GetTradeableIRO(ctx, planId, buyer)
 	if err != nil {
 		return err
 	}
 
+	if err := k.validateRollappNotSunset(ctx, plan.RollappId); err != nil {
+		return err
+	}
+
 	// deduct taker fee from the amount to spend
 	toSpendMinusTakerFeeAmt, takerFeeAmt, err := k.ApplyTakerFee(amountToSpend, k.GetParams(ctx).TakerFee, false)
 	if err != nil {
@@ -143,10 +172,22 @@ func (k Keeper) BuyExactSpend(ctx sdk.Context, planId string, buyer sdk.AccAddre
 		return types.ErrInsufficientTokens
 	}
 
-	// Charge taker fee
+	if err := k.chargePresaleAllocation(ctx, *plan, buyer, tokensOutAmt); err != nil {
+		return err
+	}
+
+	if err := k.chargeTradingLimits(ctx, plan, buyer, tokensOutAmt); err != nil {
+		return err
+	}
+
+	// Charge taker fee, paying the referrer's share first
 	takerFee := sdk.NewCoin(plan.LiquidityDenom, takerFeeAmt)
+	remainingFee, err := k.payReferralFee(ctx, *plan, buyer, referrer, takerFee, toSpendMinusTakerFeeAmt)
+	if err != nil {
+		return err
+	}
 	owner := k.rk.MustGetRollappOwner(ctx, plan.RollappId)
-	err = k.chargeTakerFee(ctx, takerFee, buyer, &owner)
+	err = k.chargeTakerFee(ctx, remainingFee, buyer, &owner)
 	if err != nil {
 		return err
 	}
@@ -165,8 +206,10 @@ func (k Keeper) BuyExactSpend(ctx sdk.Context, planId string, buyer sdk.AccAddre
 	}
 
 	// Update plan
+	openPrice := plan.SpotPrice()
 	plan.SoldAmt = plan.SoldAmt.Add(tokensOutAmt)
 	k.SetPlan(ctx, *plan)
+	k.recordCandles(ctx, *plan, openPrice, tokensOutAmt)
 
 	// Emit event
 	err = uevent.EmitTypedEvent(ctx, &types.EventBuy{
@@ -185,8 +228,9 @@ func (k Keeper) BuyExactSpend(ctx sdk.Context, planId string, buyer sdk.AccAddre
 	return nil
 }
 
-// Sell sells allocation with price according to the price curve
-func (k Keeper) Sell(ctx sdk.Context, planId string, seller sdk.AccAddress, amountTokensToSell, minIncomeAmt math.Int) error {
+// Sell sells allocation with price according to the price curve.
+// If set, the referrer earns a share of the taker fee.
+func (k Keeper) Sell(ctx sdk.Context, planId string, seller sdk.AccAddress, amountTokensToSell, minIncomeAmt math.Int, referrer sdk.AccAddress) error {
 	plan, err := k.GetTradeableIRO(ctx, planId, seller)
 	if err != nil {
 		return err
@@ -218,13 +262,19 @@ func (k Keeper) Sell(ctx sdk.Context, planId string, seller sdk.AccAddress, amou
 	}
 
 	// Update plan
+	openPrice := plan.SpotPrice()
 	plan.SoldAmt = plan.SoldAmt.Sub(amountTokensToSell)
 	k.SetPlan(ctx, *plan)
+	k.recordCandles(ctx, *plan, openPrice, amountTokensToSell)
 
-	// Charge taker fee
+	// Charge taker fee, paying the referrer's share first
 	takerFee := sdk.NewCoin(plan.LiquidityDenom, takerFeeAmt)
+	remainingFee, err := k.payReferralFee(ctx, *plan, seller, referrer, takerFee, costAmt)
+	if err != nil {
+		return err
+	}
 	owner := k.rk.MustGetRollappOwner(ctx, plan.RollappId)
-	err = k.chargeTakerFee(ctx, takerFee, seller, &owner)
+	err = k.chargeTakerFee(ctx, remainingFee, seller, &owner)
 	if err != nil {
 		return err
 	}
@@ -249,6 +299,7 @@ func (k Keeper) Sell(ctx sdk.Context, planId string, seller sdk.AccAddress, amou
 // GetTradeableIRO returns the tradeable IRO plan
 // - plan must exist
 // - plan must not be settled
+// - plan must not have failed
 // - plan must have started (unless the trader is the owner)
 func (k Keeper) GetTradeableIRO(ctx sdk.Context, planId string, trader sdk.AccAddress) (*types.Plan, error) {
 	plan, found := k.GetPlan(ctx, planId)
@@ -260,6 +311,10 @@ func (k Keeper) GetTradeableIRO(ctx sdk.Context, planId string, trader sdk.AccAd
 		return nil, errorsmod.Wrapf(types.ErrPlanSettled, "planId: %d", plan.Id)
 	}
 
+	if plan.IsFailed(ctx.BlockTime()) {
+		return nil, errorsmod.Wrapf(types.ErrPlanFailed, "planId: %d, deadline: %s", plan.Id, plan.SettlementDeadline)
+	}
+
 	// Validate start time started (unless the trader is the owner)
 	owner := k.rk.MustGetRollappOwner(ctx, plan.RollappId)
 	if owner.Equals(trader) {
@@ -277,6 +332,19 @@ func (k Keeper) GetTradeableIRO(ctx sdk.Context, planId string, trader sdk.AccAd
 	return &plan, nil
 }
 
+// validateRollappNotSunset blocks new inbound liquidity once the rollapp owner announced a shutdown.
+// Selling is still allowed so buyers can exit.
+func (k Keeper) validateRollappNotSunset(ctx sdk.Context, rollappId string) error {
+	rollapp, found := k.rk.GetRollapp(ctx, rollappId)
+	if !found {
+		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "rollapp not found")
+	}
+	if rollapp.IsSunset() {
+		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "rollapp is sunset")
+	}
+	return nil
+}
+
 // chargeTakerFee charges taker fee from the sender.
 // The fee is sent to the txfees module and the beneficiary if presented.
 func (k Keeper) chargeTakerFee(ctx sdk.Context, takerFeeCoin sdk.Coin, sender sdk.AccAddress, beneficiary *sdk.AccAddress) error {
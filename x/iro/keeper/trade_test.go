@@ -7,9 +7,12 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 
 	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	"github.com/dymensionxyz/dymension/v3/x/iro/keeper"
 	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 )
 
 func (s *KeeperTestSuite) TestTradeDisabled() {
@@ -25,7 +28,7 @@ func (s *KeeperTestSuite) TestTradeDisabled() {
 	totalAllocation := math.NewInt(1_000_000).MulRaw(1e18)
 
 	rollapp, _ := s.App.RollappKeeper.GetRollapp(s.Ctx, rollappId)
-	planId, err := k.CreatePlan(s.Ctx, "adym", totalAllocation, time.Hour, startTime, false, rollapp, curve, incentives, types.DefaultParams().MinLiquidityPart, time.Hour, 0)
+	planId, err := k.CreatePlan(s.Ctx, "adym", totalAllocation, time.Hour, startTime, false, rollapp, curve, incentives, types.DefaultParams().MinLiquidityPart, time.Hour, 0, keeper.CreatePlanOpts{})
 	s.Require().NoError(err)
 
 	plan := k.MustGetPlan(s.Ctx, planId)
@@ -46,15 +49,15 @@ func (s *KeeperTestSuite) TestTradeDisabled() {
 	buyAmt := math.NewInt(1_000).MulRaw(1e18)
 
 	// buy before plan start - should fail
-	err = k.Buy(s.Ctx.WithBlockTime(startTime.Add(-time.Minute)), planId, buyer, buyAmt, maxAmt)
+	err = k.Buy(s.Ctx.WithBlockTime(startTime.Add(-time.Minute)), planId, buyer, buyAmt, maxAmt, nil)
 	s.Require().Error(err)
 
 	// Plan is not yet enabled - should fail
-	err = k.Buy(s.Ctx.WithBlockTime(startTime.Add(time.Minute)), planId, buyer, buyAmt, maxAmt)
+	err = k.Buy(s.Ctx.WithBlockTime(startTime.Add(time.Minute)), planId, buyer, buyAmt, maxAmt, nil)
 	s.Require().Error(err)
 
 	// owner can still buy
-	err = k.Buy(s.Ctx.WithBlockTime(startTime.Add(-time.Minute)), planId, owner, buyAmt, maxAmt)
+	err = k.Buy(s.Ctx.WithBlockTime(startTime.Add(-time.Minute)), planId, owner, buyAmt, maxAmt, nil)
 	s.Require().NoError(err)
 
 	// Enable trading not as owner - should fail
@@ -79,7 +82,7 @@ func (s *KeeperTestSuite) TestTradeDisabled() {
 	s.Assert().Equal(plan.PreLaunchTime, *rollapp.PreLaunchTime)
 
 	// Buy should now succeed
-	err = k.Buy(s.Ctx.WithBlockTime(enableTime.Add(2*time.Minute)), planId, buyer, buyAmt, maxAmt)
+	err = k.Buy(s.Ctx.WithBlockTime(enableTime.Add(2*time.Minute)), planId, buyer, buyAmt, maxAmt, nil)
 	s.Require().NoError(err)
 }
 
@@ -94,7 +97,7 @@ func (s *KeeperTestSuite) TestBuy() {
 	totalAllocation := math.NewInt(1_000_000).MulRaw(1e18)
 
 	rollapp, _ := s.App.RollappKeeper.GetRollapp(s.Ctx, rollappId)
-	planId, err := k.CreatePlan(s.Ctx, "adym", totalAllocation, time.Hour, startTime, true, rollapp, curve, incentives, types.DefaultParams().MinLiquidityPart, time.Hour, 0)
+	planId, err := k.CreatePlan(s.Ctx, "adym", totalAllocation, time.Hour, startTime, true, rollapp, curve, incentives, types.DefaultParams().MinLiquidityPart, time.Hour, 0, keeper.CreatePlanOpts{})
 	s.Require().NoError(err)
 	initialOwnerBalance := s.App.BankKeeper.GetAllBalances(s.Ctx, s.App.RollappKeeper.MustGetRollappOwner(s.Ctx, rollappId))
 
@@ -111,19 +114,19 @@ func (s *KeeperTestSuite) TestBuy() {
 	expectedCost := curve.Cost(plan.SoldAmt, plan.SoldAmt.Add(buyAmt))
 
 	// buy before plan start - should fail
-	err = k.Buy(s.Ctx.WithBlockTime(startTime.Add(-time.Minute)), planId, buyer, buyAmt, maxAmt)
+	err = k.Buy(s.Ctx.WithBlockTime(startTime.Add(-time.Minute)), planId, buyer, buyAmt, maxAmt, nil)
 	s.Require().Error(err)
 
 	// cost is higher than maxCost specified - should fail
-	err = k.Buy(s.Ctx, planId, buyer, buyAmt, expectedCost.SubRaw(1))
+	err = k.Buy(s.Ctx, planId, buyer, buyAmt, expectedCost.SubRaw(1), nil)
 	s.Require().Error(err)
 
 	// buy more than user's balance - should fail
-	err = k.Buy(s.Ctx, planId, buyer, math.NewInt(100_000).MulRaw(1e18), maxAmt)
+	err = k.Buy(s.Ctx, planId, buyer, math.NewInt(100_000).MulRaw(1e18), maxAmt, nil)
 	s.Require().Error(err)
 
 	// buy very small amount - should fail (as cost ~= 0)
-	err = k.Buy(s.Ctx, planId, buyer, math.NewInt(100), maxAmt)
+	err = k.Buy(s.Ctx, planId, buyer, math.NewInt(100), maxAmt, nil)
 	s.Require().Error(err)
 
 	// assert nothing sold
@@ -133,7 +136,7 @@ func (s *KeeperTestSuite) TestBuy() {
 	s.Assert().Equal(buyersFunds.AmountOf("adym"), buyerBalance)
 
 	// successful buy
-	err = k.Buy(s.Ctx, planId, buyer, buyAmt, maxAmt)
+	err = k.Buy(s.Ctx, planId, buyer, buyAmt, maxAmt, nil)
 	s.Require().NoError(err)
 	plan, _ = k.GetPlan(s.Ctx, planId)
 	s.Assert().True(plan.SoldAmt.Sub(reservedTokens).Equal(buyAmt))
@@ -170,7 +173,7 @@ func (s *KeeperTestSuite) TestTradeAfterSettled() {
 	totalAllocation := math.NewInt(1_000_000).MulRaw(1e18)
 
 	rollapp, _ := s.App.RollappKeeper.GetRollapp(s.Ctx, rollappId)
-	planId, err := k.CreatePlan(s.Ctx, "adym", totalAllocation, time.Hour, startTime, true, rollapp, curve, incentives, types.DefaultParams().MinLiquidityPart, time.Hour, 0)
+	planId, err := k.CreatePlan(s.Ctx, "adym", totalAllocation, time.Hour, startTime, true, rollapp, curve, incentives, types.DefaultParams().MinLiquidityPart, time.Hour, 0, keeper.CreatePlanOpts{})
 	s.Require().NoError(err)
 
 	buyer := sample.Acc()
@@ -181,7 +184,7 @@ func (s *KeeperTestSuite) TestTradeAfterSettled() {
 
 	// Buy before settlement
 	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute))
-	err = k.Buy(s.Ctx, planId, buyer, buyAmt, maxAmt)
+	err = k.Buy(s.Ctx, planId, buyer, buyAmt, maxAmt, nil)
 	s.Require().NoError(err)
 
 	// settle
@@ -191,10 +194,51 @@ func (s *KeeperTestSuite) TestTradeAfterSettled() {
 	s.Require().NoError(err)
 
 	// Attempt to buy after settlement - should fail
-	err = k.Buy(s.Ctx, planId, buyer, buyAmt, maxAmt)
+	err = k.Buy(s.Ctx, planId, buyer, buyAmt, maxAmt, nil)
 	s.Require().Error(err)
 }
 
+func (s *KeeperTestSuite) TestTradeAfterSunset() {
+	rollappId := s.CreateDefaultRollapp()
+	k := s.App.IROKeeper
+	curve := types.DefaultBondingCurve()
+	incentives := types.DefaultIncentivePlanParams()
+
+	startTime := time.Now()
+	maxAmt := math.NewInt(1_000_000_000).MulRaw(1e18)
+	totalAllocation := math.NewInt(1_000_000).MulRaw(1e18)
+
+	rollapp, _ := s.App.RollappKeeper.GetRollapp(s.Ctx, rollappId)
+	planId, err := k.CreatePlan(s.Ctx, "adym", totalAllocation, time.Hour, startTime, true, rollapp, curve, incentives, types.DefaultParams().MinLiquidityPart, time.Hour, 0, keeper.CreatePlanOpts{})
+	s.Require().NoError(err)
+
+	buyer := sample.Acc()
+	buyersFunds := sdk.NewCoins(sdk.NewCoin("adym", math.NewInt(100_000).MulRaw(1e18)))
+	s.FundAcc(buyer, buyersFunds)
+
+	buyAmt := math.NewInt(1_000).MulRaw(1e18)
+
+	// Buy before sunset
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute))
+	err = k.Buy(s.Ctx, planId, buyer, buyAmt, maxAmt, nil)
+	s.Require().NoError(err)
+
+	// sunset
+	rollapp, _ = s.App.RollappKeeper.GetRollapp(s.Ctx, rollappId)
+	rollapp.Sunset = &rollapptypes.RollappSunset{FinalHeight: 1, WithdrawalWindowEnd: s.Ctx.BlockTime().Add(time.Hour)}
+	s.App.RollappKeeper.SetRollapp(s.Ctx, rollapp)
+
+	// buying is blocked
+	err = k.Buy(s.Ctx, planId, buyer, buyAmt, maxAmt, nil)
+	s.Require().ErrorIs(err, gerrc.ErrFailedPrecondition)
+	err = k.BuyExactSpend(s.Ctx, planId, buyer, math.NewInt(1_000).MulRaw(1e18), math.ZeroInt(), nil)
+	s.Require().ErrorIs(err, gerrc.ErrFailedPrecondition)
+
+	// selling is still allowed
+	err = k.Sell(s.Ctx, planId, buyer, buyAmt.QuoRaw(2), math.ZeroInt(), nil)
+	s.Require().NoError(err)
+}
+
 func (s *KeeperTestSuite) TestTakerFee() {
 	rollappId := s.CreateDefaultRollapp()
 	k := s.App.IROKeeper
@@ -212,7 +256,7 @@ func (s *KeeperTestSuite) TestTakerFee() {
 	totalAllocation := math.NewInt(1_000_000).MulRaw(1e18)
 
 	rollapp, _ := s.App.RollappKeeper.GetRollapp(s.Ctx, rollappId)
-	planId, err := k.CreatePlan(s.Ctx, "adym", totalAllocation, time.Hour, startTime, true, rollapp, curve, incentives, types.DefaultParams().MinLiquidityPart, time.Hour, 0)
+	planId, err := k.CreatePlan(s.Ctx, "adym", totalAllocation, time.Hour, startTime, true, rollapp, curve, incentives, types.DefaultParams().MinLiquidityPart, time.Hour, 0, keeper.CreatePlanOpts{})
 	s.Require().NoError(err)
 	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute))
 
@@ -223,12 +267,12 @@ func (s *KeeperTestSuite) TestTakerFee() {
 	buyAmt := math.NewInt(1_000).MulRaw(1e18)
 
 	// Attempt to buy while ignoring taker fee - should fail
-	err = k.Buy(s.Ctx, planId, buyer, buyAmt, buyAmt)
+	err = k.Buy(s.Ctx, planId, buyer, buyAmt, buyAmt, nil)
 	s.Require().Error(err)
 
 	// Successful buy
 	expectedTakerFee := s.App.IROKeeper.GetParams(s.Ctx).TakerFee.MulInt(buyAmt).TruncateInt()
-	err = k.Buy(s.Ctx, planId, buyer, buyAmt, buyAmt.Add(expectedTakerFee))
+	err = k.Buy(s.Ctx, planId, buyer, buyAmt, buyAmt.Add(expectedTakerFee), nil)
 	s.Require().NoError(err)
 
 	// Extract taker fee from buy event
@@ -249,7 +293,7 @@ func (s *KeeperTestSuite) TestSell() {
 	totalAllocation := math.NewInt(1_000_000).MulRaw(1e18)
 
 	rollapp, _ := s.App.RollappKeeper.GetRollapp(s.Ctx, rollappId)
-	planId, err := k.CreatePlan(s.Ctx, "adym", totalAllocation, time.Hour, startTime, true, rollapp, curve, incentives, types.DefaultParams().MinLiquidityPart, time.Hour, 0)
+	planId, err := k.CreatePlan(s.Ctx, "adym", totalAllocation, time.Hour, startTime, true, rollapp, curve, incentives, types.DefaultParams().MinLiquidityPart, time.Hour, 0, keeper.CreatePlanOpts{})
 	s.Require().NoError(err)
 	initialOwnerBalance := s.App.BankKeeper.GetAllBalances(s.Ctx, s.App.RollappKeeper.MustGetRollappOwner(s.Ctx, rollappId))
 	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute))
@@ -261,7 +305,7 @@ func (s *KeeperTestSuite) TestSell() {
 	buyAmt := math.NewInt(1_000).MulRaw(1e18)
 
 	// Buy tokens first
-	err = k.Buy(s.Ctx, planId, buyer, buyAmt, maxAmt)
+	err = k.Buy(s.Ctx, planId, buyer, buyAmt, maxAmt, nil)
 	s.Require().NoError(err)
 
 	// Extract taker fee from buy event
@@ -270,7 +314,7 @@ func (s *KeeperTestSuite) TestSell() {
 	// Sell tokens
 	sellAmt := math.NewInt(500).MulRaw(1e18)
 	minReceive := math.NewInt(1) // Set a very low minReceive for testing purposes
-	err = k.Sell(s.Ctx, planId, buyer, sellAmt, minReceive)
+	err = k.Sell(s.Ctx, planId, buyer, sellAmt, minReceive, nil)
 	s.Require().NoError(err)
 
 	// Extract taker fee from sell event
@@ -288,12 +332,12 @@ func (s *KeeperTestSuite) TestSell() {
 	s.Require().Equal(ownerRevenue, ownerBalanceChange.AmountOf("adym"))
 
 	// Attempt to sell more than owned - should fail
-	err = k.Sell(s.Ctx, planId, buyer, buyAmt, minReceive)
+	err = k.Sell(s.Ctx, planId, buyer, buyAmt, minReceive, nil)
 	s.Require().Error(err)
 
 	// Attempt to sell with minReceive higher than possible - should fail
 	highMinReceive := maxAmt
-	err = k.Sell(s.Ctx, planId, buyer, sellAmt, highMinReceive)
+	err = k.Sell(s.Ctx, planId, buyer, sellAmt, highMinReceive, nil)
 	s.Require().Error(err)
 }
 
@@ -358,7 +402,7 @@ func (s *KeeperTestSuite) TestBuyWithUSDC() {
 	// Create plan with USDC as liquidity denom instead of DYM
 	// Fund owner with USDC (6 decimals) for creation fee
 	s.FundAcc(sdk.MustAccAddressFromBech32(owner), sdk.NewCoins(sdk.NewCoin("usdc", math.NewInt(100_000).MulRaw(1e6)))) // 100K USDC)
-	planId, err := k.CreatePlan(s.Ctx, "usdc", totalAllocation, time.Hour, startTime, true, rollapp, curve, incentives, types.DefaultParams().MinLiquidityPart, time.Hour, 0)
+	planId, err := k.CreatePlan(s.Ctx, "usdc", totalAllocation, time.Hour, startTime, true, rollapp, curve, incentives, types.DefaultParams().MinLiquidityPart, time.Hour, 0, keeper.CreatePlanOpts{})
 	s.Require().NoError(err)
 
 	initialOwnerBalance := s.App.BankKeeper.GetAllBalances(s.Ctx, s.App.RollappKeeper.MustGetRollappOwner(s.Ctx, rollappId))
@@ -377,7 +421,7 @@ func (s *KeeperTestSuite) TestBuyWithUSDC() {
 	maxCost := expectedCost.Add(expectedTakerFee)
 
 	// Successful buy
-	err = k.Buy(s.Ctx, planId, buyer, buyAmt, maxCost)
+	err = k.Buy(s.Ctx, planId, buyer, buyAmt, maxCost, nil)
 	s.Require().NoError(err)
 
 	// Extract taker fee from buy event
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+// UpdatePlan replaces the sale settings of a plan.
+// Stateful validations on the request:
+// - The plan and its rollapp must exist
+// - The rollapp must be owned by the sender
+// - The settings must meet the same requirements as on plan creation
+func (m msgServer) UpdatePlan(goCtx context.Context, req *types.MsgUpdatePlan) (*types.MsgUpdatePlanResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	plan, found := m.Keeper.GetPlan(ctx, req.PlanId)
+	if !found {
+		return nil, types.ErrPlanNotFound
+	}
+
+	rollapp, found := m.Keeper.rk.GetRollapp(ctx, plan.RollappId)
+	if !found {
+		return nil, errorsmod.Wrapf(gerrc.ErrNotFound, "rollapp not found")
+	}
+
+	if rollapp.Owner != req.Owner {
+		return nil, sdkerrors.ErrUnauthorized
+	}
+
+	err := validatePlanSettings(m.Keeper.GetParams(ctx), req.IroPlanDuration, req.BondingCurve, req.IncentivePlanParams, req.LiquidityPart, req.VestingDuration, req.VestingStartTimeAfterSettlement)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.validateCurveDecimals(ctx, rollapp, plan.LiquidityDenom, req.BondingCurve); err != nil {
+		return nil, err
+	}
+
+	err = m.Keeper.UpdatePlan(ctx, plan, req.BondingCurve, req.IroPlanDuration, req.IncentivePlanParams, req.LiquidityPart, req.VestingDuration, req.VestingStartTimeAfterSettlement)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdatePlanResponse{}, nil
+}
+
+// UpdatePlan replaces the sale settings of a plan which is not trading yet.
+// Only the creation fee may have been sold, so the fee is repriced on the new curve:
+// the difference is charged from, or refunded to, the rollapp owner.
+func (k Keeper) UpdatePlan(ctx sdk.Context, plan types.Plan, curve types.BondingCurve, planDuration time.Duration, incentivesParams types.IncentivePlanParams, liquidityPart math.LegacyDec, vestingDuration, vestingStartTimeAfterSettlement time.Duration) error {
+	if plan.TradingEnabled {
+		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "trading already enabled")
+	}
+
+	if plan.IsSettled() {
+		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "plan already settled")
+	}
+
+	// the owner may buy before trading is enabled
+	if !plan.SoldAmt.Equal(plan.ClaimedAmt) {
+		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "plan has sold tokens")
+	}
+
+	oldCost := plan.BondingCurve.Cost(math.ZeroInt(), plan.SoldAmt)
+	if err := plan.UpdateSaleSettings(curve, planDuration, incentivesParams, liquidityPart, vestingDuration, vestingStartTimeAfterSettlement); err != nil {
+		return errors.Join(gerrc.ErrInvalidArgument, err)
+	}
+
+	if err := plan.ValidateBasic(); err != nil {
+		return errors.Join(gerrc.ErrInvalidArgument, err)
+	}
+
+	newCost := plan.BondingCurve.Cost(math.ZeroInt(), plan.SoldAmt)
+	if !newCost.IsPositive() {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "invalid cost for fee charge")
+	}
+
+	owner := k.rk.MustGetRollappOwner(ctx, plan.RollappId)
+	switch {
+	case newCost.GT(oldCost):
+		diff := sdk.NewCoin(plan.LiquidityDenom, newCost.Sub(oldCost))
+		if err := k.BK.SendCoins(ctx, owner, plan.GetAddress(), sdk.NewCoins(diff)); err != nil {
+			return err
+		}
+	case newCost.LT(oldCost):
+		diff := sdk.NewCoin(plan.LiquidityDenom, oldCost.Sub(newCost))
+		if err := k.BK.SendCoins(ctx, plan.GetAddress(), owner, sdk.NewCoins(diff)); err != nil {
+			return err
+		}
+	}
+
+	k.SetPlan(ctx, plan)
+
+	return uevent.EmitTypedEvent(ctx, &types.EventUpdatePlan{
+		Owner:     owner.String(),
+		PlanId:    fmt.Sprintf("%d", plan.Id),
+		RollappId: plan.RollappId,
+	})
+}
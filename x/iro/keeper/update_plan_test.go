@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	"github.com/dymensionxyz/dymension/v3/x/iro/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+// TestUpdatePlan tests that the owner can replace the plan settings until trading is enabled,
+// and that the creation fee paid on the curve is repriced on every update.
+func (s *KeeperTestSuite) TestUpdatePlan() {
+	rollappId := s.CreateDefaultRollapp()
+	k := s.App.IROKeeper
+	curve := types.DefaultBondingCurve()
+	incentives := types.DefaultIncentivePlanParams()
+	allocation := math.NewInt(1_000_000).MulRaw(1e18)
+	params := k.GetParams(s.Ctx)
+	s.App.BankKeeper.SetDenomMetaData(s.Ctx, banktypes.Metadata{
+		Base:       "adym",
+		Display:    "DYM",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "adym", Exponent: 0}, {Denom: "DYM", Exponent: 18}},
+	})
+
+	rollapp, _ := s.App.RollappKeeper.GetRollapp(s.Ctx, rollappId)
+	owner := sdk.MustAccAddressFromBech32(rollapp.Owner)
+	s.FundAcc(owner, sdk.NewCoins(sdk.NewCoin("adym", math.NewInt(1_000).MulRaw(1e18))))
+
+	planId, err := k.CreatePlan(s.Ctx, "adym", allocation, time.Hour, time.Time{}, false, rollapp, curve, incentives, params.MinLiquidityPart, params.MinVestingDuration, 0, keeper.CreatePlanOpts{})
+	s.Require().NoError(err)
+	plan := k.MustGetPlan(s.Ctx, planId)
+
+	// fixed price curve (1 token = 1 adym)
+	newCurve := types.BondingCurve{
+		M:                      math.LegacyMustNewDecFromStr("0"),
+		N:                      math.LegacyMustNewDecFromStr("1"),
+		C:                      math.LegacyMustNewDecFromStr("1"),
+		RollappDenomDecimals:   18,
+		LiquidityDenomDecimals: 18,
+	}
+	msg := types.MsgUpdatePlan{
+		Owner:           owner.String(),
+		PlanId:          planId,
+		BondingCurve:    newCurve,
+		IroPlanDuration: 2 * time.Hour,
+		IncentivePlanParams: types.IncentivePlanParams{
+			NumEpochsPaidOver:        incentives.NumEpochsPaidOver * 2,
+			StartTimeAfterSettlement: incentives.StartTimeAfterSettlement,
+		},
+		LiquidityPart:                   math.LegacyOneDec(),
+		VestingDuration:                 2 * params.MinVestingDuration,
+		VestingStartTimeAfterSettlement: time.Hour,
+	}
+
+	// only the owner can update the plan
+	notOwnerMsg := msg
+	notOwnerMsg.Owner = sample.AccAddress()
+	_, err = s.msgServer.UpdatePlan(s.Ctx, &notOwnerMsg)
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the settings are validated as on plan creation
+	shortVestingMsg := msg
+	shortVestingMsg.VestingDuration = params.MinVestingDuration - time.Second
+	_, err = s.msgServer.UpdatePlan(s.Ctx, &shortVestingMsg)
+	s.Require().ErrorIs(err, gerrc.ErrInvalidArgument)
+
+	ownerBalance := s.App.BankKeeper.GetBalance(s.Ctx, owner, "adym")
+	_, err = s.msgServer.UpdatePlan(s.Ctx, &msg)
+	s.Require().NoError(err)
+
+	updated := k.MustGetPlan(s.Ctx, planId)
+	s.Require().Equal(newCurve, updated.BondingCurve)
+	s.Require().Equal(msg.IroPlanDuration, updated.IroPlanDuration)
+	s.Require().Equal(msg.IncentivePlanParams, updated.IncentivePlanParams)
+	s.Require().Equal(msg.LiquidityPart, updated.LiquidityPart)
+	s.Require().Equal(msg.VestingDuration, updated.VestingPlan.VestingDuration)
+	s.Require().Equal(msg.VestingStartTimeAfterSettlement, updated.VestingPlan.StartTimeAfterSettlement)
+	s.Require().NotEqual(plan.MaxAmountToSell, updated.MaxAmountToSell)
+
+	// the creation fee is charged on the new curve
+	newFeeCost := newCurve.Cost(math.ZeroInt(), updated.SoldAmt)
+	oldFeeCost := curve.Cost(math.ZeroInt(), plan.SoldAmt)
+	s.Require().Equal(newFeeCost, s.App.BankKeeper.GetBalance(s.Ctx, updated.GetAddress(), "adym").Amount)
+	s.Require().Equal(ownerBalance.Amount.Sub(newFeeCost.Sub(oldFeeCost)), s.App.BankKeeper.GetBalance(s.Ctx, owner, "adym").Amount)
+
+	// back to the original curve, the difference is refunded
+	msg.BondingCurve = curve
+	_, err = s.msgServer.UpdatePlan(s.Ctx, &msg)
+	s.Require().NoError(err)
+	s.Require().Equal(oldFeeCost, s.App.BankKeeper.GetBalance(s.Ctx, updated.GetAddress(), "adym").Amount)
+	s.Require().Equal(ownerBalance.Amount, s.App.BankKeeper.GetBalance(s.Ctx, owner, "adym").Amount)
+
+	// the plan can't be updated once the owner bought tokens
+	s.Run("AfterOwnerBuy", func() {
+		ctx, _ := s.Ctx.CacheContext()
+		err := k.Buy(ctx, planId, owner, math.NewInt(10).MulRaw(1e18), math.NewInt(100).MulRaw(1e18), nil)
+		s.Require().NoError(err)
+
+		_, err = s.msgServer.UpdatePlan(ctx, &msg)
+		s.Require().ErrorIs(err, gerrc.ErrFailedPrecondition)
+	})
+
+	// the plan can't be updated once trading is enabled
+	err = k.EnableTrading(s.Ctx, planId, owner)
+	s.Require().NoError(err)
+	_, err = s.msgServer.UpdatePlan(s.Ctx, &msg)
+	s.Require().ErrorIs(err, gerrc.ErrFailedPrecondition)
+}
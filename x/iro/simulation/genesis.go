@@ -47,7 +47,10 @@ func generateRandomPlan(r *rand.Rand, id uint64) types.Plan {
 
 	// Generate random bonding curve
 	curve := generateRandomBondingCurve(r, allocatedAmount, liquidityPart)
-	plan := types.NewPlan(id, rollappId, "adym", allocation, curve, 24*time.Hour, types.DefaultIncentivePlanParams(), liquidityPart, 24*time.Hour, 0)
+	plan, err := types.NewPlan(id, rollappId, "adym", allocation, curve, 24*time.Hour, types.DefaultIncentivePlanParams(), liquidityPart, 24*time.Hour, 0)
+	if err != nil {
+		panic(err)
+	}
 	plan.EnableTradingWithStartTime(time.Now())
 
 	// randomize starting sold amount
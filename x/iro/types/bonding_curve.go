@@ -35,6 +35,8 @@ const (
 )
 
 /*
+Other curve families (exponential, logistic and piecewise linear) are defined in curves.go.
+
 The bonding curve implementation based on decimal representation of the X (rollapp's tokens) and Y (liquidity) values.
 we use scaling functions to convert between the decimal scale and the base denomination.
 */
@@ -70,27 +72,12 @@ func (lbc BondingCurve) LiquidityDecimals() int64 {
 
 // ValidateBasic checks if the bonding curve is valid
 func (lbc BondingCurve) ValidateBasic() error {
-	if lbc.M.IsNegative() {
-		return errorsmod.Wrapf(ErrInvalidBondingCurve, "m: %d", lbc.M)
-	}
-	if !lbc.N.IsPositive() {
-		return errorsmod.Wrapf(ErrInvalidBondingCurve, "n: %d", lbc.N)
-	}
-	if lbc.N.GT(math.LegacyNewDec(MaxNValue)) {
-		return errorsmod.Wrapf(ErrInvalidBondingCurve, "n exceeds maximum value of %d: %s", MaxNValue, lbc.N)
-	}
-
-	if lbc.C.IsNegative() {
-		return errorsmod.Wrapf(ErrInvalidBondingCurve, "c: %s", lbc.C.String())
-	}
-
-	// positive C is supported only for fixed price for now (due to equilibrium calculation)
-	if !lbc.C.IsZero() && !lbc.M.IsZero() {
-		return errorsmod.Wrapf(ErrInvalidBondingCurve, "m: %s, c: %s", lbc.M.String(), lbc.C.String())
+	if _, ok := CurveType_name[int32(lbc.CurveType)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "unknown curve type: %d", lbc.CurveType)
 	}
 
-	if !checkPrecision(lbc.N) {
-		return errorsmod.Wrapf(ErrInvalidBondingCurve, "N must have at most %d decimal places", MaxNPrecision)
+	if err := lbc.function().validate(); err != nil {
+		return err
 	}
 
 	if lbc.RollappDenomDecimals == 0 || lbc.LiquidityDenomDecimals == 0 {
@@ -207,43 +194,12 @@ func (lbc BondingCurve) TokensApproximation(startingX, spendTokens math.LegacyDe
 
 // spotPriceInternal returns the spot price at x
 func (lbc BondingCurve) spotPriceInternal(x math.LegacyDec) math.LegacyDec {
-	xDec := osmomath.BigDecFromSDKDec(x)
-	nDec := osmomath.BigDecFromSDKDec(lbc.N)
-	mDec := osmomath.BigDecFromSDKDec(lbc.M)
-
-	var xPowN osmomath.BigDec
-	if xDec.LT(osmomath.OneDec()) {
-		xPowN = osmomath.ZeroDec()
-	} else {
-		xPowN = xDec.Power(nDec) // Calculate x^N
-	}
-	price := mDec.Mul(xPowN).SDKDec().Add(lbc.C) // M * x^N + C
-	return price
+	return lbc.function().spotPrice(x)
 }
 
-// The integral of y = M * x^N + C is:
-//
-//	Cost = (M / (N + 1)) * x^(N + 1) + C * x.
+// integral returns the antiderivative of the price at x
 func (lbc BondingCurve) integral(x math.LegacyDec) math.LegacyDec {
-	xDec := osmomath.BigDecFromSDKDec(x)
-	mDec := osmomath.BigDecFromSDKDec(lbc.M)
-	cDec := osmomath.BigDecFromSDKDec(lbc.C)
-
-	nPlusOne := osmomath.BigDecFromSDKDec(lbc.N.Add(math.LegacyNewDec(1)))
-
-	var xPowNplusOne osmomath.BigDec
-	if xDec.LT(osmomath.OneDec()) {
-		xPowNplusOne = osmomath.ZeroDec()
-	} else {
-		xPowNplusOne = xDec.Power(nPlusOne) // Calculate x^(N + 1)
-	}
-
-	mDivNPlusOne := mDec.QuoMut(nPlusOne) // Calculate m / (N + 1)
-	cx := cDec.Mul(xDec)                  // Calculate C * x
-
-	// Calculate the integral
-	integral := xPowNplusOne.Mul(mDivNPlusOne).Add(cx).SDKDec()
-	return integral
+	return lbc.function().integral(x)
 }
 
 // CalculateM computes the M parameter for a bonding curve
@@ -308,9 +264,18 @@ func checkPrecision(d math.LegacyDec) bool {
 
 // String returns a human readable string representation of the bonding curve
 func (lbc BondingCurve) Stringify() string {
-	return fmt.Sprintf("M=%s N=%s C=%s",
-		lbc.M.String(),
-		lbc.N.String(),
-		lbc.C.String(),
-	)
+	switch lbc.CurveType {
+	case CURVE_TYPE_EXPONENTIAL:
+		return fmt.Sprintf("M=%s K=%s C=%s", lbc.M, lbc.K, lbc.C)
+	case CURVE_TYPE_LOGISTIC:
+		return fmt.Sprintf("K=%s C=%s MaxPrice=%s Midpoint=%s", lbc.K, lbc.C, lbc.MaxPrice, lbc.Midpoint)
+	case CURVE_TYPE_PIECEWISE_LINEAR:
+		return fmt.Sprintf("Breakpoints=%v", lbc.Breakpoints)
+	default:
+		return fmt.Sprintf("M=%s N=%s C=%s",
+			lbc.M.String(),
+			lbc.N.String(),
+			lbc.C.String(),
+		)
+	}
 }
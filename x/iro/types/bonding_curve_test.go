@@ -341,7 +341,8 @@ func TestUseCaseA(t *testing.T) {
 	curve := types.NewBondingCurve(m, n, c, 18, 18)
 
 	// find eq
-	eq := types.FindEquilibrium(curve, z.MulRaw(1e18), r)
+	eq, err := types.FindEquilibrium(curve, z.MulRaw(1e18), r)
+	require.NoError(t, err)
 
 	// verify that the cost early is lower than the cost later
 	// test for buying 10_000 RA tokens
@@ -376,7 +377,7 @@ func TestUseCaseA(t *testing.T) {
 	unsoldValue := curve.SpotPrice(eq).MulInt(unsoldRATokens).TruncateInt()
 
 	// assert dym value in the pool is equal to unsold value
-	err := approxEqualRatio(bootstrapFunds, unsoldValue, 0.001) // 0.1%
+	err = approxEqualRatio(bootstrapFunds, unsoldValue, 0.001) // 0.1%
 	require.NoError(t, err)
 
 	// assert the TVL in eq point is as expected
@@ -401,7 +402,8 @@ func TestUseCase_USDC(t *testing.T) {
 	curveDYM := types.NewBondingCurve(m, n, c, 18, 18) // we set 18 for RA and 18 for DYM
 
 	// find eq
-	eq := types.FindEquilibrium(curveUSDC, z.MulRaw(1e18), r)
+	eq, err := types.FindEquilibrium(curveUSDC, z.MulRaw(1e18), r)
+	require.NoError(t, err)
 
 	// verify that the cost early is lower than the cost later
 	// test for buying 10_000 RA tokens
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// CandleResolutions are the resolutions candles are recorded at
+var CandleResolutions = []CandleResolution{
+	CANDLE_RESOLUTION_MINUTE,
+	CANDLE_RESOLUTION_HOUR,
+	CANDLE_RESOLUTION_DAY,
+}
+
+// Duration returns the time span of a candle at the resolution
+func (r CandleResolution) Duration() time.Duration {
+	switch r {
+	case CANDLE_RESOLUTION_MINUTE:
+		return time.Minute
+	case CANDLE_RESOLUTION_HOUR:
+		return time.Hour
+	case CANDLE_RESOLUTION_DAY:
+		return 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// IsValid returns true if candles are recorded at the resolution
+func (r CandleResolution) IsValid() bool {
+	return r.Duration() > 0
+}
+
+// BucketStart returns the start of the bucket the given time falls in. Buckets are aligned to UTC.
+func (r CandleResolution) BucketStart(t time.Time) time.Time {
+	return t.Truncate(r.Duration()).UTC()
+}
+
+// NewCandle returns a candle opened at the given price, for the bucket starting at start
+func NewCandle(planId string, resolution CandleResolution, start time.Time, open math.LegacyDec) Candle {
+	return Candle{
+		PlanId:     planId,
+		Resolution: resolution,
+		StartTime:  start,
+		Open:       open,
+		High:       open,
+		Low:        open,
+		Close:      open,
+		Volume:     math.ZeroInt(),
+	}
+}
+
+// AddTrade updates the candle with a trade of the given amount that moved the price to closePrice
+func (c *Candle) AddTrade(closePrice math.LegacyDec, amt math.Int) {
+	c.High = math.LegacyMaxDec(c.High, closePrice)
+	c.Low = math.LegacyMinDec(c.Low, closePrice)
+	c.Close = closePrice
+	c.Volume = c.Volume.Add(amt)
+}
+
+func (c Candle) ValidateBasic() error {
+	if !c.Resolution.IsValid() {
+		return fmt.Errorf("invalid candle resolution: %s", c.Resolution)
+	}
+	if !c.StartTime.Equal(c.Resolution.BucketStart(c.StartTime)) {
+		return fmt.Errorf("candle start time is not aligned to the resolution: %s", c.StartTime)
+	}
+	for _, p := range []math.LegacyDec{c.Open, c.High, c.Low, c.Close} {
+		if p.IsNil() || p.IsNegative() {
+			return fmt.Errorf("candle prices must be non-negative: %s", p)
+		}
+	}
+	if c.Low.GT(c.High) || c.Open.GT(c.High) || c.Open.LT(c.Low) || c.Close.GT(c.High) || c.Close.LT(c.Low) {
+		return errors.New("candle open and close must be between low and high")
+	}
+	if c.Volume.IsNil() || c.Volume.IsNegative() {
+		return fmt.Errorf("candle volume must be non-negative: %s", c.Volume)
+	}
+	return nil
+}
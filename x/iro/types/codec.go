@@ -12,9 +12,13 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSell{}, "iro/Sell", nil)
 	cdc.RegisterConcrete(&MsgClaim{}, "iro/Claim", nil)
 	cdc.RegisterConcrete(&MsgClaimVested{}, "iro/ClaimVested", nil)
+	cdc.RegisterConcrete(&MsgRefund{}, "iro/Refund", nil)
+	cdc.RegisterConcrete(&MsgClaimTeamAllocation{}, "iro/ClaimTeamAllocation", nil)
 	cdc.RegisterConcrete(&MsgCreatePlan{}, "iro/CreatePlan", nil)
+	cdc.RegisterConcrete(&MsgUpdatePlan{}, "iro/UpdatePlan", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "iro/UpdateParams", nil)
 	cdc.RegisterConcrete(&MsgBuyExactSpend{}, "iro/BuyExactSpend", nil)
+	cdc.RegisterConcrete(&MsgBuyExactSpendWithSwap{}, "iro/BuyExactSpendWithSwap", nil)
 	cdc.RegisterConcrete(&MsgEnableTrading{}, "iro/EnableTrading", nil)
 }
 
@@ -25,8 +29,11 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgSell{},
 		&MsgClaim{},
 		&MsgClaimVested{},
+		&MsgRefund{},
+		&MsgClaimTeamAllocation{},
 		&MsgEnableTrading{},
 		&MsgCreatePlan{},
+		&MsgUpdatePlan{},
 		&MsgUpdateParams{},
 	)
 
@@ -0,0 +1,419 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+/*
+Curve families other than the power curve.
+All of them operate on the decimal representation of the supply and price, same as the power curve.
+Only spotPrice and integral are family specific: cost, the Newton-Raphson inverse and the equilibrium
+are derived from them.
+
+- Exponential: P = M * e^(K*x) + C
+  Integral = (M/K) * e^(K*x) + C*x
+- Logistic: P = C + (MaxPrice - C) / (1 + e^(-K*(x - Midpoint)))
+  Integral = C*x + ((MaxPrice - C)/K) * ln(1 + e^(K*(x - Midpoint)))
+- Piecewise linear: P interpolated linearly between breakpoints (flat after the last one)
+  Integral = sum of the trapezoids under the breakpoints up to x
+*/
+
+const (
+	// MaxExpArgument bounds K*x of exponential curves, so e^(K*x) can be evaluated
+	MaxExpArgument = 300
+
+	// MaxLogisticArgument bounds |K*(x - Midpoint)| of logistic curves, so e^(K*(x - Midpoint)) can be evaluated
+	MaxLogisticArgument = 355
+
+	// maxCostDigits bounds the price and the integral of a curve in the liquidity base denomination to
+	// 10^maxCostDigits, which keeps them in the math.Int and math.LegacyDec range (~1.15e77)
+	maxCostDigits = 76
+
+	// softplusCutoff is the |z| beyond which ln(1+e^z) is approximated by z (z > 0) or e^z (z < 0)
+	softplusCutoff = 40
+
+	// sigmoidCutoff is the |z| beyond which e^-|z| is below the BigDec precision (1e-36), so 1/(1+e^-z)
+	// is saturated to 0 or 1, and ln(1+e^-|z|) to 0
+	sigmoidCutoff = 84
+)
+
+// log2(e), used to compute e^x as 2^(x*log2(e))
+var log2E = osmomath.MustNewDecFromStr("1.442695040888963407359924681001892137")
+
+// curveFunction is the family specific part of a bonding curve
+type curveFunction interface {
+	// spotPrice returns the price at supply x
+	spotPrice(x math.LegacyDec) math.LegacyDec
+	// integral returns an antiderivative of the price at supply x
+	integral(x math.LegacyDec) math.LegacyDec
+	// validate checks the family specific parameters
+	validate() error
+}
+
+func (lbc BondingCurve) function() curveFunction {
+	switch lbc.CurveType {
+	case CURVE_TYPE_EXPONENTIAL:
+		return exponentialCurve{lbc}
+	case CURVE_TYPE_LOGISTIC:
+		return logisticCurve{lbc}
+	case CURVE_TYPE_PIECEWISE_LINEAR:
+		return piecewiseLinearCurve{lbc}
+	default:
+		return powerCurve{lbc}
+	}
+}
+
+// NewExponentialBondingCurve returns a curve with price M * e^(K*x) + C
+func NewExponentialBondingCurve(m, k, c math.LegacyDec, rollappDenomDecimals, liquidityDenomDecimals uint64) BondingCurve {
+	return BondingCurve{
+		CurveType:              CURVE_TYPE_EXPONENTIAL,
+		M:                      m,
+		N:                      math.LegacyZeroDec(),
+		C:                      c,
+		K:                      &k,
+		RollappDenomDecimals:   rollappDenomDecimals,
+		LiquidityDenomDecimals: liquidityDenomDecimals,
+	}
+}
+
+// NewLogisticBondingCurve returns a curve rising from around C to maxPrice, with the steepest growth at midpoint
+func NewLogisticBondingCurve(k, c, maxPrice, midpoint math.LegacyDec, rollappDenomDecimals, liquidityDenomDecimals uint64) BondingCurve {
+	return BondingCurve{
+		CurveType:              CURVE_TYPE_LOGISTIC,
+		M:                      math.LegacyZeroDec(),
+		N:                      math.LegacyZeroDec(),
+		C:                      c,
+		K:                      &k,
+		MaxPrice:               &maxPrice,
+		Midpoint:               &midpoint,
+		RollappDenomDecimals:   rollappDenomDecimals,
+		LiquidityDenomDecimals: liquidityDenomDecimals,
+	}
+}
+
+// NewPiecewiseLinearBondingCurve returns a curve interpolating linearly between the breakpoints
+func NewPiecewiseLinearBondingCurve(breakpoints []CurveBreakpoint, rollappDenomDecimals, liquidityDenomDecimals uint64) BondingCurve {
+	return BondingCurve{
+		CurveType:              CURVE_TYPE_PIECEWISE_LINEAR,
+		M:                      math.LegacyZeroDec(),
+		N:                      math.LegacyZeroDec(),
+		C:                      math.LegacyZeroDec(),
+		Breakpoints:            breakpoints,
+		RollappDenomDecimals:   rollappDenomDecimals,
+		LiquidityDenomDecimals: liquidityDenomDecimals,
+	}
+}
+
+// ValidateSupply checks the curve can be evaluated up to the given supply (in decimal representation)
+func (lbc BondingCurve) ValidateSupply(maxSupply math.LegacyDec) error {
+	switch lbc.CurveType {
+	case CURVE_TYPE_EXPONENTIAL:
+		return lbc.validateExponentialSupply(maxSupply)
+	case CURVE_TYPE_LOGISTIC:
+		return lbc.validateLogisticSupply(maxSupply)
+	default:
+		return nil
+	}
+}
+
+func (lbc BondingCurve) validateExponentialSupply(maxSupply math.LegacyDec) error {
+	kx := lbc.K.Mul(maxSupply)
+	if kx.GT(math.LegacyNewDec(MaxExpArgument)) {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "k * supply must be at most %d", MaxExpArgument)
+	}
+
+	// the price and the integral are increasing, so they are largest at the max supply. Both are evaluated
+	// in BigDec, which has room for values far out of the math.LegacyDec range.
+	e := exp(osmomath.BigDecFromSDKDec(kx))
+	m := osmomath.BigDecFromSDKDec(lbc.M)
+	cx := osmomath.BigDecFromSDKDec(lbc.C).Mul(osmomath.BigDecFromSDKDec(maxSupply))
+	price := m.Mul(e).Add(osmomath.BigDecFromSDKDec(lbc.C))
+	integral := m.Quo(osmomath.BigDecFromSDKDec(*lbc.K)).Mul(e).Add(cx)
+
+	digits := maxCostDigits - lbc.LiquidityDecimals()
+	if digits < 0 {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "liquidity decimals must be at most %d", maxCostDigits)
+	}
+	bound := osmomath.NewBigDec(10).PowerInteger(uint64(digits))
+	if price.GT(bound) || integral.GT(bound) {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "price and cost at supply %s must be at most 1e%d", maxSupply, digits)
+	}
+	return nil
+}
+
+// validateLogisticSupply checks K*(x - Midpoint) stays in range over [0, maxSupply]. It is most negative
+// at 0 and most positive at the max supply.
+func (lbc BondingCurve) validateLogisticSupply(maxSupply math.LegacyDec) error {
+	bound := math.LegacyNewDec(MaxLogisticArgument)
+	if lbc.K.Mul(*lbc.Midpoint).GTE(bound) {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "k * midpoint must be below %d", MaxLogisticArgument)
+	}
+	if lbc.K.Mul(maxSupply.Sub(*lbc.Midpoint)).GTE(bound) {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "k * (supply - midpoint) must be below %d", MaxLogisticArgument)
+	}
+	return nil
+}
+
+// ValidateParams checks the curve against the governance parameters
+func (lbc BondingCurve) ValidateParams(params Params) error {
+	if lbc.CurveType == CURVE_TYPE_PIECEWISE_LINEAR && uint64(len(lbc.Breakpoints)) > params.MaxCurveBreakpoints {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "breakpoints: %d, max: %d", len(lbc.Breakpoints), params.MaxCurveBreakpoints)
+	}
+	return nil
+}
+
+/* ---------------------------------- power --------------------------------- */
+
+type powerCurve struct{ BondingCurve }
+
+func (c powerCurve) validate() error {
+	lbc := c.BondingCurve
+	if lbc.M.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "m: %d", lbc.M)
+	}
+	if !lbc.N.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "n: %d", lbc.N)
+	}
+	if lbc.N.GT(math.LegacyNewDec(MaxNValue)) {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "n exceeds maximum value of %d: %s", MaxNValue, lbc.N)
+	}
+	if lbc.C.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "c: %s", lbc.C.String())
+	}
+	if !checkPrecision(lbc.N) {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "N must have at most %d decimal places", MaxNPrecision)
+	}
+	return nil
+}
+
+func (c powerCurve) spotPrice(x math.LegacyDec) math.LegacyDec {
+	xDec := osmomath.BigDecFromSDKDec(x)
+	nDec := osmomath.BigDecFromSDKDec(c.N)
+	mDec := osmomath.BigDecFromSDKDec(c.M)
+
+	var xPowN osmomath.BigDec
+	if xDec.LT(osmomath.OneDec()) {
+		xPowN = osmomath.ZeroDec()
+	} else {
+		xPowN = xDec.Power(nDec) // Calculate x^N
+	}
+	price := mDec.Mul(xPowN).SDKDec().Add(c.C) // M * x^N + C
+	return price
+}
+
+// The integral of y = M * x^N + C is:
+//
+//	Cost = (M / (N + 1)) * x^(N + 1) + C * x.
+func (c powerCurve) integral(x math.LegacyDec) math.LegacyDec {
+	xDec := osmomath.BigDecFromSDKDec(x)
+	mDec := osmomath.BigDecFromSDKDec(c.M)
+	cDec := osmomath.BigDecFromSDKDec(c.C)
+
+	nPlusOne := osmomath.BigDecFromSDKDec(c.N.Add(math.LegacyNewDec(1)))
+
+	var xPowNplusOne osmomath.BigDec
+	if xDec.LT(osmomath.OneDec()) {
+		xPowNplusOne = osmomath.ZeroDec()
+	} else {
+		xPowNplusOne = xDec.Power(nPlusOne) // Calculate x^(N + 1)
+	}
+
+	mDivNPlusOne := mDec.QuoMut(nPlusOne) // Calculate m / (N + 1)
+	cx := cDec.Mul(xDec)                  // Calculate C * x
+
+	// Calculate the integral
+	integral := xPowNplusOne.Mul(mDivNPlusOne).Add(cx).SDKDec()
+	return integral
+}
+
+/* ------------------------------- exponential ------------------------------ */
+
+type exponentialCurve struct{ BondingCurve }
+
+func (c exponentialCurve) validate() error {
+	if !c.M.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "m must be positive: %s", c.M)
+	}
+	if c.K == nil || !c.K.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidBondingCurve, "k must be positive")
+	}
+	if c.C.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "c: %s", c.C)
+	}
+	return nil
+}
+
+func (c exponentialCurve) spotPrice(x math.LegacyDec) math.LegacyDec {
+	e := exp(osmomath.BigDecFromSDKDec(c.K.Mul(x)))
+	return osmomath.BigDecFromSDKDec(c.M).Mul(e).SDKDec().Add(c.C)
+}
+
+func (c exponentialCurve) integral(x math.LegacyDec) math.LegacyDec {
+	e := exp(osmomath.BigDecFromSDKDec(c.K.Mul(x)))
+	mDivK := osmomath.BigDecFromSDKDec(c.M).Quo(osmomath.BigDecFromSDKDec(*c.K))
+	return mDivK.Mul(e).SDKDec().Add(c.C.Mul(x))
+}
+
+/* -------------------------------- logistic -------------------------------- */
+
+type logisticCurve struct{ BondingCurve }
+
+func (c logisticCurve) validate() error {
+	if c.K == nil || !c.K.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidBondingCurve, "k must be positive")
+	}
+	if c.C.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "c: %s", c.C)
+	}
+	if c.MaxPrice == nil || !c.MaxPrice.GT(c.C) {
+		return errorsmod.Wrap(ErrInvalidBondingCurve, "max price must be greater than c")
+	}
+	if c.Midpoint == nil || c.Midpoint.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidBondingCurve, "midpoint must be non-negative")
+	}
+	return nil
+}
+
+// z returns K * (x - Midpoint)
+func (c logisticCurve) z(x math.LegacyDec) osmomath.BigDec {
+	return osmomath.BigDecFromSDKDec(c.K.Mul(x.Sub(*c.Midpoint)))
+}
+
+func (c logisticCurve) spotPrice(x math.LegacyDec) math.LegacyDec {
+	return osmomath.BigDecFromSDKDec(c.MaxPrice.Sub(c.C)).Mul(sigmoid(c.z(x))).SDKDec().Add(c.C)
+}
+
+func (c logisticCurve) integral(x math.LegacyDec) math.LegacyDec {
+	scale := osmomath.BigDecFromSDKDec(c.MaxPrice.Sub(c.C)).Quo(osmomath.BigDecFromSDKDec(*c.K))
+	return scale.Mul(softplus(c.z(x))).SDKDec().Add(c.C.Mul(x))
+}
+
+/* ---------------------------- piecewise linear ---------------------------- */
+
+type piecewiseLinearCurve struct{ BondingCurve }
+
+func (c piecewiseLinearCurve) validate() error {
+	if len(c.Breakpoints) < 2 {
+		return errorsmod.Wrap(ErrInvalidBondingCurve, "at least two breakpoints are required")
+	}
+	if !c.Breakpoints[0].Supply.IsZero() {
+		return errorsmod.Wrap(ErrInvalidBondingCurve, "first breakpoint must be at zero supply")
+	}
+	if !c.Breakpoints[0].Price.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidBondingCurve, "first breakpoint price must be positive")
+	}
+	for i := 1; i < len(c.Breakpoints); i++ {
+		prev, curr := c.Breakpoints[i-1], c.Breakpoints[i]
+		if !curr.Supply.GT(prev.Supply) {
+			return errorsmod.Wrapf(ErrInvalidBondingCurve, "breakpoint %d: supply must be increasing", i)
+		}
+		if curr.Price.LT(prev.Price) {
+			return errorsmod.Wrapf(ErrInvalidBondingCurve, "breakpoint %d: price must be non-decreasing", i)
+		}
+	}
+	return nil
+}
+
+func (c piecewiseLinearCurve) spotPrice(x math.LegacyDec) math.LegacyDec {
+	bps := c.Breakpoints
+	for i := 1; i < len(bps); i++ {
+		if x.LT(bps[i].Supply) {
+			return interpolate(bps[i-1], bps[i], x)
+		}
+	}
+	return bps[len(bps)-1].Price
+}
+
+func (c piecewiseLinearCurve) integral(x math.LegacyDec) math.LegacyDec {
+	bps := c.Breakpoints
+	total := math.LegacyZeroDec()
+	for i := 1; i < len(bps); i++ {
+		prev, curr := bps[i-1], bps[i]
+		if x.LT(curr.Supply) {
+			// partial trapezoid up to x
+			return total.Add(prev.Price.Add(interpolate(prev, curr, x)).Mul(x.Sub(prev.Supply)).QuoInt64(2))
+		}
+		total = total.Add(prev.Price.Add(curr.Price).Mul(curr.Supply.Sub(prev.Supply)).QuoInt64(2))
+	}
+	last := bps[len(bps)-1]
+	return total.Add(last.Price.Mul(x.Sub(last.Supply)))
+}
+
+// interpolate returns the price at x on the segment between a and b
+func interpolate(a, b CurveBreakpoint, x math.LegacyDec) math.LegacyDec {
+	slope := b.Price.Sub(a.Price).Quo(b.Supply.Sub(a.Supply))
+	return a.Price.Add(slope.Mul(x.Sub(a.Supply)))
+}
+
+/* -------------------------------- helpers --------------------------------- */
+
+// exp returns e^x
+func exp(x osmomath.BigDec) osmomath.BigDec {
+	if x.IsNegative() {
+		return osmomath.OneDec().Quo(osmomath.Exp2(x.Neg().Mul(log2E)))
+	}
+	return osmomath.Exp2(x.Mul(log2E))
+}
+
+// softplus returns ln(1 + e^z)
+func softplus(z osmomath.BigDec) osmomath.BigDec {
+	cutoff := osmomath.NewBigDec(softplusCutoff)
+	if z.GT(cutoff) {
+		return z
+	}
+	if z.LT(osmomath.NewBigDec(-sigmoidCutoff)) {
+		return osmomath.ZeroDec()
+	}
+	if z.LT(cutoff.Neg()) {
+		return exp(z)
+	}
+	return osmomath.OneDec().Add(exp(z)).Ln()
+}
+
+// sigmoid returns 1 / (1 + e^-z)
+func sigmoid(z osmomath.BigDec) osmomath.BigDec {
+	cutoff := osmomath.NewBigDec(sigmoidCutoff)
+	if z.GT(cutoff) {
+		return osmomath.OneDec()
+	}
+	if z.LT(cutoff.Neg()) {
+		return osmomath.ZeroDec()
+	}
+	return osmomath.OneDec().Quo(osmomath.OneDec().Add(exp(z.Neg())))
+}
+
+// bisectEquilibrium finds the sold amount x in [0, t] where the curve price equals the price of a pool
+// bootstrapped with r of the raised liquidity and the unsold tokens:
+//
+//	P(x) * (t - x) = r * (I(x) - I(0))
+//
+// The left side is positive at 0 and the right side is positive at t, so a root exists in between.
+func bisectEquilibrium(f curveFunction, t, r math.LegacyDec) (math.LegacyDec, error) {
+	i0 := f.integral(math.LegacyZeroDec())
+	g := func(x math.LegacyDec) math.LegacyDec {
+		return f.spotPrice(x).Mul(t.Sub(x)).Sub(r.Mul(f.integral(x).Sub(i0)))
+	}
+
+	lo, hi := math.LegacyZeroDec(), t
+	if g(hi).IsPositive() {
+		return math.LegacyDec{}, errors.New("no equilibrium within the allocation")
+	}
+
+	epsilonDec := math.LegacyNewDecWithPrec(1, epsilonPrecision)
+	for i := 0; i < maxIterations*2; i++ {
+		if hi.Sub(lo).LT(epsilonDec) {
+			break
+		}
+		mid := lo.Add(hi).QuoInt64(2)
+		if g(mid).IsPositive() {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return lo, nil
+}
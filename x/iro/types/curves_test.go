@@ -0,0 +1,151 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+func dec(s string) math.LegacyDec {
+	return math.LegacyMustNewDecFromStr(s)
+}
+
+func TestCurveFamilies(t *testing.T) {
+	allocation := math.NewInt(1_000_000).MulRaw(1e18)
+	r := dec("0.5")
+
+	testcases := []struct {
+		name  string
+		curve types.BondingCurve
+	}{
+		{"Power with floor price", types.NewBondingCurve(dec("0.0000001"), dec("1"), dec("0.01"), 18, 18)},
+		{"Exponential", types.NewExponentialBondingCurve(dec("0.01"), dec("0.000002"), dec("0.005"), 18, 18)},
+		{"Logistic", types.NewLogisticBondingCurve(dec("0.00002"), dec("0.01"), dec("1"), dec("300000"), 18, 18)},
+		{"Piecewise linear", types.NewPiecewiseLinearBondingCurve([]types.CurveBreakpoint{
+			{Supply: dec("0"), Price: dec("0.01")},
+			{Supply: dec("100000"), Price: dec("0.02")},
+			{Supply: dec("500000"), Price: dec("0.5")},
+		}, 18, 18)},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			curve := tc.curve
+			require.NoError(t, curve.ValidateBasic())
+			require.NoError(t, curve.ValidateSupply(types.ScaleFromBase(allocation, 18)))
+
+			// price is increasing and cost is positive
+			x1 := math.NewInt(1_000).MulRaw(1e18)
+			x2 := math.NewInt(200_000).MulRaw(1e18)
+			require.True(t, curve.SpotPrice(x2).GT(curve.SpotPrice(x1)))
+			cost := curve.Cost(x1, x2)
+			require.True(t, cost.IsPositive())
+
+			// cost is between the price at both ends times the amount
+			amt := types.ScaleFromBase(x2.Sub(x1), 18)
+			costDec := types.ScaleFromBase(cost, 18)
+			require.True(t, costDec.GT(curve.SpotPrice(x1).Mul(amt)))
+			require.True(t, costDec.LT(curve.SpotPrice(x2).Mul(amt)))
+
+			// inverse of the cost
+			tokens, err := curve.TokensForExactInAmount(x1, cost)
+			require.NoError(t, err)
+			require.NoError(t, approxEqualRatio(x2.Sub(x1), tokens, 0.0001))
+
+			// equilibrium: the pool price equals the curve price
+			eq, err := types.FindEquilibrium(curve, allocation, r)
+			require.NoError(t, err)
+			require.True(t, eq.IsPositive())
+			require.True(t, eq.LT(allocation))
+			raised := curve.Cost(math.ZeroInt(), eq)
+			poolPrice := raised.ToLegacyDec().Mul(r).QuoInt(allocation.Sub(eq))
+			require.NoError(t, approxEqualRatio(curve.SpotPrice(eq), poolPrice, 0.001))
+		})
+	}
+}
+
+func TestPiecewiseLinearCurve(t *testing.T) {
+	curve := types.NewPiecewiseLinearBondingCurve([]types.CurveBreakpoint{
+		{Supply: dec("0"), Price: dec("1")},
+		{Supply: dec("10"), Price: dec("3")},
+	}, 18, 18)
+	require.NoError(t, curve.ValidateBasic())
+
+	unit := math.NewInt(1e18)
+	require.Equal(t, dec("2"), curve.SpotPrice(unit.MulRaw(5)))
+	require.Equal(t, dec("3"), curve.SpotPrice(unit.MulRaw(20)))
+
+	// trapezoid (1+3)/2*10 = 20, then flat 3*5 = 15
+	require.Equal(t, unit.MulRaw(20), curve.Cost(math.ZeroInt(), unit.MulRaw(10)))
+	require.Equal(t, unit.MulRaw(35), curve.Cost(math.ZeroInt(), unit.MulRaw(15)))
+
+	params := types.DefaultParams()
+	require.NoError(t, curve.ValidateParams(params))
+	params.MaxCurveBreakpoints = 1
+	require.Error(t, curve.ValidateParams(params))
+}
+
+func TestCurveFamilies_ValidateBasic(t *testing.T) {
+	testcases := []struct {
+		name  string
+		curve types.BondingCurve
+	}{
+		{"Exponential zero k", types.NewExponentialBondingCurve(dec("1"), dec("0"), dec("0"), 18, 18)},
+		{"Exponential zero m", types.NewExponentialBondingCurve(dec("0"), dec("1"), dec("0"), 18, 18)},
+		{"Logistic max price below floor", types.NewLogisticBondingCurve(dec("1"), dec("2"), dec("1"), dec("0"), 18, 18)},
+		{"Logistic negative midpoint", types.NewLogisticBondingCurve(dec("1"), dec("0"), dec("1"), dec("-1"), 18, 18)},
+		{"Piecewise single breakpoint", types.NewPiecewiseLinearBondingCurve([]types.CurveBreakpoint{{Supply: dec("0"), Price: dec("1")}}, 18, 18)},
+		{"Piecewise not starting at zero", types.NewPiecewiseLinearBondingCurve([]types.CurveBreakpoint{
+			{Supply: dec("1"), Price: dec("1")}, {Supply: dec("2"), Price: dec("2")},
+		}, 18, 18)},
+		{"Piecewise decreasing price", types.NewPiecewiseLinearBondingCurve([]types.CurveBreakpoint{
+			{Supply: dec("0"), Price: dec("2")}, {Supply: dec("2"), Price: dec("1")},
+		}, 18, 18)},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Error(t, tc.curve.ValidateBasic())
+		})
+	}
+
+	// exponential growth must stay in the supported range
+	curve := types.NewExponentialBondingCurve(dec("1"), dec("0.001"), dec("0"), 18, 18)
+	require.Error(t, curve.ValidateSupply(dec("1000000")))
+
+	// k * supply is in range, but the price is out of the math.LegacyDec range
+	curve = types.NewExponentialBondingCurve(dec("0.000000000000000001"), dec("0.0003"), dec("0"), 18, 18)
+	require.NoError(t, curve.ValidateBasic())
+	require.Error(t, curve.ValidateSupply(dec("1000000")))
+	require.NoError(t, curve.ValidateSupply(dec("100000")))
+	require.NotPanics(t, func() {
+		supply := math.NewInt(100_000).MulRaw(1e18)
+		curve.SpotPrice(supply)
+		curve.Cost(math.ZeroInt(), supply)
+	})
+}
+
+func TestFindEquilibrium_NoSolution(t *testing.T) {
+	curve := types.NewExponentialBondingCurve(dec("0.01"), dec("0.000002"), dec("0.005"), 18, 18)
+	// with a negative liquidity part the pool price is below the curve price for every sold amount
+	_, err := types.FindEquilibrium(curve, math.NewInt(1_000_000).MulRaw(1e18), dec("-0.5"))
+	require.ErrorIs(t, err, types.ErrInvalidBondingCurve)
+}
+
+func TestLogisticCurve_ValidateSupply(t *testing.T) {
+	// k * midpoint is 500, so e^(k*(x - midpoint)) is out of range at low supply
+	curve := types.NewLogisticBondingCurve(dec("0.000001"), dec("0.01"), dec("1"), dec("500000000"), 18, 18)
+	require.NoError(t, curve.ValidateBasic())
+	require.Error(t, curve.ValidateSupply(dec("1000000000")))
+	require.NotPanics(t, func() {
+		_, _ = types.FindEquilibrium(curve, math.NewInt(1_000_000_000).MulRaw(1e18), dec("0.5"))
+	})
+
+	// k * (supply - midpoint) is 500
+	curve = types.NewLogisticBondingCurve(dec("0.000001"), dec("0.01"), dec("1"), dec("0"), 18, 18)
+	require.Error(t, curve.ValidateSupply(dec("500000000")))
+	require.NoError(t, curve.ValidateSupply(dec("300000000")))
+}
@@ -28,4 +28,15 @@ var (
 	ErrInsufficientTokens           = errorsmod.Register(ModuleName, 1118, "insufficient tokens")
 	ErrRollappGenesisInfoNotSet     = errorsmod.Register(ModuleName, 1119, "rollapp genesis info not set")
 	ErrInvalidIncentivePlanParams   = errorsmod.Register(ModuleName, 1120, "invalid incentive plan params")
+	ErrInvalidPresale               = errorsmod.Register(ModuleName, 1121, "invalid presale")
+	ErrPresaleNotAllowed            = errorsmod.Register(ModuleName, 1122, "not allowed to buy during presale")
+	ErrInvalidTradingLimits         = errorsmod.Register(ModuleName, 1123, "invalid trading limits")
+	ErrTradingLimitExceeded         = errorsmod.Register(ModuleName, 1124, "trading limit exceeded")
+	ErrPlanFailed                   = errorsmod.Register(ModuleName, 1125, "plan failed")
+	ErrPlanNotFailed                = errorsmod.Register(ModuleName, 1126, "plan has not failed")
+	ErrNoTokensToRefund             = errorsmod.Register(ModuleName, 1127, "no tokens to refund")
+	ErrInvalidPoolParams            = errorsmod.Register(ModuleName, 1128, "invalid settlement pool params")
+	ErrInvalidTeamAllocation        = errorsmod.Register(ModuleName, 1129, "invalid team allocation")
+	ErrTeamAllocationNotFound       = errorsmod.Register(ModuleName, 1130, "team allocation not found")
+	ErrSelfReferral                 = errorsmod.Register(ModuleName, 1131, "trader cannot refer itself")
 )
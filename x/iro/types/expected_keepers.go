@@ -4,6 +4,7 @@ import (
 	context "context"
 	"time"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	gammtypes "github.com/osmosis-labs/osmosis/v15/x/gamm/types"
@@ -47,11 +48,13 @@ type IncentivesKeeper interface {
 // GammKeeper defines the expected interface needed to retrieve account balances.
 type GammKeeper interface {
 	GetParams(ctx sdk.Context) (params gammtypes.Params)
+	SubTakerFee(tokenIn sdk.Coin, takerFee math.LegacyDec) (sdk.Coin, sdk.Coin)
 }
 
 // PoolManagerKeeper defines the expected interface needed to retrieve account balances.
 type PoolManagerKeeper interface {
 	CreatePool(ctx sdk.Context, msg poolmanagertypes.CreatePoolMsg) (uint64, error)
+	RouteExactAmountIn(ctx sdk.Context, sender sdk.AccAddress, routes []poolmanagertypes.SwapAmountInRoute, tokenIn sdk.Coin, tokenOutMinAmount math.Int) (math.Int, error)
 }
 
 // RollappKeeper defines the expected interface needed to retrieve account balances.
@@ -60,6 +63,7 @@ type RollappKeeper interface {
 	SetIROPlanToRollapp(ctx sdk.Context, rollapp *rollapptypes.Rollapp, iro Plan) error
 	SetPreLaunchTime(ctx sdk.Context, rollapp *rollapptypes.Rollapp, preLaunchTime time.Time)
 	MustGetRollappOwner(ctx sdk.Context, rollappID string) sdk.AccAddress
+	GetRollappOwnerByDenom(ctx sdk.Context, denom string) (sdk.AccAddress, error)
 }
 
 type TxFeesKeeper interface {
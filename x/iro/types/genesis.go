@@ -31,5 +31,53 @@ func (gs GenesisState) Validate() error {
 		ids[plan.Id] = true
 	}
 
+	allocations := make(map[string]bool)
+	for _, a := range gs.PresaleAllocations {
+		if err := a.ValidateBasic(); err != nil {
+			return err
+		}
+		key := string(PresaleAllocationKey(a.PlanId, a.Address))
+		if allocations[key] {
+			return fmt.Errorf("duplicate presale allocation: plan %s: %s", a.PlanId, a.Address)
+		}
+		allocations[key] = true
+	}
+
+	purchases := make(map[string]bool)
+	for _, p := range gs.Purchases {
+		if err := p.ValidateBasic(); err != nil {
+			return err
+		}
+		key := string(PurchaseKey(p.PlanId, p.Address))
+		if purchases[key] {
+			return fmt.Errorf("duplicate purchase: plan %s: %s", p.PlanId, p.Address)
+		}
+		purchases[key] = true
+	}
+
+	candles := make(map[string]bool)
+	for _, c := range gs.Candles {
+		if err := c.ValidateBasic(); err != nil {
+			return err
+		}
+		key := string(CandleKey(c.PlanId, c.Resolution, c.StartTime))
+		if candles[key] {
+			return fmt.Errorf("duplicate candle: plan %s: %s: %s", c.PlanId, c.Resolution, c.StartTime)
+		}
+		candles[key] = true
+	}
+
+	referrals := make(map[string]bool)
+	for _, r := range gs.Referrals {
+		if err := r.ValidateBasic(); err != nil {
+			return err
+		}
+		key := string(ReferralKey(r.PlanId, r.Referrer))
+		if referrals[key] {
+			return fmt.Errorf("duplicate referral: plan %s: %s", r.PlanId, r.Referrer)
+		}
+		referrals[key] = true
+	}
+
 	return gs.Params.ValidateBasic()
 }
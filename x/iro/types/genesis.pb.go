@@ -29,7 +29,11 @@ type GenesisState struct {
 	// Params defines params for x/sponsorship module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// VoterInfos hold information about voters.
-	Plans []Plan `protobuf:"bytes,2,rep,name=plans,proto3" json:"plans"`
+	Plans              []Plan              `protobuf:"bytes,2,rep,name=plans,proto3" json:"plans"`
+	PresaleAllocations []PresaleAllocation `protobuf:"bytes,3,rep,name=presale_allocations,json=presaleAllocations,proto3" json:"presale_allocations"`
+	Purchases          []Purchase          `protobuf:"bytes,4,rep,name=purchases,proto3" json:"purchases"`
+	Candles            []Candle            `protobuf:"bytes,5,rep,name=candles,proto3" json:"candles"`
+	Referrals          []Referral          `protobuf:"bytes,6,rep,name=referrals,proto3" json:"referrals"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +83,34 @@ func (m *GenesisState) GetPlans() []Plan {
 	return nil
 }
 
+func (m *GenesisState) GetPresaleAllocations() []PresaleAllocation {
+	if m != nil {
+		return m.PresaleAllocations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPurchases() []Purchase {
+	if m != nil {
+		return m.Purchases
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCandles() []Candle {
+	if m != nil {
+		return m.Candles
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReferrals() []Referral {
+	if m != nil {
+		return m.Referrals
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dymensionxyz.dymension.iro.GenesisState")
 }
@@ -88,23 +120,29 @@ func init() {
 }
 
 var fileDescriptor_7c6c6e7791476d37 = []byte{
-	// 245 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x48, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x33, 0x8b, 0xf2, 0xf5,
-	0xd3, 0x53, 0xf3, 0x52, 0x8b, 0x33, 0x8b, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xa4, 0x90,
-	0x55, 0xea, 0xc1, 0x39, 0x7a, 0x99, 0x45, 0xf9, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x65,
-	0xfa, 0x20, 0x16, 0x44, 0x87, 0x94, 0x64, 0x72, 0x7e, 0x71, 0x6e, 0x7e, 0x71, 0x3c, 0x44, 0x02,
-	0xc2, 0x81, 0x4a, 0xa9, 0xe3, 0xb1, 0xb6, 0x20, 0xb1, 0x28, 0x31, 0x17, 0xa6, 0x50, 0x05, 0x8f,
-	0xc2, 0xcc, 0x22, 0xa8, 0x4d, 0x4a, 0x7d, 0x8c, 0x5c, 0x3c, 0xee, 0x10, 0xd7, 0x06, 0x97, 0x24,
-	0x96, 0xa4, 0x0a, 0x39, 0x70, 0xb1, 0x41, 0x8c, 0x91, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x52,
-	0xd2, 0xc3, 0xed, 0x7a, 0xbd, 0x00, 0xb0, 0x4a, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0,
-	0xfa, 0x84, 0x6c, 0xb8, 0x58, 0x0b, 0x72, 0x12, 0xf3, 0x8a, 0x25, 0x98, 0x14, 0x98, 0x35, 0xb8,
-	0x8d, 0x14, 0xf0, 0x1a, 0x90, 0x93, 0x98, 0x07, 0xd5, 0x0e, 0xd1, 0xe4, 0xe4, 0x75, 0xe2, 0x91,
-	0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1,
-	0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x06, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a,
-	0xc9, 0xf9, 0xb9, 0xfa, 0x38, 0xfc, 0x56, 0x66, 0xac, 0x5f, 0x01, 0xf6, 0x60, 0x49, 0x65, 0x41,
-	0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x8f, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5b, 0xad, 0x9d,
-	0xb8, 0xab, 0x01, 0x00, 0x00,
+	// 350 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xb1, 0x4e, 0xeb, 0x30,
+	0x14, 0x86, 0x93, 0x9b, 0xb6, 0x57, 0x37, 0xbd, 0x93, 0xef, 0x1d, 0x42, 0x87, 0x10, 0x55, 0x95,
+	0xe8, 0x42, 0x82, 0xda, 0x95, 0x01, 0xca, 0x00, 0x62, 0x42, 0x65, 0x63, 0xa9, 0xdc, 0xd4, 0xa4,
+	0x96, 0x1c, 0xdb, 0xf2, 0x49, 0x51, 0xcb, 0x53, 0xf0, 0x58, 0x1d, 0x18, 0x3a, 0x32, 0x21, 0xd4,
+	0xbe, 0x08, 0xaa, 0xed, 0x06, 0x84, 0xd4, 0x74, 0xcb, 0xd1, 0xf9, 0xbf, 0xcf, 0xbf, 0xa2, 0xe3,
+	0x77, 0x27, 0x8b, 0x9c, 0x70, 0xa0, 0x82, 0xcf, 0x17, 0xcf, 0x49, 0x39, 0x24, 0x54, 0x89, 0x24,
+	0x23, 0x9c, 0x00, 0x85, 0x58, 0x2a, 0x51, 0x08, 0xd4, 0xfa, 0x9e, 0x8c, 0xcb, 0x21, 0xa6, 0x4a,
+	0xb4, 0xfe, 0x67, 0x22, 0x13, 0x3a, 0x96, 0x6c, 0xbf, 0x0c, 0xd1, 0x3a, 0x4a, 0x05, 0xe4, 0x02,
+	0x46, 0x66, 0x61, 0x06, 0xbb, 0x3a, 0xa9, 0x78, 0x56, 0x62, 0x85, 0xf3, 0x5d, 0xb0, 0x53, 0x11,
+	0xa4, 0xca, 0xbe, 0xd4, 0x7e, 0xf5, 0xfc, 0xbf, 0xd7, 0xa6, 0xed, 0x7d, 0x81, 0x0b, 0x82, 0x2e,
+	0xfc, 0x86, 0xd1, 0x04, 0x6e, 0xe4, 0x76, 0x9b, 0xbd, 0x76, 0xbc, 0xbf, 0x7d, 0x7c, 0xa7, 0x93,
+	0x83, 0xda, 0xf2, 0xfd, 0xd8, 0x19, 0x5a, 0x0e, 0x9d, 0xfb, 0x75, 0xc9, 0x30, 0x87, 0xe0, 0x57,
+	0xe4, 0x75, 0x9b, 0xbd, 0xa8, 0x52, 0xc0, 0x30, 0xb7, 0xb8, 0x81, 0xd0, 0xc4, 0xff, 0x27, 0x15,
+	0x01, 0xcc, 0xc8, 0x08, 0x33, 0x26, 0x52, 0x5c, 0x50, 0xc1, 0x21, 0xf0, 0xb4, 0xeb, 0xb4, 0xd2,
+	0x65, 0xb0, 0xcb, 0x92, 0xb2, 0x62, 0x24, 0x7f, 0x2e, 0x00, 0xdd, 0xf8, 0x7f, 0xe4, 0x4c, 0xa5,
+	0x53, 0x0c, 0x04, 0x82, 0x9a, 0x76, 0x77, 0x2a, 0xdd, 0x36, 0x6c, 0x95, 0x5f, 0x30, 0x1a, 0xf8,
+	0xbf, 0x53, 0xcc, 0x27, 0x8c, 0x40, 0x50, 0x8f, 0xbc, 0x43, 0x3f, 0xec, 0x4a, 0x47, 0xad, 0x65,
+	0x07, 0x6e, 0xdb, 0x28, 0xf2, 0x48, 0x94, 0xc2, 0x0c, 0x82, 0xc6, 0xe1, 0x36, 0x43, 0x1b, 0xde,
+	0xb5, 0x29, 0xe1, 0xc1, 0xed, 0x72, 0x1d, 0xba, 0xab, 0x75, 0xe8, 0x7e, 0xac, 0x43, 0xf7, 0x65,
+	0x13, 0x3a, 0xab, 0x4d, 0xe8, 0xbc, 0x6d, 0x42, 0xe7, 0xe1, 0x2c, 0xa3, 0xc5, 0x74, 0x36, 0x8e,
+	0x53, 0x91, 0x27, 0x7b, 0x2e, 0xe3, 0xa9, 0x9f, 0xcc, 0xf5, 0x79, 0x14, 0x0b, 0x49, 0x60, 0xdc,
+	0xd0, 0x17, 0xd2, 0xff, 0x1c, 0x00, 0xe9, 0x7d, 0x3f, 0xb3, 0xe9, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +165,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Referrals) > 0 {
+		for iNdEx := len(m.Referrals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Referrals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Candles) > 0 {
+		for iNdEx := len(m.Candles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Candles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Purchases) > 0 {
+		for iNdEx := len(m.Purchases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Purchases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PresaleAllocations) > 0 {
+		for iNdEx := len(m.PresaleAllocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PresaleAllocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Plans) > 0 {
 		for iNdEx := len(m.Plans) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -179,6 +273,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PresaleAllocations) > 0 {
+		for _, e := range m.PresaleAllocations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Purchases) > 0 {
+		for _, e := range m.Purchases {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Candles) > 0 {
+		for _, e := range m.Candles {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Referrals) > 0 {
+		for _, e := range m.Referrals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -284,6 +402,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PresaleAllocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PresaleAllocations = append(m.PresaleAllocations, PresaleAllocation{})
+			if err := m.PresaleAllocations[len(m.PresaleAllocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purchases = append(m.Purchases, Purchase{})
+			if err := m.Purchases[len(m.Purchases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Candles = append(m.Candles, Candle{})
+			if err := m.Candles[len(m.Candles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrals = append(m.Referrals, Referral{})
+			if err := m.Referrals[len(m.Referrals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -31,23 +31,142 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// CandleResolution is the time span of a price candle.
+type CandleResolution int32
+
+const (
+	CANDLE_RESOLUTION_UNSPECIFIED CandleResolution = 0
+	CANDLE_RESOLUTION_MINUTE      CandleResolution = 1
+	CANDLE_RESOLUTION_HOUR        CandleResolution = 2
+	CANDLE_RESOLUTION_DAY         CandleResolution = 3
+)
+
+var CandleResolution_name = map[int32]string{
+	0: "CANDLE_RESOLUTION_UNSPECIFIED",
+	1: "CANDLE_RESOLUTION_MINUTE",
+	2: "CANDLE_RESOLUTION_HOUR",
+	3: "CANDLE_RESOLUTION_DAY",
+}
+
+var CandleResolution_value = map[string]int32{
+	"CANDLE_RESOLUTION_UNSPECIFIED": 0,
+	"CANDLE_RESOLUTION_MINUTE":      1,
+	"CANDLE_RESOLUTION_HOUR":        2,
+	"CANDLE_RESOLUTION_DAY":         3,
+}
+
+func (x CandleResolution) String() string {
+	return proto.EnumName(CandleResolution_name, int32(x))
+}
+
+func (CandleResolution) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{0}
+}
+
+// CurveType is the family of a bonding curve.
+type CurveType int32
+
+const (
+	// price = M * x^N + C
+	CURVE_TYPE_POWER CurveType = 0
+	// price = M * e^(K * x) + C
+	CURVE_TYPE_EXPONENTIAL CurveType = 1
+	// price = C + (max_price - C) / (1 + e^(-K * (x - midpoint)))
+	CURVE_TYPE_LOGISTIC CurveType = 2
+	// price interpolated linearly between breakpoints, flat after the last one
+	CURVE_TYPE_PIECEWISE_LINEAR CurveType = 3
+)
+
+var CurveType_name = map[int32]string{
+	0: "CURVE_TYPE_POWER",
+	1: "CURVE_TYPE_EXPONENTIAL",
+	2: "CURVE_TYPE_LOGISTIC",
+	3: "CURVE_TYPE_PIECEWISE_LINEAR",
+}
+
+var CurveType_value = map[string]int32{
+	"CURVE_TYPE_POWER":            0,
+	"CURVE_TYPE_EXPONENTIAL":      1,
+	"CURVE_TYPE_LOGISTIC":         2,
+	"CURVE_TYPE_PIECEWISE_LINEAR": 3,
+}
+
+func (x CurveType) String() string {
+	return proto.EnumName(CurveType_name, int32(x))
+}
+
+func (CurveType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{1}
+}
+
+// CurveBreakpoint is a point of a piecewise-linear curve.
+type CurveBreakpoint struct {
+	// supply in decimal representation
+	Supply cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=supply,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"supply"`
+	Price  cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+}
+
+func (m *CurveBreakpoint) Reset()         { *m = CurveBreakpoint{} }
+func (m *CurveBreakpoint) String() string { return proto.CompactTextString(m) }
+func (*CurveBreakpoint) ProtoMessage()    {}
+func (*CurveBreakpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{0}
+}
+func (m *CurveBreakpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CurveBreakpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CurveBreakpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CurveBreakpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurveBreakpoint.Merge(m, src)
+}
+func (m *CurveBreakpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *CurveBreakpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurveBreakpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CurveBreakpoint proto.InternalMessageInfo
+
 // Bonding curve represents a bonding curve in the IRO module.
 // BondingCurve represents a bonding curve with parameters M, N, and C.
 // The price of the token is calculated as follows:
 // price = M * x^N + C
+// Other curve families are selected by curve_type and use the additional
+// parameters below.
 type BondingCurve struct {
 	M                      cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=M,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"M"`
 	N                      cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=N,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"N"`
 	C                      cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=C,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"C"`
 	RollappDenomDecimals   uint64                      `protobuf:"varint,4,opt,name=rollapp_denom_decimals,json=rollappDenomDecimals,proto3" json:"rollapp_denom_decimals,omitempty"`
 	LiquidityDenomDecimals uint64                      `protobuf:"varint,5,opt,name=liquidity_denom_decimals,json=liquidityDenomDecimals,proto3" json:"liquidity_denom_decimals,omitempty"`
+	CurveType              CurveType                   `protobuf:"varint,6,opt,name=curve_type,json=curveType,proto3,enum=dymensionxyz.dymension.iro.CurveType" json:"curve_type,omitempty"`
+	// Growth rate of the exponential and logistic curves.
+	K *cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=K,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"K,omitempty"`
+	// The price the logistic curve approaches.
+	MaxPrice *cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=max_price,json=maxPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_price,omitempty"`
+	// The supply at which the logistic curve is halfway to max_price.
+	Midpoint *cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=midpoint,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"midpoint,omitempty"`
+	// Breakpoints of the piecewise-linear curve, sorted by supply.
+	Breakpoints []CurveBreakpoint `protobuf:"bytes,10,rep,name=breakpoints,proto3" json:"breakpoints"`
 }
 
 func (m *BondingCurve) Reset()         { *m = BondingCurve{} }
 func (m *BondingCurve) String() string { return proto.CompactTextString(m) }
 func (*BondingCurve) ProtoMessage()    {}
 func (*BondingCurve) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7d27cc6b5064d3f, []int{0}
+	return fileDescriptor_e7d27cc6b5064d3f, []int{1}
 }
 func (m *BondingCurve) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -90,6 +209,20 @@ func (m *BondingCurve) GetLiquidityDenomDecimals() uint64 {
 	return 0
 }
 
+func (m *BondingCurve) GetCurveType() CurveType {
+	if m != nil {
+		return m.CurveType
+	}
+	return CURVE_TYPE_POWER
+}
+
+func (m *BondingCurve) GetBreakpoints() []CurveBreakpoint {
+	if m != nil {
+		return m.Breakpoints
+	}
+	return nil
+}
+
 // Plan represents a plan in the IRO module.
 type Plan struct {
 	// The ID of the plan.
@@ -128,13 +261,27 @@ type Plan struct {
 	IroPlanDuration time.Duration `protobuf:"bytes,16,opt,name=iro_plan_duration,json=iroPlanDuration,proto3,stdduration" json:"iro_plan_duration"`
 	// the denom used for raising liquidity
 	LiquidityDenom string `protobuf:"bytes,17,opt,name=liquidity_denom,json=liquidityDenom,proto3" json:"liquidity_denom,omitempty"`
+	// Optional presale phase restricting buys to allowed addresses right after
+	// trading starts.
+	Presale Presale `protobuf:"bytes,18,opt,name=presale,proto3" json:"presale"`
+	// Purchase limits protecting the plan from sniping.
+	TradingLimits TradingLimits `protobuf:"bytes,19,opt,name=trading_limits,json=tradingLimits,proto3" json:"trading_limits"`
+	// The time by which the plan must be settled. Past it, an unsettled plan is
+	// failed: trading stops and buyers can refund their IRO tokens for the
+	// raised liquidity. Zero means no deadline.
+	SettlementDeadline time.Time `protobuf:"bytes,20,opt,name=settlement_deadline,json=settlementDeadline,proto3,stdtime" json:"settlement_deadline"`
+	// The parameters of the liquidity pool bootstrapped on settlement.
+	PoolParams SettlementPoolParams `protobuf:"bytes,21,opt,name=pool_params,json=poolParams,proto3" json:"pool_params"`
+	// Rollapp tokens reserved for the team out of the total allocation. They
+	// are not sold, and vest to their beneficiaries after settlement.
+	TeamAllocations []TeamAllocation `protobuf:"bytes,22,rep,name=team_allocations,json=teamAllocations,proto3" json:"team_allocations"`
 }
 
 func (m *Plan) Reset()         { *m = Plan{} }
 func (m *Plan) String() string { return proto.CompactTextString(m) }
 func (*Plan) ProtoMessage()    {}
 func (*Plan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7d27cc6b5064d3f, []int{1}
+	return fileDescriptor_e7d27cc6b5064d3f, []int{2}
 }
 func (m *Plan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -254,27 +401,66 @@ func (m *Plan) GetLiquidityDenom() string {
 	return ""
 }
 
-type IncentivePlanParams struct {
-	// start_time_after_settlement is the time after IRO settlement when the
-	// distribution of the remaining tokens as incentives will start
-	StartTimeAfterSettlement time.Duration `protobuf:"bytes,1,opt,name=start_time_after_settlement,json=startTimeAfterSettlement,proto3,stdduration" json:"start_time_after_settlement"`
-	// num_epochs_paid_over is the number of total epochs distribution will be
-	// completed over
-	NumEpochsPaidOver uint64 `protobuf:"varint,2,opt,name=num_epochs_paid_over,json=numEpochsPaidOver,proto3" json:"num_epochs_paid_over,omitempty"`
+func (m *Plan) GetPresale() Presale {
+	if m != nil {
+		return m.Presale
+	}
+	return Presale{}
 }
 
-func (m *IncentivePlanParams) Reset()         { *m = IncentivePlanParams{} }
-func (m *IncentivePlanParams) String() string { return proto.CompactTextString(m) }
-func (*IncentivePlanParams) ProtoMessage()    {}
-func (*IncentivePlanParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7d27cc6b5064d3f, []int{2}
+func (m *Plan) GetTradingLimits() TradingLimits {
+	if m != nil {
+		return m.TradingLimits
+	}
+	return TradingLimits{}
 }
-func (m *IncentivePlanParams) XXX_Unmarshal(b []byte) error {
+
+func (m *Plan) GetSettlementDeadline() time.Time {
+	if m != nil {
+		return m.SettlementDeadline
+	}
+	return time.Time{}
+}
+
+func (m *Plan) GetPoolParams() SettlementPoolParams {
+	if m != nil {
+		return m.PoolParams
+	}
+	return SettlementPoolParams{}
+}
+
+func (m *Plan) GetTeamAllocations() []TeamAllocation {
+	if m != nil {
+		return m.TeamAllocations
+	}
+	return nil
+}
+
+// TeamAllocation is an amount of rollapp tokens locked for a beneficiary on
+// settlement. Nothing vests before the cliff, then the amount vests linearly
+// until the end of the vesting duration, both counted from the settlement.
+type TeamAllocation struct {
+	Beneficiary     string                `protobuf:"bytes,1,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	Amount          cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Cliff           time.Duration         `protobuf:"bytes,3,opt,name=cliff,proto3,stdduration" json:"cliff"`
+	VestingDuration time.Duration         `protobuf:"bytes,4,opt,name=vesting_duration,json=vestingDuration,proto3,stdduration" json:"vesting_duration"`
+	Claimed         cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=claimed,proto3,customtype=cosmossdk.io/math.Int" json:"claimed"`
+	// Vesting start time (set on IRO settlement)
+	StartTime time.Time `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+}
+
+func (m *TeamAllocation) Reset()         { *m = TeamAllocation{} }
+func (m *TeamAllocation) String() string { return proto.CompactTextString(m) }
+func (*TeamAllocation) ProtoMessage()    {}
+func (*TeamAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{3}
+}
+func (m *TeamAllocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *IncentivePlanParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TeamAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_IncentivePlanParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TeamAllocation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -284,55 +470,69 @@ func (m *IncentivePlanParams) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *IncentivePlanParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IncentivePlanParams.Merge(m, src)
+func (m *TeamAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TeamAllocation.Merge(m, src)
 }
-func (m *IncentivePlanParams) XXX_Size() int {
+func (m *TeamAllocation) XXX_Size() int {
 	return m.Size()
 }
-func (m *IncentivePlanParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_IncentivePlanParams.DiscardUnknown(m)
+func (m *TeamAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_TeamAllocation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_IncentivePlanParams proto.InternalMessageInfo
+var xxx_messageInfo_TeamAllocation proto.InternalMessageInfo
 
-func (m *IncentivePlanParams) GetStartTimeAfterSettlement() time.Duration {
+func (m *TeamAllocation) GetBeneficiary() string {
 	if m != nil {
-		return m.StartTimeAfterSettlement
+		return m.Beneficiary
+	}
+	return ""
+}
+
+func (m *TeamAllocation) GetCliff() time.Duration {
+	if m != nil {
+		return m.Cliff
 	}
 	return 0
 }
 
-func (m *IncentivePlanParams) GetNumEpochsPaidOver() uint64 {
+func (m *TeamAllocation) GetVestingDuration() time.Duration {
 	if m != nil {
-		return m.NumEpochsPaidOver
+		return m.VestingDuration
 	}
 	return 0
 }
 
-type IROVestingPlan struct {
-	Amount                   cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
-	Claimed                  cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=claimed,proto3,customtype=cosmossdk.io/math.Int" json:"claimed"`
-	VestingDuration          time.Duration         `protobuf:"bytes,3,opt,name=vesting_duration,json=vestingDuration,proto3,stdduration" json:"vesting_duration" yaml:"vesting_duration"`
-	StartTimeAfterSettlement time.Duration         `protobuf:"bytes,4,opt,name=start_time_after_settlement,json=startTimeAfterSettlement,proto3,stdduration" json:"start_time_after_settlement"`
-	// Vesting start time (set on IRO settlement)
-	StartTime time.Time `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
-	// Vesting end time (set on IRO settlement)
-	EndTime time.Time `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+func (m *TeamAllocation) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
 }
 
-func (m *IROVestingPlan) Reset()         { *m = IROVestingPlan{} }
-func (m *IROVestingPlan) String() string { return proto.CompactTextString(m) }
-func (*IROVestingPlan) ProtoMessage()    {}
-func (*IROVestingPlan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7d27cc6b5064d3f, []int{3}
+// SettlementPoolParams configures the balancer pool bootstrapped with the
+// raised liquidity on settlement. Unset fields keep the defaults: equal weights
+// and the global gamm swap fee.
+type SettlementPoolParams struct {
+	// The normalized weight of the rollapp token in the pool, e.g. 0.8 for a
+	// 80/20 pool. The liquidity token gets the rest.
+	RollappTokenWeight *cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=rollapp_token_weight,json=rollappTokenWeight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rollapp_token_weight,omitempty"`
+	// The swap fee of the pool.
+	SwapFee *cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=swap_fee,json=swapFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"swap_fee,omitempty"`
 }
-func (m *IROVestingPlan) XXX_Unmarshal(b []byte) error {
+
+func (m *SettlementPoolParams) Reset()         { *m = SettlementPoolParams{} }
+func (m *SettlementPoolParams) String() string { return proto.CompactTextString(m) }
+func (*SettlementPoolParams) ProtoMessage()    {}
+func (*SettlementPoolParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{4}
+}
+func (m *SettlementPoolParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *IROVestingPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SettlementPoolParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_IROVestingPlan.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SettlementPoolParams.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -342,426 +542,792 @@ func (m *IROVestingPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *IROVestingPlan) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IROVestingPlan.Merge(m, src)
+func (m *SettlementPoolParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettlementPoolParams.Merge(m, src)
 }
-func (m *IROVestingPlan) XXX_Size() int {
+func (m *SettlementPoolParams) XXX_Size() int {
 	return m.Size()
 }
-func (m *IROVestingPlan) XXX_DiscardUnknown() {
-	xxx_messageInfo_IROVestingPlan.DiscardUnknown(m)
+func (m *SettlementPoolParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettlementPoolParams.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_IROVestingPlan proto.InternalMessageInfo
+var xxx_messageInfo_SettlementPoolParams proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*BondingCurve)(nil), "dymensionxyz.dymension.iro.BondingCurve")
-	proto.RegisterType((*Plan)(nil), "dymensionxyz.dymension.iro.Plan")
-	proto.RegisterType((*IncentivePlanParams)(nil), "dymensionxyz.dymension.iro.IncentivePlanParams")
-	proto.RegisterType((*IROVestingPlan)(nil), "dymensionxyz.dymension.iro.IROVestingPlan")
+// TradingLimits bounds how fast the plan can be bought out. Zero values mean
+// no limit. The rollapp owner is not limited.
+type TradingLimits struct {
+	// The maximum amount of tokens a single address can buy over the plan.
+	MaxPerAddress cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=max_per_address,json=maxPerAddress,proto3,customtype=cosmossdk.io/math.Int" json:"max_per_address"`
+	// The maximum amount of tokens that can be bought in a single block.
+	MaxPerBlock cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=max_per_block,json=maxPerBlock,proto3,customtype=cosmossdk.io/math.Int" json:"max_per_block"`
+	// If set, the per-address limit ramps up linearly over the first
+	// ramp_hours hours after the plan start: during hour h the cap is
+	// max_per_address * (h+1) / ramp_hours.
+	RampHours uint64 `protobuf:"varint,3,opt,name=ramp_hours,json=rampHours,proto3" json:"ramp_hours,omitempty"`
+	// The height of the block of the last buy.
+	BlockHeight int64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The amount of tokens bought in block_height.
+	BlockBought cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=block_bought,json=blockBought,proto3,customtype=cosmossdk.io/math.Int" json:"block_bought"`
 }
 
-func init() {
-	proto.RegisterFile("dymensionxyz/dymension/iro/iro.proto", fileDescriptor_e7d27cc6b5064d3f)
+func (m *TradingLimits) Reset()         { *m = TradingLimits{} }
+func (m *TradingLimits) String() string { return proto.CompactTextString(m) }
+func (*TradingLimits) ProtoMessage()    {}
+func (*TradingLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{5}
+}
+func (m *TradingLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TradingLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TradingLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TradingLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradingLimits.Merge(m, src)
+}
+func (m *TradingLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *TradingLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradingLimits.DiscardUnknown(m)
 }
 
-var fileDescriptor_e7d27cc6b5064d3f = []byte{
-	// 1009 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x3b, 0x6f, 0x1b, 0x47,
-	0x17, 0xd5, 0x4a, 0xb4, 0x1e, 0x23, 0x89, 0x94, 0xc6, 0xb2, 0xbf, 0xb5, 0x8c, 0x8f, 0x14, 0xe8,
-	0x00, 0x16, 0x12, 0x78, 0x37, 0xb2, 0x53, 0x04, 0x6e, 0x0c, 0x8a, 0x92, 0x01, 0x19, 0xb2, 0x25,
-	0xac, 0x8c, 0xc0, 0x48, 0xb3, 0x18, 0xee, 0x8c, 0xa9, 0x41, 0xe6, 0xb1, 0x99, 0x9d, 0x25, 0xc4,
-	0xb4, 0x69, 0x52, 0xba, 0x4c, 0x99, 0x3a, 0xb5, 0xff, 0x40, 0x3a, 0x97, 0x86, 0xab, 0x20, 0x85,
-	0x12, 0x48, 0xff, 0x20, 0x4d, 0xda, 0x60, 0x1e, 0xa4, 0x24, 0x3a, 0x96, 0x43, 0x21, 0x05, 0x01,
-	0xce, 0xbd, 0x73, 0xce, 0xdd, 0xb9, 0xf7, 0x9c, 0xd9, 0x05, 0x9f, 0xe0, 0x3e, 0x27, 0xa2, 0xa0,
-	0x52, 0x1c, 0xf5, 0xbf, 0x8b, 0x87, 0x8b, 0x98, 0x2a, 0x69, 0x7e, 0x51, 0xae, 0xa4, 0x96, 0x70,
-	0xf5, 0xfc, 0xae, 0x68, 0xb8, 0x88, 0xa8, 0x92, 0xab, 0x2b, 0x5d, 0xd9, 0x95, 0x76, 0x5b, 0x6c,
-	0xfe, 0x39, 0xc4, 0x6a, 0xa3, 0x2b, 0x65, 0x97, 0x91, 0xd8, 0xae, 0x3a, 0xe5, 0xcb, 0x58, 0x53,
-	0x4e, 0x0a, 0x8d, 0x78, 0xee, 0x37, 0xd4, 0x47, 0x37, 0xe0, 0x52, 0x21, 0x6d, 0x48, 0x7d, 0x3e,
-	0x93, 0x05, 0x97, 0x45, 0xdc, 0x41, 0x05, 0x89, 0x7b, 0x1b, 0x1d, 0xa2, 0xd1, 0x46, 0x9c, 0x49,
-	0x3a, 0xc8, 0xdf, 0x72, 0xf9, 0xd4, 0x55, 0x76, 0x0b, 0x9f, 0xba, 0x7b, 0xc9, 0x99, 0x72, 0xa4,
-	0x10, 0xf7, 0x1b, 0x9b, 0xbf, 0x4c, 0x82, 0x85, 0x4d, 0x29, 0x30, 0x15, 0xdd, 0x76, 0xa9, 0x7a,
-	0x04, 0x3e, 0x02, 0xc1, 0xd3, 0x30, 0x58, 0x0b, 0xd6, 0xe7, 0x36, 0x37, 0xde, 0x1c, 0x37, 0x26,
-	0x7e, 0x3b, 0x6e, 0xdc, 0x76, 0xd4, 0x05, 0xfe, 0x26, 0xa2, 0x32, 0xe6, 0x48, 0x1f, 0x46, 0xbb,
-	0xa4, 0x8b, 0xb2, 0xfe, 0x16, 0xc9, 0xde, 0xbd, 0xbe, 0x07, 0x7c, 0xe5, 0x2d, 0x92, 0x25, 0xc1,
-	0x53, 0x43, 0xf0, 0x2c, 0x9c, 0xbc, 0x32, 0xc1, 0x33, 0x43, 0xd0, 0x0e, 0xa7, 0xae, 0x4c, 0xd0,
-	0x86, 0x5f, 0x80, 0x9b, 0x4a, 0x32, 0x86, 0xf2, 0x3c, 0xc5, 0x44, 0x48, 0x9e, 0x62, 0x92, 0x51,
-	0x8e, 0x58, 0x11, 0x56, 0xd6, 0x82, 0xf5, 0x4a, 0xb2, 0xe2, 0xb3, 0x5b, 0x26, 0xb9, 0xe5, 0x73,
-	0xf0, 0x4b, 0x10, 0x32, 0xfa, 0x6d, 0x49, 0x31, 0xd5, 0xfd, 0x51, 0xdc, 0x35, 0x8b, 0xbb, 0x39,
-	0xcc, 0x5f, 0x40, 0x36, 0xbf, 0x9f, 0x03, 0x95, 0x7d, 0x86, 0x04, 0xac, 0x82, 0x49, 0x8a, 0x6d,
-	0xf3, 0x2a, 0xc9, 0x24, 0xc5, 0xf0, 0xff, 0x00, 0x0c, 0x1e, 0x84, 0x62, 0xd7, 0x93, 0x64, 0xce,
-	0x47, 0x76, 0x30, 0x7c, 0x0c, 0x20, 0x97, 0xb8, 0x64, 0x24, 0x45, 0x59, 0x96, 0x22, 0x8c, 0x15,
-	0x29, 0x0a, 0x7f, 0xf2, 0xf0, 0xdd, 0xeb, 0x7b, 0x2b, 0xfe, 0x58, 0x2d, 0x97, 0x39, 0xd0, 0x8a,
-	0x8a, 0x6e, 0xb2, 0xe4, 0x30, 0xad, 0x2c, 0xf3, 0x71, 0xf8, 0x04, 0x2c, 0x69, 0xa9, 0x11, 0x4b,
-	0x11, 0x63, 0x32, 0xb3, 0x0a, 0xb2, 0x27, 0x9d, 0xbf, 0x7f, 0x2b, 0xf2, 0x14, 0x46, 0x42, 0x91,
-	0x97, 0x50, 0xd4, 0x96, 0x54, 0x6c, 0x56, 0x4c, 0x6b, 0x93, 0x9a, 0x05, 0xb6, 0x86, 0x38, 0x78,
-	0x00, 0x16, 0x3b, 0x4e, 0x0e, 0x69, 0x66, 0xf4, 0x60, 0x8f, 0x3e, 0x7f, 0x7f, 0x3d, 0xfa, 0xb0,
-	0xfc, 0xa3, 0xf3, 0xfa, 0xf1, 0xbc, 0x0b, 0x9d, 0xf3, 0x9a, 0xba, 0x03, 0x16, 0x0b, 0xa2, 0x35,
-	0x23, 0xd8, 0x35, 0x36, 0x9c, 0xb6, 0xad, 0x58, 0xf0, 0x41, 0xdb, 0x4d, 0xd8, 0x06, 0xa0, 0xd0,
-	0x48, 0xe9, 0xd4, 0xd8, 0x24, 0x9c, 0xb1, 0x65, 0x57, 0x23, 0x67, 0x91, 0x68, 0x60, 0x91, 0xe8,
-	0xf9, 0xc0, 0x43, 0x9b, 0xb3, 0xa6, 0xd0, 0xab, 0xdf, 0x1b, 0x41, 0x32, 0x67, 0x71, 0x26, 0x03,
-	0x77, 0x41, 0x2d, 0x57, 0x24, 0x65, 0xa8, 0x14, 0xd9, 0xa1, 0x63, 0x9a, 0x1d, 0x83, 0x69, 0x31,
-	0x57, 0x64, 0xd7, 0x62, 0x2d, 0xdb, 0x63, 0x30, 0x5b, 0x48, 0x86, 0x53, 0xc4, 0x75, 0x38, 0x67,
-	0xc7, 0xf2, 0x99, 0x17, 0xe4, 0x8d, 0xf7, 0x05, 0xb9, 0x23, 0xf4, 0x39, 0x29, 0xee, 0x08, 0x9d,
-	0xcc, 0x18, 0x70, 0x8b, 0x6b, 0xb8, 0x0b, 0xe6, 0x33, 0x86, 0x28, 0x27, 0x8e, 0x0a, 0x8c, 0x4f,
-	0x05, 0x3c, 0xde, 0xb0, 0x51, 0x70, 0x83, 0x8a, 0x8c, 0x08, 0x4d, 0x7b, 0x24, 0xcd, 0x19, 0x12,
-	0xa9, 0x73, 0x74, 0x38, 0x6f, 0x4f, 0x1a, 0x5f, 0x36, 0xaa, 0x9d, 0x01, 0xd0, 0xe8, 0x75, 0xdf,
-	0xc2, 0xfc, 0xc4, 0xae, 0xd3, 0xf7, 0x53, 0xf0, 0x05, 0x80, 0x1c, 0x1d, 0xa5, 0x88, 0xcb, 0x52,
-	0xe8, 0x54, 0xcb, 0xb4, 0x20, 0x8c, 0x85, 0x0b, 0xe3, 0x3f, 0x7f, 0x8d, 0xa3, 0xa3, 0x96, 0x65,
-	0x79, 0x2e, 0x0f, 0x08, 0x63, 0xf0, 0x05, 0xa8, 0x9e, 0xb9, 0x2d, 0x47, 0x4a, 0x87, 0x8b, 0x57,
-	0x75, 0xfc, 0xe2, 0x90, 0x68, 0x1f, 0x29, 0x0d, 0x0f, 0xc0, 0x42, 0x8f, 0x14, 0xda, 0x28, 0xd8,
-	0x34, 0x27, 0xac, 0xda, 0xae, 0x7c, 0x7a, 0x69, 0x57, 0x92, 0xbd, 0xaf, 0x1c, 0xc4, 0x9c, 0xdd,
-	0x37, 0x64, 0xbe, 0x77, 0x16, 0x82, 0x77, 0x41, 0x4d, 0x2b, 0x64, 0x6d, 0x41, 0x04, 0xea, 0x30,
-	0x82, 0xc3, 0xda, 0x5a, 0xb0, 0x3e, 0x9b, 0x54, 0x7d, 0x78, 0xdb, 0x45, 0xe1, 0x1e, 0x58, 0xa6,
-	0x4a, 0xba, 0xb1, 0x0c, 0xae, 0xf3, 0x70, 0xc9, 0x9b, 0x71, 0x54, 0x82, 0x5b, 0x7e, 0x83, 0x53,
-	0xe0, 0x8f, 0x46, 0x81, 0x35, 0xaa, 0xa4, 0xa9, 0x38, 0x48, 0x99, 0xca, 0x23, 0xd7, 0x52, 0xb8,
-	0x6c, 0xdd, 0x53, 0xbd, 0x78, 0x1b, 0x35, 0x7f, 0x0e, 0xc0, 0xf5, 0x7f, 0x18, 0x2f, 0xec, 0x80,
-	0xdb, 0x67, 0xbe, 0x4a, 0xd1, 0x4b, 0x4d, 0x54, 0xea, 0x8c, 0xc7, 0x89, 0xd0, 0xf6, 0xb6, 0xfa,
-	0x97, 0xcf, 0x16, 0x0e, 0x7d, 0xd6, 0x32, 0x2c, 0x07, 0x43, 0x12, 0x18, 0x83, 0x15, 0x51, 0xf2,
-	0x94, 0xe4, 0x32, 0x3b, 0x2c, 0xd2, 0x1c, 0x51, 0x9c, 0xca, 0x1e, 0x51, 0xf6, 0xca, 0xab, 0x24,
-	0xcb, 0xa2, 0xe4, 0xdb, 0x36, 0xb5, 0x8f, 0x28, 0xde, 0xeb, 0x11, 0xd5, 0xfc, 0x6b, 0x0a, 0x54,
-	0x2f, 0x76, 0x1d, 0xb6, 0xc1, 0xb4, 0xd3, 0x99, 0x7f, 0xfb, 0x8c, 0xa5, 0x2f, 0x0f, 0x85, 0xdb,
-	0x60, 0xc6, 0x3b, 0xc5, 0xbf, 0x82, 0xc6, 0x33, 0xac, 0xc7, 0x42, 0x0a, 0x96, 0x06, 0x1a, 0x1a,
-	0x0e, 0x71, 0xea, 0x63, 0x8d, 0xba, 0x63, 0x4a, 0xfd, 0x79, 0xdc, 0xf8, 0x5f, 0x1f, 0x71, 0xf6,
-	0xb0, 0x39, 0x4a, 0xd0, 0x74, 0xf3, 0xf5, 0xe1, 0xe1, 0x7c, 0x3f, 0x32, 0x9e, 0xca, 0x7f, 0x31,
-	0x9e, 0x8b, 0x57, 0xeb, 0xb5, 0xab, 0x5d, 0xad, 0x8f, 0xc0, 0x2c, 0x11, 0xd8, 0x51, 0x4c, 0x8f,
-	0x41, 0x31, 0x43, 0x04, 0x36, 0xf1, 0x87, 0x95, 0x1f, 0x7e, 0x6a, 0x4c, 0x6c, 0x3e, 0x79, 0x73,
-	0x52, 0x0f, 0xde, 0x9e, 0xd4, 0x83, 0x3f, 0x4e, 0xea, 0xc1, 0xab, 0xd3, 0xfa, 0xc4, 0xdb, 0xd3,
-	0xfa, 0xc4, 0xaf, 0xa7, 0xf5, 0x89, 0xaf, 0x3f, 0xef, 0x52, 0x7d, 0x58, 0x76, 0xa2, 0x4c, 0xf2,
-	0xf8, 0x03, 0x9f, 0x2f, 0xbd, 0x07, 0xf1, 0x91, 0xfd, 0x86, 0xd1, 0xfd, 0x9c, 0x14, 0x9d, 0x69,
-	0x5b, 0xf8, 0xc1, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x36, 0x30, 0x64, 0x27, 0xc2, 0x09, 0x00,
-	0x00,
+var xxx_messageInfo_TradingLimits proto.InternalMessageInfo
+
+func (m *TradingLimits) GetRampHours() uint64 {
+	if m != nil {
+		return m.RampHours
+	}
+	return 0
 }
 
-func (m *BondingCurve) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TradingLimits) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *BondingCurve) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Purchase tracks the amount of tokens an address bought from a plan.
+type Purchase struct {
+	PlanId  string                `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Address string                `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
 
-func (m *BondingCurve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LiquidityDenomDecimals != 0 {
-		i = encodeVarintIro(dAtA, i, uint64(m.LiquidityDenomDecimals))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.RollappDenomDecimals != 0 {
-		i = encodeVarintIro(dAtA, i, uint64(m.RollappDenomDecimals))
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size := m.C.Size()
-		i -= size
-		if _, err := m.C.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintIro(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.N.Size()
-		i -= size
-		if _, err := m.N.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *Purchase) Reset()         { *m = Purchase{} }
+func (m *Purchase) String() string { return proto.CompactTextString(m) }
+func (*Purchase) ProtoMessage()    {}
+func (*Purchase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{6}
+}
+func (m *Purchase) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Purchase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Purchase.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintIro(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.M.Size()
-		i -= size
-		if _, err := m.M.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintIro(dAtA, i, uint64(size))
+}
+func (m *Purchase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Purchase.Merge(m, src)
+}
+func (m *Purchase) XXX_Size() int {
+	return m.Size()
+}
+func (m *Purchase) XXX_DiscardUnknown() {
+	xxx_messageInfo_Purchase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Purchase proto.InternalMessageInfo
+
+func (m *Purchase) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Plan) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Purchase) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Plan) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Referral tracks the trades an address referred to a plan.
+type Referral struct {
+	PlanId   string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Referrer string `protobuf:"bytes,2,opt,name=referrer,proto3" json:"referrer,omitempty"`
+	// The liquidity denom volume of the referred trades.
+	Volume cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=volume,proto3,customtype=cosmossdk.io/math.Int" json:"volume"`
+	// The referral fees earned, in liquidity denom.
+	Earnings cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=earnings,proto3,customtype=cosmossdk.io/math.Int" json:"earnings"`
 }
 
-func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LiquidityDenom) > 0 {
-		i -= len(m.LiquidityDenom)
-		copy(dAtA[i:], m.LiquidityDenom)
-		i = encodeVarintIro(dAtA, i, uint64(len(m.LiquidityDenom)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
-	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.IroPlanDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IroPlanDuration):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintIro(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x82
-	if m.TradingEnabled {
-		i--
-		if m.TradingEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x78
-	}
-	{
-		size, err := m.VestingPlan.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintIro(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x72
-	{
-		size := m.LiquidityPart.Size()
-		i -= size
-		if _, err := m.LiquidityPart.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintIro(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x6a
-	{
-		size := m.MaxAmountToSell.Size()
-		i -= size
-		if _, err := m.MaxAmountToSell.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintIro(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x62
-	{
-		size, err := m.IncentivePlanParams.MarshalToSizedBuffer(dAtA[:i])
+func (m *Referral) Reset()         { *m = Referral{} }
+func (m *Referral) String() string { return proto.CompactTextString(m) }
+func (*Referral) ProtoMessage()    {}
+func (*Referral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{7}
+}
+func (m *Referral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Referral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Referral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintIro(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size := m.ClaimedAmt.Size()
-		i -= size
-		if _, err := m.ClaimedAmt.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintIro(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size := m.SoldAmt.Size()
-		i -= size
-		if _, err := m.SoldAmt.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			return nil, err
 		}
-		i = encodeVarintIro(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PreLaunchTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreLaunchTime):])
-	if err4 != nil {
-		return 0, err4
-	}
-	i -= n4
-	i = encodeVarintIro(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x42
-	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err5 != nil {
-		return 0, err5
+		return b[:n], nil
 	}
-	i -= n5
-	i = encodeVarintIro(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x3a
-	if len(m.SettledDenom) > 0 {
-		i -= len(m.SettledDenom)
-		copy(dAtA[i:], m.SettledDenom)
-		i = encodeVarintIro(dAtA, i, uint64(len(m.SettledDenom)))
-		i--
-		dAtA[i] = 0x32
+}
+func (m *Referral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Referral.Merge(m, src)
+}
+func (m *Referral) XXX_Size() int {
+	return m.Size()
+}
+func (m *Referral) XXX_DiscardUnknown() {
+	xxx_messageInfo_Referral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Referral proto.InternalMessageInfo
+
+func (m *Referral) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
 	}
-	{
-		size, err := m.BondingCurve.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintIro(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *Referral) GetReferrer() string {
+	if m != nil {
+		return m.Referrer
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size, err := m.TotalAllocation.MarshalToSizedBuffer(dAtA[:i])
+	return ""
+}
+
+// Presale restricts buying to allowed addresses for a period after trading
+// starts. The presale is disabled when the duration is zero.
+type Presale struct {
+	// Addresses allowed to buy up to max_per_address during the presale. Only set
+	// on plan creation: the plan keeps them as presale allocations.
+	Allowlist []string `protobuf:"bytes,1,rep,name=allowlist,proto3" json:"allowlist,omitempty"`
+	// Merkle root of sha256(0x00 || "<address>:<cap>") leaves, where inner nodes
+	// are sha256(0x01 || min(a, b) || max(a, b)). Addresses proving membership
+	// can buy up to their own cap during the presale.
+	MerkleRoot []byte `protobuf:"bytes,2,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// The maximum amount of tokens each allowlisted address can buy.
+	MaxPerAddress cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_per_address,json=maxPerAddress,proto3,customtype=cosmossdk.io/math.Int" json:"max_per_address"`
+	// The sold amount the presale can reach. It bounds the curve segment, and
+	// hence the price range, available during the presale. Zero means no bound.
+	MaxSoldAmt cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_sold_amt,json=maxSoldAmt,proto3,customtype=cosmossdk.io/math.Int" json:"max_sold_amt"`
+	// The duration of the presale, counted from the plan start time.
+	Duration time.Duration `protobuf:"bytes,5,opt,name=duration,proto3,stdduration" json:"duration"`
+	// The presale end time (set when trading is enabled).
+	EndTime time.Time `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *Presale) Reset()         { *m = Presale{} }
+func (m *Presale) String() string { return proto.CompactTextString(m) }
+func (*Presale) ProtoMessage()    {}
+func (*Presale) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{8}
+}
+func (m *Presale) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Presale) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Presale.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintIro(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.ModuleAccAddress) > 0 {
-		i -= len(m.ModuleAccAddress)
-		copy(dAtA[i:], m.ModuleAccAddress)
-		i = encodeVarintIro(dAtA, i, uint64(len(m.ModuleAccAddress)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *Presale) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Presale.Merge(m, src)
+}
+func (m *Presale) XXX_Size() int {
+	return m.Size()
+}
+func (m *Presale) XXX_DiscardUnknown() {
+	xxx_messageInfo_Presale.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Presale proto.InternalMessageInfo
+
+func (m *Presale) GetAllowlist() []string {
+	if m != nil {
+		return m.Allowlist
 	}
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintIro(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *Presale) GetMerkleRoot() []byte {
+	if m != nil {
+		return m.MerkleRoot
 	}
-	if m.Id != 0 {
-		i = encodeVarintIro(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *Presale) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *IncentivePlanParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Presale) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *IncentivePlanParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PresaleAllocation tracks how much an address may buy and has bought during
+// the presale of a plan.
+type PresaleAllocation struct {
+	PlanId  string                `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Address string                `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Cap     cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=cap,proto3,customtype=cosmossdk.io/math.Int" json:"cap"`
+	Bought  cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=bought,proto3,customtype=cosmossdk.io/math.Int" json:"bought"`
 }
 
-func (m *IncentivePlanParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NumEpochsPaidOver != 0 {
-		i = encodeVarintIro(dAtA, i, uint64(m.NumEpochsPaidOver))
-		i--
-		dAtA[i] = 0x10
+func (m *PresaleAllocation) Reset()         { *m = PresaleAllocation{} }
+func (m *PresaleAllocation) String() string { return proto.CompactTextString(m) }
+func (*PresaleAllocation) ProtoMessage()    {}
+func (*PresaleAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{9}
+}
+func (m *PresaleAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PresaleAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PresaleAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.StartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StartTimeAfterSettlement):])
-	if err8 != nil {
-		return 0, err8
+}
+func (m *PresaleAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PresaleAllocation.Merge(m, src)
+}
+func (m *PresaleAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PresaleAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PresaleAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PresaleAllocation proto.InternalMessageInfo
+
+func (m *PresaleAllocation) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
 	}
-	i -= n8
-	i = encodeVarintIro(dAtA, i, uint64(n8))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *IROVestingPlan) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PresaleAllocation) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *IROVestingPlan) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type IncentivePlanParams struct {
+	// start_time_after_settlement is the time after IRO settlement when the
+	// distribution of the remaining tokens as incentives will start
+	StartTimeAfterSettlement time.Duration `protobuf:"bytes,1,opt,name=start_time_after_settlement,json=startTimeAfterSettlement,proto3,stdduration" json:"start_time_after_settlement"`
+	// num_epochs_paid_over is the number of total epochs distribution will be
+	// completed over
+	NumEpochsPaidOver uint64 `protobuf:"varint,2,opt,name=num_epochs_paid_over,json=numEpochsPaidOver,proto3" json:"num_epochs_paid_over,omitempty"`
 }
 
-func (m *IROVestingPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *IncentivePlanParams) Reset()         { *m = IncentivePlanParams{} }
+func (m *IncentivePlanParams) String() string { return proto.CompactTextString(m) }
+func (*IncentivePlanParams) ProtoMessage()    {}
+func (*IncentivePlanParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{10}
+}
+func (m *IncentivePlanParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentivePlanParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentivePlanParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncentivePlanParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentivePlanParams.Merge(m, src)
+}
+func (m *IncentivePlanParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentivePlanParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentivePlanParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentivePlanParams proto.InternalMessageInfo
+
+func (m *IncentivePlanParams) GetStartTimeAfterSettlement() time.Duration {
+	if m != nil {
+		return m.StartTimeAfterSettlement
+	}
+	return 0
+}
+
+func (m *IncentivePlanParams) GetNumEpochsPaidOver() uint64 {
+	if m != nil {
+		return m.NumEpochsPaidOver
+	}
+	return 0
+}
+
+type IROVestingPlan struct {
+	Amount                   cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Claimed                  cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=claimed,proto3,customtype=cosmossdk.io/math.Int" json:"claimed"`
+	VestingDuration          time.Duration         `protobuf:"bytes,3,opt,name=vesting_duration,json=vestingDuration,proto3,stdduration" json:"vesting_duration" yaml:"vesting_duration"`
+	StartTimeAfterSettlement time.Duration         `protobuf:"bytes,4,opt,name=start_time_after_settlement,json=startTimeAfterSettlement,proto3,stdduration" json:"start_time_after_settlement"`
+	// Vesting start time (set on IRO settlement)
+	StartTime time.Time `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// Vesting end time (set on IRO settlement)
+	EndTime time.Time `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *IROVestingPlan) Reset()         { *m = IROVestingPlan{} }
+func (m *IROVestingPlan) String() string { return proto.CompactTextString(m) }
+func (*IROVestingPlan) ProtoMessage()    {}
+func (*IROVestingPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{11}
+}
+func (m *IROVestingPlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IROVestingPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IROVestingPlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IROVestingPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IROVestingPlan.Merge(m, src)
+}
+func (m *IROVestingPlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *IROVestingPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_IROVestingPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IROVestingPlan proto.InternalMessageInfo
+
+// Candle is the OHLCV summary of the trades of a plan within a time bucket.
+// Prices are the spot price of 1 IRO token, as in QuerySpotPrice.
+type Candle struct {
+	PlanId     string           `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Resolution CandleResolution `protobuf:"varint,2,opt,name=resolution,proto3,enum=dymensionxyz.dymension.iro.CandleResolution" json:"resolution,omitempty"`
+	// The start of the bucket.
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// The spot price before the first trade of the bucket.
+	Open cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=open,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"open"`
+	High cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=high,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"high"`
+	Low  cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=low,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"low"`
+	// The spot price after the last trade of the bucket.
+	Close cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=close,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"close"`
+	// The amount of IRO tokens bought and sold in the bucket.
+	Volume cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=volume,proto3,customtype=cosmossdk.io/math.Int" json:"volume"`
+}
+
+func (m *Candle) Reset()         { *m = Candle{} }
+func (m *Candle) String() string { return proto.CompactTextString(m) }
+func (*Candle) ProtoMessage()    {}
+func (*Candle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{12}
+}
+func (m *Candle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Candle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Candle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Candle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Candle.Merge(m, src)
+}
+func (m *Candle) XXX_Size() int {
+	return m.Size()
+}
+func (m *Candle) XXX_DiscardUnknown() {
+	xxx_messageInfo_Candle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Candle proto.InternalMessageInfo
+
+func (m *Candle) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
+	}
+	return ""
+}
+
+func (m *Candle) GetResolution() CandleResolution {
+	if m != nil {
+		return m.Resolution
+	}
+	return CANDLE_RESOLUTION_UNSPECIFIED
+}
+
+func (m *Candle) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("dymensionxyz.dymension.iro.CandleResolution", CandleResolution_name, CandleResolution_value)
+	proto.RegisterEnum("dymensionxyz.dymension.iro.CurveType", CurveType_name, CurveType_value)
+	proto.RegisterType((*CurveBreakpoint)(nil), "dymensionxyz.dymension.iro.CurveBreakpoint")
+	proto.RegisterType((*BondingCurve)(nil), "dymensionxyz.dymension.iro.BondingCurve")
+	proto.RegisterType((*Plan)(nil), "dymensionxyz.dymension.iro.Plan")
+	proto.RegisterType((*TeamAllocation)(nil), "dymensionxyz.dymension.iro.TeamAllocation")
+	proto.RegisterType((*SettlementPoolParams)(nil), "dymensionxyz.dymension.iro.SettlementPoolParams")
+	proto.RegisterType((*TradingLimits)(nil), "dymensionxyz.dymension.iro.TradingLimits")
+	proto.RegisterType((*Purchase)(nil), "dymensionxyz.dymension.iro.Purchase")
+	proto.RegisterType((*Referral)(nil), "dymensionxyz.dymension.iro.Referral")
+	proto.RegisterType((*Presale)(nil), "dymensionxyz.dymension.iro.Presale")
+	proto.RegisterType((*PresaleAllocation)(nil), "dymensionxyz.dymension.iro.PresaleAllocation")
+	proto.RegisterType((*IncentivePlanParams)(nil), "dymensionxyz.dymension.iro.IncentivePlanParams")
+	proto.RegisterType((*IROVestingPlan)(nil), "dymensionxyz.dymension.iro.IROVestingPlan")
+	proto.RegisterType((*Candle)(nil), "dymensionxyz.dymension.iro.Candle")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/iro/iro.proto", fileDescriptor_e7d27cc6b5064d3f)
+}
+
+var fileDescriptor_e7d27cc6b5064d3f = []byte{
+	// 1939 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x6f, 0xdb, 0xc8,
+	0xf9, 0x36, 0x25, 0xd9, 0x92, 0x5e, 0xf9, 0x43, 0x19, 0x3b, 0x09, 0xe3, 0xec, 0xda, 0x5e, 0xe5,
+	0xf7, 0xc3, 0xba, 0xd9, 0x46, 0xda, 0x78, 0xf7, 0xd0, 0xa6, 0x28, 0x16, 0xb2, 0xa4, 0x24, 0xca,
+	0x2a, 0x92, 0x40, 0xc9, 0x49, 0xb6, 0x3d, 0x10, 0x23, 0x72, 0x2c, 0x0d, 0x4c, 0x72, 0x58, 0x92,
+	0x72, 0xec, 0x02, 0xbd, 0xef, 0x31, 0x97, 0x02, 0x3d, 0xf4, 0x50, 0xa0, 0x28, 0x50, 0x14, 0x28,
+	0xd0, 0xc3, 0xfe, 0x0f, 0xdd, 0xde, 0x16, 0x7b, 0x2a, 0x7a, 0x48, 0x8b, 0xa4, 0x40, 0x6f, 0x3d,
+	0xf4, 0xd2, 0x6b, 0x31, 0xc3, 0x21, 0x2d, 0x7f, 0x44, 0x8e, 0x98, 0x3d, 0x18, 0x30, 0xe7, 0x7d,
+	0x9f, 0x67, 0xbe, 0x9e, 0xf7, 0x43, 0x03, 0xff, 0x67, 0x1e, 0xdb, 0xc4, 0xf1, 0x29, 0x73, 0x8e,
+	0x8e, 0x7f, 0x5e, 0x89, 0x3f, 0x2a, 0xd4, 0x63, 0xfc, 0xaf, 0xec, 0x7a, 0x2c, 0x60, 0x68, 0x7d,
+	0xd2, 0xab, 0x1c, 0x7f, 0x94, 0xa9, 0xc7, 0xd6, 0xd7, 0x86, 0x6c, 0xc8, 0x84, 0x5b, 0x85, 0xff,
+	0x17, 0x22, 0xd6, 0x37, 0x87, 0x8c, 0x0d, 0x2d, 0x52, 0x11, 0x5f, 0x83, 0xf1, 0x7e, 0x25, 0xa0,
+	0x36, 0xf1, 0x03, 0x6c, 0xbb, 0xd2, 0x61, 0xe3, 0xac, 0x83, 0x39, 0xf6, 0x70, 0xc0, 0x49, 0xa5,
+	0xdd, 0x60, 0xbe, 0xcd, 0xfc, 0xca, 0x00, 0xfb, 0xa4, 0x72, 0x78, 0x77, 0x40, 0x02, 0x7c, 0xb7,
+	0x62, 0x30, 0x1a, 0xd9, 0x6f, 0x84, 0x76, 0x3d, 0x9c, 0x39, 0xfc, 0x90, 0xa6, 0x0f, 0xa7, 0xec,
+	0xc9, 0xc5, 0x1e, 0xb6, 0xa5, 0x63, 0xe9, 0x77, 0x0a, 0xac, 0xd4, 0xc6, 0xde, 0x21, 0xd9, 0xf5,
+	0x08, 0x3e, 0x70, 0x19, 0x75, 0x02, 0xd4, 0x84, 0x05, 0x7f, 0xec, 0xba, 0xd6, 0xb1, 0xaa, 0x6c,
+	0x29, 0xdb, 0xf9, 0xdd, 0xbb, 0x5f, 0xbf, 0xdc, 0x9c, 0xfb, 0xdb, 0xcb, 0xcd, 0x9b, 0xe1, 0x14,
+	0xbe, 0x79, 0x50, 0xa6, 0xac, 0x62, 0xe3, 0x60, 0x54, 0x6e, 0x91, 0x21, 0x36, 0x8e, 0xeb, 0xc4,
+	0xf8, 0xf6, 0xab, 0x3b, 0x20, 0x57, 0x50, 0x27, 0x86, 0x26, 0x09, 0xd0, 0x03, 0x98, 0x77, 0x3d,
+	0x6a, 0x10, 0x35, 0x95, 0x94, 0x29, 0xc4, 0x97, 0xfe, 0x34, 0x0f, 0x8b, 0xbb, 0xcc, 0x31, 0xa9,
+	0x33, 0x14, 0xcb, 0x45, 0x9f, 0x81, 0xf2, 0x38, 0xf9, 0xfa, 0x94, 0xc7, 0x9c, 0xa0, 0x9d, 0x7c,
+	0x59, 0x4a, 0x9b, 0x13, 0xd4, 0xd4, 0x74, 0x62, 0x82, 0x1a, 0xfa, 0x14, 0xae, 0x79, 0xcc, 0xb2,
+	0xb0, 0xeb, 0xea, 0x26, 0x71, 0x98, 0xad, 0x9b, 0xc4, 0xa0, 0x36, 0xb6, 0x7c, 0x35, 0xb3, 0xa5,
+	0x6c, 0x67, 0xb4, 0x35, 0x69, 0xad, 0x73, 0x63, 0x5d, 0xda, 0xd0, 0x0f, 0x40, 0xb5, 0xe8, 0xcf,
+	0xc6, 0xd4, 0xa4, 0xc1, 0xf1, 0x59, 0xdc, 0xbc, 0xc0, 0x5d, 0x8b, 0xed, 0xa7, 0x91, 0x75, 0x00,
+	0x83, 0x9f, 0x9d, 0x1e, 0x1c, 0xbb, 0x44, 0x5d, 0xd8, 0x52, 0xb6, 0x97, 0x77, 0xfe, 0xbf, 0xfc,
+	0x66, 0x5d, 0x97, 0xc5, 0x49, 0xf7, 0x8f, 0x5d, 0xa2, 0xe5, 0x8d, 0xe8, 0x5f, 0xf4, 0x23, 0x50,
+	0x3e, 0x57, 0xb3, 0x62, 0xdb, 0x77, 0x66, 0xdc, 0xf2, 0xe7, 0xe8, 0x11, 0xe4, 0x6d, 0x7c, 0xa4,
+	0x87, 0x9a, 0xc8, 0x25, 0x21, 0xc9, 0xd9, 0xf8, 0xa8, 0xcb, 0xe1, 0xa8, 0x09, 0x39, 0x9b, 0x9a,
+	0x42, 0xb2, 0x6a, 0x3e, 0x19, 0x95, 0x84, 0xa3, 0x1e, 0x14, 0x06, 0xb1, 0xfe, 0x7d, 0x15, 0xb6,
+	0xd2, 0xdb, 0x85, 0x9d, 0x8f, 0x2e, 0x3d, 0x9a, 0x93, 0x98, 0xd9, 0xcd, 0x70, 0x05, 0x68, 0x93,
+	0x2c, 0xa5, 0x3f, 0x2e, 0x42, 0xa6, 0x6b, 0x61, 0x07, 0x2d, 0x43, 0x8a, 0x9a, 0x42, 0xab, 0x19,
+	0x2d, 0x45, 0x4d, 0xf4, 0x3e, 0x40, 0x74, 0xef, 0xd4, 0x0c, 0x25, 0xa8, 0xe5, 0xe5, 0x48, 0xd3,
+	0x44, 0xf7, 0x01, 0xd9, 0xcc, 0x1c, 0x5b, 0x44, 0xc7, 0x86, 0xa1, 0x63, 0xd3, 0xf4, 0x88, 0xef,
+	0x4b, 0xa1, 0xa9, 0xdf, 0x7e, 0x75, 0x67, 0x4d, 0x6e, 0xa1, 0x1a, 0x5a, 0x7a, 0x81, 0x47, 0x9d,
+	0xa1, 0x56, 0x0c, 0x31, 0x55, 0xc3, 0x90, 0xe3, 0xe8, 0x11, 0x14, 0x03, 0x16, 0x60, 0x4b, 0xc7,
+	0x96, 0xc5, 0x0c, 0x91, 0x58, 0x84, 0xb0, 0x0a, 0x3b, 0x37, 0xca, 0x92, 0x82, 0x67, 0x96, 0xb2,
+	0xcc, 0x2c, 0xe5, 0x1a, 0xa3, 0x8e, 0xdc, 0xc7, 0x8a, 0x00, 0x56, 0x63, 0x1c, 0xea, 0xc1, 0xd2,
+	0x20, 0x8c, 0x3e, 0x5d, 0x28, 0x41, 0x28, 0xad, 0xb0, 0xb3, 0x3d, 0xed, 0x88, 0x26, 0xc3, 0x55,
+	0xf2, 0x2e, 0x0e, 0x26, 0x43, 0xf8, 0x16, 0x2c, 0xf9, 0x24, 0x08, 0x2c, 0x62, 0x86, 0x3a, 0x16,
+	0x92, 0xcc, 0x6b, 0x8b, 0x72, 0x50, 0x88, 0x17, 0xd5, 0x00, 0xfc, 0x00, 0x7b, 0x81, 0xce, 0xb3,
+	0xa7, 0xd0, 0x5d, 0x61, 0x67, 0xbd, 0x1c, 0x66, 0xce, 0x72, 0x94, 0x39, 0xcb, 0xfd, 0x28, 0xb5,
+	0xee, 0xe6, 0xf8, 0x44, 0x2f, 0xfe, 0xbe, 0xa9, 0x68, 0x79, 0x81, 0xe3, 0x16, 0xd4, 0x82, 0x15,
+	0xd7, 0x23, 0xba, 0x85, 0xc7, 0x8e, 0x31, 0x0a, 0x99, 0x72, 0x33, 0x30, 0x2d, 0xb9, 0x1e, 0x69,
+	0x09, 0xac, 0x60, 0xbb, 0x0f, 0x39, 0x9f, 0x59, 0xa6, 0x8e, 0xed, 0x48, 0x78, 0x1f, 0xc9, 0xf8,
+	0xbf, 0x7a, 0x5e, 0x7c, 0x4d, 0x27, 0x98, 0x90, 0x5d, 0xd3, 0x09, 0xb4, 0x2c, 0x07, 0x57, 0xed,
+	0x00, 0xb5, 0xa0, 0x60, 0x58, 0x98, 0xda, 0x24, 0xa4, 0x82, 0xd9, 0xa9, 0x40, 0xe2, 0x39, 0x1b,
+	0x85, 0xab, 0xd4, 0x31, 0x88, 0x13, 0xd0, 0x43, 0xa2, 0xbb, 0x16, 0x76, 0xf4, 0x30, 0xd1, 0xab,
+	0x05, 0xb1, 0xd3, 0xca, 0xb4, 0xab, 0x6a, 0x46, 0x40, 0xae, 0xd7, 0xae, 0x80, 0xc9, 0x1b, 0x5b,
+	0xa5, 0xe7, 0x4d, 0xe8, 0x19, 0x20, 0x1e, 0xc5, 0xd8, 0x66, 0x63, 0x27, 0xd0, 0x03, 0xa6, 0xfb,
+	0xc4, 0xb2, 0xd4, 0xc5, 0xd9, 0xd7, 0xbf, 0x62, 0xe3, 0xa3, 0xaa, 0x60, 0xe9, 0xb3, 0x1e, 0xb1,
+	0x2c, 0xf4, 0x0c, 0x96, 0x4f, 0x92, 0x9b, 0x8b, 0xbd, 0x40, 0x5d, 0x4a, 0x9a, 0x60, 0x97, 0x62,
+	0xa2, 0x2e, 0xf6, 0x78, 0x88, 0x2f, 0x1e, 0x12, 0x3f, 0xe0, 0x0a, 0xe6, 0x87, 0xa3, 0x2e, 0x8b,
+	0x53, 0xb9, 0x3d, 0xf5, 0x54, 0xb4, 0xce, 0x93, 0x10, 0xc2, 0xf7, 0x1e, 0x85, 0xf8, 0xe1, 0xc9,
+	0x10, 0xfa, 0x10, 0x56, 0x02, 0x0f, 0x8b, 0xb0, 0x20, 0x0e, 0x1e, 0x58, 0xc4, 0x54, 0x57, 0xb6,
+	0x94, 0xed, 0x9c, 0xb6, 0x2c, 0x87, 0x1b, 0xe1, 0x28, 0xea, 0xc0, 0x15, 0xea, 0xb1, 0xf0, 0x5a,
+	0xa2, 0x2a, 0xaf, 0x16, 0x65, 0x30, 0x9e, 0x95, 0x60, 0x5d, 0x3a, 0x84, 0x0a, 0xfc, 0x15, 0x57,
+	0xe0, 0x0a, 0xf5, 0x18, 0x9f, 0x31, 0x32, 0xf1, 0x99, 0xcf, 0x54, 0x01, 0xf5, 0x8a, 0x88, 0x9e,
+	0xe5, 0xd3, 0xc9, 0x1f, 0xd5, 0x20, 0xeb, 0x7a, 0xc4, 0xc7, 0x16, 0x51, 0x91, 0x98, 0xef, 0xd6,
+	0xb4, 0x2d, 0x77, 0x43, 0x57, 0xb9, 0xd7, 0x08, 0x89, 0x9e, 0x40, 0xb4, 0x21, 0xdd, 0xa2, 0x36,
+	0x0d, 0x7c, 0x75, 0x55, 0x70, 0x7d, 0x6f, 0x1a, 0x57, 0x3f, 0x44, 0xb4, 0x04, 0x40, 0x32, 0x2e,
+	0x05, 0x93, 0x83, 0x68, 0x0f, 0x56, 0xc3, 0x60, 0xb7, 0x89, 0x13, 0xe8, 0x26, 0xc1, 0xa6, 0x45,
+	0x1d, 0xa2, 0xae, 0xcd, 0x10, 0x9b, 0xe8, 0x84, 0xa0, 0x2e, 0xf1, 0xe8, 0x29, 0x14, 0x5c, 0xc6,
+	0xac, 0x28, 0x00, 0xae, 0x0a, 0xba, 0x8f, 0xa7, 0xad, 0xb5, 0x17, 0x93, 0x74, 0x19, 0xb3, 0x4e,
+	0x45, 0x00, 0xb8, 0xf1, 0x08, 0xfa, 0x29, 0x14, 0x03, 0x82, 0xed, 0x89, 0x8c, 0xea, 0xab, 0xd7,
+	0xb6, 0xd2, 0x97, 0x09, 0xa9, 0x4f, 0xb0, 0x7d, 0x92, 0x4c, 0xe3, 0x1c, 0x7b, 0x6a, 0xd4, 0x2f,
+	0xfd, 0x3e, 0x0d, 0xcb, 0xa7, 0x3d, 0xd1, 0x3d, 0x28, 0x0c, 0x88, 0x43, 0xf6, 0xa9, 0x41, 0xb1,
+	0x17, 0xb5, 0x63, 0x6f, 0xae, 0x01, 0x93, 0xce, 0xa8, 0x06, 0x0b, 0x61, 0x80, 0xaa, 0xa9, 0xd9,
+	0x03, 0x53, 0x42, 0xd1, 0x0f, 0x61, 0xde, 0xb0, 0xe8, 0xfe, 0xbe, 0x9a, 0x7e, 0x7b, 0xad, 0x86,
+	0x08, 0xd4, 0x86, 0x62, 0x14, 0x70, 0xb1, 0xe2, 0x33, 0x33, 0x28, 0x5e, 0x82, 0x63, 0xc5, 0x37,
+	0x20, 0x2b, 0xb3, 0x9d, 0x3a, 0x3f, 0xfb, 0x86, 0x22, 0xec, 0x99, 0x7a, 0xb2, 0x90, 0xa8, 0x9e,
+	0x94, 0xfe, 0xa2, 0xc0, 0xda, 0x45, 0x92, 0x41, 0x06, 0x44, 0x4d, 0x9b, 0x1e, 0xb0, 0x03, 0xe2,
+	0xe8, 0xcf, 0x09, 0x1d, 0x8e, 0x82, 0x89, 0x46, 0x55, 0x99, 0x2d, 0x8b, 0x21, 0x49, 0xd7, 0xe7,
+	0x6c, 0x4f, 0x05, 0x19, 0x6a, 0x41, 0xce, 0x7f, 0x8e, 0x5d, 0x7d, 0x9f, 0x4c, 0xf6, 0xd5, 0x33,
+	0x12, 0x67, 0x39, 0xc5, 0x7d, 0x42, 0x4a, 0x7f, 0x4e, 0xc1, 0xd2, 0xa9, 0x50, 0x45, 0x3d, 0x58,
+	0x11, 0x4d, 0x1a, 0xf1, 0xe2, 0xee, 0x43, 0x99, 0xfd, 0xc4, 0x97, 0x78, 0xa3, 0x46, 0xbc, 0xa8,
+	0x1b, 0xe9, 0xc0, 0x52, 0x44, 0x3a, 0xb0, 0x98, 0x71, 0x90, 0x44, 0x95, 0x85, 0x90, 0x72, 0x97,
+	0xe3, 0x45, 0x17, 0x85, 0x6d, 0x57, 0x1f, 0xb1, 0xb1, 0x17, 0xb6, 0x47, 0x19, 0x2d, 0xcf, 0x47,
+	0x1e, 0xf2, 0x01, 0xf4, 0x01, 0x2c, 0x8a, 0x79, 0xf4, 0x51, 0x78, 0x03, 0x5c, 0x7a, 0x69, 0xad,
+	0x20, 0xc6, 0x1e, 0x86, 0xe7, 0xd8, 0x8e, 0x5c, 0x06, 0x6c, 0xcc, 0x5d, 0x12, 0xc8, 0x2a, 0xe4,
+	0xdb, 0x15, 0xf8, 0xd2, 0xaf, 0x15, 0xc8, 0x75, 0xc7, 0x9e, 0x31, 0xc2, 0x3e, 0x41, 0xd7, 0x21,
+	0x2b, 0xb2, 0xbd, 0xec, 0xfc, 0xf2, 0xda, 0x02, 0xff, 0x6c, 0x9a, 0x68, 0x07, 0xb2, 0xd1, 0xa9,
+	0xa6, 0x2e, 0x89, 0xe7, 0xc8, 0x71, 0x22, 0x96, 0xd3, 0x89, 0x63, 0xb9, 0xf4, 0x2f, 0x05, 0x72,
+	0x1a, 0xd9, 0x27, 0x9e, 0x87, 0xad, 0x37, 0x2f, 0xef, 0x53, 0xc8, 0x79, 0xc2, 0x89, 0x78, 0x97,
+	0xae, 0x2f, 0xf6, 0xe4, 0x0b, 0x3c, 0x64, 0xd6, 0xd8, 0x26, 0x89, 0x16, 0x18, 0x42, 0xd1, 0x03,
+	0xc8, 0x11, 0xec, 0x39, 0xd4, 0x19, 0x86, 0xbf, 0x80, 0x66, 0xa4, 0x89, 0xc1, 0xa5, 0x7f, 0xa7,
+	0x20, 0x2b, 0x2b, 0x19, 0x7a, 0x0f, 0xf2, 0x3c, 0x5b, 0x3f, 0xb7, 0xa8, 0xcf, 0xc3, 0x30, 0xcd,
+	0x7b, 0xed, 0x78, 0x00, 0x6d, 0x42, 0xc1, 0x26, 0xde, 0x81, 0x45, 0x74, 0x8f, 0xb1, 0x30, 0x53,
+	0x2e, 0x6a, 0x10, 0x0e, 0x69, 0x8c, 0x05, 0x17, 0xc5, 0x42, 0xfa, 0x9d, 0x63, 0xe1, 0x31, 0x2c,
+	0x72, 0xd2, 0xb8, 0x89, 0x4c, 0xb0, 0x59, 0xb0, 0xf1, 0x51, 0x4f, 0xf6, 0x91, 0x9f, 0x41, 0x2e,
+	0xce, 0xb0, 0xf3, 0x6f, 0x9f, 0x61, 0x63, 0x10, 0x27, 0x20, 0x8e, 0x39, 0x7b, 0x46, 0xcc, 0x12,
+	0xc7, 0x14, 0xf9, 0xf0, 0x9f, 0x0a, 0x5c, 0x91, 0x07, 0x3e, 0x51, 0xbd, 0xbe, 0xd3, 0x10, 0xf8,
+	0x31, 0xa4, 0x0d, 0xec, 0x26, 0x39, 0x7c, 0x8e, 0xe3, 0x02, 0x95, 0x51, 0x9e, 0xe0, 0xb0, 0x25,
+	0xb4, 0xf4, 0x07, 0x05, 0x56, 0x2f, 0x68, 0x95, 0xd1, 0x00, 0x6e, 0x9e, 0xd4, 0x14, 0x1d, 0xef,
+	0x07, 0xc4, 0xd3, 0x4f, 0xda, 0x12, 0x55, 0x79, 0xfb, 0x3b, 0x51, 0xe3, 0x1a, 0x53, 0xe5, 0x2c,
+	0x27, 0x35, 0x06, 0x55, 0x60, 0xcd, 0x19, 0xdb, 0x3a, 0x71, 0x99, 0x31, 0xf2, 0x75, 0x17, 0x53,
+	0x53, 0x67, 0x87, 0x32, 0x46, 0x33, 0xda, 0x15, 0x67, 0x6c, 0x37, 0x84, 0xa9, 0x8b, 0xa9, 0xd9,
+	0x39, 0x24, 0x5e, 0xe9, 0xbf, 0x69, 0x58, 0x3e, 0xdd, 0xc1, 0x4e, 0xa4, 0x11, 0x25, 0x79, 0x4b,
+	0x30, 0x51, 0x87, 0x53, 0xef, 0x50, 0x87, 0xe9, 0x05, 0xed, 0xc1, 0xa5, 0x4d, 0xc6, 0x2d, 0x3e,
+	0xd5, 0x7f, 0x5e, 0x6e, 0x5e, 0x3f, 0xc6, 0xb6, 0x75, 0xaf, 0x74, 0x96, 0xa0, 0x74, 0x71, 0xe7,
+	0x70, 0xc9, 0xf5, 0x64, 0xbe, 0x8b, 0xeb, 0x39, 0xdd, 0x56, 0xcc, 0x27, 0xfb, 0x99, 0xfa, 0xae,
+	0x71, 0x78, 0x2f, 0xf3, 0xe5, 0x6f, 0x36, 0xe7, 0x4a, 0xbf, 0xcc, 0xc0, 0x42, 0x0d, 0x3b, 0xa6,
+	0x35, 0xa5, 0x0a, 0xb5, 0x00, 0x3c, 0xe2, 0x33, 0x6b, 0x2c, 0x0e, 0x3e, 0x25, 0xde, 0x82, 0xbe,
+	0x3f, 0xf5, 0xc1, 0x43, 0x10, 0x6a, 0x31, 0x46, 0x9b, 0xc0, 0x9f, 0xd9, 0x7d, 0x3a, 0xd9, 0xee,
+	0x1b, 0x90, 0x61, 0x2e, 0x71, 0xd4, 0x4c, 0xdc, 0xd2, 0xcc, 0xf8, 0x8b, 0x4f, 0xc0, 0x39, 0xcd,
+	0x88, 0x0e, 0x47, 0xea, 0x7c, 0x62, 0x1a, 0x0e, 0x47, 0x35, 0x48, 0x5b, 0xec, 0xb9, 0xba, 0x90,
+	0x94, 0x85, 0xa3, 0xf9, 0xf3, 0xa7, 0x61, 0x31, 0x9f, 0xa8, 0xd9, 0xa4, 0x34, 0x21, 0x7e, 0xa2,
+	0xbe, 0xe6, 0x12, 0xd7, 0xd7, 0xdb, 0x2f, 0x14, 0x28, 0x9e, 0xbd, 0x46, 0xf4, 0x01, 0xbc, 0x5f,
+	0xab, 0xb6, 0xeb, 0xad, 0x86, 0xae, 0x35, 0x7a, 0x9d, 0xd6, 0x5e, 0xbf, 0xd9, 0x69, 0xeb, 0x7b,
+	0xed, 0x5e, 0xb7, 0x51, 0x6b, 0xde, 0x6f, 0x36, 0xea, 0xc5, 0x39, 0xf4, 0x1e, 0xa8, 0xe7, 0x5d,
+	0x1e, 0x37, 0xdb, 0x7b, 0xfd, 0x46, 0x51, 0x41, 0xeb, 0x70, 0xed, 0xbc, 0xf5, 0x61, 0x67, 0x4f,
+	0x2b, 0xa6, 0xd0, 0x0d, 0xb8, 0x7a, 0xde, 0x56, 0xaf, 0x7e, 0x51, 0x4c, 0xaf, 0x67, 0xbe, 0xfc,
+	0xed, 0xc6, 0xdc, 0xed, 0x5f, 0x40, 0x3e, 0x7e, 0x64, 0x44, 0x6b, 0x50, 0xac, 0xed, 0x69, 0x4f,
+	0x1a, 0x7a, 0xff, 0x8b, 0x6e, 0x43, 0xef, 0x76, 0x9e, 0x36, 0xb4, 0xe2, 0x9c, 0xe0, 0x3f, 0x19,
+	0x6d, 0x3c, 0xeb, 0x76, 0xda, 0x8d, 0x76, 0xbf, 0x59, 0x6d, 0x15, 0x15, 0x74, 0x1d, 0x56, 0x27,
+	0x6c, 0xad, 0xce, 0x83, 0x66, 0xaf, 0xdf, 0xac, 0x15, 0x53, 0x68, 0x13, 0x6e, 0x4e, 0x52, 0x35,
+	0x1b, 0xb5, 0xc6, 0xd3, 0x66, 0xaf, 0xa1, 0xb7, 0x9a, 0xed, 0x46, 0x55, 0x8b, 0xa6, 0xdf, 0x7d,
+	0xf4, 0xf5, 0xab, 0x0d, 0xe5, 0x9b, 0x57, 0x1b, 0xca, 0x3f, 0x5e, 0x6d, 0x28, 0x2f, 0x5e, 0x6f,
+	0xcc, 0x7d, 0xf3, 0x7a, 0x63, 0xee, 0xaf, 0xaf, 0x37, 0xe6, 0x7e, 0xf2, 0xf1, 0x90, 0x06, 0xa3,
+	0xf1, 0xa0, 0x6c, 0x30, 0xbb, 0xf2, 0x86, 0xb7, 0xf4, 0xc3, 0x4f, 0x2a, 0x47, 0xe2, 0x41, 0x9d,
+	0x3f, 0xa7, 0xfa, 0x83, 0x05, 0xa1, 0xf3, 0x4f, 0xfe, 0x37, 0x00, 0x63, 0x1c, 0x84, 0x98, 0x4f,
+	0x18, 0x00, 0x00,
+}
+
+func (m *CurveBreakpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CurveBreakpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CurveBreakpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
-	if err9 != nil {
-		return 0, err9
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
 	}
-	i -= n9
-	i = encodeVarintIro(dAtA, i, uint64(n9))
 	i--
-	dAtA[i] = 0x32
-	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err10 != nil {
-		return 0, err10
+	dAtA[i] = 0x12
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
 	}
-	i -= n10
-	i = encodeVarintIro(dAtA, i, uint64(n10))
 	i--
-	dAtA[i] = 0x2a
-	n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.StartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StartTimeAfterSettlement):])
-	if err11 != nil {
-		return 0, err11
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BondingCurve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	i -= n11
-	i = encodeVarintIro(dAtA, i, uint64(n11))
-	i--
-	dAtA[i] = 0x22
-	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VestingDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingDuration):])
-	if err12 != nil {
-		return 0, err12
+	return dAtA[:n], nil
+}
+
+func (m *BondingCurve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BondingCurve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Breakpoints) > 0 {
+		for iNdEx := len(m.Breakpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Breakpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIro(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Midpoint != nil {
+		{
+			size := m.Midpoint.Size()
+			i -= size
+			if _, err := m.Midpoint.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintIro(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.MaxPrice != nil {
+		{
+			size := m.MaxPrice.Size()
+			i -= size
+			if _, err := m.MaxPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintIro(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.K != nil {
+		{
+			size := m.K.Size()
+			i -= size
+			if _, err := m.K.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintIro(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CurveType != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.CurveType))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LiquidityDenomDecimals != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.LiquidityDenomDecimals))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RollappDenomDecimals != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.RollappDenomDecimals))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.C.Size()
+		i -= size
+		if _, err := m.C.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
 	}
-	i -= n12
-	i = encodeVarintIro(dAtA, i, uint64(n12))
 	i--
 	dAtA[i] = 0x1a
 	{
-		size := m.Claimed.Size()
+		size := m.N.Size()
 		i -= size
-		if _, err := m.Claimed.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.N.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintIro(dAtA, i, uint64(size))
@@ -769,145 +1335,3340 @@ func (m *IROVestingPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x12
 	{
-		size := m.Amount.Size()
+		size := m.M.Size()
 		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.M.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i = encodeVarintIro(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintIro(dAtA []byte, offset int, v uint64) int {
-	offset -= sovIro(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *BondingCurve) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.M.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.N.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.C.Size()
-	n += 1 + l + sovIro(uint64(l))
-	if m.RollappDenomDecimals != 0 {
-		n += 1 + sovIro(uint64(m.RollappDenomDecimals))
-	}
-	if m.LiquidityDenomDecimals != 0 {
-		n += 1 + sovIro(uint64(m.LiquidityDenomDecimals))
-	}
-	return n
-}
-
-func (m *Plan) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovIro(uint64(m.Id))
-	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovIro(uint64(l))
-	}
-	l = len(m.ModuleAccAddress)
-	if l > 0 {
-		n += 1 + l + sovIro(uint64(l))
-	}
-	l = m.TotalAllocation.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.BondingCurve.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = len(m.SettledDenom)
-	if l > 0 {
-		n += 1 + l + sovIro(uint64(l))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovIro(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreLaunchTime)
-	n += 1 + l + sovIro(uint64(l))
-	l = m.SoldAmt.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.ClaimedAmt.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.IncentivePlanParams.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.MaxAmountToSell.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.LiquidityPart.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.VestingPlan.Size()
-	n += 1 + l + sovIro(uint64(l))
-	if m.TradingEnabled {
-		n += 2
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IroPlanDuration)
-	n += 2 + l + sovIro(uint64(l))
-	l = len(m.LiquidityDenom)
-	if l > 0 {
-		n += 2 + l + sovIro(uint64(l))
-	}
-	return n
-}
-
-func (m *IncentivePlanParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StartTimeAfterSettlement)
-	n += 1 + l + sovIro(uint64(l))
-	if m.NumEpochsPaidOver != 0 {
-		n += 1 + sovIro(uint64(m.NumEpochsPaidOver))
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Plan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Plan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TeamAllocations) > 0 {
+		for iNdEx := len(m.TeamAllocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TeamAllocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIro(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	{
+		size, err := m.PoolParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SettlementDeadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SettlementDeadline):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintIro(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	{
+		size, err := m.TradingLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	{
+		size, err := m.Presale.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if len(m.LiquidityDenom) > 0 {
+		i -= len(m.LiquidityDenom)
+		copy(dAtA[i:], m.LiquidityDenom)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.LiquidityDenom)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.IroPlanDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IroPlanDuration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintIro(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.TradingEnabled {
+		i--
+		if m.TradingEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	{
+		size, err := m.VestingPlan.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.LiquidityPart.Size()
+		i -= size
+		if _, err := m.LiquidityPart.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.MaxAmountToSell.Size()
+		i -= size
+		if _, err := m.MaxAmountToSell.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.IncentivePlanParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.ClaimedAmt.Size()
+		i -= size
+		if _, err := m.ClaimedAmt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.SoldAmt.Size()
+		i -= size
+		if _, err := m.SoldAmt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PreLaunchTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreLaunchTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintIro(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x42
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintIro(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.SettledDenom) > 0 {
+		i -= len(m.SettledDenom)
+		copy(dAtA[i:], m.SettledDenom)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.SettledDenom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.BondingCurve.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.TotalAllocation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ModuleAccAddress) > 0 {
+		i -= len(m.ModuleAccAddress)
+		copy(dAtA[i:], m.ModuleAccAddress)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.ModuleAccAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TeamAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TeamAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TeamAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintIro(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Claimed.Size()
+		i -= size
+		if _, err := m.Claimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VestingDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingDuration):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintIro(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x22
+	n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Cliff, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Cliff):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintIro(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SettlementPoolParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SettlementPoolParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SettlementPoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SwapFee != nil {
+		{
+			size := m.SwapFee.Size()
+			i -= size
+			if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintIro(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RollappTokenWeight != nil {
+		{
+			size := m.RollappTokenWeight.Size()
+			i -= size
+			if _, err := m.RollappTokenWeight.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintIro(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TradingLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TradingLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradingLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BlockBought.Size()
+		i -= size
+		if _, err := m.BlockBought.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.BlockHeight != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RampHours != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.RampHours))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MaxPerBlock.Size()
+		i -= size
+		if _, err := m.MaxPerBlock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxPerAddress.Size()
+		i -= size
+		if _, err := m.MaxPerAddress.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Purchase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Purchase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Purchase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Referral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Referral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Referral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Earnings.Size()
+		i -= size
+		if _, err := m.Earnings.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Presale) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Presale) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Presale) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintIro(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x32
+	n16, err16 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintIro(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxSoldAmt.Size()
+		i -= size
+		if _, err := m.MaxSoldAmt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxPerAddress.Size()
+		i -= size
+		if _, err := m.MaxPerAddress.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintIro(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PresaleAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PresaleAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PresaleAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Bought.Size()
+		i -= size
+		if _, err := m.Bought.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Cap.Size()
+		i -= size
+		if _, err := m.Cap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IncentivePlanParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentivePlanParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentivePlanParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumEpochsPaidOver != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.NumEpochsPaidOver))
+		i--
+		dAtA[i] = 0x10
+	}
+	n17, err17 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.StartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StartTimeAfterSettlement):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintIro(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *IROVestingPlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IROVestingPlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IROVestingPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintIro(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x32
+	n19, err19 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintIro(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x2a
+	n20, err20 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.StartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StartTimeAfterSettlement):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintIro(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0x22
+	n21, err21 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VestingDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingDuration):])
+	if err21 != nil {
+		return 0, err21
+	}
+	i -= n21
+	i = encodeVarintIro(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Claimed.Size()
+		i -= size
+		if _, err := m.Claimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Candle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Candle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Candle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Close.Size()
+		i -= size
+		if _, err := m.Close.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Low.Size()
+		i -= size
+		if _, err := m.Low.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.High.Size()
+		i -= size
+		if _, err := m.High.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Open.Size()
+		i -= size
+		if _, err := m.Open.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n22, err22 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintIro(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x1a
+	if m.Resolution != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.Resolution))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIro(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIro(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CurveBreakpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Supply.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *BondingCurve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.M.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.N.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.C.Size()
+	n += 1 + l + sovIro(uint64(l))
+	if m.RollappDenomDecimals != 0 {
+		n += 1 + sovIro(uint64(m.RollappDenomDecimals))
+	}
+	if m.LiquidityDenomDecimals != 0 {
+		n += 1 + sovIro(uint64(m.LiquidityDenomDecimals))
+	}
+	if m.CurveType != 0 {
+		n += 1 + sovIro(uint64(m.CurveType))
+	}
+	if m.K != nil {
+		l = m.K.Size()
+		n += 1 + l + sovIro(uint64(l))
+	}
+	if m.MaxPrice != nil {
+		l = m.MaxPrice.Size()
+		n += 1 + l + sovIro(uint64(l))
+	}
+	if m.Midpoint != nil {
+		l = m.Midpoint.Size()
+		n += 1 + l + sovIro(uint64(l))
+	}
+	if len(m.Breakpoints) > 0 {
+		for _, e := range m.Breakpoints {
+			l = e.Size()
+			n += 1 + l + sovIro(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Plan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovIro(uint64(m.Id))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = len(m.ModuleAccAddress)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = m.TotalAllocation.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.BondingCurve.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = len(m.SettledDenom)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreLaunchTime)
+	n += 1 + l + sovIro(uint64(l))
+	l = m.SoldAmt.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.ClaimedAmt.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.IncentivePlanParams.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.MaxAmountToSell.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.LiquidityPart.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.VestingPlan.Size()
+	n += 1 + l + sovIro(uint64(l))
+	if m.TradingEnabled {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IroPlanDuration)
+	n += 2 + l + sovIro(uint64(l))
+	l = len(m.LiquidityDenom)
+	if l > 0 {
+		n += 2 + l + sovIro(uint64(l))
+	}
+	l = m.Presale.Size()
+	n += 2 + l + sovIro(uint64(l))
+	l = m.TradingLimits.Size()
+	n += 2 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SettlementDeadline)
+	n += 2 + l + sovIro(uint64(l))
+	l = m.PoolParams.Size()
+	n += 2 + l + sovIro(uint64(l))
+	if len(m.TeamAllocations) > 0 {
+		for _, e := range m.TeamAllocations {
+			l = e.Size()
+			n += 2 + l + sovIro(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TeamAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Cliff)
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingDuration)
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Claimed.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *SettlementPoolParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RollappTokenWeight != nil {
+		l = m.RollappTokenWeight.Size()
+		n += 1 + l + sovIro(uint64(l))
+	}
+	if m.SwapFee != nil {
+		l = m.SwapFee.Size()
+		n += 1 + l + sovIro(uint64(l))
+	}
+	return n
+}
+
+func (m *TradingLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxPerAddress.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.MaxPerBlock.Size()
+	n += 1 + l + sovIro(uint64(l))
+	if m.RampHours != 0 {
+		n += 1 + sovIro(uint64(m.RampHours))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovIro(uint64(m.BlockHeight))
+	}
+	l = m.BlockBought.Size()
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *Purchase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *Referral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = m.Volume.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Earnings.Size()
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *Presale) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovIro(uint64(l))
+		}
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = m.MaxPerAddress.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.MaxSoldAmt.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *PresaleAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = m.Cap.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Bought.Size()
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *IncentivePlanParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StartTimeAfterSettlement)
+	n += 1 + l + sovIro(uint64(l))
+	if m.NumEpochsPaidOver != 0 {
+		n += 1 + sovIro(uint64(m.NumEpochsPaidOver))
+	}
+	return n
+}
+
+func (m *IROVestingPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Claimed.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingDuration)
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StartTimeAfterSettlement)
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *Candle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	if m.Resolution != 0 {
+		n += 1 + sovIro(uint64(m.Resolution))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Open.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.High.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Low.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Close.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Volume.Size()
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func sovIro(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIro(x uint64) (n int) {
+	return sovIro(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CurveBreakpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurveBreakpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurveBreakpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BondingCurve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BondingCurve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BondingCurve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.M.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field N", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.N.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field C", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.C.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappDenomDecimals", wireType)
+			}
+			m.RollappDenomDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RollappDenomDecimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDenomDecimals", wireType)
+			}
+			m.LiquidityDenomDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiquidityDenomDecimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurveType", wireType)
+			}
+			m.CurveType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurveType |= CurveType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field K", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.K = &v
+			if err := m.K.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxPrice = &v
+			if err := m.MaxPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Midpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.Midpoint = &v
+			if err := m.Midpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Breakpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Breakpoints = append(m.Breakpoints, CurveBreakpoint{})
+			if err := m.Breakpoints[len(m.Breakpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Plan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Plan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Plan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAllocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalAllocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondingCurve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondingCurve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettledDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreLaunchTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PreLaunchTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoldAmt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SoldAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedAmt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimedAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivePlanParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IncentivePlanParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountToSell", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmountToSell.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityPart", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityPart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPlan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VestingPlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradingEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TradingEnabled = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IroPlanDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.IroPlanDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Presale", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Presale.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradingLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TradingLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDeadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SettlementDeadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TeamAllocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TeamAllocations = append(m.TeamAllocations, TeamAllocation{})
+			if err := m.TeamAllocations[len(m.TeamAllocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TeamAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TeamAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TeamAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cliff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Cliff, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VestingDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SettlementPoolParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SettlementPoolParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SettlementPoolParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappTokenWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.RollappTokenWeight = &v
+			if err := m.RollappTokenWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.SwapFee = &v
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TradingLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradingLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradingLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPerAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RampHours", wireType)
+			}
+			m.RampHours = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RampHours |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockBought", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockBought.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Purchase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Purchase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Purchase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Referral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Referral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Referral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Earnings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Earnings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *IROVestingPlan) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.Claimed.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingDuration)
-	n += 1 + l + sovIro(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StartTimeAfterSettlement)
-	n += 1 + l + sovIro(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovIro(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
-	n += 1 + l + sovIro(uint64(l))
-	return n
-}
-
-func sovIro(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozIro(x uint64) (n int) {
-	return sovIro(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *BondingCurve) Unmarshal(dAtA []byte) error {
+func (m *Presale) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -930,15 +4691,15 @@ func (m *BondingCurve) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BondingCurve: wiretype end group for non-group")
+			return fmt.Errorf("proto: Presale: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BondingCurve: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Presale: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field M", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -966,13 +4727,45 @@ func (m *BondingCurve) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.M.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field N", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = append(m.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleRoot == nil {
+				m.MerkleRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1000,13 +4793,13 @@ func (m *BondingCurve) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.N.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxPerAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field C", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSoldAmt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1023,26 +4816,59 @@ func (m *BondingCurve) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSoldAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthIro
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthIro
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.C.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappDenomDecimals", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			m.RollappDenomDecimals = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1052,30 +4878,25 @@ func (m *BondingCurve) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RollappDenomDecimals |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDenomDecimals", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthIro
 			}
-			m.LiquidityDenomDecimals = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIro
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LiquidityDenomDecimals |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIro(dAtA[iNdEx:])
@@ -1097,7 +4918,7 @@ func (m *BondingCurve) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Plan) Unmarshal(dAtA []byte) error {
+func (m *PresaleAllocation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1120,34 +4941,15 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Plan: wiretype end group for non-group")
+			return fmt.Errorf("proto: PresaleAllocation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Plan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PresaleAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIro
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1175,11 +4977,11 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			m.PlanId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1207,46 +5009,13 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleAccAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalAllocation", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIro
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthIro
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthIro
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TotalAllocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondingCurve", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1256,28 +5025,29 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIro
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIro
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BondingCurve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettledDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bought", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1305,44 +5075,63 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SettledDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			if err := m.Bought.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIro
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthIro
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthIro
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncentivePlanParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 8:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncentivePlanParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncentivePlanParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreLaunchTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTimeAfterSettlement", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1369,15 +5158,15 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PreLaunchTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.StartTimeAfterSettlement, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SoldAmt", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochsPaidOver", wireType)
 			}
-			var stringLen uint64
+			m.NumEpochsPaidOver = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1387,29 +5176,64 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NumEpochsPaidOver |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthIro
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthIro
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SoldAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IROVestingPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
 			}
-			iNdEx = postIndex
-		case 10:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IROVestingPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IROVestingPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedAmt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1437,46 +5261,13 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ClaimedAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncentivePlanParams", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIro
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthIro
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthIro
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.IncentivePlanParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountToSell", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1504,15 +5295,15 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxAmountToSell.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Claimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityPart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingDuration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1522,29 +5313,28 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthIro
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthIro
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidityPart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VestingDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingPlan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTimeAfterSettlement", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1571,33 +5361,13 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VestingPlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.StartTimeAfterSettlement, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradingEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIro
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.TradingEnabled = bool(v != 0)
-		case 16:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IroPlanDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1624,15 +5394,15 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.IroPlanDuration, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1642,23 +5412,24 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthIro
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthIro
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidityDenom = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1681,7 +5452,7 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IncentivePlanParams) Unmarshal(dAtA []byte) error {
+func (m *Candle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1704,17 +5475,17 @@ func (m *IncentivePlanParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IncentivePlanParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: Candle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IncentivePlanParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Candle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTimeAfterSettlement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1724,30 +5495,29 @@ func (m *IncentivePlanParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIro
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIro
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.StartTimeAfterSettlement, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochsPaidOver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
 			}
-			m.NumEpochsPaidOver = 0
+			m.Resolution = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1757,66 +5527,16 @@ func (m *IncentivePlanParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumEpochsPaidOver |= uint64(b&0x7F) << shift
+				m.Resolution |= CandleResolution(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipIro(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthIro
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *IROVestingPlan) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowIro
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IROVestingPlan: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IROVestingPlan: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1826,29 +5546,28 @@ func (m *IROVestingPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthIro
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthIro
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1876,15 +5595,15 @@ func (m *IROVestingPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Claimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Open.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1894,30 +5613,31 @@ func (m *IROVestingPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIro
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIro
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VestingDuration, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.High.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTimeAfterSettlement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1927,30 +5647,31 @@ func (m *IROVestingPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIro
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIro
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.StartTimeAfterSettlement, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Low.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Close", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1960,30 +5681,31 @@ func (m *IROVestingPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIro
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIro
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Close.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1993,22 +5715,23 @@ func (m *IROVestingPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIro
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIro
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
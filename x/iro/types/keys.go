@@ -1,6 +1,11 @@
 package types
 
-import fmt "fmt"
+import (
+	fmt "fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	// ModuleName defines the module name
@@ -28,6 +33,18 @@ var (
 
 	// ParamsKey is the key to retrieve the module parameters
 	ParamsKey = []byte{0x4} // params
+
+	// PresaleAllocationKeyPrefix is the prefix to retrieve presale allocations by plan ID and address
+	PresaleAllocationKeyPrefix = []byte{0x5} // prefix/planId/address
+
+	// PurchaseKeyPrefix is the prefix to retrieve the amount bought by plan ID and address
+	PurchaseKeyPrefix = []byte{0x6} // prefix/planId/address
+
+	// CandleKeyPrefix is the prefix to retrieve price candles by plan ID, resolution and start time
+	CandleKeyPrefix = []byte{0x7} // prefix/planId/resolution/startTime
+
+	// ReferralKeyPrefix is the prefix to retrieve referrals by plan ID and referrer
+	ReferralKeyPrefix = []byte{0x8} // prefix/planId/referrer
 )
 
 /* --------------------- specific plan ID keys -------------------- */
@@ -40,3 +57,34 @@ func PlansByRollappKey(rollappId string) []byte {
 	rollappIdBytes := []byte(rollappId)
 	return []byte(fmt.Sprintf("%s%s%s", PlansByRollappKeyPrefix, KeySeparator, rollappIdBytes))
 }
+
+/* ----------------------- presale allocation keys ---------------------- */
+func PresaleAllocationKey(planId, address string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s%s", PresaleAllocationKeyPrefix, KeySeparator, planId, KeySeparator, address))
+}
+
+/* --------------------------- purchase keys --------------------------- */
+func PurchaseKey(planId, address string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s%s", PurchaseKeyPrefix, KeySeparator, planId, KeySeparator, address))
+}
+
+/* ---------------------------- candle keys ---------------------------- */
+// CandlesKeyPrefix returns the prefix of the candles of a plan at a resolution
+func CandlesKeyPrefix(planId string, resolution CandleResolution) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s%d%s", CandleKeyPrefix, KeySeparator, planId, KeySeparator, resolution, KeySeparator))
+}
+
+// CandleKey returns the key of a candle. The start time is big endian encoded so candles are ordered by time.
+func CandleKey(planId string, resolution CandleResolution, start time.Time) []byte {
+	return append(CandlesKeyPrefix(planId, resolution), sdk.Uint64ToBigEndian(uint64(start.Unix()))...)
+}
+
+/* --------------------------- referral keys --------------------------- */
+// ReferralsKeyPrefix returns the prefix of the referrals of a plan
+func ReferralsKeyPrefix(planId string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s", ReferralKeyPrefix, KeySeparator, planId, KeySeparator))
+}
+
+func ReferralKey(planId, referrer string) []byte {
+	return append(ReferralsKeyPrefix(planId), []byte(referrer)...)
+}
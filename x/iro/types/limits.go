@@ -0,0 +1,84 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewTradingLimits returns trading limits with a clean per-block state
+func NewTradingLimits(maxPerAddress, maxPerBlock math.Int, rampHours uint64) TradingLimits {
+	return TradingLimits{
+		MaxPerAddress: maxPerAddress,
+		MaxPerBlock:   maxPerBlock,
+		RampHours:     rampHours,
+		BlockBought:   math.ZeroInt(),
+	}
+}
+
+// HasMaxPerAddress returns true if the amount each address can buy is limited
+func (l TradingLimits) HasMaxPerAddress() bool {
+	return !l.MaxPerAddress.IsNil() && l.MaxPerAddress.IsPositive()
+}
+
+// HasMaxPerBlock returns true if the amount bought per block is limited
+func (l TradingLimits) HasMaxPerBlock() bool {
+	return !l.MaxPerBlock.IsNil() && l.MaxPerBlock.IsPositive()
+}
+
+// AddressCap returns the amount a single address can buy in total at the given time.
+// Zero means no limit.
+func (l TradingLimits) AddressCap(start, now time.Time) math.Int {
+	if !l.HasMaxPerAddress() {
+		return math.ZeroInt()
+	}
+	if l.RampHours == 0 || now.Before(start) {
+		return l.MaxPerAddress
+	}
+
+	hour := uint64(now.Sub(start) / time.Hour)
+	if hour+1 >= l.RampHours {
+		return l.MaxPerAddress
+	}
+	return l.MaxPerAddress.Mul(math.NewIntFromUint64(hour + 1)).Quo(math.NewIntFromUint64(l.RampHours))
+}
+
+// BoughtInBlock returns the amount bought at the given height
+func (l TradingLimits) BoughtInBlock(height int64) math.Int {
+	if l.BlockHeight != height || l.BlockBought.IsNil() {
+		return math.ZeroInt()
+	}
+	return l.BlockBought
+}
+
+func (l TradingLimits) ValidateBasic() error {
+	if !l.MaxPerAddress.IsNil() && l.MaxPerAddress.IsNegative() {
+		return fmt.Errorf("max per address cannot be negative: %s", l.MaxPerAddress)
+	}
+	if !l.MaxPerBlock.IsNil() && l.MaxPerBlock.IsNegative() {
+		return fmt.Errorf("max per block cannot be negative: %s", l.MaxPerBlock)
+	}
+	if l.RampHours > 0 && !l.HasMaxPerAddress() {
+		return errors.New("ramp requires max per address")
+	}
+	if l.BlockHeight < 0 {
+		return fmt.Errorf("block height cannot be negative: %d", l.BlockHeight)
+	}
+	if !l.BlockBought.IsNil() && l.BlockBought.IsNegative() {
+		return fmt.Errorf("block bought cannot be negative: %s", l.BlockBought)
+	}
+	return nil
+}
+
+func (p Purchase) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(p.Address); err != nil {
+		return fmt.Errorf("invalid purchase address: %s: %w", p.Address, err)
+	}
+	if p.Amount.IsNil() || p.Amount.IsNegative() {
+		return fmt.Errorf("purchase amount must be non-negative: %s", p.Amount)
+	}
+	return nil
+}
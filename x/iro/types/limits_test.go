@@ -0,0 +1,22 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTradingLimitsAddressCap(t *testing.T) {
+	start := time.Unix(0, 0)
+	limits := NewTradingLimits(math.NewInt(100), math.ZeroInt(), 4)
+
+	require.Equal(t, math.NewInt(25), limits.AddressCap(start, start))
+	require.Equal(t, math.NewInt(50), limits.AddressCap(start, start.Add(time.Hour)))
+	require.Equal(t, math.NewInt(75), limits.AddressCap(start, start.Add(3*time.Hour-time.Second)))
+	require.Equal(t, math.NewInt(100), limits.AddressCap(start, start.Add(3*time.Hour)))
+
+	noLimit := NewTradingLimits(math.ZeroInt(), math.ZeroInt(), 0)
+	require.True(t, noLimit.AddressCap(start, start).IsZero())
+}
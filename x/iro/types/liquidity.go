@@ -1,20 +1,27 @@
 package types
 
-import "cosmossdk.io/math"
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
 
 // CalcLiquidityPoolTokens determines the tokens and liquidity to be used for bootstrapping the liquidity pool.
 //
 // This function calculates the required liquidity based on the settled token price and compares it with the raised liquidity.
 // It returns the amount of RA tokens and liquidity to be used for bootstrapping the liquidity pool so it fulfills the last price.
 // We expect all the raised liquidity to be used for liquidity pool, so incentives will consist of the remaining tokens.s
-func CalcLiquidityPoolTokens(unsoldRATokens, raisedLiquidity math.Int, settledTokenPrice math.LegacyDec) (RATokens, liquidity math.Int) {
-	requiredLiquidity := settledTokenPrice.MulInt(unsoldRATokens).TruncateInt()
+//
+// The balancer spot price is (liquidity / liquidityWeight) / (RATokens / rollappWeight), so for non-equal weights
+// the ratio of liquidity to RA tokens is scaled by liquidityWeight / rollappWeight to keep the last price.
+func CalcLiquidityPoolTokens(unsoldRATokens, raisedLiquidity math.Int, settledTokenPrice math.LegacyDec, rollappWeight, liquidityWeight math.Int) (RATokens, liquidity math.Int) {
+	poolRatio := settledTokenPrice.MulInt(liquidityWeight).QuoInt(rollappWeight)
+	requiredLiquidity := poolRatio.MulInt(unsoldRATokens).TruncateInt()
 
 	// if raisedLiquidity is less than requiredLiquidity, than liquidity is the limiting factor
 	// we use all the raisedLiquidity, and the corresponding amount of tokens
 	if raisedLiquidity.LT(requiredLiquidity) {
 		liquidity = raisedLiquidity
-		RATokens = raisedLiquidity.ToLegacyDec().Quo(settledTokenPrice).TruncateInt()
+		RATokens = raisedLiquidity.ToLegacyDec().Quo(poolRatio).TruncateInt()
 	} else {
 		// if raisedLiquidity is more than requiredLiquidity, than tokens are the limiting factor
 		// we use all the unsold tokens, and the corresponding amount of liquidity
@@ -36,7 +43,9 @@ func CalcLiquidityPoolTokens(unsoldRATokens, raisedLiquidity math.Int, settledTo
 
 // Find the max selling amt such that the price of the liquidity pool is is equal to the last spot price of the bonding curve
 //
-// Assuming c=0 (enforced in curve validation):
+// Power curves with c=0 or m=0 have a closed form solution, other curves are solved numerically.
+//
+// Assuming c=0:
 //
 //	    Define SpotIRO(x)=mx^n
 //	    Define RaisedLiquidity(x)=(mx^(n+1))/(n+1) [by integral]
@@ -51,7 +60,16 @@ func CalcLiquidityPoolTokens(unsoldRATokens, raisedLiquidity math.Int, settledTo
 //		SpotPool(x)=(r*cx)/(totalAllocation-x)
 //		Solve SpotIRO=SpotPool [cancel c terms and rearrange linear eq]
 //	 => x=totalAllocation/(r+1) [same as above calculation but n=0]
-func FindEquilibrium(curve BondingCurve, totalAllocation math.Int, r math.LegacyDec) math.Int {
+func FindEquilibrium(curve BondingCurve, totalAllocation math.Int, r math.LegacyDec) (math.Int, error) {
+	if curve.CurveType != CURVE_TYPE_POWER || (!curve.M.IsZero() && !curve.C.IsZero()) {
+		t := ScaleFromBase(totalAllocation, curve.SupplyDecimals())
+		eq, err := bisectEquilibrium(curve.function(), t, r)
+		if err != nil {
+			return math.Int{}, errorsmod.Wrap(ErrInvalidBondingCurve, err.Error())
+		}
+		return ScaleToBase(eq, curve.SupplyDecimals()), nil
+	}
+
 	n := curve.N
 
 	if curve.M.IsZero() { // c is allowed to be non-zero
@@ -62,5 +80,5 @@ func FindEquilibrium(curve BondingCurve, totalAllocation math.Int, r math.Legacy
 	n2 := n1.Add(r)                                          // N + 1 + R
 	eq := (n1.Quo(n2)).MulInt(totalAllocation).TruncateInt() // ((N+1) / (N+1+R)) * T
 
-	return eq
+	return eq, nil
 }
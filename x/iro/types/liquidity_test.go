@@ -70,7 +70,8 @@ func TestFindEquilibrium(t *testing.T) {
 
 				curve := types.NewBondingCurve(calcaulateM, tc.n, sdkmath.LegacyZeroDec(), 18, 18)
 				// assert eq is > 0
-				eq := types.FindEquilibrium(curve, allocationScaled, r)
+				eq, err := types.FindEquilibrium(curve, allocationScaled, r)
+				require.NoError(t, err)
 				require.True(t, eq.IsPositive())
 
 				actualRaised := curve.Cost(sdkmath.ZeroInt(), eq)
@@ -84,7 +85,7 @@ func TestFindEquilibrium(t *testing.T) {
 				leftoverTokens := allocationScaled.Sub(eq)
 				poolPrice := bootstrapFunds.ToLegacyDec().QuoInt(leftoverTokens)
 
-				err := approxEqualRatio(curvePrice, poolPrice, 0.001) // 0.1%
+				err = approxEqualRatio(curvePrice, poolPrice, 0.001) // 0.1%
 				require.NoError(t, err)
 
 				unsoldValue := curvePrice.MulInt(leftoverTokens).TruncateInt()
@@ -11,11 +11,15 @@ import (
 
 var (
 	_ sdk.Msg = &MsgCreatePlan{}
+	_ sdk.Msg = &MsgUpdatePlan{}
 	_ sdk.Msg = &MsgBuy{}
 	_ sdk.Msg = &MsgBuyExactSpend{}
+	_ sdk.Msg = &MsgBuyExactSpendWithSwap{}
 	_ sdk.Msg = &MsgSell{}
 	_ sdk.Msg = &MsgClaim{}
 	_ sdk.Msg = &MsgClaimVested{}
+	_ sdk.Msg = &MsgRefund{}
+	_ sdk.Msg = &MsgClaimTeamAllocation{}
 	_ sdk.Msg = &MsgEnableTrading{}
 	_ sdk.Msg = &MsgUpdateParams{}
 )
@@ -38,6 +42,9 @@ func (m *MsgCreatePlan) ValidateBasic() error {
 	if !allocationDec.GT(MinTokenAllocation) {
 		return ErrInvalidAllocation
 	}
+	if err := m.BondingCurve.ValidateSupply(allocationDec); err != nil {
+		return err
+	}
 
 	if m.IroPlanDuration < 0 {
 		return ErrInvalidEndTime
@@ -67,6 +74,62 @@ func (m *MsgCreatePlan) ValidateBasic() error {
 	if sdk.ValidateDenom(m.LiquidityDenom) != nil {
 		return fmt.Errorf("invalid liquidity denom: %s", m.LiquidityDenom)
 	}
+
+	if err := m.Presale.ValidateBasic(); err != nil {
+		return errors.Join(ErrInvalidPresale, err)
+	}
+	if err := m.Presale.ValidateAdmission(); err != nil {
+		return errors.Join(ErrInvalidPresale, err)
+	}
+
+	if err := m.TradingLimits.ValidateBasic(); err != nil {
+		return errors.Join(ErrInvalidTradingLimits, err)
+	}
+
+	if err := m.PoolParams.ValidateBasic(); err != nil {
+		return errors.Join(ErrInvalidPoolParams, err)
+	}
+
+	if err := ValidateTeamAllocations(m.TeamAllocations); err != nil {
+		return errors.Join(ErrInvalidTeamAllocation, err)
+	}
+	if teamAmt := TeamAllocationsTotal(m.TeamAllocations); teamAmt.GTE(m.AllocatedAmount) {
+		return errors.Join(ErrInvalidTeamAllocation, fmt.Errorf("team allocations must be less than the allocated amount: %s >= %s", teamAmt, m.AllocatedAmount))
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation checks on the MsgUpdatePlan message.
+// The allocation dependent checks are done against the stored plan.
+func (m *MsgUpdatePlan) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+
+	if err := m.BondingCurve.ValidateBasic(); err != nil {
+		return errors.Join(ErrInvalidBondingCurve, err)
+	}
+
+	if m.IroPlanDuration < 0 {
+		return ErrInvalidEndTime
+	}
+
+	if err := m.IncentivePlanParams.ValidateBasic(); err != nil {
+		return errors.Join(ErrInvalidIncentivePlanParams, err)
+	}
+
+	if m.LiquidityPart.IsNil() || m.LiquidityPart.IsNegative() || m.LiquidityPart.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("liquidity part must be positive: %s", m.LiquidityPart)
+	}
+
+	if m.VestingDuration < 0 {
+		return fmt.Errorf("vesting duration must be non-negative: %v", m.VestingDuration)
+	}
+
+	if m.VestingStartTimeAfterSettlement < 0 {
+		return fmt.Errorf("vesting start time after settlement must be non-negative: %v", m.VestingStartTimeAfterSettlement)
+	}
 	return nil
 }
 
@@ -86,7 +149,11 @@ func (m *MsgBuy) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrapf("expected out amount %v must be positive", m.MaxCostAmount)
 	}
 
-	return nil
+	if err := ValidateReferrer(m.Referrer, m.Buyer); err != nil {
+		return errors.Join(sdkerrors.ErrInvalidRequest, err)
+	}
+
+	return m.PresaleProof.ValidateBasic()
 }
 
 func (m *MsgSell) ValidateBasic() error {
@@ -105,6 +172,10 @@ func (m *MsgSell) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrapf("expected out amount %v must be positive", m.MinIncomeAmount)
 	}
 
+	if err := ValidateReferrer(m.Referrer, m.Seller); err != nil {
+		return errors.Join(sdkerrors.ErrInvalidRequest, err)
+	}
+
 	return nil
 }
 
@@ -129,6 +200,28 @@ func (m *MsgClaimVested) ValidateBasic() error {
 	return nil
 }
 
+// ValidateBasic implements types.Msg.
+func (m *MsgRefund) ValidateBasic() error {
+	// refunder bech32
+	_, err := sdk.AccAddressFromBech32(m.Refunder)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid refunder address: %s", err)
+	}
+
+	return nil
+}
+
+// ValidateBasic implements types.Msg.
+func (m *MsgClaimTeamAllocation) ValidateBasic() error {
+	// beneficiary bech32
+	_, err := sdk.AccAddressFromBech32(m.Beneficiary)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid beneficiary address: %s", err)
+	}
+
+	return nil
+}
+
 func (m *MsgUpdateParams) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(m.Authority)
 	if err != nil {
@@ -163,7 +256,38 @@ func (m *MsgBuyExactSpend) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrapf("expected out amount %v must be positive", m.MinOutTokensAmount)
 	}
 
-	return nil
+	if err := ValidateReferrer(m.Referrer, m.Buyer); err != nil {
+		return errors.Join(sdkerrors.ErrInvalidRequest, err)
+	}
+
+	return m.PresaleProof.ValidateBasic()
+}
+
+func (m *MsgBuyExactSpendWithSwap) ValidateBasic() error {
+	// buyer bech32
+	_, err := sdk.AccAddressFromBech32(m.Buyer)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid buyer address: %s", err)
+	}
+
+	// coin exist and valid
+	if !m.TokenIn.IsValid() || !m.TokenIn.IsPositive() {
+		return sdkerrors.ErrInvalidRequest.Wrapf("token in %v must be positive", m.TokenIn)
+	}
+
+	if err := ValidateSwapRoutes(m.Routes, m.TokenIn.Denom); err != nil {
+		return errors.Join(sdkerrors.ErrInvalidRequest, err)
+	}
+
+	if m.MinOutTokensAmount.IsNil() || !m.MinOutTokensAmount.IsPositive() {
+		return sdkerrors.ErrInvalidRequest.Wrapf("expected out amount %v must be positive", m.MinOutTokensAmount)
+	}
+
+	if err := ValidateReferrer(m.Referrer, m.Buyer); err != nil {
+		return errors.Join(sdkerrors.ErrInvalidRequest, err)
+	}
+
+	return m.PresaleProof.ValidateBasic()
 }
 
 func (m *MsgEnableTrading) ValidateBasic() error {
@@ -175,3 +299,14 @@ func (m *MsgEnableTrading) ValidateBasic() error {
 
 	return nil
 }
+
+// ValidateBasic validates an optional presale proof
+func (p *PresaleProof) ValidateBasic() error {
+	if p == nil {
+		return nil
+	}
+	if p.Cap.IsNil() || !p.Cap.IsPositive() {
+		return sdkerrors.ErrInvalidRequest.Wrapf("presale cap %v must be positive", p.Cap)
+	}
+	return nil
+}
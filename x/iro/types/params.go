@@ -18,6 +18,16 @@ var (
 	DefaultMinLiquidityPart                             = "0.4"                       // default: at least 40% goes to the liquidity pool
 	DefaultMinVestingDuration                           = 7 * 24 * time.Hour          // default: min 7 days
 	DefaultMinVestingStartTimeAfterSettlement           = 0 * time.Minute             // default: no enforced minimum by default
+	DefaultMaxCurveBreakpoints                          = uint64(16)                  // default: up to 16 breakpoints for piecewise-linear curves
+	DefaultSettlementGracePeriod                        = 90 * 24 * time.Hour         // default: 90 days after pre-launch to settle
+	DefaultCandleRetention                              = uint64(1440)                // default: a day of minute candles
+	DefaultMaxPoolWeight                                = "0.8"                       // default: up to 80/20 pools
+	DefaultMinPoolSwapFee                               = "0.001"                     // default: min 0.1% custom swap fee
+	DefaultMaxPoolSwapFee                               = "0.05"                      // default: max 5% custom swap fee
+	DefaultReferralFeeShare                             = "0.2"                       // default: 20% of the taker fee goes to the referrer
+
+	// MaxCandleRetention bounds the candle retention so the retention window of daily candles fits in a time.Duration
+	MaxCandleRetention = uint64(100_000)
 )
 
 // NewParams creates a new Params object
@@ -45,6 +55,13 @@ func DefaultParams() Params {
 		MinLiquidityPart:                      math.LegacyMustNewDecFromStr(DefaultMinLiquidityPart),
 		MinVestingDuration:                    DefaultMinVestingDuration,
 		MinVestingStartTimeAfterSettlement:    DefaultMinVestingStartTimeAfterSettlement,
+		MaxCurveBreakpoints:                   DefaultMaxCurveBreakpoints,
+		SettlementGracePeriod:                 DefaultSettlementGracePeriod,
+		CandleRetention:                       DefaultCandleRetention,
+		MaxPoolWeight:                         math.LegacyMustNewDecFromStr(DefaultMaxPoolWeight),
+		MinPoolSwapFee:                        math.LegacyMustNewDecFromStr(DefaultMinPoolSwapFee),
+		MaxPoolSwapFee:                        math.LegacyMustNewDecFromStr(DefaultMaxPoolSwapFee),
+		ReferralFeeShare:                      math.LegacyMustNewDecFromStr(DefaultReferralFeeShare),
 	}
 }
 
@@ -78,6 +95,22 @@ func (p Params) ValidateBasic() error {
 		return fmt.Errorf("minimum vesting duration must be non-negative: %v", p.MinVestingDuration)
 	}
 
+	if p.SettlementGracePeriod < 0 {
+		return fmt.Errorf("settlement grace period must be non-negative: %v", p.SettlementGracePeriod)
+	}
+
+	if p.CandleRetention > MaxCandleRetention {
+		return fmt.Errorf("candle retention must be at most %d: %d", MaxCandleRetention, p.CandleRetention)
+	}
+
+	if err := validatePoolBounds(p.MaxPoolWeight, p.MinPoolSwapFee, p.MaxPoolSwapFee); err != nil {
+		return err
+	}
+
+	if err := validateReferralFeeShare(p.ReferralFeeShare); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -93,6 +126,43 @@ func validateTakerFee(v math.LegacyDec) error {
 	return nil
 }
 
+// validatePoolBounds validates the bounds of the settlement pool params. Unset bounds disable the
+// matching custom pool params.
+func validatePoolBounds(maxWeight, minSwapFee, maxSwapFee math.LegacyDec) error {
+	if !maxWeight.IsNil() && !maxWeight.IsZero() {
+		if maxWeight.LT(math.LegacyNewDecWithPrec(5, 1)) || maxWeight.GT(MaxPoolWeight) {
+			return fmt.Errorf("max pool weight must be zero or between 0.5 and %s: %s", MaxPoolWeight, maxWeight)
+		}
+	}
+
+	if !minSwapFee.IsNil() && minSwapFee.IsNegative() {
+		return fmt.Errorf("min pool swap fee must be non-negative: %s", minSwapFee)
+	}
+
+	if !maxSwapFee.IsNil() {
+		if maxSwapFee.IsNegative() || maxSwapFee.GTE(math.LegacyOneDec()) {
+			return fmt.Errorf("max pool swap fee must be in [0, 1): %s", maxSwapFee)
+		}
+		if !minSwapFee.IsNil() && !maxSwapFee.IsZero() && minSwapFee.GT(maxSwapFee) {
+			return fmt.Errorf("min pool swap fee must not exceed the max pool swap fee: %s > %s", minSwapFee, maxSwapFee)
+		}
+	}
+
+	return nil
+}
+
+// validateReferralFeeShare validates the referrer's share of the taker fee. It's kept below 1 so a part
+// of the fee always goes to the txfees module.
+func validateReferralFeeShare(v math.LegacyDec) error {
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() || v.GTE(math.LegacyOneDec()) {
+		return fmt.Errorf("referral fee share must be in [0, 1): %s", v)
+	}
+	return nil
+}
+
 func validateCreationFee(v math.Int) error {
 	// creation fee must be a positive integer greater than 1^18 (1 Rollapp token)
 	if v.LT(math.NewIntWithDecimal(1, 18)) {
@@ -53,6 +53,28 @@ type Params struct {
 	MinVestingDuration time.Duration               `protobuf:"bytes,7,opt,name=min_vesting_duration,json=minVestingDuration,proto3,stdduration" json:"min_vesting_duration"`
 	// Minimum start time after settlement to start vesting
 	MinVestingStartTimeAfterSettlement time.Duration `protobuf:"bytes,8,opt,name=min_vesting_start_time_after_settlement,json=minVestingStartTimeAfterSettlement,proto3,stdduration" json:"min_vesting_start_time_after_settlement"`
+	// Maximum number of breakpoints of a piecewise-linear bonding curve. Zero
+	// disables piecewise-linear curves.
+	MaxCurveBreakpoints uint64 `protobuf:"varint,9,opt,name=max_curve_breakpoints,json=maxCurveBreakpoints,proto3" json:"max_curve_breakpoints,omitempty"`
+	// The time after the pre-launch time within which the plan must be settled.
+	// Past this deadline an unsettled plan is failed and buyers can refund their
+	// IRO tokens. Zero disables the deadline for new plans.
+	SettlementGracePeriod time.Duration `protobuf:"bytes,10,opt,name=settlement_grace_period,json=settlementGracePeriod,proto3,stdduration" json:"settlement_grace_period"`
+	// The number of buckets of price candles kept per plan at each resolution.
+	// Older candles are pruned. Zero disables recording candles.
+	CandleRetention uint64 `protobuf:"varint,11,opt,name=candle_retention,json=candleRetention,proto3" json:"candle_retention,omitempty"`
+	// The maximum normalized weight of either asset of the pool bootstrapped on
+	// settlement, e.g. 0.8 allows up to 80/20 pools. Zero only allows equal
+	// weights.
+	MaxPoolWeight cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=max_pool_weight,json=maxPoolWeight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_pool_weight"`
+	// The bounds of a custom swap fee of the pool bootstrapped on settlement. A
+	// zero max only allows the global gamm swap fee.
+	MinPoolSwapFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,13,opt,name=min_pool_swap_fee,json=minPoolSwapFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_pool_swap_fee"`
+	MaxPoolSwapFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,14,opt,name=max_pool_swap_fee,json=maxPoolSwapFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_pool_swap_fee"`
+	// The share of the taker fee paid to the referrer of a trade. Zero disables
+	// referral fees. Referrers don't need to register, so a trader can refer
+	// itself from a second address: the share is also the max taker fee discount.
+	ReferralFeeShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,15,opt,name=referral_fee_share,json=referralFeeShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"referral_fee_share"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -123,6 +145,27 @@ func (m *Params) GetMinVestingStartTimeAfterSettlement() time.Duration {
 	return 0
 }
 
+func (m *Params) GetMaxCurveBreakpoints() uint64 {
+	if m != nil {
+		return m.MaxCurveBreakpoints
+	}
+	return 0
+}
+
+func (m *Params) GetSettlementGracePeriod() time.Duration {
+	if m != nil {
+		return m.SettlementGracePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetCandleRetention() uint64 {
+	if m != nil {
+		return m.CandleRetention
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.iro.Params")
 }
@@ -132,41 +175,52 @@ func init() {
 }
 
 var fileDescriptor_321dd4e17bb4cbec = []byte{
-	// 538 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xc1, 0x6e, 0xd3, 0x4e,
-	0x10, 0xc6, 0xe3, 0xff, 0xbf, 0x2d, 0xad, 0x8b, 0x04, 0x58, 0x45, 0x4a, 0x83, 0xe4, 0x44, 0x45,
-	0xa8, 0x11, 0x08, 0x2f, 0xa1, 0x4f, 0x40, 0x14, 0x90, 0x0a, 0x25, 0x8d, 0x52, 0xe0, 0xc0, 0x65,
-	0xb5, 0xb1, 0x27, 0xce, 0xaa, 0xd9, 0x5d, 0xb3, 0x3b, 0xb6, 0x12, 0x0e, 0x3c, 0x03, 0x47, 0x1e,
-	0x84, 0x87, 0xe8, 0xb1, 0xe2, 0x84, 0x38, 0x14, 0x94, 0xbc, 0x04, 0x47, 0x64, 0x3b, 0x4e, 0x42,
-	0x51, 0x51, 0xc4, 0x6d, 0x47, 0xf3, 0xcd, 0xef, 0x1b, 0x7d, 0xa3, 0xb5, 0xf7, 0x83, 0xb1, 0x00,
-	0x69, 0xb8, 0x92, 0xa3, 0xf1, 0x7b, 0x32, 0x2f, 0x08, 0xd7, 0x8a, 0x44, 0x4c, 0x33, 0x61, 0xbc,
-	0x48, 0x2b, 0x54, 0x4e, 0x65, 0x59, 0xe8, 0xcd, 0x0b, 0x8f, 0x6b, 0x55, 0xd9, 0x09, 0x55, 0xa8,
-	0x32, 0x19, 0x49, 0x5f, 0xf9, 0x44, 0xa5, 0x1a, 0x2a, 0x15, 0x0e, 0x81, 0x64, 0x55, 0x2f, 0xee,
-	0x13, 0xe4, 0x02, 0x0c, 0x32, 0x11, 0xcd, 0x04, 0xee, 0x65, 0x41, 0x10, 0x6b, 0x86, 0x29, 0x74,
-	0xd6, 0xf7, 0x95, 0x11, 0xca, 0x90, 0x1e, 0x33, 0x40, 0x92, 0x46, 0x0f, 0x90, 0x35, 0x88, 0xaf,
-	0x78, 0xd1, 0xdf, 0xcd, 0xfb, 0x34, 0x77, 0xce, 0x8b, 0xbc, 0xb5, 0xf7, 0x73, 0xdd, 0xde, 0xe8,
-	0x64, 0xeb, 0x3b, 0x6d, 0x7b, 0x0b, 0xd9, 0x29, 0x68, 0xda, 0x07, 0x28, 0x5b, 0x35, 0xab, 0xbe,
-	0xd5, 0x6c, 0x9c, 0x5d, 0x54, 0x4b, 0xdf, 0x2e, 0xaa, 0x77, 0xf2, 0x19, 0x13, 0x9c, 0x7a, 0x5c,
-	0x11, 0xc1, 0x70, 0xe0, 0x1d, 0x41, 0xc8, 0xfc, 0x71, 0x0b, 0xfc, 0x2f, 0x9f, 0x1f, 0xda, 0x33,
-	0x64, 0x0b, 0xfc, 0xee, 0x66, 0xc6, 0x78, 0x06, 0xe0, 0xb4, 0xed, 0xeb, 0xbe, 0x86, 0x6c, 0xcf,
-	0x0c, 0xf9, 0x5f, 0x86, 0x7c, 0x30, 0x43, 0xde, 0xfe, 0x13, 0x79, 0x28, 0x71, 0x09, 0x76, 0x28,
-	0xb1, 0xbb, 0x5d, 0x00, 0x52, 0xde, 0xb1, 0x7d, 0x4b, 0x70, 0x49, 0xa3, 0x21, 0x93, 0xb4, 0x08,
-	0xa0, 0xfc, 0x7f, 0xcd, 0xaa, 0x6f, 0x3f, 0xde, 0xf5, 0xf2, 0x84, 0xbc, 0x22, 0x21, 0xaf, 0x35,
-	0x13, 0x34, 0x37, 0x53, 0xbf, 0x4f, 0xdf, 0xab, 0x56, 0xf7, 0x86, 0xe0, 0xb2, 0x33, 0x64, 0xb2,
-	0x68, 0x39, 0x1f, 0xec, 0xfb, 0x5c, 0xfa, 0x20, 0x91, 0x27, 0x60, 0x68, 0xca, 0x36, 0xc8, 0x34,
-	0xd2, 0x34, 0x7e, 0xca, 0xfa, 0x08, 0x9a, 0x1a, 0x40, 0x1c, 0x82, 0x00, 0x89, 0xe5, 0xb5, 0xd5,
-	0x9d, 0xee, 0x2d, 0xb0, 0x2f, 0xb9, 0x3c, 0x49, 0xa1, 0xaf, 0xb8, 0x80, 0x27, 0x29, 0xf2, 0x64,
-	0x4e, 0x74, 0x5e, 0xd8, 0x77, 0x2f, 0xf9, 0xcb, 0x58, 0x50, 0x88, 0x94, 0x3f, 0x30, 0x34, 0x62,
-	0x3c, 0xa0, 0x2a, 0x01, 0x5d, 0x5e, 0xaf, 0x59, 0xf5, 0xb5, 0xae, 0xfb, 0x1b, 0xb3, 0x1d, 0x8b,
-	0xa7, 0x99, 0xae, 0xc3, 0x78, 0x70, 0x9c, 0x80, 0x76, 0xa8, 0xed, 0xa4, 0x84, 0x21, 0x7f, 0x17,
-	0xf3, 0x80, 0xe3, 0x98, 0x46, 0x4c, 0x63, 0x79, 0xe3, 0x5f, 0xcf, 0x78, 0x53, 0x70, 0x79, 0x54,
-	0xb0, 0x3a, 0x4c, 0xa3, 0xf3, 0xda, 0xde, 0x49, 0x0d, 0x12, 0x30, 0xc8, 0x65, 0xb8, 0xb8, 0xc0,
-	0xb5, 0xd5, 0x73, 0x49, 0x37, 0x7c, 0x93, 0xcf, 0xcf, 0x8f, 0x30, 0xb2, 0xf7, 0x97, 0xb1, 0x7f,
-	0xbb, 0xc0, 0xe6, 0xea, 0x4e, 0x7b, 0x0b, 0xa7, 0xab, 0xe2, 0x6f, 0x3e, 0x3f, 0x9b, 0xb8, 0xd6,
-	0xf9, 0xc4, 0xb5, 0x7e, 0x4c, 0x5c, 0xeb, 0xe3, 0xd4, 0x2d, 0x9d, 0x4f, 0xdd, 0xd2, 0xd7, 0xa9,
-	0x5b, 0x7a, 0xfb, 0x28, 0xe4, 0x38, 0x88, 0x7b, 0x9e, 0xaf, 0x04, 0xb9, 0xe2, 0xdb, 0x27, 0x07,
-	0x64, 0x94, 0xfd, 0x7d, 0x1c, 0x47, 0x60, 0x7a, 0x1b, 0xd9, 0x32, 0x07, 0xbf, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x8d, 0x37, 0x2d, 0x84, 0x26, 0x04, 0x00, 0x00,
+	// 708 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0xd5, 0xdd, 0x4e, 0xdb, 0x48,
+	0x14, 0x00, 0xe0, 0x78, 0x97, 0x65, 0x61, 0xf8, 0x09, 0xeb, 0x05, 0xad, 0x61, 0x25, 0x07, 0xb1,
+	0x5a, 0xc1, 0xee, 0x6a, 0xed, 0x05, 0x9e, 0x60, 0x53, 0x4a, 0x45, 0x4b, 0x21, 0x4a, 0xfa, 0xa3,
+	0xfe, 0x48, 0xa3, 0x89, 0x7d, 0xe2, 0x8c, 0xf0, 0xcc, 0xb8, 0x33, 0x93, 0xe0, 0xf4, 0xa2, 0xcf,
+	0xd0, 0xcb, 0x3e, 0x44, 0x2f, 0xfb, 0x10, 0x5c, 0xa2, 0x5e, 0x55, 0xbd, 0xa0, 0x15, 0xbc, 0x48,
+	0x35, 0x76, 0x9c, 0xa4, 0x54, 0x54, 0x51, 0xee, 0x32, 0x3e, 0xc7, 0xdf, 0x39, 0x3a, 0x27, 0x23,
+	0xa3, 0xcd, 0xb0, 0xc7, 0x80, 0x2b, 0x2a, 0x78, 0xda, 0x7b, 0xe9, 0x0f, 0x0e, 0x3e, 0x95, 0xc2,
+	0x4f, 0x88, 0x24, 0x4c, 0x79, 0x89, 0x14, 0x5a, 0xd8, 0x6b, 0xa3, 0x89, 0xde, 0xe0, 0xe0, 0x51,
+	0x29, 0xd6, 0x96, 0x23, 0x11, 0x89, 0x2c, 0xcd, 0x37, 0xbf, 0xf2, 0x37, 0xd6, 0x2a, 0x91, 0x10,
+	0x51, 0x0c, 0x7e, 0x76, 0x6a, 0x76, 0x5a, 0xbe, 0xa6, 0x0c, 0x94, 0x26, 0x2c, 0xe9, 0x27, 0xb8,
+	0xd7, 0x13, 0xc2, 0x8e, 0x24, 0xda, 0xa0, 0xfd, 0x78, 0x20, 0x14, 0x13, 0xca, 0x6f, 0x12, 0x05,
+	0x7e, 0x77, 0xbb, 0x09, 0x9a, 0x6c, 0xfb, 0x81, 0xa0, 0x45, 0x7c, 0x35, 0x8f, 0xe3, 0xbc, 0x72,
+	0x7e, 0xc8, 0x43, 0x1b, 0x6f, 0x11, 0x9a, 0xae, 0x65, 0xed, 0xdb, 0x47, 0x68, 0x56, 0x93, 0x13,
+	0x90, 0xb8, 0x05, 0xe0, 0x58, 0xeb, 0xd6, 0xd6, 0x6c, 0x75, 0xfb, 0xec, 0xa2, 0x52, 0xfa, 0x78,
+	0x51, 0xf9, 0x3d, 0x7f, 0x47, 0x85, 0x27, 0x1e, 0x15, 0x3e, 0x23, 0xba, 0xed, 0x1d, 0x42, 0x44,
+	0x82, 0xde, 0x1e, 0x04, 0xef, 0xdf, 0xfd, 0x8b, 0xfa, 0xe4, 0x1e, 0x04, 0xf5, 0x99, 0xcc, 0xd8,
+	0x07, 0xb0, 0x8f, 0xd0, 0x7c, 0x20, 0x21, 0xeb, 0x33, 0x23, 0x7f, 0xc8, 0xc8, 0x7f, 0xfa, 0xe4,
+	0xca, 0xb7, 0xe4, 0x01, 0xd7, 0x23, 0xd8, 0x01, 0xd7, 0xf5, 0xb9, 0x02, 0x30, 0xde, 0x31, 0xfa,
+	0x85, 0x51, 0x8e, 0x93, 0x98, 0x70, 0x5c, 0x0c, 0xc0, 0xf9, 0x71, 0xdd, 0xda, 0x9a, 0xdb, 0x59,
+	0xf5, 0xf2, 0x09, 0x79, 0xc5, 0x84, 0xbc, 0xbd, 0x7e, 0x42, 0x75, 0xc6, 0xd4, 0x7b, 0xf3, 0xa9,
+	0x62, 0xd5, 0xcb, 0x8c, 0xf2, 0x5a, 0x4c, 0x78, 0x11, 0xb2, 0x5f, 0xa1, 0xbf, 0x29, 0x0f, 0x80,
+	0x6b, 0xda, 0x05, 0x85, 0x8d, 0xad, 0x34, 0x91, 0x1a, 0x9b, 0xf1, 0x63, 0xd2, 0xd2, 0x20, 0xb1,
+	0x02, 0xad, 0x63, 0x60, 0xc0, 0xb5, 0x33, 0x35, 0x7e, 0xa5, 0x3f, 0x87, 0xec, 0x7d, 0xca, 0x1b,
+	0x06, 0x7d, 0x40, 0x19, 0xfc, 0x6f, 0xc8, 0xc6, 0x40, 0xb4, 0xef, 0xa1, 0x3f, 0xae, 0xd5, 0xe7,
+	0x1d, 0x86, 0x21, 0x11, 0x41, 0x5b, 0xe1, 0x84, 0xd0, 0x10, 0x8b, 0x2e, 0x48, 0xe7, 0xa7, 0x75,
+	0x6b, 0x6b, 0xaa, 0xee, 0x7e, 0x65, 0x1e, 0x75, 0xd8, 0xed, 0x2c, 0xaf, 0x46, 0x68, 0x78, 0xdc,
+	0x05, 0x69, 0x63, 0x64, 0x1b, 0x21, 0xa6, 0x2f, 0x3a, 0x34, 0xa4, 0xba, 0x87, 0x13, 0x22, 0xb5,
+	0x33, 0x3d, 0xe9, 0x1a, 0x97, 0x18, 0xe5, 0x87, 0x85, 0x55, 0x23, 0x52, 0xdb, 0x0f, 0xd1, 0xb2,
+	0x29, 0xd0, 0x05, 0xa5, 0x29, 0x8f, 0x86, 0x1b, 0xf8, 0x79, 0xfc, 0xb9, 0x98, 0x0e, 0x1f, 0xe5,
+	0xef, 0x0f, 0x96, 0x90, 0xa2, 0xcd, 0x51, 0xf6, 0x7b, 0x1b, 0x98, 0x19, 0xbf, 0xd2, 0xc6, 0xb0,
+	0xd2, 0x8d, 0xe3, 0xdf, 0x41, 0x2b, 0x8c, 0xa4, 0x38, 0xe8, 0xc8, 0x2e, 0xe0, 0xa6, 0x04, 0x72,
+	0x92, 0x08, 0xca, 0xb5, 0x72, 0x66, 0xb3, 0x81, 0xff, 0xca, 0x48, 0x7a, 0xcb, 0xc4, 0xaa, 0xc3,
+	0x90, 0xfd, 0x0c, 0xfd, 0x36, 0x6c, 0x08, 0x47, 0x92, 0x04, 0x80, 0x13, 0x90, 0x54, 0x84, 0x0e,
+	0x1a, 0xbf, 0xbb, 0x95, 0xa1, 0x71, 0xc7, 0x10, 0xb5, 0x4c, 0xb0, 0xff, 0x42, 0x4b, 0x01, 0xe1,
+	0x61, 0x0c, 0x58, 0x82, 0x36, 0xbb, 0x16, 0xdc, 0x99, 0xcb, 0x7a, 0x29, 0xe7, 0xcf, 0xeb, 0xc5,
+	0x63, 0xfb, 0x09, 0x2a, 0x9b, 0xde, 0x13, 0x21, 0x62, 0x7c, 0x0a, 0x34, 0x6a, 0x6b, 0x67, 0x7e,
+	0xd2, 0x55, 0x2f, 0x30, 0x92, 0xd6, 0x84, 0x88, 0x1f, 0x67, 0x8e, 0xfd, 0xbc, 0x7f, 0xcd, 0x0c,
+	0xad, 0x4e, 0x49, 0x92, 0xdd, 0xdd, 0x85, 0x49, 0xf1, 0x45, 0x73, 0xe9, 0x84, 0x88, 0x1b, 0xa7,
+	0x24, 0x31, 0x97, 0xd8, 0xe8, 0x24, 0xbd, 0xa6, 0x2f, 0x4e, 0xae, 0x93, 0x74, 0x54, 0xc7, 0xc8,
+	0x96, 0xd0, 0x02, 0x29, 0x49, 0x6c, 0x60, 0xac, 0xda, 0x44, 0x82, 0x53, 0x9e, 0xf8, 0x12, 0x14,
+	0xd8, 0x3e, 0x40, 0xc3, 0x50, 0xd5, 0xbb, 0x67, 0x97, 0xae, 0x75, 0x7e, 0xe9, 0x5a, 0x9f, 0x2f,
+	0x5d, 0xeb, 0xf5, 0x95, 0x5b, 0x3a, 0xbf, 0x72, 0x4b, 0x1f, 0xae, 0xdc, 0xd2, 0xd3, 0xff, 0x22,
+	0xaa, 0xdb, 0x9d, 0xa6, 0x17, 0x08, 0xe6, 0xdf, 0xf0, 0xa9, 0xe8, 0xee, 0xfa, 0x69, 0xf6, 0xbd,
+	0xd0, 0xbd, 0x04, 0x54, 0x73, 0x3a, 0xfb, 0x8b, 0xec, 0x7e, 0x19, 0x00, 0xa8, 0x89, 0x57, 0xdc,
+	0x5a, 0x06, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -189,21 +243,79 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinVestingStartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinVestingStartTimeAfterSettlement):])
+	{
+		size := m.ReferralFeeShare.Size()
+		i -= size
+		if _, err := m.ReferralFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.MaxPoolSwapFee.Size()
+		i -= size
+		if _, err := m.MaxPoolSwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.MinPoolSwapFee.Size()
+		i -= size
+		if _, err := m.MinPoolSwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.MaxPoolWeight.Size()
+		i -= size
+		if _, err := m.MaxPoolWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.CandleRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CandleRetention))
+		i--
+		dAtA[i] = 0x58
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SettlementGracePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SettlementGracePeriod):])
 	if err1 != nil {
 		return 0, err1
 	}
 	i -= n1
 	i = encodeVarintParams(dAtA, i, uint64(n1))
 	i--
-	dAtA[i] = 0x42
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinVestingDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinVestingDuration):])
+	dAtA[i] = 0x52
+	if m.MaxCurveBreakpoints != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxCurveBreakpoints))
+		i--
+		dAtA[i] = 0x48
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinVestingStartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinVestingStartTimeAfterSettlement):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintParams(dAtA, i, uint64(n2))
 	i--
+	dAtA[i] = 0x42
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinVestingDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinVestingDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
 	dAtA[i] = 0x3a
 	{
 		size := m.MinLiquidityPart.Size()
@@ -220,21 +332,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x28
 	}
-	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.IncentivesMinStartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IncentivesMinStartTimeAfterSettlement):])
-	if err3 != nil {
-		return 0, err3
-	}
-	i -= n3
-	i = encodeVarintParams(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x22
-	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinPlanDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinPlanDuration):])
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.IncentivesMinStartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IncentivesMinStartTimeAfterSettlement):])
 	if err4 != nil {
 		return 0, err4
 	}
 	i -= n4
 	i = encodeVarintParams(dAtA, i, uint64(n4))
 	i--
+	dAtA[i] = 0x22
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinPlanDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinPlanDuration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintParams(dAtA, i, uint64(n5))
+	i--
 	dAtA[i] = 0x1a
 	{
 		size := m.CreationFee.Size()
@@ -293,6 +405,22 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinVestingStartTimeAfterSettlement)
 	n += 1 + l + sovParams(uint64(l))
+	if m.MaxCurveBreakpoints != 0 {
+		n += 1 + sovParams(uint64(m.MaxCurveBreakpoints))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SettlementGracePeriod)
+	n += 1 + l + sovParams(uint64(l))
+	if m.CandleRetention != 0 {
+		n += 1 + sovParams(uint64(m.CandleRetention))
+	}
+	l = m.MaxPoolWeight.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinPoolSwapFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxPoolSwapFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.ReferralFeeShare.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -584,6 +712,213 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCurveBreakpoints", wireType)
+			}
+			m.MaxCurveBreakpoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCurveBreakpoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementGracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SettlementGracePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandleRetention", wireType)
+			}
+			m.CandleRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CandleRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPoolWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPoolSwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinPoolSwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolSwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPoolSwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferralFeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReferralFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
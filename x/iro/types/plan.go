@@ -24,8 +24,11 @@ func RollappIDFromIRODenom(denom string) (string, bool) {
 
 var MinTokenAllocation = math.LegacyNewDec(10) // min allocation in decimal representation
 
-func NewPlan(id uint64, rollappId string, liquidityDenom string, allocation sdk.Coin, curve BondingCurve, planDuration time.Duration, incentivesParams IncentivePlanParams, liquidityPart math.LegacyDec, vestingDuration, vestingStartTimeAfterSettlement time.Duration) Plan {
-	eq := FindEquilibrium(curve, allocation.Amount, liquidityPart)
+func NewPlan(id uint64, rollappId string, liquidityDenom string, allocation sdk.Coin, curve BondingCurve, planDuration time.Duration, incentivesParams IncentivePlanParams, liquidityPart math.LegacyDec, vestingDuration, vestingStartTimeAfterSettlement time.Duration) (Plan, error) {
+	eq, err := FindEquilibrium(curve, allocation.Amount, liquidityPart)
+	if err != nil {
+		return Plan{}, err
+	}
 	// start time and pre-launch time are set later on
 	plan := Plan{
 		Id:                  id,
@@ -45,9 +48,14 @@ func NewPlan(id uint64, rollappId string, liquidityDenom string, allocation sdk.
 			VestingDuration:          vestingDuration,
 			StartTimeAfterSettlement: vestingStartTimeAfterSettlement,
 		},
+		Presale: Presale{
+			MaxPerAddress: math.ZeroInt(),
+			MaxSoldAmt:    math.ZeroInt(),
+		},
+		TradingLimits: NewTradingLimits(math.ZeroInt(), math.ZeroInt(), 0),
 	}
 	plan.ModuleAccAddress = authtypes.NewModuleAddress(plan.ModuleAccName()).String()
-	return plan
+	return plan, nil
 }
 
 // ValidateBasic checks if the plan is valid
@@ -60,9 +68,15 @@ func (p Plan) ValidateBasic() error {
 	if !allocationDec.GT(MinTokenAllocation) {
 		return ErrInvalidAllocation
 	}
+	if err := p.BondingCurve.ValidateSupply(allocationDec); err != nil {
+		return err
+	}
 	if p.PreLaunchTime.Before(p.StartTime) {
 		return ErrInvalidEndTime
 	}
+	if !p.SettlementDeadline.IsZero() && p.SettlementDeadline.Before(p.PreLaunchTime) {
+		return errors.Join(ErrInvalidEndTime, errors.New("settlement deadline is before the pre-launch time"))
+	}
 	if p.ModuleAccAddress == "" {
 		return errors.New("module account address cannot be empty")
 	}
@@ -79,6 +93,16 @@ func (p Plan) ValidateBasic() error {
 		return fmt.Errorf("max amount to sell must be less than or equal to the total allocation: %s > %s", p.MaxAmountToSell.String(), p.TotalAllocation.Amount.String())
 	}
 
+	if err := ValidateTeamAllocations(p.TeamAllocations); err != nil {
+		return errors.Join(ErrInvalidTeamAllocation, err)
+	}
+	if teamAmt := p.TeamAllocationsTotal(); teamAmt.GTE(p.TotalAllocation.Amount) {
+		return errors.Join(ErrInvalidTeamAllocation, fmt.Errorf("team allocations must be less than the total allocation: %s >= %s", teamAmt, p.TotalAllocation.Amount))
+	}
+	if p.MaxAmountToSell.GT(p.SellableAllocation()) {
+		return fmt.Errorf("max amount to sell must be less than or equal to the allocation left after team allocations: %s > %s", p.MaxAmountToSell, p.SellableAllocation())
+	}
+
 	if p.LiquidityPart.IsNegative() || p.LiquidityPart.GT(math.LegacyOneDec()) {
 		return errors.New("liquidity part must be between 0 and 1")
 	}
@@ -95,6 +119,26 @@ func (p Plan) ValidateBasic() error {
 		return errorsmod.Wrap(err, "invalid liquidity denom")
 	}
 
+	if err := p.TradingLimits.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "trading limits")
+	}
+
+	if err := p.PoolParams.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "pool params")
+	}
+
+	if err := p.Presale.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "presale")
+	}
+	if p.Presale.IsEnabled() {
+		if p.Presale.Duration > p.IroPlanDuration {
+			return errors.New("presale duration cannot exceed the plan duration")
+		}
+		if p.Presale.HasMaxSoldAmt() && p.Presale.MaxSoldAmt.GT(p.MaxAmountToSell) {
+			return fmt.Errorf("presale max sold amount must be less than or equal to the max amount to sell: %s > %s", p.Presale.MaxSoldAmt, p.MaxAmountToSell)
+		}
+	}
+
 	return nil
 }
 
@@ -107,6 +151,11 @@ func (p Plan) IsSettled() bool {
 	return p.SettledDenom != ""
 }
 
+// IsFailed returns true if the plan was not settled by its settlement deadline
+func (p Plan) IsFailed(t time.Time) bool {
+	return !p.IsSettled() && !p.SettlementDeadline.IsZero() && !t.Before(p.SettlementDeadline)
+}
+
 func (p Plan) ModuleAccName() string {
 	return ModuleName + "-" + p.RollappId
 }
@@ -127,6 +176,77 @@ func (p *Plan) EnableTradingWithStartTime(startTime time.Time) {
 	p.TradingEnabled = true
 	p.StartTime = startTime
 	p.PreLaunchTime = startTime.Add(p.IroPlanDuration)
+	if p.Presale.IsEnabled() {
+		p.Presale.EndTime = startTime.Add(p.Presale.Duration)
+	}
+}
+
+// SetPoolParams sets the parameters of the pool bootstrapped on settlement. The max amount to sell is
+// recalculated, so the raised liquidity fits the pool weights at the closing price.
+func (p *Plan) SetPoolParams(poolParams SettlementPoolParams) error {
+	p.PoolParams = poolParams
+	return p.updateMaxAmountToSell()
+}
+
+// UpdateSaleSettings replaces the settings of a plan which is not trading yet. The max amount to sell is
+// recalculated for the new curve and liquidity part.
+func (p *Plan) UpdateSaleSettings(curve BondingCurve, planDuration time.Duration, incentivesParams IncentivePlanParams, liquidityPart math.LegacyDec, vestingDuration, vestingStartTimeAfterSettlement time.Duration) error {
+	p.BondingCurve = curve
+	p.IroPlanDuration = planDuration
+	p.IncentivePlanParams = incentivesParams
+	p.LiquidityPart = liquidityPart
+	p.VestingPlan.VestingDuration = vestingDuration
+	p.VestingPlan.StartTimeAfterSettlement = vestingStartTimeAfterSettlement
+	return p.updateMaxAmountToSell()
+}
+
+// SetTeamAllocations reserves the team allocations out of the total allocation. The max amount to sell is
+// recalculated, as the reserved tokens are not available to bootstrap the pool.
+func (p *Plan) SetTeamAllocations(team []TeamAllocation) error {
+	p.TeamAllocations = make([]TeamAllocation, 0, len(team))
+	for _, a := range team {
+		p.TeamAllocations = append(p.TeamAllocations, NewTeamAllocation(a.Beneficiary, a.Amount, a.Cliff, a.VestingDuration))
+	}
+	return p.updateMaxAmountToSell()
+}
+
+func (p *Plan) updateMaxAmountToSell() error {
+	eq, err := FindEquilibrium(p.BondingCurve, p.SellableAllocation(), p.PoolParams.EffectiveLiquidityPart(p.LiquidityPart))
+	if err != nil {
+		return err
+	}
+	p.MaxAmountToSell = eq
+	return nil
+}
+
+// TeamAllocationsTotal returns the amount of tokens reserved for the team
+func (p Plan) TeamAllocationsTotal() math.Int {
+	return TeamAllocationsTotal(p.TeamAllocations)
+}
+
+// SellableAllocation returns the allocation left for the sale and the liquidity pool after the team allocations
+func (p Plan) SellableAllocation() math.Int {
+	return p.TotalAllocation.Amount.Sub(p.TeamAllocationsTotal())
+}
+
+// GetTeamAllocation returns the index of the team allocation of the beneficiary
+func (p Plan) GetTeamAllocation(beneficiary string) (int, bool) {
+	for i, a := range p.TeamAllocations {
+		if a.Beneficiary == beneficiary {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// SetSettlementDeadline sets the time by which the plan must be settled, counted from the
+// pre-launch time. A zero grace period leaves the plan without a deadline.
+func (p *Plan) SetSettlementDeadline(gracePeriod time.Duration) {
+	if gracePeriod == 0 {
+		p.SettlementDeadline = time.Time{}
+		return
+	}
+	p.SettlementDeadline = p.PreLaunchTime.Add(gracePeriod)
 }
 
 func DefaultIncentivePlanParams() IncentivePlanParams {
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// PoolWeightPrecision is the total of the balancer weights of a pool with custom weights.
+// It stays under the balancer max user specified weight.
+const PoolWeightPrecision = 1_000_000
+
+// MaxPoolWeight bounds the weight of either pool asset so both balancer weights stay positive
+var MaxPoolWeight = math.LegacyMustNewDecFromStr("0.99")
+
+// HasCustomWeights returns true if the pool is not bootstrapped with equal weights
+func (p SettlementPoolParams) HasCustomWeights() bool {
+	return p.RollappTokenWeight != nil
+}
+
+// HasCustomSwapFee returns true if the pool does not use the global gamm swap fee
+func (p SettlementPoolParams) HasCustomSwapFee() bool {
+	return p.SwapFee != nil
+}
+
+// Weights returns the balancer weights of the rollapp token and the liquidity token
+func (p SettlementPoolParams) Weights() (rollappWeight, liquidityWeight math.Int) {
+	if !p.HasCustomWeights() {
+		return math.OneInt(), math.OneInt()
+	}
+	rollappWeight = p.RollappTokenWeight.MulInt64(PoolWeightPrecision).TruncateInt()
+	return rollappWeight, math.NewInt(PoolWeightPrecision).Sub(rollappWeight)
+}
+
+// EffectiveLiquidityPart returns the liquidity part adjusted to the pool weights, so the plan sells the amount
+// whose raised liquidity fits the pool at the closing price. Equal weights keep the liquidity part as is.
+func (p SettlementPoolParams) EffectiveLiquidityPart(liquidityPart math.LegacyDec) math.LegacyDec {
+	rollappWeight, liquidityWeight := p.Weights()
+	return liquidityPart.MulInt(rollappWeight).QuoInt(liquidityWeight)
+}
+
+func (p SettlementPoolParams) ValidateBasic() error {
+	if p.HasCustomWeights() {
+		w := *p.RollappTokenWeight
+		if w.GT(MaxPoolWeight) || math.LegacyOneDec().Sub(w).GT(MaxPoolWeight) {
+			return fmt.Errorf("rollapp token weight must be between %s and %s: %s", math.LegacyOneDec().Sub(MaxPoolWeight), MaxPoolWeight, w)
+		}
+	}
+	if p.HasCustomSwapFee() {
+		if p.SwapFee.IsNegative() || p.SwapFee.GTE(math.LegacyOneDec()) {
+			return fmt.Errorf("swap fee must be in [0, 1): %s", p.SwapFee)
+		}
+	}
+	return nil
+}
+
+// ValidateParams checks the pool params against the bounds set in the module params
+func (p SettlementPoolParams) ValidateParams(params Params) error {
+	if p.HasCustomWeights() {
+		maxWeight := params.MaxPoolWeight
+		if maxWeight.IsNil() || maxWeight.LT(math.LegacyNewDecWithPrec(5, 1)) {
+			maxWeight = math.LegacyNewDecWithPrec(5, 1)
+		}
+		w := *p.RollappTokenWeight
+		if w.GT(maxWeight) || math.LegacyOneDec().Sub(w).GT(maxWeight) {
+			return fmt.Errorf("rollapp token weight must be between %s and %s: %s", math.LegacyOneDec().Sub(maxWeight), maxWeight, w)
+		}
+	}
+	if p.HasCustomSwapFee() {
+		if params.MaxPoolSwapFee.IsNil() || params.MaxPoolSwapFee.IsZero() {
+			return fmt.Errorf("custom pool swap fee is disabled")
+		}
+		if (!params.MinPoolSwapFee.IsNil() && p.SwapFee.LT(params.MinPoolSwapFee)) || p.SwapFee.GT(params.MaxPoolSwapFee) {
+			return fmt.Errorf("swap fee must be between %s and %s: %s", params.MinPoolSwapFee, params.MaxPoolSwapFee, p.SwapFee)
+		}
+	}
+	return nil
+}
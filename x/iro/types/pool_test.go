@@ -0,0 +1,78 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+func TestSettlementPoolParams_Validate(t *testing.T) {
+	dec := func(s string) *math.LegacyDec {
+		d := math.LegacyMustNewDecFromStr(s)
+		return &d
+	}
+	disabled := types.DefaultParams()
+	disabled.MaxPoolWeight = math.LegacyZeroDec()
+	disabled.MaxPoolSwapFee = math.LegacyZeroDec()
+
+	testCases := []struct {
+		name       string
+		poolParams types.SettlementPoolParams
+		params     types.Params
+		expectErr  bool
+	}{
+		{"default pool", types.SettlementPoolParams{}, types.DefaultParams(), false},
+		{"default pool, custom params disabled", types.SettlementPoolParams{}, disabled, false},
+		{"80/20", types.SettlementPoolParams{RollappTokenWeight: dec("0.8")}, types.DefaultParams(), false},
+		{"20/80", types.SettlementPoolParams{RollappTokenWeight: dec("0.2")}, types.DefaultParams(), false},
+		{"90/10 above max weight", types.SettlementPoolParams{RollappTokenWeight: dec("0.9")}, types.DefaultParams(), true},
+		{"custom weights disabled", types.SettlementPoolParams{RollappTokenWeight: dec("0.6")}, disabled, true},
+		{"equal weights allowed when disabled", types.SettlementPoolParams{RollappTokenWeight: dec("0.5")}, disabled, false},
+		{"swap fee within bounds", types.SettlementPoolParams{SwapFee: dec("0.01")}, types.DefaultParams(), false},
+		{"swap fee below min", types.SettlementPoolParams{SwapFee: dec("0.0001")}, types.DefaultParams(), true},
+		{"swap fee above max", types.SettlementPoolParams{SwapFee: dec("0.1")}, types.DefaultParams(), true},
+		{"custom swap fee disabled", types.SettlementPoolParams{SwapFee: dec("0.01")}, disabled, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.params.ValidateBasic())
+			err := tc.poolParams.ValidateBasic()
+			if err == nil {
+				err = tc.poolParams.ValidateParams(tc.params)
+			}
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	// basic validation rejects weights leaving no weight to the other asset
+	require.Error(t, types.SettlementPoolParams{RollappTokenWeight: dec("1")}.ValidateBasic())
+	require.Error(t, types.SettlementPoolParams{SwapFee: dec("-0.1")}.ValidateBasic())
+}
+
+func TestCalcLiquidityPoolTokens_Weighted(t *testing.T) {
+	price := math.LegacyMustNewDecFromStr("0.1")
+	unsold := math.NewInt(1_000_000).MulRaw(1e18)
+	raised := math.NewInt(1_000).MulRaw(1e18)
+
+	weight := math.LegacyMustNewDecFromStr("0.8")
+	rollappWeight, liquidityWeight := types.SettlementPoolParams{RollappTokenWeight: &weight}.Weights()
+	raTokens, liquidity := types.CalcLiquidityPoolTokens(unsold, raised, price, rollappWeight, liquidityWeight)
+
+	// liquidity is the limiting factor, and the pool spot price matches the settled price
+	require.Equal(t, raised, liquidity)
+	spot := liquidity.ToLegacyDec().QuoInt(liquidityWeight).Quo(raTokens.ToLegacyDec().QuoInt(rollappWeight))
+	require.True(t, spot.Sub(price).Abs().LTE(math.LegacyNewDecWithPrec(1, 12)), "spot: %s", spot)
+
+	// equal weights keep the previous behaviour
+	raTokens, liquidity = types.CalcLiquidityPoolTokens(unsold, raised, price, math.OneInt(), math.OneInt())
+	require.Equal(t, raised, liquidity)
+	require.Equal(t, math.NewInt(10_000).MulRaw(1e18), raTokens)
+}
@@ -0,0 +1,116 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsEnabled returns true if the plan has a presale phase
+func (p Presale) IsEnabled() bool {
+	return p.Duration > 0
+}
+
+// IsActive returns true if the presale is running at the given time.
+// The presale starts together with the plan.
+func (p Presale) IsActive(t time.Time) bool {
+	return p.IsEnabled() && t.Before(p.EndTime)
+}
+
+// HasMaxSoldAmt returns true if the presale bounds the curve segment it sells
+func (p Presale) HasMaxSoldAmt() bool {
+	return !p.MaxSoldAmt.IsNil() && p.MaxSoldAmt.IsPositive()
+}
+
+func (p Presale) ValidateBasic() error {
+	if p.Duration < 0 {
+		return fmt.Errorf("presale duration must be non-negative: %v", p.Duration)
+	}
+	if !p.IsEnabled() {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(p.Allowlist))
+	for _, addr := range p.Allowlist {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid presale allowlist address: %s: %w", addr, err)
+		}
+		if seen[addr] {
+			return fmt.Errorf("duplicate presale allowlist address: %s", addr)
+		}
+		seen[addr] = true
+	}
+
+	if len(p.Allowlist) > 0 && (p.MaxPerAddress.IsNil() || !p.MaxPerAddress.IsPositive()) {
+		return fmt.Errorf("presale max per address must be positive: %s", p.MaxPerAddress)
+	}
+
+	if len(p.MerkleRoot) > 0 && len(p.MerkleRoot) != sha256.Size {
+		return fmt.Errorf("presale merkle root must be %d bytes", sha256.Size)
+	}
+
+	if !p.MaxSoldAmt.IsNil() && p.MaxSoldAmt.IsNegative() {
+		return fmt.Errorf("presale max sold amount cannot be negative: %s", p.MaxSoldAmt)
+	}
+
+	return nil
+}
+
+// ValidateAdmission checks that a new presale admits some buyers. The allowlist is only set on creation,
+// stored plans keep it as presale allocations.
+func (p Presale) ValidateAdmission() error {
+	if p.IsEnabled() && len(p.Allowlist) == 0 && len(p.MerkleRoot) == 0 {
+		return errors.New("presale must have an allowlist or a merkle root")
+	}
+	return nil
+}
+
+// Leaves and inner nodes of the presale merkle tree are hashed with different prefixes, so that an inner
+// node can't be passed off as a leaf.
+const (
+	presaleLeafPrefix byte = 0x00
+	presaleNodePrefix byte = 0x01
+)
+
+// PresaleLeaf returns the merkle leaf of an address and its presale cap
+func PresaleLeaf(addr string, cap math.Int) []byte {
+	h := sha256.Sum256(append([]byte{presaleLeafPrefix}, fmt.Sprintf("%s:%s", addr, cap)...))
+	return h[:]
+}
+
+// PresaleNode hashes two merkle nodes. The pair is sorted so proofs don't need
+// to carry the position of each sibling.
+func PresaleNode(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	h := sha256.Sum256(append(append([]byte{presaleNodePrefix}, a...), b...))
+	return h[:]
+}
+
+// VerifyPresaleProof checks the address is allowed to buy up to cap under the given merkle root
+func VerifyPresaleProof(root []byte, addr string, cap math.Int, proof [][]byte) bool {
+	node := PresaleLeaf(addr, cap)
+	for _, sibling := range proof {
+		node = PresaleNode(node, sibling)
+	}
+	return bytes.Equal(node, root)
+}
+
+func (a PresaleAllocation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(a.Address); err != nil {
+		return fmt.Errorf("invalid presale allocation address: %s: %w", a.Address, err)
+	}
+	if a.Cap.IsNil() || a.Cap.IsNegative() {
+		return fmt.Errorf("presale allocation cap must be non-negative: %s", a.Cap)
+	}
+	if a.Bought.IsNil() || a.Bought.IsNegative() || a.Bought.GT(a.Cap) {
+		return fmt.Errorf("presale allocation bought must be between zero and cap: %s", a.Bought)
+	}
+	return nil
+}
@@ -33,6 +33,361 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// QueryReferralsRequest is the request type for the Query/QueryReferrals RPC
+// method.
+type QueryReferralsRequest struct {
+	PlanId string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// Optional referrer to query.
+	Referrer   string             `protobuf:"bytes,2,opt,name=referrer,proto3" json:"referrer,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryReferralsRequest) Reset()         { *m = QueryReferralsRequest{} }
+func (m *QueryReferralsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReferralsRequest) ProtoMessage()    {}
+func (*QueryReferralsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{0}
+}
+func (m *QueryReferralsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReferralsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReferralsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReferralsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReferralsRequest.Merge(m, src)
+}
+func (m *QueryReferralsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReferralsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReferralsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReferralsRequest proto.InternalMessageInfo
+
+func (m *QueryReferralsRequest) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
+	}
+	return ""
+}
+
+func (m *QueryReferralsRequest) GetReferrer() string {
+	if m != nil {
+		return m.Referrer
+	}
+	return ""
+}
+
+func (m *QueryReferralsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryReferralsResponse is the response type for the Query/QueryReferrals RPC
+// method.
+type QueryReferralsResponse struct {
+	Referrals  []Referral          `protobuf:"bytes,1,rep,name=referrals,proto3" json:"referrals"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryReferralsResponse) Reset()         { *m = QueryReferralsResponse{} }
+func (m *QueryReferralsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReferralsResponse) ProtoMessage()    {}
+func (*QueryReferralsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{1}
+}
+func (m *QueryReferralsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReferralsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReferralsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReferralsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReferralsResponse.Merge(m, src)
+}
+func (m *QueryReferralsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReferralsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReferralsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReferralsResponse proto.InternalMessageInfo
+
+func (m *QueryReferralsResponse) GetReferrals() []Referral {
+	if m != nil {
+		return m.Referrals
+	}
+	return nil
+}
+
+func (m *QueryReferralsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTeamAllocationsRequest is the request type for the
+// Query/QueryTeamAllocations RPC method.
+type QueryTeamAllocationsRequest struct {
+	PlanId string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+}
+
+func (m *QueryTeamAllocationsRequest) Reset()         { *m = QueryTeamAllocationsRequest{} }
+func (m *QueryTeamAllocationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTeamAllocationsRequest) ProtoMessage()    {}
+func (*QueryTeamAllocationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{2}
+}
+func (m *QueryTeamAllocationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTeamAllocationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTeamAllocationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTeamAllocationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTeamAllocationsRequest.Merge(m, src)
+}
+func (m *QueryTeamAllocationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTeamAllocationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTeamAllocationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTeamAllocationsRequest proto.InternalMessageInfo
+
+func (m *QueryTeamAllocationsRequest) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
+	}
+	return ""
+}
+
+// QueryTeamAllocationsResponse is the response type for the
+// Query/QueryTeamAllocations RPC method.
+type QueryTeamAllocationsResponse struct {
+	Allocations []TeamAllocationStatus `protobuf:"bytes,1,rep,name=allocations,proto3" json:"allocations"`
+}
+
+func (m *QueryTeamAllocationsResponse) Reset()         { *m = QueryTeamAllocationsResponse{} }
+func (m *QueryTeamAllocationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTeamAllocationsResponse) ProtoMessage()    {}
+func (*QueryTeamAllocationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{3}
+}
+func (m *QueryTeamAllocationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTeamAllocationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTeamAllocationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTeamAllocationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTeamAllocationsResponse.Merge(m, src)
+}
+func (m *QueryTeamAllocationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTeamAllocationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTeamAllocationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTeamAllocationsResponse proto.InternalMessageInfo
+
+func (m *QueryTeamAllocationsResponse) GetAllocations() []TeamAllocationStatus {
+	if m != nil {
+		return m.Allocations
+	}
+	return nil
+}
+
+// TeamAllocationStatus is the vesting state of a team allocation.
+type TeamAllocationStatus struct {
+	Beneficiary string                `protobuf:"bytes,1,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	Amount      cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// vested is the amount of tokens vested so far, claimed or not.
+	Vested  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=vested,proto3,customtype=cosmossdk.io/math.Int" json:"vested"`
+	Claimed cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=claimed,proto3,customtype=cosmossdk.io/math.Int" json:"claimed"`
+	// locked is the amount of tokens not vested yet.
+	Locked cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=locked,proto3,customtype=cosmossdk.io/math.Int" json:"locked"`
+}
+
+func (m *TeamAllocationStatus) Reset()         { *m = TeamAllocationStatus{} }
+func (m *TeamAllocationStatus) String() string { return proto.CompactTextString(m) }
+func (*TeamAllocationStatus) ProtoMessage()    {}
+func (*TeamAllocationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{4}
+}
+func (m *TeamAllocationStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TeamAllocationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TeamAllocationStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TeamAllocationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TeamAllocationStatus.Merge(m, src)
+}
+func (m *TeamAllocationStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *TeamAllocationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_TeamAllocationStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TeamAllocationStatus proto.InternalMessageInfo
+
+func (m *TeamAllocationStatus) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
+// QueryPurchasedRequest is the request type for the
+// Query/QueryPurchased RPC method.
+type QueryPurchasedRequest struct {
+	PlanId  string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryPurchasedRequest) Reset()         { *m = QueryPurchasedRequest{} }
+func (m *QueryPurchasedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPurchasedRequest) ProtoMessage()    {}
+func (*QueryPurchasedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{5}
+}
+func (m *QueryPurchasedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPurchasedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPurchasedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPurchasedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPurchasedRequest.Merge(m, src)
+}
+func (m *QueryPurchasedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPurchasedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPurchasedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPurchasedRequest proto.InternalMessageInfo
+
+func (m *QueryPurchasedRequest) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
+	}
+	return ""
+}
+
+func (m *QueryPurchasedRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryPurchasedResponse is the response type for the
+// Query/QueryPurchased RPC method.
+type QueryPurchasedResponse struct {
+	Purchased cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=purchased,proto3,customtype=cosmossdk.io/math.Int" json:"purchased"`
+	// cap is the current per-address limit. Zero means no limit.
+	Cap cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=cap,proto3,customtype=cosmossdk.io/math.Int" json:"cap"`
+}
+
+func (m *QueryPurchasedResponse) Reset()         { *m = QueryPurchasedResponse{} }
+func (m *QueryPurchasedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPurchasedResponse) ProtoMessage()    {}
+func (*QueryPurchasedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{6}
+}
+func (m *QueryPurchasedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPurchasedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPurchasedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPurchasedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPurchasedResponse.Merge(m, src)
+}
+func (m *QueryPurchasedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPurchasedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPurchasedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPurchasedResponse proto.InternalMessageInfo
+
 // QueryVestingRequest is the request type for the
 // Query/QueryVesting RPC method.
 type QueryVestingRequest struct {
@@ -44,7 +399,7 @@ func (m *QueryVestingRequest) Reset()         { *m = QueryVestingRequest{} }
 func (m *QueryVestingRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVestingRequest) ProtoMessage()    {}
 func (*QueryVestingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{0}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{7}
 }
 func (m *QueryVestingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -95,7 +450,7 @@ func (m *QueryVestingResponse) Reset()         { *m = QueryVestingResponse{} }
 func (m *QueryVestingResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVestingResponse) ProtoMessage()    {}
 func (*QueryVestingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{1}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{8}
 }
 func (m *QueryVestingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -139,7 +494,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{2}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{9}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -178,7 +533,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{3}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{10}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -226,7 +581,7 @@ func (m *QueryPlansRequest) Reset()         { *m = QueryPlansRequest{} }
 func (m *QueryPlansRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPlansRequest) ProtoMessage()    {}
 func (*QueryPlansRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{4}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{11}
 }
 func (m *QueryPlansRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -279,7 +634,7 @@ func (m *QueryPlansResponse) Reset()         { *m = QueryPlansResponse{} }
 func (m *QueryPlansResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPlansResponse) ProtoMessage()    {}
 func (*QueryPlansResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{5}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{12}
 }
 func (m *QueryPlansResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -331,7 +686,7 @@ func (m *QueryPlanRequest) Reset()         { *m = QueryPlanRequest{} }
 func (m *QueryPlanRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPlanRequest) ProtoMessage()    {}
 func (*QueryPlanRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{6}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{13}
 }
 func (m *QueryPlanRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -376,7 +731,7 @@ func (m *QueryPlanResponse) Reset()         { *m = QueryPlanResponse{} }
 func (m *QueryPlanResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPlanResponse) ProtoMessage()    {}
 func (*QueryPlanResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{7}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{14}
 }
 func (m *QueryPlanResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -422,7 +777,7 @@ func (m *QueryPlanByRollappRequest) Reset()         { *m = QueryPlanByRollappReq
 func (m *QueryPlanByRollappRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPlanByRollappRequest) ProtoMessage()    {}
 func (*QueryPlanByRollappRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{8}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{15}
 }
 func (m *QueryPlanByRollappRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -468,7 +823,7 @@ func (m *QueryPlanByRollappResponse) Reset()         { *m = QueryPlanByRollappRe
 func (m *QueryPlanByRollappResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPlanByRollappResponse) ProtoMessage()    {}
 func (*QueryPlanByRollappResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{9}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{16}
 }
 func (m *QueryPlanByRollappResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -514,7 +869,7 @@ func (m *QuerySpotPriceRequest) Reset()         { *m = QuerySpotPriceRequest{} }
 func (m *QuerySpotPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySpotPriceRequest) ProtoMessage()    {}
 func (*QuerySpotPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{10}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{17}
 }
 func (m *QuerySpotPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -560,7 +915,7 @@ func (m *QuerySpotPriceResponse) Reset()         { *m = QuerySpotPriceResponse{}
 func (m *QuerySpotPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySpotPriceResponse) ProtoMessage()    {}
 func (*QuerySpotPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{11}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{18}
 }
 func (m *QuerySpotPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -589,25 +944,26 @@ func (m *QuerySpotPriceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QuerySpotPriceResponse proto.InternalMessageInfo
 
-// QueryCostRequest is the request type for the Query/QueryCost RPC method.
-type QueryCostRequest struct {
-	PlanId string                `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
-	Amt    cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amt,proto3,customtype=cosmossdk.io/math.Int" json:"amt"`
-	Sell   bool                  `protobuf:"varint,3,opt,name=sell,proto3" json:"sell,omitempty"`
+// QueryCandlesRequest is the request type for the Query/QueryCandles RPC
+// method.
+type QueryCandlesRequest struct {
+	PlanId     string             `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Resolution CandleResolution   `protobuf:"varint,2,opt,name=resolution,proto3,enum=dymensionxyz.dymension.iro.CandleResolution" json:"resolution,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryCostRequest) Reset()         { *m = QueryCostRequest{} }
-func (m *QueryCostRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryCostRequest) ProtoMessage()    {}
-func (*QueryCostRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{12}
+func (m *QueryCandlesRequest) Reset()         { *m = QueryCandlesRequest{} }
+func (m *QueryCandlesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCandlesRequest) ProtoMessage()    {}
+func (*QueryCandlesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{19}
 }
-func (m *QueryCostRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryCandlesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCandlesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCostRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCandlesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -617,14 +973,129 @@ func (m *QueryCostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *QueryCostRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCostRequest.Merge(m, src)
+func (m *QueryCandlesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCandlesRequest.Merge(m, src)
 }
-func (m *QueryCostRequest) XXX_Size() int {
+func (m *QueryCandlesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCostRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCostRequest.DiscardUnknown(m)
+func (m *QueryCandlesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCandlesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCandlesRequest proto.InternalMessageInfo
+
+func (m *QueryCandlesRequest) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
+	}
+	return ""
+}
+
+func (m *QueryCandlesRequest) GetResolution() CandleResolution {
+	if m != nil {
+		return m.Resolution
+	}
+	return CANDLE_RESOLUTION_UNSPECIFIED
+}
+
+func (m *QueryCandlesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryCandlesResponse is the response type for the Query/QueryCandles RPC
+// method.
+type QueryCandlesResponse struct {
+	Candles    []Candle            `protobuf:"bytes,1,rep,name=candles,proto3" json:"candles"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCandlesResponse) Reset()         { *m = QueryCandlesResponse{} }
+func (m *QueryCandlesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCandlesResponse) ProtoMessage()    {}
+func (*QueryCandlesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{20}
+}
+func (m *QueryCandlesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCandlesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCandlesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCandlesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCandlesResponse.Merge(m, src)
+}
+func (m *QueryCandlesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCandlesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCandlesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCandlesResponse proto.InternalMessageInfo
+
+func (m *QueryCandlesResponse) GetCandles() []Candle {
+	if m != nil {
+		return m.Candles
+	}
+	return nil
+}
+
+func (m *QueryCandlesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryCostRequest is the request type for the Query/QueryCost RPC method.
+type QueryCostRequest struct {
+	PlanId string                `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Amt    cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amt,proto3,customtype=cosmossdk.io/math.Int" json:"amt"`
+	Sell   bool                  `protobuf:"varint,3,opt,name=sell,proto3" json:"sell,omitempty"`
+}
+
+func (m *QueryCostRequest) Reset()         { *m = QueryCostRequest{} }
+func (m *QueryCostRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCostRequest) ProtoMessage()    {}
+func (*QueryCostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{21}
+}
+func (m *QueryCostRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCostRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCostRequest.Merge(m, src)
+}
+func (m *QueryCostRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCostRequest.DiscardUnknown(m)
 }
 
 var xxx_messageInfo_QueryCostRequest proto.InternalMessageInfo
@@ -652,7 +1123,7 @@ func (m *QueryCostResponse) Reset()         { *m = QueryCostResponse{} }
 func (m *QueryCostResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCostResponse) ProtoMessage()    {}
 func (*QueryCostResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{13}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{22}
 }
 func (m *QueryCostResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -699,7 +1170,7 @@ func (m *QueryTokensForExactInAmountRequest) Reset()         { *m = QueryTokensF
 func (m *QueryTokensForExactInAmountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTokensForExactInAmountRequest) ProtoMessage()    {}
 func (*QueryTokensForExactInAmountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{14}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{23}
 }
 func (m *QueryTokensForExactInAmountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -745,7 +1216,7 @@ func (m *QueryTokensForExactInAmountResponse) Reset()         { *m = QueryTokens
 func (m *QueryTokensForExactInAmountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTokensForExactInAmountResponse) ProtoMessage()    {}
 func (*QueryTokensForExactInAmountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{15}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{24}
 }
 func (m *QueryTokensForExactInAmountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -791,7 +1262,7 @@ func (m *QueryClaimedRequest) Reset()         { *m = QueryClaimedRequest{} }
 func (m *QueryClaimedRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryClaimedRequest) ProtoMessage()    {}
 func (*QueryClaimedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{16}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{25}
 }
 func (m *QueryClaimedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -837,7 +1308,7 @@ func (m *QueryClaimedResponse) Reset()         { *m = QueryClaimedResponse{} }
 func (m *QueryClaimedResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryClaimedResponse) ProtoMessage()    {}
 func (*QueryClaimedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{17}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{26}
 }
 func (m *QueryClaimedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -867,6 +1338,13 @@ func (m *QueryClaimedResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_QueryClaimedResponse proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterType((*QueryReferralsRequest)(nil), "dymensionxyz.dymension.iro.QueryReferralsRequest")
+	proto.RegisterType((*QueryReferralsResponse)(nil), "dymensionxyz.dymension.iro.QueryReferralsResponse")
+	proto.RegisterType((*QueryTeamAllocationsRequest)(nil), "dymensionxyz.dymension.iro.QueryTeamAllocationsRequest")
+	proto.RegisterType((*QueryTeamAllocationsResponse)(nil), "dymensionxyz.dymension.iro.QueryTeamAllocationsResponse")
+	proto.RegisterType((*TeamAllocationStatus)(nil), "dymensionxyz.dymension.iro.TeamAllocationStatus")
+	proto.RegisterType((*QueryPurchasedRequest)(nil), "dymensionxyz.dymension.iro.QueryPurchasedRequest")
+	proto.RegisterType((*QueryPurchasedResponse)(nil), "dymensionxyz.dymension.iro.QueryPurchasedResponse")
 	proto.RegisterType((*QueryVestingRequest)(nil), "dymensionxyz.dymension.iro.QueryVestingRequest")
 	proto.RegisterType((*QueryVestingResponse)(nil), "dymensionxyz.dymension.iro.QueryVestingResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "dymensionxyz.dymension.iro.QueryParamsRequest")
@@ -879,6 +1357,8 @@ func init() {
 	proto.RegisterType((*QueryPlanByRollappResponse)(nil), "dymensionxyz.dymension.iro.QueryPlanByRollappResponse")
 	proto.RegisterType((*QuerySpotPriceRequest)(nil), "dymensionxyz.dymension.iro.QuerySpotPriceRequest")
 	proto.RegisterType((*QuerySpotPriceResponse)(nil), "dymensionxyz.dymension.iro.QuerySpotPriceResponse")
+	proto.RegisterType((*QueryCandlesRequest)(nil), "dymensionxyz.dymension.iro.QueryCandlesRequest")
+	proto.RegisterType((*QueryCandlesResponse)(nil), "dymensionxyz.dymension.iro.QueryCandlesResponse")
 	proto.RegisterType((*QueryCostRequest)(nil), "dymensionxyz.dymension.iro.QueryCostRequest")
 	proto.RegisterType((*QueryCostResponse)(nil), "dymensionxyz.dymension.iro.QueryCostResponse")
 	proto.RegisterType((*QueryTokensForExactInAmountRequest)(nil), "dymensionxyz.dymension.iro.QueryTokensForExactInAmountRequest")
@@ -892,76 +1372,102 @@ func init() {
 }
 
 var fileDescriptor_ae2c72bd0c23c1c0 = []byte{
-	// 1091 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0x8e, 0x9b, 0xec, 0xd2, 0xbc, 0x84, 0x12, 0x86, 0x14, 0x12, 0x17, 0xb6, 0xc5, 0xad, 0x9a,
-	0x90, 0x74, 0xed, 0xec, 0x86, 0x22, 0xf1, 0xbb, 0xd9, 0x94, 0xb6, 0x8b, 0x90, 0x08, 0x2e, 0x2a,
-	0x88, 0x8b, 0x99, 0xf5, 0x0e, 0x5b, 0xab, 0xde, 0x19, 0xd7, 0x9e, 0x84, 0x2c, 0xa5, 0x1c, 0x90,
-	0xb8, 0x23, 0x21, 0x90, 0x10, 0x70, 0xe7, 0xc0, 0x81, 0x03, 0x7f, 0x03, 0xea, 0xb1, 0x82, 0x0b,
-	0xe2, 0x50, 0xa1, 0x84, 0x03, 0x7f, 0x06, 0xf2, 0xcc, 0xd8, 0xeb, 0x4d, 0x53, 0xdb, 0x5b, 0xc4,
-	0x6d, 0x67, 0xe6, 0x7d, 0xef, 0x7d, 0xdf, 0x9b, 0xe7, 0xcf, 0x6b, 0x38, 0xdb, 0x1d, 0xf4, 0x09,
-	0x8d, 0x3c, 0x46, 0x77, 0x07, 0x9f, 0x58, 0xe9, 0xc2, 0xf2, 0x42, 0x66, 0xdd, 0xdc, 0x26, 0xe1,
-	0xc0, 0x0c, 0x42, 0xc6, 0x19, 0xd2, 0xb3, 0x71, 0x66, 0xba, 0x30, 0xbd, 0x90, 0xe9, 0xf3, 0x3d,
-	0xd6, 0x63, 0x22, 0xcc, 0x8a, 0x7f, 0x49, 0x84, 0xbe, 0xe8, 0xb2, 0xa8, 0xcf, 0x22, 0x47, 0x1e,
-	0xc8, 0x85, 0x3a, 0x7a, 0xba, 0xc7, 0x58, 0xcf, 0x27, 0x16, 0x0e, 0x3c, 0x0b, 0x53, 0xca, 0x38,
-	0xe6, 0x1e, 0xa3, 0xc9, 0xe9, 0x99, 0x1c, 0x4a, 0x5e, 0x98, 0xa4, 0xaf, 0xc9, 0x8c, 0x56, 0x07,
-	0x47, 0xc4, 0xda, 0x69, 0x74, 0x08, 0xc7, 0x0d, 0xcb, 0x65, 0x1e, 0x55, 0xe7, 0x4b, 0x39, 0x59,
-	0x02, 0x1c, 0xe2, 0x7e, 0x52, 0x6e, 0x25, 0x9b, 0x48, 0x48, 0x4e, 0xd3, 0x05, 0xb8, 0xe7, 0x51,
-	0xc1, 0x4d, 0xc6, 0x1a, 0x26, 0x3c, 0xf1, 0x4e, 0x1c, 0x71, 0x8d, 0x44, 0xdc, 0xa3, 0x3d, 0x9b,
-	0xdc, 0xdc, 0x26, 0x11, 0x47, 0x4f, 0xc1, 0x23, 0x81, 0x8f, 0xa9, 0xe3, 0x75, 0x17, 0xb4, 0x53,
-	0xda, 0xf2, 0xb4, 0x5d, 0x8d, 0x97, 0xed, 0xae, 0xf1, 0xed, 0x11, 0x98, 0x1f, 0x05, 0x44, 0x01,
-	0xa3, 0x11, 0x41, 0xf3, 0x50, 0x61, 0x1f, 0x53, 0x12, 0xaa, 0x78, 0xb9, 0x40, 0x1b, 0x50, 0xe1,
-	0x8c, 0x63, 0x7f, 0xe1, 0x48, 0xbc, 0xdb, 0x5a, 0xbd, 0x73, 0xef, 0xe4, 0xc4, 0x9f, 0xf7, 0x4e,
-	0x1e, 0x97, 0x0c, 0xa3, 0xee, 0x0d, 0xd3, 0x63, 0x56, 0x1f, 0xf3, 0xeb, 0x66, 0x9b, 0xf2, 0xdf,
-	0x7e, 0xa9, 0x83, 0xea, 0x6a, 0x9b, 0x72, 0x5b, 0x22, 0xd1, 0x16, 0x3c, 0xba, 0x43, 0x22, 0x4e,
-	0xba, 0x0e, 0xee, 0xb3, 0x6d, 0xca, 0x17, 0x26, 0xc7, 0x4f, 0x35, 0x2b, 0x33, 0x6c, 0x88, 0x04,
-	0xe8, 0x1a, 0xcc, 0xb9, 0x3e, 0xf6, 0xfa, 0xb8, 0xe3, 0x93, 0x24, 0xe9, 0xd4, 0xf8, 0x49, 0x1f,
-	0x4b, 0x93, 0xc8, 0xbc, 0xc6, 0x3c, 0x20, 0xd1, 0x9a, 0x2d, 0x71, 0x19, 0xaa, 0x95, 0xc6, 0x7b,
-	0xaa, 0xc3, 0xc9, 0xae, 0xea, 0xd7, 0x05, 0xa8, 0xca, 0x4b, 0x13, 0x0d, 0x9b, 0x69, 0x1a, 0xe6,
-	0x83, 0xe7, 0xd1, 0x94, 0xd8, 0xd6, 0x54, 0x4c, 0xcf, 0x56, 0x38, 0xe3, 0x0b, 0x0d, 0x1e, 0x97,
-	0x99, 0x7d, 0x4c, 0x93, 0x72, 0x68, 0x19, 0xe6, 0x28, 0xa3, 0x4e, 0x44, 0x38, 0xf7, 0x49, 0xd7,
-	0x61, 0xd4, 0x1f, 0x88, 0x0a, 0x47, 0xed, 0x63, 0x94, 0xd1, 0xab, 0x72, 0xfb, 0x6d, 0xea, 0x0f,
-	0xd0, 0x25, 0x80, 0xe1, 0x38, 0x88, 0x0b, 0x9a, 0x69, 0x9e, 0x35, 0x95, 0xc0, 0x78, 0x76, 0x4c,
-	0xf9, 0xb8, 0xa8, 0xd9, 0x31, 0xb7, 0x70, 0x8f, 0xa8, 0x2a, 0x76, 0x06, 0x69, 0x7c, 0xa7, 0x25,
-	0xba, 0x25, 0x0f, 0x25, 0xf0, 0x15, 0xa8, 0xc4, 0x33, 0x13, 0xeb, 0x9b, 0x5c, 0x9e, 0x69, 0x9e,
-	0xca, 0xd5, 0xe7, 0x63, 0xaa, 0xd4, 0x49, 0x10, 0xba, 0x7c, 0x08, 0xb9, 0xa5, 0x42, 0x72, 0xb2,
-	0xf4, 0x08, 0xbb, 0x55, 0x98, 0x4b, 0xc9, 0x15, 0x4e, 0x77, 0x3b, 0xd3, 0xd1, 0x54, 0xc8, 0xf3,
-	0x30, 0x15, 0x1f, 0xab, 0x7b, 0x2a, 0xd4, 0x61, 0x8b, 0x68, 0xe3, 0x25, 0x58, 0x4c, 0x53, 0xb5,
-	0x06, 0x36, 0xf3, 0x7d, 0x1c, 0x04, 0x09, 0x81, 0x67, 0x00, 0x42, 0xb9, 0x33, 0xe4, 0x30, 0xad,
-	0x76, 0xda, 0x5d, 0xc3, 0x06, 0xfd, 0x30, 0xec, 0x7f, 0xe2, 0xb3, 0x06, 0xc7, 0x45, 0xce, 0xab,
-	0x01, 0xe3, 0x5b, 0xa1, 0xe7, 0x92, 0xc2, 0x66, 0x60, 0x78, 0xf2, 0x20, 0x42, 0x31, 0xb8, 0x0c,
-	0x95, 0x20, 0xde, 0x90, 0x80, 0x56, 0x43, 0x3d, 0x35, 0x27, 0xee, 0x7f, 0x6a, 0xde, 0x22, 0x3d,
-	0xec, 0x0e, 0x2e, 0x12, 0x37, 0xf3, 0xec, 0x5c, 0x24, 0xae, 0x2d, 0xf1, 0xc6, 0x67, 0xea, 0x72,
-	0x36, 0x59, 0xc4, 0x8b, 0xf8, 0xa0, 0x57, 0x61, 0x12, 0xf7, 0xf9, 0xc3, 0x38, 0x49, 0x8c, 0x43,
-	0x08, 0xa6, 0x22, 0xe2, 0xfb, 0xc2, 0x3e, 0x8e, 0xda, 0xe2, 0xb7, 0xd1, 0x52, 0xf7, 0x2d, 0xeb,
-	0x2b, 0x75, 0x75, 0x98, 0x72, 0x59, 0xc4, 0x55, 0x7f, 0x17, 0x47, 0x86, 0x2e, 0x19, 0xb7, 0x4d,
-	0xe6, 0x51, 0x5b, 0x84, 0x19, 0x9f, 0x82, 0x21, 0x72, 0xbc, 0xcb, 0x6e, 0x10, 0x1a, 0x5d, 0x62,
-	0xe1, 0x1b, 0xbb, 0xd8, 0xe5, 0x6d, 0x2a, 0x4d, 0xe1, 0x7f, 0x56, 0x65, 0xbc, 0x0f, 0xa7, 0x73,
-	0xab, 0x2b, 0x4d, 0x0d, 0xa8, 0x72, 0x11, 0x51, 0xac, 0x4a, 0x05, 0xa6, 0x6f, 0x86, 0xcd, 0xd8,
-	0xe5, 0x48, 0xb7, 0x70, 0x5c, 0x3e, 0x54, 0x2f, 0x86, 0x34, 0x5e, 0x95, 0xbe, 0x02, 0x33, 0xae,
-	0xdc, 0x72, 0x62, 0xa1, 0x72, 0x64, 0x96, 0xca, 0x8a, 0x04, 0x85, 0xdd, 0xe8, 0xf3, 0xe6, 0xcf,
-	0xb3, 0x50, 0x11, 0x25, 0xd0, 0xd7, 0x1a, 0x54, 0xa5, 0x27, 0x22, 0x33, 0x6f, 0xfe, 0xef, 0xb7,
-	0x63, 0xdd, 0x2a, 0x1d, 0x2f, 0xf9, 0x1b, 0x2b, 0x9f, 0xff, 0xfe, 0xf7, 0x57, 0x47, 0xce, 0x20,
-	0xc3, 0x2a, 0x7c, 0xff, 0xa2, 0x6f, 0x34, 0x80, 0xa1, 0x15, 0xa2, 0x7a, 0x71, 0xad, 0x8c, 0x75,
-	0xeb, 0x66, 0xd9, 0x70, 0xc5, 0xec, 0x39, 0xc1, 0xec, 0x34, 0x7a, 0x36, 0x97, 0x99, 0x60, 0xf2,
-	0x83, 0x06, 0xd3, 0x69, 0x06, 0x74, 0xae, 0x54, 0xa1, 0x84, 0x56, 0xbd, 0x64, 0xb4, 0x62, 0xb5,
-	0x2e, 0x58, 0xd5, 0xd1, 0x6a, 0x21, 0x2b, 0xeb, 0x96, 0x9a, 0xa4, 0xdb, 0xe8, 0xd7, 0xec, 0x3b,
-	0x24, 0xb5, 0x3c, 0x74, 0xbe, 0x54, 0xe9, 0x83, 0xf6, 0xaa, 0xbf, 0x30, 0x2e, 0x4c, 0x51, 0xdf,
-	0x10, 0xd4, 0x5f, 0x46, 0x2f, 0x16, 0x52, 0x77, 0x3a, 0x03, 0x47, 0xf9, 0xb5, 0x75, 0x6b, 0x68,
-	0xe5, 0xb7, 0xd1, 0x4f, 0x1a, 0x1c, 0x1b, 0x75, 0x4d, 0xd4, 0x28, 0x64, 0x73, 0xd0, 0x93, 0xf5,
-	0xe6, 0x38, 0x90, 0xb1, 0xfa, 0x1e, 0x43, 0x32, 0x7d, 0xff, 0x3e, 0x99, 0x8b, 0xd8, 0x01, 0x4b,
-	0xcc, 0x45, 0xc6, 0xa8, 0x4b, 0xcc, 0x45, 0xd6, 0x56, 0x8d, 0xa6, 0xe0, 0x77, 0x0e, 0xad, 0xe4,
-	0xf1, 0x8b, 0x1d, 0x35, 0x43, 0xef, 0x1f, 0x0d, 0x4e, 0xe4, 0xd8, 0x1b, 0x7a, 0xad, 0x90, 0x42,
-	0xae, 0x2b, 0xeb, 0xaf, 0x3f, 0x34, 0x5e, 0x89, 0xba, 0x22, 0x44, 0xb5, 0xd0, 0x85, 0x3c, 0x51,
-	0xd2, 0x50, 0x9d, 0x8f, 0x58, 0xe8, 0x90, 0x38, 0x8b, 0xe3, 0x51, 0xf5, 0xb7, 0x33, 0x23, 0xf5,
-	0x47, 0x0d, 0x66, 0xb3, 0xfe, 0x89, 0x8a, 0x8d, 0x6a, 0xd4, 0x99, 0xf5, 0xb5, 0xf2, 0x00, 0xc5,
-	0xfe, 0xbc, 0x60, 0x6f, 0xa1, 0x7a, 0xee, 0x95, 0x48, 0xd0, 0x61, 0x54, 0xd5, 0x37, 0x40, 0x09,
-	0xaa, 0xa3, 0x9f, 0x17, 0x25, 0xa8, 0x1e, 0xf8, 0xbc, 0x28, 0x47, 0x75, 0x47, 0x82, 0x86, 0x54,
-	0x5b, 0x6f, 0xde, 0xd9, 0xab, 0x69, 0x77, 0xf7, 0x6a, 0xda, 0x5f, 0x7b, 0x35, 0xed, 0xcb, 0xfd,
-	0xda, 0xc4, 0xdd, 0xfd, 0xda, 0xc4, 0x1f, 0xfb, 0xb5, 0x89, 0x0f, 0xd6, 0x7a, 0x1e, 0xbf, 0xbe,
-	0xdd, 0x31, 0x5d, 0xd6, 0x7f, 0x50, 0xca, 0x9d, 0x75, 0x6b, 0x57, 0x5e, 0xe0, 0x20, 0x20, 0x51,
-	0xa7, 0x2a, 0xbe, 0x98, 0xd6, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x00, 0xb3, 0x21, 0xf8, 0x61,
-	0x0e, 0x00, 0x00,
+	// 1510 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4b, 0x8f, 0xd4, 0xc6,
+	0x16, 0x1e, 0xcf, 0x93, 0x39, 0xc3, 0xe5, 0x42, 0x31, 0x70, 0x1b, 0xc3, 0x1d, 0xe6, 0x1a, 0x04,
+	0x73, 0x81, 0xb6, 0x67, 0x9a, 0xf0, 0x08, 0x09, 0x84, 0xe9, 0xe1, 0x35, 0x08, 0x29, 0x13, 0x4f,
+	0x44, 0x50, 0x36, 0x9d, 0x1a, 0x77, 0xd1, 0x58, 0xb8, 0x5d, 0x8d, 0xed, 0x9e, 0x4c, 0x87, 0x90,
+	0x45, 0xa4, 0xec, 0x23, 0xe5, 0xa5, 0x28, 0xc9, 0x26, 0xd9, 0x44, 0x4a, 0x16, 0x59, 0xb0, 0xca,
+	0x3a, 0x8a, 0xc8, 0x0e, 0x91, 0x4d, 0x94, 0x05, 0x8a, 0x20, 0x0b, 0x7e, 0x46, 0xe4, 0xaa, 0x63,
+	0xb7, 0xbb, 0x69, 0x6c, 0x77, 0x87, 0xec, 0x5c, 0xe5, 0xf3, 0x9d, 0xfa, 0xce, 0xa9, 0xf3, 0xa8,
+	0x2a, 0x38, 0x50, 0x6d, 0xd5, 0x99, 0xeb, 0xdb, 0xdc, 0xdd, 0x68, 0xbd, 0x63, 0xc4, 0x03, 0xc3,
+	0xf6, 0xb8, 0x71, 0xab, 0xc9, 0xbc, 0x96, 0xde, 0xf0, 0x78, 0xc0, 0x89, 0x9a, 0x94, 0xd3, 0xe3,
+	0x81, 0x6e, 0x7b, 0x5c, 0x9d, 0xae, 0xf1, 0x1a, 0x17, 0x62, 0x46, 0xf8, 0x25, 0x11, 0xea, 0x2e,
+	0x8b, 0xfb, 0x75, 0xee, 0x57, 0xe4, 0x0f, 0x39, 0xc0, 0x5f, 0x7b, 0x6a, 0x9c, 0xd7, 0x1c, 0x66,
+	0xd0, 0x86, 0x6d, 0x50, 0xd7, 0xe5, 0x01, 0x0d, 0x6c, 0xee, 0x46, 0x7f, 0xf7, 0xa7, 0x50, 0xb2,
+	0xbd, 0x48, 0xfd, 0x8c, 0xd4, 0x68, 0xac, 0x51, 0x9f, 0x19, 0xeb, 0x0b, 0x6b, 0x2c, 0xa0, 0x0b,
+	0x86, 0xc5, 0x6d, 0x17, 0xff, 0x1f, 0x4c, 0xd1, 0xd2, 0xa0, 0x1e, 0xad, 0x47, 0xcb, 0x1d, 0x4a,
+	0x2a, 0x12, 0x26, 0xc7, 0xea, 0x1a, 0xb4, 0x66, 0xbb, 0x82, 0x9b, 0x94, 0xd5, 0x3e, 0x56, 0x60,
+	0xc7, 0x6b, 0xa1, 0x88, 0xc9, 0xae, 0x33, 0xcf, 0xa3, 0x8e, 0x6f, 0xb2, 0x5b, 0x4d, 0xe6, 0x07,
+	0xe4, 0x3f, 0x30, 0xd1, 0x70, 0xa8, 0x5b, 0xb1, 0xab, 0x05, 0x65, 0x56, 0x99, 0x9b, 0x34, 0xc7,
+	0xc3, 0xe1, 0x72, 0x95, 0xa8, 0xb0, 0xc9, 0x13, 0xc2, 0xcc, 0x2b, 0x0c, 0x8b, 0x3f, 0xf1, 0x98,
+	0x5c, 0x00, 0x68, 0x2f, 0x51, 0x18, 0x99, 0x55, 0xe6, 0xa6, 0x4a, 0x07, 0x74, 0x74, 0x55, 0xc8,
+	0x47, 0x97, 0x5b, 0x80, 0x7c, 0xf4, 0x15, 0x5a, 0x63, 0xb8, 0xa0, 0x99, 0x40, 0x6a, 0xdf, 0x29,
+	0xb0, 0xb3, 0x9b, 0x96, 0xdf, 0xe0, 0xae, 0xcf, 0xc8, 0x25, 0x98, 0xf4, 0xa2, 0xc9, 0x82, 0x32,
+	0x3b, 0x32, 0x37, 0x55, 0xda, 0xaf, 0x3f, 0x7b, 0x2f, 0xf5, 0x48, 0x43, 0x79, 0xf4, 0xde, 0xc3,
+	0xbd, 0x43, 0x66, 0x1b, 0x4c, 0x2e, 0x76, 0x90, 0x1d, 0x16, 0x64, 0x0f, 0x66, 0x92, 0x95, 0x34,
+	0x3a, 0xd8, 0x1e, 0x87, 0xdd, 0x82, 0xec, 0xeb, 0x8c, 0xd6, 0x17, 0x1d, 0x87, 0x5b, 0x62, 0x3a,
+	0xd3, 0x93, 0xda, 0x06, 0xec, 0xe9, 0x8d, 0x43, 0x53, 0xaf, 0xc1, 0x14, 0x6d, 0x4f, 0xa3, 0xb1,
+	0xf3, 0x69, 0xc6, 0x76, 0x6a, 0x5a, 0x0d, 0x68, 0xd0, 0xf4, 0xd1, 0xf0, 0xa4, 0x2a, 0xed, 0xc9,
+	0x30, 0x4c, 0xf7, 0x92, 0x25, 0xa7, 0x60, 0x6a, 0x8d, 0xb9, 0xec, 0xba, 0x6d, 0xd9, 0xd4, 0x6b,
+	0x49, 0xbe, 0xe5, 0xc2, 0x83, 0xbb, 0xc5, 0x69, 0xf4, 0xcb, 0x62, 0xb5, 0xea, 0x31, 0xdf, 0x5f,
+	0x0d, 0x3c, 0xdb, 0xad, 0x99, 0x49, 0x61, 0xb2, 0x04, 0xe3, 0xb4, 0xce, 0x9b, 0x6e, 0x20, 0xc3,
+	0xa2, 0x7c, 0x38, 0x5c, 0xf7, 0xf7, 0x87, 0x7b, 0x77, 0x48, 0xa8, 0x5f, 0xbd, 0xa9, 0xdb, 0xdc,
+	0xa8, 0xd3, 0xe0, 0x86, 0xbe, 0xec, 0x06, 0x0f, 0xee, 0x16, 0x01, 0x75, 0x2e, 0xbb, 0x81, 0x89,
+	0xd0, 0x50, 0xc9, 0x3a, 0xf3, 0x03, 0x56, 0x2d, 0x8c, 0x0c, 0xa0, 0x44, 0x42, 0xc9, 0x79, 0x98,
+	0xb0, 0x1c, 0x6a, 0xd7, 0x59, 0xb5, 0x30, 0xda, 0xbf, 0x96, 0x08, 0x1b, 0x72, 0x71, 0xb8, 0x75,
+	0x93, 0x55, 0x0b, 0x63, 0x03, 0x70, 0x91, 0x50, 0xed, 0x32, 0x26, 0xd8, 0x4a, 0xd3, 0xb3, 0x6e,
+	0x50, 0x9f, 0x55, 0x33, 0x13, 0xac, 0x00, 0x13, 0x54, 0x7a, 0x19, 0xf3, 0x2b, 0x1a, 0x6a, 0x5f,
+	0x47, 0x69, 0x91, 0x50, 0x86, 0xb1, 0xb2, 0x0c, 0x93, 0x8d, 0x68, 0xb2, 0xa0, 0xf4, 0x4f, 0xb7,
+	0x8d, 0x26, 0xa7, 0x61, 0xc4, 0xa2, 0x8d, 0x41, 0x36, 0x31, 0xc4, 0x69, 0x3a, 0x6c, 0x17, 0x1c,
+	0xaf, 0x32, 0x3f, 0x08, 0x63, 0x24, 0x2b, 0x0b, 0x3e, 0x1f, 0x86, 0xe9, 0x4e, 0x00, 0x9a, 0x34,
+	0x0d, 0x63, 0xfc, 0x6d, 0x97, 0x79, 0x28, 0x2f, 0x07, 0x64, 0x11, 0xc6, 0x02, 0x1e, 0x50, 0x67,
+	0x10, 0x7e, 0x12, 0x49, 0x56, 0xe0, 0x5f, 0x32, 0x50, 0x2a, 0x18, 0xaf, 0x03, 0x84, 0xda, 0x66,
+	0xa9, 0x61, 0x51, 0x46, 0xed, 0x55, 0xd8, 0x2a, 0x82, 0x86, 0xae, 0x39, 0x2c, 0x52, 0x3a, 0x40,
+	0xe4, 0xfd, 0x3b, 0x56, 0x22, 0xf5, 0x6a, 0xd3, 0x40, 0xe4, 0x7e, 0x8b, 0xfa, 0x8e, 0xae, 0xd4,
+	0xde, 0x80, 0xed, 0x1d, 0xb3, 0xe8, 0xaf, 0xb3, 0x30, 0x2e, 0xfb, 0x80, 0x70, 0xd8, 0x54, 0x49,
+	0x4b, 0xab, 0x14, 0x12, 0x8b, 0xb5, 0x01, 0x71, 0xda, 0x07, 0x0a, 0x6c, 0x93, 0x9a, 0x1d, 0xda,
+	0xae, 0x5f, 0x73, 0xb0, 0xd5, 0xe5, 0x6e, 0xc5, 0x67, 0x41, 0xe0, 0xb0, 0x6a, 0x85, 0xbb, 0x8e,
+	0x2c, 0x0c, 0x9b, 0xcc, 0x2d, 0x2e, 0x77, 0x57, 0xe5, 0xf4, 0xab, 0xae, 0xd3, 0xea, 0x2a, 0xff,
+	0xc3, 0x03, 0x97, 0xff, 0x2f, 0x14, 0x20, 0x49, 0x1e, 0x68, 0xe0, 0xcb, 0x30, 0x16, 0xc6, 0x4c,
+	0x54, 0x09, 0x67, 0x53, 0xed, 0x73, 0xa8, 0x8b, 0xd6, 0x49, 0xd0, 0xf3, 0x2b, 0xf7, 0x87, 0x61,
+	0x6b, 0x4c, 0x2e, 0x33, 0xba, 0x97, 0x13, 0x1e, 0x8d, 0x0d, 0x79, 0x01, 0x46, 0xc3, 0xdf, 0xb8,
+	0x4f, 0x99, 0x76, 0x98, 0x42, 0x5a, 0x3b, 0x05, 0xbb, 0x62, 0x55, 0xe5, 0x96, 0xc9, 0x1d, 0x87,
+	0x36, 0x1a, 0x11, 0x81, 0xff, 0x02, 0x78, 0x72, 0xa6, 0xcd, 0x61, 0x12, 0x67, 0x96, 0xab, 0x9a,
+	0x09, 0x6a, 0x2f, 0xec, 0xdf, 0xe2, 0x33, 0x8f, 0x95, 0x6d, 0xb5, 0xc1, 0x83, 0x15, 0xcf, 0xb6,
+	0x58, 0xa6, 0x33, 0x28, 0xec, 0xec, 0x46, 0x20, 0x83, 0x8b, 0x30, 0xd6, 0x08, 0x27, 0xb0, 0x74,
+	0x2d, 0x60, 0xd6, 0xec, 0x7e, 0x3a, 0x6b, 0xae, 0xb0, 0x1a, 0xb5, 0x5a, 0xe7, 0x98, 0x95, 0xc8,
+	0x9d, 0x73, 0xcc, 0x32, 0x25, 0x5e, 0xfb, 0x49, 0xc1, 0xe4, 0x58, 0xa2, 0x6e, 0xd5, 0x61, 0xd9,
+	0xc7, 0x99, 0x2b, 0x00, 0x1e, 0xf3, 0xb9, 0xd3, 0x8c, 0xc3, 0x62, 0x4b, 0xe9, 0x48, 0x9a, 0x07,
+	0xa4, 0x62, 0x33, 0xc6, 0x98, 0x09, 0xfc, 0x73, 0x3b, 0x00, 0x7d, 0xa3, 0x60, 0x51, 0x8c, 0xcd,
+	0x40, 0x47, 0x95, 0x61, 0xc2, 0x92, 0x53, 0x98, 0x05, 0x5a, 0x36, 0x57, 0xcc, 0x83, 0x08, 0xf8,
+	0xfc, 0x32, 0xe1, 0x3d, 0xcc, 0x84, 0x25, 0xee, 0x07, 0x99, 0x8e, 0x3e, 0x0d, 0x23, 0xb4, 0x3e,
+	0xd0, 0xd9, 0x20, 0xc4, 0x11, 0x02, 0xa3, 0x3e, 0x73, 0x1c, 0xe1, 0xd3, 0x4d, 0xa6, 0xf8, 0xd6,
+	0xca, 0xb0, 0x2d, 0xb1, 0x3e, 0x7a, 0xa8, 0x08, 0xa3, 0x16, 0xf7, 0x03, 0x0c, 0xe6, 0x5d, 0x1d,
+	0x76, 0x45, 0x16, 0x2d, 0x71, 0xdb, 0x35, 0x85, 0x98, 0xf6, 0x2e, 0x68, 0xf2, 0x10, 0xc6, 0x6f,
+	0x32, 0xd7, 0xbf, 0xc0, 0xbd, 0xf3, 0x1b, 0xd4, 0x0a, 0x96, 0x5d, 0x59, 0x81, 0xff, 0x61, 0xab,
+	0xb4, 0x6b, 0xb0, 0x2f, 0x75, 0x75, 0xb4, 0x69, 0x01, 0xc6, 0x03, 0x21, 0x91, 0x6d, 0x15, 0x0a,
+	0xc6, 0x6d, 0x78, 0x49, 0x1e, 0x66, 0x32, 0x73, 0xf3, 0x2d, 0x98, 0xee, 0x94, 0x8f, 0xcf, 0xdb,
+	0x53, 0x78, 0x1e, 0xaa, 0x84, 0x86, 0xca, 0xfc, 0x3c, 0x98, 0xd7, 0x48, 0x40, 0xec, 0x62, 0x3d,
+	0x28, 0x7d, 0x46, 0x60, 0x4c, 0x2c, 0x41, 0x3e, 0x51, 0x60, 0x5c, 0x36, 0x20, 0xa2, 0xa7, 0x85,
+	0xef, 0xd3, 0xbd, 0x4f, 0x35, 0x72, 0xcb, 0x4b, 0xfe, 0xda, 0xa1, 0xf7, 0x7f, 0xfd, 0xf3, 0xa3,
+	0xe1, 0xfd, 0x44, 0x33, 0x32, 0xef, 0x4f, 0xe4, 0x53, 0x05, 0xa0, 0xdd, 0x77, 0x48, 0x31, 0x7b,
+	0xad, 0x44, 0x9f, 0x54, 0xf5, 0xbc, 0xe2, 0xc8, 0xec, 0xff, 0x82, 0xd9, 0x3e, 0xf2, 0xbf, 0x54,
+	0x66, 0x82, 0xc9, 0x57, 0x0a, 0x4c, 0xc6, 0x1a, 0xc8, 0x91, 0x5c, 0x0b, 0x45, 0xb4, 0x8a, 0x39,
+	0xa5, 0x91, 0xd5, 0x51, 0xc1, 0xaa, 0x48, 0x0e, 0x67, 0xb2, 0x32, 0x6e, 0x63, 0x24, 0xdd, 0x21,
+	0x3f, 0x27, 0x1b, 0x76, 0xdc, 0x5f, 0xc8, 0xb1, 0x5c, 0x4b, 0x77, 0xf7, 0x32, 0xf5, 0x78, 0xbf,
+	0x30, 0xa4, 0xbe, 0x28, 0xa8, 0xbf, 0x44, 0x5e, 0xcc, 0xa4, 0x5e, 0x59, 0x6b, 0x55, 0xb0, 0x39,
+	0x1a, 0xb7, 0xdb, 0x7d, 0xf3, 0x0e, 0xf9, 0x5e, 0x81, 0x2d, 0x9d, 0x2d, 0x8a, 0x2c, 0x64, 0xb2,
+	0xe9, 0x6e, 0x80, 0x6a, 0xa9, 0x1f, 0x48, 0x5f, 0x7e, 0x0f, 0x21, 0x09, 0xbf, 0x7f, 0xab, 0xc0,
+	0xe6, 0x64, 0x9b, 0x20, 0xd9, 0xe9, 0xd1, 0xd9, 0x17, 0xd5, 0xf9, 0xfc, 0x00, 0x24, 0x7a, 0x4c,
+	0x10, 0x35, 0x48, 0x31, 0x8d, 0x28, 0xb6, 0x9a, 0x04, 0xd5, 0x2f, 0xa3, 0x10, 0x0e, 0x8b, 0x75,
+	0x8e, 0x10, 0x4e, 0xf4, 0x14, 0xb5, 0x98, 0x53, 0x1a, 0x19, 0x96, 0x04, 0xc3, 0x23, 0xe4, 0x50,
+	0x2a, 0x43, 0xee, 0x07, 0x09, 0x7a, 0x4f, 0x94, 0xe8, 0x12, 0xdf, 0xb3, 0x12, 0x93, 0x33, 0x99,
+	0x14, 0x52, 0x1b, 0x88, 0xfa, 0xca, 0xc0, 0x78, 0x34, 0xea, 0x92, 0x30, 0xaa, 0x4c, 0xce, 0xa6,
+	0x19, 0x25, 0x6b, 0x7f, 0xe5, 0x3a, 0xf7, 0x2a, 0x2c, 0xd4, 0x52, 0xb1, 0x5d, 0xbc, 0x8e, 0xf4,
+	0x0c, 0x1a, 0xbc, 0xe7, 0xe6, 0x08, 0x9a, 0x8e, 0x26, 0xa2, 0xce, 0xe7, 0x07, 0xf4, 0x15, 0x34,
+	0x12, 0xd4, 0x8b, 0x2a, 0xde, 0x0d, 0x73, 0x50, 0xed, 0xbc, 0x76, 0xaa, 0xf3, 0xf9, 0x01, 0xfd,
+	0x50, 0x5d, 0x97, 0xa0, 0x04, 0xd5, 0x1f, 0xa3, 0xca, 0x11, 0xdf, 0xcd, 0x73, 0x54, 0x8e, 0xee,
+	0x47, 0x01, 0xb5, 0xd4, 0x0f, 0xa4, 0xaf, 0xb2, 0x17, 0xc1, 0xda, 0x94, 0x8d, 0xdb, 0xf8, 0xae,
+	0x70, 0x87, 0xfc, 0x12, 0x1d, 0x37, 0xbb, 0x9e, 0xa2, 0xc8, 0x89, 0xec, 0xb0, 0xed, 0xf9, 0xe8,
+	0xa5, 0x9e, 0xec, 0x1f, 0x88, 0xe6, 0x9c, 0x11, 0xe6, 0x9c, 0x24, 0xc7, 0x53, 0x03, 0x9d, 0xd1,
+	0x7a, 0x25, 0xf1, 0xa2, 0x95, 0xd8, 0x88, 0x1f, 0xa2, 0x8d, 0x88, 0xdf, 0x0e, 0x73, 0x6c, 0x44,
+	0xf7, 0xf3, 0xa7, 0x5a, 0xea, 0x07, 0x82, 0xcc, 0x4f, 0x08, 0xe6, 0x0b, 0xc4, 0x48, 0x63, 0x1e,
+	0xbf, 0x3f, 0xb6, 0x29, 0x97, 0x2f, 0xdf, 0x7b, 0x34, 0xa3, 0xdc, 0x7f, 0x34, 0xa3, 0xfc, 0xf1,
+	0x68, 0x46, 0xf9, 0xf0, 0xf1, 0xcc, 0xd0, 0xfd, 0xc7, 0x33, 0x43, 0xbf, 0x3d, 0x9e, 0x19, 0x7a,
+	0x73, 0xbe, 0x66, 0x07, 0x37, 0x9a, 0x6b, 0xba, 0xc5, 0xeb, 0xcf, 0x52, 0xba, 0x7e, 0xd4, 0xd8,
+	0x90, 0x3e, 0x69, 0x35, 0x98, 0xbf, 0x36, 0x2e, 0x1e, 0x76, 0x8f, 0xfe, 0x35, 0x00, 0xe0, 0xa6,
+	0x08, 0xd2, 0x08, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -987,6 +1493,9 @@ type QueryClient interface {
 	// QuerySpotPrice retrieves the current spot price for the specified plan ID.
 	// The result is the price of 1 IRO token (not iro's base denom)
 	QuerySpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error)
+	// QueryCandles retrieves the OHLCV price candles of the specified plan ID
+	// at the given resolution, oldest first.
+	QueryCandles(ctx context.Context, in *QueryCandlesRequest, opts ...grpc.CallOption) (*QueryCandlesResponse, error)
 	// QueryCost retrieves the expected cost for buying or selling the specified
 	// amount of shares.
 	QueryCost(ctx context.Context, in *QueryCostRequest, opts ...grpc.CallOption) (*QueryCostResponse, error)
@@ -997,6 +1506,15 @@ type QueryClient interface {
 	// QueryVesting queries the claimable and vested amount for
 	// the specified plan ID.
 	QueryVesting(ctx context.Context, in *QueryVestingRequest, opts ...grpc.CallOption) (*QueryVestingResponse, error)
+	// QueryPurchased queries the amount of tokens an address bought from the
+	// plan, and how much it can buy in total at the current time.
+	QueryPurchased(ctx context.Context, in *QueryPurchasedRequest, opts ...grpc.CallOption) (*QueryPurchasedResponse, error)
+	// QueryTeamAllocations queries the vested, claimed and locked amounts of
+	// the team allocations of the specified plan ID.
+	QueryTeamAllocations(ctx context.Context, in *QueryTeamAllocationsRequest, opts ...grpc.CallOption) (*QueryTeamAllocationsResponse, error)
+	// QueryReferrals queries the volume and earnings of the referrers of the
+	// specified plan ID, or of a single referrer if set.
+	QueryReferrals(ctx context.Context, in *QueryReferralsRequest, opts ...grpc.CallOption) (*QueryReferralsResponse, error)
 }
 
 type queryClient struct {
@@ -1052,6 +1570,15 @@ func (c *queryClient) QuerySpotPrice(ctx context.Context, in *QuerySpotPriceRequ
 	return out, nil
 }
 
+func (c *queryClient) QueryCandles(ctx context.Context, in *QueryCandlesRequest, opts ...grpc.CallOption) (*QueryCandlesResponse, error) {
+	out := new(QueryCandlesResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryCandles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) QueryCost(ctx context.Context, in *QueryCostRequest, opts ...grpc.CallOption) (*QueryCostResponse, error) {
 	out := new(QueryCostResponse)
 	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryCost", in, out, opts...)
@@ -1088,6 +1615,33 @@ func (c *queryClient) QueryVesting(ctx context.Context, in *QueryVestingRequest,
 	return out, nil
 }
 
+func (c *queryClient) QueryPurchased(ctx context.Context, in *QueryPurchasedRequest, opts ...grpc.CallOption) (*QueryPurchasedResponse, error) {
+	out := new(QueryPurchasedResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryPurchased", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryTeamAllocations(ctx context.Context, in *QueryTeamAllocationsRequest, opts ...grpc.CallOption) (*QueryTeamAllocationsResponse, error) {
+	out := new(QueryTeamAllocationsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryTeamAllocations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryReferrals(ctx context.Context, in *QueryReferralsRequest, opts ...grpc.CallOption) (*QueryReferralsResponse, error) {
+	out := new(QueryReferralsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryReferrals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of the IRO module.
@@ -1101,6 +1655,9 @@ type QueryServer interface {
 	// QuerySpotPrice retrieves the current spot price for the specified plan ID.
 	// The result is the price of 1 IRO token (not iro's base denom)
 	QuerySpotPrice(context.Context, *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error)
+	// QueryCandles retrieves the OHLCV price candles of the specified plan ID
+	// at the given resolution, oldest first.
+	QueryCandles(context.Context, *QueryCandlesRequest) (*QueryCandlesResponse, error)
 	// QueryCost retrieves the expected cost for buying or selling the specified
 	// amount of shares.
 	QueryCost(context.Context, *QueryCostRequest) (*QueryCostResponse, error)
@@ -1111,6 +1668,15 @@ type QueryServer interface {
 	// QueryVesting queries the claimable and vested amount for
 	// the specified plan ID.
 	QueryVesting(context.Context, *QueryVestingRequest) (*QueryVestingResponse, error)
+	// QueryPurchased queries the amount of tokens an address bought from the
+	// plan, and how much it can buy in total at the current time.
+	QueryPurchased(context.Context, *QueryPurchasedRequest) (*QueryPurchasedResponse, error)
+	// QueryTeamAllocations queries the vested, claimed and locked amounts of
+	// the team allocations of the specified plan ID.
+	QueryTeamAllocations(context.Context, *QueryTeamAllocationsRequest) (*QueryTeamAllocationsResponse, error)
+	// QueryReferrals queries the volume and earnings of the referrers of the
+	// specified plan ID, or of a single referrer if set.
+	QueryReferrals(context.Context, *QueryReferralsRequest) (*QueryReferralsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1132,6 +1698,9 @@ func (*UnimplementedQueryServer) QueryPlanByRollapp(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) QuerySpotPrice(ctx context.Context, req *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QuerySpotPrice not implemented")
 }
+func (*UnimplementedQueryServer) QueryCandles(ctx context.Context, req *QueryCandlesRequest) (*QueryCandlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCandles not implemented")
+}
 func (*UnimplementedQueryServer) QueryCost(ctx context.Context, req *QueryCostRequest) (*QueryCostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryCost not implemented")
 }
@@ -1144,6 +1713,15 @@ func (*UnimplementedQueryServer) QueryClaimed(ctx context.Context, req *QueryCla
 func (*UnimplementedQueryServer) QueryVesting(ctx context.Context, req *QueryVestingRequest) (*QueryVestingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryVesting not implemented")
 }
+func (*UnimplementedQueryServer) QueryPurchased(ctx context.Context, req *QueryPurchasedRequest) (*QueryPurchasedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPurchased not implemented")
+}
+func (*UnimplementedQueryServer) QueryTeamAllocations(ctx context.Context, req *QueryTeamAllocationsRequest) (*QueryTeamAllocationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryTeamAllocations not implemented")
+}
+func (*UnimplementedQueryServer) QueryReferrals(ctx context.Context, req *QueryReferralsRequest) (*QueryReferralsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryReferrals not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1239,6 +1817,24 @@ func _Query_QuerySpotPrice_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QueryCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCandlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCandles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryCandles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCandles(ctx, req.(*QueryCandlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_QueryCost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCostRequest)
 	if err := dec(in); err != nil {
@@ -1311,6 +1907,60 @@ func _Query_QueryVesting_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QueryPurchased_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPurchasedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPurchased(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryPurchased",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPurchased(ctx, req.(*QueryPurchasedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryTeamAllocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTeamAllocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryTeamAllocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryTeamAllocations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryTeamAllocations(ctx, req.(*QueryTeamAllocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryReferrals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReferralsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryReferrals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryReferrals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryReferrals(ctx, req.(*QueryReferralsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dymensionxyz.dymension.iro.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1335,6 +1985,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QuerySpotPrice",
 			Handler:    _Query_QuerySpotPrice_Handler,
 		},
+		{
+			MethodName: "QueryCandles",
+			Handler:    _Query_QueryCandles_Handler,
+		},
 		{
 			MethodName: "QueryCost",
 			Handler:    _Query_QueryCost_Handler,
@@ -1351,12 +2005,24 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryVesting",
 			Handler:    _Query_QueryVesting_Handler,
 		},
+		{
+			MethodName: "QueryPurchased",
+			Handler:    _Query_QueryPurchased_Handler,
+		},
+		{
+			MethodName: "QueryTeamAllocations",
+			Handler:    _Query_QueryTeamAllocations_Handler,
+		},
+		{
+			MethodName: "QueryReferrals",
+			Handler:    _Query_QueryReferrals_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dymensionxyz/dymension/iro/query.proto",
 }
 
-func (m *QueryVestingRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryReferralsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1366,16 +2032,35 @@ func (m *QueryVestingRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryVestingRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryReferralsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVestingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryReferralsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.PlanId) > 0 {
 		i -= len(m.PlanId)
 		copy(dAtA[i:], m.PlanId)
@@ -1386,7 +2071,7 @@ func (m *QueryVestingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryVestingResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryReferralsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1396,57 +2081,46 @@ func (m *QueryVestingResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryVestingResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryReferralsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVestingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryReferralsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.ClaimableAmount.Size()
-		i -= size
-		if _, err := m.ClaimableAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.VestedAmount.Size()
-		i -= size
-		if _, err := m.VestedAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.Total.Size()
-		i -= size
-		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.Referrals) > 0 {
+		for iNdEx := len(m.Referrals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Referrals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTeamAllocationsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1456,20 +2130,27 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTeamAllocationsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTeamAllocationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTeamAllocationsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1479,30 +2160,34 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTeamAllocationsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTeamAllocationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Allocations) > 0 {
+		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPlansRequest) Marshal() (dAtA []byte, err error) {
+func (m *TeamAllocationStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1512,91 +2197,67 @@ func (m *QueryPlansRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPlansRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TeamAllocationStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPlansRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TeamAllocationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size := m.Locked.Size()
+		i -= size
+		if _, err := m.Locked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x12
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.NonSettledOnly {
-		i--
-		if m.NonSettledOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Claimed.Size()
+		i -= size
+		if _, err := m.Claimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryPlansResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPlansResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryPlansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Vested.Size()
+		i -= size
+		if _, err := m.Vested.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x12
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if len(m.Plans) > 0 {
-		for iNdEx := len(m.Plans) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Plans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPlanRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPurchasedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1606,16 +2267,23 @@ func (m *QueryPlanRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPlanRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPurchasedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPurchasedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.PlanId) > 0 {
 		i -= len(m.PlanId)
 		copy(dAtA[i:], m.PlanId)
@@ -1626,7 +2294,7 @@ func (m *QueryPlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPlanResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPurchasedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1636,32 +2304,40 @@ func (m *QueryPlanResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPlanResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPurchasedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPurchasedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Plan != nil {
-		{
-			size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size := m.Cap.Size()
+		i -= size
+		if _, err := m.Cap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Purchased.Size()
+		i -= size
+		if _, err := m.Purchased.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPlanByRollappRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryVestingRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1671,27 +2347,27 @@ func (m *QueryPlanByRollappRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPlanByRollappRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryVestingRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPlanByRollappRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryVestingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPlanByRollappResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryVestingResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1701,32 +2377,57 @@ func (m *QueryPlanByRollappResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPlanByRollappResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryVestingResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPlanByRollappResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryVestingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Plan != nil {
-		{
-			size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size := m.ClaimableAmount.Size()
+		i -= size
+		if _, err := m.ClaimableAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.VestedAmount.Size()
+		i -= size
+		if _, err := m.VestedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySpotPriceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1736,27 +2437,20 @@ func (m *QuerySpotPriceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySpotPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySpotPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PlanId) > 0 {
-		i -= len(m.PlanId)
-		copy(dAtA[i:], m.PlanId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySpotPriceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1766,22 +2460,22 @@ func (m *QuerySpotPriceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySpotPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
@@ -1789,7 +2483,7 @@ func (m *QuerySpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCostRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPlansRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1799,47 +2493,42 @@ func (m *QueryCostRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryCostRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPlansRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPlansRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Sell {
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		if m.Sell {
-			dAtA[i] = 1
+		dAtA[i] = 0x12
+	}
+	if m.NonSettledOnly {
+		i--
+		if m.NonSettledOnly {
+			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x18
-	}
-	{
-		size := m.Amt.Size()
-		i -= size
-		if _, err := m.Amt.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.PlanId) > 0 {
-		i -= len(m.PlanId)
-		copy(dAtA[i:], m.PlanId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
-		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCostResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPlansResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1849,19 +2538,19 @@ func (m *QueryCostResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryCostResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPlansResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPlansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Cost != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.Cost.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1869,12 +2558,26 @@ func (m *QueryCostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.Plans) > 0 {
+		for iNdEx := len(m.Plans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Plans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTokensForExactInAmountRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPlanRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1884,26 +2587,16 @@ func (m *QueryTokensForExactInAmountRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryTokensForExactInAmountRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPlanRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTokensForExactInAmountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Amt.Size()
-		i -= size
-		if _, err := m.Amt.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
 	if len(m.PlanId) > 0 {
 		i -= len(m.PlanId)
 		copy(dAtA[i:], m.PlanId)
@@ -1914,7 +2607,7 @@ func (m *QueryTokensForExactInAmountRequest) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTokensForExactInAmountResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPlanResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1924,19 +2617,19 @@ func (m *QueryTokensForExactInAmountResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryTokensForExactInAmountResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPlanResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTokensForExactInAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Tokens != nil {
+	if m.Plan != nil {
 		{
-			size, err := m.Tokens.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1949,7 +2642,7 @@ func (m *QueryTokensForExactInAmountResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryClaimedRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPlanByRollappRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1959,27 +2652,27 @@ func (m *QueryClaimedRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryClaimedRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPlanByRollappRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryClaimedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPlanByRollappRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PlanId) > 0 {
-		i -= len(m.PlanId)
-		copy(dAtA[i:], m.PlanId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryClaimedResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPlanByRollappResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1989,23 +2682,23 @@ func (m *QueryClaimedResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryClaimedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPlanByRollappResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryClaimedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPlanByRollappResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ClaimedAmt != nil {
+	if m.Plan != nil {
 		{
-			size := m.ClaimedAmt.Size()
-			i -= size
-			if _, err := m.ClaimedAmt.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
@@ -2014,270 +2707,1698 @@ func (m *QueryClaimedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySpotPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryVestingRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PlanId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QuerySpotPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVestingResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySpotPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Total.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.VestedAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.ClaimableAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySpotPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QuerySpotPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPlansRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NonSettledOnly {
-		n += 2
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPlansResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCandlesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCandlesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCandlesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Plans) > 0 {
-		for _, e := range m.Plans {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Resolution != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Resolution))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPlanRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PlanId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryCandlesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPlanResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Plan != nil {
-		l = m.Plan.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryCandlesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPlanByRollappRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCandlesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryPlanByRollappResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if m.Plan != nil {
-		l = m.Plan.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Candles) > 0 {
+		for iNdEx := len(m.Candles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Candles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySpotPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PlanId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryCostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySpotPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Price.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryCostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCostRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PlanId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = m.Amt.Size()
-	n += 1 + l + sovQuery(uint64(l))
 	if m.Sell {
-		n += 2
+		i--
+		if m.Sell {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	{
+		size := m.Amt.Size()
+		i -= size
+		if _, err := m.Amt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCostResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Cost != nil {
-		l = m.Cost.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Cost.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTokensForExactInAmountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PlanId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryTokensForExactInAmountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Amt.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTokensForExactInAmountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Tokens != nil {
-		l = m.Tokens.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryTokensForExactInAmountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryClaimedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTokensForExactInAmountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PlanId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.Amt.Size()
+		i -= size
+		if _, err := m.Amt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryClaimedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTokensForExactInAmountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokensForExactInAmountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokensForExactInAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ClaimedAmt != nil {
-		l = m.ClaimedAmt.Size()
+	if m.Tokens != nil {
+		{
+			size, err := m.Tokens.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClaimedAmt != nil {
+		{
+			size := m.ClaimedAmt.Size()
+			i -= size
+			if _, err := m.ClaimedAmt.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryReferralsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReferralsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Referrals) > 0 {
+		for _, e := range m.Referrals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTeamAllocationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTeamAllocationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allocations) > 0 {
+		for _, e := range m.Allocations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TeamAllocationStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Vested.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Claimed.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Locked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPurchasedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPurchasedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Purchased.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Cap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVestingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.VestedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ClaimableAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPlansRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NonSettledOnly {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryPlansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Plans) > 0 {
+		for _, e := range m.Plans {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Plan != nil {
+		l = m.Plan.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlanByRollappRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlanByRollappResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Plan != nil {
+		l = m.Plan.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpotPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpotPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCandlesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Resolution != 0 {
+		n += 1 + sovQuery(uint64(m.Resolution))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCandlesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Candles) > 0 {
+		for _, e := range m.Candles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amt.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Sell {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryCostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cost != nil {
+		l = m.Cost.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokensForExactInAmountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amt.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTokensForExactInAmountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tokens != nil {
+		l = m.Tokens.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClaimedAmt != nil {
+		l = m.ClaimedAmt.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryReferralsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReferralsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReferralsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReferralsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReferralsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReferralsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrals = append(m.Referrals, Referral{})
+			if err := m.Referrals[len(m.Referrals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTeamAllocationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTeamAllocationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTeamAllocationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTeamAllocationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTeamAllocationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTeamAllocationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allocations = append(m.Allocations, TeamAllocationStatus{})
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TeamAllocationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TeamAllocationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TeamAllocationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Vested.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Locked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPurchasedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPurchasedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPurchasedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPurchasedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPurchasedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPurchasedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchased", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Purchased.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
 func (m *QueryVestingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -3406,6 +5527,263 @@ func (m *QuerySpotPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryCandlesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCandlesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCandlesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
+			}
+			m.Resolution = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Resolution |= CandleResolution(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCandlesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCandlesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCandlesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Candles = append(m.Candles, Candle{})
+			if err := m.Candles[len(m.Candles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryCostRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewReferral returns a referral with no volume nor earnings
+func NewReferral(planId, referrer string) Referral {
+	return Referral{
+		PlanId:   planId,
+		Referrer: referrer,
+		Volume:   math.ZeroInt(),
+		Earnings: math.ZeroInt(),
+	}
+}
+
+func (r Referral) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(r.Referrer); err != nil {
+		return fmt.Errorf("invalid referrer address: %s: %w", r.Referrer, err)
+	}
+	if r.Volume.IsNil() || r.Volume.IsNegative() {
+		return fmt.Errorf("referral volume must be non-negative: %s", r.Volume)
+	}
+	if r.Earnings.IsNil() || r.Earnings.IsNegative() {
+		return fmt.Errorf("referral earnings must be non-negative: %s", r.Earnings)
+	}
+	return nil
+}
+
+// ValidateReferrer checks the optional referrer of a trade. Traders can't refer themselves.
+func ValidateReferrer(referrer, trader string) error {
+	if referrer == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(referrer); err != nil {
+		return fmt.Errorf("invalid referrer address: %w", err)
+	}
+	if referrer == trader {
+		return ErrSelfReferral
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+func (r SwapRoute) ValidateBasic() error {
+	if r.PoolId == 0 {
+		return errors.New("pool id must be positive")
+	}
+	if err := sdk.ValidateDenom(r.TokenOutDenom); err != nil {
+		return fmt.Errorf("invalid token out denom: %w", err)
+	}
+	return nil
+}
+
+// ValidateSwapRoutes checks the routes of a swap from the given denom
+func ValidateSwapRoutes(routes []SwapRoute, denomIn string) error {
+	if len(routes) == 0 {
+		return errors.New("swap routes cannot be empty")
+	}
+	for _, r := range routes {
+		if err := r.ValidateBasic(); err != nil {
+			return err
+		}
+		if r.TokenOutDenom == denomIn {
+			return fmt.Errorf("swap route pool %d outputs its input denom: %s", r.PoolId, denomIn)
+		}
+		denomIn = r.TokenOutDenom
+	}
+	return nil
+}
+
+// ToPoolManagerRoutes converts the routes to the poolmanager swap routes
+func ToPoolManagerRoutes(routes []SwapRoute) []poolmanagertypes.SwapAmountInRoute {
+	res := make([]poolmanagertypes.SwapAmountInRoute, 0, len(routes))
+	for _, r := range routes {
+		res = append(res, poolmanagertypes.SwapAmountInRoute{PoolId: r.PoolId, TokenOutDenom: r.TokenOutDenom})
+	}
+	return res
+}
@@ -0,0 +1,105 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxTeamAllocations bounds the number of team allocations of a plan
+const MaxTeamAllocations = 20
+
+// NewTeamAllocation returns a team allocation with nothing claimed. The start time is set on settlement.
+func NewTeamAllocation(beneficiary string, amount math.Int, cliff, vestingDuration time.Duration) TeamAllocation {
+	return TeamAllocation{
+		Beneficiary:     beneficiary,
+		Amount:          amount,
+		Cliff:           cliff,
+		VestingDuration: vestingDuration,
+		Claimed:         math.ZeroInt(),
+	}
+}
+
+func (a TeamAllocation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(a.Beneficiary); err != nil {
+		return fmt.Errorf("invalid beneficiary address: %w", err)
+	}
+	if a.Amount.IsNil() || !a.Amount.IsPositive() {
+		return fmt.Errorf("amount must be positive: %s", a.Amount)
+	}
+	if a.Cliff < 0 || a.VestingDuration < 0 {
+		return errors.New("cliff and vesting duration cannot be negative")
+	}
+	if a.Cliff > a.VestingDuration {
+		return fmt.Errorf("cliff cannot exceed the vesting duration: %s > %s", a.Cliff, a.VestingDuration)
+	}
+	if !a.Claimed.IsNil() && (a.Claimed.IsNegative() || a.Claimed.GT(a.Amount)) {
+		return fmt.Errorf("claimed must be between 0 and the amount: %s", a.Claimed)
+	}
+	return nil
+}
+
+// VestedAmt returns the total amount vested at the given time, claimed or not.
+// Nothing is vested before settlement.
+func (a TeamAllocation) VestedAmt(currTime time.Time) math.Int {
+	// not settled or before the cliff
+	if a.StartTime.IsZero() || currTime.Before(a.StartTime.Add(a.Cliff)) {
+		return math.ZeroInt()
+	}
+
+	// ended
+	elapsed := currTime.Sub(a.StartTime)
+	if elapsed >= a.VestingDuration {
+		return a.Amount
+	}
+
+	s := math.LegacyNewDec(elapsed.Nanoseconds()).Quo(math.LegacyNewDec(a.VestingDuration.Nanoseconds()))
+	return s.MulInt(a.Amount).TruncateInt()
+}
+
+// ClaimableAmt returns the vested amount not claimed yet
+func (a TeamAllocation) ClaimableAmt(currTime time.Time) math.Int {
+	return a.VestedAmt(currTime).Sub(a.Claimed)
+}
+
+// Status returns the vesting state of the allocation at the given time
+func (a TeamAllocation) Status(currTime time.Time) TeamAllocationStatus {
+	vested := a.VestedAmt(currTime)
+	return TeamAllocationStatus{
+		Beneficiary: a.Beneficiary,
+		Amount:      a.Amount,
+		Vested:      vested,
+		Claimed:     a.Claimed,
+		Locked:      a.Amount.Sub(vested),
+	}
+}
+
+// ValidateTeamAllocations checks the team allocations of a plan, and that beneficiaries are unique
+func ValidateTeamAllocations(team []TeamAllocation) error {
+	if len(team) > MaxTeamAllocations {
+		return fmt.Errorf("too many team allocations: %d > %d", len(team), MaxTeamAllocations)
+	}
+	seen := make(map[string]struct{}, len(team))
+	for _, a := range team {
+		if err := a.ValidateBasic(); err != nil {
+			return fmt.Errorf("team allocation %s: %w", a.Beneficiary, err)
+		}
+		if _, ok := seen[a.Beneficiary]; ok {
+			return fmt.Errorf("duplicate team allocation beneficiary: %s", a.Beneficiary)
+		}
+		seen[a.Beneficiary] = struct{}{}
+	}
+	return nil
+}
+
+// TeamAllocationsTotal returns the sum of the team allocations
+func TeamAllocationsTotal(team []TeamAllocation) math.Int {
+	total := math.ZeroInt()
+	for _, a := range team {
+		total = total.Add(a.Amount)
+	}
+	return total
+}
@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+func TestTeamAllocationVestedAmt(t *testing.T) {
+	startTime := time.Now()
+	amount := math.NewInt(1_000_000).MulRaw(1e18)
+
+	a := types.NewTeamAllocation(sample.AccAddress(), amount, 6*time.Hour, 24*time.Hour)
+	require.True(t, a.VestedAmt(startTime.Add(48*time.Hour)).IsZero(), "not settled")
+
+	a.StartTime = startTime
+	cases := []struct {
+		name     string
+		time     time.Time
+		expected math.Int
+	}{
+		{"not started", startTime.Add(-time.Hour), math.ZeroInt()},
+		{"before cliff", startTime.Add(6*time.Hour - time.Second), math.ZeroInt()},
+		{"at cliff", startTime.Add(6 * time.Hour), amount.QuoRaw(4)},
+		{"partially vested", startTime.Add(12 * time.Hour), amount.QuoRaw(2)},
+		{"fully vested", startTime.Add(24 * time.Hour), amount},
+		{"ended", startTime.Add(48 * time.Hour), amount},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, a.VestedAmt(tc.time))
+			require.Equal(t, amount.Sub(tc.expected), a.Status(tc.time).Locked)
+		})
+	}
+
+	a.Claimed = amount.QuoRaw(4)
+	require.Equal(t, amount.QuoRaw(4), a.ClaimableAmt(startTime.Add(12*time.Hour)))
+}
+
+func TestValidateTeamAllocations(t *testing.T) {
+	addr := sample.AccAddress()
+	amount := math.NewInt(1000)
+
+	require.NoError(t, types.ValidateTeamAllocations(nil))
+	require.NoError(t, types.ValidateTeamAllocations([]types.TeamAllocation{types.NewTeamAllocation(addr, amount, 0, 0)}))
+	require.NoError(t, types.ValidateTeamAllocations([]types.TeamAllocation{types.NewTeamAllocation(addr, amount, time.Hour, time.Hour)}))
+
+	require.Error(t, types.ValidateTeamAllocations([]types.TeamAllocation{types.NewTeamAllocation("invalid", amount, 0, time.Hour)}), "invalid address")
+	require.Error(t, types.ValidateTeamAllocations([]types.TeamAllocation{types.NewTeamAllocation(addr, math.ZeroInt(), 0, time.Hour)}), "zero amount")
+	require.Error(t, types.ValidateTeamAllocations([]types.TeamAllocation{types.NewTeamAllocation(addr, amount, 2*time.Hour, time.Hour)}), "cliff after vesting end")
+	require.Error(t, types.ValidateTeamAllocations([]types.TeamAllocation{
+		types.NewTeamAllocation(addr, amount, 0, time.Hour),
+		types.NewTeamAllocation(addr, amount, 0, time.Hour),
+	}), "duplicate beneficiary")
+}
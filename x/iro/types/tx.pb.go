@@ -8,7 +8,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -155,6 +155,16 @@ type MsgCreatePlan struct {
 	LiquidityDenom                  string                      `protobuf:"bytes,10,opt,name=liquidity_denom,json=liquidityDenom,proto3" json:"liquidity_denom,omitempty"`
 	VestingDuration                 time.Duration               `protobuf:"bytes,11,opt,name=vesting_duration,json=vestingDuration,proto3,stdduration" json:"vesting_duration" yaml:"vesting_duration"`
 	VestingStartTimeAfterSettlement time.Duration               `protobuf:"bytes,12,opt,name=vesting_start_time_after_settlement,json=vestingStartTimeAfterSettlement,proto3,stdduration" json:"vesting_start_time_after_settlement" yaml:"vesting_start_time_after_settlement"`
+	// Optional presale phase. The end time is set when trading is enabled.
+	Presale Presale `protobuf:"bytes,13,opt,name=presale,proto3" json:"presale"`
+	// Optional purchase limits. Only the limit fields are used.
+	TradingLimits TradingLimits `protobuf:"bytes,14,opt,name=trading_limits,json=tradingLimits,proto3" json:"trading_limits"`
+	// Optional parameters of the liquidity pool bootstrapped on settlement,
+	// bounded by the module params.
+	PoolParams SettlementPoolParams `protobuf:"bytes,15,opt,name=pool_params,json=poolParams,proto3" json:"pool_params"`
+	// Optional team allocations, taken out of the allocated amount. Only the
+	// beneficiary, amount, cliff and vesting duration fields are used.
+	TeamAllocations []TeamAllocation `protobuf:"bytes,16,rep,name=team_allocations,json=teamAllocations,proto3" json:"team_allocations"`
 }
 
 func (m *MsgCreatePlan) Reset()         { *m = MsgCreatePlan{} }
@@ -260,6 +270,34 @@ func (m *MsgCreatePlan) GetVestingStartTimeAfterSettlement() time.Duration {
 	return 0
 }
 
+func (m *MsgCreatePlan) GetPresale() Presale {
+	if m != nil {
+		return m.Presale
+	}
+	return Presale{}
+}
+
+func (m *MsgCreatePlan) GetTradingLimits() TradingLimits {
+	if m != nil {
+		return m.TradingLimits
+	}
+	return TradingLimits{}
+}
+
+func (m *MsgCreatePlan) GetPoolParams() SettlementPoolParams {
+	if m != nil {
+		return m.PoolParams
+	}
+	return SettlementPoolParams{}
+}
+
+func (m *MsgCreatePlan) GetTeamAllocations() []TeamAllocation {
+	if m != nil {
+		return m.TeamAllocations
+	}
+	return nil
+}
+
 type MsgCreatePlanResponse struct {
 	// The ID of the plan.
 	PlanId string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
@@ -305,6 +343,143 @@ func (m *MsgCreatePlanResponse) GetPlanId() string {
 	return ""
 }
 
+// MsgUpdatePlan replaces the sale settings of a plan. Only allowed before
+// trading is enabled and before any tokens are bought.
+type MsgUpdatePlan struct {
+	// The address of the rollapp owner.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The ID of the plan.
+	PlanId       string       `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	BondingCurve BondingCurve `protobuf:"bytes,3,opt,name=bonding_curve,json=bondingCurve,proto3" json:"bonding_curve"`
+	// The duration of the plan.
+	IroPlanDuration time.Duration `protobuf:"bytes,4,opt,name=iro_plan_duration,json=iroPlanDuration,proto3,stdduration" json:"iro_plan_duration"`
+	// The incentive plan parameters for the tokens left after the plan is
+	// settled.
+	IncentivePlanParams IncentivePlanParams `protobuf:"bytes,5,opt,name=incentive_plan_params,json=incentivePlanParams,proto3" json:"incentive_plan_params"`
+	// The part of the liquidity that will be used for liquidity pool
+	LiquidityPart                   cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=liquidity_part,json=liquidityPart,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"liquidity_part"`
+	VestingDuration                 time.Duration               `protobuf:"bytes,7,opt,name=vesting_duration,json=vestingDuration,proto3,stdduration" json:"vesting_duration" yaml:"vesting_duration"`
+	VestingStartTimeAfterSettlement time.Duration               `protobuf:"bytes,8,opt,name=vesting_start_time_after_settlement,json=vestingStartTimeAfterSettlement,proto3,stdduration" json:"vesting_start_time_after_settlement" yaml:"vesting_start_time_after_settlement"`
+}
+
+func (m *MsgUpdatePlan) Reset()         { *m = MsgUpdatePlan{} }
+func (m *MsgUpdatePlan) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePlan) ProtoMessage()    {}
+func (*MsgUpdatePlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41b9ae3e091bbd60, []int{4}
+}
+func (m *MsgUpdatePlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePlan.Merge(m, src)
+}
+func (m *MsgUpdatePlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePlan proto.InternalMessageInfo
+
+func (m *MsgUpdatePlan) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgUpdatePlan) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
+	}
+	return ""
+}
+
+func (m *MsgUpdatePlan) GetBondingCurve() BondingCurve {
+	if m != nil {
+		return m.BondingCurve
+	}
+	return BondingCurve{}
+}
+
+func (m *MsgUpdatePlan) GetIroPlanDuration() time.Duration {
+	if m != nil {
+		return m.IroPlanDuration
+	}
+	return 0
+}
+
+func (m *MsgUpdatePlan) GetIncentivePlanParams() IncentivePlanParams {
+	if m != nil {
+		return m.IncentivePlanParams
+	}
+	return IncentivePlanParams{}
+}
+
+func (m *MsgUpdatePlan) GetVestingDuration() time.Duration {
+	if m != nil {
+		return m.VestingDuration
+	}
+	return 0
+}
+
+func (m *MsgUpdatePlan) GetVestingStartTimeAfterSettlement() time.Duration {
+	if m != nil {
+		return m.VestingStartTimeAfterSettlement
+	}
+	return 0
+}
+
+type MsgUpdatePlanResponse struct {
+}
+
+func (m *MsgUpdatePlanResponse) Reset()         { *m = MsgUpdatePlanResponse{} }
+func (m *MsgUpdatePlanResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePlanResponse) ProtoMessage()    {}
+func (*MsgUpdatePlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41b9ae3e091bbd60, []int{5}
+}
+func (m *MsgUpdatePlanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePlanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePlanResponse.Merge(m, src)
+}
+func (m *MsgUpdatePlanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePlanResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePlanResponse proto.InternalMessageInfo
+
 type MsgEnableTrading struct {
 	// The address of the plan owner.
 	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
@@ -316,7 +491,7 @@ func (m *MsgEnableTrading) Reset()         { *m = MsgEnableTrading{} }
 func (m *MsgEnableTrading) String() string { return proto.CompactTextString(m) }
 func (*MsgEnableTrading) ProtoMessage()    {}
 func (*MsgEnableTrading) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41b9ae3e091bbd60, []int{4}
+	return fileDescriptor_41b9ae3e091bbd60, []int{6}
 }
 func (m *MsgEnableTrading) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -366,7 +541,7 @@ func (m *MsgEnableTradingResponse) Reset()         { *m = MsgEnableTradingRespon
 func (m *MsgEnableTradingResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgEnableTradingResponse) ProtoMessage()    {}
 func (*MsgEnableTradingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41b9ae3e091bbd60, []int{5}
+	return fileDescriptor_41b9ae3e091bbd60, []int{7}
 }
 func (m *MsgEnableTradingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -404,13 +579,19 @@ type MsgBuy struct {
 	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 	// The maximum cost this buy action can incur.
 	MaxCostAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_cost_amount,json=maxCostAmount,proto3,customtype=cosmossdk.io/math.Int" json:"max_cost_amount"`
+	// Proof of the buyer's presale cap. Only needed on the first presale buy of
+	// an address admitted via the merkle root.
+	PresaleProof *PresaleProof `protobuf:"bytes,5,opt,name=presale_proof,json=presaleProof,proto3" json:"presale_proof,omitempty"`
+	// Optional address that referred the buyer. It earns a share of the taker
+	// fee.
+	Referrer string `protobuf:"bytes,6,opt,name=referrer,proto3" json:"referrer,omitempty"`
 }
 
 func (m *MsgBuy) Reset()         { *m = MsgBuy{} }
 func (m *MsgBuy) String() string { return proto.CompactTextString(m) }
 func (*MsgBuy) ProtoMessage()    {}
 func (*MsgBuy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41b9ae3e091bbd60, []int{6}
+	return fileDescriptor_41b9ae3e091bbd60, []int{8}
 }
 func (m *MsgBuy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,6 +634,67 @@ func (m *MsgBuy) GetPlanId() string {
 	return ""
 }
 
+func (m *MsgBuy) GetPresaleProof() *PresaleProof {
+	if m != nil {
+		return m.PresaleProof
+	}
+	return nil
+}
+
+func (m *MsgBuy) GetReferrer() string {
+	if m != nil {
+		return m.Referrer
+	}
+	return ""
+}
+
+// PresaleProof proves membership in the presale merkle tree of a plan.
+type PresaleProof struct {
+	Cap cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=cap,proto3,customtype=cosmossdk.io/math.Int" json:"cap"`
+	// Sibling hashes from the leaf to the root.
+	Proof [][]byte `protobuf:"bytes,2,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *PresaleProof) Reset()         { *m = PresaleProof{} }
+func (m *PresaleProof) String() string { return proto.CompactTextString(m) }
+func (*PresaleProof) ProtoMessage()    {}
+func (*PresaleProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41b9ae3e091bbd60, []int{9}
+}
+func (m *PresaleProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PresaleProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PresaleProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PresaleProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PresaleProof.Merge(m, src)
+}
+func (m *PresaleProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *PresaleProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_PresaleProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PresaleProof proto.InternalMessageInfo
+
+func (m *PresaleProof) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
 type MsgBuyExactSpend struct {
 	Buyer string `protobuf:"bytes,1,opt,name=buyer,proto3" json:"buyer,omitempty"`
 	// The ID of the plan.
@@ -461,13 +703,19 @@ type MsgBuyExactSpend struct {
 	Spend cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=spend,proto3,customtype=cosmossdk.io/math.Int" json:"spend"`
 	// The minimum tokens this buy action can provide.
 	MinOutTokensAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=min_out_tokens_amount,json=minOutTokensAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_out_tokens_amount"`
+	// Proof of the buyer's presale cap. Only needed on the first presale buy of
+	// an address admitted via the merkle root.
+	PresaleProof *PresaleProof `protobuf:"bytes,5,opt,name=presale_proof,json=presaleProof,proto3" json:"presale_proof,omitempty"`
+	// Optional address that referred the buyer. It earns a share of the taker
+	// fee.
+	Referrer string `protobuf:"bytes,6,opt,name=referrer,proto3" json:"referrer,omitempty"`
 }
 
 func (m *MsgBuyExactSpend) Reset()         { *m = MsgBuyExactSpend{} }
 func (m *MsgBuyExactSpend) String() string { return proto.CompactTextString(m) }
 func (*MsgBuyExactSpend) ProtoMessage()    {}
 func (*MsgBuyExactSpend) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41b9ae3e091bbd60, []int{7}
+	return fileDescriptor_41b9ae3e091bbd60, []int{10}
 }
 func (m *MsgBuyExactSpend) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -510,6 +758,171 @@ func (m *MsgBuyExactSpend) GetPlanId() string {
 	return ""
 }
 
+func (m *MsgBuyExactSpend) GetPresaleProof() *PresaleProof {
+	if m != nil {
+		return m.PresaleProof
+	}
+	return nil
+}
+
+func (m *MsgBuyExactSpend) GetReferrer() string {
+	if m != nil {
+		return m.Referrer
+	}
+	return ""
+}
+
+// MsgBuyExactSpendWithSwap defines a message to buy allocation with a token
+// other than the liquidity denom of the plan. The token is swapped along the
+// routes, and the whole swap output is spent on the plan.
+type MsgBuyExactSpendWithSwap struct {
+	Buyer string `protobuf:"bytes,1,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// The ID of the plan.
+	PlanId string `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// The token to swap and spend.
+	TokenIn types.Coin `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in"`
+	// The gamm pools to swap through. The last one must output the liquidity
+	// denom of the plan.
+	Routes []SwapRoute `protobuf:"bytes,4,rep,name=routes,proto3" json:"routes"`
+	// The minimum tokens this buy action can provide. It is the only slippage
+	// guard, covering both the swap and the buy.
+	MinOutTokensAmount cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=min_out_tokens_amount,json=minOutTokensAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_out_tokens_amount"`
+	// Proof of the buyer's presale cap. Only needed on the first presale buy of
+	// an address admitted via the merkle root.
+	PresaleProof *PresaleProof `protobuf:"bytes,6,opt,name=presale_proof,json=presaleProof,proto3" json:"presale_proof,omitempty"`
+	// Optional address that referred the buyer. It earns a share of the taker
+	// fee.
+	Referrer string `protobuf:"bytes,7,opt,name=referrer,proto3" json:"referrer,omitempty"`
+}
+
+func (m *MsgBuyExactSpendWithSwap) Reset()         { *m = MsgBuyExactSpendWithSwap{} }
+func (m *MsgBuyExactSpendWithSwap) String() string { return proto.CompactTextString(m) }
+func (*MsgBuyExactSpendWithSwap) ProtoMessage()    {}
+func (*MsgBuyExactSpendWithSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41b9ae3e091bbd60, []int{11}
+}
+func (m *MsgBuyExactSpendWithSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBuyExactSpendWithSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBuyExactSpendWithSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBuyExactSpendWithSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBuyExactSpendWithSwap.Merge(m, src)
+}
+func (m *MsgBuyExactSpendWithSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBuyExactSpendWithSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBuyExactSpendWithSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBuyExactSpendWithSwap proto.InternalMessageInfo
+
+func (m *MsgBuyExactSpendWithSwap) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *MsgBuyExactSpendWithSwap) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
+	}
+	return ""
+}
+
+func (m *MsgBuyExactSpendWithSwap) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *MsgBuyExactSpendWithSwap) GetRoutes() []SwapRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *MsgBuyExactSpendWithSwap) GetPresaleProof() *PresaleProof {
+	if m != nil {
+		return m.PresaleProof
+	}
+	return nil
+}
+
+func (m *MsgBuyExactSpendWithSwap) GetReferrer() string {
+	if m != nil {
+		return m.Referrer
+	}
+	return ""
+}
+
+// SwapRoute is a hop of a swap through a gamm pool.
+type SwapRoute struct {
+	PoolId        uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	TokenOutDenom string `protobuf:"bytes,2,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty"`
+}
+
+func (m *SwapRoute) Reset()         { *m = SwapRoute{} }
+func (m *SwapRoute) String() string { return proto.CompactTextString(m) }
+func (*SwapRoute) ProtoMessage()    {}
+func (*SwapRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41b9ae3e091bbd60, []int{12}
+}
+func (m *SwapRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapRoute.Merge(m, src)
+}
+func (m *SwapRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapRoute proto.InternalMessageInfo
+
+func (m *SwapRoute) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *SwapRoute) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
 type MsgBuyResponse struct {
 }
 
@@ -517,7 +930,7 @@ func (m *MsgBuyResponse) Reset()         { *m = MsgBuyResponse{} }
 func (m *MsgBuyResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBuyResponse) ProtoMessage()    {}
 func (*MsgBuyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41b9ae3e091bbd60, []int{8}
+	return fileDescriptor_41b9ae3e091bbd60, []int{13}
 }
 func (m *MsgBuyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -555,13 +968,16 @@ type MsgSell struct {
 	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 	// The minimum income this sell action can incur.
 	MinIncomeAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=min_income_amount,json=minIncomeAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_income_amount"`
+	// Optional address that referred the seller. It earns a share of the taker
+	// fee.
+	Referrer string `protobuf:"bytes,5,opt,name=referrer,proto3" json:"referrer,omitempty"`
 }
 
 func (m *MsgSell) Reset()         { *m = MsgSell{} }
 func (m *MsgSell) String() string { return proto.CompactTextString(m) }
 func (*MsgSell) ProtoMessage()    {}
 func (*MsgSell) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41b9ae3e091bbd60, []int{9}
+	return fileDescriptor_41b9ae3e091bbd60, []int{14}
 }
 func (m *MsgSell) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -604,14 +1020,21 @@ func (m *MsgSell) GetPlanId() string {
 	return ""
 }
 
-type MsgSellResponse struct {
-}
+func (m *MsgSell) GetReferrer() string {
+	if m != nil {
+		return m.Referrer
+	}
+	return ""
+}
+
+type MsgSellResponse struct {
+}
 
 func (m *MsgSellResponse) Reset()         { *m = MsgSellResponse{} }
 func (m *MsgSellResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSellResponse) ProtoMessage()    {}
 func (*MsgSellResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41b9ae3e091bbd60, []int{10}
+	return fileDescriptor_41b9ae3e091bbd60, []int{15}
 }
 func (m *MsgSellResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -651,7 +1074,7 @@ func (m *MsgClaim) Reset()         { *m = MsgClaim{} }
 func (m *MsgClaim) String() string { return proto.CompactTextString(m) }
 func (*MsgClaim) ProtoMessage()    {}
 func (*MsgClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41b9ae3e091bbd60, []int{11}
+	return fileDescriptor_41b9ae3e091bbd60, []int{16}
 }
 func (m *MsgClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -701,7 +1124,7 @@ func (m *MsgClaimResponse) Reset()         { *m = MsgClaimResponse{} }
 func (m *MsgClaimResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimResponse) ProtoMessage()    {}
 func (*MsgClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41b9ae3e091bbd60, []int{12}
+	return fileDescriptor_41b9ae3e091bbd60, []int{17}
 }
 func (m *MsgClaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -741,7 +1164,7 @@ func (m *MsgClaimVested) Reset()         { *m = MsgClaimVested{} }
 func (m *MsgClaimVested) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimVested) ProtoMessage()    {}
 func (*MsgClaimVested) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41b9ae3e091bbd60, []int{13}
+	return fileDescriptor_41b9ae3e091bbd60, []int{18}
 }
 func (m *MsgClaimVested) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -791,7 +1214,7 @@ func (m *MsgClaimVestedResponse) Reset()         { *m = MsgClaimVestedResponse{}
 func (m *MsgClaimVestedResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimVestedResponse) ProtoMessage()    {}
 func (*MsgClaimVestedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41b9ae3e091bbd60, []int{14}
+	return fileDescriptor_41b9ae3e091bbd60, []int{19}
 }
 func (m *MsgClaimVestedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -820,15 +1243,202 @@ func (m *MsgClaimVestedResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClaimVestedResponse proto.InternalMessageInfo
 
+// MsgRefund defines a message to redeem IRO tokens for a pro-rata share of the
+// raised liquidity after the plan failed.
+type MsgRefund struct {
+	Refunder string `protobuf:"bytes,1,opt,name=refunder,proto3" json:"refunder,omitempty"`
+	// The ID of the plan.
+	PlanId string `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+}
+
+func (m *MsgRefund) Reset()         { *m = MsgRefund{} }
+func (m *MsgRefund) String() string { return proto.CompactTextString(m) }
+func (*MsgRefund) ProtoMessage()    {}
+func (*MsgRefund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41b9ae3e091bbd60, []int{20}
+}
+func (m *MsgRefund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRefund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefund.Merge(m, src)
+}
+func (m *MsgRefund) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefund) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRefund proto.InternalMessageInfo
+
+func (m *MsgRefund) GetRefunder() string {
+	if m != nil {
+		return m.Refunder
+	}
+	return ""
+}
+
+func (m *MsgRefund) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
+	}
+	return ""
+}
+
+type MsgRefundResponse struct {
+}
+
+func (m *MsgRefundResponse) Reset()         { *m = MsgRefundResponse{} }
+func (m *MsgRefundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundResponse) ProtoMessage()    {}
+func (*MsgRefundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41b9ae3e091bbd60, []int{21}
+}
+func (m *MsgRefundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRefundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundResponse.Merge(m, src)
+}
+func (m *MsgRefundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRefundResponse proto.InternalMessageInfo
+
+// MsgClaimTeamAllocation defines a message to claim the vested rollapp tokens
+// of a team allocation.
+type MsgClaimTeamAllocation struct {
+	Beneficiary string `protobuf:"bytes,1,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	// The ID of the plan.
+	PlanId string `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+}
+
+func (m *MsgClaimTeamAllocation) Reset()         { *m = MsgClaimTeamAllocation{} }
+func (m *MsgClaimTeamAllocation) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimTeamAllocation) ProtoMessage()    {}
+func (*MsgClaimTeamAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41b9ae3e091bbd60, []int{22}
+}
+func (m *MsgClaimTeamAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimTeamAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimTeamAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimTeamAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimTeamAllocation.Merge(m, src)
+}
+func (m *MsgClaimTeamAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimTeamAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimTeamAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimTeamAllocation proto.InternalMessageInfo
+
+func (m *MsgClaimTeamAllocation) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
+func (m *MsgClaimTeamAllocation) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
+	}
+	return ""
+}
+
+type MsgClaimTeamAllocationResponse struct {
+}
+
+func (m *MsgClaimTeamAllocationResponse) Reset()         { *m = MsgClaimTeamAllocationResponse{} }
+func (m *MsgClaimTeamAllocationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimTeamAllocationResponse) ProtoMessage()    {}
+func (*MsgClaimTeamAllocationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41b9ae3e091bbd60, []int{23}
+}
+func (m *MsgClaimTeamAllocationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimTeamAllocationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimTeamAllocationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimTeamAllocationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimTeamAllocationResponse.Merge(m, src)
+}
+func (m *MsgClaimTeamAllocationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimTeamAllocationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimTeamAllocationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimTeamAllocationResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "dymensionxyz.dymension.iro.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dymensionxyz.dymension.iro.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgCreatePlan)(nil), "dymensionxyz.dymension.iro.MsgCreatePlan")
 	proto.RegisterType((*MsgCreatePlanResponse)(nil), "dymensionxyz.dymension.iro.MsgCreatePlanResponse")
+	proto.RegisterType((*MsgUpdatePlan)(nil), "dymensionxyz.dymension.iro.MsgUpdatePlan")
+	proto.RegisterType((*MsgUpdatePlanResponse)(nil), "dymensionxyz.dymension.iro.MsgUpdatePlanResponse")
 	proto.RegisterType((*MsgEnableTrading)(nil), "dymensionxyz.dymension.iro.MsgEnableTrading")
 	proto.RegisterType((*MsgEnableTradingResponse)(nil), "dymensionxyz.dymension.iro.MsgEnableTradingResponse")
 	proto.RegisterType((*MsgBuy)(nil), "dymensionxyz.dymension.iro.MsgBuy")
+	proto.RegisterType((*PresaleProof)(nil), "dymensionxyz.dymension.iro.PresaleProof")
 	proto.RegisterType((*MsgBuyExactSpend)(nil), "dymensionxyz.dymension.iro.MsgBuyExactSpend")
+	proto.RegisterType((*MsgBuyExactSpendWithSwap)(nil), "dymensionxyz.dymension.iro.MsgBuyExactSpendWithSwap")
+	proto.RegisterType((*SwapRoute)(nil), "dymensionxyz.dymension.iro.SwapRoute")
 	proto.RegisterType((*MsgBuyResponse)(nil), "dymensionxyz.dymension.iro.MsgBuyResponse")
 	proto.RegisterType((*MsgSell)(nil), "dymensionxyz.dymension.iro.MsgSell")
 	proto.RegisterType((*MsgSellResponse)(nil), "dymensionxyz.dymension.iro.MsgSellResponse")
@@ -836,6 +1446,10 @@ func init() {
 	proto.RegisterType((*MsgClaimResponse)(nil), "dymensionxyz.dymension.iro.MsgClaimResponse")
 	proto.RegisterType((*MsgClaimVested)(nil), "dymensionxyz.dymension.iro.MsgClaimVested")
 	proto.RegisterType((*MsgClaimVestedResponse)(nil), "dymensionxyz.dymension.iro.MsgClaimVestedResponse")
+	proto.RegisterType((*MsgRefund)(nil), "dymensionxyz.dymension.iro.MsgRefund")
+	proto.RegisterType((*MsgRefundResponse)(nil), "dymensionxyz.dymension.iro.MsgRefundResponse")
+	proto.RegisterType((*MsgClaimTeamAllocation)(nil), "dymensionxyz.dymension.iro.MsgClaimTeamAllocation")
+	proto.RegisterType((*MsgClaimTeamAllocationResponse)(nil), "dymensionxyz.dymension.iro.MsgClaimTeamAllocationResponse")
 }
 
 func init() {
@@ -843,82 +1457,113 @@ func init() {
 }
 
 var fileDescriptor_41b9ae3e091bbd60 = []byte{
-	// 1198 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0x13, 0xc7,
-	0x1b, 0xce, 0x42, 0xe2, 0xc4, 0x6f, 0xfe, 0xb2, 0x3f, 0xf2, 0xcb, 0xb2, 0x55, 0x1d, 0xb4, 0x41,
-	0x22, 0x0d, 0xb0, 0x4b, 0x42, 0xc5, 0x81, 0x5b, 0x9c, 0xa0, 0x2a, 0x55, 0x23, 0x90, 0x0d, 0x14,
-	0xb5, 0x52, 0x57, 0xe3, 0xdd, 0x61, 0x99, 0xb2, 0x3b, 0xe3, 0xee, 0xcc, 0x06, 0xbb, 0xa7, 0xaa,
-	0xc7, 0x9e, 0x38, 0xf6, 0x56, 0xa9, 0x9f, 0x80, 0x03, 0xdf, 0xa1, 0x1c, 0x29, 0xa7, 0xaa, 0x07,
-	0x5a, 0xc1, 0x81, 0x7b, 0x2f, 0x3d, 0xb6, 0x9a, 0x99, 0xdd, 0xb5, 0x1d, 0x1a, 0xdb, 0xa1, 0xe5,
-	0x14, 0xcf, 0xcc, 0x33, 0xcf, 0xf3, 0xfa, 0x79, 0xff, 0x64, 0x0c, 0x6b, 0x61, 0x37, 0xc1, 0x94,
-	0x13, 0x46, 0x3b, 0xdd, 0xaf, 0xbd, 0x72, 0xe1, 0x91, 0x94, 0x79, 0xa2, 0xe3, 0xb6, 0x53, 0x26,
-	0x98, 0x69, 0xf7, 0x83, 0xdc, 0x72, 0xe1, 0x92, 0x94, 0xd9, 0xa7, 0x23, 0x16, 0x31, 0x05, 0xf3,
-	0xe4, 0x27, 0x7d, 0xc3, 0x3e, 0x13, 0x30, 0x9e, 0x30, 0xee, 0xeb, 0x03, 0xbd, 0xc8, 0x8f, 0x56,
-	0xf4, 0xca, 0x4b, 0x78, 0xe4, 0x1d, 0x6c, 0xca, 0x3f, 0xf9, 0xc1, 0xb9, 0x21, 0xa1, 0x90, 0xb4,
-	0x60, 0xae, 0x45, 0x8c, 0x45, 0x31, 0xf6, 0xd4, 0xaa, 0x95, 0xdd, 0xf3, 0xc2, 0x2c, 0x45, 0x42,
-	0x46, 0xa3, 0xcf, 0x57, 0x0f, 0x9f, 0x0b, 0x92, 0x60, 0x2e, 0x50, 0xd2, 0x2e, 0x08, 0x72, 0xfd,
-	0x16, 0xe2, 0xd8, 0x3b, 0xd8, 0x6c, 0x61, 0x81, 0x36, 0xbd, 0x80, 0x91, 0x82, 0xe0, 0xfc, 0x90,
-	0x30, 0xda, 0x28, 0x45, 0x49, 0xfe, 0x45, 0x9c, 0x1f, 0x0d, 0x58, 0xdc, 0xe7, 0xd1, 0xed, 0x76,
-	0x88, 0x04, 0xbe, 0xa9, 0x4e, 0xcc, 0xab, 0x50, 0x45, 0x99, 0xb8, 0xcf, 0x52, 0x22, 0xba, 0x96,
-	0x71, 0xd6, 0x58, 0xaf, 0xd6, 0xad, 0xe7, 0x4f, 0x2e, 0x9d, 0xce, 0x1d, 0xd8, 0x0e, 0xc3, 0x14,
-	0x73, 0xde, 0x14, 0x29, 0xa1, 0x51, 0xa3, 0x07, 0x35, 0x3f, 0x02, 0xa0, 0xf8, 0xa1, 0xaf, 0xf9,
-	0xad, 0x13, 0x67, 0x8d, 0xf5, 0xd9, 0x2d, 0xc7, 0x3d, 0xda, 0x76, 0x57, 0xeb, 0xd5, 0x27, 0x9f,
-	0xbe, 0x58, 0x9d, 0x68, 0x54, 0x29, 0x7e, 0xa8, 0x37, 0xae, 0x2d, 0x7c, 0xfb, 0xfa, 0xf1, 0x46,
-	0x8f, 0xd8, 0x39, 0x03, 0x2b, 0x87, 0x62, 0x6c, 0x60, 0xde, 0x66, 0x94, 0x63, 0xe7, 0xe7, 0x69,
-	0x98, 0xdf, 0xe7, 0xd1, 0x4e, 0x8a, 0xe5, 0x59, 0x8c, 0xa8, 0xe9, 0xc2, 0x14, 0x7b, 0x48, 0x71,
-	0x3a, 0x32, 0x72, 0x0d, 0x33, 0xdf, 0x07, 0x48, 0x59, 0x1c, 0xa3, 0x76, 0xdb, 0x27, 0xa1, 0x8a,
-	0xba, 0xda, 0xa8, 0xe6, 0x3b, 0x7b, 0xa1, 0x79, 0x07, 0x96, 0x50, 0x1c, 0xb3, 0x00, 0x09, 0x1c,
-	0xfa, 0x28, 0x61, 0x19, 0x15, 0xd6, 0x49, 0xc5, 0x7c, 0x41, 0x86, 0xfd, 0xeb, 0x8b, 0xd5, 0x65,
-	0xcd, 0xce, 0xc3, 0x07, 0x2e, 0x61, 0x5e, 0x82, 0xc4, 0x7d, 0x77, 0x8f, 0x8a, 0xe7, 0x4f, 0x2e,
-	0x41, 0x2e, 0xbb, 0x47, 0x45, 0x63, 0xb1, 0x24, 0xd9, 0x56, 0x1c, 0x66, 0x13, 0xe6, 0x5b, 0x8c,
-	0x86, 0x84, 0x46, 0x7e, 0x90, 0xa5, 0x07, 0xd8, 0x9a, 0x54, 0x7e, 0xad, 0x0f, 0xf3, 0xab, 0xae,
-	0x2f, 0xec, 0x48, 0x7c, 0xee, 0xda, 0x5c, 0xab, 0x6f, 0xcf, 0x3c, 0x0f, 0x8b, 0x22, 0x45, 0x8a,
-	0x14, 0x53, 0xd4, 0x8a, 0x71, 0x68, 0x4d, 0x9d, 0x35, 0xd6, 0x67, 0x1a, 0x0b, 0xf9, 0xf6, 0x75,
-	0xbd, 0x6b, 0xee, 0x00, 0x70, 0x81, 0x52, 0xe1, 0xcb, 0xc2, 0xb2, 0x2a, 0x4a, 0xda, 0x76, 0x75,
-	0xd5, 0xb9, 0x45, 0xd5, 0xb9, 0xb7, 0x8a, 0xaa, 0xab, 0xcf, 0x48, 0xb1, 0x47, 0xbf, 0xad, 0x1a,
-	0x8d, 0xaa, 0xba, 0x27, 0x4f, 0xcc, 0x1b, 0x70, 0x8a, 0xa4, 0xcc, 0x6f, 0xc7, 0x88, 0xfa, 0x45,
-	0x01, 0x5b, 0xd3, 0x8a, 0xeb, 0xcc, 0x1b, 0x5c, 0xbb, 0x39, 0x40, 0x53, 0x7d, 0x2f, 0xa9, 0x16,
-	0x49, 0xca, 0x64, 0xca, 0x8a, 0x23, 0x93, 0xc0, 0x32, 0xa1, 0x01, 0xa6, 0x82, 0x1c, 0x60, 0x4d,
-	0x9b, 0xd7, 0xd2, 0x8c, 0x22, 0xf5, 0x86, 0x79, 0xb3, 0x57, 0x5c, 0x94, 0x8c, 0x03, 0x85, 0xf5,
-	0x3f, 0xf2, 0xe6, 0x91, 0x79, 0x17, 0x16, 0x62, 0xf2, 0x55, 0x46, 0x42, 0x22, 0xba, 0x52, 0x45,
-	0x58, 0x55, 0x95, 0xd4, 0xcd, 0x3c, 0xa9, 0xef, 0xbd, 0x99, 0xd4, 0x4f, 0x70, 0x84, 0x82, 0xee,
-	0x2e, 0x0e, 0xfa, 0x52, 0xbb, 0x8b, 0x83, 0xc6, 0x7c, 0x49, 0x74, 0x13, 0xa5, 0x42, 0xe6, 0xa0,
-	0xc7, 0x1c, 0x62, 0xca, 0x12, 0x0b, 0x54, 0x51, 0xf5, 0x04, 0x77, 0xe5, 0xae, 0x49, 0x60, 0xe9,
-	0x00, 0x73, 0x21, 0x93, 0x55, 0xba, 0x37, 0x3b, 0xca, 0xbd, 0x35, 0x19, 0xdf, 0x1f, 0x2f, 0x56,
-	0x57, 0xba, 0x28, 0x89, 0xaf, 0x39, 0x87, 0x09, 0x1c, 0x6d, 0x6c, 0xbe, 0x5d, 0x1a, 0xfb, 0x83,
-	0x01, 0x6b, 0x05, 0xb4, 0x97, 0x77, 0x1f, 0xdd, 0x13, 0x38, 0xf5, 0x39, 0x16, 0x22, 0xc6, 0x09,
-	0xa6, 0xc2, 0x9a, 0x1b, 0x25, 0x7f, 0x35, 0x97, 0xdf, 0x18, 0x94, 0x1f, 0xc2, 0xa9, 0x23, 0x5a,
-	0xcd, 0x91, 0xcd, 0xa2, 0x78, 0xb6, 0x25, 0xac, 0x59, 0xa2, 0xae, 0x81, 0x6c, 0x79, 0xdd, 0x91,
-	0xce, 0x65, 0x58, 0x1e, 0x68, 0xe9, 0xa2, 0xd9, 0xcd, 0x15, 0x98, 0x56, 0x55, 0x41, 0x42, 0xdd,
-	0xdc, 0x8d, 0x8a, 0x5c, 0xee, 0x85, 0x4e, 0x04, 0x4b, 0xfb, 0x3c, 0x2f, 0xee, 0x5b, 0xba, 0xd2,
-	0x8f, 0x3d, 0x07, 0xfa, 0xc8, 0x4f, 0xf4, 0x93, 0x0f, 0x84, 0x66, 0x83, 0x75, 0x58, 0xa8, 0x1c,
-	0x45, 0x7f, 0x1a, 0x50, 0xd9, 0xe7, 0x51, 0x3d, 0xeb, 0x4a, 0xed, 0x56, 0xd6, 0x1d, 0x47, 0x5b,
-	0xc1, 0x8e, 0xd4, 0x36, 0x77, 0xa0, 0xf2, 0xf6, 0x33, 0x27, 0xbf, 0x6a, 0x36, 0x61, 0x31, 0x41,
-	0x1d, 0x3f, 0x60, 0x5c, 0x14, 0x13, 0x6c, 0xf2, 0xf8, 0x6c, 0xf3, 0x09, 0xea, 0xec, 0x30, 0x2e,
-	0xf4, 0xfc, 0xca, 0x5d, 0x51, 0xe1, 0x3b, 0xdf, 0x9d, 0x50, 0xfe, 0xd7, 0xb3, 0xee, 0xf5, 0x0e,
-	0x0a, 0x44, 0xb3, 0x8d, 0x69, 0xf8, 0xdf, 0x79, 0xb0, 0x0d, 0x53, 0x5c, 0x32, 0xbe, 0x8d, 0x05,
-	0xfa, 0xa6, 0xf9, 0x05, 0x2c, 0x27, 0x84, 0xfa, 0x2c, 0x13, 0xbe, 0x60, 0x0f, 0x30, 0xe5, 0xff,
-	0xc2, 0x07, 0x33, 0x21, 0xf4, 0x46, 0x26, 0x6e, 0x29, 0x9e, 0x7f, 0x30, 0x63, 0x09, 0x16, 0xb4,
-	0x17, 0x65, 0x61, 0xfc, 0x65, 0xc0, 0xf4, 0x3e, 0x8f, 0x9a, 0x38, 0x8e, 0xcd, 0xcb, 0x50, 0xe1,
-	0x38, 0x8e, 0xc7, 0xb0, 0x25, 0xc7, 0xbd, 0xe3, 0xda, 0xf8, 0x14, 0x4e, 0x49, 0x67, 0x08, 0x0d,
-	0x98, 0x6c, 0xde, 0xb7, 0x76, 0x65, 0x31, 0x21, 0x74, 0x4f, 0x91, 0xe4, 0x96, 0xcc, 0x4a, 0x4b,
-	0xf2, 0xef, 0xe0, 0x9c, 0x52, 0x8f, 0x0c, 0x69, 0x40, 0x69, 0x0a, 0x86, 0x19, 0xd9, 0xe4, 0x31,
-	0x22, 0x89, 0xb9, 0x05, 0xd3, 0x81, 0xfc, 0x30, 0x86, 0x2b, 0x05, 0xf0, 0xe8, 0x76, 0x9d, 0x93,
-	0xc2, 0x05, 0xcc, 0x31, 0x55, 0x65, 0x2a, 0x99, 0x52, 0xfa, 0x81, 0xca, 0x90, 0xda, 0xbb, 0x83,
-	0xb9, 0xc0, 0xe1, 0xbb, 0x0c, 0xc0, 0x82, 0xff, 0x0f, 0x8a, 0x15, 0x61, 0x6c, 0xfd, 0x54, 0x81,
-	0x93, 0xfb, 0x3c, 0x32, 0xdb, 0x30, 0x37, 0xf0, 0xfc, 0xba, 0x30, 0xec, 0xdf, 0xdc, 0xa1, 0x77,
-	0x90, 0x7d, 0xe5, 0x18, 0xe0, 0x72, 0x8e, 0x7e, 0x09, 0xd0, 0xf7, 0x60, 0xfa, 0x60, 0x04, 0x45,
-	0x0f, 0x6a, 0x6f, 0x8e, 0x0d, 0x2d, 0xb5, 0x38, 0xcc, 0x0f, 0xce, 0xe5, 0x8b, 0x23, 0x38, 0x06,
-	0xd0, 0xf6, 0x87, 0xc7, 0x41, 0x97, 0xa2, 0xb7, 0xe1, 0xa4, 0x1c, 0xc3, 0xce, 0x88, 0xcb, 0xf5,
-	0xac, 0x6b, 0x6f, 0x8c, 0xc6, 0x94, 0xb4, 0x04, 0xe6, 0x07, 0x67, 0xdc, 0xc5, 0xd1, 0x97, 0x7b,
-	0xe8, 0x63, 0x49, 0xdd, 0x85, 0x49, 0x35, 0x2f, 0xd6, 0x46, 0xdc, 0x91, 0x20, 0xfb, 0xc2, 0x18,
-	0xa0, 0x92, 0xf9, 0x73, 0x98, 0xd2, 0x5d, 0x77, 0x6e, 0x54, 0x32, 0x25, 0xca, 0xbe, 0x38, 0x0e,
-	0xaa, 0x24, 0x4f, 0x60, 0xb6, 0xbf, 0xaf, 0x36, 0xc6, 0xb9, 0xac, 0xb1, 0xf6, 0xd6, 0xf8, 0xd8,
-	0x42, 0xce, 0x9e, 0xfa, 0xe6, 0xf5, 0xe3, 0x0d, 0xa3, 0xfe, 0xf1, 0xd3, 0x97, 0x35, 0xe3, 0xd9,
-	0xcb, 0x9a, 0xf1, 0xfb, 0xcb, 0x9a, 0xf1, 0xe8, 0x55, 0x6d, 0xe2, 0xd9, 0xab, 0xda, 0xc4, 0x2f,
-	0xaf, 0x6a, 0x13, 0x9f, 0x5d, 0x8e, 0x88, 0xb8, 0x9f, 0xb5, 0xdc, 0x80, 0x25, 0xde, 0x11, 0x3f,
-	0x89, 0x0e, 0xae, 0x78, 0x1d, 0xfd, 0x4b, 0xb1, 0xdb, 0xc6, 0xbc, 0x55, 0x51, 0x8f, 0x9e, 0x2b,
-	0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x59, 0xcf, 0x69, 0x2f, 0x54, 0x0e, 0x00, 0x00,
+	// 1691 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x8f, 0x2c, 0x4b, 0xb6, 0x9e, 0x2d, 0xcb, 0x61, 0xe2, 0x9a, 0x51, 0x51, 0xdb, 0xa0, 0x77,
+	0xbb, 0x8e, 0x93, 0x48, 0xb1, 0x13, 0xec, 0xc1, 0x40, 0x0f, 0x96, 0xb3, 0x28, 0x54, 0x44, 0x48,
+	0x20, 0x65, 0x93, 0x45, 0x17, 0x58, 0x62, 0x44, 0x8e, 0x99, 0x69, 0x48, 0x0e, 0xcb, 0x19, 0xda,
+	0x56, 0x2f, 0x2d, 0x0a, 0xf4, 0xdc, 0x3d, 0x15, 0xbd, 0x15, 0x28, 0xfa, 0x07, 0xec, 0x61, 0xfb,
+	0x3f, 0xec, 0x71, 0xb1, 0x40, 0x81, 0xa2, 0x28, 0xd2, 0x22, 0x39, 0xec, 0xbd, 0xe7, 0x1e, 0x8a,
+	0xf9, 0x20, 0x25, 0xf9, 0x43, 0x1f, 0xae, 0xd3, 0xed, 0xc9, 0x9e, 0x99, 0xdf, 0xfb, 0xbd, 0xc7,
+	0xf7, 0x1e, 0x7f, 0x7c, 0xa4, 0x60, 0xd3, 0xed, 0x05, 0x38, 0x64, 0x84, 0x86, 0x27, 0xbd, 0x5f,
+	0xd4, 0xb3, 0x45, 0x9d, 0xc4, 0xb4, 0xce, 0x4f, 0x6a, 0x51, 0x4c, 0x39, 0x35, 0xaa, 0x83, 0xa0,
+	0x5a, 0xb6, 0xa8, 0x91, 0x98, 0x56, 0x6f, 0x7a, 0xd4, 0xa3, 0x12, 0x56, 0x17, 0xff, 0x29, 0x8b,
+	0xea, 0x2d, 0x87, 0xb2, 0x80, 0x32, 0x5b, 0x1d, 0xa8, 0x85, 0x3e, 0x5a, 0x55, 0xab, 0x7a, 0xc0,
+	0xbc, 0xfa, 0xd1, 0x8e, 0xf8, 0xa3, 0x0f, 0xde, 0x1b, 0x11, 0x0a, 0x89, 0x53, 0xe6, 0x35, 0x8f,
+	0x52, 0xcf, 0xc7, 0x75, 0xb9, 0xea, 0x26, 0x87, 0x75, 0x37, 0x89, 0x11, 0x17, 0xd1, 0xa8, 0xf3,
+	0xf5, 0xd3, 0xe7, 0x9c, 0x04, 0x98, 0x71, 0x14, 0x44, 0x29, 0x81, 0xf6, 0xdf, 0x45, 0x0c, 0xd7,
+	0x8f, 0x76, 0xba, 0x98, 0xa3, 0x9d, 0xba, 0x43, 0x49, 0x4a, 0xf0, 0xc1, 0x88, 0x30, 0x22, 0x14,
+	0xa3, 0x40, 0x5f, 0x88, 0xf5, 0xc7, 0x1c, 0x54, 0x5a, 0xcc, 0xfb, 0x38, 0x72, 0x11, 0xc7, 0x4f,
+	0xe5, 0x89, 0xf1, 0x21, 0x94, 0x50, 0xc2, 0x5f, 0xd2, 0x98, 0xf0, 0x9e, 0x99, 0xdb, 0xc8, 0x6d,
+	0x95, 0x1a, 0xe6, 0x37, 0x5f, 0xde, 0xbb, 0xa9, 0x33, 0xb0, 0xef, 0xba, 0x31, 0x66, 0xac, 0xc3,
+	0x63, 0x12, 0x7a, 0xed, 0x3e, 0xd4, 0xf8, 0x31, 0x40, 0x88, 0x8f, 0x6d, 0xc5, 0x6f, 0xce, 0x6c,
+	0xe4, 0xb6, 0x16, 0x76, 0xad, 0xda, 0xc5, 0x69, 0xaf, 0x29, 0x7f, 0x8d, 0xd9, 0xaf, 0x5e, 0xaf,
+	0x5f, 0x6b, 0x97, 0x42, 0x7c, 0xac, 0x36, 0xf6, 0x96, 0x7e, 0xfd, 0xed, 0x17, 0xdb, 0x7d, 0x62,
+	0xeb, 0x16, 0xac, 0x9e, 0x8a, 0xb1, 0x8d, 0x59, 0x44, 0x43, 0x86, 0xad, 0x3f, 0x01, 0x94, 0x5b,
+	0xcc, 0x3b, 0x88, 0xb1, 0x38, 0xf3, 0x51, 0x68, 0xd4, 0xa0, 0x40, 0x8f, 0x43, 0x1c, 0x8f, 0x8d,
+	0x5c, 0xc1, 0x8c, 0x1f, 0x00, 0xc4, 0xd4, 0xf7, 0x51, 0x14, 0xd9, 0xc4, 0x95, 0x51, 0x97, 0xda,
+	0x25, 0xbd, 0xd3, 0x74, 0x8d, 0xe7, 0xb0, 0x8c, 0x7c, 0x9f, 0x3a, 0x88, 0x63, 0xd7, 0x46, 0x01,
+	0x4d, 0x42, 0x6e, 0xe6, 0x25, 0xf3, 0x1d, 0x11, 0xf6, 0xdf, 0x5e, 0xaf, 0xaf, 0x28, 0x76, 0xe6,
+	0xbe, 0xaa, 0x11, 0x5a, 0x0f, 0x10, 0x7f, 0x59, 0x6b, 0x86, 0xfc, 0x9b, 0x2f, 0xef, 0x81, 0x76,
+	0xdb, 0x0c, 0x79, 0xbb, 0x92, 0x91, 0xec, 0x4b, 0x0e, 0xa3, 0x03, 0xe5, 0x2e, 0x0d, 0x5d, 0x12,
+	0x7a, 0xb6, 0x93, 0xc4, 0x47, 0xd8, 0x9c, 0x95, 0xf9, 0xda, 0x1a, 0x95, 0xaf, 0x86, 0x32, 0x38,
+	0x10, 0x78, 0x9d, 0xb5, 0xc5, 0xee, 0xc0, 0x9e, 0xf1, 0x01, 0x54, 0x78, 0x8c, 0x24, 0x29, 0x0e,
+	0x51, 0xd7, 0xc7, 0xae, 0x59, 0xd8, 0xc8, 0x6d, 0xcd, 0xb7, 0x97, 0xf4, 0xf6, 0x47, 0x6a, 0xd7,
+	0x38, 0x00, 0x60, 0x1c, 0xc5, 0xdc, 0x16, 0x8d, 0x65, 0x16, 0xa5, 0xeb, 0x6a, 0x4d, 0x75, 0x5d,
+	0x2d, 0xed, 0xba, 0xda, 0xb3, 0xb4, 0xeb, 0x1a, 0xf3, 0xc2, 0xd9, 0xe7, 0xff, 0x58, 0xcf, 0xb5,
+	0x4b, 0xd2, 0x4e, 0x9c, 0x18, 0x4f, 0xe0, 0x3a, 0x89, 0xa9, 0x1d, 0xf9, 0x28, 0xb4, 0xd3, 0x06,
+	0x36, 0xe7, 0x24, 0xd7, 0xad, 0x33, 0x5c, 0x8f, 0x34, 0x40, 0x51, 0xfd, 0x5e, 0x50, 0x55, 0x48,
+	0x4c, 0x45, 0xc9, 0xd2, 0x23, 0x83, 0xc0, 0x0a, 0x09, 0x1d, 0x1c, 0x72, 0x72, 0x84, 0x15, 0xad,
+	0xee, 0xa5, 0x79, 0x49, 0x5a, 0x1f, 0x95, 0x9b, 0x66, 0x6a, 0x28, 0x18, 0x87, 0x1a, 0xeb, 0x06,
+	0x39, 0x7b, 0x64, 0x7c, 0x02, 0x4b, 0x3e, 0xf9, 0x79, 0x42, 0x5c, 0xc2, 0x7b, 0xc2, 0x0b, 0x37,
+	0x4b, 0xb2, 0xa8, 0x3b, 0xba, 0xa8, 0xdf, 0x3f, 0x5b, 0xd4, 0xc7, 0xd8, 0x43, 0x4e, 0xef, 0x11,
+	0x76, 0x06, 0x4a, 0xfb, 0x08, 0x3b, 0xed, 0x72, 0x46, 0xf4, 0x14, 0xc5, 0x5c, 0xd4, 0xa0, 0xcf,
+	0xec, 0xe2, 0x90, 0x06, 0x26, 0xc8, 0xa6, 0xea, 0x3b, 0x7c, 0x24, 0x76, 0x0d, 0x02, 0xcb, 0x47,
+	0x98, 0x71, 0x51, 0xac, 0x2c, 0x7b, 0x0b, 0xe3, 0xb2, 0xb7, 0x29, 0xe2, 0xfb, 0xd7, 0xeb, 0xf5,
+	0xd5, 0x1e, 0x0a, 0xfc, 0x3d, 0xeb, 0x34, 0x81, 0xa5, 0x12, 0xab, 0xb7, 0xb3, 0xc4, 0xfe, 0x21,
+	0x07, 0x9b, 0x29, 0xb4, 0x5f, 0x77, 0x1b, 0x1d, 0x72, 0x1c, 0xdb, 0x0c, 0x73, 0xee, 0xe3, 0x00,
+	0x87, 0xdc, 0x5c, 0x1c, 0xe7, 0xfe, 0x43, 0xed, 0x7e, 0x7b, 0xd8, 0xfd, 0x08, 0x4e, 0x15, 0xd1,
+	0xba, 0x46, 0x76, 0xd2, 0xe6, 0xd9, 0x17, 0xb0, 0x4e, 0x86, 0x32, 0x0e, 0x60, 0x2e, 0x8a, 0x31,
+	0x43, 0x3e, 0x36, 0xcb, 0x32, 0x88, 0xcd, 0x91, 0xc2, 0xa1, 0xa0, 0xba, 0xc0, 0xa9, 0xa5, 0xf1,
+	0x1c, 0xd2, 0x3e, 0xb7, 0x7d, 0x12, 0x10, 0xce, 0xcc, 0x25, 0xc9, 0x75, 0x7b, 0x14, 0xd7, 0x33,
+	0x65, 0xf1, 0x58, 0x1a, 0x68, 0xc6, 0x32, 0x1f, 0xdc, 0x34, 0x5e, 0xc0, 0x42, 0x44, 0xa9, 0x9f,
+	0x76, 0x63, 0x45, 0x92, 0xde, 0x1f, 0x45, 0xda, 0xbf, 0xb2, 0xa7, 0x94, 0xfa, 0x43, 0xed, 0x08,
+	0x51, 0xb6, 0x63, 0x7c, 0x0a, 0xcb, 0x1c, 0xa3, 0xc0, 0xd6, 0xe2, 0x40, 0x68, 0xc8, 0xcc, 0xe5,
+	0x8d, 0xfc, 0xd6, 0xc2, 0xee, 0xf6, 0xc8, 0x90, 0x31, 0x0a, 0xf6, 0x33, 0x13, 0xcd, 0x5b, 0xe1,
+	0x43, 0xbb, 0x6c, 0x0f, 0x84, 0x8a, 0x2a, 0x91, 0xb3, 0xee, 0xc3, 0xca, 0x90, 0x4a, 0xa6, 0xfa,
+	0x69, 0xac, 0xc2, 0x9c, 0xbc, 0xd1, 0x88, 0xab, 0xf4, 0xb2, 0x5d, 0x14, 0xcb, 0xa6, 0x6b, 0xfd,
+	0xbd, 0x00, 0xe5, 0xbe, 0xe8, 0x5e, 0x46, 0x58, 0x07, 0xa8, 0x67, 0x06, 0xa9, 0xcf, 0x4a, 0x5f,
+	0xfe, 0x0a, 0xa4, 0xef, 0x5c, 0x31, 0x9a, 0x7d, 0x17, 0x62, 0x54, 0xf8, 0x1f, 0x88, 0x51, 0xf1,
+	0x8a, 0xc4, 0xe8, 0x3c, 0x8d, 0x99, 0xfb, 0x6e, 0x35, 0x66, 0xfe, 0xbb, 0xd2, 0x98, 0xa1, 0x1b,
+	0x62, 0x15, 0x56, 0x86, 0xba, 0x3b, 0x1b, 0x28, 0x3c, 0x58, 0x6e, 0x31, 0xfd, 0x9c, 0xd4, 0xd2,
+	0x70, 0x65, 0x9d, 0x3f, 0x14, 0x41, 0x15, 0xcc, 0xd3, 0x8e, 0xb2, 0x20, 0xfe, 0x3d, 0x03, 0xc5,
+	0x16, 0xf3, 0x1a, 0x49, 0x4f, 0xf8, 0xee, 0x26, 0xbd, 0x49, 0x7c, 0x4b, 0xd8, 0xc5, 0x77, 0xdd,
+	0x01, 0x14, 0x2f, 0x3f, 0xbe, 0x68, 0x53, 0xa3, 0x03, 0x95, 0x00, 0x9d, 0xd8, 0x0e, 0x65, 0x3c,
+	0x1d, 0x86, 0x66, 0xa7, 0x67, 0x2b, 0x07, 0xe8, 0xe4, 0x80, 0x32, 0xae, 0x47, 0xa1, 0x16, 0x94,
+	0xb5, 0x82, 0x8b, 0x51, 0x9b, 0x1e, 0x9a, 0x85, 0xf1, 0x7a, 0xa0, 0x9f, 0x00, 0x4f, 0x05, 0xbe,
+	0xbd, 0x18, 0x0d, 0xac, 0x8c, 0x87, 0x30, 0x1f, 0xe3, 0x43, 0x1c, 0xc7, 0x38, 0x36, 0x8b, 0x63,
+	0x92, 0x96, 0x21, 0x75, 0x69, 0x64, 0x0e, 0x2d, 0x07, 0x16, 0x07, 0xf9, 0x8d, 0x1f, 0x41, 0xde,
+	0x41, 0x91, 0x99, 0x9b, 0xfe, 0x4a, 0x85, 0x9d, 0x71, 0x13, 0x0a, 0xea, 0xba, 0x66, 0x36, 0xf2,
+	0x5b, 0x8b, 0x6d, 0xb5, 0xb0, 0x7e, 0x9b, 0x97, 0x9d, 0xd6, 0x48, 0x7a, 0x1f, 0x9d, 0x20, 0x87,
+	0x77, 0x22, 0x1c, 0xba, 0x57, 0x57, 0xed, 0x7d, 0x28, 0x30, 0xc1, 0x78, 0x99, 0x62, 0x2b, 0x4b,
+	0xe3, 0x33, 0x58, 0x09, 0x48, 0x68, 0xd3, 0x84, 0xdb, 0x9c, 0xbe, 0xc2, 0x21, 0xfb, 0x2f, 0x2a,
+	0x6e, 0x04, 0x24, 0x7c, 0x92, 0xf0, 0x67, 0x92, 0xe7, 0xff, 0xb5, 0xec, 0x7f, 0xc9, 0x83, 0x79,
+	0xba, 0x22, 0x2f, 0x08, 0x7f, 0xd9, 0x39, 0x46, 0xd1, 0xd5, 0x55, 0x66, 0x0f, 0xe6, 0x65, 0x3a,
+	0x6d, 0x12, 0xea, 0x07, 0xdf, 0xad, 0x9a, 0x26, 0x12, 0x6f, 0x73, 0x35, 0xfd, 0x36, 0x57, 0x3b,
+	0xa0, 0x24, 0x7d, 0xb4, 0xcf, 0x49, 0x83, 0x66, 0x28, 0xee, 0xe1, 0x98, 0x26, 0x1c, 0x33, 0x73,
+	0x56, 0x4e, 0x09, 0xef, 0x8f, 0x9c, 0x41, 0x8e, 0x51, 0xd4, 0x16, 0x68, 0xcd, 0xa2, 0x4d, 0x2f,
+	0xae, 0x6b, 0xe1, 0x1d, 0xd5, 0xb5, 0x78, 0x65, 0x75, 0x9d, 0xbb, 0x54, 0x5d, 0x1f, 0x43, 0x29,
+	0xcb, 0x85, 0xac, 0x8b, 0x98, 0xe5, 0xf4, 0xc0, 0x33, 0xdb, 0x2e, 0x8a, 0x65, 0xd3, 0x35, 0x7e,
+	0x08, 0x15, 0x55, 0x17, 0x91, 0x18, 0x35, 0xb7, 0xab, 0xc2, 0x95, 0xe5, 0xf6, 0x93, 0x84, 0xcb,
+	0xb1, 0xdd, 0x5a, 0x86, 0x25, 0xd5, 0x24, 0x99, 0x5a, 0xff, 0x79, 0x06, 0xe6, 0x5a, 0xcc, 0xeb,
+	0x60, 0xdf, 0x37, 0xee, 0x43, 0x91, 0x61, 0xdf, 0x9f, 0xa0, 0x4f, 0x34, 0xee, 0x1d, 0x0b, 0xf6,
+	0x0b, 0xb8, 0x2e, 0x8a, 0x4d, 0x42, 0x87, 0x8a, 0x07, 0xe7, 0xa5, 0x6f, 0xe0, 0x4a, 0x40, 0xc2,
+	0xa6, 0x24, 0xd1, 0x55, 0x1e, 0x2c, 0x4b, 0x61, 0xe2, 0xb2, 0x2c, 0x88, 0xb2, 0xe8, 0x2b, 0xb7,
+	0xae, 0x43, 0x45, 0xa7, 0x2d, 0x4b, 0x25, 0x86, 0x79, 0x31, 0xa7, 0xfa, 0x88, 0x04, 0xc6, 0x2e,
+	0xcc, 0x39, 0xe2, 0x9f, 0x09, 0x72, 0x99, 0x02, 0x2f, 0x7e, 0xf2, 0x2e, 0x0a, 0xc7, 0x29, 0xcc,
+	0x32, 0x60, 0x39, 0x75, 0x93, 0xb9, 0x7e, 0x05, 0x4b, 0xe9, 0xde, 0x73, 0xcc, 0x38, 0x76, 0xdf,
+	0x65, 0x00, 0x26, 0x7c, 0x6f, 0xd8, 0x59, 0x16, 0x06, 0x81, 0x52, 0x8b, 0x79, 0x6d, 0x7c, 0x98,
+	0x84, 0xae, 0x4e, 0x72, 0x12, 0xba, 0x13, 0x84, 0x90, 0x21, 0x2f, 0x8e, 0xa1, 0x2c, 0x62, 0xc8,
+	0x70, 0xd6, 0x0d, 0xb8, 0x9e, 0xb9, 0xca, 0xfc, 0xff, 0xb2, 0x1f, 0xd9, 0xf0, 0x6b, 0x86, 0xb1,
+	0x07, 0x0b, 0x5d, 0x1c, 0xe2, 0x43, 0xe2, 0x10, 0x14, 0x8f, 0xff, 0x30, 0x34, 0x08, 0xbe, 0x38,
+	0xa4, 0x65, 0x11, 0xd2, 0x20, 0xd4, 0xda, 0x80, 0xb5, 0xf3, 0x03, 0x48, 0x43, 0xdc, 0xfd, 0x1d,
+	0x40, 0xbe, 0xc5, 0x3c, 0x23, 0x82, 0xc5, 0xa1, 0xef, 0x56, 0x77, 0x46, 0x29, 0xcc, 0xa9, 0x0f,
+	0x48, 0xd5, 0x07, 0x53, 0x80, 0xb3, 0xb7, 0xa5, 0x9f, 0x01, 0x0c, 0x7c, 0x69, 0xba, 0x3d, 0x86,
+	0xa2, 0x0f, 0xad, 0xee, 0x4c, 0x0c, 0x1d, 0xf4, 0x35, 0xf0, 0xf2, 0x75, 0x7b, 0xb2, 0x70, 0x27,
+	0xf1, 0x75, 0x76, 0xe8, 0x35, 0x18, 0x94, 0x87, 0x27, 0xde, 0xbb, 0x63, 0x38, 0x86, 0xd0, 0xd5,
+	0x87, 0xd3, 0xa0, 0x33, 0xa7, 0x1f, 0x43, 0x5e, 0x0c, 0xb8, 0xd6, 0x18, 0xe3, 0x46, 0xd2, 0xab,
+	0x6e, 0x8f, 0xc7, 0x64, 0xb4, 0x04, 0xca, 0xc3, 0x33, 0xd5, 0xdd, 0xf1, 0xc6, 0x7d, 0xf4, 0x54,
+	0xae, 0x7a, 0xb0, 0x72, 0xfe, 0xb0, 0xf0, 0x70, 0x1a, 0x97, 0xa9, 0xd5, 0x54, 0xae, 0x3f, 0x81,
+	0x59, 0xf9, 0xbc, 0xd9, 0x1c, 0x63, 0x23, 0x40, 0xd5, 0x3b, 0x13, 0x80, 0x32, 0xe6, 0x4f, 0xa1,
+	0xa0, 0xf4, 0xf7, 0xbd, 0x71, 0x3d, 0x2b, 0x50, 0xd5, 0xbb, 0x93, 0xa0, 0x32, 0xf2, 0x00, 0x16,
+	0x06, 0x15, 0x76, 0x7b, 0x12, 0x63, 0x85, 0xad, 0xee, 0x4e, 0x8e, 0xcd, 0xdc, 0x7d, 0x06, 0x45,
+	0xad, 0xa4, 0xef, 0x8f, 0xb1, 0x56, 0xb0, 0xea, 0xbd, 0x89, 0x60, 0x19, 0xff, 0x6f, 0x72, 0x70,
+	0xe3, 0x3c, 0xa9, 0x9c, 0x28, 0xd6, 0x61, 0x9b, 0xea, 0xde, 0xf4, 0x36, 0x69, 0x1c, 0xd5, 0xc2,
+	0xaf, 0xbe, 0xfd, 0x62, 0x3b, 0xd7, 0xf8, 0xc9, 0x57, 0x6f, 0xd6, 0x72, 0x5f, 0xbf, 0x59, 0xcb,
+	0xfd, 0xf3, 0xcd, 0x5a, 0xee, 0xf3, 0xb7, 0x6b, 0xd7, 0xbe, 0x7e, 0xbb, 0x76, 0xed, 0xaf, 0x6f,
+	0xd7, 0xae, 0xfd, 0xf4, 0xbe, 0x47, 0xf8, 0xcb, 0xa4, 0x5b, 0x73, 0x68, 0x50, 0xbf, 0xe0, 0xa7,
+	0x81, 0xa3, 0x07, 0xf5, 0x13, 0xf5, 0x8b, 0x49, 0x2f, 0xc2, 0xac, 0x5b, 0x94, 0x2f, 0xe6, 0x0f,
+	0xfe, 0x33, 0x00, 0x80, 0x77, 0x5d, 0x4a, 0x5c, 0x19, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -937,15 +1582,27 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// CreatePlan is used to create a new plan.
 	CreatePlan(ctx context.Context, in *MsgCreatePlan, opts ...grpc.CallOption) (*MsgCreatePlanResponse, error)
+	// UpdatePlan is used by the rollapp owner to edit a plan before trading is
+	// enabled.
+	UpdatePlan(ctx context.Context, in *MsgUpdatePlan, opts ...grpc.CallOption) (*MsgUpdatePlanResponse, error)
 	EnableTrading(ctx context.Context, in *MsgEnableTrading, opts ...grpc.CallOption) (*MsgEnableTradingResponse, error)
 	// Buy is used to buy allocation.
 	Buy(ctx context.Context, in *MsgBuy, opts ...grpc.CallOption) (*MsgBuyResponse, error)
 	BuyExactSpend(ctx context.Context, in *MsgBuyExactSpend, opts ...grpc.CallOption) (*MsgBuyResponse, error)
+	// BuyExactSpendWithSwap swaps any token into the liquidity denom of the plan
+	// through gamm pools, and spends the proceeds on the plan.
+	BuyExactSpendWithSwap(ctx context.Context, in *MsgBuyExactSpendWithSwap, opts ...grpc.CallOption) (*MsgBuyResponse, error)
 	// Sell is used to sell allocation.
 	Sell(ctx context.Context, in *MsgSell, opts ...grpc.CallOption) (*MsgSellResponse, error)
 	// Claim is used to claim tokens after the plan is settled.
 	Claim(ctx context.Context, in *MsgClaim, opts ...grpc.CallOption) (*MsgClaimResponse, error)
 	ClaimVested(ctx context.Context, in *MsgClaimVested, opts ...grpc.CallOption) (*MsgClaimVestedResponse, error)
+	// Refund is used to redeem IRO tokens for the raised liquidity after the
+	// plan failed.
+	Refund(ctx context.Context, in *MsgRefund, opts ...grpc.CallOption) (*MsgRefundResponse, error)
+	// ClaimTeamAllocation is used by a team allocation beneficiary to claim the
+	// vested rollapp tokens.
+	ClaimTeamAllocation(ctx context.Context, in *MsgClaimTeamAllocation, opts ...grpc.CallOption) (*MsgClaimTeamAllocationResponse, error)
 }
 
 type msgClient struct {
@@ -974,6 +1631,15 @@ func (c *msgClient) CreatePlan(ctx context.Context, in *MsgCreatePlan, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) UpdatePlan(ctx context.Context, in *MsgUpdatePlan, opts ...grpc.CallOption) (*MsgUpdatePlanResponse, error) {
+	out := new(MsgUpdatePlanResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Msg/UpdatePlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) EnableTrading(ctx context.Context, in *MsgEnableTrading, opts ...grpc.CallOption) (*MsgEnableTradingResponse, error) {
 	out := new(MsgEnableTradingResponse)
 	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Msg/EnableTrading", in, out, opts...)
@@ -1001,6 +1667,15 @@ func (c *msgClient) BuyExactSpend(ctx context.Context, in *MsgBuyExactSpend, opt
 	return out, nil
 }
 
+func (c *msgClient) BuyExactSpendWithSwap(ctx context.Context, in *MsgBuyExactSpendWithSwap, opts ...grpc.CallOption) (*MsgBuyResponse, error) {
+	out := new(MsgBuyResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Msg/BuyExactSpendWithSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Sell(ctx context.Context, in *MsgSell, opts ...grpc.CallOption) (*MsgSellResponse, error) {
 	out := new(MsgSellResponse)
 	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Msg/Sell", in, out, opts...)
@@ -1028,21 +1703,51 @@ func (c *msgClient) ClaimVested(ctx context.Context, in *MsgClaimVested, opts ..
 	return out, nil
 }
 
+func (c *msgClient) Refund(ctx context.Context, in *MsgRefund, opts ...grpc.CallOption) (*MsgRefundResponse, error) {
+	out := new(MsgRefundResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Msg/Refund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimTeamAllocation(ctx context.Context, in *MsgClaimTeamAllocation, opts ...grpc.CallOption) (*MsgClaimTeamAllocationResponse, error) {
+	out := new(MsgClaimTeamAllocationResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Msg/ClaimTeamAllocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams is used for updating module params.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// CreatePlan is used to create a new plan.
 	CreatePlan(context.Context, *MsgCreatePlan) (*MsgCreatePlanResponse, error)
+	// UpdatePlan is used by the rollapp owner to edit a plan before trading is
+	// enabled.
+	UpdatePlan(context.Context, *MsgUpdatePlan) (*MsgUpdatePlanResponse, error)
 	EnableTrading(context.Context, *MsgEnableTrading) (*MsgEnableTradingResponse, error)
 	// Buy is used to buy allocation.
 	Buy(context.Context, *MsgBuy) (*MsgBuyResponse, error)
 	BuyExactSpend(context.Context, *MsgBuyExactSpend) (*MsgBuyResponse, error)
+	// BuyExactSpendWithSwap swaps any token into the liquidity denom of the plan
+	// through gamm pools, and spends the proceeds on the plan.
+	BuyExactSpendWithSwap(context.Context, *MsgBuyExactSpendWithSwap) (*MsgBuyResponse, error)
 	// Sell is used to sell allocation.
 	Sell(context.Context, *MsgSell) (*MsgSellResponse, error)
 	// Claim is used to claim tokens after the plan is settled.
 	Claim(context.Context, *MsgClaim) (*MsgClaimResponse, error)
 	ClaimVested(context.Context, *MsgClaimVested) (*MsgClaimVestedResponse, error)
+	// Refund is used to redeem IRO tokens for the raised liquidity after the
+	// plan failed.
+	Refund(context.Context, *MsgRefund) (*MsgRefundResponse, error)
+	// ClaimTeamAllocation is used by a team allocation beneficiary to claim the
+	// vested rollapp tokens.
+	ClaimTeamAllocation(context.Context, *MsgClaimTeamAllocation) (*MsgClaimTeamAllocationResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1055,6 +1760,9 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) CreatePlan(ctx context.Context, req *MsgCreatePlan) (*MsgCreatePlanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePlan not implemented")
 }
+func (*UnimplementedMsgServer) UpdatePlan(ctx context.Context, req *MsgUpdatePlan) (*MsgUpdatePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePlan not implemented")
+}
 func (*UnimplementedMsgServer) EnableTrading(ctx context.Context, req *MsgEnableTrading) (*MsgEnableTradingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EnableTrading not implemented")
 }
@@ -1064,6 +1772,9 @@ func (*UnimplementedMsgServer) Buy(ctx context.Context, req *MsgBuy) (*MsgBuyRes
 func (*UnimplementedMsgServer) BuyExactSpend(ctx context.Context, req *MsgBuyExactSpend) (*MsgBuyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BuyExactSpend not implemented")
 }
+func (*UnimplementedMsgServer) BuyExactSpendWithSwap(ctx context.Context, req *MsgBuyExactSpendWithSwap) (*MsgBuyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyExactSpendWithSwap not implemented")
+}
 func (*UnimplementedMsgServer) Sell(ctx context.Context, req *MsgSell) (*MsgSellResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Sell not implemented")
 }
@@ -1073,6 +1784,12 @@ func (*UnimplementedMsgServer) Claim(ctx context.Context, req *MsgClaim) (*MsgCl
 func (*UnimplementedMsgServer) ClaimVested(ctx context.Context, req *MsgClaimVested) (*MsgClaimVestedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClaimVested not implemented")
 }
+func (*UnimplementedMsgServer) Refund(ctx context.Context, req *MsgRefund) (*MsgRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Refund not implemented")
+}
+func (*UnimplementedMsgServer) ClaimTeamAllocation(ctx context.Context, req *MsgClaimTeamAllocation) (*MsgClaimTeamAllocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimTeamAllocation not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1114,6 +1831,24 @@ func _Msg_CreatePlan_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePlan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Msg/UpdatePlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePlan(ctx, req.(*MsgUpdatePlan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_EnableTrading_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgEnableTrading)
 	if err := dec(in); err != nil {
@@ -1168,6 +1903,24 @@ func _Msg_BuyExactSpend_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BuyExactSpendWithSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBuyExactSpendWithSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BuyExactSpendWithSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Msg/BuyExactSpendWithSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BuyExactSpendWithSwap(ctx, req.(*MsgBuyExactSpendWithSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Sell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSell)
 	if err := dec(in); err != nil {
@@ -1222,6 +1975,42 @@ func _Msg_ClaimVested_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Refund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefund)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Refund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Msg/Refund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Refund(ctx, req.(*MsgRefund))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimTeamAllocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimTeamAllocation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimTeamAllocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Msg/ClaimTeamAllocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimTeamAllocation(ctx, req.(*MsgClaimTeamAllocation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dymensionxyz.dymension.iro.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1234,6 +2023,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreatePlan",
 			Handler:    _Msg_CreatePlan_Handler,
 		},
+		{
+			MethodName: "UpdatePlan",
+			Handler:    _Msg_UpdatePlan_Handler,
+		},
 		{
 			MethodName: "EnableTrading",
 			Handler:    _Msg_EnableTrading_Handler,
@@ -1246,6 +2039,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BuyExactSpend",
 			Handler:    _Msg_BuyExactSpend_Handler,
 		},
+		{
+			MethodName: "BuyExactSpendWithSwap",
+			Handler:    _Msg_BuyExactSpendWithSwap_Handler,
+		},
 		{
 			MethodName: "Sell",
 			Handler:    _Msg_Sell_Handler,
@@ -1258,6 +2055,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClaimVested",
 			Handler:    _Msg_ClaimVested_Handler,
 		},
+		{
+			MethodName: "Refund",
+			Handler:    _Msg_Refund_Handler,
+		},
+		{
+			MethodName: "ClaimTeamAllocation",
+			Handler:    _Msg_ClaimTeamAllocation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dymensionxyz/dymension/iro/tx.proto",
@@ -1346,20 +2151,66 @@ func (m *MsgCreatePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VestingStartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingStartTimeAfterSettlement):])
-	if err2 != nil {
-		return 0, err2
+	if len(m.TeamAllocations) > 0 {
+		for iNdEx := len(m.TeamAllocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TeamAllocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	{
+		size, err := m.PoolParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size, err := m.TradingLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size, err := m.Presale.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VestingStartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingStartTimeAfterSettlement):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n2
-	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x62
-	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VestingDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingDuration):])
-	if err3 != nil {
-		return 0, err3
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VestingDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingDuration):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n3
-	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i -= n6
+	i = encodeVarintTx(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x5a
 	if len(m.LiquidityDenom) > 0 {
@@ -1389,20 +2240,20 @@ func (m *MsgCreatePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x42
-	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.IroPlanDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IroPlanDuration):])
-	if err5 != nil {
-		return 0, err5
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.IroPlanDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IroPlanDuration):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n5
-	i = encodeVarintTx(dAtA, i, uint64(n5))
+	i -= n8
+	i = encodeVarintTx(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x3a
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err6 != nil {
-		return 0, err6
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n6
-	i = encodeVarintTx(dAtA, i, uint64(n6))
+	i -= n9
+	i = encodeVarintTx(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0x32
 	if m.TradingEnabled {
@@ -1482,7 +2333,121 @@ func (m *MsgCreatePlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgEnableTrading) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdatePlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VestingStartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingStartTimeAfterSettlement):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintTx(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x42
+	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VestingDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingDuration):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintTx(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.LiquidityPart.Size()
+		i -= size
+		if _, err := m.LiquidityPart.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.IncentivePlanParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.IroPlanDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IroPlanDuration):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintTx(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.BondingCurve.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnableTrading) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1562,6 +2527,25 @@ func (m *MsgBuy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PresaleProof != nil {
+		{
+			size, err := m.PresaleProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.MaxCostAmount.Size()
 		i -= size
@@ -1599,6 +2583,48 @@ func (m *MsgBuy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PresaleProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PresaleProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PresaleProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.Cap.Size()
+		i -= size
+		if _, err := m.Cap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgBuyExactSpend) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1619,6 +2645,25 @@ func (m *MsgBuyExactSpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PresaleProof != nil {
+		{
+			size, err := m.PresaleProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.MinOutTokensAmount.Size()
 		i -= size
@@ -1656,6 +2701,131 @@ func (m *MsgBuyExactSpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgBuyExactSpendWithSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBuyExactSpendWithSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBuyExactSpendWithSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.PresaleProof != nil {
+		{
+			size, err := m.PresaleProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.MinOutTokensAmount.Size()
+		i -= size
+		if _, err := m.MinOutTokensAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgBuyResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1699,6 +2869,13 @@ func (m *MsgSell) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.MinIncomeAmount.Size()
 		i -= size
@@ -1879,24 +3056,144 @@ func (m *MsgClaimVestedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRefund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgRefund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Refunder) > 0 {
+		i -= len(m.Refunder)
+		copy(dAtA[i:], m.Refunder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Refunder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimTeamAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimTeamAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimTeamAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimTeamAllocationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimTeamAllocationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimTeamAllocationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -1951,6 +3248,18 @@ func (m *MsgCreatePlan) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingStartTimeAfterSettlement)
 	n += 1 + l + sovTx(uint64(l))
+	l = m.Presale.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TradingLimits.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.PoolParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.TeamAllocations) > 0 {
+		for _, e := range m.TeamAllocations {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1967,6 +3276,44 @@ func (m *MsgCreatePlanResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdatePlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.BondingCurve.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IroPlanDuration)
+	n += 1 + l + sovTx(uint64(l))
+	l = m.IncentivePlanParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.LiquidityPart.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingDuration)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingStartTimeAfterSettlement)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdatePlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgEnableTrading) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2011,6 +3358,31 @@ func (m *MsgBuy) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = m.MaxCostAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.PresaleProof != nil {
+		l = m.PresaleProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *PresaleProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Cap.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2032,6 +3404,65 @@ func (m *MsgBuyExactSpend) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = m.MinOutTokensAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.PresaleProof != nil {
+		l = m.PresaleProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBuyExactSpendWithSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.MinOutTokensAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.PresaleProof != nil {
+		l = m.PresaleProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *SwapRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2062,6 +3493,10 @@ func (m *MsgSell) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = m.MinIncomeAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2126,6 +3561,58 @@ func (m *MsgClaimVestedResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRefund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Refunder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRefundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimTeamAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimTeamAllocationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2708,61 +4195,44 @@ func (m *MsgCreatePlan) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Presale", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCreatePlanResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Presale.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePlanResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TradingLimits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2772,79 +4242,30 @@ func (m *MsgCreatePlanResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlanId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.TradingLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgEnableTrading) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEnableTrading: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEnableTrading: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolParams", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2854,29 +4275,30 @@ func (m *MsgEnableTrading) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if err := m.PoolParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TeamAllocations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2886,23 +4308,25 @@ func (m *MsgEnableTrading) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlanId = string(dAtA[iNdEx:postIndex])
+			m.TeamAllocations = append(m.TeamAllocations, TeamAllocation{})
+			if err := m.TeamAllocations[len(m.TeamAllocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2925,7 +4349,7 @@ func (m *MsgEnableTrading) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEnableTradingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePlanResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2948,12 +4372,44 @@ func (m *MsgEnableTradingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEnableTradingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreatePlanResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEnableTradingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreatePlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2975,7 +4431,7 @@ func (m *MsgEnableTradingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBuy) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePlan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2998,15 +4454,15 @@ func (m *MsgBuy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBuy: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePlan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBuy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePlan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3034,7 +4490,7 @@ func (m *MsgBuy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Buyer = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3070,9 +4526,9 @@ func (m *MsgBuy) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BondingCurve", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3082,29 +4538,1376 @@ func (m *MsgBuy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BondingCurve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxCostAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IroPlanDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.IroPlanDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivePlanParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IncentivePlanParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityPart", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityPart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VestingDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingStartTimeAfterSettlement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VestingStartTimeAfterSettlement, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEnableTrading) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEnableTrading: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEnableTrading: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEnableTradingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEnableTradingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEnableTradingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBuy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBuy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBuy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCostAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCostAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PresaleProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PresaleProof == nil {
+				m.PresaleProof = &PresaleProof{}
+			}
+			if err := m.PresaleProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PresaleProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PresaleProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PresaleProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBuyExactSpend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBuyExactSpend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBuyExactSpend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOutTokensAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinOutTokensAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PresaleProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PresaleProof == nil {
+				m.PresaleProof = &PresaleProof{}
+			}
+			if err := m.PresaleProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBuyExactSpendWithSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBuyExactSpendWithSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBuyExactSpendWithSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOutTokensAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinOutTokensAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PresaleProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PresaleProof == nil {
+				m.PresaleProof = &PresaleProof{}
+			}
+			if err := m.PresaleProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3132,10 +5935,58 @@ func (m *MsgBuy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxCostAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBuyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBuyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBuyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3157,7 +6008,7 @@ func (m *MsgBuy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBuyExactSpend) Unmarshal(dAtA []byte) error {
+func (m *MsgSell) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3180,15 +6031,15 @@ func (m *MsgBuyExactSpend) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBuyExactSpend: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSell: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBuyExactSpend: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSell: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3216,7 +6067,7 @@ func (m *MsgBuyExactSpend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Buyer = string(dAtA[iNdEx:postIndex])
+			m.Seller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3252,7 +6103,7 @@ func (m *MsgBuyExactSpend) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3280,13 +6131,13 @@ func (m *MsgBuyExactSpend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinOutTokensAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinIncomeAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3314,10 +6165,42 @@ func (m *MsgBuyExactSpend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinOutTokensAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinIncomeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3339,7 +6222,7 @@ func (m *MsgBuyExactSpend) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBuyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSellResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3362,10 +6245,10 @@ func (m *MsgBuyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBuyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSellResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBuyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSellResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3389,7 +6272,7 @@ func (m *MsgBuyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSell) Unmarshal(dAtA []byte) error {
+func (m *MsgClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3412,15 +6295,15 @@ func (m *MsgSell) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSell: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSell: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3448,7 +6331,7 @@ func (m *MsgSell) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Seller = string(dAtA[iNdEx:postIndex])
+			m.Claimer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3482,9 +6365,109 @@ func (m *MsgSell) Unmarshal(dAtA []byte) error {
 			}
 			m.PlanId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimVested) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimVested: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimVested: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3512,13 +6495,11 @@ func (m *MsgSell) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Claimer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinIncomeAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3546,9 +6527,7 @@ func (m *MsgSell) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinIncomeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3571,7 +6550,7 @@ func (m *MsgSell) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSellResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimVestedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3594,10 +6573,10 @@ func (m *MsgSellResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSellResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimVestedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSellResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimVestedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3621,7 +6600,7 @@ func (m *MsgSellResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaim) Unmarshal(dAtA []byte) error {
+func (m *MsgRefund) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3644,15 +6623,15 @@ func (m *MsgClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRefund: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRefund: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3680,7 +6659,7 @@ func (m *MsgClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Claimer = string(dAtA[iNdEx:postIndex])
+			m.Refunder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3735,7 +6714,7 @@ func (m *MsgClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRefundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3758,10 +6737,10 @@ func (m *MsgClaimResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRefundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRefundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3785,7 +6764,7 @@ func (m *MsgClaimResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimVested) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimTeamAllocation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3808,15 +6787,15 @@ func (m *MsgClaimVested) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimVested: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimTeamAllocation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimVested: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimTeamAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3844,7 +6823,7 @@ func (m *MsgClaimVested) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Claimer = string(dAtA[iNdEx:postIndex])
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3899,7 +6878,7 @@ func (m *MsgClaimVested) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimVestedResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimTeamAllocationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3922,10 +6901,10 @@ func (m *MsgClaimVestedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimVestedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimTeamAllocationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimVestedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimTeamAllocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -26,6 +27,9 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(
 		CmdGetExpectedClientState(),
 		CmdGetLightClient(),
+		CmdGetSigner(),
+		CmdGetSignedHeights(),
+		CmdGetUnbondBlockers(),
 	)
 
 	return cmd
@@ -33,7 +37,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 func CmdGetExpectedClientState() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "expected",
+		Use:   "expected [rollapp-id]",
 		Short: "Query the expected client state - NOTE: not all returned fields are relevant",
 		Long: `Query the expected client state.
 Relevant fields:
@@ -45,9 +49,10 @@ Relevant fields:
 	proof specs
 	upgrade path
 	
-The other fields can take any value`,
+The other fields can take any value.
+If the rollapp id is given, the client state expected for the rollapp is returned.`,
 		Example: fmt.Sprintf("%s query %s expected", version.AppName, ibcexported.ModuleName),
-		Args:    cobra.ExactArgs(0),
+		Args:    cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -56,6 +61,9 @@ The other fields can take any value`,
 			queryClient := types.NewQueryClient(clientCtx)
 
 			req := &types.QueryExpectedClientStateRequest{}
+			if len(args) == 1 {
+				req.RollappId = args[0]
+			}
 
 			clientStateRes, err := queryClient.ExpectedClientState(cmd.Context(), req)
 			if err != nil {
@@ -101,3 +109,121 @@ func CmdGetLightClient() *cobra.Command {
 
 	return cmd
 }
+
+func CmdGetSigner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signer [client-id] [height]",
+		Short: "Get the sequencer which signed the header of the client height",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("height: %w", err)
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Signer(cmd.Context(), &types.QuerySignerRequest{
+				ClientId: args[0],
+				Height:   height,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdGetSignedHeights() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "signed-heights [sequencer] [client-id] [from-height] [to-height]",
+		Short:   "Get the unverified heights of the client signed by the sequencer",
+		Long:    "Get the unverified heights of the client signed by the sequencer, within the inclusive range. A to-height of 0 means no upper bound.",
+		Example: fmt.Sprintf("%s query %s signed-heights <sequencer> <client-id> 0 0", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			from, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("from height: %w", err)
+			}
+			to, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("to height: %w", err)
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SignedHeights(cmd.Context(), &types.QuerySignedHeightsRequest{
+				Sequencer:  args[0],
+				ClientId:   args[1],
+				FromHeight: from,
+				ToHeight:   to,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdGetUnbondBlockers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbond-blockers [sequencer]",
+		Short: "Get the unverified heights signed by the sequencer which prevent it from unbonding",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.UnbondBlockers(cmd.Context(), &types.QueryUnbondBlockersRequest{
+				Sequencer:  args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
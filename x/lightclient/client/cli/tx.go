@@ -2,12 +2,18 @@ package cli
 
 import (
 	"fmt"
+	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 
 	"github.com/dymensionxyz/dymension/v3/x/lightclient/types"
 )
@@ -23,6 +29,9 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(NewSetCanonicalClientTxCmd())
+	cmd.AddCommand(NewSubmitSequencerEquivocationTxCmd())
+	cmd.AddCommand(NewRecoverCanonicalClientTxCmd())
+	cmd.AddCommand(NewSetClientParamsTxCmd())
 
 	return cmd
 }
@@ -57,3 +66,131 @@ func NewSetCanonicalClientTxCmd() *cobra.Command {
 
 	return cmd
 }
+
+func NewSubmitSequencerEquivocationTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "submit-equivocation [path/to/header-1.json] [path/to/header-2.json]",
+		Short:   "Submit two conflicting rollapp headers signed by the same sequencer",
+		Example: "dymd tx lightclient submit-equivocation header1.json header2.json",
+		Long: `Submit two different tendermint headers for the same rollapp height, signed by the same sequencer.
+The sequencer is slashed and the submitter is rewarded.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			header1, err := readHeader(clientCtx.Codec, args[0])
+			if err != nil {
+				return fmt.Errorf("header 1: %w", err)
+			}
+			header2, err := readHeader(clientCtx.Codec, args[1])
+			if err != nil {
+				return fmt.Errorf("header 2: %w", err)
+			}
+
+			msg := &types.MsgSubmitSequencerEquivocation{
+				Submitter: clientCtx.GetFromAddress().String(),
+				Header_1:  header1,
+				Header_2:  header2,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRecoverCanonicalClientTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "recover-canonical-client [rollapp-id] [client-id] [channel-id]",
+		Short:   "Replace the expired or frozen canonical client of a rollapp",
+		Example: "dymd tx lightclient recover-canonical-client <rollapp-id> <client-id> <channel-id>",
+		Long: `Replace the expired or frozen canonical client of a rollapp by a new client matching the finalized rollapp state.
+The transfer channel, which must be open over the new client, becomes the canonical channel of the rollapp.
+Only the rollapp owner can submit it outside of a governance proposal.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRecoverCanonicalClient{
+				Signer:    clientCtx.GetFromAddress().String(),
+				RollappId: args[0],
+				ClientId:  args[1],
+				ChannelId: args[2],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetClientParamsTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-client-params [rollapp-id] [unbonding-period] [max-clock-drift]",
+		Short:   "Set the trust parameters expected from the canonical client of a rollapp",
+		Example: "dymd tx lightclient set-client-params <rollapp-id> 504h 70m",
+		Long: `Set the trust parameters expected from the canonical client of a rollapp. The trusting period is derived from the unbonding period.
+Only the rollapp owner can submit it outside of a governance proposal.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			unbondingPeriod, err := time.ParseDuration(args[1])
+			if err != nil {
+				return fmt.Errorf("unbonding period: %w", err)
+			}
+			maxClockDrift, err := time.ParseDuration(args[2])
+			if err != nil {
+				return fmt.Errorf("max clock drift: %w", err)
+			}
+
+			msg := &types.MsgSetClientParams{
+				Signer:          clientCtx.GetFromAddress().String(),
+				RollappId:       args[0],
+				UnbondingPeriod: unbondingPeriod,
+				MaxClockDrift:   maxClockDrift,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func readHeader(cdc codec.Codec, path string) (*codectypes.Any, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var header exported.ClientMessage
+	if err := cdc.UnmarshalInterfaceJSON(bz, &header); err != nil {
+		return nil, fmt.Errorf("unmarshal header: %w", err)
+	}
+	return ibcclienttypes.PackClientMessage(header)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"errors"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,6 +19,7 @@ var (
 	ErrNoMatch        = gerrc.ErrFailedPrecondition.Wrap("not at least one cons state matches the rollapp state")
 	ErrMismatch       = gerrc.ErrInvalidArgument.Wrap("consensus state mismatch")
 	ErrParamsMismatch = gerrc.ErrInvalidArgument.Wrap("params")
+	ErrNotCommitted   = gerrc.ErrInvalidArgument.Wrap("clients latest height not committed")
 )
 
 // intended to be called by relayer, but can be called by anyone
@@ -90,8 +92,33 @@ func (k Keeper) GetAllCanonicalClients(ctx sdk.Context) (clients []types.Canonic
 	return
 }
 
-func (k Keeper) expectedClient() ibctm.ClientState {
-	return types.DefaultExpectedCanonicalClientParams()
+// expectedClient returns the client state expected from the canonical client of the rollapp, which uses the
+// defaults unless client params are set for the rollapp
+func (k Keeper) expectedClient(ctx sdk.Context, rollappId string) (ibctm.ClientState, error) {
+	p, err := k.GetClientParams(ctx, rollappId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DefaultExpectedCanonicalClientParams(), nil
+	}
+	if err != nil {
+		return ibctm.ClientState{}, errorsmod.Wrap(err, "get client params")
+	}
+	return p.ExpectedClient(), nil
+}
+
+func (k Keeper) SetClientParams(ctx sdk.Context, p types.ClientParams) error {
+	return k.clientParams.Set(ctx, p.RollappId, p)
+}
+
+func (k Keeper) GetClientParams(ctx sdk.Context, rollappId string) (types.ClientParams, error) {
+	return k.clientParams.Get(ctx, rollappId)
+}
+
+func (k Keeper) AllClientParams(ctx sdk.Context) ([]types.ClientParams, error) {
+	iter, err := k.clientParams.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
 }
 
 // The canonical client criteria are:
@@ -100,24 +127,31 @@ func (k Keeper) expectedClient() ibctm.ClientState {
 // 3. ClientID must not have any connections
 // 4. All the existing consensus states much match the corresponding height rollapp block descriptors
 func (k Keeper) validClient(ctx sdk.Context, clientID string, cs *ibctm.ClientState, rollappId string) error {
-	expClient := k.expectedClient()
-	if err := types.IsCanonicalClientParamsValid(cs, &expClient); err != nil {
-		return errors.Join(err, ErrParamsMismatch)
-	}
-
 	// Check if the clientID has any connections
 	_, found := k.ibcConnectionK.GetClientConnectionPaths(ctx, clientID)
 	if found {
 		return gerrc.ErrFailedPrecondition.Wrap("client already has connections")
 	}
+	return k.clientMatchesRollapp(ctx, clientID, cs, rollappId)
+}
+
+// clientMatchesRollapp checks the criteria of a canonical client, except the absence of connections
+func (k Keeper) clientMatchesRollapp(ctx sdk.Context, clientID string, cs *ibctm.ClientState, rollappId string) error {
+	expClient, err := k.expectedClient(ctx, rollappId)
+	if err != nil {
+		return err
+	}
+	if err := types.IsCanonicalClientParamsValid(cs, &expClient); err != nil {
+		return errors.Join(err, ErrParamsMismatch)
+	}
 
 	// validate latest height is already committed
 	latestCommittedHeight, ok := k.rollappKeeper.GetLatestHeight(ctx, rollappId)
 	if !ok {
-		return gerrc.ErrNotFound.Wrap("latest height")
+		return errorsmod.Wrap(ErrNotCommitted, "no latest height")
 	}
 	if latestCommittedHeight < cs.GetLatestHeight().GetRevisionHeight() {
-		return gerrc.ErrInvalidArgument.Wrap("clients latest height not committed")
+		return ErrNotCommitted
 	}
 
 	sinfo, ok := k.rollappKeeper.GetLatestStateInfoIndex(ctx, rollappId)
@@ -161,7 +195,7 @@ func (k Keeper) ValidateHeaderAgainstStateInfo(ctx sdk.Context, sInfo *rollappty
 		return errorsmod.Wrapf(gerrc.ErrInternal, "no block descriptor found for height %d", h)
 	}
 
-	nextSeq, err := k.SeqK.RealSequencer(ctx, sInfo.NextSequencerForHeight(h))
+	nextSeq, err := k.SeqK.SequencerAtHeight(ctx, sInfo.NextSequencerForHeight(h), h+1)
 	if err != nil {
 		return errorsmod.Wrap(errors.Join(err, gerrc.ErrInternal), "get sequencer of state info")
 	}
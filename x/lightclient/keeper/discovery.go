@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/lightclient/types"
+)
+
+const (
+	// DiscoveryBudget is the max number of candidates tried per block
+	DiscoveryBudget = 10
+	// MaxCandidatesPerRollapp is the max number of candidates kept for a rollapp
+	MaxCandidatesPerRollapp = 5
+	// MaxCandidateAttempts is the number of times a candidate is tried before it is dropped, so that clients
+	// which never match a state update do not hold the slots of the rollapp. A dropped client is added again
+	// on its next update.
+	MaxCandidateAttempts = 100
+)
+
+// AddCandidate records the client as candidate to become the canonical client of the rollapp, if the rollapp
+// has no canonical client yet and the client is for the rollapp chain with the expected params.
+// Further clients are ignored once the rollapp has MaxCandidatesPerRollapp candidates.
+func (k Keeper) AddCandidate(ctx sdk.Context, rollappID, clientID string) error {
+	if _, ok := k.GetCanonicalClient(ctx, rollappID); ok {
+		return nil
+	}
+	cs, ok := k.ibcClientKeeper.GetClientState(ctx, clientID)
+	if !ok {
+		return nil
+	}
+	tmCS, ok := cs.(*ibctm.ClientState)
+	if !ok || tmCS.ChainId != rollappID {
+		return nil
+	}
+	expClient, err := k.expectedClient(ctx, rollappID)
+	if err != nil {
+		return err
+	}
+	if err := types.IsCanonicalClientParamsValid(tmCS, &expClient); err != nil {
+		return nil
+	}
+
+	key := collections.Join(rollappID, clientID)
+	has, err := k.candidates.Has(ctx, key)
+	if err != nil || has {
+		return err
+	}
+	n, err := k.countCandidates(ctx, rollappID)
+	if err != nil {
+		return err
+	}
+	if MaxCandidatesPerRollapp <= n {
+		return nil
+	}
+	return k.candidates.Set(ctx, key, 0)
+}
+
+func (k Keeper) countCandidates(ctx sdk.Context, rollappID string) (int, error) {
+	n := 0
+	rng := collections.NewPrefixedPairRange[string, string](rollappID)
+	err := k.candidates.Walk(ctx, rng, func(collections.Pair[string, string], uint64) (bool, error) {
+		n++
+		return false, nil
+	})
+	return n, err
+}
+
+func (k Keeper) AllCandidates(ctx sdk.Context) ([]collections.Pair[string, string], error) {
+	iter, err := k.candidates.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Keys()
+}
+
+// DiscoverCanonicalClients tries to set candidates as canonical clients, up to the budget, continuing after
+// the last candidate tried in the previous block.
+// Candidates are removed once their rollapp has a canonical client, or if they can never become canonical. Candidates
+// which are only missing state updates are kept for later blocks, up to MaxCandidateAttempts tries.
+func (k *Keeper) DiscoverCanonicalClients(ctx sdk.Context) error {
+	batch, err := k.nextCandidates(ctx, DiscoveryBudget)
+	if err != nil {
+		return errorsmod.Wrap(err, "next candidates")
+	}
+	if len(batch) == 0 {
+		return nil
+	}
+	if err := k.candidateCursor.Set(ctx, batch[len(batch)-1]); err != nil {
+		return err
+	}
+
+	// each candidate is tried in its own cache context, so a failure does not affect the others
+	for _, c := range batch {
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.tryCandidate(cacheCtx, c); err != nil {
+			k.Logger(ctx).Error("Try canonical client candidate.", "rollapp", c.K1(), "client", c.K2(), "err", err)
+			continue
+		}
+		write()
+	}
+	return nil
+}
+
+// tryCandidate tries to set the candidate as the canonical client of its rollapp, and removes the candidate
+// unless it is only missing state updates and has attempts left
+func (k *Keeper) tryCandidate(ctx sdk.Context, c collections.Pair[string, string]) error {
+	rollappID, clientID := c.K1(), c.K2()
+	if _, ok := k.GetCanonicalClient(ctx, rollappID); ok {
+		return k.candidates.Remove(ctx, c)
+	}
+
+	err := k.TrySetCanonicalClient(ctx, clientID)
+	if errorsmod.IsOf(err, ErrNoMatch, ErrNotCommitted) {
+		return k.countAttempt(ctx, c)
+	}
+	if err != nil {
+		k.Logger(ctx).Debug("Drop canonical client candidate.", "rollapp", rollappID, "client", clientID, "err", err)
+		return k.candidates.Remove(ctx, c)
+	}
+	if err := k.candidates.Remove(ctx, c); err != nil {
+		return err
+	}
+
+	return uevent.EmitTypedEvent(ctx, &types.EventCanonicalClientDiscovered{
+		RollappId: rollappID,
+		ClientId:  clientID,
+	})
+}
+
+// countAttempt records a failed try of the candidate, and removes it once it has no attempts left
+func (k *Keeper) countAttempt(ctx sdk.Context, c collections.Pair[string, string]) error {
+	attempts, err := k.candidates.Get(ctx, c)
+	if err != nil {
+		return err
+	}
+	attempts++
+	if MaxCandidateAttempts <= attempts {
+		k.Logger(ctx).Debug("Drop canonical client candidate, no attempts left.", "rollapp", c.K1(), "client", c.K2())
+		return k.candidates.Remove(ctx, c)
+	}
+	return k.candidates.Set(ctx, c, attempts)
+}
+
+// nextCandidates returns up to n candidates after the cursor, wrapping around to the first candidate
+func (k Keeper) nextCandidates(ctx sdk.Context, n int) ([]collections.Pair[string, string], error) {
+	cursor, err := k.candidateCursor.Get(ctx)
+	hasCursor := err == nil
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	var ret []collections.Pair[string, string]
+	collect := func(key collections.Pair[string, string], _ uint64) (bool, error) {
+		ret = append(ret, key)
+		return n <= len(ret), nil
+	}
+
+	var after collections.Ranger[collections.Pair[string, string]]
+	if hasCursor {
+		after = new(collections.Range[collections.Pair[string, string]]).StartExclusive(cursor)
+	}
+	if err := k.candidates.Walk(ctx, after, collect); err != nil {
+		return nil, err
+	}
+	if !hasCursor || n <= len(ret) {
+		return ret, nil
+	}
+	before := new(collections.Range[collections.Pair[string, string]]).EndInclusive(cursor)
+	if err := k.candidates.Walk(ctx, before, collect); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
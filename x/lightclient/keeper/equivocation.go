@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"bytes"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	cmttypes "github.com/cometbft/cometbft/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/lightclient/types"
+)
+
+// HandleSequencerEquivocation punishes the sequencer which signed both headers, if they are for the same
+// rollapp height but are different. The submitter is rewarded.
+func (k Keeper) HandleSequencerEquivocation(ctx sdk.Context, submitter sdk.AccAddress, header1, header2 *codectypes.Any) error {
+	h1, err := unpackSignedHeader(header1)
+	if err != nil {
+		return errorsmod.Wrap(err, "header 1")
+	}
+	h2, err := unpackSignedHeader(header2)
+	if err != nil {
+		return errorsmod.Wrap(err, "header 2")
+	}
+
+	if h1.ChainID != h2.ChainID || h1.Height != h2.Height || h1.Version.App != h2.Version.App {
+		return gerrc.ErrInvalidArgument.Wrap("headers are not for the same chain, revision and height")
+	}
+	if bytes.Equal(h1.Hash(), h2.Hash()) {
+		return gerrc.ErrInvalidArgument.Wrap("headers are equal")
+	}
+	if !bytes.Equal(h1.ProposerAddress, h2.ProposerAddress) {
+		return gerrc.ErrInvalidArgument.Wrap("headers have different proposers")
+	}
+
+	seq, err := k.SeqK.SequencerByDymintAddr(ctx, h1.ProposerAddress)
+	if err != nil {
+		return errorsmod.Wrap(err, "sequencer by dymint addr")
+	}
+	if seq.RollappId != h1.ChainID {
+		return gerrc.ErrInvalidArgument.Wrapf("header chain id is not the sequencer rollapp: rollapp: %s", seq.RollappId)
+	}
+
+	h := uint64(h1.Height)
+	seq, err = k.SeqK.SequencerAtHeight(ctx, seq.Address, h)
+	if err != nil {
+		return errorsmod.Wrap(err, "sequencer at height")
+	}
+	valset, err := seq.Valset()
+	if err != nil {
+		return errorsmod.Wrap(err, "valset")
+	}
+	for _, sh := range []*cmttypes.SignedHeader{h1, h2} {
+		if err := sh.ValidateBasic(sh.ChainID); err != nil {
+			return errorsmod.Wrap(gerrc.ErrInvalidArgument, err.Error())
+		}
+		if err := valset.VerifyCommitLight(sh.ChainID, sh.Commit.BlockID, sh.Height, sh.Commit); err != nil {
+			return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "verify commit: %s", err)
+		}
+	}
+
+	key := collections.Join(seq.Address, h)
+	punished, err := k.equivocations.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+	if punished {
+		return gerrc.ErrAlreadyExists.Wrap("equivocation already punished")
+	}
+	if err := k.equivocations.Set(ctx, key); err != nil {
+		return err
+	}
+
+	if err := k.SeqK.PunishEquivocation(ctx, seq.Address, submitter); err != nil {
+		return errorsmod.Wrap(err, "punish equivocation")
+	}
+
+	return uevent.EmitTypedEvent(ctx, &types.EventSequencerEquivocation{
+		RollappId: seq.RollappId,
+		Sequencer: seq.Address,
+		Height:    h,
+		Submitter: submitter.String(),
+	})
+}
+
+func unpackSignedHeader(a *codectypes.Any) (*cmttypes.SignedHeader, error) {
+	clientMessage, err := ibcclienttypes.UnpackClientMessage(a)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "unpack client message")
+	}
+	header, ok := clientMessage.(*ibctm.Header)
+	if !ok || header.SignedHeader == nil {
+		return nil, gerrc.ErrInvalidArgument.Wrap("not a tendermint header")
+	}
+	sh, err := cmttypes.SignedHeaderFromProto(header.SignedHeader)
+	if err != nil {
+		return nil, errorsmod.Wrap(gerrc.ErrInvalidArgument, err.Error())
+	}
+	return sh, nil
+}
@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	cmted25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	comettypes "github.com/cometbft/cometbft/types"
+	"github.com/cometbft/cometbft/version"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/utest"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/dymensionxyz/dymension/v3/testutil/keeper"
+	sequencertypes "github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// signedHeader returns a header for the rollapp height, signed by pv
+func signedHeader(t *testing.T, pv comettypes.PrivValidator, valset *comettypes.ValidatorSet, chainID string, h int64, appHash string) *comettypes.SignedHeader {
+	header := comettypes.Header{
+		Version:            cmtversion.Consensus{Block: version.BlockProtocol},
+		ChainID:            chainID,
+		Height:             h,
+		Time:               time.Unix(1724392989, 0).UTC(),
+		ValidatorsHash:     valset.Hash(),
+		NextValidatorsHash: valset.Hash(),
+		AppHash:            []byte(appHash),
+		ProposerAddress:    valset.Proposer.Address,
+	}
+	partsHash := sha256.Sum256([]byte("parts"))
+	blockID := comettypes.BlockID{
+		Hash:          header.Hash(),
+		PartSetHeader: comettypes.PartSetHeader{Total: 1, Hash: partsHash[:]},
+	}
+	voteSet := comettypes.NewVoteSet(chainID, h, 0, cmtproto.PrecommitType, valset)
+	extCommit, err := comettypes.MakeExtCommit(blockID, h, 0, voteSet, []comettypes.PrivValidator{pv}, header.Time, false)
+	require.NoError(t, err)
+	return &comettypes.SignedHeader{Header: &header, Commit: extCommit.ToCommit()}
+}
+
+func packHeader(t *testing.T, sh *comettypes.SignedHeader) *codectypes.Any {
+	a, err := ibcclienttypes.PackClientMessage(&ibctm.Header{SignedHeader: sh.ToProto()})
+	require.NoError(t, err)
+	return a
+}
+
+func TestHandleSequencerEquivocation(t *testing.T) {
+	priv := cmted25519.GenPrivKey()
+	pv := comettypes.NewMockPVWithParams(priv, false, false)
+	seq := sequencertypes.NewTestSequencer(&ed25519.PubKey{Key: priv.PubKey().Bytes()})
+	seq.Status = sequencertypes.Bonded
+	seq.RollappId = keepertest.DefaultRollapp
+	valset := seq.MustValset()
+
+	submitter := sdk.AccAddress("submitter")
+	ra := keepertest.DefaultRollapp
+
+	t.Run("headers are not for the same height", func(t *testing.T) {
+		k, ctx := keepertest.LightClientKeeper(t)
+		seqK := keepertest.NewMockSequencerKeeper(map[string]*sequencertypes.Sequencer{seq.Address: &seq})
+		k.SeqK = seqK
+
+		err := k.HandleSequencerEquivocation(ctx, submitter, packHeader(t, signedHeader(t, pv, valset, ra, 5, "a")), packHeader(t, signedHeader(t, pv, valset, ra, 6, "b")))
+		utest.IsErr(require.New(t), err, gerrc.ErrInvalidArgument)
+		require.Empty(t, seqK.Punished)
+	})
+	t.Run("headers are equal", func(t *testing.T) {
+		k, ctx := keepertest.LightClientKeeper(t)
+		seqK := keepertest.NewMockSequencerKeeper(map[string]*sequencertypes.Sequencer{seq.Address: &seq})
+		k.SeqK = seqK
+
+		err := k.HandleSequencerEquivocation(ctx, submitter, packHeader(t, signedHeader(t, pv, valset, ra, 5, "a")), packHeader(t, signedHeader(t, pv, valset, ra, 5, "a")))
+		utest.IsErr(require.New(t), err, gerrc.ErrInvalidArgument)
+		require.Empty(t, seqK.Punished)
+	})
+	t.Run("header not signed by the sequencer", func(t *testing.T) {
+		k, ctx := keepertest.LightClientKeeper(t)
+		seqK := keepertest.NewMockSequencerKeeper(map[string]*sequencertypes.Sequencer{seq.Address: &seq})
+		k.SeqK = seqK
+
+		forged := signedHeader(t, pv, valset, ra, 5, "b")
+		forged.Commit.Signatures[0].Signature[0] ^= 1
+
+		err := k.HandleSequencerEquivocation(ctx, submitter, packHeader(t, signedHeader(t, pv, valset, ra, 5, "a")), packHeader(t, forged))
+		utest.IsErr(require.New(t), err, gerrc.ErrInvalidArgument)
+		require.Empty(t, seqK.Punished)
+	})
+	t.Run("chain id is not the sequencer rollapp", func(t *testing.T) {
+		k, ctx := keepertest.LightClientKeeper(t)
+		seqK := keepertest.NewMockSequencerKeeper(map[string]*sequencertypes.Sequencer{seq.Address: &seq})
+		k.SeqK = seqK
+
+		err := k.HandleSequencerEquivocation(ctx, submitter, packHeader(t, signedHeader(t, pv, valset, "other", 5, "a")), packHeader(t, signedHeader(t, pv, valset, "other", 5, "b")))
+		utest.IsErr(require.New(t), err, gerrc.ErrInvalidArgument)
+		require.Empty(t, seqK.Punished)
+	})
+	t.Run("equivocation is punished once", func(t *testing.T) {
+		k, ctx := keepertest.LightClientKeeper(t)
+		seqK := keepertest.NewMockSequencerKeeper(map[string]*sequencertypes.Sequencer{seq.Address: &seq})
+		k.SeqK = seqK
+
+		h1 := packHeader(t, signedHeader(t, pv, valset, ra, 5, "a"))
+		h2 := packHeader(t, signedHeader(t, pv, valset, ra, 5, "b"))
+		err := k.HandleSequencerEquivocation(ctx, submitter, h1, h2)
+		require.NoError(t, err)
+		require.Equal(t, []string{seq.Address}, seqK.Punished)
+
+		err = k.HandleSequencerEquivocation(ctx, submitter, h2, h1)
+		utest.IsErr(require.New(t), err, gerrc.ErrAlreadyExists)
+		require.Len(t, seqK.Punished, 1)
+	})
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"errors"
+
 	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -19,6 +21,26 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genesisState types.GenesisState) {
 			panic(err)
 		}
 	}
+	for _, p := range genesisState.ClientParams {
+		if err := k.SetClientParams(ctx, p); err != nil {
+			panic(err)
+		}
+	}
+	for _, e := range genesisState.Equivocations {
+		if err := k.equivocations.Set(ctx, collections.Join(e.SequencerAddress, e.Height)); err != nil {
+			panic(err)
+		}
+	}
+	for _, c := range genesisState.Candidates {
+		if err := k.candidates.Set(ctx, collections.Join(c.RollappId, c.ClientId), c.Attempts); err != nil {
+			panic(err)
+		}
+	}
+	if c := genesisState.CandidateCursor; c != nil {
+		if err := k.candidateCursor.Set(ctx, collections.Join(c.RollappId, c.ClientId)); err != nil {
+			panic(err)
+		}
+	}
 }
 
 func (k Keeper) ExportGenesis(ctx sdk.Context) types.GenesisState {
@@ -39,5 +61,40 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) types.GenesisState {
 		}); err != nil {
 		panic(err)
 	}
+
+	params, err := k.AllClientParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+	ret.ClientParams = params
+
+	if err := k.equivocations.Walk(ctx, nil,
+		func(key collections.Pair[string, uint64]) (stop bool, err error) {
+			ret.Equivocations = append(ret.Equivocations, types.EquivocationEntry{
+				SequencerAddress: key.K1(),
+				Height:           key.K2(),
+			})
+			return false, nil
+		}); err != nil {
+		panic(err)
+	}
+
+	iter, err := k.candidates.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	candidates, err := iter.KeyValues()
+	if err != nil {
+		panic(err)
+	}
+	for _, c := range candidates {
+		ret.Candidates = append(ret.Candidates, types.CandidateEntry{RollappId: c.Key.K1(), ClientId: c.Key.K2(), Attempts: c.Value})
+	}
+	cursor, err := k.candidateCursor.Get(ctx)
+	if err == nil {
+		ret.CandidateCursor = &types.CandidateEntry{RollappId: cursor.K1(), ClientId: cursor.K2()}
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
+	}
 	return ret
 }
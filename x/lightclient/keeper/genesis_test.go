@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -70,6 +71,34 @@ func TestImportExportGenesis(t *testing.T) {
 				Height:           43,
 			},
 		},
+		ClientParams: []types.ClientParams{
+			{
+				RollappId:       "rollapp-1",
+				UnbondingPeriod: time.Hour * 24,
+				MaxClockDrift:   time.Minute,
+			},
+		},
+		Equivocations: []types.EquivocationEntry{
+			{
+				SequencerAddress: "signer-1",
+				Height:           41,
+			},
+		},
+		Candidates: []types.CandidateEntry{
+			{
+				RollappId: "rollapp-3",
+				ClientId:  "client-3",
+			},
+			{
+				RollappId: "rollapp-3",
+				ClientId:  "client-4",
+				Attempts:  7,
+			},
+		},
+		CandidateCursor: &types.CandidateEntry{
+			RollappId: "rollapp-3",
+			ClientId:  "client-3",
+		},
 	}
 
 	k.InitGenesis(ctx, g)
@@ -17,6 +17,7 @@ var (
 	errIsMisbehaviour   = errorsmod.Wrap(gerrc.ErrFailedPrecondition, "misbehavior evidence is disabled for canonical clients")
 	errNoHeader         = errors.New("message does not contain header")
 	errProposerMismatch = errorsmod.Wrap(gerrc.ErrInvalidArgument, "validator set proposer not equal header proposer field")
+	errInactiveKey      = errorsmod.Wrap(gerrc.ErrInvalidArgument, "header signed with sequencer key not active at header height")
 )
 
 func (i IBCMessagesDecorator) HandleMsgUpdateClient(ctx sdk.Context, msg *ibcclienttypes.MsgUpdateClient) error {
@@ -74,6 +75,12 @@ func (i IBCMessagesDecorator) HandleMsgUpdateClient(ctx sdk.Context, msg *ibccli
 		return errorsmod.Wrapf(gerrc.ErrFailedPrecondition, "client update revision mismatch (expected: %d , actual: %d)", rollapp.LatestRevision().Number, header.Header.Version.App)
 	}
 
+	if !canonical {
+		if err := i.k.AddCandidate(ctx, rollapp.RollappId, msg.ClientId); err != nil {
+			return errorsmod.Wrap(err, "add canonical client candidate")
+		}
+	}
+
 	h := header.GetHeight().GetRevisionHeight()
 	sInfo, err := i.raK.FindStateInfoByHeight(ctx, rollapp.RollappId, h)
 	if errorsmod.IsOf(err, gerrc.ErrNotFound) {
@@ -103,7 +110,23 @@ func (i IBCMessagesDecorator) getSequencer(ctx sdk.Context, header *ibctm.Header
 	if !bytes.Equal(proposerBySignature, proposerByData) {
 		return sequencertypes.Sequencer{}, errProposerMismatch
 	}
-	return i.k.SeqK.SequencerByDymintAddr(ctx, proposerByData)
+	seq, err := i.k.SeqK.SequencerByDymintAddr(ctx, proposerByData)
+	if err != nil {
+		return sequencertypes.Sequencer{}, err
+	}
+	// the sequencer may have rotated its key, so check that the key was in use at the header height
+	seq, err = i.k.SeqK.SequencerAtHeight(ctx, seq.Address, header.GetHeight().GetRevisionHeight())
+	if err != nil {
+		return sequencertypes.Sequencer{}, errorsmod.Wrap(err, "sequencer at height")
+	}
+	activeAddr, err := seq.ProposerAddr()
+	if err != nil {
+		return sequencertypes.Sequencer{}, errorsmod.Wrap(err, "proposer addr")
+	}
+	if !bytes.Equal(activeAddr, proposerByData) {
+		return sequencertypes.Sequencer{}, errInactiveKey
+	}
+	return seq, nil
 }
 
 func getHeader(msg *ibcclienttypes.MsgUpdateClient) (*ibctm.Header, error) {
@@ -8,6 +8,7 @@ import (
 	cometprototypes "github.com/cometbft/cometbft/proto/tendermint/types"
 	comettypes "github.com/cometbft/cometbft/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibcsolomachine "github.com/cosmos/ibc-go/v8/modules/light-clients/06-solomachine"
@@ -15,6 +16,7 @@ import (
 	keepertest "github.com/dymensionxyz/dymension/v3/testutil/keeper"
 	"github.com/dymensionxyz/dymension/v3/x/lightclient/keeper"
 	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+	sequencertypes "github.com/dymensionxyz/dymension/v3/x/sequencer/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -135,3 +137,64 @@ func TestHandleMsgUpdateClientGood(t *testing.T) {
 }
 
 // TODO: bring back the rest of the old tests https://github.com/dymensionxyz/dymension/issues/1364
+
+func TestHandleMsgUpdateClientAcrossKeyRotation(t *testing.T) {
+	k, ctx := keepertest.LightClientKeeper(t)
+
+	seq := sequencertypes.NewTestSequencer(ed25519.GenPrivKey().PubKey())
+	seq.Status = sequencertypes.Bonded
+	seq.RollappId = keepertest.DefaultRollapp
+	oldKey := seq
+	newKey := sequencertypes.NewTestSequencer(ed25519.GenPrivKey().PubKey())
+
+	// the sequencer signs with the new key from height 3 on
+	seqK := keepertest.NewMockSequencerKeeper(map[string]*sequencertypes.Sequencer{seq.Address: &seq})
+	seqK.RotateKey(seq.Address, newKey.DymintPubKey, 3)
+	k.SeqK = seqK
+
+	rollapps := map[string]rollapptypes.Rollapp{
+		keepertest.DefaultRollapp: {RollappId: keepertest.DefaultRollapp},
+	}
+	stateInfos := map[string]map[uint64]rollapptypes.StateInfo{keepertest.DefaultRollapp: {}}
+	ibcclientKeeper := NewMockIBCClientKeeper(map[string]exported.ClientState{
+		keepertest.CanonClientID: &ibctm.ClientState{ChainId: keepertest.DefaultRollapp},
+	})
+	ibcMsgDecorator := keeper.NewIBCMessagesDecorator(*k, ibcclientKeeper, NewMockIBCChannelKeeper(nil), NewMockRollappKeeper(rollapps, stateInfos))
+
+	update := func(signer sequencertypes.Sequencer, h int64) error {
+		header := ibctm.Header{
+			SignedHeader: &cmtproto.SignedHeader{
+				Header: &cmtproto.Header{
+					AppHash:            []byte("appHash"),
+					ProposerAddress:    signer.MustProposerAddr(),
+					Time:               time.Unix(1724392989, 0),
+					ValidatorsHash:     signer.MustValsetHash(),
+					NextValidatorsHash: signer.MustValsetHash(),
+					Height:             h,
+				},
+				Commit: &cmtproto.Commit{},
+			},
+			ValidatorSet:  ConvertValidatorSet(signer.MustValset()),
+			TrustedHeight: ibcclienttypes.MustParseHeight("1-1"),
+		}
+		clientMsg, err := ibcclienttypes.PackClientMessage(&header)
+		require.NoError(t, err)
+		return ibcMsgDecorator.HandleMsgUpdateClient(ctx, &ibcclienttypes.MsgUpdateClient{
+			ClientId:      keepertest.CanonClientID,
+			ClientMessage: clientMsg,
+			Signer:        "relayerAddr",
+		})
+	}
+
+	// both keys map to the sequencer, each at the heights where it is active
+	require.NoError(t, update(oldKey, 2))
+	require.NoError(t, update(newKey, 3))
+	for _, h := range []uint64{2, 3} {
+		signer, err := k.GetSigner(ctx, keepertest.CanonClientID, h)
+		require.NoError(t, err)
+		require.Equal(t, seq.Address, signer)
+	}
+
+	require.Error(t, update(oldKey, 4))
+	require.Error(t, update(newKey, 1))
+}
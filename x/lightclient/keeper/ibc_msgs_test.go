@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
@@ -11,6 +12,7 @@ import (
 	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	ibcconnectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 )
 
 type MockRollappKeeper struct {
@@ -31,6 +33,16 @@ func (m *MockRollappKeeper) GetLatestHeight(ctx sdk.Context, rollappId string) (
 	panic("implement me")
 }
 
+// GetLatestFinalizedStateInfo implements types.RollappKeeperExpected.
+func (m *MockRollappKeeper) GetLatestFinalizedStateInfo(ctx sdk.Context, rollappId string) (rollapptypes.StateInfo, bool) {
+	panic("unimplemented")
+}
+
+// DisputePeriodInBlocks implements types.RollappKeeperExpected.
+func (m *MockRollappKeeper) DisputePeriodInBlocks(ctx sdk.Context) uint64 {
+	panic("unimplemented")
+}
+
 // GetLatestStateInfo implements types.RollappKeeperExpected.
 func (m *MockRollappKeeper) GetLatestStateInfo(ctx sdk.Context, rollappId string) (rollapptypes.StateInfo, bool) {
 	return rollapptypes.StateInfo{}, false
@@ -59,7 +71,8 @@ func (m *MockRollappKeeper) FindStateInfoByHeight(ctx sdk.Context, rollappId str
 	}
 	stateInfo, found := stateInfos[height]
 	if !found {
-		return nil, rollapptypes.ErrNotFound
+		// as the real keeper
+		return nil, errorsmod.Wrapf(gerrc.ErrNotFound, "state info for height: %d", height)
 	}
 	return &stateInfo, nil
 }
@@ -106,6 +119,10 @@ func (m *MockIBCClientKeeper) GetClientState(ctx sdk.Context, clientID string) (
 	return val, found
 }
 
+func (m *MockIBCClientKeeper) GetClientStatus(ctx sdk.Context, clientState exported.ClientState, clientID string) exported.Status {
+	return exported.Active
+}
+
 func (m *MockIBCClientKeeper) IterateClientStates(ctx sdk.Context, prefix []byte, cb func(clientID string, cs exported.ClientState) bool) {
 }
 
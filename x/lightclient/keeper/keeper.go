@@ -6,11 +6,13 @@ import (
 	"fmt"
 
 	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
@@ -41,11 +43,20 @@ type Keeper struct {
 	ibcChannelK     types.IBCChannelKeeperExpected
 	SeqK            types.SequencerKeeperExpected
 	rollappKeeper   types.RollappKeeperExpected
+	authority       string // authority is the x/gov module account
 
 	// <sequencer addr,client ID, height>
 	headerSigners collections.KeySet[collections.Triple[string, string, uint64]]
 	// <client ID, height> -> <sequencer addr>
 	clientHeightToSigner collections.Map[collections.Pair[string, uint64], string]
+	// <sequencer addr, height> of punished equivocations
+	equivocations collections.KeySet[collections.Pair[string, uint64]]
+	// <rollapp ID, client ID> of clients which may become canonical -> attempts
+	candidates collections.Map[collections.Pair[string, string], uint64]
+	// last candidate tried by the end blocker
+	candidateCursor collections.Item[collections.Pair[string, string]]
+	// <rollapp ID> -> expected client params, if not the defaults
+	clientParams collections.Map[string, types.ClientParams]
 }
 
 func (k Keeper) Enabled() bool {
@@ -64,7 +75,11 @@ func NewKeeper(
 	ibcChannelK types.IBCChannelKeeperExpected,
 	sequencerKeeper types.SequencerKeeperExpected,
 	rollappKeeper types.RollappKeeperExpected,
+	authority string,
 ) *Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Errorf("invalid x/lightclient authority address: %w", err))
+	}
 	service := collcompat.NewKVStoreService(storeKey)
 	sb := collections.NewSchemaBuilder(service)
 	k := &Keeper{
@@ -76,6 +91,7 @@ func NewKeeper(
 		ibcChannelK:     ibcChannelK,
 		SeqK:            sequencerKeeper,
 		rollappKeeper:   rollappKeeper,
+		authority:       authority,
 		headerSigners: collections.NewKeySet(
 			sb,
 			types.HeaderSignersPrefixKey,
@@ -89,6 +105,32 @@ func NewKeeper(
 			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
 			collections.StringValue,
 		),
+		equivocations: collections.NewKeySet(
+			sb,
+			types.EquivocationsPrefixKey,
+			"equivocations",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+		),
+		candidates: collections.NewMap(
+			sb,
+			types.CandidatesPrefixKey,
+			"candidates",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			collections.Uint64Value,
+		),
+		candidateCursor: collections.NewItem(
+			sb,
+			types.CandidateCursorKey,
+			"candidate_cursor",
+			collcodec.KeyToValueCodec(collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		),
+		clientParams: collections.NewMap(
+			sb,
+			types.ClientParamsPrefixKey,
+			"client_params",
+			collections.StringKey,
+			collcompat.ProtoValue[types.ClientParams](cdc),
+		),
 	}
 	return k
 }
@@ -154,8 +196,12 @@ func (k Keeper) LightClient(goCtx context.Context, req *types.QueryGetLightClien
 	return &types.QueryGetLightClientResponse{ClientId: id}, nil
 }
 
-func (k Keeper) ExpectedClientState(context.Context, *types.QueryExpectedClientStateRequest) (*types.QueryExpectedClientStateResponse, error) {
-	c := k.expectedClient()
+func (k Keeper) ExpectedClientState(goCtx context.Context, req *types.QueryExpectedClientStateRequest) (*types.QueryExpectedClientStateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	c, err := k.expectedClient(ctx, req.GetRollappId())
+	if err != nil {
+		return nil, err
+	}
 	anyClient, err := ibcclienttypes.PackClientState(&c)
 	if err != nil {
 		return nil, errorsmod.Wrap(errors.Join(gerrc.ErrInternal, err), "pack client state")
@@ -183,6 +229,70 @@ func (k Keeper) RollappCanonChannel(goCtx context.Context, req *types.QueryRolla
 	}, nil
 }
 
+func (k Keeper) Signer(goCtx context.Context, req *types.QuerySignerRequest) (*types.QuerySignerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	seq, err := k.GetSigner(ctx, req.GetClientId(), req.GetHeight())
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, gerrc.ErrNotFound.Wrapf("signer: client: %s: height: %d", req.GetClientId(), req.GetHeight())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySignerResponse{Sequencer: seq}, nil
+}
+
+func (k Keeper) SignedHeights(goCtx context.Context, req *types.QuerySignedHeightsRequest) (*types.QuerySignedHeightsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	heights, pageRes, err := k.SignedHeightsInRange(ctx, req.GetSequencer(), req.GetClientId(), req.GetFromHeight(), req.GetToHeight(), req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySignedHeightsResponse{Heights: heights, Pagination: pageRes}, nil
+}
+
+// UnbondBlockers returns the heights which make CanUnbond fail for the sequencer
+func (k Keeper) UnbondBlockers(goCtx context.Context, req *types.QueryUnbondBlockersRequest) (*types.QueryUnbondBlockersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	seq, err := k.SeqK.RealSequencer(ctx, req.GetSequencer())
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sequencer")
+	}
+	client, ok := k.GetCanonicalClient(ctx, seq.RollappId)
+	if !ok {
+		return &types.QueryUnbondBlockersResponse{}, nil
+	}
+	heights, pageRes, err := k.SignedHeightsInRange(ctx, seq.Address, client, 0, 0, req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryUnbondBlockersResponse{ClientId: client, Heights: heights, Pagination: pageRes}, nil
+}
+
+// SignedHeightsInRange returns a page of the unverified heights of the client signed by the sequencer, within
+// [from, to]. If to is 0 there is no upper bound.
+func (k Keeper) SignedHeightsInRange(ctx sdk.Context, seqAddr, client string, from, to uint64, pageReq *query.PageRequest) ([]uint64, *query.PageResponse, error) {
+	heights, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		k.headerSigners,
+		pageReq,
+		func(key collections.Triple[string, string, uint64], _ collections.NoValue) (bool, error) {
+			h := key.K3()
+			return from <= h && (to == 0 || h <= to), nil
+		},
+		func(key collections.Triple[string, string, uint64], _ collections.NoValue) (uint64, error) {
+			return key.K3(), nil
+		},
+		func(o *query.CollectionsPaginateOptions[collections.Triple[string, string, uint64]]) {
+			prefix := collections.TripleSuperPrefix[string, string, uint64](seqAddr, client)
+			o.Prefix = &prefix
+		},
+	)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "paginate signers")
+	}
+	return heights, pageRes, nil
+}
+
 func (k Keeper) pruneSigners(ctx sdk.Context, client string, h uint64, isAbove bool) error {
 	var rng *collections.PairRange[string, uint64]
 	if isAbove {
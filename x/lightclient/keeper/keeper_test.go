@@ -2,12 +2,20 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 
 	"github.com/dymensionxyz/dymension/v3/app/apptesting"
 	keepertest "github.com/dymensionxyz/dymension/v3/testutil/keeper"
 	"github.com/dymensionxyz/dymension/v3/x/lightclient/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/lightclient/types"
 	sequencertypes "github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 	"github.com/dymensionxyz/sdk-utils/utils/utest"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -112,3 +120,79 @@ func (s *TestSuite) TestPruneBelow() {
 	err = s.k().CanUnbond(s.Ctx, seq)
 	s.Require().NoError(err)
 }
+
+func TestSignerQueries(t *testing.T) {
+	k, ctx := keepertest.LightClientKeeper(t)
+	seq := keepertest.Alice
+	client := keepertest.CanonClientID
+
+	res, err := k.UnbondBlockers(ctx, &types.QueryUnbondBlockersRequest{Sequencer: seq.Address})
+	require.NoError(t, err)
+	require.Empty(t, res.ClientId)
+
+	k.SetCanonicalClient(ctx, seq.RollappId, client)
+	for _, h := range []uint64{2, 4, 6, 8} {
+		require.NoError(t, k.SaveSigner(ctx, seq.Address, client, h))
+	}
+	require.NoError(t, k.SaveSigner(ctx, seq.Address, "other", 5))
+
+	signer, err := k.Signer(ctx, &types.QuerySignerRequest{ClientId: client, Height: 4})
+	require.NoError(t, err)
+	require.Equal(t, seq.Address, signer.Sequencer)
+	_, err = k.Signer(ctx, &types.QuerySignerRequest{ClientId: client, Height: 5})
+	utest.IsErr(require.New(t), err, gerrc.ErrNotFound)
+
+	heights, err := k.SignedHeights(ctx, &types.QuerySignedHeightsRequest{Sequencer: seq.Address, ClientId: client, FromHeight: 3, ToHeight: 6})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{4, 6}, heights.Heights)
+
+	res, err = k.UnbondBlockers(ctx, &types.QueryUnbondBlockersRequest{Sequencer: seq.Address})
+	require.NoError(t, err)
+	require.Equal(t, client, res.ClientId)
+	require.Equal(t, []uint64{2, 4, 6, 8}, res.Heights)
+
+	// paginated
+	res, err = k.UnbondBlockers(ctx, &types.QueryUnbondBlockersRequest{Sequencer: seq.Address, Pagination: &query.PageRequest{Limit: 3}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 4, 6}, res.Heights)
+	res, err = k.UnbondBlockers(ctx, &types.QueryUnbondBlockersRequest{Sequencer: seq.Address, Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{8}, res.Heights)
+	heights, err = k.SignedHeights(ctx, &types.QuerySignedHeightsRequest{
+		Sequencer: seq.Address, ClientId: client, FromHeight: 3, Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{4}, heights.Heights)
+	require.Equal(t, uint64(3), heights.Pagination.Total)
+}
+
+func TestExpectedClientState(t *testing.T) {
+	k, ctx := keepertest.LightClientKeeper(t)
+	expected := func(rollapp string) *ibctm.ClientState {
+		res, err := k.ExpectedClientState(ctx, &types.QueryExpectedClientStateRequest{RollappId: rollapp})
+		require.NoError(t, err)
+		cs, err := ibcclienttypes.UnpackClientState(res.ClientState)
+		require.NoError(t, err)
+		return cs.(*ibctm.ClientState)
+	}
+
+	def := types.DefaultExpectedCanonicalClientParams()
+	require.Equal(t, def.UnbondingPeriod, expected(keepertest.DefaultRollapp).UnbondingPeriod)
+
+	p := types.ClientParams{
+		RollappId:       keepertest.DefaultRollapp,
+		UnbondingPeriod: time.Hour * 24,
+		MaxClockDrift:   time.Minute,
+	}
+	require.NoError(t, k.SetClientParams(ctx, p))
+
+	got := expected(keepertest.DefaultRollapp)
+	require.Equal(t, p.UnbondingPeriod, got.UnbondingPeriod)
+	require.Equal(t, p.MaxClockDrift, got.MaxClockDrift)
+	require.Less(t, got.TrustingPeriod, got.UnbondingPeriod)
+	require.NoError(t, types.IsCanonicalClientParamsValid(got, got))
+	require.Error(t, types.IsCanonicalClientParamsValid(&def, got))
+
+	// other rollapps keep the defaults
+	require.Equal(t, def.UnbondingPeriod, expected("other").UnbondingPeriod)
+}
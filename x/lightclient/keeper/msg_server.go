@@ -3,7 +3,11 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
 	"github.com/dymensionxyz/dymension/v3/x/lightclient/types"
 )
 
@@ -27,3 +31,56 @@ func (m msgServer) SetCanonicalClient(goCtx context.Context, msg *types.MsgSetCa
 	}
 	return &types.MsgSetCanonicalClientResponse{}, nil
 }
+
+func (m msgServer) SubmitSequencerEquivocation(goCtx context.Context, msg *types.MsgSubmitSequencerEquivocation) (*types.MsgSubmitSequencerEquivocationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := m.Keeper.HandleSequencerEquivocation(ctx, sdk.MustAccAddressFromBech32(msg.Submitter), msg.Header_1, msg.Header_2)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgSubmitSequencerEquivocationResponse{}, nil
+}
+
+// RecoverCanonicalClient can be submitted by the rollapp owner or the gov module
+func (m msgServer) RecoverCanonicalClient(goCtx context.Context, msg *types.MsgRecoverCanonicalClient) (*types.MsgRecoverCanonicalClientResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ra, ok := m.rollappKeeper.GetRollapp(ctx, msg.RollappId)
+	if !ok {
+		return nil, gerrc.ErrNotFound.Wrap("rollapp")
+	}
+	if msg.Signer != m.authority && msg.Signer != ra.Owner {
+		return nil, gerrc.ErrPermissionDenied.Wrap("only the rollapp owner or the gov module can recover the canonical client")
+	}
+
+	if err := m.Keeper.RecoverCanonicalClient(ctx, msg.RollappId, msg.ClientId, msg.ChannelId); err != nil {
+		return nil, err
+	}
+	return &types.MsgRecoverCanonicalClientResponse{}, nil
+}
+
+// SetClientParams can be submitted by the rollapp owner or the gov module
+func (m msgServer) SetClientParams(goCtx context.Context, msg *types.MsgSetClientParams) (*types.MsgSetClientParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ra, ok := m.rollappKeeper.GetRollapp(ctx, msg.RollappId)
+	if !ok {
+		return nil, gerrc.ErrNotFound.Wrap("rollapp")
+	}
+	if msg.Signer != m.authority && msg.Signer != ra.Owner {
+		return nil, gerrc.ErrPermissionDenied.Wrap("only the rollapp owner or the gov module can set the client params")
+	}
+
+	p := msg.ClientParams()
+	if err := p.ValidateDisputePeriod(m.rollappKeeper.DisputePeriodInBlocks(ctx)); err != nil {
+		return nil, errorsmod.Wrap(gerrc.ErrInvalidArgument, err.Error())
+	}
+	if err := m.Keeper.SetClientParams(ctx, p); err != nil {
+		return nil, err
+	}
+	if err := uevent.EmitTypedEvent(ctx, &types.EventSetClientParams{Params: p}); err != nil {
+		return nil, err
+	}
+	return &types.MsgSetClientParamsResponse{}, nil
+}
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/lightclient/types"
+)
+
+// RecoverCanonicalClient replaces the canonical client of the rollapp, once it expired or got frozen, by a new client
+// which matches the finalized rollapp state.
+// The given transfer channel, which must be open over the new client, becomes the canonical channel of the rollapp.
+// Headers of the old client which are not verified yet are attributed to the new client, so the sequencers
+// which signed them still cannot unbond until they are verified.
+func (k *Keeper) RecoverCanonicalClient(ctx sdk.Context, rollappID, clientID, channelID string) error {
+	ra, ok := k.rollappKeeper.GetRollapp(ctx, rollappID)
+	if !ok {
+		return gerrc.ErrNotFound.Wrap("rollapp")
+	}
+
+	oldClientID, ok := k.GetCanonicalClient(ctx, rollappID)
+	if !ok {
+		return gerrc.ErrNotFound.Wrap("canonical client for rollapp")
+	}
+	if oldClientID == clientID {
+		return gerrc.ErrInvalidArgument.Wrap("client is already canonical")
+	}
+	oldClientState, ok := k.ibcClientKeeper.GetClientState(ctx, oldClientID)
+	if !ok {
+		return errorsmod.Wrap(gerrc.ErrInternal, "canonical client state")
+	}
+	status := k.ibcClientKeeper.GetClientStatus(ctx, oldClientState, oldClientID)
+	if status != exported.Expired && status != exported.Frozen {
+		return gerrc.ErrFailedPrecondition.Wrapf("canonical client is not expired or frozen: status: %s", status)
+	}
+
+	clientStateI, ok := k.ibcClientKeeper.GetClientState(ctx, clientID)
+	if !ok {
+		return gerrc.ErrNotFound.Wrap("client")
+	}
+	clientState, ok := clientStateI.(*ibctm.ClientState)
+	if !ok {
+		return gerrc.ErrInvalidArgument.Wrap("not tm client")
+	}
+	if clientState.ChainId != rollappID {
+		return gerrc.ErrInvalidArgument.Wrapf("client chain id is not the rollapp: chain id: %s", clientState.ChainId)
+	}
+	if status := k.ibcClientKeeper.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
+		return gerrc.ErrFailedPrecondition.Wrapf("client is not active: status: %s", status)
+	}
+
+	// all consensus states of the client must be backed by finalized state updates
+	finalized, ok := k.rollappKeeper.GetLatestFinalizedStateInfo(ctx, rollappID)
+	if !ok || finalized.GetLatestHeight() < clientState.GetLatestHeight().GetRevisionHeight() {
+		return gerrc.ErrFailedPrecondition.Wrap("client latest height not finalized")
+	}
+	// unlike a client set canonical for the first time, the new client already carries the canonical channel
+	if err := k.clientMatchesRollapp(ctx, clientID, clientState, rollappID); err != nil {
+		return errorsmod.Wrap(err, "recover canonical client")
+	}
+	if err := k.checkChannelOverClient(ctx, channelID, clientID); err != nil {
+		return errorsmod.Wrap(err, "canonical channel")
+	}
+
+	if err := k.migrateSigners(ctx, oldClientID, clientID); err != nil {
+		return errorsmod.Wrap(err, "migrate signers")
+	}
+	ctx.KVStore(k.storeKey).Delete(types.CanonicalClientKey(oldClientID))
+	k.SetCanonicalClient(ctx, rollappID, clientID)
+
+	ra.ChannelId = channelID
+	k.rollappKeeper.SetRollapp(ctx, ra)
+
+	return uevent.EmitTypedEvent(ctx, &types.EventRecoverCanonicalClient{
+		RollappId:   rollappID,
+		OldClientId: oldClientID,
+		ClientId:    clientID,
+		ChannelId:   channelID,
+	})
+}
+
+// checkChannelOverClient checks that the transfer channel is open on a connection of the client
+func (k Keeper) checkChannelOverClient(ctx sdk.Context, channelID, clientID string) error {
+	channel, ok := k.ibcChannelK.GetChannel(ctx, ibctransfertypes.PortID, channelID)
+	if !ok {
+		return gerrc.ErrNotFound.Wrapf("transfer channel: %s", channelID)
+	}
+	if channel.State != ibcchanneltypes.OPEN {
+		return gerrc.ErrFailedPrecondition.Wrapf("channel is not open: state: %s", channel.State)
+	}
+	_, connection, err := k.ibcChannelK.GetChannelConnection(ctx, ibctransfertypes.PortID, channelID)
+	if err != nil {
+		return errorsmod.Wrap(err, "get channel connection")
+	}
+	if connection.GetClientID() != clientID {
+		return gerrc.ErrInvalidArgument.Wrapf("channel is not over the client: channel client: %s", connection.GetClientID())
+	}
+	return nil
+}
+
+// migrateSigners moves the signer bookkeeping of all heights from one client to the other
+func (k Keeper) migrateSigners(ctx sdk.Context, from, to string) error {
+	rng := collections.NewPrefixedPairRange[string, uint64](from)
+	iter, err := k.clientHeightToSigner.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	signers, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+	for _, kv := range signers {
+		h := kv.Key.K2()
+		if err := k.RemoveSigner(ctx, kv.Value, from, h); err != nil {
+			return errorsmod.Wrap(err, "remove signer")
+		}
+		if err := k.SaveSigner(ctx, kv.Value, to, h); err != nil {
+			return errorsmod.Wrap(err, "save signer")
+		}
+	}
+	return nil
+}
@@ -89,7 +89,7 @@ func (k Keeper) UpdateClientFromStateInfo(ctx sdk.Context, clientID string, stat
 		)
 	}
 
-	proposer, _ := k.SeqK.RealSequencer(ctx, stateInfo.NextProposer)
+	proposer, _ := k.SeqK.SequencerAtHeight(ctx, stateInfo.NextProposer, height+1)
 	valHash, _ := proposer.ValsetHash()
 
 	// add consensus states based on the block descriptors
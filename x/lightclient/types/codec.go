@@ -10,11 +10,17 @@ import (
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSetCanonicalClient{}, "lightclient/SetCanonicalClient", nil)
+	cdc.RegisterConcrete(&MsgSubmitSequencerEquivocation{}, "lightclient/SubmitSequencerEquivocation", nil)
+	cdc.RegisterConcrete(&MsgRecoverCanonicalClient{}, "lightclient/RecoverCanonicalClient", nil)
+	cdc.RegisterConcrete(&MsgSetClientParams{}, "lightclient/SetClientParams", nil)
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSetCanonicalClient{},
+		&MsgSubmitSequencerEquivocation{},
+		&MsgRecoverCanonicalClient{},
+		&MsgSetClientParams{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 	registry.RegisterInterface(
@@ -15,6 +15,8 @@ import (
 type SequencerKeeperExpected interface {
 	SequencerByDymintAddr(ctx sdk.Context, addr cryptotypes.Address) (sequencertypes.Sequencer, error)
 	RealSequencer(ctx sdk.Context, addr string) (sequencertypes.Sequencer, error)
+	SequencerAtHeight(ctx sdk.Context, addr string, h uint64) (sequencertypes.Sequencer, error)
+	PunishEquivocation(ctx sdk.Context, seqAddr string, rewardee sdk.AccAddress) error
 }
 
 type RollappKeeperExpected interface {
@@ -22,6 +24,8 @@ type RollappKeeperExpected interface {
 	GetLatestHeight(ctx sdk.Context, rollappId string) (uint64, bool)
 	FindStateInfoByHeight(ctx sdk.Context, rollappId string, height uint64) (*rollapptypes.StateInfo, error)
 	GetLatestStateInfo(ctx sdk.Context, rollappId string) (rollapptypes.StateInfo, bool)
+	GetLatestFinalizedStateInfo(ctx sdk.Context, rollappId string) (rollapptypes.StateInfo, bool)
+	DisputePeriodInBlocks(ctx sdk.Context) uint64
 	SetRollapp(ctx sdk.Context, rollapp rollapptypes.Rollapp)
 	IsFirstHeightOfLatestFork(ctx sdk.Context, rollappId string, revision, height uint64) bool
 
@@ -32,6 +36,7 @@ type RollappKeeperExpected interface {
 type IBCClientKeeperExpected interface {
 	GetClientConsensusState(ctx sdk.Context, clientID string, height exported.Height) (exported.ConsensusState, bool)
 	GetClientState(ctx sdk.Context, clientID string) (exported.ClientState, bool)
+	GetClientStatus(ctx sdk.Context, clientState exported.ClientState, clientID string) exported.Status
 	ClientStore(ctx sdk.Context, clientID string) storetypes.KVStore
 	IterateConsensusStates(ctx sdk.Context, cb func(clientID string, cs ibcclienttypes.ConsensusStateWithHeight) bool)
 }
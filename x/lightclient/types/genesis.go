@@ -5,9 +5,20 @@ import "fmt"
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
 		CanonicalClients: []CanonicalClient{},
+		ClientParams:     []ClientParams{},
 	}
 }
 
+func (c CandidateEntry) Validate() error {
+	if c.RollappId == "" {
+		return fmt.Errorf("invalid rollapp id: %v", c)
+	}
+	if c.ClientId == "" {
+		return fmt.Errorf("invalid ibc client id: %v", c)
+	}
+	return nil
+}
+
 func (g GenesisState) Validate() error {
 	for _, client := range g.CanonicalClients {
 		if client.RollappId == "" {
@@ -17,6 +28,31 @@ func (g GenesisState) Validate() error {
 			return fmt.Errorf("invalid ibc client id: %v", client)
 		}
 	}
+	seen := make(map[string]struct{})
+	for _, p := range g.ClientParams {
+		if err := p.ValidateBasic(); err != nil {
+			return fmt.Errorf("client params: %w", err)
+		}
+		if _, ok := seen[p.RollappId]; ok {
+			return fmt.Errorf("duplicate client params: rollapp: %s", p.RollappId)
+		}
+		seen[p.RollappId] = struct{}{}
+	}
+	for _, e := range g.Equivocations {
+		if e.SequencerAddress == "" {
+			return fmt.Errorf("invalid equivocation sequencer: %v", e)
+		}
+	}
+	for _, c := range g.Candidates {
+		if err := c.Validate(); err != nil {
+			return fmt.Errorf("candidate: %w", err)
+		}
+	}
+	if g.CandidateCursor != nil {
+		if err := g.CandidateCursor.Validate(); err != nil {
+			return fmt.Errorf("candidate cursor: %w", err)
+		}
+	}
 
 	return nil
 }
@@ -85,16 +85,140 @@ func (m *HeaderSignerEntry) GetHeight() uint64 {
 	return 0
 }
 
+// Used for genesis import/export only
+type EquivocationEntry struct {
+	// acc addr of the punished sequencer
+	SequencerAddress string `protobuf:"bytes,1,opt,name=sequencer_address,json=sequencerAddress,proto3" json:"sequencer_address,omitempty"`
+	// rollapp height of the conflicting headers
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *EquivocationEntry) Reset()         { *m = EquivocationEntry{} }
+func (m *EquivocationEntry) String() string { return proto.CompactTextString(m) }
+func (*EquivocationEntry) ProtoMessage()    {}
+func (*EquivocationEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5520440548912168, []int{1}
+}
+func (m *EquivocationEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EquivocationEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EquivocationEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EquivocationEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EquivocationEntry.Merge(m, src)
+}
+func (m *EquivocationEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *EquivocationEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_EquivocationEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EquivocationEntry proto.InternalMessageInfo
+
+func (m *EquivocationEntry) GetSequencerAddress() string {
+	if m != nil {
+		return m.SequencerAddress
+	}
+	return ""
+}
+
+func (m *EquivocationEntry) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// Used for genesis import/export only
+type CandidateEntry struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	ClientId  string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// number of times the candidate was tried without matching a state update
+	Attempts uint64 `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
+}
+
+func (m *CandidateEntry) Reset()         { *m = CandidateEntry{} }
+func (m *CandidateEntry) String() string { return proto.CompactTextString(m) }
+func (*CandidateEntry) ProtoMessage()    {}
+func (*CandidateEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5520440548912168, []int{2}
+}
+func (m *CandidateEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CandidateEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CandidateEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CandidateEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandidateEntry.Merge(m, src)
+}
+func (m *CandidateEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *CandidateEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandidateEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CandidateEntry proto.InternalMessageInfo
+
+func (m *CandidateEntry) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *CandidateEntry) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *CandidateEntry) GetAttempts() uint64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
 type GenesisState struct {
 	CanonicalClients []CanonicalClient   `protobuf:"bytes,1,rep,name=canonical_clients,json=canonicalClients,proto3" json:"canonical_clients"`
 	HeaderSigners    []HeaderSignerEntry `protobuf:"bytes,3,rep,name=header_signers,json=headerSigners,proto3" json:"header_signers"`
+	ClientParams     []ClientParams      `protobuf:"bytes,4,rep,name=client_params,json=clientParams,proto3" json:"client_params"`
+	// equivocations which were already punished
+	Equivocations []EquivocationEntry `protobuf:"bytes,5,rep,name=equivocations,proto3" json:"equivocations"`
+	// clients which may become the canonical client of their rollapp
+	Candidates []CandidateEntry `protobuf:"bytes,6,rep,name=candidates,proto3" json:"candidates"`
+	// last candidate tried by the end blocker, if any
+	CandidateCursor *CandidateEntry `protobuf:"bytes,7,opt,name=candidate_cursor,json=candidateCursor,proto3" json:"candidate_cursor,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5520440548912168, []int{1}
+	return fileDescriptor_5520440548912168, []int{3}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -137,6 +261,34 @@ func (m *GenesisState) GetHeaderSigners() []HeaderSignerEntry {
 	return nil
 }
 
+func (m *GenesisState) GetClientParams() []ClientParams {
+	if m != nil {
+		return m.ClientParams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEquivocations() []EquivocationEntry {
+	if m != nil {
+		return m.Equivocations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCandidates() []CandidateEntry {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCandidateCursor() *CandidateEntry {
+	if m != nil {
+		return m.CandidateCursor
+	}
+	return nil
+}
+
 type CanonicalClient struct {
 	RollappId   string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
 	IbcClientId string `protobuf:"bytes,2,opt,name=ibc_client_id,json=ibcClientId,proto3" json:"ibc_client_id,omitempty"`
@@ -146,7 +298,7 @@ func (m *CanonicalClient) Reset()         { *m = CanonicalClient{} }
 func (m *CanonicalClient) String() string { return proto.CompactTextString(m) }
 func (*CanonicalClient) ProtoMessage()    {}
 func (*CanonicalClient) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5520440548912168, []int{2}
+	return fileDescriptor_5520440548912168, []int{4}
 }
 func (m *CanonicalClient) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -191,6 +343,8 @@ func (m *CanonicalClient) GetIbcClientId() string {
 
 func init() {
 	proto.RegisterType((*HeaderSignerEntry)(nil), "dymensionxyz.dymension.lightclient.HeaderSignerEntry")
+	proto.RegisterType((*EquivocationEntry)(nil), "dymensionxyz.dymension.lightclient.EquivocationEntry")
+	proto.RegisterType((*CandidateEntry)(nil), "dymensionxyz.dymension.lightclient.CandidateEntry")
 	proto.RegisterType((*GenesisState)(nil), "dymensionxyz.dymension.lightclient.GenesisState")
 	proto.RegisterType((*CanonicalClient)(nil), "dymensionxyz.dymension.lightclient.CanonicalClient")
 }
@@ -200,30 +354,39 @@ func init() {
 }
 
 var fileDescriptor_5520440548912168 = []byte{
-	// 363 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x4f, 0x4b, 0xfb, 0x40,
-	0x14, 0x4c, 0x7e, 0x2d, 0xe5, 0xd7, 0xad, 0xd5, 0x76, 0x11, 0x09, 0x8a, 0xb1, 0xe4, 0x54, 0x10,
-	0x12, 0xb1, 0x08, 0x5e, 0x6d, 0x11, 0xed, 0x35, 0xf5, 0xe4, 0x25, 0x24, 0x9b, 0x67, 0xb2, 0x90,
-	0xee, 0xc6, 0xec, 0x56, 0x1a, 0x3f, 0x85, 0x1f, 0xab, 0xc7, 0x1e, 0xc5, 0x83, 0x48, 0xfb, 0x45,
-	0x24, 0x7f, 0x2c, 0x6d, 0x45, 0xf4, 0xb6, 0x33, 0xfb, 0x86, 0x79, 0x33, 0x3c, 0x74, 0xe6, 0xa7,
-	0x63, 0x60, 0x82, 0x72, 0x36, 0x4d, 0x9f, 0xad, 0x15, 0xb0, 0x22, 0x1a, 0x84, 0x92, 0x44, 0x14,
-	0x98, 0xb4, 0x02, 0x60, 0x20, 0xa8, 0x30, 0xe3, 0x84, 0x4b, 0x8e, 0x8d, 0x75, 0x85, 0xb9, 0x02,
-	0xe6, 0x9a, 0xe2, 0x70, 0x3f, 0xe0, 0x01, 0xcf, 0xc7, 0xad, 0xec, 0x55, 0x28, 0x8d, 0x09, 0x6a,
-	0xdf, 0x82, 0xeb, 0x43, 0x32, 0xa2, 0x01, 0x83, 0xe4, 0x9a, 0xc9, 0x24, 0xc5, 0xa7, 0xa8, 0x2d,
-	0xe0, 0x71, 0x02, 0x8c, 0x40, 0xe2, 0xb8, 0xbe, 0x9f, 0x80, 0x10, 0x9a, 0xda, 0x51, 0xbb, 0x75,
-	0xbb, 0xb5, 0xfa, 0xb8, 0x2a, 0x78, 0x7c, 0x84, 0xea, 0x85, 0x83, 0x43, 0x7d, 0xed, 0x5f, 0x3e,
-	0xf4, 0xbf, 0x20, 0x86, 0x3e, 0x3e, 0x40, 0xb5, 0x10, 0xb2, 0x25, 0xb4, 0x4a, 0x47, 0xed, 0x56,
-	0xed, 0x12, 0x19, 0x6f, 0x2a, 0xda, 0xb9, 0x29, 0x22, 0x8c, 0xa4, 0x2b, 0x01, 0x3f, 0xa0, 0x36,
-	0x71, 0x19, 0x67, 0x94, 0xb8, 0x91, 0x53, 0xc8, 0x33, 0xcb, 0x4a, 0xb7, 0x71, 0xde, 0x33, 0x7f,
-	0x4f, 0x67, 0x0e, 0xbe, 0xc4, 0x83, 0x1c, 0xf7, 0xab, 0xb3, 0xf7, 0x13, 0xc5, 0x6e, 0x91, 0x4d,
-	0x5a, 0x60, 0x0f, 0xed, 0x86, 0x79, 0x5e, 0x47, 0xe4, 0x81, 0x85, 0x56, 0xc9, 0x4d, 0x2e, 0xfe,
-	0x62, 0xf2, 0xad, 0xa9, 0xd2, 0xa6, 0x19, 0xae, 0x7d, 0x08, 0xe3, 0x0e, 0xed, 0x6d, 0xad, 0x83,
-	0x8f, 0x11, 0x4a, 0x78, 0x14, 0xb9, 0x71, 0x9c, 0xb5, 0x54, 0x54, 0x59, 0x2f, 0x99, 0xa1, 0x8f,
-	0x0d, 0xd4, 0xa4, 0x1e, 0x71, 0xb6, 0x7b, 0x6c, 0x50, 0x8f, 0x0c, 0xca, 0x2a, 0xfb, 0xf6, 0x6c,
-	0xa1, 0xab, 0xf3, 0x85, 0xae, 0x7e, 0x2c, 0x74, 0xf5, 0x65, 0xa9, 0x2b, 0xf3, 0xa5, 0xae, 0xbc,
-	0x2e, 0x75, 0xe5, 0xfe, 0x32, 0xa0, 0x32, 0x9c, 0x78, 0x26, 0xe1, 0x63, 0xeb, 0x87, 0xd3, 0x79,
-	0xea, 0x59, 0xd3, 0x8d, 0xfb, 0x91, 0x69, 0x0c, 0xc2, 0xab, 0xe5, 0x47, 0xd0, 0xfb, 0x0c, 0x00,
-	0x00, 0xff, 0xff, 0xb7, 0x25, 0x65, 0x00, 0x72, 0x02, 0x00, 0x00,
+	// 508 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x41, 0x6b, 0xdb, 0x30,
+	0x14, 0xc7, 0xe3, 0x36, 0xcb, 0x9a, 0xd7, 0xa6, 0x4d, 0xc4, 0x18, 0x26, 0x63, 0x5e, 0xf0, 0x29,
+	0x30, 0xb0, 0x4b, 0xc3, 0x60, 0xd7, 0x35, 0x94, 0xad, 0xb7, 0x91, 0xee, 0x50, 0x36, 0x86, 0x51,
+	0x64, 0xcd, 0x16, 0x24, 0x92, 0x2b, 0xc9, 0xa5, 0xd9, 0xa7, 0xd8, 0xc7, 0xea, 0xb1, 0xb0, 0xcb,
+	0x4e, 0x63, 0x24, 0x5f, 0x64, 0x58, 0x76, 0x3c, 0xa7, 0x65, 0xad, 0xe9, 0x29, 0x79, 0xcf, 0x7e,
+	0xbf, 0xff, 0x93, 0xff, 0x7f, 0x04, 0x87, 0xe1, 0x62, 0x4e, 0xb9, 0x62, 0x82, 0x5f, 0x2d, 0xbe,
+	0xfb, 0x65, 0xe1, 0xcf, 0x58, 0x14, 0x6b, 0x32, 0x63, 0x94, 0x6b, 0x3f, 0xa2, 0x9c, 0x2a, 0xa6,
+	0xbc, 0x44, 0x0a, 0x2d, 0x90, 0x5b, 0x9d, 0xf0, 0xca, 0xc2, 0xab, 0x4c, 0xf4, 0x9f, 0x45, 0x22,
+	0x12, 0xe6, 0x75, 0x3f, 0xfb, 0x97, 0x4f, 0xf6, 0xfd, 0x1a, 0x5a, 0x09, 0x96, 0x78, 0x5e, 0x48,
+	0xb9, 0x29, 0xf4, 0x3e, 0x50, 0x1c, 0x52, 0x79, 0xc6, 0x22, 0x4e, 0xe5, 0x09, 0xd7, 0x72, 0x81,
+	0x5e, 0x43, 0x4f, 0xd1, 0x8b, 0x94, 0x72, 0x42, 0x65, 0x80, 0xc3, 0x50, 0x52, 0xa5, 0x6c, 0x6b,
+	0x60, 0x0d, 0xdb, 0x93, 0x6e, 0xf9, 0xe0, 0x5d, 0xde, 0x47, 0x2f, 0xa0, 0x9d, 0x83, 0x03, 0x16,
+	0xda, 0x5b, 0xe6, 0xa5, 0x9d, 0xbc, 0x71, 0x1a, 0xa2, 0xe7, 0xd0, 0x8a, 0x69, 0xa6, 0x6d, 0x6f,
+	0x0f, 0xac, 0x61, 0x73, 0x52, 0x54, 0xee, 0x39, 0xf4, 0x4e, 0x2e, 0x52, 0x76, 0x29, 0x08, 0xd6,
+	0x4c, 0xf0, 0x47, 0xc8, 0xfe, 0x23, 0x6f, 0x6d, 0x90, 0x63, 0xd8, 0x1f, 0x63, 0x1e, 0xb2, 0x10,
+	0x6b, 0x9a, 0x63, 0x5f, 0x02, 0x48, 0x31, 0x9b, 0xe1, 0x24, 0xc9, 0x36, 0xcc, 0x79, 0xed, 0xa2,
+	0x73, 0x1a, 0xde, 0xbf, 0x7f, 0x1f, 0x76, 0xb0, 0xd6, 0x74, 0x9e, 0x68, 0x55, 0x9c, 0xa0, 0xac,
+	0xdd, 0x9f, 0x4d, 0xd8, 0x7b, 0x9f, 0xfb, 0x76, 0xa6, 0xb1, 0xa6, 0xe8, 0x1b, 0xf4, 0x08, 0xe6,
+	0x82, 0x33, 0x82, 0x67, 0x41, 0x8e, 0xc8, 0xf6, 0xdf, 0x1e, 0xee, 0x1e, 0x8d, 0xbc, 0x87, 0x2d,
+	0xf5, 0xc6, 0xeb, 0xe1, 0xb1, 0xa9, 0x8f, 0x9b, 0xd7, 0xbf, 0x5f, 0x35, 0x26, 0x5d, 0xb2, 0xd9,
+	0x56, 0x68, 0x0a, 0xfb, 0xb1, 0xf1, 0x2c, 0x50, 0xc6, 0xb4, 0x6c, 0xb5, 0x4c, 0xe4, 0x4d, 0x1d,
+	0x91, 0x3b, 0x6e, 0x17, 0x32, 0x9d, 0xb8, 0xf2, 0x40, 0xa1, 0x2f, 0xd0, 0x29, 0xbe, 0x4a, 0x1e,
+	0x17, 0xbb, 0x69, 0x24, 0x0e, 0x6b, 0x9d, 0xc3, 0xfc, 0x7c, 0x34, 0x73, 0x05, 0x7d, 0x8f, 0x54,
+	0x7a, 0x08, 0x43, 0x87, 0x56, 0xdc, 0x57, 0xf6, 0x93, 0xfa, 0xfb, 0xdf, 0x89, 0xcd, 0x7a, 0xff,
+	0x0d, 0x22, 0x3a, 0x07, 0x20, 0xeb, 0x18, 0x28, 0xbb, 0x65, 0xf8, 0x47, 0x35, 0x4d, 0xa8, 0x84,
+	0xa7, 0x80, 0x57, 0x58, 0xe8, 0x2b, 0x74, 0xcb, 0x2a, 0x20, 0xa9, 0x54, 0x42, 0xda, 0x4f, 0x07,
+	0xd6, 0xe3, 0xf8, 0x93, 0x83, 0x92, 0x35, 0x36, 0x28, 0xf7, 0x13, 0x1c, 0xdc, 0xca, 0xc1, 0x43,
+	0x01, 0x76, 0xa1, 0xc3, 0xa6, 0x24, 0xb8, 0x1d, 0xe2, 0x5d, 0x36, 0x25, 0xe3, 0x22, 0xc7, 0xc7,
+	0x93, 0xeb, 0xa5, 0x63, 0xdd, 0x2c, 0x1d, 0xeb, 0xcf, 0xd2, 0xb1, 0x7e, 0xac, 0x9c, 0xc6, 0xcd,
+	0xca, 0x69, 0xfc, 0x5a, 0x39, 0x8d, 0xcf, 0x6f, 0x23, 0xa6, 0xe3, 0x74, 0xea, 0x11, 0x31, 0xff,
+	0xdf, 0xe5, 0x71, 0x39, 0xf2, 0xaf, 0x36, 0x6e, 0x10, 0xbd, 0x48, 0xa8, 0x9a, 0xb6, 0xcc, 0x0d,
+	0x32, 0xfa, 0x3b, 0x00, 0xa8, 0x0a, 0x3a, 0x9c, 0xe0, 0x04, 0x00, 0x00,
 }
 
 func (m *HeaderSignerEntry) Marshal() (dAtA []byte, err error) {
@@ -268,6 +431,83 @@ func (m *HeaderSignerEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EquivocationEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EquivocationEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EquivocationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SequencerAddress) > 0 {
+		i -= len(m.SequencerAddress)
+		copy(dAtA[i:], m.SequencerAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.SequencerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CandidateEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CandidateEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CandidateEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attempts != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -288,6 +528,60 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CandidateCursor != nil {
+		{
+			size, err := m.CandidateCursor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Candidates) > 0 {
+		for iNdEx := len(m.Candidates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Candidates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Equivocations) > 0 {
+		for iNdEx := len(m.Equivocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Equivocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ClientParams) > 0 {
+		for iNdEx := len(m.ClientParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClientParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.HeaderSigners) > 0 {
 		for iNdEx := len(m.HeaderSigners) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -387,6 +681,42 @@ func (m *HeaderSignerEntry) Size() (n int) {
 	return n
 }
 
+func (m *EquivocationEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SequencerAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *CandidateEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovGenesis(uint64(m.Attempts))
+	}
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -405,6 +735,28 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ClientParams) > 0 {
+		for _, e := range m.ClientParams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Equivocations) > 0 {
+		for _, e := range m.Equivocations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Candidates) > 0 {
+		for _, e := range m.Candidates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.CandidateCursor != nil {
+		l = m.CandidateCursor.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -564,7 +916,7 @@ func (m *HeaderSignerEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *EquivocationEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -587,17 +939,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: EquivocationEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EquivocationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalClients", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SequencerAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -607,7 +959,241 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SequencerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CandidateEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CandidateEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CandidateEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalClients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -661,6 +1247,144 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientParams = append(m.ClientParams, ClientParams{})
+			if err := m.ClientParams[len(m.ClientParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Equivocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Equivocations = append(m.Equivocations, EquivocationEntry{})
+			if err := m.Equivocations[len(m.Equivocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candidates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Candidates = append(m.Candidates, CandidateEntry{})
+			if err := m.Candidates[len(m.Candidates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandidateCursor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CandidateCursor == nil {
+				m.CandidateCursor = &CandidateEntry{}
+			}
+			if err := m.CandidateCursor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
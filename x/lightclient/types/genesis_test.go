@@ -41,6 +41,22 @@ func TestGenesisValidate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			name: "invalid candidate",
+			g: types.GenesisState{
+				Candidates: []types.CandidateEntry{
+					{RollappId: "rollapp-1", ClientId: ""},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid candidate cursor",
+			g: types.GenesisState{
+				CandidateCursor: &types.CandidateEntry{RollappId: "", ClientId: "client-1"},
+			},
+			valid: false,
+		},
 
 		{
 			name:  "empty",
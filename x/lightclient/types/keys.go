@@ -16,6 +16,10 @@ var (
 	_                      = []byte{0x05}
 	HeaderSignersPrefixKey = collections.NewPrefix("headerSigners/")
 	ClientHeightToSigner   = collections.NewPrefix("clientHeightToSigner/")
+	EquivocationsPrefixKey = collections.NewPrefix("equivocations/")
+	CandidatesPrefixKey    = collections.NewPrefix("canonicalClientCandidates/")
+	CandidateCursorKey     = collections.NewPrefix("canonicalClientCandidateCursor/")
+	ClientParamsPrefixKey  = collections.NewPrefix("clientParams/")
 )
 
 func GetRollappClientKey(rollappId string) []byte {
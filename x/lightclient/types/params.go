@@ -24,6 +24,8 @@ func DefaultExpectedCanonicalClientParams() ibctm.ClientState {
 
 const (
 	trustPeriodMultiplier = 65
+	// ExpectedHubBlockTime is used to convert the dispute period, which is in hub blocks, to a duration
+	ExpectedHubBlockTime = 6 * time.Second
 )
 
 // expectedTrustPeriod calculates a sensible trust period based on unbonding period
@@ -65,6 +67,40 @@ func ExpectedCanonicalClientParams(rollappUnbondingPeriod time.Duration) ibctm.C
 	}
 }
 
+// ExpectedClient returns the client state expected from the canonical client of the rollapp
+func (p ClientParams) ExpectedClient() ibctm.ClientState {
+	c := ExpectedCanonicalClientParams(p.UnbondingPeriod)
+	c.MaxClockDrift = p.MaxClockDrift
+	return c
+}
+
+func (p ClientParams) ValidateBasic() error {
+	if p.RollappId == "" {
+		return errors.New("empty rollapp id")
+	}
+	if expectedTrustPeriod(p.UnbondingPeriod) <= 0 {
+		return fmt.Errorf("unbonding period too short: %s", p.UnbondingPeriod)
+	}
+	if p.MaxClockDrift <= 0 {
+		return fmt.Errorf("max clock drift must be positive: %s", p.MaxClockDrift)
+	}
+	if tp := expectedTrustPeriod(p.UnbondingPeriod); tp <= p.MaxClockDrift {
+		return fmt.Errorf("max clock drift must be shorter than the trusting period: drift: %s: trusting period: %s", p.MaxClockDrift, tp)
+	}
+	return nil
+}
+
+// ValidateDisputePeriod checks that the client stays trusted for the whole dispute period, so that its headers
+// can still be checked against the state updates once they are finalized.
+func (p ClientParams) ValidateDisputePeriod(disputePeriodInBlocks uint64) error {
+	tp := expectedTrustPeriod(p.UnbondingPeriod)
+	if uint64(tp/ExpectedHubBlockTime) < disputePeriodInBlocks {
+		return fmt.Errorf("trusting period shorter than the dispute period: trusting period: %s: dispute period: %d blocks of %s",
+			tp, disputePeriodInBlocks, ExpectedHubBlockTime)
+	}
+	return nil
+}
+
 // IsCanonicalClientParamsValid checks if the given IBC tendermint client state has the expected canonical client parameters
 func IsCanonicalClientParamsValid(got *ibctm.ClientState, expect *ibctm.ClientState) error {
 	if got.TrustLevel != expect.TrustLevel {
@@ -2,11 +2,14 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cometbft/cometbft/libs/math"
 	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	ics23 "github.com/cosmos/ics23/go"
+	"github.com/stretchr/testify/require"
+
 	"github.com/dymensionxyz/dymension/v3/x/lightclient/types"
 )
 
@@ -98,3 +101,17 @@ func TestIsCanonicalClientParamsValid(t *testing.T) {
 		})
 	}
 }
+
+func TestClientParamsValidate(t *testing.T) {
+	p := types.ClientParams{RollappId: "rollapp_1-1", UnbondingPeriod: time.Hour * 24, MaxClockDrift: time.Minute}
+	require.NoError(t, p.ValidateBasic())
+
+	// the trusting period is 65% of the unbonding period
+	tooMuchDrift := p
+	tooMuchDrift.MaxClockDrift = time.Hour * 16
+	require.Error(t, tooMuchDrift.ValidateBasic())
+
+	trustedBlocks := uint64(time.Hour * 24 / 100 * 65 / types.ExpectedHubBlockTime)
+	require.NoError(t, p.ValidateDisputePeriod(trustedBlocks))
+	require.Error(t, p.ValidateDisputePeriod(trustedBlocks+1))
+}
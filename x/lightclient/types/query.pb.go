@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -120,6 +121,8 @@ func (m *QueryGetLightClientResponse) GetClientId() string {
 }
 
 type QueryExpectedClientStateRequest struct {
+	// optional, the defaults are returned if empty
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
 }
 
 func (m *QueryExpectedClientStateRequest) Reset()         { *m = QueryExpectedClientStateRequest{} }
@@ -155,6 +158,13 @@ func (m *QueryExpectedClientStateRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryExpectedClientStateRequest proto.InternalMessageInfo
 
+func (m *QueryExpectedClientStateRequest) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
 type QueryExpectedClientStateResponse struct {
 	// client state
 	ClientState *types.Any `protobuf:"bytes,2,opt,name=client_state,json=clientState,proto3" json:"client_state,omitempty" yaml:"client_state"`
@@ -298,6 +308,348 @@ func (m *QueryRollappCanonChannelResponse) GetRollappChannelId() string {
 	return ""
 }
 
+type QuerySignerRequest struct {
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Height   uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QuerySignerRequest) Reset()         { *m = QuerySignerRequest{} }
+func (m *QuerySignerRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySignerRequest) ProtoMessage()    {}
+func (*QuerySignerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a51f5810cc34625d, []int{6}
+}
+func (m *QuerySignerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySignerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignerRequest.Merge(m, src)
+}
+func (m *QuerySignerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySignerRequest proto.InternalMessageInfo
+
+func (m *QuerySignerRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *QuerySignerRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type QuerySignerResponse struct {
+	// sequencer address
+	Sequencer string `protobuf:"bytes,1,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+}
+
+func (m *QuerySignerResponse) Reset()         { *m = QuerySignerResponse{} }
+func (m *QuerySignerResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySignerResponse) ProtoMessage()    {}
+func (*QuerySignerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a51f5810cc34625d, []int{7}
+}
+func (m *QuerySignerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySignerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignerResponse.Merge(m, src)
+}
+func (m *QuerySignerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySignerResponse proto.InternalMessageInfo
+
+func (m *QuerySignerResponse) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
+	}
+	return ""
+}
+
+type QuerySignedHeightsRequest struct {
+	// sequencer address
+	Sequencer string `protobuf:"bytes,1,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+	ClientId  string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// inclusive
+	FromHeight uint64 `protobuf:"varint,3,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// inclusive, 0 means no upper bound
+	ToHeight   uint64             `protobuf:"varint,4,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySignedHeightsRequest) Reset()         { *m = QuerySignedHeightsRequest{} }
+func (m *QuerySignedHeightsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySignedHeightsRequest) ProtoMessage()    {}
+func (*QuerySignedHeightsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a51f5810cc34625d, []int{8}
+}
+func (m *QuerySignedHeightsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignedHeightsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignedHeightsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySignedHeightsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignedHeightsRequest.Merge(m, src)
+}
+func (m *QuerySignedHeightsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignedHeightsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignedHeightsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySignedHeightsRequest proto.InternalMessageInfo
+
+func (m *QuerySignedHeightsRequest) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
+	}
+	return ""
+}
+
+func (m *QuerySignedHeightsRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *QuerySignedHeightsRequest) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QuerySignedHeightsRequest) GetToHeight() uint64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *QuerySignedHeightsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QuerySignedHeightsResponse struct {
+	Heights    []uint64            `protobuf:"varint,1,rep,packed,name=heights,proto3" json:"heights,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySignedHeightsResponse) Reset()         { *m = QuerySignedHeightsResponse{} }
+func (m *QuerySignedHeightsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySignedHeightsResponse) ProtoMessage()    {}
+func (*QuerySignedHeightsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a51f5810cc34625d, []int{9}
+}
+func (m *QuerySignedHeightsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignedHeightsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignedHeightsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySignedHeightsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignedHeightsResponse.Merge(m, src)
+}
+func (m *QuerySignedHeightsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignedHeightsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignedHeightsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySignedHeightsResponse proto.InternalMessageInfo
+
+func (m *QuerySignedHeightsResponse) GetHeights() []uint64 {
+	if m != nil {
+		return m.Heights
+	}
+	return nil
+}
+
+func (m *QuerySignedHeightsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryUnbondBlockersRequest struct {
+	// sequencer address
+	Sequencer  string             `protobuf:"bytes,1,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUnbondBlockersRequest) Reset()         { *m = QueryUnbondBlockersRequest{} }
+func (m *QueryUnbondBlockersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondBlockersRequest) ProtoMessage()    {}
+func (*QueryUnbondBlockersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a51f5810cc34625d, []int{10}
+}
+func (m *QueryUnbondBlockersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondBlockersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondBlockersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnbondBlockersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondBlockersRequest.Merge(m, src)
+}
+func (m *QueryUnbondBlockersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondBlockersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondBlockersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnbondBlockersRequest proto.InternalMessageInfo
+
+func (m *QueryUnbondBlockersRequest) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
+	}
+	return ""
+}
+
+func (m *QueryUnbondBlockersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryUnbondBlockersResponse struct {
+	// canonical client of the sequencer rollapp, empty if there is none
+	ClientId   string              `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Heights    []uint64            `protobuf:"varint,2,rep,packed,name=heights,proto3" json:"heights,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUnbondBlockersResponse) Reset()         { *m = QueryUnbondBlockersResponse{} }
+func (m *QueryUnbondBlockersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondBlockersResponse) ProtoMessage()    {}
+func (*QueryUnbondBlockersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a51f5810cc34625d, []int{11}
+}
+func (m *QueryUnbondBlockersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondBlockersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondBlockersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnbondBlockersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondBlockersResponse.Merge(m, src)
+}
+func (m *QueryUnbondBlockersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondBlockersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondBlockersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnbondBlockersResponse proto.InternalMessageInfo
+
+func (m *QueryUnbondBlockersResponse) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *QueryUnbondBlockersResponse) GetHeights() []uint64 {
+	if m != nil {
+		return m.Heights
+	}
+	return nil
+}
+
+func (m *QueryUnbondBlockersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGetLightClientRequest)(nil), "dymensionxyz.dymension.lightclient.QueryGetLightClientRequest")
 	proto.RegisterType((*QueryGetLightClientResponse)(nil), "dymensionxyz.dymension.lightclient.QueryGetLightClientResponse")
@@ -305,6 +657,12 @@ func init() {
 	proto.RegisterType((*QueryExpectedClientStateResponse)(nil), "dymensionxyz.dymension.lightclient.QueryExpectedClientStateResponse")
 	proto.RegisterType((*QueryRollappCanonChannelRequest)(nil), "dymensionxyz.dymension.lightclient.QueryRollappCanonChannelRequest")
 	proto.RegisterType((*QueryRollappCanonChannelResponse)(nil), "dymensionxyz.dymension.lightclient.QueryRollappCanonChannelResponse")
+	proto.RegisterType((*QuerySignerRequest)(nil), "dymensionxyz.dymension.lightclient.QuerySignerRequest")
+	proto.RegisterType((*QuerySignerResponse)(nil), "dymensionxyz.dymension.lightclient.QuerySignerResponse")
+	proto.RegisterType((*QuerySignedHeightsRequest)(nil), "dymensionxyz.dymension.lightclient.QuerySignedHeightsRequest")
+	proto.RegisterType((*QuerySignedHeightsResponse)(nil), "dymensionxyz.dymension.lightclient.QuerySignedHeightsResponse")
+	proto.RegisterType((*QueryUnbondBlockersRequest)(nil), "dymensionxyz.dymension.lightclient.QueryUnbondBlockersRequest")
+	proto.RegisterType((*QueryUnbondBlockersResponse)(nil), "dymensionxyz.dymension.lightclient.QueryUnbondBlockersResponse")
 }
 
 func init() {
@@ -312,41 +670,63 @@ func init() {
 }
 
 var fileDescriptor_a51f5810cc34625d = []byte{
-	// 537 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcf, 0x6e, 0xd3, 0x30,
-	0x18, 0x6f, 0x26, 0x86, 0x98, 0x3b, 0x21, 0xe4, 0x4e, 0x02, 0xb2, 0x91, 0x0e, 0x8b, 0x03, 0x07,
-	0x14, 0x4b, 0xdb, 0x01, 0xa8, 0x60, 0xd5, 0xd6, 0x4d, 0xa8, 0x12, 0x07, 0x08, 0x37, 0x2e, 0x93,
-	0x93, 0x98, 0x24, 0x52, 0x6a, 0x67, 0x8d, 0x33, 0x35, 0xa0, 0x5d, 0x78, 0x02, 0x24, 0x5e, 0x80,
-	0x07, 0xe0, 0x41, 0x38, 0x4e, 0x82, 0x03, 0x27, 0x04, 0x2d, 0x12, 0x9c, 0x79, 0x02, 0x14, 0xdb,
-	0x6d, 0x82, 0x68, 0xa0, 0x83, 0x5b, 0xfc, 0xf9, 0xfb, 0x7e, 0x7f, 0x3e, 0xff, 0x02, 0x6c, 0x3f,
-	0x1f, 0x50, 0x96, 0x46, 0x9c, 0x8d, 0xf2, 0xe7, 0x78, 0x76, 0xc0, 0x71, 0x14, 0x84, 0xc2, 0x8b,
-	0x23, 0xca, 0x04, 0x3e, 0xca, 0xe8, 0x30, 0xb7, 0x93, 0x21, 0x17, 0x1c, 0xa2, 0x6a, 0x7f, 0x39,
-	0x6c, 0x57, 0xfa, 0xcd, 0xb5, 0x80, 0x07, 0x5c, 0xb6, 0xe3, 0xe2, 0x4b, 0x4d, 0x9a, 0x1b, 0x01,
-	0xe7, 0x41, 0x4c, 0x31, 0x49, 0x22, 0x4c, 0x18, 0xe3, 0x82, 0x88, 0x88, 0xb3, 0x54, 0xdf, 0x5e,
-	0xd5, 0xb7, 0xf2, 0xe4, 0x66, 0xcf, 0x30, 0x61, 0x9a, 0x12, 0xed, 0x02, 0xf3, 0x71, 0xa1, 0xe0,
-	0x01, 0x15, 0x0f, 0x0b, 0x96, 0x9e, 0x64, 0x71, 0xe8, 0x51, 0x46, 0x53, 0x01, 0xaf, 0x01, 0x30,
-	0xe4, 0x71, 0x4c, 0x92, 0xe4, 0x30, 0xf2, 0xaf, 0x18, 0x9b, 0xc6, 0xcd, 0x15, 0x67, 0x45, 0x57,
-	0xfa, 0x7e, 0xe7, 0xdc, 0xf7, 0x37, 0xed, 0x06, 0xea, 0x80, 0xf5, 0xb9, 0x10, 0x69, 0xc2, 0x59,
-	0x4a, 0xe1, 0x3a, 0x58, 0x51, 0xd2, 0x0b, 0x88, 0x25, 0x09, 0x71, 0x41, 0x15, 0xfa, 0x3e, 0xba,
-	0x0e, 0xda, 0x72, 0xf6, 0x60, 0x94, 0x50, 0x4f, 0x50, 0x5f, 0xcd, 0x3e, 0x11, 0x44, 0x50, 0xad,
-	0x01, 0x09, 0xb0, 0x59, 0xdf, 0xa2, 0x39, 0x1e, 0x81, 0x55, 0xcd, 0x91, 0x16, 0x75, 0x49, 0xd3,
-	0xdc, 0x5a, 0xb3, 0x95, 0x6f, 0x7b, 0xea, 0xdb, 0xde, 0x65, 0xf9, 0xde, 0xe5, 0x1f, 0x9f, 0xda,
-	0xad, 0x9c, 0x0c, 0xe2, 0x0e, 0xaa, 0xce, 0x20, 0xa7, 0xe9, 0x95, 0xc8, 0xa8, 0xab, 0x85, 0x39,
-	0xca, 0x6c, 0x8f, 0x30, 0xce, 0x7a, 0x21, 0x61, 0x8c, 0xc6, 0xd3, 0xe5, 0x6c, 0x80, 0x72, 0x15,
-	0xbf, 0xed, 0x06, 0x1d, 0x6b, 0xd9, 0x73, 0x01, 0xb4, 0xec, 0x1b, 0xe0, 0x62, 0x98, 0xb9, 0x87,
-	0x9e, 0x2a, 0x97, 0x2b, 0x5e, 0x0d, 0x33, 0x57, 0xf7, 0xf6, 0x7d, 0x78, 0x0b, 0xc0, 0xe9, 0x23,
-	0x54, 0x3a, 0xd5, 0x26, 0x2f, 0xe9, 0x9b, 0x59, 0xf7, 0xd6, 0xdb, 0x65, 0xb0, 0x2c, 0x89, 0xe1,
-	0x07, 0x03, 0x34, 0x2b, 0x0f, 0x02, 0x77, 0xec, 0xbf, 0xc7, 0xcb, 0xae, 0x0f, 0x83, 0xd9, 0xfd,
-	0xe7, 0x79, 0x65, 0x17, 0xed, 0xbf, 0x7c, 0xff, 0xf5, 0xf5, 0xd2, 0x0e, 0xbc, 0x87, 0x17, 0xf8,
-	0x2f, 0xaa, 0xdf, 0x2f, 0xca, 0x10, 0x9e, 0xc0, 0x2f, 0x06, 0x68, 0xcd, 0xc9, 0x02, 0xec, 0x2d,
-	0x2c, 0xaf, 0x3e, 0x6c, 0xe6, 0xfe, 0xff, 0x81, 0x68, 0xa3, 0x5d, 0x69, 0xf4, 0x2e, 0xbc, 0xbd,
-	0x88, 0x51, 0xaa, 0x81, 0xd4, 0x51, 0x66, 0x11, 0x7e, 0x33, 0x40, 0x6b, 0x4e, 0x70, 0xce, 0xe0,
-	0xb1, 0x3e, 0xb7, 0x67, 0xf0, 0xf8, 0x87, 0xec, 0xa2, 0x03, 0xe9, 0xb1, 0x0b, 0xef, 0x2f, 0xe2,
-	0xd1, 0x2b, 0x10, 0xa6, 0xe9, 0x9d, 0x3d, 0x67, 0xdf, 0x3f, 0xd9, 0x73, 0xde, 0x8d, 0x2d, 0xe3,
-	0x74, 0x6c, 0x19, 0x9f, 0xc7, 0x96, 0xf1, 0x6a, 0x62, 0x35, 0x4e, 0x27, 0x56, 0xe3, 0xe3, 0xc4,
-	0x6a, 0x3c, 0xbd, 0x13, 0x44, 0x22, 0xcc, 0x5c, 0xdb, 0xe3, 0x83, 0x3a, 0x8a, 0xe3, 0x6d, 0x3c,
-	0xfa, 0x85, 0x47, 0xe4, 0x09, 0x4d, 0xdd, 0xf3, 0xf2, 0x7f, 0xdf, 0xfe, 0x19, 0x00, 0x00, 0xff,
-	0xff, 0x03, 0xd6, 0x9a, 0xf1, 0x7f, 0x05, 0x00, 0x00,
+	// 881 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x24, 0x69, 0x68, 0x9e, 0x4b, 0x85, 0xc6, 0x15, 0xb8, 0x9b, 0x60, 0x5b, 0x2b, 0x04,
+	0x15, 0x42, 0xbb, 0x6a, 0x23, 0x51, 0x88, 0x68, 0x4c, 0xe2, 0xb4, 0xc1, 0xa8, 0x87, 0xb2, 0x15,
+	0x17, 0x2e, 0xd6, 0xfe, 0x99, 0xae, 0x57, 0xac, 0x67, 0xb6, 0xde, 0x71, 0x14, 0x63, 0x59, 0x48,
+	0xfd, 0x04, 0x48, 0x9c, 0x91, 0xf8, 0x0e, 0x7c, 0x03, 0x4e, 0x1c, 0x2b, 0xc1, 0x01, 0x21, 0x84,
+	0xc0, 0x41, 0x82, 0x33, 0x9f, 0x00, 0xed, 0xcc, 0xac, 0xbd, 0xdb, 0xae, 0xdb, 0xb5, 0x73, 0xf3,
+	0xcc, 0xbc, 0xf7, 0x7b, 0xbf, 0xdf, 0x9b, 0x79, 0xbf, 0x35, 0x18, 0xde, 0xa8, 0x4f, 0x68, 0x1c,
+	0x30, 0x7a, 0x36, 0xfa, 0xca, 0x9c, 0x2d, 0xcc, 0x30, 0xf0, 0x7b, 0xdc, 0x0d, 0x03, 0x42, 0xb9,
+	0xf9, 0x78, 0x48, 0x06, 0x23, 0x23, 0x1a, 0x30, 0xce, 0xb0, 0x9e, 0x8d, 0x9f, 0x27, 0x1b, 0x99,
+	0x78, 0xed, 0x9a, 0xcf, 0x7c, 0x26, 0xc2, 0xcd, 0xe4, 0x97, 0xcc, 0xd4, 0x76, 0x7d, 0xc6, 0xfc,
+	0x90, 0x98, 0x76, 0x14, 0x98, 0x36, 0xa5, 0x8c, 0xdb, 0x3c, 0x60, 0x34, 0x56, 0xa7, 0xd7, 0xd5,
+	0xa9, 0x58, 0x39, 0xc3, 0x47, 0xa6, 0x4d, 0x55, 0x49, 0xed, 0x5d, 0x97, 0xc5, 0x7d, 0x16, 0x9b,
+	0x8e, 0x1d, 0x13, 0xc9, 0xc5, 0x3c, 0xbd, 0xe9, 0x10, 0x6e, 0xdf, 0x34, 0x23, 0xdb, 0x0f, 0xa8,
+	0xc0, 0x91, 0xb1, 0xfa, 0x21, 0x68, 0x9f, 0x25, 0x11, 0x27, 0x84, 0xdf, 0x4f, 0x18, 0xb5, 0x05,
+	0x23, 0x8b, 0x3c, 0x1e, 0x92, 0x98, 0xe3, 0x37, 0x01, 0x06, 0x2c, 0x0c, 0xed, 0x28, 0xea, 0x06,
+	0x5e, 0x0d, 0x35, 0xd1, 0x8d, 0x6d, 0x6b, 0x5b, 0xed, 0x74, 0xbc, 0xfd, 0xcd, 0x7f, 0xbf, 0x6f,
+	0xac, 0xe9, 0xfb, 0xb0, 0x53, 0x08, 0x11, 0x47, 0x8c, 0xc6, 0x04, 0xef, 0xc0, 0xb6, 0x94, 0x99,
+	0x40, 0xac, 0x0b, 0x88, 0xcb, 0x72, 0xa3, 0xe3, 0xe9, 0x1f, 0x43, 0x43, 0xe4, 0xde, 0x3d, 0x8b,
+	0x88, 0xcb, 0x89, 0x27, 0x73, 0x1f, 0x72, 0x9b, 0x93, 0x72, 0x1c, 0x74, 0x0e, 0xcd, 0xc5, 0x08,
+	0x8a, 0xc2, 0x03, 0xb8, 0xa2, 0x28, 0xc4, 0xc9, 0xbe, 0x60, 0x51, 0xb9, 0x75, 0xcd, 0x90, 0x2d,
+	0x34, 0xd2, 0x16, 0x1a, 0x87, 0x74, 0x74, 0xf4, 0xc6, 0x7f, 0x7f, 0x34, 0xaa, 0x23, 0xbb, 0x1f,
+	0xee, 0xeb, 0xd9, 0x1c, 0xdd, 0xaa, 0xb8, 0x73, 0x64, 0xbd, 0xa5, 0x78, 0x5b, 0x92, 0x47, 0xdb,
+	0xa6, 0x8c, 0xb6, 0x7b, 0x36, 0xa5, 0x24, 0x4c, 0x79, 0xef, 0xc2, 0x9c, 0xe5, 0xf3, 0xb4, 0x4f,
+	0xa1, 0xb9, 0x18, 0x40, 0xd1, 0x7e, 0x0b, 0xae, 0xf6, 0x86, 0x4e, 0xd7, 0x95, 0xdb, 0x73, 0xf5,
+	0x57, 0x7a, 0x43, 0x47, 0xc5, 0x76, 0x3c, 0xfc, 0x1e, 0xe0, 0xb4, 0x3f, 0x99, 0x48, 0xd9, 0xe8,
+	0xd7, 0xd4, 0xc9, 0x2c, 0x5a, 0xef, 0x00, 0x16, 0x75, 0x1f, 0x06, 0x3e, 0x25, 0x83, 0x94, 0x6b,
+	0xee, 0x8e, 0x50, 0xfe, 0x8e, 0xf0, 0xeb, 0xb0, 0xd5, 0x23, 0xc9, 0xc5, 0x0a, 0xd0, 0x4d, 0x4b,
+	0xad, 0xf4, 0x3d, 0xa8, 0xe6, 0xa0, 0x14, 0xeb, 0x5d, 0xd8, 0x8e, 0x13, 0x58, 0xea, 0x92, 0x41,
+	0xaa, 0x7b, 0xb6, 0xa1, 0xff, 0x8e, 0xe0, 0xfa, 0x3c, 0xcb, 0xfb, 0x44, 0x40, 0xc5, 0x99, 0x9e,
+	0x2d, 0xce, 0x7d, 0xe1, 0x4b, 0xc2, 0x0d, 0xa8, 0x3c, 0x1a, 0xb0, 0x7e, 0x57, 0x51, 0xdd, 0x10,
+	0x54, 0x21, 0xd9, 0x92, 0x35, 0x92, 0x6c, 0xce, 0xd2, 0xe3, 0x4d, 0x71, 0x7c, 0x99, 0x33, 0x75,
+	0x78, 0x0f, 0x60, 0x3e, 0x1a, 0xb5, 0x4b, 0xe2, 0x7d, 0xbc, 0x6d, 0xc8, 0x39, 0x32, 0x92, 0x39,
+	0x32, 0xe4, 0x4c, 0xab, 0x39, 0x32, 0x1e, 0xd8, 0x7e, 0xfa, 0x40, 0xad, 0x4c, 0xa6, 0xfe, 0x35,
+	0x68, 0x45, 0xea, 0x54, 0x6b, 0x6a, 0xf0, 0x8a, 0xac, 0x1f, 0xd7, 0x50, 0x73, 0xe3, 0xc6, 0xa6,
+	0x95, 0x2e, 0xf1, 0x49, 0xae, 0xbe, 0x7c, 0x9f, 0xef, 0xbc, 0xb4, 0xbe, 0x84, 0xcd, 0x11, 0x78,
+	0x82, 0x14, 0x83, 0xcf, 0xa9, 0xc3, 0xa8, 0x77, 0x14, 0x32, 0xf7, 0x4b, 0x32, 0x28, 0xd9, 0xe0,
+	0x7b, 0x05, 0x2c, 0x56, 0xe9, 0xc2, 0x77, 0x08, 0x76, 0x0a, 0x49, 0x14, 0x59, 0xc2, 0xb3, 0xcf,
+	0x2d, 0xd3, 0xa4, 0xf5, 0x17, 0x35, 0x69, 0x63, 0xe5, 0x26, 0xdd, 0xfa, 0x01, 0xe0, 0x92, 0xe0,
+	0x87, 0x7f, 0x41, 0x50, 0xc9, 0x98, 0x16, 0x3e, 0x30, 0x5e, 0x6e, 0xd7, 0xc6, 0x62, 0xc3, 0xd4,
+	0x5a, 0x2b, 0xe7, 0x4b, 0x9a, 0xfa, 0xf1, 0x93, 0x9f, 0xff, 0xfe, 0x76, 0xfd, 0x00, 0x7f, 0x64,
+	0x96, 0xf8, 0xce, 0x64, 0x7f, 0x8f, 0xe7, 0x26, 0x39, 0xc1, 0x7f, 0x21, 0xa8, 0x16, 0x18, 0x22,
+	0x6e, 0x97, 0xa6, 0xb7, 0xd8, 0x90, 0xb5, 0xe3, 0x8b, 0x81, 0x28, 0xa1, 0x2d, 0x21, 0xf4, 0x43,
+	0x7c, 0xbb, 0x8c, 0x50, 0xa2, 0x80, 0xe4, 0x52, 0x18, 0x32, 0xfe, 0x07, 0x41, 0xb5, 0xc0, 0x3d,
+	0x97, 0xd0, 0xb8, 0xd8, 0xbc, 0xb5, 0xe3, 0x8b, 0x81, 0x28, 0x8d, 0x77, 0x85, 0xc6, 0x16, 0xbe,
+	0x53, 0x46, 0xa3, 0x9b, 0x20, 0xa4, 0x16, 0x3e, 0xbb, 0xce, 0x8e, 0x37, 0xc1, 0x3f, 0x22, 0xd8,
+	0x92, 0x26, 0x8b, 0xdf, 0x2f, 0xcd, 0x2b, 0x67, 0xf0, 0xda, 0xed, 0xa5, 0xf3, 0x94, 0x84, 0x13,
+	0x21, 0xe1, 0x10, 0xb7, 0xca, 0x48, 0x88, 0x45, 0xae, 0x39, 0x9e, 0x0d, 0xf7, 0xc4, 0x1c, 0xcb,
+	0xe1, 0x9d, 0xe0, 0x29, 0x82, 0x57, 0x73, 0xae, 0x88, 0xef, 0x2c, 0xc7, 0xe9, 0x99, 0x6f, 0x85,
+	0x76, 0xb0, 0x6a, 0xba, 0x52, 0x66, 0x09, 0x65, 0xf7, 0xf1, 0xa7, 0xa5, 0x95, 0x79, 0xea, 0xeb,
+	0x11, 0x9b, 0xe3, 0x99, 0x67, 0x4e, 0xb2, 0x6a, 0xf1, 0x6f, 0x08, 0xae, 0xe6, 0x3d, 0x6f, 0x09,
+	0x47, 0x29, 0x74, 0x6c, 0xad, 0xb5, 0x72, 0xfe, 0x2a, 0x37, 0x38, 0x14, 0x18, 0x5d, 0x47, 0x81,
+	0x64, 0x85, 0x1e, 0x59, 0x3f, 0x4d, 0xeb, 0xe8, 0xe9, 0xb4, 0x8e, 0xfe, 0x9c, 0xd6, 0xd1, 0x37,
+	0xe7, 0xf5, 0xb5, 0xa7, 0xe7, 0xf5, 0xb5, 0x5f, 0xcf, 0xeb, 0x6b, 0x5f, 0x7c, 0xe0, 0x07, 0xbc,
+	0x37, 0x74, 0x0c, 0x97, 0xf5, 0x17, 0x15, 0x39, 0xdd, 0x33, 0xcf, 0x72, 0x95, 0xf8, 0x28, 0x22,
+	0xb1, 0xb3, 0x25, 0xfe, 0x7b, 0xed, 0xfd, 0x3f, 0x00, 0x0d, 0x9d, 0xc8, 0xe5, 0x56, 0x0b, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -364,6 +744,14 @@ type QueryClient interface {
 	LightClient(ctx context.Context, in *QueryGetLightClientRequest, opts ...grpc.CallOption) (*QueryGetLightClientResponse, error)
 	ExpectedClientState(ctx context.Context, in *QueryExpectedClientStateRequest, opts ...grpc.CallOption) (*QueryExpectedClientStateResponse, error)
 	RollappCanonChannel(ctx context.Context, in *QueryRollappCanonChannelRequest, opts ...grpc.CallOption) (*QueryRollappCanonChannelResponse, error)
+	// the sequencer which signed the header of the client height
+	Signer(ctx context.Context, in *QuerySignerRequest, opts ...grpc.CallOption) (*QuerySignerResponse, error)
+	// the heights of the client signed by the sequencer, which are not verified
+	// against a state update yet
+	SignedHeights(ctx context.Context, in *QuerySignedHeightsRequest, opts ...grpc.CallOption) (*QuerySignedHeightsResponse, error)
+	// the unverified heights signed by the sequencer on the canonical client of
+	// its rollapp, which prevent it from unbonding
+	UnbondBlockers(ctx context.Context, in *QueryUnbondBlockersRequest, opts ...grpc.CallOption) (*QueryUnbondBlockersResponse, error)
 }
 
 type queryClient struct {
@@ -401,11 +789,46 @@ func (c *queryClient) RollappCanonChannel(ctx context.Context, in *QueryRollappC
 	return out, nil
 }
 
+func (c *queryClient) Signer(ctx context.Context, in *QuerySignerRequest, opts ...grpc.CallOption) (*QuerySignerResponse, error) {
+	out := new(QuerySignerResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Query/Signer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SignedHeights(ctx context.Context, in *QuerySignedHeightsRequest, opts ...grpc.CallOption) (*QuerySignedHeightsResponse, error) {
+	out := new(QuerySignedHeightsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Query/SignedHeights", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnbondBlockers(ctx context.Context, in *QueryUnbondBlockersRequest, opts ...grpc.CallOption) (*QueryUnbondBlockersResponse, error) {
+	out := new(QueryUnbondBlockersResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Query/UnbondBlockers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	LightClient(context.Context, *QueryGetLightClientRequest) (*QueryGetLightClientResponse, error)
 	ExpectedClientState(context.Context, *QueryExpectedClientStateRequest) (*QueryExpectedClientStateResponse, error)
 	RollappCanonChannel(context.Context, *QueryRollappCanonChannelRequest) (*QueryRollappCanonChannelResponse, error)
+	// the sequencer which signed the header of the client height
+	Signer(context.Context, *QuerySignerRequest) (*QuerySignerResponse, error)
+	// the heights of the client signed by the sequencer, which are not verified
+	// against a state update yet
+	SignedHeights(context.Context, *QuerySignedHeightsRequest) (*QuerySignedHeightsResponse, error)
+	// the unverified heights signed by the sequencer on the canonical client of
+	// its rollapp, which prevent it from unbonding
+	UnbondBlockers(context.Context, *QueryUnbondBlockersRequest) (*QueryUnbondBlockersResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -421,6 +844,15 @@ func (*UnimplementedQueryServer) ExpectedClientState(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) RollappCanonChannel(ctx context.Context, req *QueryRollappCanonChannelRequest) (*QueryRollappCanonChannelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RollappCanonChannel not implemented")
 }
+func (*UnimplementedQueryServer) Signer(ctx context.Context, req *QuerySignerRequest) (*QuerySignerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Signer not implemented")
+}
+func (*UnimplementedQueryServer) SignedHeights(ctx context.Context, req *QuerySignedHeightsRequest) (*QuerySignedHeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignedHeights not implemented")
+}
+func (*UnimplementedQueryServer) UnbondBlockers(ctx context.Context, req *QueryUnbondBlockersRequest) (*QueryUnbondBlockersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondBlockers not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -480,32 +912,98 @@ func _Query_RollappCanonChannel_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dymensionxyz.dymension.lightclient.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "LightClient",
-			Handler:    _Query_LightClient_Handler,
-		},
-		{
-			MethodName: "ExpectedClientState",
-			Handler:    _Query_ExpectedClientState_Handler,
-		},
-		{
-			MethodName: "RollappCanonChannel",
-			Handler:    _Query_RollappCanonChannel_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dymensionxyz/dymension/lightclient/query.proto",
-}
-
-func (m *QueryGetLightClientRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Signer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Signer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.lightclient.Query/Signer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Signer(ctx, req.(*QuerySignerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignedHeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignedHeightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignedHeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.lightclient.Query/SignedHeights",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignedHeights(ctx, req.(*QuerySignedHeightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnbondBlockers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondBlockersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondBlockers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.lightclient.Query/UnbondBlockers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondBlockers(ctx, req.(*QueryUnbondBlockersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dymensionxyz.dymension.lightclient.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LightClient",
+			Handler:    _Query_LightClient_Handler,
+		},
+		{
+			MethodName: "ExpectedClientState",
+			Handler:    _Query_ExpectedClientState_Handler,
+		},
+		{
+			MethodName: "RollappCanonChannel",
+			Handler:    _Query_RollappCanonChannel_Handler,
+		},
+		{
+			MethodName: "Signer",
+			Handler:    _Query_Signer_Handler,
+		},
+		{
+			MethodName: "SignedHeights",
+			Handler:    _Query_SignedHeights_Handler,
+		},
+		{
+			MethodName: "UnbondBlockers",
+			Handler:    _Query_UnbondBlockers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dymensionxyz/dymension/lightclient/query.proto",
+}
+
+func (m *QueryGetLightClientRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
@@ -581,6 +1079,13 @@ func (m *QueryExpectedClientStateRequest) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -686,102 +1191,1030 @@ func (m *QueryRollappCanonChannelResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySignerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryGetLightClientRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QuerySignerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetLightClientResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySignerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryExpectedClientStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySignedHeightsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryExpectedClientStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySignedHeightsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignedHeightsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ClientState != nil {
-		l = m.ClientState.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRollappCanonChannelRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySignedHeightsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignedHeightsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignedHeightsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Heights) > 0 {
+		dAtA5 := make([]byte, len(m.Heights)*10)
+		var j4 int
+		for _, num := range m.Heights {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintQuery(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRollappCanonChannelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryUnbondBlockersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondBlockersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondBlockersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.HubChannelId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.RollappChannelId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondBlockersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondBlockersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondBlockersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Heights) > 0 {
+		dAtA9 := make([]byte, len(m.Heights)*10)
+		var j8 int
+		for _, num := range m.Heights {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintQuery(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGetLightClientRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetLightClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExpectedClientStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExpectedClientStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClientState != nil {
+		l = m.ClientState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRollappCanonChannelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRollappCanonChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HubChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RollappChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QuerySignerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignedHeightsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignedHeightsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Heights) > 0 {
+		l = 0
+		for _, e := range m.Heights {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondBlockersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondBlockersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Heights) > 0 {
+		l = 0
+		for _, e := range m.Heights {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGetLightClientRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetLightClientRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetLightClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetLightClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetLightClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetLightClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExpectedClientStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExpectedClientStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExpectedClientStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExpectedClientStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExpectedClientStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExpectedClientStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientState == nil {
+				m.ClientState = &types.Any{}
+			}
+			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRollappCanonChannelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRollappCanonChannelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRollappCanonChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRollappCanonChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRollappCanonChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRollappCanonChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HubChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HubChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryGetLightClientRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySignerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -804,15 +2237,15 @@ func (m *QueryGetLightClientRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetLightClientRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetLightClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -840,8 +2273,27 @@ func (m *QueryGetLightClientRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -863,7 +2315,7 @@ func (m *QueryGetLightClientRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetLightClientResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySignerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -886,15 +2338,15 @@ func (m *QueryGetLightClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetLightClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetLightClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -922,7 +2374,7 @@ func (m *QueryGetLightClientResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -945,7 +2397,7 @@ func (m *QueryGetLightClientResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExpectedClientStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySignedHeightsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -968,12 +2420,150 @@ func (m *QueryExpectedClientStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExpectedClientStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignedHeightsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExpectedClientStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignedHeightsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -995,7 +2585,7 @@ func (m *QueryExpectedClientStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExpectedClientStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySignedHeightsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1018,15 +2608,91 @@ func (m *QueryExpectedClientStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExpectedClientStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignedHeightsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExpectedClientStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignedHeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Heights = append(m.Heights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Heights) == 0 {
+					m.Heights = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Heights = append(m.Heights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Heights", wireType)
+			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1053,10 +2719,10 @@ func (m *QueryExpectedClientStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientState == nil {
-				m.ClientState = &types.Any{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1081,7 +2747,7 @@ func (m *QueryExpectedClientStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRollappCanonChannelRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUnbondBlockersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1104,15 +2770,15 @@ func (m *QueryRollappCanonChannelRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRollappCanonChannelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnbondBlockersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRollappCanonChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnbondBlockersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1140,7 +2806,43 @@ func (m *QueryRollappCanonChannelRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1163,7 +2865,7 @@ func (m *QueryRollappCanonChannelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRollappCanonChannelResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUnbondBlockersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1186,15 +2888,15 @@ func (m *QueryRollappCanonChannelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRollappCanonChannelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnbondBlockersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRollappCanonChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnbondBlockersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HubChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1222,13 +2924,89 @@ func (m *QueryRollappCanonChannelResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HubChannelId = string(dAtA[iNdEx:postIndex])
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Heights = append(m.Heights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Heights) == 0 {
+					m.Heights = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Heights = append(m.Heights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Heights", wireType)
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1238,23 +3016,27 @@ func (m *QueryRollappCanonChannelResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappChannelId = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2,12 +2,18 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 )
 
 var (
-	_ sdk.Msg = &MsgSetCanonicalClient{}
+	_ sdk.Msg                            = &MsgSetCanonicalClient{}
+	_ sdk.Msg                            = &MsgSubmitSequencerEquivocation{}
+	_ sdk.Msg                            = &MsgRecoverCanonicalClient{}
+	_ sdk.Msg                            = &MsgSetClientParams{}
+	_ codectypes.UnpackInterfacesMessage = MsgSubmitSequencerEquivocation{}
 )
 
 func (msg *MsgSetCanonicalClient) ValidateBasic() error {
@@ -20,3 +26,59 @@ func (msg *MsgSetCanonicalClient) ValidateBasic() error {
 	}
 	return nil
 }
+
+func (msg *MsgSubmitSequencerEquivocation) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Submitter)
+	if err != nil {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "invalid submitter address (%s)", err)
+	}
+	if msg.Header_1 == nil || msg.Header_2 == nil {
+		return gerrc.ErrInvalidArgument.Wrap("two headers are required")
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgSubmitSequencerEquivocation) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var clientMsg exported.ClientMessage
+	if err := unpacker.UnpackAny(msg.Header_1, &clientMsg); err != nil {
+		return err
+	}
+	return unpacker.UnpackAny(msg.Header_2, &clientMsg)
+}
+
+func (msg *MsgRecoverCanonicalClient) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "invalid signer address (%s)", err)
+	}
+	if msg.RollappId == "" {
+		return gerrc.ErrInvalidArgument.Wrap("empty rollapp id")
+	}
+	if msg.ClientId == "" {
+		return gerrc.ErrInvalidArgument.Wrap("empty client id")
+	}
+	if msg.ChannelId == "" {
+		return gerrc.ErrInvalidArgument.Wrap("empty channel id")
+	}
+	return nil
+}
+
+func (msg *MsgSetClientParams) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "invalid signer address (%s)", err)
+	}
+	if err := msg.ClientParams().ValidateBasic(); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, err.Error())
+	}
+	return nil
+}
+
+func (msg *MsgSetClientParams) ClientParams() ClientParams {
+	return ClientParams{
+		RollappId:       msg.RollappId,
+		UnbondingPeriod: msg.UnbondingPeriod,
+		MaxClockDrift:   msg.MaxClockDrift,
+	}
+}
@@ -6,24 +6,28 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/codec/types"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -123,9 +127,337 @@ func (m *MsgSetCanonicalClientResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetCanonicalClientResponse proto.InternalMessageInfo
 
+// submit two conflicting headers signed by the same sequencer at the same
+// rollapp height and revision. If they are valid, the sequencer is punished
+// and the submitter receives a part of the slashed bond.
+type MsgSubmitSequencerEquivocation struct {
+	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// tendermint light client header, as for MsgUpdateClient
+	Header_1 *types.Any `protobuf:"bytes,2,opt,name=header_1,json=header1,proto3" json:"header_1,omitempty"`
+	// tendermint light client header, as for MsgUpdateClient
+	Header_2 *types.Any `protobuf:"bytes,3,opt,name=header_2,json=header2,proto3" json:"header_2,omitempty"`
+}
+
+func (m *MsgSubmitSequencerEquivocation) Reset()         { *m = MsgSubmitSequencerEquivocation{} }
+func (m *MsgSubmitSequencerEquivocation) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitSequencerEquivocation) ProtoMessage()    {}
+func (*MsgSubmitSequencerEquivocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86d8e0adcad4a570, []int{2}
+}
+func (m *MsgSubmitSequencerEquivocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitSequencerEquivocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitSequencerEquivocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitSequencerEquivocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitSequencerEquivocation.Merge(m, src)
+}
+func (m *MsgSubmitSequencerEquivocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitSequencerEquivocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitSequencerEquivocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitSequencerEquivocation proto.InternalMessageInfo
+
+func (m *MsgSubmitSequencerEquivocation) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *MsgSubmitSequencerEquivocation) GetHeader_1() *types.Any {
+	if m != nil {
+		return m.Header_1
+	}
+	return nil
+}
+
+func (m *MsgSubmitSequencerEquivocation) GetHeader_2() *types.Any {
+	if m != nil {
+		return m.Header_2
+	}
+	return nil
+}
+
+type MsgSubmitSequencerEquivocationResponse struct {
+}
+
+func (m *MsgSubmitSequencerEquivocationResponse) Reset() {
+	*m = MsgSubmitSequencerEquivocationResponse{}
+}
+func (m *MsgSubmitSequencerEquivocationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitSequencerEquivocationResponse) ProtoMessage()    {}
+func (*MsgSubmitSequencerEquivocationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86d8e0adcad4a570, []int{3}
+}
+func (m *MsgSubmitSequencerEquivocationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitSequencerEquivocationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitSequencerEquivocationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitSequencerEquivocationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitSequencerEquivocationResponse.Merge(m, src)
+}
+func (m *MsgSubmitSequencerEquivocationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitSequencerEquivocationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitSequencerEquivocationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitSequencerEquivocationResponse proto.InternalMessageInfo
+
+// replace the canonical client of the rollapp, once it expired or got frozen,
+// by a new client which matches the finalized rollapp state.
+// Can be submitted by the rollapp owner or the gov module.
+type MsgRecoverCanonicalClient struct {
+	Signer    string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// id of the new ibc client state
+	ClientId string `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// id of the transfer channel over the new client, which becomes the canonical
+	// channel of the rollapp
+	ChannelId string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *MsgRecoverCanonicalClient) Reset()         { *m = MsgRecoverCanonicalClient{} }
+func (m *MsgRecoverCanonicalClient) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverCanonicalClient) ProtoMessage()    {}
+func (*MsgRecoverCanonicalClient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86d8e0adcad4a570, []int{4}
+}
+func (m *MsgRecoverCanonicalClient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverCanonicalClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverCanonicalClient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecoverCanonicalClient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverCanonicalClient.Merge(m, src)
+}
+func (m *MsgRecoverCanonicalClient) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverCanonicalClient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverCanonicalClient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecoverCanonicalClient proto.InternalMessageInfo
+
+func (m *MsgRecoverCanonicalClient) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgRecoverCanonicalClient) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *MsgRecoverCanonicalClient) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *MsgRecoverCanonicalClient) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+type MsgRecoverCanonicalClientResponse struct {
+}
+
+func (m *MsgRecoverCanonicalClientResponse) Reset()         { *m = MsgRecoverCanonicalClientResponse{} }
+func (m *MsgRecoverCanonicalClientResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverCanonicalClientResponse) ProtoMessage()    {}
+func (*MsgRecoverCanonicalClientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86d8e0adcad4a570, []int{5}
+}
+func (m *MsgRecoverCanonicalClientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverCanonicalClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverCanonicalClientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecoverCanonicalClientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverCanonicalClientResponse.Merge(m, src)
+}
+func (m *MsgRecoverCanonicalClientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverCanonicalClientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverCanonicalClientResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecoverCanonicalClientResponse proto.InternalMessageInfo
+
+// set the trust parameters expected from the canonical client of the rollapp.
+// Can be submitted by the rollapp owner or the gov module. It does not affect
+// a canonical client which is already set.
+type MsgSetClientParams struct {
+	Signer    string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// the unbonding period of the rollapp sequencers
+	UnbondingPeriod time.Duration `protobuf:"bytes,3,opt,name=unbonding_period,json=unbondingPeriod,proto3,stdduration" json:"unbonding_period"`
+	// how much the header time can drift into the future, relative to the hub
+	MaxClockDrift time.Duration `protobuf:"bytes,4,opt,name=max_clock_drift,json=maxClockDrift,proto3,stdduration" json:"max_clock_drift"`
+}
+
+func (m *MsgSetClientParams) Reset()         { *m = MsgSetClientParams{} }
+func (m *MsgSetClientParams) String() string { return proto.CompactTextString(m) }
+func (*MsgSetClientParams) ProtoMessage()    {}
+func (*MsgSetClientParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86d8e0adcad4a570, []int{6}
+}
+func (m *MsgSetClientParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetClientParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetClientParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetClientParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetClientParams.Merge(m, src)
+}
+func (m *MsgSetClientParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetClientParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetClientParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetClientParams proto.InternalMessageInfo
+
+func (m *MsgSetClientParams) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgSetClientParams) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *MsgSetClientParams) GetUnbondingPeriod() time.Duration {
+	if m != nil {
+		return m.UnbondingPeriod
+	}
+	return 0
+}
+
+func (m *MsgSetClientParams) GetMaxClockDrift() time.Duration {
+	if m != nil {
+		return m.MaxClockDrift
+	}
+	return 0
+}
+
+type MsgSetClientParamsResponse struct {
+}
+
+func (m *MsgSetClientParamsResponse) Reset()         { *m = MsgSetClientParamsResponse{} }
+func (m *MsgSetClientParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetClientParamsResponse) ProtoMessage()    {}
+func (*MsgSetClientParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86d8e0adcad4a570, []int{7}
+}
+func (m *MsgSetClientParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetClientParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetClientParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetClientParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetClientParamsResponse.Merge(m, src)
+}
+func (m *MsgSetClientParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetClientParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetClientParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetClientParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSetCanonicalClient)(nil), "dymensionxyz.dymension.lightclient.MsgSetCanonicalClient")
 	proto.RegisterType((*MsgSetCanonicalClientResponse)(nil), "dymensionxyz.dymension.lightclient.MsgSetCanonicalClientResponse")
+	proto.RegisterType((*MsgSubmitSequencerEquivocation)(nil), "dymensionxyz.dymension.lightclient.MsgSubmitSequencerEquivocation")
+	proto.RegisterType((*MsgSubmitSequencerEquivocationResponse)(nil), "dymensionxyz.dymension.lightclient.MsgSubmitSequencerEquivocationResponse")
+	proto.RegisterType((*MsgRecoverCanonicalClient)(nil), "dymensionxyz.dymension.lightclient.MsgRecoverCanonicalClient")
+	proto.RegisterType((*MsgRecoverCanonicalClientResponse)(nil), "dymensionxyz.dymension.lightclient.MsgRecoverCanonicalClientResponse")
+	proto.RegisterType((*MsgSetClientParams)(nil), "dymensionxyz.dymension.lightclient.MsgSetClientParams")
+	proto.RegisterType((*MsgSetClientParamsResponse)(nil), "dymensionxyz.dymension.lightclient.MsgSetClientParamsResponse")
 }
 
 func init() {
@@ -133,27 +465,47 @@ func init() {
 }
 
 var fileDescriptor_86d8e0adcad4a570 = []byte{
-	// 320 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4e, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x73, 0x32, 0xd3, 0x33,
-	0x4a, 0x92, 0x73, 0x32, 0x53, 0xf3, 0x4a, 0xf4, 0x4b, 0x2a, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2,
-	0x85, 0x94, 0x90, 0x15, 0xeb, 0xc1, 0x39, 0x7a, 0x48, 0x8a, 0xa5, 0xc4, 0x93, 0xf3, 0x8b, 0x73,
-	0xf3, 0x8b, 0xf5, 0x73, 0x8b, 0xd3, 0xf5, 0xcb, 0x0c, 0x41, 0x14, 0x44, 0xb3, 0x94, 0x48, 0x7a,
-	0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x45, 0x65, 0xd2, 0xf3, 0xf3, 0xd3, 0x73, 0x52,
-	0xf5, 0x13, 0x0b, 0x32, 0xf5, 0x13, 0xf3, 0xf2, 0xf2, 0x4b, 0x12, 0x4b, 0x32, 0xf3, 0xf3, 0x8a,
-	0xa1, 0xb2, 0x92, 0x50, 0x59, 0x30, 0x2f, 0xa9, 0x34, 0x4d, 0x3f, 0x31, 0xaf, 0x12, 0x22, 0xa5,
-	0x14, 0xc9, 0x25, 0xea, 0x5b, 0x9c, 0x1e, 0x9c, 0x5a, 0xe2, 0x9c, 0x98, 0x97, 0x9f, 0x97, 0x99,
-	0x9c, 0x98, 0xe3, 0x0c, 0x76, 0x80, 0x90, 0x18, 0x17, 0x5b, 0x71, 0x66, 0x7a, 0x5e, 0x6a, 0x91,
-	0x04, 0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10, 0x94, 0x27, 0x24, 0xcd, 0xc5, 0x09, 0x71, 0x62, 0x7c,
-	0x66, 0x8a, 0x04, 0x13, 0x58, 0x8a, 0x03, 0x22, 0xe0, 0x99, 0x62, 0xc5, 0xdd, 0xf4, 0x7c, 0x83,
-	0x16, 0x54, 0xa5, 0x92, 0x3c, 0x97, 0x2c, 0x56, 0xa3, 0x83, 0x52, 0x8b, 0x0b, 0xf2, 0xf3, 0x8a,
-	0x53, 0x8d, 0x16, 0x32, 0x72, 0x31, 0xfb, 0x16, 0xa7, 0x0b, 0x4d, 0x62, 0xe4, 0x12, 0xc2, 0xe2,
-	0x02, 0x4b, 0x3d, 0xc2, 0xe1, 0xa4, 0x87, 0xd5, 0x06, 0x29, 0x47, 0xb2, 0xb5, 0xc2, 0x1c, 0x27,
-	0xc5, 0xda, 0xf0, 0x7c, 0x83, 0x16, 0xa3, 0x53, 0xd0, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9,
-	0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e,
-	0xcb, 0x31, 0x44, 0x59, 0xa4, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xe3,
-	0x88, 0xfd, 0x32, 0x63, 0xfd, 0x0a, 0xd4, 0x24, 0x50, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e,
-	0x7a, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x54, 0xc2, 0xb7, 0xf5, 0x35, 0x02, 0x00, 0x00,
+	// 636 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x6b, 0x13, 0x41,
+	0x14, 0xcf, 0xd8, 0x5a, 0x9b, 0x29, 0x5a, 0x59, 0x6a, 0x4d, 0xb7, 0xed, 0x56, 0x23, 0x48, 0xa9,
+	0xb0, 0x43, 0x53, 0x10, 0x2d, 0x28, 0xf4, 0xdf, 0xa1, 0x4a, 0xa4, 0x6c, 0x4f, 0x7a, 0x09, 0x93,
+	0xdd, 0xe9, 0x64, 0x70, 0x77, 0x66, 0xbb, 0xb3, 0x1b, 0x12, 0x4f, 0xe2, 0xcd, 0x9b, 0x78, 0x12,
+	0x04, 0xbf, 0x81, 0x90, 0x8f, 0xd1, 0x63, 0x8f, 0x9e, 0x54, 0x92, 0x43, 0x3f, 0x81, 0x57, 0x91,
+	0xfd, 0x93, 0xcd, 0x9f, 0x26, 0x6d, 0x4c, 0x4f, 0x3b, 0xef, 0xfd, 0xde, 0xef, 0xcd, 0xef, 0xbd,
+	0xb7, 0x8f, 0x81, 0x8f, 0xac, 0xba, 0x43, 0xb8, 0x64, 0x82, 0xd7, 0xea, 0xef, 0x50, 0x6a, 0x20,
+	0x9b, 0xd1, 0x8a, 0x6f, 0xda, 0x8c, 0x70, 0x1f, 0xf9, 0x35, 0xdd, 0xf5, 0x84, 0x2f, 0x94, 0x7c,
+	0x77, 0xb0, 0x9e, 0x1a, 0x7a, 0x57, 0xb0, 0x7a, 0xd7, 0x14, 0xd2, 0x11, 0x12, 0x39, 0x92, 0xa2,
+	0xea, 0x7a, 0xf8, 0x89, 0xc9, 0xea, 0x1c, 0x15, 0x54, 0x44, 0x47, 0x14, 0x9e, 0x12, 0xef, 0x12,
+	0x15, 0x82, 0xda, 0x04, 0x61, 0x97, 0x21, 0xcc, 0xb9, 0xf0, 0xb1, 0xcf, 0x04, 0x97, 0x09, 0xba,
+	0x90, 0xa0, 0x91, 0x55, 0x0e, 0x8e, 0x10, 0xe6, 0xf5, 0x04, 0xd2, 0xfa, 0x21, 0x2b, 0xf0, 0x22,
+	0x6e, 0x8c, 0xe7, 0x5f, 0xc3, 0x3b, 0x45, 0x49, 0x0f, 0x89, 0xbf, 0x83, 0xb9, 0xe0, 0xcc, 0xc4,
+	0xf6, 0x4e, 0x24, 0x50, 0x99, 0x87, 0x53, 0x92, 0x51, 0x4e, 0xbc, 0x1c, 0xb8, 0x07, 0x56, 0xb3,
+	0x46, 0x62, 0x29, 0x8b, 0x30, 0x1b, 0x97, 0x50, 0x62, 0x56, 0xee, 0x5a, 0x04, 0x4d, 0xc7, 0x8e,
+	0x7d, 0x6b, 0x73, 0xe6, 0xc3, 0x59, 0x63, 0x2d, 0x89, 0xcc, 0xaf, 0xc0, 0xe5, 0x81, 0xa9, 0x0d,
+	0x22, 0x5d, 0xc1, 0x25, 0xc9, 0x37, 0x00, 0xd4, 0xc2, 0x88, 0xa0, 0xec, 0x30, 0xff, 0x90, 0x1c,
+	0x07, 0x84, 0x9b, 0xc4, 0xdb, 0x3b, 0x0e, 0x58, 0x55, 0x98, 0x91, 0x48, 0x65, 0x09, 0x66, 0x65,
+	0x04, 0xfb, 0xa9, 0x90, 0x8e, 0x43, 0x41, 0x70, 0xba, 0x42, 0xb0, 0x45, 0xbc, 0xd2, 0x7a, 0x24,
+	0x65, 0xa6, 0x30, 0xa7, 0xc7, 0xf5, 0xea, 0xed, 0x7a, 0xf5, 0x2d, 0x5e, 0x37, 0x6e, 0xc4, 0x51,
+	0xeb, 0x5d, 0x84, 0x42, 0x6e, 0xe2, 0x72, 0x42, 0x61, 0xf3, 0x56, 0x58, 0x50, 0xe7, 0xc6, 0xfc,
+	0x2a, 0x7c, 0x78, 0xb1, 0xe2, 0xb4, 0xb8, 0xaf, 0x00, 0x2e, 0x14, 0x25, 0x35, 0x88, 0x29, 0xaa,
+	0xc4, 0x1b, 0xb5, 0xbb, 0xcb, 0x10, 0x7a, 0xc2, 0xb6, 0xb1, 0xeb, 0x76, 0xda, 0x9b, 0x4d, 0x3c,
+	0xfb, 0x56, 0x6f, 0xf3, 0x27, 0x7a, 0x9b, 0x1f, 0x72, 0xcd, 0x0a, 0xe6, 0x9c, 0xd8, 0x21, 0x3a,
+	0x19, 0x73, 0x13, 0x4f, 0xff, 0x6c, 0x1e, 0xc0, 0xfb, 0x43, 0xc5, 0xa5, 0x25, 0xfc, 0x01, 0x50,
+	0x49, 0x26, 0x18, 0x01, 0x07, 0xd8, 0xc3, 0x8e, 0x1c, 0x57, 0xfb, 0x2b, 0x78, 0x3b, 0xe0, 0x65,
+	0xc1, 0x2d, 0xc6, 0x69, 0xc9, 0x25, 0x1e, 0x13, 0x56, 0x32, 0x83, 0x85, 0x73, 0x33, 0xd8, 0x4d,
+	0x7e, 0xd2, 0xed, 0xe9, 0x93, 0x9f, 0x2b, 0x99, 0x2f, 0xbf, 0x56, 0x80, 0x31, 0x9b, 0x92, 0x0f,
+	0x22, 0xae, 0xf2, 0x12, 0xce, 0x3a, 0xb8, 0x56, 0x32, 0x6d, 0x61, 0xbe, 0x2d, 0x59, 0x1e, 0x3b,
+	0xf2, 0x73, 0x93, 0xa3, 0xa7, 0xbb, 0xe9, 0xe0, 0xda, 0x4e, 0x48, 0xdd, 0x0d, 0x99, 0xbd, 0xcd,
+	0x59, 0x82, 0xea, 0xf9, 0xb2, 0xdb, 0x5d, 0x29, 0xfc, 0x9d, 0x84, 0x13, 0x45, 0x49, 0x95, 0xcf,
+	0x00, 0x2a, 0x03, 0xf6, 0xe6, 0xa9, 0x7e, 0xf9, 0xf6, 0xeb, 0x03, 0xf7, 0x42, 0xdd, 0x1a, 0x9b,
+	0xda, 0x16, 0xa7, 0x7c, 0x07, 0x70, 0xf1, 0xa2, 0x7d, 0xda, 0x1e, 0xf5, 0x8a, 0xe1, 0x39, 0xd4,
+	0x17, 0x57, 0xcf, 0x91, 0xea, 0xfd, 0x06, 0xe0, 0xfc, 0x90, 0x15, 0x79, 0x36, 0xe2, 0x35, 0x83,
+	0xe9, 0xea, 0xde, 0x95, 0xe8, 0xa9, 0xc0, 0x8f, 0x00, 0xce, 0xf6, 0x2f, 0xc0, 0xe3, 0xff, 0x98,
+	0x53, 0x17, 0x4f, 0x7d, 0x3e, 0x1e, 0xaf, 0xad, 0x45, 0xbd, 0xfe, 0xfe, 0xac, 0xb1, 0x06, 0xb6,
+	0x8d, 0x93, 0xa6, 0x06, 0x4e, 0x9b, 0x1a, 0xf8, 0xdd, 0xd4, 0xc0, 0xa7, 0x96, 0x96, 0x39, 0x6d,
+	0x69, 0x99, 0x1f, 0x2d, 0x2d, 0xf3, 0xe6, 0x09, 0x65, 0x7e, 0x25, 0x28, 0xeb, 0xa6, 0x70, 0xd0,
+	0x90, 0x07, 0xab, 0xba, 0x81, 0x6a, 0xbd, 0xaf, 0x56, 0xdd, 0x25, 0xb2, 0x3c, 0x15, 0xed, 0xca,
+	0xc6, 0xbf, 0x01, 0x00, 0x9e, 0x59, 0x35, 0xf0, 0xe8, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -169,6 +521,9 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
 	SetCanonicalClient(ctx context.Context, in *MsgSetCanonicalClient, opts ...grpc.CallOption) (*MsgSetCanonicalClientResponse, error)
+	SubmitSequencerEquivocation(ctx context.Context, in *MsgSubmitSequencerEquivocation, opts ...grpc.CallOption) (*MsgSubmitSequencerEquivocationResponse, error)
+	RecoverCanonicalClient(ctx context.Context, in *MsgRecoverCanonicalClient, opts ...grpc.CallOption) (*MsgRecoverCanonicalClientResponse, error)
+	SetClientParams(ctx context.Context, in *MsgSetClientParams, opts ...grpc.CallOption) (*MsgSetClientParamsResponse, error)
 }
 
 type msgClient struct {
@@ -188,9 +543,39 @@ func (c *msgClient) SetCanonicalClient(ctx context.Context, in *MsgSetCanonicalC
 	return out, nil
 }
 
+func (c *msgClient) SubmitSequencerEquivocation(ctx context.Context, in *MsgSubmitSequencerEquivocation, opts ...grpc.CallOption) (*MsgSubmitSequencerEquivocationResponse, error) {
+	out := new(MsgSubmitSequencerEquivocationResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Msg/SubmitSequencerEquivocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RecoverCanonicalClient(ctx context.Context, in *MsgRecoverCanonicalClient, opts ...grpc.CallOption) (*MsgRecoverCanonicalClientResponse, error) {
+	out := new(MsgRecoverCanonicalClientResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Msg/RecoverCanonicalClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetClientParams(ctx context.Context, in *MsgSetClientParams, opts ...grpc.CallOption) (*MsgSetClientParamsResponse, error) {
+	out := new(MsgSetClientParamsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Msg/SetClientParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	SetCanonicalClient(context.Context, *MsgSetCanonicalClient) (*MsgSetCanonicalClientResponse, error)
+	SubmitSequencerEquivocation(context.Context, *MsgSubmitSequencerEquivocation) (*MsgSubmitSequencerEquivocationResponse, error)
+	RecoverCanonicalClient(context.Context, *MsgRecoverCanonicalClient) (*MsgRecoverCanonicalClientResponse, error)
+	SetClientParams(context.Context, *MsgSetClientParams) (*MsgSetClientParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -200,6 +585,15 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) SetCanonicalClient(ctx context.Context, req *MsgSetCanonicalClient) (*MsgSetCanonicalClientResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetCanonicalClient not implemented")
 }
+func (*UnimplementedMsgServer) SubmitSequencerEquivocation(ctx context.Context, req *MsgSubmitSequencerEquivocation) (*MsgSubmitSequencerEquivocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSequencerEquivocation not implemented")
+}
+func (*UnimplementedMsgServer) RecoverCanonicalClient(ctx context.Context, req *MsgRecoverCanonicalClient) (*MsgRecoverCanonicalClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverCanonicalClient not implemented")
+}
+func (*UnimplementedMsgServer) SetClientParams(ctx context.Context, req *MsgSetClientParams) (*MsgSetClientParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetClientParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -223,6 +617,60 @@ func _Msg_SetCanonicalClient_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitSequencerEquivocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitSequencerEquivocation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitSequencerEquivocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.lightclient.Msg/SubmitSequencerEquivocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitSequencerEquivocation(ctx, req.(*MsgSubmitSequencerEquivocation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RecoverCanonicalClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecoverCanonicalClient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RecoverCanonicalClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.lightclient.Msg/RecoverCanonicalClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecoverCanonicalClient(ctx, req.(*MsgRecoverCanonicalClient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetClientParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetClientParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetClientParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.lightclient.Msg/SetClientParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetClientParams(ctx, req.(*MsgSetClientParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dymensionxyz.dymension.lightclient.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -231,6 +679,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetCanonicalClient",
 			Handler:    _Msg_SetCanonicalClient_Handler,
 		},
+		{
+			MethodName: "SubmitSequencerEquivocation",
+			Handler:    _Msg_SubmitSequencerEquivocation_Handler,
+		},
+		{
+			MethodName: "RecoverCanonicalClient",
+			Handler:    _Msg_RecoverCanonicalClient_Handler,
+		},
+		{
+			MethodName: "SetClientParams",
+			Handler:    _Msg_SetClientParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dymensionxyz/dymension/lightclient/tx.proto",
@@ -296,13 +756,240 @@ func (m *MsgSetCanonicalClientResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSubmitSequencerEquivocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitSequencerEquivocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitSequencerEquivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Header_2 != nil {
+		{
+			size, err := m.Header_2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Header_1 != nil {
+		{
+			size, err := m.Header_1.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitSequencerEquivocationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitSequencerEquivocationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitSequencerEquivocationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecoverCanonicalClient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecoverCanonicalClient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverCanonicalClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecoverCanonicalClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecoverCanonicalClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverCanonicalClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetClientParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetClientParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetClientParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxClockDrift, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxClockDrift):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetClientParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetClientParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetClientParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
 	dAtA[offset] = uint8(v)
 	return base
@@ -333,6 +1020,100 @@ func (m *MsgSetCanonicalClientResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSubmitSequencerEquivocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Header_1 != nil {
+		l = m.Header_1.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Header_2 != nil {
+		l = m.Header_2.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitSequencerEquivocationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRecoverCanonicalClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRecoverCanonicalClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetClientParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxClockDrift)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetClientParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -503,6 +1284,668 @@ func (m *MsgSetCanonicalClientResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSubmitSequencerEquivocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitSequencerEquivocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitSequencerEquivocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header_1", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header_1 == nil {
+				m.Header_1 = &types.Any{}
+			}
+			if err := m.Header_1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header_2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header_2 == nil {
+				m.Header_2 = &types.Any{}
+			}
+			if err := m.Header_2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitSequencerEquivocationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitSequencerEquivocationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitSequencerEquivocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecoverCanonicalClient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecoverCanonicalClient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecoverCanonicalClient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecoverCanonicalClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecoverCanonicalClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecoverCanonicalClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetClientParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetClientParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetClientParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxClockDrift", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxClockDrift, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetClientParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetClientParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetClientParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
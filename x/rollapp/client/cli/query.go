@@ -27,6 +27,9 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdShowLatestHeight())
 	cmd.AddCommand(CmdShowLatestStateIndex())
 	cmd.AddCommand(CmdQueryRegisteredDenoms())
+	cmd.AddCommand(CmdShowOwnershipTransfer())
+	cmd.AddCommand(CmdShowScheduledDRSUpgrade())
+	cmd.AddCommand(CmdShowMaintenanceWindow())
 
 	return cmd
 }
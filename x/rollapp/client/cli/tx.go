@@ -22,6 +22,11 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdCreateRollapp())
 	cmd.AddCommand(CmdUpdateRollapp())
 	cmd.AddCommand(CmdTransferOwnership())
+	cmd.AddCommand(CmdAcceptOwnership())
+	cmd.AddCommand(CmdCancelOwnershipTransfer())
+	cmd.AddCommand(CmdSunsetRollapp())
+	cmd.AddCommand(CmdScheduleDRSUpgrade())
+	cmd.AddCommand(CmdDeclareMaintenanceWindow())
 	cmd.AddCommand(CmdAddApp())
 	cmd.AddCommand(CmdUpdateApp())
 	cmd.AddCommand(CmdRemoveApp())
@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func CmdDeclareMaintenanceWindow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "declare-maintenance-window [rollapp-id] [start-height] [end-height]",
+		Short:   "Declare a range of hub heights during which the rollapp is not slashed for liveness",
+		Example: "dymd tx rollapp declare-maintenance-window ROLLAPP_CHAIN_ID 1000000 1001000",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argRollappId := args[0]
+
+			startHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			endHeight, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeclareMaintenanceWindow(
+				clientCtx.GetFromAddress().String(),
+				argRollappId,
+				startHeight,
+				endHeight,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func CmdScheduleDRSUpgrade() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "schedule-drs-upgrade [rollapp-id] [height] [drs-version]",
+		Short:   "Schedule a DRS upgrade of a rollapp at the given rollapp height",
+		Example: "dymd tx rollapp schedule-drs-upgrade ROLLAPP_CHAIN_ID 1000000 3",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argRollappId := args[0]
+
+			height, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			drsVersion, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgScheduleDRSUpgrade(
+				clientCtx.GetFromAddress().String(),
+				argRollappId,
+				height,
+				uint32(drsVersion),
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
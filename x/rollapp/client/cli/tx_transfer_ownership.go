@@ -38,3 +38,59 @@ func CmdTransferOwnership() *cobra.Command {
 
 	return cmd
 }
+
+func CmdAcceptOwnership() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "accept-ownership [rollapp-id]",
+		Short:   "Accept a pending ownership transfer of a rollapp",
+		Example: "dymd tx rollapp accept-ownership ROLLAPP_CHAIN_ID",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argRollappId := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptOwnership(
+				clientCtx.GetFromAddress().String(),
+				argRollappId,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCancelOwnershipTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-ownership-transfer [rollapp-id]",
+		Short:   "Cancel a pending ownership transfer of a rollapp",
+		Example: "dymd tx rollapp cancel-ownership-transfer ROLLAPP_CHAIN_ID",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argRollappId := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelOwnershipTransfer(
+				clientCtx.GetFromAddress().String(),
+				argRollappId,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -59,6 +59,30 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		}
 	}
 
+	// Set all the pending ownership transfers
+	for _, elem := range genState.OwnershipTransfers {
+		err := k.SetOwnershipTransfer(ctx, elem)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Set all the scheduled DRS upgrades
+	for _, elem := range genState.ScheduledDrsUpgrades {
+		err := k.SetScheduledDRSUpgrade(ctx, elem)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Set all the maintenance windows
+	for _, elem := range genState.MaintenanceWindows {
+		err := k.SetMaintenanceWindow(ctx, elem)
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	k.SetParams(ctx, genState.Params)
 }
 
@@ -108,5 +132,23 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	}
 	genesis.ObsoleteDrsVersions = drsVersions
 
+	ownershipTransfers, err := k.GetAllOwnershipTransfers(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.OwnershipTransfers = ownershipTransfers
+
+	scheduledDrsUpgrades, err := k.GetAllScheduledDRSUpgrades(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.ScheduledDrsUpgrades = scheduledDrsUpgrades
+
+	maintenanceWindows, err := k.GetAllMaintenanceWindows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.MaintenanceWindows = maintenanceWindows
+
 	return genesis
 }
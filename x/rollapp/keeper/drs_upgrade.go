@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (k Keeper) SetScheduledDRSUpgrade(ctx sdk.Context, upgrade types.ScheduledDRSUpgrade) error {
+	return k.scheduledDRSUpgrades.Set(ctx, upgrade.RollappId, upgrade)
+}
+
+func (k Keeper) GetScheduledDRSUpgrade(ctx sdk.Context, rollappID string) (types.ScheduledDRSUpgrade, bool, error) {
+	upgrade, err := k.scheduledDRSUpgrades.Get(ctx, rollappID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ScheduledDRSUpgrade{}, false, nil
+	}
+	if err != nil {
+		return types.ScheduledDRSUpgrade{}, false, err
+	}
+	return upgrade, true, nil
+}
+
+func (k Keeper) DeleteScheduledDRSUpgrade(ctx sdk.Context, rollappID string) error {
+	return k.scheduledDRSUpgrades.Remove(ctx, rollappID)
+}
+
+func (k Keeper) GetAllScheduledDRSUpgrades(ctx sdk.Context) ([]types.ScheduledDRSUpgrade, error) {
+	iter, err := k.scheduledDRSUpgrades.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// CheckScheduledDRSUpgrade verifies that all the blocks of the state at or past the scheduled upgrade height
+// report the new DRS version. Once the rollapp reported the new version, the upgrade is considered done.
+func (k Keeper) CheckScheduledDRSUpgrade(ctx sdk.Context, stateInfo *types.StateInfo) error {
+	upgrade, found, err := k.GetScheduledDRSUpgrade(ctx, stateInfo.GetRollappId())
+	if err != nil {
+		return err
+	}
+	if !found {
+		return nil
+	}
+
+	upgraded := false
+	for _, bd := range stateInfo.BDs.BD {
+		if bd.Height < upgrade.Height {
+			continue
+		}
+		if bd.DrsVersion != upgrade.DrsVersion {
+			return errorsmod.Wrapf(types.ErrInvalidDRSVersion,
+				"scheduled DRS upgrade not applied: height: %d: expected: %d: got: %d",
+				bd.Height, upgrade.DrsVersion, bd.DrsVersion)
+		}
+		upgraded = true
+	}
+
+	if upgraded {
+		return k.DeleteScheduledDRSUpgrade(ctx, upgrade.RollappId)
+	}
+	return nil
+}
@@ -63,6 +63,7 @@ func getSummaryResponse(ctx sdk.Context, k Keeper, rollapp types.Rollapp, ok, wi
 
 	s := types.RollappSummary{
 		RollappId: rollapp.RollappId,
+		Retired:   rollapp.IsRetired(ctx.BlockTime()),
 	}
 	latestStateInfoIndex, found := k.GetLatestStateInfoIndex(ctx, rollapp.RollappId)
 	if found {
@@ -208,6 +208,16 @@ func (k Keeper) IsFirstHeightOfLatestFork(ctx sdk.Context, rollappId string, rev
 	return rollapp.DidFork() && rollapp.IsRevisionStartHeight(revision, height) && revision == latest
 }
 
+// IsFrozen returns true if the rollapp hard forked and no state of the new revision was submitted yet.
+// Until then, the canonical light client of the rollapp stays frozen.
+func (k Keeper) IsFrozen(ctx sdk.Context, rollapp types.Rollapp) bool {
+	if !rollapp.DidFork() {
+		return false
+	}
+	lastHeight, _ := k.GetLatestHeight(ctx, rollapp.RollappId)
+	return lastHeight < rollapp.LatestRevision().StartHeight
+}
+
 // is forking to the latest height going to violate assumptions?
 func (k Keeper) ForkLatestAllowed(ctx sdk.Context, rollapp string) bool {
 	lastHeight, _ := k.GetLatestHeight(ctx, rollapp)
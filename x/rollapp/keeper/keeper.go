@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/collections/indexes"
@@ -48,6 +49,15 @@ type Keeper struct {
 
 	finalizePending        func(ctx sdk.Context, stateInfoIndex types.StateInfoIndex) error
 	seqToUnfinalizedHeight collections.KeySet[collections.Pair[string, uint64]]
+	// ownershipTransfers is a map from rollappID to the pending ownership transfer.
+	ownershipTransfers collections.Map[string, types.OwnershipTransfer]
+	// ownershipTransferExpiries indexes the pending ownership transfers by expiry, for pruning.
+	// Key: (expiry, rollappID).
+	ownershipTransferExpiries collections.KeySet[collections.Pair[time.Time, string]]
+	// scheduledDRSUpgrades is a map from rollappID to the pending DRS upgrade.
+	scheduledDRSUpgrades collections.Map[string, types.ScheduledDRSUpgrade]
+	// maintenanceWindows is a map from rollappID to the latest declared maintenance window.
+	maintenanceWindows collections.Map[string, types.MaintenanceWindow]
 }
 
 func NewKeeper(
@@ -111,6 +121,33 @@ func NewKeeper(
 			"seq_to_unfinalized_height",
 			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
 		),
+		ownershipTransfers: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.OwnershipTransferKeyPrefix),
+			"ownership_transfers",
+			collections.StringKey,
+			collcompat.ProtoValue[types.OwnershipTransfer](cdc),
+		),
+		ownershipTransferExpiries: collections.NewKeySet(
+			sb,
+			collections.NewPrefix(types.OwnershipTransferExpiryKeyPrefix),
+			"ownership_transfer_expiries",
+			collections.PairKeyCodec(sdk.TimeKey, collections.StringKey),
+		),
+		scheduledDRSUpgrades: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.ScheduledDRSUpgradeKeyPrefix),
+			"scheduled_drs_upgrades",
+			collections.StringKey,
+			collcompat.ProtoValue[types.ScheduledDRSUpgrade](cdc),
+		),
+		maintenanceWindows: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.MaintenanceWindowKeyPrefix),
+			"maintenance_windows",
+			collections.StringKey,
+			collcompat.ProtoValue[types.MaintenanceWindow](cdc),
+		),
 	}
 	k.SetFinalizePendingFn(k.finalizePendingState)
 	return k
@@ -58,13 +58,29 @@ func (k Keeper) CheckLiveness(ctx sdk.Context) {
 }
 
 // HandleLivenessEvent will slash or jail and then schedule a new event in the future.
+// Retired rollapps are not expected to be live, so the event is dropped.
+// During a maintenance window, the event is postponed to after the window instead.
 func (k Keeper) HandleLivenessEvent(ctx sdk.Context, e types.LivenessEvent) error {
+	ra := k.MustGetRollapp(ctx, e.RollappId)
+	if ra.IsRetired(ctx.BlockTime()) {
+		k.DelLivenessEvents(ctx, e.HubHeight, e.RollappId)
+		ra.LivenessEventHeight = 0
+		k.SetRollapp(ctx, ra)
+		return nil
+	}
+	if _, ok := k.ActiveMaintenanceWindow(ctx, e.RollappId); ok {
+		k.DelLivenessEvents(ctx, e.HubHeight, e.RollappId)
+		k.IndicateLiveness(ctx, &ra)
+		k.SetRollapp(ctx, ra)
+		return nil
+	}
+
 	err := k.SequencerK.SlashLiveness(ctx, e.RollappId)
 	if err != nil {
 		return errorsmod.Wrap(err, "slash liveness")
 	}
 
-	ra := k.MustGetRollapp(ctx, e.RollappId)
+	ra = k.MustGetRollapp(ctx, e.RollappId)
 	k.DelLivenessEvents(ctx, e.HubHeight, e.RollappId)
 	k.ScheduleLivenessEvent(ctx, &ra)
 	k.SetRollapp(ctx, ra)
@@ -77,20 +93,29 @@ func (k Keeper) IndicateLiveness(ctx sdk.Context, ra *types.Rollapp) {
 }
 
 // ResetLivenessClock will reschedule pending liveness events to a later block height.
+// During a maintenance window, the clock is held until the end of the window.
 // Modifies the passed-in rollapp object.
 func (k Keeper) ResetLivenessClock(ctx sdk.Context, ra *types.Rollapp) {
 	k.DelLivenessEvents(ctx, ra.LivenessEventHeight, ra.RollappId)
 	ra.LivenessEventHeight = 0
 	ra.LivenessCountdownStartHeight = ctx.BlockHeight()
+	if w, ok := k.ActiveMaintenanceWindow(ctx, ra.RollappId); ok {
+		ra.LivenessCountdownStartHeight = w.EndHeight
+	}
 }
 
 // ScheduleLivenessEvent schedules a new liveness event. Assumes an event does not
 // already exist for the rollapp. Modifies the passed-in rollapp object.
+// Nothing is scheduled for retired rollapps.
 func (k Keeper) ScheduleLivenessEvent(ctx sdk.Context, ra *types.Rollapp) {
+	if ra.IsRetired(ctx.BlockTime()) {
+		return
+	}
 	nextH := NextSlashHeight(
 		k.LivenessSlashBlocks(ctx),
 		k.LivenessSlashInterval(ctx),
-		ctx.BlockHeight(),
+		// the countdown starts in the future if the clock is held by a maintenance window
+		max(ctx.BlockHeight(), ra.LivenessCountdownStartHeight),
 		ra.LivenessCountdownStartHeight,
 	)
 	ra.LivenessEventHeight = nextH
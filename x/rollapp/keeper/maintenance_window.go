@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (k Keeper) SetMaintenanceWindow(ctx sdk.Context, w types.MaintenanceWindow) error {
+	return k.maintenanceWindows.Set(ctx, w.RollappId, w)
+}
+
+// GetMaintenanceWindow returns the latest maintenance window declared for the rollapp
+func (k Keeper) GetMaintenanceWindow(ctx sdk.Context, rollappID string) (types.MaintenanceWindow, bool, error) {
+	w, err := k.maintenanceWindows.Get(ctx, rollappID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.MaintenanceWindow{}, false, nil
+	}
+	if err != nil {
+		return types.MaintenanceWindow{}, false, err
+	}
+	return w, true, nil
+}
+
+func (k Keeper) GetAllMaintenanceWindows(ctx sdk.Context) ([]types.MaintenanceWindow, error) {
+	iter, err := k.maintenanceWindows.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// ActiveMaintenanceWindow returns the maintenance window of the rollapp, if the current hub height is within it
+func (k Keeper) ActiveMaintenanceWindow(ctx sdk.Context, rollappID string) (types.MaintenanceWindow, bool) {
+	w, found, err := k.GetMaintenanceWindow(ctx, rollappID)
+	if err != nil || !found || !w.Active(ctx.BlockHeight()) {
+		return types.MaintenanceWindow{}, false
+	}
+	return w, true
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// DeclareMaintenanceWindow declares a future range of hub heights during which the rollapp is not slashed
+// for liveness. Can be submitted either by the rollapp owner or by the current proposer. The length and the
+// frequency of the windows are bounded by params.
+func (k msgServer) DeclareMaintenanceWindow(goCtx context.Context, msg *types.MsgDeclareMaintenanceWindow) (*types.MsgDeclareMaintenanceWindowResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rollapp, ok := k.GetRollapp(ctx, msg.RollappId)
+	if !ok {
+		return nil, types.ErrUnknownRollappID
+	}
+
+	if msg.Creator != rollapp.Owner && msg.Creator != k.SequencerK.GetProposer(ctx, msg.RollappId).Address {
+		return nil, types.ErrUnauthorizedSigner
+	}
+
+	// the window must be declared in advance
+	if msg.StartHeight <= ctx.BlockHeight() {
+		return nil, errorsmod.Wrapf(gerrc.ErrInvalidArgument,
+			"start height must be greater than the current height: start: %d: current: %d", msg.StartHeight, ctx.BlockHeight())
+	}
+
+	if maxBlocks := k.MaintenanceWindowMaxBlocks(ctx); maxBlocks < uint64(msg.EndHeight-msg.StartHeight) {
+		return nil, errorsmod.Wrapf(gerrc.ErrInvalidArgument,
+			"window is too long: blocks: %d: max: %d", msg.EndHeight-msg.StartHeight, maxBlocks)
+	}
+
+	prev, found, err := k.GetMaintenanceWindow(ctx, msg.RollappId)
+	if err != nil {
+		return nil, fmt.Errorf("get maintenance window: %w", err)
+	}
+	if found {
+		if ctx.BlockHeight() < prev.EndHeight {
+			return nil, errorsmod.Wrap(gerrc.ErrFailedPrecondition, "previous maintenance window not over")
+		}
+		if next := prev.StartHeight + int64(k.MaintenanceWindowMinInterval(ctx)); msg.StartHeight < next {
+			return nil, errorsmod.Wrapf(gerrc.ErrFailedPrecondition,
+				"maintenance windows are too frequent: earliest start: %d", next)
+		}
+	}
+
+	w := types.MaintenanceWindow{
+		RollappId:   msg.RollappId,
+		StartHeight: msg.StartHeight,
+		EndHeight:   msg.EndHeight,
+		Declarer:    msg.Creator,
+	}
+	if err := k.SetMaintenanceWindow(ctx, w); err != nil {
+		return nil, fmt.Errorf("set maintenance window: %w", err)
+	}
+
+	err = uevent.EmitTypedEvent(ctx, &types.EventMaintenanceWindowDeclared{
+		RollappId:   w.RollappId,
+		StartHeight: w.StartHeight,
+		EndHeight:   w.EndHeight,
+		Declarer:    w.Declarer,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("emit event: %w", err)
+	}
+
+	return &types.MsgDeclareMaintenanceWindowResponse{}, nil
+}
@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (s *RollappTestSuite) TestDeclareMaintenanceWindow() {
+	s.Ctx = s.Ctx.WithBlockHeight(1)
+	p := s.k().GetParams(s.Ctx)
+	p.LivenessSlashBlocks = 10
+	p.MaintenanceWindowMaxBlocks = 10
+	p.MaintenanceWindowMinInterval = 100
+	s.k().SetParams(s.Ctx, p)
+
+	rollappId, proposer := s.CreateDefaultRollappAndProposer()
+	tracker := newLivenessMockSequencerKeeper(s.k().SequencerK)
+	s.k().SetSequencerKeeper(tracker)
+
+	// only the owner or the proposer can declare
+	_, err := s.msgServer.DeclareMaintenanceWindow(s.Ctx, types.NewMsgDeclareMaintenanceWindow(bob, rollappId, 10, 20))
+	s.Require().ErrorIs(err, types.ErrUnauthorizedSigner)
+
+	// must be declared in advance
+	_, err = s.msgServer.DeclareMaintenanceWindow(s.Ctx, types.NewMsgDeclareMaintenanceWindow(proposer, rollappId, 1, 10))
+	s.Require().ErrorIs(err, gerrc.ErrInvalidArgument)
+
+	// cannot be too long
+	_, err = s.msgServer.DeclareMaintenanceWindow(s.Ctx, types.NewMsgDeclareMaintenanceWindow(proposer, rollappId, 10, 21))
+	s.Require().ErrorIs(err, gerrc.ErrInvalidArgument)
+
+	_, err = s.msgServer.DeclareMaintenanceWindow(s.Ctx, types.NewMsgDeclareMaintenanceWindow(proposer, rollappId, 10, 20))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(s.Ctx, proto.MessageName(new(types.EventMaintenanceWindowDeclared)), 1)
+
+	// cannot declare another one before the window is over
+	_, err = s.msgServer.DeclareMaintenanceWindow(s.Ctx, types.NewMsgDeclareMaintenanceWindow(proposer, rollappId, 30, 40))
+	s.Require().ErrorIs(err, gerrc.ErrFailedPrecondition)
+
+	resp, err := s.k().MaintenanceWindow(s.Ctx, &types.QueryMaintenanceWindowRequest{RollappId: rollappId})
+	s.Require().NoError(err)
+	s.Require().Equal(types.MaintenanceWindow{RollappId: rollappId, StartHeight: 10, EndHeight: 20, Declarer: proposer}, resp.Window)
+	s.Require().False(resp.Active)
+
+	// the rollapp is live before the window, so the event is due during the window
+	s.Ctx = s.Ctx.WithBlockHeight(5)
+	ra := s.k().MustGetRollapp(s.Ctx, rollappId)
+	s.k().IndicateLiveness(s.Ctx, &ra)
+	s.k().SetRollapp(s.Ctx, ra)
+	s.Require().Equal(int64(15), ra.LivenessEventHeight)
+
+	// the event is postponed to after the window
+	s.Ctx = s.Ctx.WithBlockHeight(15)
+	s.k().CheckLiveness(s.Ctx)
+	s.Require().Zero(tracker.slashes[rollappId])
+	ra = s.k().MustGetRollapp(s.Ctx, rollappId)
+	s.Require().Equal(int64(20), ra.LivenessCountdownStartHeight)
+	s.Require().Equal(int64(30), ra.LivenessEventHeight)
+	msg, broken := keeper.LivenessEventInvariant(*s.k())(s.Ctx)
+	s.Require().False(broken, msg)
+
+	// the clock holds during the window
+	s.Ctx = s.Ctx.WithBlockHeight(18)
+	ra = s.k().MustGetRollapp(s.Ctx, rollappId)
+	s.k().IndicateLiveness(s.Ctx, &ra)
+	s.k().SetRollapp(s.Ctx, ra)
+	s.Require().Equal(int64(20), ra.LivenessCountdownStartHeight)
+	s.Require().Equal(int64(30), ra.LivenessEventHeight)
+
+	// the rollapp is slashed once the window is over
+	s.Ctx = s.Ctx.WithBlockHeight(30)
+	s.k().CheckLiveness(s.Ctx)
+	s.Require().Equal(1, tracker.slashes[rollappId])
+	msg, broken = keeper.LivenessEventInvariant(*s.k())(s.Ctx)
+	s.Require().False(broken, msg)
+
+	// windows are limited in frequency
+	_, err = s.msgServer.DeclareMaintenanceWindow(s.Ctx, types.NewMsgDeclareMaintenanceWindow(proposer, rollappId, 100, 110))
+	s.Require().ErrorIs(err, gerrc.ErrFailedPrecondition)
+	_, err = s.msgServer.DeclareMaintenanceWindow(s.Ctx, types.NewMsgDeclareMaintenanceWindow(proposer, rollappId, 110, 120))
+	s.Require().NoError(err)
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// ScheduleDRSUpgrade schedules a DRS upgrade of the rollapp at a rollapp height. Can be submitted either by
+// the gov module or by the rollapp owner. A new schedule replaces the pending one.
+func (k msgServer) ScheduleDRSUpgrade(goCtx context.Context, msg *types.MsgScheduleDRSUpgrade) (*types.MsgScheduleDRSUpgradeResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rollapp, ok := k.GetRollapp(ctx, msg.RollappId)
+	if !ok {
+		return nil, types.ErrUnknownRollappID
+	}
+
+	if msg.Signer != k.authority && msg.Signer != rollapp.Owner {
+		return nil, types.ErrUnauthorizedSigner
+	}
+
+	if k.IsDRSVersionObsolete(ctx, msg.DrsVersion) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidDRSVersion, "obsolete DRS version: %d", msg.DrsVersion)
+	}
+
+	// the upgrade can only happen at a height the rollapp did not produce yet
+	latestHeight, ok := k.GetLatestHeight(ctx, msg.RollappId)
+	if ok && msg.Height <= latestHeight {
+		return nil, errorsmod.Wrapf(gerrc.ErrInvalidArgument,
+			"upgrade height must be greater than the latest height: upgrade: %d: latest: %d", msg.Height, latestHeight)
+	}
+
+	err := k.SetScheduledDRSUpgrade(ctx, types.ScheduledDRSUpgrade{
+		RollappId:  msg.RollappId,
+		Height:     msg.Height,
+		DrsVersion: msg.DrsVersion,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("set scheduled DRS upgrade: %w", err)
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, msg); err != nil {
+		return nil, fmt.Errorf("emit event: %w", err)
+	}
+
+	return &types.MsgScheduleDRSUpgradeResponse{}, nil
+}
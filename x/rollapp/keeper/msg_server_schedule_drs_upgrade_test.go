@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (s *RollappTestSuite) TestScheduleDRSUpgrade() {
+	govModule := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	rollappId, proposer := s.CreateDefaultRollappAndProposer()
+	owner := s.k().MustGetRollapp(s.Ctx, rollappId).Owner
+
+	_, err := s.PostStateUpdateWithDRSVersion(s.Ctx, rollappId, proposer, 1, 10, 1)
+	s.Require().NoError(err)
+
+	// only gov or the owner can schedule
+	_, err = s.msgServer.ScheduleDRSUpgrade(s.Ctx, types.NewMsgScheduleDRSUpgrade(bob, rollappId, 20, 2))
+	s.Require().ErrorIs(err, types.ErrUnauthorizedSigner)
+
+	// cannot schedule in the past
+	_, err = s.msgServer.ScheduleDRSUpgrade(s.Ctx, types.NewMsgScheduleDRSUpgrade(owner, rollappId, 10, 2))
+	s.Require().ErrorIs(err, gerrc.ErrInvalidArgument)
+
+	// cannot schedule an obsolete version
+	s.Require().NoError(s.k().SetObsoleteDRSVersion(s.Ctx, 3))
+	_, err = s.msgServer.ScheduleDRSUpgrade(s.Ctx, types.NewMsgScheduleDRSUpgrade(owner, rollappId, 20, 3))
+	s.Require().ErrorIs(err, types.ErrInvalidDRSVersion)
+
+	_, err = s.msgServer.ScheduleDRSUpgrade(s.Ctx, types.NewMsgScheduleDRSUpgrade(owner, rollappId, 20, 2))
+	s.Require().NoError(err)
+
+	// gov replaces the pending upgrade
+	_, err = s.msgServer.ScheduleDRSUpgrade(s.Ctx, types.NewMsgScheduleDRSUpgrade(govModule, rollappId, 16, 2))
+	s.Require().NoError(err)
+
+	resp, err := s.k().ScheduledDRSUpgrade(s.Ctx, &types.QueryScheduledDRSUpgradeRequest{RollappId: rollappId})
+	s.Require().NoError(err)
+	s.Require().Equal(types.ScheduledDRSUpgrade{RollappId: rollappId, Height: 16, DrsVersion: 2}, resp.Upgrade)
+
+	// states before the upgrade height keep the old version
+	_, err = s.PostStateUpdateWithDRSVersion(s.Ctx, rollappId, proposer, 11, 5, 1)
+	s.Require().NoError(err)
+
+	// states past the upgrade height with the old version are rejected
+	_, err = s.PostStateUpdateWithDRSVersion(s.Ctx, rollappId, proposer, 16, 5, 1)
+	s.Require().ErrorIs(err, types.ErrInvalidDRSVersion)
+
+	_, err = s.PostStateUpdateWithDRSVersion(s.Ctx, rollappId, proposer, 16, 5, 2)
+	s.Require().NoError(err)
+
+	// the upgrade is done
+	_, err = s.k().ScheduledDRSUpgrade(s.Ctx, &types.QueryScheduledDRSUpgradeRequest{RollappId: rollappId})
+	s.Require().Error(err)
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// SunsetRollapp announces a graceful shutdown of the rollapp. States are accepted up to the final height
+// and users get a withdrawal window to bridge their funds back. Once the window is over, the rollapp is retired.
+func (k msgServer) SunsetRollapp(goCtx context.Context, msg *types.MsgSunsetRollapp) (*types.MsgSunsetRollappResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rollapp, ok := k.GetRollapp(ctx, msg.RollappId)
+	if !ok {
+		return nil, types.ErrUnknownRollappID
+	}
+
+	if rollapp.Owner != msg.Owner {
+		return nil, types.ErrUnauthorizedSigner
+	}
+
+	if rollapp.IsSunset() {
+		return nil, errorsmod.Wrap(types.ErrRollappSunset, "already announced")
+	}
+
+	// the final height must be announced on a live revision
+	if k.IsFrozen(ctx, rollapp) {
+		return nil, types.ErrRollappFrozen
+	}
+
+	// the final height cannot be lower than what the rollapp already produced
+	latestHeight, ok := k.GetLatestHeight(ctx, msg.RollappId)
+	if ok && msg.FinalHeight < latestHeight {
+		return nil, errorsmod.Wrapf(gerrc.ErrInvalidArgument,
+			"final height is lower than the latest height: final: %d: latest: %d", msg.FinalHeight, latestHeight)
+	}
+
+	rollapp.Sunset = &types.RollappSunset{
+		FinalHeight:         msg.FinalHeight,
+		WithdrawalWindowEnd: ctx.BlockTime().Add(k.SunsetWithdrawalWindow(ctx)),
+	}
+	k.SetRollapp(ctx, rollapp)
+
+	if err := uevent.EmitTypedEvent(ctx, msg); err != nil {
+		return nil, fmt.Errorf("emit event: %w", err)
+	}
+
+	return &types.MsgSunsetRollappResponse{}, nil
+}
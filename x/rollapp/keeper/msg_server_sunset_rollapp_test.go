@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (s *RollappTestSuite) TestSunsetRollapp() {
+	rollappId, proposer := s.CreateDefaultRollappAndProposer()
+	owner := s.k().MustGetRollapp(s.Ctx, rollappId).Owner
+
+	_, err := s.PostStateUpdate(s.Ctx, rollappId, proposer, 1, 10)
+	s.Require().NoError(err)
+
+	// only the owner can sunset
+	_, err = s.msgServer.SunsetRollapp(s.Ctx, types.NewMsgSunsetRollapp(bob, rollappId, 20))
+	s.Require().ErrorIs(err, types.ErrUnauthorizedSigner)
+
+	// cannot announce a final height lower than what was already produced
+	_, err = s.msgServer.SunsetRollapp(s.Ctx, types.NewMsgSunsetRollapp(owner, rollappId, 9))
+	s.Require().ErrorIs(err, gerrc.ErrInvalidArgument)
+
+	_, err = s.msgServer.SunsetRollapp(s.Ctx, types.NewMsgSunsetRollapp(owner, rollappId, 15))
+	s.Require().NoError(err)
+
+	rollapp := s.k().MustGetRollapp(s.Ctx, rollappId)
+	s.Require().True(rollapp.IsSunset())
+	s.Require().Equal(uint64(15), rollapp.Sunset.FinalHeight)
+	s.Require().Equal(s.Ctx.BlockTime().Add(s.k().SunsetWithdrawalWindow(s.Ctx)), rollapp.Sunset.WithdrawalWindowEnd)
+
+	// cannot sunset twice
+	_, err = s.msgServer.SunsetRollapp(s.Ctx, types.NewMsgSunsetRollapp(owner, rollappId, 20))
+	s.Require().ErrorIs(err, types.ErrRollappSunset)
+
+	// states beyond the final height are rejected
+	_, err = s.PostStateUpdate(s.Ctx, rollappId, proposer, 11, 10)
+	s.Require().ErrorIs(err, types.ErrRollappSunset)
+
+	// states up to the final height are still accepted
+	_, err = s.PostStateUpdate(s.Ctx, rollappId, proposer, 11, 5)
+	s.Require().NoError(err)
+
+	resp, err := s.k().Rollapp(s.Ctx, &types.QueryGetRollappRequest{RollappId: rollappId})
+	s.Require().NoError(err)
+	s.Require().False(resp.Summary.Retired)
+
+	// withdrawal window is over
+	s.Ctx = s.Ctx.WithBlockTime(rollapp.Sunset.WithdrawalWindowEnd)
+
+	resp, err = s.k().Rollapp(s.Ctx, &types.QueryGetRollappRequest{RollappId: rollappId})
+	s.Require().NoError(err)
+	s.Require().True(resp.Summary.Retired)
+}
+
+func (s *RollappTestSuite) TestSunsetRollappStopsLiveness() {
+	tracker := newLivenessMockSequencerKeeper(s.k().SequencerK)
+	s.k().SetSequencerKeeper(tracker)
+
+	rollappId, proposer := s.CreateDefaultRollappAndProposer()
+	owner := s.k().MustGetRollapp(s.Ctx, rollappId).Owner
+
+	_, err := s.PostStateUpdate(s.Ctx, rollappId, proposer, 1, 10)
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.SunsetRollapp(s.Ctx, types.NewMsgSunsetRollapp(owner, rollappId, 10))
+	s.Require().NoError(err)
+
+	rollapp := s.k().MustGetRollapp(s.Ctx, rollappId)
+	s.Require().NotZero(rollapp.LivenessEventHeight)
+	e := types.LivenessEvent{RollappId: rollappId, HubHeight: rollapp.LivenessEventHeight}
+
+	// still slashed during the withdrawal window
+	err = s.k().HandleLivenessEvent(s.Ctx, e)
+	s.Require().NoError(err)
+	s.Require().Equal(1, tracker.slashes[rollappId])
+
+	// not slashed anymore once retired
+	rollapp = s.k().MustGetRollapp(s.Ctx, rollappId)
+	e = types.LivenessEvent{RollappId: rollappId, HubHeight: rollapp.LivenessEventHeight}
+	s.Ctx = s.Ctx.WithBlockTime(rollapp.Sunset.WithdrawalWindowEnd.Add(time.Second))
+
+	err = s.k().HandleLivenessEvent(s.Ctx, e)
+	s.Require().NoError(err)
+	s.Require().Equal(1, tracker.slashes[rollappId])
+
+	rollapp = s.k().MustGetRollapp(s.Ctx, rollappId)
+	s.Require().Zero(rollapp.LivenessEventHeight)
+	s.Require().Empty(s.k().GetLivenessEvents(s.Ctx, nil))
+}
+
+func (s *RollappTestSuite) TestSunsetFrozenRollapp() {
+	rollappId, proposer := s.CreateDefaultRollappAndProposer()
+	owner := s.k().MustGetRollapp(s.Ctx, rollappId).Owner
+
+	_, err := s.PostStateUpdate(s.Ctx, rollappId, proposer, 1, 10)
+	s.Require().NoError(err)
+
+	// the rollapp is frozen from the hard fork until a state of the new revision is submitted
+	err = s.k().HardFork(s.Ctx, rollappId, 5)
+	s.Require().NoError(err)
+	s.Require().True(s.k().IsFrozen(s.Ctx, s.k().MustGetRollapp(s.Ctx, rollappId)))
+
+	_, err = s.msgServer.SunsetRollapp(s.Ctx, types.NewMsgSunsetRollapp(owner, rollappId, 20))
+	s.Require().ErrorIs(err, types.ErrRollappFrozen)
+	s.Require().False(s.k().MustGetRollapp(s.Ctx, rollappId).IsSunset())
+}
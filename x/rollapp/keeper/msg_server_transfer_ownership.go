@@ -6,11 +6,15 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 
 	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 )
 
+// TransferOwnership proposes a transfer of the rollapp ownership. The ownership
+// is only moved once the new owner accepts it, which protects against typos in
+// the new owner address. A new proposal replaces the pending one.
 func (k msgServer) TransferOwnership(goCtx context.Context, msg *types.MsgTransferOwnership) (*types.MsgTransferOwnershipResponse, error) {
 	if err := msg.ValidateBasic(); err != nil {
 		return nil, errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
@@ -31,8 +35,15 @@ func (k msgServer) TransferOwnership(goCtx context.Context, msg *types.MsgTransf
 		return nil, types.ErrSameOwner
 	}
 
-	rollapp.Owner = msg.NewOwner
-	k.SetRollapp(ctx, rollapp)
+	transfer := types.OwnershipTransfer{
+		RollappId:    msg.RollappId,
+		CurrentOwner: msg.CurrentOwner,
+		NewOwner:     msg.NewOwner,
+		Expiry:       ctx.BlockTime().Add(k.GetParams(ctx).OwnershipTransferExpiry),
+	}
+	if err := k.SetOwnershipTransfer(ctx, transfer); err != nil {
+		return nil, fmt.Errorf("set ownership transfer: %w", err)
+	}
 
 	if err := uevent.EmitTypedEvent(ctx, msg); err != nil {
 		return nil, fmt.Errorf("emit event: %w", err)
@@ -40,3 +51,89 @@ func (k msgServer) TransferOwnership(goCtx context.Context, msg *types.MsgTransf
 
 	return &types.MsgTransferOwnershipResponse{}, nil
 }
+
+// AcceptOwnership completes a pending ownership transfer. Must be signed by the
+// proposed new owner before the transfer expires.
+func (k msgServer) AcceptOwnership(goCtx context.Context, msg *types.MsgAcceptOwnership) (*types.MsgAcceptOwnershipResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rollapp, ok := k.GetRollapp(ctx, msg.RollappId)
+	if !ok {
+		return nil, types.ErrUnknownRollappID
+	}
+
+	transfer, ok, err := k.GetOwnershipTransfer(ctx, msg.RollappId)
+	if err != nil {
+		return nil, fmt.Errorf("get ownership transfer: %w", err)
+	}
+	if !ok {
+		return nil, errorsmod.Wrap(gerrc.ErrNotFound, "ownership transfer")
+	}
+
+	if transfer.NewOwner != msg.NewOwner {
+		return nil, types.ErrUnauthorizedSigner
+	}
+
+	if !ctx.BlockTime().Before(transfer.Expiry) {
+		return nil, types.ErrOwnershipTransferExpired
+	}
+
+	// the proposal is only valid for the owner who made it
+	if rollapp.Owner != transfer.CurrentOwner {
+		return nil, errorsmod.Wrap(gerrc.ErrFailedPrecondition, "rollapp owner changed since the transfer was proposed")
+	}
+
+	rollapp.Owner = transfer.NewOwner
+	k.SetRollapp(ctx, rollapp)
+
+	if err := k.DeleteOwnershipTransfer(ctx, msg.RollappId); err != nil {
+		return nil, fmt.Errorf("delete ownership transfer: %w", err)
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, msg); err != nil {
+		return nil, fmt.Errorf("emit event: %w", err)
+	}
+
+	return &types.MsgAcceptOwnershipResponse{}, nil
+}
+
+// CancelOwnershipTransfer removes a pending ownership transfer. Must be signed
+// by the current owner.
+func (k msgServer) CancelOwnershipTransfer(goCtx context.Context, msg *types.MsgCancelOwnershipTransfer) (*types.MsgCancelOwnershipTransferResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rollapp, ok := k.GetRollapp(ctx, msg.RollappId)
+	if !ok {
+		return nil, types.ErrUnknownRollappID
+	}
+
+	if rollapp.Owner != msg.Owner {
+		return nil, types.ErrUnauthorizedSigner
+	}
+
+	_, ok, err := k.GetOwnershipTransfer(ctx, msg.RollappId)
+	if err != nil {
+		return nil, fmt.Errorf("get ownership transfer: %w", err)
+	}
+	if !ok {
+		return nil, errorsmod.Wrap(gerrc.ErrNotFound, "ownership transfer")
+	}
+
+	if err := k.DeleteOwnershipTransfer(ctx, msg.RollappId); err != nil {
+		return nil, fmt.Errorf("delete ownership transfer: %w", err)
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, msg); err != nil {
+		return nil, fmt.Errorf("emit event: %w", err)
+	}
+
+	return &types.MsgCancelOwnershipTransferResponse{}, nil
+}
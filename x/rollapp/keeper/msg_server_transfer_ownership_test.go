@@ -1,7 +1,10 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 
 	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 )
@@ -17,13 +20,13 @@ func (s *RollappTestSuite) TestTransferOwnership() {
 		expRollapp types.Rollapp
 	}{
 		{
-			name: "Transfer rollapp ownership: success",
+			name: "Transfer rollapp ownership: success, ownership is pending",
 			request: types.NewMsgTransferOwnership(
 				alice, bob, rollappId,
 			),
 			expError: nil,
 			expRollapp: types.Rollapp{
-				Owner:       bob,
+				Owner:       alice,
 				RollappId:   rollappId,
 				GenesisInfo: *mockGenesisInfo,
 			},
@@ -81,9 +84,155 @@ func (s *RollappTestSuite) TestTransferOwnership() {
 				resp, err := s.queryClient.Rollapp(goCtx, &types.QueryGetRollappRequest{RollappId: tc.request.RollappId})
 				s.Require().NoError(err)
 				s.Equal(tc.expRollapp, resp.Rollapp)
+
+				transfer, ok, err := s.k().GetOwnershipTransfer(s.Ctx, tc.request.RollappId)
+				s.Require().NoError(err)
+				s.Require().True(ok)
+				s.Equal(tc.request.NewOwner, transfer.NewOwner)
+				s.Equal(tc.request.CurrentOwner, transfer.CurrentOwner)
 			} else {
 				s.ErrorIs(err, tc.expError)
 			}
 		})
 	}
 }
+
+func (s *RollappTestSuite) TestAcceptOwnership() {
+	const rollappId = "rollapp_1234-1"
+
+	tests := []struct {
+		name     string
+		request  *types.MsgAcceptOwnership
+		malleate func()
+		expError error
+		expOwner string
+	}{
+		{
+			name:     "Accept rollapp ownership: success",
+			request:  types.NewMsgAcceptOwnership(bob, rollappId),
+			expOwner: bob,
+		}, {
+			name:     "Accept rollapp ownership: failed, not the new owner",
+			request:  types.NewMsgAcceptOwnership(alice, rollappId),
+			expError: types.ErrUnauthorizedSigner,
+		}, {
+			name:    "Accept rollapp ownership: failed, no pending transfer",
+			request: types.NewMsgAcceptOwnership(bob, rollappId),
+			malleate: func() {
+				err := s.k().DeleteOwnershipTransfer(s.Ctx, rollappId)
+				s.Require().NoError(err)
+			},
+			expError: gerrc.ErrNotFound,
+		}, {
+			name:    "Accept rollapp ownership: failed, transfer expired",
+			request: types.NewMsgAcceptOwnership(bob, rollappId),
+			malleate: func() {
+				s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(s.k().GetParams(s.Ctx).OwnershipTransferExpiry))
+			},
+			expError: types.ErrOwnershipTransferExpired,
+		}, {
+			name:    "Accept rollapp ownership: failed, owner changed",
+			request: types.NewMsgAcceptOwnership(bob, rollappId),
+			malleate: func() {
+				rollapp := s.k().MustGetRollapp(s.Ctx, rollappId)
+				rollapp.Owner = bob
+				s.k().SetRollapp(s.Ctx, rollapp)
+			},
+			expError: gerrc.ErrFailedPrecondition,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.Ctx = s.Ctx.WithBlockTime(time.Now())
+
+			s.k().SetRollapp(s.Ctx, types.Rollapp{
+				RollappId:   rollappId,
+				Owner:       alice,
+				GenesisInfo: *mockGenesisInfo,
+			})
+
+			_, err := s.msgServer.TransferOwnership(s.Ctx, types.NewMsgTransferOwnership(alice, bob, rollappId))
+			s.Require().NoError(err)
+
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+
+			_, err = s.msgServer.AcceptOwnership(s.Ctx, tc.request)
+			if tc.expError == nil {
+				s.Require().NoError(err)
+				rollapp := s.k().MustGetRollapp(s.Ctx, rollappId)
+				s.Equal(tc.expOwner, rollapp.Owner)
+
+				_, ok, err := s.k().GetOwnershipTransfer(s.Ctx, rollappId)
+				s.Require().NoError(err)
+				s.False(ok)
+			} else {
+				s.ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (s *RollappTestSuite) TestCancelOwnershipTransfer() {
+	const rollappId = "rollapp_1234-1"
+
+	s.k().SetRollapp(s.Ctx, types.Rollapp{
+		RollappId:   rollappId,
+		Owner:       alice,
+		GenesisInfo: *mockGenesisInfo,
+	})
+
+	// nothing to cancel
+	_, err := s.msgServer.CancelOwnershipTransfer(s.Ctx, types.NewMsgCancelOwnershipTransfer(alice, rollappId))
+	s.ErrorIs(err, gerrc.ErrNotFound)
+
+	_, err = s.msgServer.TransferOwnership(s.Ctx, types.NewMsgTransferOwnership(alice, bob, rollappId))
+	s.Require().NoError(err)
+
+	// only the owner can cancel
+	_, err = s.msgServer.CancelOwnershipTransfer(s.Ctx, types.NewMsgCancelOwnershipTransfer(bob, rollappId))
+	s.ErrorIs(err, types.ErrUnauthorizedSigner)
+
+	_, err = s.msgServer.CancelOwnershipTransfer(s.Ctx, types.NewMsgCancelOwnershipTransfer(alice, rollappId))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.AcceptOwnership(s.Ctx, types.NewMsgAcceptOwnership(bob, rollappId))
+	s.ErrorIs(err, gerrc.ErrNotFound)
+	s.Equal(alice, s.k().MustGetRollapp(s.Ctx, rollappId).Owner)
+}
+
+func (s *RollappTestSuite) TestPruneExpiredOwnershipTransfers() {
+	for _, id := range []string{"rollapp_1234-1", "rollapp_1235-1"} {
+		s.k().SetRollapp(s.Ctx, types.Rollapp{
+			RollappId:   id,
+			Owner:       alice,
+			GenesisInfo: *mockGenesisInfo,
+		})
+		_, err := s.msgServer.TransferOwnership(s.Ctx, types.NewMsgTransferOwnership(alice, bob, id))
+		s.Require().NoError(err)
+	}
+	expiry := s.k().GetParams(s.Ctx).OwnershipTransferExpiry
+
+	// replacing a transfer moves its expiry
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Second))
+	_, err := s.msgServer.TransferOwnership(s.Ctx, types.NewMsgTransferOwnership(alice, bob, "rollapp_1235-1"))
+	s.Require().NoError(err)
+
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(expiry - time.Second))
+	s.Require().NoError(s.k().PruneExpiredOwnershipTransfers(s.Ctx))
+	_, found, err := s.k().GetOwnershipTransfer(s.Ctx, "rollapp_1234-1")
+	s.Require().NoError(err)
+	s.False(found)
+	_, found, err = s.k().GetOwnershipTransfer(s.Ctx, "rollapp_1235-1")
+	s.Require().NoError(err)
+	s.True(found)
+
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Second))
+	s.Require().NoError(s.k().PruneExpiredOwnershipTransfers(s.Ctx))
+	transfers, err := s.k().GetAllOwnershipTransfers(s.Ctx)
+	s.Require().NoError(err)
+	s.Empty(transfers)
+}
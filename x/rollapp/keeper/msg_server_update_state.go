@@ -33,6 +33,16 @@ func (k msgServer) UpdateState(goCtx context.Context, msg *types.MsgUpdateState)
 			rollapp.LatestRevision().Number, msg.RollappRevision)
 	}
 
+	// a sunset rollapp cannot go beyond the announced final height
+	if rollapp.IsSunset() {
+		lastHeight := msg.StartHeight + msg.NumBlocks - 1
+		if rollapp.Sunset.FinalHeight < lastHeight {
+			return nil, errorsmod.Wrapf(types.ErrRollappSunset,
+				"state exceeds the final height: final: %d: last: %d",
+				rollapp.Sunset.FinalHeight, lastHeight)
+		}
+	}
+
 	// retrieve last updating index
 	var newIndex, lastIndex uint64
 	latestStateInfoIndex, found := k.GetLatestStateInfoIndex(ctx, msg.RollappId)
@@ -103,6 +113,11 @@ func (k msgServer) UpdateState(goCtx context.Context, msg *types.MsgUpdateState)
 			msg.RollappId, stateInfo.GetLatestBlockDescriptor().DrsVersion)
 	}
 
+	// verify the scheduled DRS upgrade, if any, was applied
+	if err := k.CheckScheduledDRSUpgrade(ctx, stateInfo); err != nil {
+		return nil, errorsmod.Wrap(err, "check scheduled DRS upgrade")
+	}
+
 	// Write new index information to the store
 	k.SetLatestStateInfoIndex(ctx, types.StateInfoIndex{
 		RollappId: msg.RollappId,
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// OwnershipTransferPruneBudget is the max number of expired ownership transfers deleted per block
+const OwnershipTransferPruneBudget = 100
+
+// SetOwnershipTransfer sets the pending transfer of the rollapp, replacing the previous one if any
+func (k Keeper) SetOwnershipTransfer(ctx sdk.Context, transfer types.OwnershipTransfer) error {
+	if err := k.DeleteOwnershipTransfer(ctx, transfer.RollappId); err != nil {
+		return err
+	}
+	if err := k.ownershipTransferExpiries.Set(ctx, collections.Join(transfer.Expiry, transfer.RollappId)); err != nil {
+		return err
+	}
+	return k.ownershipTransfers.Set(ctx, transfer.RollappId, transfer)
+}
+
+func (k Keeper) GetOwnershipTransfer(ctx sdk.Context, rollappID string) (types.OwnershipTransfer, bool, error) {
+	transfer, err := k.ownershipTransfers.Get(ctx, rollappID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.OwnershipTransfer{}, false, nil
+	}
+	if err != nil {
+		return types.OwnershipTransfer{}, false, err
+	}
+	return transfer, true, nil
+}
+
+// DeleteOwnershipTransfer deletes the pending transfer of the rollapp, if any
+func (k Keeper) DeleteOwnershipTransfer(ctx sdk.Context, rollappID string) error {
+	transfer, ok, err := k.GetOwnershipTransfer(ctx, rollappID)
+	if err != nil || !ok {
+		return err
+	}
+	if err := k.ownershipTransferExpiries.Remove(ctx, collections.Join(transfer.Expiry, rollappID)); err != nil {
+		return err
+	}
+	return k.ownershipTransfers.Remove(ctx, rollappID)
+}
+
+func (k Keeper) GetAllOwnershipTransfers(ctx sdk.Context) ([]types.OwnershipTransfer, error) {
+	iter, err := k.ownershipTransfers.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// PruneExpiredOwnershipTransfers deletes up to OwnershipTransferPruneBudget pending transfers which can no
+// longer be accepted, which is once the block time is not before the expiry. The rest are deleted in later blocks.
+func (k Keeper) PruneExpiredOwnershipTransfers(ctx sdk.Context) error {
+	var expired []string
+	rng := collections.NewPrefixUntilPairRange[time.Time, string](ctx.BlockTime())
+	err := k.ownershipTransferExpiries.Walk(ctx, rng, func(key collections.Pair[time.Time, string]) (bool, error) {
+		expired = append(expired, key.K2())
+		return OwnershipTransferPruneBudget <= len(expired), nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, rollappID := range expired {
+		if err := k.DeleteOwnershipTransfer(ctx, rollappID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
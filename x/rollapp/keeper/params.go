@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
@@ -43,3 +45,15 @@ func (k Keeper) AppRegistrationFee(ctx sdk.Context) (res sdk.Coin) {
 func (k Keeper) MinSequencerBondGlobal(ctx sdk.Context) (res sdk.Coin) {
 	return k.GetParams(ctx).MinSequencerBondGlobal
 }
+
+func (k Keeper) SunsetWithdrawalWindow(ctx sdk.Context) (res time.Duration) {
+	return k.GetParams(ctx).SunsetWithdrawalWindow
+}
+
+func (k Keeper) MaintenanceWindowMaxBlocks(ctx sdk.Context) (res uint64) {
+	return k.GetParams(ctx).MaintenanceWindowMaxBlocks
+}
+
+func (k Keeper) MaintenanceWindowMinInterval(ctx sdk.Context) (res uint64) {
+	return k.GetParams(ctx).MaintenanceWindowMinInterval
+}
@@ -163,10 +163,17 @@ func (am AppModule) GetHooks() []types.RollappHooks {
 }
 
 // EndBlock finalizes states from rollapps (after dispute period) and corresponding packets. It slashes and jails
-// sequencers of inactive rollapps.
+// sequencers of inactive rollapps, and deletes expired ownership transfers.
 func (am AppModule) EndBlock(goCtx context.Context) error {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	am.keeper.FinalizeRollappStates(ctx)
 	am.keeper.CheckLiveness(ctx)
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := am.keeper.PruneExpiredOwnershipTransfers(cacheCtx); err != nil {
+		am.keeper.Logger(ctx).Error("Prune expired ownership transfers.", "err", err)
+	} else {
+		write()
+	}
 	return nil
 }
@@ -11,7 +11,12 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateRollapp{}, "rollapp/CreateRollapp", nil)
 	cdc.RegisterConcrete(&MsgUpdateRollappInformation{}, "rollapp/UpdateRollappInformation", nil)
 	cdc.RegisterConcrete(&MsgTransferOwnership{}, "rollapp/TransferDymNameOwnership", nil)
+	cdc.RegisterConcrete(&MsgAcceptOwnership{}, "rollapp/AcceptOwnership", nil)
+	cdc.RegisterConcrete(&MsgCancelOwnershipTransfer{}, "rollapp/CancelOwnershipTransfer", nil)
 	cdc.RegisterConcrete(&MsgUpdateState{}, "rollapp/UpdateState", nil)
+	cdc.RegisterConcrete(&MsgSunsetRollapp{}, "rollapp/SunsetRollapp", nil)
+	cdc.RegisterConcrete(&MsgScheduleDRSUpgrade{}, "rollapp/ScheduleDRSUpgrade", nil)
+	cdc.RegisterConcrete(&MsgDeclareMaintenanceWindow{}, "rollapp/DeclareMaintenanceWindow", nil)
 	cdc.RegisterConcrete(&MsgAddApp{}, "rollapp/AddApp", nil)
 	cdc.RegisterConcrete(&MsgUpdateApp{}, "rollapp/UpdateApp", nil)
 	cdc.RegisterConcrete(&MsgRemoveApp{}, "rollapp/RemoveApp", nil)
@@ -25,7 +30,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCreateRollapp{},
 		&MsgUpdateRollappInformation{},
 		&MsgTransferOwnership{},
+		&MsgAcceptOwnership{},
+		&MsgCancelOwnershipTransfer{},
 		&MsgUpdateState{},
+		&MsgSunsetRollapp{},
+		&MsgScheduleDRSUpgrade{},
+		&MsgDeclareMaintenanceWindow{},
 		&MsgAddApp{},
 		&MsgUpdateApp{},
 		&MsgRemoveApp{},
@@ -55,6 +55,8 @@ var (
 	ErrTooManyGenesisAccounts            = errorsmod.Wrap(gerrc.ErrInvalidArgument, "too many genesis accounts")
 	ErrInvalidBech32Prefix               = errorsmod.Wrap(gerrc.ErrInvalidArgument, "invalid bech32 prefix")
 	ErrRollappNotFound                   = errorsmod.Wrap(gerrc.ErrNotFound, "rollapp")
+	ErrOwnershipTransferExpired          = errorsmod.Wrap(gerrc.ErrFailedPrecondition, "ownership transfer expired")
+	ErrRollappSunset                     = errorsmod.Wrap(gerrc.ErrFailedPrecondition, "rollapp is sunset")
 
 	/* ------------------------------ fraud related ----------------------------- */
 	ErrDisputeAlreadyFinalized = errorsmod.Register(ModuleName, 2000, "disputed height already finalized")
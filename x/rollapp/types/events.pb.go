@@ -208,11 +208,86 @@ func (m *EventMarkObsoleteRollapps) GetDrsVersions() []uint32 {
 	return nil
 }
 
+// EventMaintenanceWindowDeclared is emitted when a maintenance window is
+// declared for a rollapp.
+type EventMaintenanceWindowDeclared struct {
+	// rollapp_id is the unique identifier of the rollapp chain
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// start_height is the first hub height of the window
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the first hub height after the window
+	EndHeight int64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// declarer is the rollapp owner or proposer which declared the window
+	Declarer string `protobuf:"bytes,4,opt,name=declarer,proto3" json:"declarer,omitempty"`
+}
+
+func (m *EventMaintenanceWindowDeclared) Reset()         { *m = EventMaintenanceWindowDeclared{} }
+func (m *EventMaintenanceWindowDeclared) String() string { return proto.CompactTextString(m) }
+func (*EventMaintenanceWindowDeclared) ProtoMessage()    {}
+func (*EventMaintenanceWindowDeclared) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f74405c12dec3c, []int{4}
+}
+func (m *EventMaintenanceWindowDeclared) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMaintenanceWindowDeclared) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMaintenanceWindowDeclared.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMaintenanceWindowDeclared) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMaintenanceWindowDeclared.Merge(m, src)
+}
+func (m *EventMaintenanceWindowDeclared) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMaintenanceWindowDeclared) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMaintenanceWindowDeclared.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMaintenanceWindowDeclared proto.InternalMessageInfo
+
+func (m *EventMaintenanceWindowDeclared) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *EventMaintenanceWindowDeclared) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *EventMaintenanceWindowDeclared) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *EventMaintenanceWindowDeclared) GetDeclarer() string {
+	if m != nil {
+		return m.Declarer
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventAppAdded)(nil), "dymensionxyz.dymension.rollapp.EventAppAdded")
 	proto.RegisterType((*EventAppUpdated)(nil), "dymensionxyz.dymension.rollapp.EventAppUpdated")
 	proto.RegisterType((*EventAppRemoved)(nil), "dymensionxyz.dymension.rollapp.EventAppRemoved")
 	proto.RegisterType((*EventMarkObsoleteRollapps)(nil), "dymensionxyz.dymension.rollapp.EventMarkObsoleteRollapps")
+	proto.RegisterType((*EventMaintenanceWindowDeclared)(nil), "dymensionxyz.dymension.rollapp.EventMaintenanceWindowDeclared")
 }
 
 func init() {
@@ -220,25 +295,30 @@ func init() {
 }
 
 var fileDescriptor_e0f74405c12dec3c = []byte{
-	// 277 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4e, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x8b, 0xf2, 0x73, 0x72,
-	0x12, 0x0b, 0x0a, 0xf4, 0x53, 0xcb, 0x52, 0xf3, 0x4a, 0x8a, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2,
-	0x85, 0xe4, 0x90, 0x15, 0xeb, 0xc1, 0x39, 0x7a, 0x50, 0xc5, 0x52, 0x1a, 0x04, 0x0c, 0x4b, 0x2c,
-	0x28, 0x80, 0x98, 0xa4, 0xe4, 0xc6, 0xc5, 0xeb, 0x0a, 0x32, 0xd9, 0xb1, 0xa0, 0xc0, 0x31, 0x25,
-	0x25, 0x35, 0x45, 0xc8, 0x94, 0x8b, 0x39, 0xb1, 0xa0, 0x40, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb,
-	0x48, 0x59, 0x0f, 0xbf, 0x45, 0x7a, 0x8e, 0x05, 0x05, 0x41, 0x20, 0xf5, 0x4a, 0x1e, 0x5c, 0xfc,
-	0x30, 0x73, 0x42, 0x0b, 0x52, 0x12, 0x4b, 0xa8, 0x62, 0x52, 0x50, 0x6a, 0x6e, 0x7e, 0x19, 0xf9,
-	0x26, 0x15, 0x70, 0x49, 0x82, 0x4d, 0xf2, 0x4d, 0x2c, 0xca, 0xf6, 0x4f, 0x2a, 0xce, 0xcf, 0x49,
-	0x2d, 0x49, 0x0d, 0x82, 0x28, 0x2a, 0x16, 0x32, 0xe0, 0x12, 0xc9, 0x87, 0x8a, 0xc5, 0x43, 0x75,
-	0xc6, 0xe7, 0x95, 0xe6, 0x82, 0x2d, 0x61, 0x09, 0x12, 0xca, 0x47, 0x55, 0xef, 0x57, 0x9a, 0x2b,
-	0xa4, 0xc8, 0xc5, 0x93, 0x52, 0x54, 0x1c, 0x5f, 0x96, 0x5a, 0x04, 0xb2, 0xae, 0x58, 0x82, 0x49,
-	0x81, 0x59, 0x83, 0x37, 0x88, 0x3b, 0xa5, 0xa8, 0x38, 0x0c, 0x2a, 0xe4, 0xe4, 0x77, 0xe2, 0x91,
-	0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1,
-	0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x26, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a,
-	0xc9, 0xf9, 0xb9, 0xfa, 0x38, 0x22, 0xa7, 0xcc, 0x58, 0xbf, 0x02, 0x1e, 0x43, 0x25, 0x95, 0x05,
-	0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x48, 0x32, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x9b, 0x93, 0xbd,
-	0x81, 0x1d, 0x02, 0x00, 0x00,
+	// 364 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xc1, 0x4a, 0xeb, 0x40,
+	0x18, 0x85, 0x9b, 0xa6, 0x5c, 0x6e, 0xa7, 0xb7, 0x5c, 0x08, 0x77, 0x91, 0x5b, 0x70, 0xa8, 0x75,
+	0x13, 0x10, 0x12, 0xb1, 0xfa, 0x00, 0x15, 0x95, 0xba, 0xb0, 0x42, 0x40, 0x05, 0x37, 0x61, 0xda,
+	0xf9, 0x69, 0x83, 0xc9, 0xcc, 0x30, 0x33, 0x8d, 0xad, 0x4f, 0xe1, 0x0b, 0xf8, 0x3e, 0x2e, 0xbb,
+	0x74, 0x29, 0xed, 0x8b, 0x48, 0xa6, 0x69, 0xd0, 0x85, 0x0a, 0xe2, 0xf2, 0x3f, 0x73, 0xe6, 0xfb,
+	0x36, 0x07, 0xed, 0xd2, 0x79, 0x0a, 0x4c, 0xc5, 0x9c, 0xcd, 0xe6, 0xf7, 0x41, 0x79, 0x04, 0x92,
+	0x27, 0x09, 0x11, 0x22, 0x80, 0x0c, 0x98, 0x56, 0xbe, 0x90, 0x5c, 0x73, 0x07, 0xbf, 0x2d, 0xfb,
+	0xe5, 0xe1, 0x17, 0xe5, 0x96, 0xf7, 0x05, 0x8c, 0x08, 0xb1, 0x26, 0x75, 0x4e, 0x51, 0xf3, 0x24,
+	0x27, 0xf7, 0x84, 0xe8, 0x51, 0x0a, 0xd4, 0x39, 0x44, 0x36, 0x11, 0xc2, 0xb5, 0xda, 0x96, 0xd7,
+	0xd8, 0xdf, 0xf1, 0x3f, 0x17, 0xf9, 0x3d, 0x21, 0xc2, 0xbc, 0xdf, 0xe9, 0xa3, 0xbf, 0x1b, 0xce,
+	0xa5, 0xa0, 0x44, 0xff, 0x08, 0x29, 0x84, 0x94, 0x67, 0xdf, 0x27, 0x09, 0xf4, 0xdf, 0x90, 0xce,
+	0x89, 0xbc, 0xbd, 0x18, 0x2a, 0x9e, 0x80, 0x86, 0x70, 0x5d, 0x52, 0xce, 0x1e, 0xfa, 0xc7, 0x8b,
+	0x2c, 0x2a, 0x7e, 0x46, 0x6c, 0x9a, 0x1a, 0x49, 0x2d, 0x74, 0xf8, 0xfb, 0xfe, 0x60, 0x9a, 0x3a,
+	0xdb, 0xe8, 0x0f, 0x95, 0x2a, 0xca, 0x40, 0xe6, 0x3a, 0xe5, 0x56, 0xdb, 0xb6, 0xd7, 0x0c, 0x1b,
+	0x54, 0xaa, 0xab, 0x22, 0xea, 0x3c, 0x5a, 0x08, 0x17, 0xca, 0x98, 0x69, 0x60, 0x84, 0x8d, 0xe0,
+	0x3a, 0x66, 0x94, 0xdf, 0x1d, 0xc3, 0x28, 0x21, 0x12, 0xa8, 0xb3, 0x85, 0xd0, 0x46, 0x17, 0x53,
+	0x63, 0xab, 0x87, 0xf5, 0x22, 0x39, 0xa3, 0xb9, 0x44, 0x69, 0x22, 0x75, 0x34, 0x81, 0x78, 0x3c,
+	0xd1, 0x6e, 0xb5, 0x6d, 0x79, 0x76, 0xd8, 0x30, 0x59, 0xdf, 0x44, 0x39, 0x01, 0x18, 0xdd, 0x14,
+	0x6c, 0x53, 0xa8, 0x03, 0xa3, 0xc5, 0x73, 0x0b, 0xfd, 0xa6, 0x6b, 0x99, 0x74, 0x6b, 0x06, 0x5f,
+	0xde, 0x47, 0x83, 0xa7, 0x25, 0xb6, 0x16, 0x4b, 0x6c, 0xbd, 0x2c, 0xb1, 0xf5, 0xb0, 0xc2, 0x95,
+	0xc5, 0x0a, 0x57, 0x9e, 0x57, 0xb8, 0x72, 0x73, 0x30, 0x8e, 0xf5, 0x64, 0x3a, 0xf4, 0x47, 0x3c,
+	0x0d, 0x3e, 0x18, 0x4f, 0xd6, 0x0d, 0x66, 0xe5, 0x82, 0xf4, 0x5c, 0x80, 0x1a, 0xfe, 0x32, 0x23,
+	0xea, 0xbe, 0x0e, 0x00, 0xe1, 0xef, 0xdf, 0x22, 0xbd, 0x02, 0x00, 0x00,
 }
 
 func (m *EventAppAdded) Marshal() (dAtA []byte, err error) {
@@ -392,6 +472,53 @@ func (m *EventMarkObsoleteRollapps) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *EventMaintenanceWindowDeclared) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMaintenanceWindowDeclared) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMaintenanceWindowDeclared) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Declarer) > 0 {
+		i -= len(m.Declarer)
+		copy(dAtA[i:], m.Declarer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Declarer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -461,6 +588,29 @@ func (m *EventMarkObsoleteRollapps) Size() (n int) {
 	return n
 }
 
+func (m *EventMaintenanceWindowDeclared) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovEvents(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovEvents(uint64(m.EndHeight))
+	}
+	l = len(m.Declarer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -870,6 +1020,158 @@ func (m *EventMarkObsoleteRollapps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventMaintenanceWindowDeclared) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMaintenanceWindowDeclared: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMaintenanceWindowDeclared: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Declarer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Declarer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -153,5 +153,44 @@ func (gs GenesisState) Validate() error {
 		obsoleteDRSVersionIndexMap[elem] = struct{}{}
 	}
 
+	// Check for duplicated index in ownershipTransfers
+	ownershipTransfersIndexMap := make(map[string]struct{})
+	for _, elem := range gs.OwnershipTransfers {
+		if _, ok := ownershipTransfersIndexMap[elem.RollappId]; ok {
+			return errors.New("duplicated index for OwnershipTransfers")
+		}
+		ownershipTransfersIndexMap[elem.RollappId] = struct{}{}
+
+		if err := elem.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid OwnershipTransfer for RollappId %s: %w", elem.RollappId, err)
+		}
+	}
+
+	// Check for duplicated index in scheduledDrsUpgrades
+	scheduledDrsUpgradesIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ScheduledDrsUpgrades {
+		if _, ok := scheduledDrsUpgradesIndexMap[elem.RollappId]; ok {
+			return errors.New("duplicated index for ScheduledDrsUpgrades")
+		}
+		scheduledDrsUpgradesIndexMap[elem.RollappId] = struct{}{}
+
+		if elem.Height == 0 || elem.DrsVersion == 0 {
+			return fmt.Errorf("invalid ScheduledDrsUpgrade for RollappId %s", elem.RollappId)
+		}
+	}
+
+	// Check for duplicated index in maintenanceWindows
+	maintenanceWindowsIndexMap := make(map[string]struct{})
+	for _, elem := range gs.MaintenanceWindows {
+		if _, ok := maintenanceWindowsIndexMap[elem.RollappId]; ok {
+			return errors.New("duplicated index for MaintenanceWindows")
+		}
+		maintenanceWindowsIndexMap[elem.RollappId] = struct{}{}
+
+		if err := elem.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid MaintenanceWindow for RollappId %s: %w", elem.RollappId, err)
+		}
+	}
+
 	return gs.Params.Validate()
 }
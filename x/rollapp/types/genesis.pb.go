@@ -38,6 +38,13 @@ type GenesisState struct {
 	SequencerHeightPairs []SequencerHeightPair     `protobuf:"bytes,10,rep,name=sequencerHeightPairs,proto3" json:"sequencerHeightPairs"`
 	// ObsoleteDrsVersions is a list of DRS versions that are marked obsolete
 	ObsoleteDrsVersions []uint32 `protobuf:"varint,11,rep,packed,name=obsolete_drs_versions,json=obsoleteDrsVersions,proto3" json:"obsolete_drs_versions,omitempty"`
+	// OwnershipTransfers is a list of pending rollapp ownership transfers
+	OwnershipTransfers []OwnershipTransfer `protobuf:"bytes,12,rep,name=ownership_transfers,json=ownershipTransfers,proto3" json:"ownership_transfers"`
+	// ScheduledDrsUpgrades is a list of pending DRS upgrades
+	ScheduledDrsUpgrades []ScheduledDRSUpgrade `protobuf:"bytes,13,rep,name=scheduled_drs_upgrades,json=scheduledDrsUpgrades,proto3" json:"scheduled_drs_upgrades"`
+	// MaintenanceWindows is a list of the latest maintenance window of each
+	// rollapp
+	MaintenanceWindows []MaintenanceWindow `protobuf:"bytes,14,rep,name=maintenance_windows,json=maintenanceWindows,proto3" json:"maintenance_windows"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -150,6 +157,27 @@ func (m *GenesisState) GetObsoleteDrsVersions() []uint32 {
 	return nil
 }
 
+func (m *GenesisState) GetOwnershipTransfers() []OwnershipTransfer {
+	if m != nil {
+		return m.OwnershipTransfers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduledDrsUpgrades() []ScheduledDRSUpgrade {
+	if m != nil {
+		return m.ScheduledDrsUpgrades
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMaintenanceWindows() []MaintenanceWindow {
+	if m != nil {
+		return m.MaintenanceWindows
+	}
+	return nil
+}
+
 type SequencerHeightPair struct {
 	Sequencer string `protobuf:"bytes,1,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
 	Height    uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
@@ -265,44 +293,50 @@ func init() {
 }
 
 var fileDescriptor_b76890aebc09aa04 = []byte{
-	// 589 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcf, 0x6e, 0xd3, 0x4c,
-	0x14, 0xc5, 0xe3, 0xb6, 0x5f, 0xda, 0x4c, 0xbe, 0x22, 0x34, 0x2d, 0x60, 0x45, 0xd4, 0x44, 0x41,
-	0x82, 0x20, 0xa8, 0x2d, 0x25, 0x48, 0xec, 0x90, 0x08, 0xe1, 0x4f, 0x44, 0x05, 0xc1, 0x05, 0x16,
-	0xb0, 0x88, 0x9c, 0xf8, 0xd6, 0x19, 0xe1, 0x78, 0x8c, 0x67, 0x12, 0x25, 0x79, 0x0a, 0x16, 0xbc,
-	0x08, 0x6f, 0xd1, 0x65, 0x97, 0xac, 0x10, 0x4a, 0x5e, 0x04, 0x79, 0x3c, 0x36, 0xa1, 0x6d, 0x32,
-	0x91, 0x58, 0x39, 0xe3, 0x39, 0xf7, 0x77, 0x8e, 0x6f, 0xae, 0x2e, 0x7a, 0xe0, 0x4e, 0x06, 0x10,
-	0x30, 0x42, 0x83, 0xf1, 0x64, 0x6a, 0x65, 0x07, 0x2b, 0xa2, 0xbe, 0xef, 0x84, 0xa1, 0xe5, 0x41,
-	0x00, 0x8c, 0x30, 0x33, 0x8c, 0x28, 0xa7, 0xd8, 0x58, 0x54, 0x9b, 0xd9, 0xc1, 0x94, 0xea, 0xd2,
-	0xbe, 0x47, 0x3d, 0x2a, 0xa4, 0x56, 0xfc, 0x2b, 0xa9, 0x2a, 0xdd, 0x57, 0x78, 0x84, 0x4e, 0xe4,
-	0x0c, 0xa4, 0x45, 0x49, 0x15, 0x48, 0x3e, 0xa5, 0xda, 0x52, 0xa8, 0x19, 0x77, 0x38, 0x74, 0x48,
-	0x70, 0x92, 0x66, 0x39, 0x54, 0x14, 0xf8, 0x64, 0x14, 0x7f, 0x71, 0x9a, 0xa6, 0xaa, 0x90, 0x67,
-	0x49, 0x2a, 0xdf, 0x77, 0xd0, 0xff, 0x2f, 0x92, 0x66, 0x1d, 0xc7, 0xa6, 0xb8, 0x89, 0xf2, 0xc9,
-	0x87, 0xe9, 0x5a, 0x59, 0xab, 0x16, 0x6b, 0x77, 0xcc, 0xd5, 0xcd, 0x33, 0xdb, 0x42, 0xdd, 0xd8,
-	0x3a, 0xfd, 0x79, 0x2b, 0x67, 0xcb, 0x5a, 0xfc, 0x06, 0x15, 0xe5, 0xfd, 0x11, 0x61, 0x5c, 0xdf,
-	0x28, 0x6f, 0x56, 0x8b, 0xb5, 0xbb, 0x2a, 0x94, 0x9d, 0x3c, 0x25, 0x6b, 0x91, 0x80, 0xdf, 0xa3,
-	0x5d, 0xd1, 0x94, 0x56, 0x70, 0x42, 0x05, 0x72, 0x53, 0x20, 0xef, 0xa9, 0x90, 0xc7, 0x69, 0x91,
-	0x84, 0xfe, 0x4d, 0xc1, 0x21, 0xd2, 0x7d, 0x87, 0x03, 0xe3, 0x99, 0xae, 0x15, 0xb8, 0x30, 0x16,
-	0x0e, 0x5b, 0xc2, 0xc1, 0x5c, 0xdb, 0x41, 0x54, 0x4a, 0x9b, 0xa5, 0x54, 0x3c, 0x45, 0x07, 0xc9,
-	0xdd, 0x73, 0x12, 0x38, 0x3e, 0x99, 0x82, 0x2b, 0x45, 0xa9, 0xed, 0x7f, 0xff, 0x60, 0xbb, 0x1a,
-	0x8d, 0xbf, 0x69, 0xa8, 0xd2, 0xf5, 0x69, 0xef, 0xf3, 0x4b, 0x20, 0x5e, 0x9f, 0xbf, 0xa3, 0x52,
-	0xe8, 0x70, 0x42, 0x83, 0xb7, 0x43, 0x18, 0x82, 0x48, 0x90, 0x17, 0x09, 0x1e, 0xab, 0x12, 0x34,
-	0x56, 0x92, 0x64, 0xa2, 0x35, 0xfc, 0xf0, 0x27, 0x74, 0x25, 0x9d, 0xdf, 0x67, 0x23, 0x08, 0x38,
-	0xd3, 0xb7, 0x45, 0x82, 0x43, 0x55, 0x82, 0xa3, 0xc5, 0x2a, 0x69, 0x78, 0x0e, 0x85, 0x9f, 0xa2,
-	0xed, 0x74, 0x0a, 0x77, 0x04, 0xf5, 0xb6, 0x8a, 0xfa, 0x24, 0x9b, 0xc0, 0xb4, 0x12, 0x13, 0x74,
-	0x35, 0x02, 0x8f, 0x30, 0x0e, 0x11, 0xb8, 0x4d, 0x08, 0xe8, 0x80, 0xe9, 0x05, 0x41, 0x7b, 0xb4,
-	0xe6, 0x4c, 0xdb, 0xe7, 0xca, 0xa5, 0xc3, 0x05, 0x2c, 0x1e, 0xa0, 0x7d, 0x06, 0x5f, 0x86, 0x10,
-	0xf4, 0x20, 0x4a, 0xda, 0xd6, 0x76, 0x48, 0xc4, 0x74, 0x24, 0xec, 0xea, 0xca, 0xb1, 0xb8, 0x58,
-	0x2b, 0xad, 0x2e, 0xc5, 0xe2, 0x1a, 0xba, 0x46, 0xbb, 0x8c, 0xfa, 0xc0, 0xa1, 0xe3, 0x46, 0xac,
-	0x33, 0x82, 0x28, 0xe6, 0x31, 0xbd, 0x58, 0xde, 0xac, 0xee, 0xda, 0x7b, 0xe9, 0x65, 0x33, 0x62,
-	0x1f, 0xe4, 0x55, 0xe5, 0x15, 0xda, 0xbb, 0xc4, 0x06, 0xdf, 0x44, 0x85, 0xcc, 0x42, 0x2c, 0x8f,
-	0x82, 0xfd, 0xe7, 0x05, 0xbe, 0x8e, 0xf2, 0x7d, 0xa1, 0xd5, 0x37, 0xca, 0x5a, 0x75, 0xcb, 0x96,
-	0xa7, 0x4a, 0x1b, 0xdd, 0x58, 0xd2, 0x22, 0x7c, 0x80, 0x90, 0xfc, 0xac, 0x0e, 0x71, 0x53, 0xa2,
-	0x7c, 0xd3, 0x72, 0x63, 0xa2, 0x9b, 0xfc, 0x15, 0xf1, 0x7a, 0x29, 0xd8, 0xf2, 0xd4, 0x78, 0x7d,
-	0x3a, 0x33, 0xb4, 0xb3, 0x99, 0xa1, 0xfd, 0x9a, 0x19, 0xda, 0xd7, 0xb9, 0x91, 0x3b, 0x9b, 0x1b,
-	0xb9, 0x1f, 0x73, 0x23, 0xf7, 0xf1, 0xa1, 0x47, 0x78, 0x7f, 0xd8, 0x35, 0x7b, 0x74, 0xb0, 0x6c,
-	0x03, 0x8f, 0xea, 0xd6, 0x38, 0x5b, 0x93, 0x7c, 0x12, 0x02, 0xeb, 0xe6, 0xc5, 0xa6, 0xac, 0xff,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x21, 0x55, 0x13, 0x74, 0x06, 0x00, 0x00,
+	// 684 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x4f, 0x13, 0x41,
+	0x14, 0xef, 0x02, 0x16, 0x3b, 0x05, 0x62, 0x06, 0xc4, 0x0d, 0x91, 0xb5, 0xa9, 0x89, 0xd6, 0x28,
+	0xdb, 0x08, 0x26, 0xde, 0x4c, 0xc4, 0xfa, 0x87, 0x88, 0x82, 0x0b, 0x68, 0xa2, 0x87, 0x66, 0xdb,
+	0x7d, 0x6c, 0x27, 0xee, 0xce, 0xac, 0x33, 0xd3, 0xf2, 0xe7, 0x53, 0x78, 0xf0, 0x43, 0x71, 0xe4,
+	0xe8, 0xc9, 0x18, 0xf8, 0x0e, 0x9e, 0x4d, 0x67, 0x67, 0x17, 0x2c, 0xd0, 0x69, 0xe2, 0xa9, 0x9d,
+	0x7d, 0xbf, 0x7f, 0xef, 0xed, 0xcb, 0x0e, 0x7a, 0x14, 0x1c, 0xc4, 0x40, 0x05, 0x61, 0x74, 0xff,
+	0xe0, 0xb0, 0x9e, 0x1f, 0xea, 0x9c, 0x45, 0x91, 0x9f, 0x24, 0xf5, 0x10, 0x28, 0x08, 0x22, 0xdc,
+	0x84, 0x33, 0xc9, 0xb0, 0x73, 0x1e, 0xed, 0xe6, 0x07, 0x57, 0xa3, 0x17, 0xe6, 0x42, 0x16, 0x32,
+	0x05, 0xad, 0xf7, 0xff, 0xa5, 0xac, 0x85, 0x87, 0x06, 0x8f, 0xc4, 0xe7, 0x7e, 0xac, 0x2d, 0x16,
+	0x4c, 0x81, 0xf4, 0xaf, 0x46, 0xd7, 0x0d, 0x68, 0x21, 0x7d, 0x09, 0x4d, 0x42, 0x77, 0xb3, 0x2c,
+	0x4b, 0x06, 0x42, 0x44, 0x7a, 0xfd, 0x8e, 0xb3, 0x34, 0x35, 0x03, 0x3c, 0x4f, 0x52, 0xfd, 0x83,
+	0xd0, 0xd4, 0xeb, 0x74, 0x58, 0x5b, 0x7d, 0x53, 0xdc, 0x40, 0xc5, 0xb4, 0x31, 0xdb, 0xaa, 0x58,
+	0xb5, 0xf2, 0xf2, 0x3d, 0x77, 0xf8, 0xf0, 0xdc, 0x4d, 0x85, 0x5e, 0x9d, 0x38, 0xfa, 0x75, 0xa7,
+	0xe0, 0x69, 0x2e, 0xde, 0x40, 0x65, 0x5d, 0x5f, 0x27, 0x42, 0xda, 0x63, 0x95, 0xf1, 0x5a, 0x79,
+	0xf9, 0xbe, 0x49, 0xca, 0x4b, 0x7f, 0xb5, 0xd6, 0x79, 0x05, 0xbc, 0x83, 0xa6, 0xd5, 0x50, 0xd6,
+	0xe8, 0x2e, 0x53, 0x92, 0xe3, 0x4a, 0xf2, 0x81, 0x49, 0x72, 0x2b, 0x23, 0x69, 0xd1, 0x7f, 0x55,
+	0x70, 0x82, 0xec, 0xc8, 0x97, 0x20, 0x64, 0x8e, 0x5b, 0xa3, 0x01, 0xec, 0x2b, 0x87, 0x09, 0xe5,
+	0xe0, 0x8e, 0xec, 0xa0, 0x98, 0xda, 0xe6, 0x4a, 0x55, 0x7c, 0x88, 0x16, 0xd3, 0xda, 0x2b, 0x42,
+	0xfd, 0x88, 0x1c, 0x42, 0xa0, 0x41, 0x99, 0xed, 0xb5, 0xff, 0xb0, 0x1d, 0x2e, 0x8d, 0x7f, 0x58,
+	0xa8, 0xda, 0x8a, 0x58, 0xfb, 0xeb, 0x1b, 0x20, 0x61, 0x47, 0x6e, 0x33, 0x0d, 0xf4, 0x25, 0x61,
+	0xf4, 0x43, 0x17, 0xba, 0xa0, 0x12, 0x14, 0x55, 0x82, 0x67, 0xa6, 0x04, 0xab, 0x43, 0x95, 0x74,
+	0xa2, 0x11, 0xfc, 0xf0, 0x17, 0x34, 0x93, 0xed, 0xef, 0xcb, 0x1e, 0x50, 0x29, 0xec, 0x49, 0x95,
+	0x60, 0xc9, 0x94, 0x60, 0xfd, 0x3c, 0x4b, 0x1b, 0x0e, 0x48, 0xe1, 0x17, 0x68, 0x32, 0xdb, 0xc2,
+	0xeb, 0x4a, 0xf5, 0xae, 0x49, 0xf5, 0x79, 0xbe, 0x81, 0x19, 0x13, 0x13, 0x74, 0x83, 0x43, 0x48,
+	0x84, 0x04, 0x0e, 0x41, 0x03, 0x28, 0x8b, 0x85, 0x5d, 0x52, 0x6a, 0x4f, 0x47, 0xdc, 0x69, 0x6f,
+	0x80, 0xae, 0x1d, 0x2e, 0xc8, 0xe2, 0x18, 0xcd, 0x09, 0xf8, 0xd6, 0x05, 0xda, 0x06, 0x9e, 0x8e,
+	0x6d, 0xd3, 0x27, 0x5c, 0xd8, 0x48, 0xd9, 0xad, 0x18, 0xd7, 0xe2, 0x22, 0x57, 0x5b, 0x5d, 0x2a,
+	0x8b, 0x97, 0xd1, 0x4d, 0xd6, 0x12, 0x2c, 0x02, 0x09, 0xcd, 0x80, 0x8b, 0x66, 0x0f, 0x78, 0x5f,
+	0x4f, 0xd8, 0xe5, 0xca, 0x78, 0x6d, 0xda, 0x9b, 0xcd, 0x8a, 0x0d, 0x2e, 0x3e, 0xea, 0x12, 0xee,
+	0xa0, 0x59, 0xb6, 0x47, 0x81, 0x8b, 0x0e, 0x49, 0x9a, 0x92, 0xfb, 0x54, 0xec, 0x02, 0x17, 0xf6,
+	0x94, 0x4a, 0xf8, 0xd8, 0x94, 0x70, 0x23, 0xa3, 0x6e, 0x6b, 0xa6, 0xce, 0x87, 0xd9, 0x60, 0x41,
+	0x60, 0x86, 0xe6, 0x45, 0xbb, 0x03, 0x41, 0x37, 0x82, 0x40, 0xc5, 0xeb, 0x26, 0x21, 0xf7, 0x03,
+	0x10, 0xf6, 0xf4, 0x88, 0xe3, 0xc8, 0xd8, 0x0d, 0x6f, 0x6b, 0x27, 0xe5, 0xe6, 0xe3, 0xc8, 0x4b,
+	0x5c, 0xe8, 0x92, 0x6a, 0x2d, 0xf6, 0x09, 0x95, 0x40, 0x7d, 0xda, 0x86, 0xe6, 0x1e, 0xa1, 0x01,
+	0xdb, 0x13, 0xf6, 0xcc, 0x68, 0xad, 0xbd, 0x3b, 0xa3, 0x7e, 0x52, 0xcc, 0xac, 0xb5, 0x78, 0xb0,
+	0x20, 0xaa, 0x6f, 0xd1, 0xec, 0x25, 0xef, 0x0a, 0xdf, 0x46, 0xa5, 0xfc, 0x3d, 0xa9, 0x2f, 0x70,
+	0xc9, 0x3b, 0x7b, 0x80, 0xe7, 0x51, 0xb1, 0xa3, 0xb0, 0xf6, 0x58, 0xc5, 0xaa, 0x4d, 0x78, 0xfa,
+	0x54, 0xdd, 0x44, 0xb7, 0xae, 0xd8, 0x33, 0xbc, 0x88, 0x90, 0x8e, 0xd7, 0x24, 0x41, 0xa6, 0xa8,
+	0x9f, 0xac, 0x05, 0x7d, 0xc5, 0x20, 0xdd, 0xe7, 0xfe, 0x37, 0xba, 0xe4, 0xe9, 0xd3, 0xea, 0xfb,
+	0xa3, 0x13, 0xc7, 0x3a, 0x3e, 0x71, 0xac, 0xdf, 0x27, 0x8e, 0xf5, 0xfd, 0xd4, 0x29, 0x1c, 0x9f,
+	0x3a, 0x85, 0x9f, 0xa7, 0x4e, 0xe1, 0xf3, 0x93, 0x90, 0xc8, 0x4e, 0xb7, 0xe5, 0xb6, 0x59, 0x7c,
+	0xd5, 0x35, 0xd6, 0x5b, 0xa9, 0xef, 0xe7, 0x77, 0x8d, 0x3c, 0x48, 0x40, 0xb4, 0x8a, 0xea, 0xba,
+	0x59, 0xf9, 0x3b, 0x00, 0x71, 0x8a, 0x28, 0xb2, 0xb9, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -325,6 +359,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MaintenanceWindows) > 0 {
+		for iNdEx := len(m.MaintenanceWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaintenanceWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.ScheduledDrsUpgrades) > 0 {
+		for iNdEx := len(m.ScheduledDrsUpgrades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledDrsUpgrades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.OwnershipTransfers) > 0 {
+		for iNdEx := len(m.OwnershipTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OwnershipTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.ObsoleteDrsVersions) > 0 {
 		dAtA2 := make([]byte, len(m.ObsoleteDrsVersions)*10)
 		var j1 int
@@ -636,6 +712,24 @@ func (m *GenesisState) Size() (n int) {
 		}
 		n += 1 + sovGenesis(uint64(l)) + l
 	}
+	if len(m.OwnershipTransfers) > 0 {
+		for _, e := range m.OwnershipTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScheduledDrsUpgrades) > 0 {
+		for _, e := range m.ScheduledDrsUpgrades {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MaintenanceWindows) > 0 {
+		for _, e := range m.MaintenanceWindows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1124,6 +1218,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field ObsoleteDrsVersions", wireType)
 			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnershipTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnershipTransfers = append(m.OwnershipTransfers, OwnershipTransfer{})
+			if err := m.OwnershipTransfers[len(m.OwnershipTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledDrsUpgrades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledDrsUpgrades = append(m.ScheduledDrsUpgrades, ScheduledDRSUpgrade{})
+			if err := m.ScheduledDrsUpgrades[len(m.ScheduledDrsUpgrades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaintenanceWindows = append(m.MaintenanceWindows, MaintenanceWindow{})
+			if err := m.MaintenanceWindows[len(m.MaintenanceWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
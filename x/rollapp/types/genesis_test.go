@@ -5,10 +5,13 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
 	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	owner, newOwner := sample.AccAddress(), sample.AccAddress()
+
 	for _, tc := range []struct {
 		desc     string
 		genState *types.GenesisState
@@ -62,6 +65,9 @@ func TestGenesisState_Validate(t *testing.T) {
 					},
 				},
 				ObsoleteDrsVersions: []uint32{1, 2},
+				OwnershipTransfers: []types.OwnershipTransfer{
+					{RollappId: "0", CurrentOwner: owner, NewOwner: newOwner},
+				},
 			},
 			valid: true,
 		},
@@ -170,6 +176,27 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated OwnershipTransfers",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				OwnershipTransfers: []types.OwnershipTransfer{
+					{RollappId: "rollapp1", CurrentOwner: owner, NewOwner: newOwner},
+					{RollappId: "rollapp1", CurrentOwner: owner, NewOwner: newOwner},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid OwnershipTransfers new owner",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				OwnershipTransfers: []types.OwnershipTransfer{
+					{RollappId: "rollapp1", CurrentOwner: owner, NewOwner: "invalid"},
+				},
+			},
+			valid: false,
+		},
 		{
 			desc: "empty RollappId in RegisteredDenoms",
 			genState: &types.GenesisState{
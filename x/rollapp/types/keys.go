@@ -32,6 +32,14 @@ const (
 	ObsoleteDRSVersionsKeyPrefix = "obsoleteDRSVersions/value/"
 	// KeyRegisteredDenomPrefix is the prefix to retrieve all RegisteredDenom
 	KeyRegisteredDenomPrefix = "RegisteredDenom/value/"
+	// OwnershipTransferKeyPrefix is the prefix to retrieve all pending OwnershipTransfer
+	OwnershipTransferKeyPrefix = "ownershipTransfer/value/"
+	// OwnershipTransferExpiryKeyPrefix is the prefix to retrieve the pending OwnershipTransfer by expiry
+	OwnershipTransferExpiryKeyPrefix = "ownershipTransfer/expiry/"
+	// ScheduledDRSUpgradeKeyPrefix is the prefix to retrieve all pending ScheduledDRSUpgrade
+	ScheduledDRSUpgradeKeyPrefix = "scheduledDRSUpgrade/value/"
+	// MaintenanceWindowKeyPrefix is the prefix to retrieve all MaintenanceWindow
+	MaintenanceWindowKeyPrefix = "maintenanceWindow/value/"
 )
 
 var SeqToUnfinalizedHeightKeyPrefix = collections.NewPrefix("seqToFinalizeHeight/")
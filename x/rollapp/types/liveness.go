@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/binary"
+	"errors"
 )
 
 var (
@@ -45,3 +46,18 @@ func LivenessEventQueueKeyToEvent(k []byte) LivenessEvent {
 	ret.RollappId = string(k[l:])
 	return ret
 }
+
+// Active returns whether the hub height is within the window
+func (w MaintenanceWindow) Active(h int64) bool {
+	return w.StartHeight <= h && h < w.EndHeight
+}
+
+func (w MaintenanceWindow) ValidateBasic() error {
+	if w.StartHeight <= 0 {
+		return errors.New("start height must be positive")
+	}
+	if w.EndHeight <= w.StartHeight {
+		return errors.New("end height must be greater than start height")
+	}
+	return nil
+}
@@ -79,8 +79,84 @@ func (m *LivenessEvent) GetHubHeight() int64 {
 	return 0
 }
 
+// MaintenanceWindow is a range of hub heights, declared in advance, during which
+// the rollapp is not expected to be live. The liveness clock is held and no
+// liveness events fire until the window ends.
+type MaintenanceWindow struct {
+	// rollapp_id is the unique identifier of the rollapp chain
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// start_height is the first hub height of the window
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the first hub height after the window
+	EndHeight int64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// declarer is the rollapp owner or proposer which declared the window
+	Declarer string `protobuf:"bytes,4,opt,name=declarer,proto3" json:"declarer,omitempty"`
+}
+
+func (m *MaintenanceWindow) Reset()         { *m = MaintenanceWindow{} }
+func (m *MaintenanceWindow) String() string { return proto.CompactTextString(m) }
+func (*MaintenanceWindow) ProtoMessage()    {}
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e2dfe628b004fdb, []int{1}
+}
+func (m *MaintenanceWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaintenanceWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaintenanceWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceWindow.Merge(m, src)
+}
+func (m *MaintenanceWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceWindow proto.InternalMessageInfo
+
+func (m *MaintenanceWindow) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *MaintenanceWindow) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *MaintenanceWindow) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *MaintenanceWindow) GetDeclarer() string {
+	if m != nil {
+		return m.Declarer
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*LivenessEvent)(nil), "dymensionxyz.dymension.rollapp.LivenessEvent")
+	proto.RegisterType((*MaintenanceWindow)(nil), "dymensionxyz.dymension.rollapp.MaintenanceWindow")
 }
 
 func init() {
@@ -88,23 +164,27 @@ func init() {
 }
 
 var fileDescriptor_0e2dfe628b004fdb = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4d, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x8b, 0xf2, 0x73, 0x72,
-	0x12, 0x0b, 0x0a, 0xf4, 0x73, 0x32, 0xcb, 0x52, 0xf3, 0x52, 0x8b, 0x8b, 0xf5, 0x0a, 0x8a, 0xf2,
-	0x4b, 0xf2, 0x85, 0xe4, 0x90, 0x95, 0xeb, 0xc1, 0x39, 0x7a, 0x50, 0xe5, 0x52, 0x22, 0xe9, 0xf9,
-	0xe9, 0xf9, 0x60, 0xa5, 0xfa, 0x20, 0x16, 0x44, 0x97, 0x94, 0x3e, 0x01, 0x4b, 0x8a, 0x4b, 0x12,
-	0x4b, 0x52, 0xe3, 0x33, 0xf3, 0xd2, 0x60, 0x1a, 0xe4, 0x92, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xf5,
-	0x93, 0x12, 0x8b, 0x53, 0xf5, 0xcb, 0x0c, 0x93, 0x52, 0x4b, 0x12, 0x0d, 0xf5, 0x93, 0xf3, 0x33,
-	0xf3, 0x20, 0xf2, 0x4a, 0xc1, 0x5c, 0xbc, 0x3e, 0x50, 0x87, 0xb9, 0x96, 0xa5, 0xe6, 0x95, 0x08,
-	0xc9, 0x72, 0x71, 0x41, 0x0d, 0x8b, 0xcf, 0x4c, 0x91, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0xe2,
-	0x84, 0x8a, 0x78, 0xa6, 0x80, 0xa4, 0x33, 0x4a, 0x93, 0xe2, 0x33, 0x52, 0x33, 0xd3, 0x33, 0x4a,
-	0x24, 0x98, 0x14, 0x18, 0x35, 0x98, 0x83, 0x38, 0x33, 0x4a, 0x93, 0x3c, 0xc0, 0x02, 0x5e, 0x2c,
-	0x1c, 0xcc, 0x02, 0x2c, 0x4e, 0x7e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0,
-	0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10,
-	0x65, 0x92, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0x8b, 0xcb, 0x2b, 0x65, 0xc6,
-	0xfa, 0x15, 0x70, 0xff, 0x94, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xdd, 0x6a, 0x0c, 0x08,
-	0x00, 0x00, 0xff, 0xff, 0x0d, 0x3f, 0x53, 0x4d, 0x63, 0x01, 0x00, 0x00,
+	// 313 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x90, 0xcf, 0x4a, 0x33, 0x31,
+	0x14, 0xc5, 0x9b, 0xaf, 0xe5, 0xa3, 0x8d, 0x0a, 0x5a, 0x5c, 0x94, 0x82, 0xa1, 0x76, 0xd5, 0x8d,
+	0x13, 0x4a, 0x7d, 0x02, 0x41, 0x50, 0x51, 0x17, 0x75, 0x21, 0xb8, 0x29, 0xc9, 0xe4, 0x3a, 0x13,
+	0x98, 0xde, 0x0c, 0x93, 0x74, 0x6c, 0x7d, 0x8a, 0x3e, 0x96, 0xcb, 0x2e, 0x5d, 0x4a, 0xe7, 0x45,
+	0x64, 0xfe, 0x74, 0xd0, 0x85, 0x74, 0x97, 0x7b, 0xce, 0xb9, 0xbf, 0x1b, 0x0e, 0xbd, 0x50, 0xab,
+	0x39, 0xa0, 0xd5, 0x06, 0x97, 0xab, 0x77, 0x5e, 0x0f, 0x3c, 0x31, 0x51, 0x24, 0xe2, 0x98, 0x47,
+	0x3a, 0x05, 0x04, 0x6b, 0xbd, 0x38, 0x31, 0xce, 0x74, 0xd9, 0xcf, 0xb8, 0x57, 0x0f, 0x5e, 0x15,
+	0xef, 0x9f, 0x06, 0x26, 0x30, 0x45, 0x94, 0xe7, 0xaf, 0x72, 0xab, 0xcf, 0xf7, 0x1c, 0xb1, 0x4e,
+	0x38, 0x98, 0x69, 0x7c, 0xdd, 0x2d, 0x30, 0xdf, 0xd8, 0xb9, 0xb1, 0x5c, 0x0a, 0x0b, 0x3c, 0x1d,
+	0x4b, 0x70, 0x62, 0xcc, 0x7d, 0xa3, 0xb1, 0xf4, 0x87, 0x4f, 0xf4, 0xe8, 0xbe, 0xfa, 0xd8, 0x75,
+	0x0a, 0xe8, 0xba, 0x67, 0x94, 0x56, 0xb0, 0x99, 0x56, 0x3d, 0x32, 0x20, 0xa3, 0xce, 0xb4, 0x53,
+	0x29, 0xb7, 0x2a, 0xb7, 0xc3, 0x85, 0x9c, 0x85, 0xa0, 0x83, 0xd0, 0xf5, 0xfe, 0x0d, 0xc8, 0xa8,
+	0x39, 0xed, 0x84, 0x0b, 0x79, 0x53, 0x08, 0x77, 0xad, 0x76, 0xf3, 0xb8, 0x35, 0x5c, 0x13, 0x7a,
+	0xf2, 0x20, 0x34, 0x3a, 0x40, 0x81, 0x3e, 0x3c, 0x6b, 0x54, 0xe6, 0x6d, 0x1f, 0xf9, 0x9c, 0x1e,
+	0x5a, 0x27, 0x12, 0xf7, 0x9b, 0x7d, 0x50, 0x68, 0x25, 0x3d, 0x27, 0x00, 0xaa, 0x5d, 0xa0, 0x59,
+	0x1e, 0x07, 0x54, 0x95, 0xdd, 0xa7, 0x6d, 0x05, 0x7e, 0x24, 0x12, 0x48, 0x7a, 0xad, 0x02, 0x5f,
+	0xcf, 0x57, 0x8f, 0x1f, 0x5b, 0x46, 0x36, 0x5b, 0x46, 0xbe, 0xb6, 0x8c, 0xac, 0x33, 0xd6, 0xd8,
+	0x64, 0xac, 0xf1, 0x99, 0xb1, 0xc6, 0xcb, 0x65, 0xa0, 0x5d, 0xb8, 0x90, 0x9e, 0x6f, 0xe6, 0x7f,
+	0xb5, 0x9b, 0x4e, 0xf8, 0xb2, 0xae, 0xd8, 0xad, 0x62, 0xb0, 0xf2, 0x7f, 0x51, 0xdf, 0xe4, 0x7b,
+	0x00, 0x10, 0x85, 0x58, 0x62, 0xf6, 0x01, 0x00, 0x00,
 }
 
 func (m *LivenessEvent) Marshal() (dAtA []byte, err error) {
@@ -142,6 +222,53 @@ func (m *LivenessEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MaintenanceWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Declarer) > 0 {
+		i -= len(m.Declarer)
+		copy(dAtA[i:], m.Declarer)
+		i = encodeVarintLiveness(dAtA, i, uint64(len(m.Declarer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintLiveness(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintLiveness(dAtA []byte, offset int, v uint64) int {
 	offset -= sovLiveness(v)
 	base := offset
@@ -169,6 +296,29 @@ func (m *LivenessEvent) Size() (n int) {
 	return n
 }
 
+func (m *MaintenanceWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovLiveness(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovLiveness(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovLiveness(uint64(m.EndHeight))
+	}
+	l = len(m.Declarer)
+	if l > 0 {
+		n += 1 + l + sovLiveness(uint64(l))
+	}
+	return n
+}
+
 func sovLiveness(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -276,6 +426,158 @@ func (m *LivenessEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MaintenanceWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiveness
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaintenanceWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaintenanceWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Declarer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Declarer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiveness(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipLiveness(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
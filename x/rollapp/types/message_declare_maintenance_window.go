@@ -0,0 +1,36 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgDeclareMaintenanceWindow{}
+
+func NewMsgDeclareMaintenanceWindow(
+	creator,
+	rollappId string,
+	startHeight int64,
+	endHeight int64,
+) *MsgDeclareMaintenanceWindow {
+	return &MsgDeclareMaintenanceWindow{
+		Creator:     creator,
+		RollappId:   rollappId,
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+	}
+}
+
+func (msg *MsgDeclareMaintenanceWindow) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Join(ErrInvalidAddress, err)
+	}
+
+	return MaintenanceWindow{
+		RollappId:   msg.RollappId,
+		StartHeight: msg.StartHeight,
+		EndHeight:   msg.EndHeight,
+		Declarer:    msg.Creator,
+	}.ValidateBasic()
+}
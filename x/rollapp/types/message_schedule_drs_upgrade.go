@@ -0,0 +1,39 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgScheduleDRSUpgrade{}
+
+func NewMsgScheduleDRSUpgrade(
+	signer,
+	rollappId string,
+	height uint64,
+	drsVersion uint32,
+) *MsgScheduleDRSUpgrade {
+	return &MsgScheduleDRSUpgrade{
+		Signer:     signer,
+		RollappId:  rollappId,
+		Height:     height,
+		DrsVersion: drsVersion,
+	}
+}
+
+func (msg *MsgScheduleDRSUpgrade) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errors.Join(ErrInvalidAddress, err)
+	}
+
+	if msg.Height == 0 {
+		return errors.New("height must be positive")
+	}
+
+	if msg.DrsVersion == 0 {
+		return errors.New("DRS version must be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgSunsetRollapp{}
+
+func NewMsgSunsetRollapp(
+	owner,
+	rollappId string,
+	finalHeight uint64,
+) *MsgSunsetRollapp {
+	return &MsgSunsetRollapp{
+		Owner:       owner,
+		RollappId:   rollappId,
+		FinalHeight: finalHeight,
+	}
+}
+
+func (msg *MsgSunsetRollapp) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errors.Join(ErrInvalidAddress, err)
+	}
+
+	if msg.FinalHeight == 0 {
+		return errors.New("final height must be positive")
+	}
+
+	return nil
+}
@@ -4,7 +4,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var _ sdk.Msg = &MsgTransferOwnership{}
+var (
+	_ sdk.Msg = &MsgTransferOwnership{}
+	_ sdk.Msg = &MsgAcceptOwnership{}
+	_ sdk.Msg = &MsgCancelOwnershipTransfer{}
+)
 
 func NewMsgTransferOwnership(
 	currentOwner,
@@ -29,3 +33,39 @@ func (msg *MsgTransferOwnership) ValidateBasic() error {
 
 	return nil
 }
+
+func NewMsgAcceptOwnership(
+	newOwner,
+	rollappId string,
+) *MsgAcceptOwnership {
+	return &MsgAcceptOwnership{
+		NewOwner:  newOwner,
+		RollappId: rollappId,
+	}
+}
+
+func (msg *MsgAcceptOwnership) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.NewOwner); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewMsgCancelOwnershipTransfer(
+	owner,
+	rollappId string,
+) *MsgCancelOwnershipTransfer {
+	return &MsgCancelOwnershipTransfer{
+		Owner:     owner,
+		RollappId: rollappId,
+	}
+}
+
+func (msg *MsgCancelOwnershipTransfer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return err
+	}
+
+	return nil
+}
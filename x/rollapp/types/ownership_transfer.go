@@ -0,0 +1,27 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (t OwnershipTransfer) ValidateBasic() error {
+	if t.RollappId == "" {
+		return errors.New("empty rollapp id")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(t.CurrentOwner); err != nil {
+		return errors.Join(ErrInvalidAddress, err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(t.NewOwner); err != nil {
+		return errors.Join(ErrInvalidAddress, err)
+	}
+
+	if t.CurrentOwner == t.NewOwner {
+		return ErrSameOwner
+	}
+
+	return nil
+}
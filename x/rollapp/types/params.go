@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -24,6 +25,14 @@ const (
 
 	DefaultLivenessSlashBlocks   = uint64(7200) // 12 hours worth of blocks at 1 block per 6 seconds
 	DefaultLivenessSlashInterval = uint64(600)  // 1 hour worth of blocks at 1 block per 6 seconds
+
+	// DefaultOwnershipTransferExpiry gives the new owner (possibly a multisig) enough time to accept
+	DefaultOwnershipTransferExpiry = 7 * 24 * time.Hour
+	// DefaultSunsetWithdrawalWindow gives users enough time to bridge their funds back to the hub
+	DefaultSunsetWithdrawalWindow = 14 * 24 * time.Hour
+
+	DefaultMaintenanceWindowMaxBlocks   = uint64(3600)   // 6 hours worth of blocks at 1 block per 6 seconds
+	DefaultMaintenanceWindowMinInterval = uint64(100800) // 1 week worth of blocks at 1 block per 6 seconds
 )
 
 // NewParams creates a new Params instance
@@ -33,13 +42,22 @@ func NewParams(
 	livenessSlashInterval uint64,
 	appRegistrationFee sdk.Coin,
 	minSequencerBondGlobal sdk.Coin,
+	ownershipTransferExpiry time.Duration,
+	sunsetWithdrawalWindow time.Duration,
+	maintenanceWindowMaxBlocks uint64,
+	maintenanceWindowMinInterval uint64,
 ) Params {
 	return Params{
-		DisputePeriodInBlocks:  disputePeriodInBlocks,
-		LivenessSlashBlocks:    livenessSlashBlocks,
-		LivenessSlashInterval:  livenessSlashInterval,
-		AppRegistrationFee:     appRegistrationFee,
-		MinSequencerBondGlobal: minSequencerBondGlobal,
+		DisputePeriodInBlocks:   disputePeriodInBlocks,
+		LivenessSlashBlocks:     livenessSlashBlocks,
+		LivenessSlashInterval:   livenessSlashInterval,
+		AppRegistrationFee:      appRegistrationFee,
+		MinSequencerBondGlobal:  minSequencerBondGlobal,
+		OwnershipTransferExpiry: ownershipTransferExpiry,
+		SunsetWithdrawalWindow:  sunsetWithdrawalWindow,
+
+		MaintenanceWindowMaxBlocks:   maintenanceWindowMaxBlocks,
+		MaintenanceWindowMinInterval: maintenanceWindowMinInterval,
 	}
 }
 
@@ -51,6 +69,10 @@ func DefaultParams() Params {
 		DefaultLivenessSlashInterval,
 		DefaultAppRegistrationFee,
 		DefaultMinSequencerBondGlobalCoin,
+		DefaultOwnershipTransferExpiry,
+		DefaultSunsetWithdrawalWindow,
+		DefaultMaintenanceWindowMaxBlocks,
+		DefaultMaintenanceWindowMinInterval,
 	)
 }
 
@@ -88,6 +110,18 @@ func (p Params) ValidateBasic() error {
 	if err := uparam.ValidateCoin(p.MinSequencerBondGlobal); err != nil {
 		return errorsmod.Wrap(err, "min sequencer bond")
 	}
+	if err := validateOwnershipTransferExpiry(p.OwnershipTransferExpiry); err != nil {
+		return errorsmod.Wrap(err, "ownership transfer expiry")
+	}
+	if err := validateSunsetWithdrawalWindow(p.SunsetWithdrawalWindow); err != nil {
+		return errorsmod.Wrap(err, "sunset withdrawal window")
+	}
+	if err := uparam.ValidatePositiveUint64(p.MaintenanceWindowMaxBlocks); err != nil {
+		return errorsmod.Wrap(err, "maintenance window max blocks")
+	}
+	if err := uparam.ValidatePositiveUint64(p.MaintenanceWindowMinInterval); err != nil {
+		return errorsmod.Wrap(err, "maintenance window min interval")
+	}
 	return nil
 }
 
@@ -119,6 +153,22 @@ func validateDisputePeriodInBlocks(disputePeriodInBlocks uint64) error {
 	return nil
 }
 
+func validateOwnershipTransferExpiry(v time.Duration) error {
+	if v <= 0 {
+		return fmt.Errorf("must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateSunsetWithdrawalWindow(v time.Duration) error {
+	if v <= 0 {
+		return fmt.Errorf("must be positive: %s", v)
+	}
+
+	return nil
+}
+
 func validateAppRegistrationFee(v sdk.Coin) error {
 	if !v.IsValid() {
 		return fmt.Errorf("invalid app creation cost: %s", v)
@@ -8,15 +8,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -40,6 +44,18 @@ type Params struct {
 	AppRegistrationFee types.Coin `protobuf:"bytes,7,opt,name=app_registration_fee,json=appRegistrationFee,proto3" json:"app_registration_fee" yaml:"app_registration_fee"`
 	// no rollapp can have a minimum less than this (in dym)
 	MinSequencerBondGlobal types.Coin `protobuf:"bytes,8,opt,name=min_sequencer_bond_global,json=minSequencerBondGlobal,proto3" json:"min_sequencer_bond_global" yaml:"min_sequencer_bond_global"`
+	// ownership_transfer_expiry is how long a proposed ownership transfer can be
+	// accepted by the new owner before it expires
+	OwnershipTransferExpiry time.Duration `protobuf:"bytes,9,opt,name=ownership_transfer_expiry,json=ownershipTransferExpiry,proto3,stdduration" json:"ownership_transfer_expiry" yaml:"ownership_transfer_expiry"`
+	// sunset_withdrawal_window is how long users have to withdraw their funds
+	// from a rollapp after its owner announced a sunset
+	SunsetWithdrawalWindow time.Duration `protobuf:"bytes,10,opt,name=sunset_withdrawal_window,json=sunsetWithdrawalWindow,proto3,stdduration" json:"sunset_withdrawal_window" yaml:"sunset_withdrawal_window"`
+	// maintenance_window_max_blocks is the max length (num hub blocks) of a
+	// maintenance window
+	MaintenanceWindowMaxBlocks uint64 `protobuf:"varint,11,opt,name=maintenance_window_max_blocks,json=maintenanceWindowMaxBlocks,proto3" json:"maintenance_window_max_blocks,omitempty" yaml:"maintenance_window_max_blocks"`
+	// maintenance_window_min_interval is the min gap (num hub blocks) between the
+	// starts of two maintenance windows of the same rollapp
+	MaintenanceWindowMinInterval uint64 `protobuf:"varint,12,opt,name=maintenance_window_min_interval,json=maintenanceWindowMinInterval,proto3" json:"maintenance_window_min_interval,omitempty" yaml:"maintenance_window_min_interval"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -109,6 +125,34 @@ func (m *Params) GetMinSequencerBondGlobal() types.Coin {
 	return types.Coin{}
 }
 
+func (m *Params) GetOwnershipTransferExpiry() time.Duration {
+	if m != nil {
+		return m.OwnershipTransferExpiry
+	}
+	return 0
+}
+
+func (m *Params) GetSunsetWithdrawalWindow() time.Duration {
+	if m != nil {
+		return m.SunsetWithdrawalWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaintenanceWindowMaxBlocks() uint64 {
+	if m != nil {
+		return m.MaintenanceWindowMaxBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaintenanceWindowMinInterval() uint64 {
+	if m != nil {
+		return m.MaintenanceWindowMinInterval
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.rollapp.Params")
 }
@@ -118,36 +162,47 @@ func init() {
 }
 
 var fileDescriptor_75a44aa904ae1ba5 = []byte{
-	// 454 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcd, 0x6e, 0xd3, 0x40,
-	0x14, 0x85, 0x6d, 0x6a, 0xd2, 0xc8, 0x6c, 0x22, 0xd3, 0x80, 0x5b, 0xd0, 0x38, 0x72, 0x37, 0x91,
-	0x90, 0x3c, 0x2a, 0x65, 0xd5, 0xa5, 0x91, 0x40, 0xcd, 0x02, 0x55, 0x2e, 0xab, 0x0a, 0x69, 0x34,
-	0xb6, 0x07, 0x77, 0xc4, 0x78, 0xee, 0xe0, 0x71, 0xa2, 0x86, 0x05, 0xcf, 0xc0, 0x92, 0x25, 0xe2,
-	0x69, 0xba, 0xec, 0x92, 0x95, 0x85, 0x92, 0x37, 0xc8, 0x13, 0xa0, 0xf8, 0x27, 0x2a, 0x28, 0x11,
-	0xbb, 0xb9, 0xe7, 0x9c, 0xf9, 0x8e, 0x74, 0x75, 0xed, 0x17, 0xe9, 0x3c, 0x67, 0x52, 0x73, 0x90,
-	0x37, 0xf3, 0x2f, 0x78, 0x33, 0xe0, 0x02, 0x84, 0xa0, 0x4a, 0x61, 0x45, 0x0b, 0x9a, 0xeb, 0x40,
-	0x15, 0x50, 0x82, 0x83, 0xee, 0x87, 0x83, 0xcd, 0x10, 0xb4, 0xe1, 0xa3, 0x83, 0x0c, 0x32, 0xa8,
-	0xa3, 0x78, 0xfd, 0x6a, 0x7e, 0x1d, 0xa1, 0x04, 0x74, 0x0e, 0x1a, 0xc7, 0x54, 0x33, 0x3c, 0x3b,
-	0x89, 0x59, 0x49, 0x4f, 0x70, 0x02, 0x5c, 0x36, 0xbe, 0xff, 0xd3, 0xb2, 0x7b, 0x17, 0x75, 0x8d,
-	0xf3, 0xc1, 0x76, 0x53, 0xae, 0xd5, 0xb4, 0x64, 0x44, 0xb1, 0x82, 0x43, 0x4a, 0xb8, 0x24, 0xb1,
-	0x80, 0xe4, 0x93, 0x76, 0xcd, 0x91, 0x39, 0xb6, 0xc2, 0xe3, 0x55, 0xe5, 0x79, 0x73, 0x9a, 0x8b,
-	0x33, 0x7f, 0x57, 0xd2, 0x8f, 0x86, 0xad, 0x75, 0x51, 0x3b, 0xe7, 0x32, 0xac, 0x75, 0xe7, 0xbd,
-	0x3d, 0x14, 0x7c, 0xc6, 0x24, 0xd3, 0x9a, 0x68, 0x41, 0xf5, 0x75, 0x87, 0xb6, 0x6a, 0xf4, 0x68,
-	0x55, 0x79, 0xcf, 0x1b, 0xf4, 0xd6, 0x98, 0x1f, 0x3d, 0xee, 0xf4, 0xcb, 0xb5, 0xdc, 0x52, 0xaf,
-	0xec, 0xa7, 0xff, 0xc4, 0xb9, 0x2c, 0x59, 0x31, 0xa3, 0xc2, 0x7d, 0x58, 0x73, 0xfd, 0x55, 0xe5,
-	0xa1, 0xad, 0xdc, 0x2e, 0xe8, 0x47, 0xc3, 0xbf, 0xc8, 0xe7, 0xad, 0xee, 0x28, 0xfb, 0x80, 0x2a,
-	0x45, 0x0a, 0x96, 0x71, 0x5d, 0x16, 0xb4, 0xe4, 0x20, 0xc9, 0x47, 0xc6, 0xdc, 0xfd, 0x91, 0x39,
-	0x7e, 0xf4, 0xf2, 0x30, 0x68, 0x36, 0x1b, 0xac, 0x37, 0x1b, 0xb4, 0x9b, 0x0d, 0x5e, 0x03, 0x97,
-	0xe1, 0xf1, 0x6d, 0xe5, 0x19, 0xab, 0xca, 0x7b, 0xd6, 0xf4, 0x6e, 0x83, 0xf8, 0x91, 0x43, 0x95,
-	0x8a, 0xee, 0xa9, 0x6f, 0x18, 0x73, 0xbe, 0xda, 0x87, 0x39, 0x97, 0x44, 0xb3, 0xcf, 0x53, 0x26,
-	0x13, 0x56, 0x90, 0x18, 0x64, 0x4a, 0x32, 0x01, 0x31, 0x15, 0x6e, 0xff, 0x7f, 0xb5, 0xe3, 0xb6,
-	0x76, 0xd4, 0xd4, 0xee, 0x24, 0xf9, 0xd1, 0x93, 0x9c, 0xcb, 0xcb, 0xce, 0x0a, 0x41, 0xa6, 0x6f,
-	0x6b, 0xe3, 0xcc, 0xfa, 0xfe, 0xc3, 0x33, 0x26, 0x56, 0xff, 0xc1, 0x60, 0x6f, 0x62, 0xf5, 0xf7,
-	0x06, 0xd6, 0xc4, 0xea, 0xf7, 0x06, 0xfb, 0xe1, 0xbb, 0xdb, 0x05, 0x32, 0xef, 0x16, 0xc8, 0xfc,
-	0xbd, 0x40, 0xe6, 0xb7, 0x25, 0x32, 0xee, 0x96, 0xc8, 0xf8, 0xb5, 0x44, 0xc6, 0xd5, 0xab, 0x8c,
-	0x97, 0xd7, 0xd3, 0x38, 0x48, 0x20, 0xc7, 0x3b, 0x8e, 0x79, 0x76, 0x8a, 0x6f, 0x36, 0x17, 0x5d,
-	0xce, 0x15, 0xd3, 0x71, 0xaf, 0xbe, 0xbd, 0xd3, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x96, 0xbb,
-	0xec, 0xbf, 0x00, 0x03, 0x00, 0x00,
+	// 638 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x4d, 0x4f, 0xd4, 0x40,
+	0x18, 0xde, 0x4a, 0x85, 0xb5, 0x78, 0x20, 0x95, 0x8f, 0x82, 0xd8, 0x6e, 0x8a, 0x31, 0x1b, 0x31,
+	0x6d, 0x10, 0x4f, 0x1c, 0xd7, 0xaf, 0x40, 0xa2, 0x21, 0x85, 0x84, 0x84, 0x98, 0x4c, 0xa6, 0xdb,
+	0xa1, 0x3b, 0xa1, 0x9d, 0x19, 0x66, 0xba, 0x5f, 0x1e, 0x8c, 0x89, 0x47, 0x2f, 0x1e, 0x39, 0xfa,
+	0x73, 0x38, 0x72, 0xf4, 0x54, 0x0d, 0xfc, 0x83, 0xfd, 0x05, 0x66, 0xa7, 0xd3, 0x75, 0xd1, 0x5d,
+	0xb9, 0xf5, 0x7d, 0x9f, 0xe7, 0x7d, 0x9e, 0x37, 0x6f, 0x9f, 0x8c, 0xb1, 0x19, 0xf5, 0x53, 0x44,
+	0x04, 0xa6, 0xa4, 0xd7, 0xff, 0xe8, 0x8f, 0x0a, 0x9f, 0xd3, 0x24, 0x81, 0x8c, 0xf9, 0x0c, 0x72,
+	0x98, 0x0a, 0x8f, 0x71, 0x9a, 0x51, 0xd3, 0x1e, 0x27, 0x7b, 0xa3, 0xc2, 0x53, 0xe4, 0xb5, 0xc5,
+	0x98, 0xc6, 0x54, 0x52, 0xfd, 0xe1, 0x57, 0x31, 0xb5, 0x66, 0x37, 0xa9, 0x48, 0xa9, 0xf0, 0x43,
+	0x28, 0x90, 0xdf, 0xd9, 0x0a, 0x51, 0x06, 0xb7, 0xfc, 0x26, 0xc5, 0xa4, 0xc4, 0x63, 0x4a, 0xe3,
+	0x04, 0xf9, 0xb2, 0x0a, 0xdb, 0x27, 0x7e, 0xd4, 0xe6, 0x30, 0x1b, 0xea, 0xca, 0x8e, 0xfb, 0xb5,
+	0x6a, 0xcc, 0xee, 0xcb, 0x35, 0xcc, 0x0f, 0x86, 0x15, 0x61, 0xc1, 0xda, 0x19, 0x02, 0x0c, 0x71,
+	0x4c, 0x23, 0x80, 0x09, 0x08, 0x13, 0xda, 0x3c, 0x15, 0x96, 0x56, 0xd3, 0xea, 0x7a, 0x63, 0x63,
+	0x90, 0x3b, 0x4e, 0x1f, 0xa6, 0xc9, 0x8e, 0x3b, 0x8d, 0xe9, 0x06, 0x4b, 0x0a, 0xda, 0x97, 0xc8,
+	0x2e, 0x69, 0xc8, 0xbe, 0x79, 0x68, 0x2c, 0x25, 0xb8, 0x83, 0x08, 0x12, 0x02, 0x88, 0x04, 0x8a,
+	0x56, 0x29, 0xad, 0x4b, 0xe9, 0xda, 0x20, 0x77, 0xd6, 0x0b, 0xe9, 0x89, 0x34, 0x37, 0x78, 0x50,
+	0xf6, 0x0f, 0x86, 0x6d, 0xa5, 0x7a, 0x6c, 0xac, 0xfc, 0x45, 0xc7, 0x24, 0x43, 0xbc, 0x03, 0x13,
+	0xeb, 0xae, 0xd4, 0x75, 0x07, 0xb9, 0x63, 0x4f, 0xd4, 0x2d, 0x89, 0x6e, 0xb0, 0x74, 0x43, 0x79,
+	0x57, 0xf5, 0x4d, 0x66, 0x2c, 0x42, 0xc6, 0x00, 0x47, 0x31, 0x16, 0x59, 0x71, 0x34, 0x70, 0x82,
+	0x90, 0x35, 0x57, 0xd3, 0xea, 0xf3, 0xcf, 0x57, 0xbd, 0xe2, 0xf2, 0xde, 0xf0, 0xf2, 0x9e, 0xba,
+	0xbc, 0xf7, 0x92, 0x62, 0xd2, 0xd8, 0xb8, 0xc8, 0x9d, 0xca, 0x20, 0x77, 0x1e, 0x16, 0xbe, 0x93,
+	0x44, 0xdc, 0xc0, 0x84, 0x8c, 0x05, 0x63, 0xdd, 0x37, 0x08, 0x99, 0x9f, 0x8c, 0xd5, 0x14, 0x13,
+	0x20, 0xd0, 0x59, 0x1b, 0x91, 0x26, 0xe2, 0x20, 0xa4, 0x24, 0x02, 0x71, 0x42, 0x43, 0x98, 0x58,
+	0xd5, 0xdb, 0x6c, 0xeb, 0xca, 0xb6, 0x56, 0xd8, 0x4e, 0x55, 0x72, 0x83, 0xe5, 0x14, 0x93, 0x83,
+	0x12, 0x6a, 0x50, 0x12, 0xbd, 0x95, 0x80, 0xf9, 0x45, 0x33, 0x56, 0x69, 0x97, 0x20, 0x2e, 0x5a,
+	0x98, 0x81, 0x8c, 0x43, 0x22, 0x4e, 0x10, 0x07, 0xa8, 0xc7, 0x30, 0xef, 0x5b, 0xf7, 0xd4, 0x02,
+	0x45, 0xa2, 0xbc, 0x32, 0x51, 0xde, 0x2b, 0x95, 0xa8, 0xc6, 0xb3, 0x9b, 0x0b, 0x4c, 0x55, 0x72,
+	0xcf, 0x7f, 0x3a, 0x5a, 0xb0, 0x32, 0xc2, 0x0f, 0x15, 0xfc, 0x5a, 0xa2, 0xe6, 0x67, 0xcd, 0xb0,
+	0x44, 0x9b, 0x08, 0x94, 0x81, 0x2e, 0xce, 0x5a, 0x11, 0x87, 0x5d, 0x98, 0x80, 0x2e, 0x26, 0x11,
+	0xed, 0x5a, 0xc6, 0x6d, 0x4b, 0x6c, 0xaa, 0x25, 0x54, 0x4e, 0xa7, 0x09, 0x15, 0x3b, 0x2c, 0x17,
+	0xf0, 0xd1, 0x08, 0x3d, 0x92, 0xa0, 0x79, 0x6a, 0x3c, 0x4a, 0xe1, 0x30, 0x20, 0x04, 0x92, 0x26,
+	0x52, 0x23, 0x20, 0x85, 0xbd, 0x32, 0xb4, 0xf3, 0x32, 0x5c, 0xf5, 0x41, 0xee, 0x3c, 0x56, 0xd7,
+	0xfe, 0x1f, 0xdd, 0x0d, 0xd6, 0xc6, 0xf0, 0xc2, 0xe3, 0x1d, 0xec, 0xa9, 0x0c, 0x9f, 0x19, 0xce,
+	0xa4, 0x69, 0x4c, 0xfe, 0x64, 0xf9, 0xbe, 0xb4, 0x7b, 0x3a, 0xc8, 0x9d, 0x27, 0xd3, 0xed, 0xc6,
+	0x06, 0xdc, 0x60, 0xfd, 0x5f, 0x43, 0x4c, 0xca, 0x68, 0xef, 0xe8, 0xe7, 0xdf, 0x9d, 0xca, 0x9e,
+	0x5e, 0xbd, 0xb3, 0x30, 0xb3, 0xa7, 0x57, 0x67, 0x16, 0xf4, 0x3d, 0xbd, 0x3a, 0xbb, 0x30, 0xd7,
+	0x78, 0x7f, 0x71, 0x65, 0x6b, 0x97, 0x57, 0xb6, 0xf6, 0xeb, 0xca, 0xd6, 0xbe, 0x5d, 0xdb, 0x95,
+	0xcb, 0x6b, 0xbb, 0xf2, 0xe3, 0xda, 0xae, 0x1c, 0xbf, 0x88, 0x71, 0xd6, 0x6a, 0x87, 0x5e, 0x93,
+	0xa6, 0xfe, 0x94, 0x57, 0xad, 0xb3, 0xed, 0xf7, 0x46, 0x4f, 0x5b, 0xd6, 0x67, 0x48, 0x84, 0xb3,
+	0xf2, 0xff, 0x6c, 0xff, 0x1e, 0x00, 0x93, 0x1a, 0xa3, 0x50, 0x09, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -170,6 +225,32 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaintenanceWindowMinInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaintenanceWindowMinInterval))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MaintenanceWindowMaxBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaintenanceWindowMaxBlocks))
+		i--
+		dAtA[i] = 0x58
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SunsetWithdrawalWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SunsetWithdrawalWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x52
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.OwnershipTransferExpiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.OwnershipTransferExpiry):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x4a
 	{
 		size, err := m.MinSequencerBondGlobal.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -238,6 +319,16 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.MinSequencerBondGlobal.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.OwnershipTransferExpiry)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SunsetWithdrawalWindow)
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaintenanceWindowMaxBlocks != 0 {
+		n += 1 + sovParams(uint64(m.MaintenanceWindowMaxBlocks))
+	}
+	if m.MaintenanceWindowMinInterval != 0 {
+		n += 1 + sovParams(uint64(m.MaintenanceWindowMinInterval))
+	}
 	return n
 }
 
@@ -399,6 +490,110 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnershipTransferExpiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.OwnershipTransferExpiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SunsetWithdrawalWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SunsetWithdrawalWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceWindowMaxBlocks", wireType)
+			}
+			m.MaintenanceWindowMaxBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenanceWindowMaxBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceWindowMinInterval", wireType)
+			}
+			m.MaintenanceWindowMinInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenanceWindowMinInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
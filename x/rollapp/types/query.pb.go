@@ -977,6 +977,279 @@ func (m *QueryValidateGenesisBridgeResponse) GetErr() string {
 	return ""
 }
 
+type QueryOwnershipTransferRequest struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+}
+
+func (m *QueryOwnershipTransferRequest) Reset()         { *m = QueryOwnershipTransferRequest{} }
+func (m *QueryOwnershipTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOwnershipTransferRequest) ProtoMessage()    {}
+func (*QueryOwnershipTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00a0238fb38306fa, []int{19}
+}
+func (m *QueryOwnershipTransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOwnershipTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOwnershipTransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOwnershipTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOwnershipTransferRequest.Merge(m, src)
+}
+func (m *QueryOwnershipTransferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOwnershipTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOwnershipTransferRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOwnershipTransferRequest proto.InternalMessageInfo
+
+func (m *QueryOwnershipTransferRequest) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+type QueryOwnershipTransferResponse struct {
+	Transfer OwnershipTransfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer"`
+}
+
+func (m *QueryOwnershipTransferResponse) Reset()         { *m = QueryOwnershipTransferResponse{} }
+func (m *QueryOwnershipTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOwnershipTransferResponse) ProtoMessage()    {}
+func (*QueryOwnershipTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00a0238fb38306fa, []int{20}
+}
+func (m *QueryOwnershipTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOwnershipTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOwnershipTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOwnershipTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOwnershipTransferResponse.Merge(m, src)
+}
+func (m *QueryOwnershipTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOwnershipTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOwnershipTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOwnershipTransferResponse proto.InternalMessageInfo
+
+func (m *QueryOwnershipTransferResponse) GetTransfer() OwnershipTransfer {
+	if m != nil {
+		return m.Transfer
+	}
+	return OwnershipTransfer{}
+}
+
+type QueryScheduledDRSUpgradeRequest struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+}
+
+func (m *QueryScheduledDRSUpgradeRequest) Reset()         { *m = QueryScheduledDRSUpgradeRequest{} }
+func (m *QueryScheduledDRSUpgradeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledDRSUpgradeRequest) ProtoMessage()    {}
+func (*QueryScheduledDRSUpgradeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00a0238fb38306fa, []int{21}
+}
+func (m *QueryScheduledDRSUpgradeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledDRSUpgradeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledDRSUpgradeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledDRSUpgradeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledDRSUpgradeRequest.Merge(m, src)
+}
+func (m *QueryScheduledDRSUpgradeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledDRSUpgradeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledDRSUpgradeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledDRSUpgradeRequest proto.InternalMessageInfo
+
+func (m *QueryScheduledDRSUpgradeRequest) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+type QueryScheduledDRSUpgradeResponse struct {
+	Upgrade ScheduledDRSUpgrade `protobuf:"bytes,1,opt,name=upgrade,proto3" json:"upgrade"`
+}
+
+func (m *QueryScheduledDRSUpgradeResponse) Reset()         { *m = QueryScheduledDRSUpgradeResponse{} }
+func (m *QueryScheduledDRSUpgradeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledDRSUpgradeResponse) ProtoMessage()    {}
+func (*QueryScheduledDRSUpgradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00a0238fb38306fa, []int{22}
+}
+func (m *QueryScheduledDRSUpgradeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledDRSUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledDRSUpgradeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledDRSUpgradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledDRSUpgradeResponse.Merge(m, src)
+}
+func (m *QueryScheduledDRSUpgradeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledDRSUpgradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledDRSUpgradeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledDRSUpgradeResponse proto.InternalMessageInfo
+
+func (m *QueryScheduledDRSUpgradeResponse) GetUpgrade() ScheduledDRSUpgrade {
+	if m != nil {
+		return m.Upgrade
+	}
+	return ScheduledDRSUpgrade{}
+}
+
+type QueryMaintenanceWindowRequest struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+}
+
+func (m *QueryMaintenanceWindowRequest) Reset()         { *m = QueryMaintenanceWindowRequest{} }
+func (m *QueryMaintenanceWindowRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMaintenanceWindowRequest) ProtoMessage()    {}
+func (*QueryMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00a0238fb38306fa, []int{23}
+}
+func (m *QueryMaintenanceWindowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMaintenanceWindowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMaintenanceWindowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMaintenanceWindowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMaintenanceWindowRequest.Merge(m, src)
+}
+func (m *QueryMaintenanceWindowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMaintenanceWindowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMaintenanceWindowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMaintenanceWindowRequest proto.InternalMessageInfo
+
+func (m *QueryMaintenanceWindowRequest) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+type QueryMaintenanceWindowResponse struct {
+	Window MaintenanceWindow `protobuf:"bytes,1,opt,name=window,proto3" json:"window"`
+	// active is whether the current hub height is within the window
+	Active bool `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (m *QueryMaintenanceWindowResponse) Reset()         { *m = QueryMaintenanceWindowResponse{} }
+func (m *QueryMaintenanceWindowResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMaintenanceWindowResponse) ProtoMessage()    {}
+func (*QueryMaintenanceWindowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00a0238fb38306fa, []int{24}
+}
+func (m *QueryMaintenanceWindowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMaintenanceWindowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMaintenanceWindowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMaintenanceWindowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMaintenanceWindowResponse.Merge(m, src)
+}
+func (m *QueryMaintenanceWindowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMaintenanceWindowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMaintenanceWindowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMaintenanceWindowResponse proto.InternalMessageInfo
+
+func (m *QueryMaintenanceWindowResponse) GetWindow() MaintenanceWindow {
+	if m != nil {
+		return m.Window
+	}
+	return MaintenanceWindow{}
+}
+
+func (m *QueryMaintenanceWindowResponse) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "dymensionxyz.dymension.rollapp.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "dymensionxyz.dymension.rollapp.QueryParamsResponse")
@@ -997,6 +1270,12 @@ func init() {
 	proto.RegisterType((*QueryObsoleteDRSVersionsResponse)(nil), "dymensionxyz.dymension.rollapp.QueryObsoleteDRSVersionsResponse")
 	proto.RegisterType((*QueryValidateGenesisBridgeRequest)(nil), "dymensionxyz.dymension.rollapp.QueryValidateGenesisBridgeRequest")
 	proto.RegisterType((*QueryValidateGenesisBridgeResponse)(nil), "dymensionxyz.dymension.rollapp.QueryValidateGenesisBridgeResponse")
+	proto.RegisterType((*QueryOwnershipTransferRequest)(nil), "dymensionxyz.dymension.rollapp.QueryOwnershipTransferRequest")
+	proto.RegisterType((*QueryOwnershipTransferResponse)(nil), "dymensionxyz.dymension.rollapp.QueryOwnershipTransferResponse")
+	proto.RegisterType((*QueryScheduledDRSUpgradeRequest)(nil), "dymensionxyz.dymension.rollapp.QueryScheduledDRSUpgradeRequest")
+	proto.RegisterType((*QueryScheduledDRSUpgradeResponse)(nil), "dymensionxyz.dymension.rollapp.QueryScheduledDRSUpgradeResponse")
+	proto.RegisterType((*QueryMaintenanceWindowRequest)(nil), "dymensionxyz.dymension.rollapp.QueryMaintenanceWindowRequest")
+	proto.RegisterType((*QueryMaintenanceWindowResponse)(nil), "dymensionxyz.dymension.rollapp.QueryMaintenanceWindowResponse")
 }
 
 func init() {
@@ -1004,82 +1283,98 @@ func init() {
 }
 
 var fileDescriptor_00a0238fb38306fa = []byte{
-	// 1199 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0x38, 0x8e, 0x13, 0xbf, 0x14, 0x35, 0x9a, 0x86, 0x10, 0xdc, 0xe0, 0x26, 0x8b, 0xd4,
-	0xba, 0x05, 0x79, 0xe5, 0x04, 0x37, 0x8d, 0x42, 0x4a, 0x1d, 0xa5, 0x09, 0x29, 0xa5, 0x84, 0x0d,
-	0x14, 0x01, 0x42, 0xd6, 0xba, 0x3b, 0x71, 0x16, 0xad, 0x77, 0xb7, 0x3b, 0x9b, 0x28, 0x6e, 0x14,
-	0x09, 0x21, 0xce, 0x08, 0x89, 0x7b, 0x25, 0xfe, 0x01, 0xae, 0x9c, 0x11, 0x97, 0x08, 0x71, 0xa8,
-	0xc4, 0x01, 0x2e, 0x20, 0x94, 0xf0, 0x3f, 0x70, 0x45, 0x9e, 0x79, 0xbb, 0xfe, 0x11, 0xdb, 0xbb,
-	0x71, 0x7b, 0xb2, 0x67, 0xf2, 0xde, 0x37, 0xdf, 0x37, 0xf3, 0x7e, 0x39, 0x70, 0xc3, 0xa8, 0xd7,
-	0x98, 0xcd, 0x4d, 0xc7, 0x3e, 0xa8, 0x3f, 0x51, 0xc3, 0x85, 0xea, 0x39, 0x96, 0xa5, 0xbb, 0xae,
-	0xfa, 0x78, 0x8f, 0x79, 0xf5, 0xbc, 0xeb, 0x39, 0xbe, 0x43, 0xb3, 0xad, 0xb6, 0xf9, 0x70, 0x91,
-	0x47, 0xdb, 0xcc, 0x64, 0xd5, 0xa9, 0x3a, 0xc2, 0x54, 0x6d, 0x7c, 0x93, 0x5e, 0x99, 0x99, 0xaa,
-	0xe3, 0x54, 0x2d, 0xa6, 0xea, 0xae, 0xa9, 0xea, 0xb6, 0xed, 0xf8, 0xba, 0x6f, 0x3a, 0x36, 0xc7,
-	0xbf, 0xde, 0x78, 0xe4, 0xf0, 0x9a, 0xc3, 0xd5, 0x8a, 0xce, 0x99, 0x3c, 0x4c, 0xdd, 0x2f, 0x54,
-	0x98, 0xaf, 0x17, 0x54, 0x57, 0xaf, 0x9a, 0xb6, 0x30, 0x46, 0xdb, 0x37, 0x22, 0xb8, 0xba, 0xba,
-	0xa7, 0xd7, 0x02, 0xe0, 0x37, 0x23, 0x8c, 0xf1, 0x13, 0xad, 0xd5, 0x08, 0x6b, 0xee, 0xeb, 0x3e,
-	0x2b, 0x9b, 0xf6, 0x4e, 0xa0, 0x2a, 0x17, 0xe1, 0xd0, 0x84, 0xbe, 0x15, 0x61, 0x59, 0x65, 0x36,
-	0xe3, 0x26, 0x2f, 0x57, 0x3c, 0xd3, 0xa8, 0xb2, 0xb2, 0xa1, 0xfb, 0xba, 0xf4, 0x54, 0x26, 0x81,
-	0x7e, 0xd8, 0xb8, 0x91, 0x2d, 0xa1, 0x4b, 0x63, 0x8f, 0xf7, 0x18, 0xf7, 0x95, 0xcf, 0xe1, 0x52,
-	0xdb, 0x2e, 0x77, 0x1d, 0x9b, 0x33, 0xba, 0x06, 0x29, 0xa9, 0x7f, 0x9a, 0xcc, 0x92, 0xdc, 0xf8,
-	0xfc, 0xd5, 0x7c, 0xff, 0xd7, 0xca, 0x4b, 0xff, 0xd5, 0xe4, 0xf1, 0xdf, 0x57, 0x86, 0x34, 0xf4,
-	0x55, 0xb6, 0x61, 0x4a, 0x80, 0x6f, 0x30, 0x5f, 0x93, 0x76, 0x78, 0x2c, 0x9d, 0x81, 0x34, 0x7a,
-	0x6e, 0x1a, 0xe2, 0x88, 0xb4, 0xd6, 0xdc, 0xa0, 0x97, 0x21, 0xed, 0xd4, 0x4c, 0xbf, 0xac, 0xbb,
-	0x2e, 0x9f, 0x4e, 0xcc, 0x92, 0xdc, 0x98, 0x36, 0xd6, 0xd8, 0x28, 0xb9, 0x2e, 0x57, 0x3e, 0x86,
-	0x6c, 0x07, 0xe8, 0x6a, 0xfd, 0xee, 0xe6, 0x56, 0xa1, 0x58, 0x0c, 0xc0, 0xa7, 0x20, 0xc5, 0x4c,
-	0xb7, 0x50, 0x2c, 0x0a, 0xe4, 0xa4, 0x86, 0xab, 0xfe, 0xb0, 0x9f, 0xc2, 0xe5, 0x00, 0xf6, 0xbe,
-	0xee, 0x33, 0xee, 0xbf, 0xcb, 0xcc, 0xea, 0xae, 0x1f, 0x8f, 0xf0, 0x0c, 0xa4, 0x77, 0x4c, 0x5b,
-	0xb7, 0xcc, 0x27, 0xcc, 0x40, 0xe4, 0xe6, 0x86, 0x72, 0x13, 0x66, 0xba, 0x43, 0xe3, 0x65, 0x4f,
-	0x41, 0x6a, 0x57, 0xec, 0x04, 0x7c, 0xe5, 0x4a, 0xf9, 0x02, 0xae, 0xb4, 0xfb, 0x6d, 0x37, 0xe2,
-	0x66, 0xd3, 0x36, 0xd8, 0xc1, 0x8b, 0xa0, 0x75, 0x00, 0xb3, 0xbd, 0xe1, 0x91, 0xda, 0x47, 0x00,
-	0x3c, 0xdc, 0xc5, 0x58, 0xc8, 0x47, 0xc5, 0x02, 0xe2, 0xec, 0x38, 0xc2, 0x0b, 0x63, 0xa2, 0x05,
-	0x47, 0xf9, 0x8f, 0xc0, 0x2b, 0x67, 0x02, 0x03, 0x4f, 0xdc, 0x80, 0x51, 0xc4, 0xc1, 0xe3, 0xae,
-	0x45, 0x1d, 0x17, 0x44, 0x81, 0x3c, 0x27, 0xf0, 0xa6, 0x0f, 0x60, 0x94, 0xef, 0xd5, 0x6a, 0xba,
-	0x57, 0x9f, 0x4e, 0xc5, 0xe3, 0x8d, 0x40, 0xdb, 0xd2, 0x2b, 0xc0, 0x43, 0x10, 0xba, 0x02, 0x49,
-	0x11, 0x38, 0xa3, 0xb3, 0xc3, 0xb9, 0xf1, 0xf9, 0xd7, 0xa3, 0xc0, 0x4a, 0xc8, 0x88, 0x68, 0xc2,
-	0xed, 0x5e, 0x72, 0x2c, 0x31, 0x91, 0x52, 0x8e, 0x30, 0x23, 0x4a, 0x96, 0xd5, 0x91, 0x11, 0xeb,
-	0x00, 0xcd, 0x12, 0x15, 0x66, 0x9d, 0xac, 0x67, 0xf9, 0x46, 0x3d, 0xcb, 0xcb, 0xe2, 0x89, 0xf5,
-	0x2c, 0xbf, 0xa5, 0x57, 0x19, 0xfa, 0x6a, 0x2d, 0x9e, 0xfd, 0x83, 0xfc, 0xe7, 0xe0, 0xe2, 0x5b,
-	0xcf, 0xc7, 0x8b, 0xff, 0xa4, 0x79, 0xf1, 0xc3, 0x42, 0xe2, 0x62, 0x94, 0xc4, 0x1e, 0x4f, 0xd8,
-	0xf9, 0x10, 0x1b, 0x6d, 0xca, 0x12, 0xf8, 0xa8, 0x51, 0xca, 0x24, 0x56, 0xab, 0xb4, 0x7b, 0xc9,
-	0x31, 0x32, 0x91, 0x50, 0xbe, 0x21, 0x30, 0x1d, 0x9c, 0x1c, 0x46, 0x5a, 0xbc, 0x7c, 0x98, 0x84,
-	0x11, 0x53, 0x04, 0x72, 0x42, 0xe4, 0x99, 0x5c, 0xb4, 0xa4, 0xdf, 0x70, 0x6b, 0xfa, 0xb5, 0x67,
-	0x4f, 0xb2, 0x33, 0x7b, 0xbe, 0x84, 0x57, 0xbb, 0xb0, 0xc0, 0xbb, 0x7c, 0x1f, 0xd2, 0x3c, 0xd8,
-	0xc4, 0xb7, 0xbc, 0x1e, 0x3b, 0x6b, 0xf0, 0xfe, 0x9a, 0x08, 0x0d, 0xc9, 0xb2, 0x82, 0x68, 0xac,
-	0x6a, 0x72, 0x9f, 0x79, 0xcc, 0x58, 0x63, 0xb6, 0x13, 0x56, 0xf1, 0x08, 0xd9, 0xeb, 0x5d, 0x1e,
-	0x60, 0x80, 0xd0, 0x52, 0xbe, 0x22, 0xf0, 0x5a, 0x0f, 0x1a, 0xcd, 0x4a, 0x66, 0x88, 0x9d, 0x69,
-	0x32, 0x3b, 0x9c, 0x4b, 0x6b, 0xb8, 0x7a, 0x61, 0x21, 0xa0, 0xcc, 0x61, 0x49, 0xfc, 0xa0, 0xc2,
-	0x1d, 0x8b, 0xf9, 0x6c, 0x4d, 0xdb, 0x7e, 0xc8, 0xbc, 0xc6, 0x3d, 0x86, 0x1d, 0xed, 0x2e, 0x96,
-	0xb5, 0xae, 0x26, 0xc8, 0x73, 0x0e, 0x2e, 0x18, 0x1e, 0x2f, 0xef, 0xe3, 0xbe, 0x60, 0xfb, 0x92,
-	0x36, 0x6e, 0x78, 0x3c, 0x30, 0x55, 0xbe, 0x25, 0x30, 0x27, 0x70, 0x1e, 0xea, 0x96, 0x69, 0xe8,
-	0x3e, 0xdb, 0x90, 0x9d, 0x75, 0x55, 0x34, 0xd6, 0x78, 0x17, 0xff, 0x1e, 0x24, 0x1b, 0x0d, 0x18,
-	0x05, 0x17, 0xa2, 0x22, 0xa0, 0xed, 0x84, 0x35, 0xdd, 0xd7, 0x31, 0x12, 0x04, 0x88, 0x72, 0x1f,
-	0x94, 0x7e, 0x7c, 0x50, 0xd9, 0x24, 0x8c, 0xec, 0x37, 0x0c, 0x04, 0x99, 0x31, 0x4d, 0x2e, 0xe8,
-	0x04, 0x0c, 0x33, 0xcf, 0x13, 0x3c, 0xd2, 0x5a, 0xe3, 0xeb, 0xfc, 0xd3, 0x8b, 0x30, 0x22, 0xe0,
-	0xe8, 0x0f, 0x04, 0x52, 0xb2, 0x7b, 0xd3, 0xf9, 0x58, 0x19, 0xdf, 0x36, 0x40, 0x64, 0x16, 0xce,
-	0xe5, 0x23, 0x59, 0x2a, 0xf9, 0xaf, 0x7f, 0xff, 0xf7, 0xfb, 0x44, 0x8e, 0x5e, 0x55, 0x63, 0x0d,
-	0x61, 0xf4, 0x27, 0x02, 0xa3, 0x58, 0x65, 0xe8, 0xcd, 0x73, 0x97, 0x25, 0x49, 0x74, 0xd0, 0x72,
-	0xa6, 0x2c, 0x0b, 0xb2, 0x45, 0xba, 0xa0, 0xc6, 0x1b, 0x02, 0xd5, 0xc3, 0x30, 0x02, 0x8e, 0xe8,
-	0x2f, 0x04, 0x2e, 0x76, 0x8c, 0x29, 0xf4, 0xf6, 0x39, 0x99, 0x74, 0xcc, 0x37, 0x83, 0x2b, 0x59,
-	0x14, 0x4a, 0x0a, 0x54, 0x8d, 0x52, 0x22, 0x07, 0x26, 0xf5, 0x50, 0x7e, 0x1e, 0xd1, 0x1f, 0x09,
-	0x00, 0x82, 0x95, 0x2c, 0x2b, 0xe6, 0x13, 0x9c, 0xe9, 0x71, 0x31, 0x89, 0x9f, 0xed, 0x4d, 0x8a,
-	0x2a, 0x88, 0x5f, 0xa7, 0xd7, 0x62, 0x3e, 0x01, 0xfd, 0x8d, 0xc0, 0x85, 0xd6, 0x59, 0x8b, 0x2e,
-	0xc7, 0xbd, 0xb3, 0x2e, 0xc3, 0x5f, 0xe6, 0xed, 0xc1, 0x9c, 0x91, 0x7c, 0x49, 0x90, 0x5f, 0xa6,
-	0x4b, 0x51, 0xe4, 0x2d, 0xe1, 0x5d, 0x96, 0xed, 0xa7, 0x2d, 0x8a, 0xfe, 0x22, 0x30, 0xd1, 0x39,
-	0xa3, 0xd1, 0x77, 0xce, 0xc7, 0xea, 0xcc, 0xf0, 0x98, 0xb9, 0x33, 0x38, 0x00, 0x4a, 0x5b, 0x17,
-	0xd2, 0xee, 0xd0, 0xdb, 0x31, 0xa5, 0x05, 0x3f, 0x7c, 0x0c, 0x76, 0xd0, 0xa6, 0xef, 0x98, 0x40,
-	0x3a, 0xec, 0x7f, 0xf4, 0x56, 0x5c, 0x5e, 0x9d, 0xed, 0x3f, 0xb3, 0x34, 0x80, 0xe7, 0x79, 0xa5,
-	0x34, 0x7f, 0xbc, 0xb5, 0x4a, 0x50, 0x0f, 0x85, 0xaa, 0x23, 0xfa, 0x2b, 0x81, 0x89, 0xce, 0xfe,
-	0x48, 0xe3, 0x05, 0x50, 0x8f, 0xee, 0x9e, 0x59, 0x19, 0xd0, 0x1b, 0x95, 0x2d, 0x09, 0x65, 0x0b,
-	0xb4, 0x10, 0x99, 0x3c, 0x21, 0x42, 0x19, 0xfb, 0xf6, 0x1f, 0x04, 0x2e, 0x75, 0xe9, 0xa3, 0x31,
-	0x43, 0xaf, 0x77, 0x93, 0x8e, 0x19, 0x7a, 0x7d, 0x5a, 0xb8, 0xb2, 0x22, 0x54, 0x2d, 0xd2, 0x62,
-	0x94, 0x2a, 0x07, 0x41, 0xca, 0xad, 0x1d, 0x9f, 0x3e, 0x25, 0xf0, 0x72, 0xd7, 0x4e, 0x4a, 0x4b,
-	0xb1, 0xa8, 0xf5, 0x9b, 0x0a, 0x32, 0xab, 0xcf, 0x03, 0x81, 0x43, 0xf4, 0x83, 0xe3, 0x93, 0x2c,
-	0x79, 0x76, 0x92, 0x25, 0xff, 0x9c, 0x64, 0xc9, 0x77, 0xa7, 0xd9, 0xa1, 0x67, 0xa7, 0xd9, 0xa1,
-	0x3f, 0x4f, 0xb3, 0x43, 0x9f, 0xbd, 0x55, 0x35, 0xfd, 0xdd, 0xbd, 0x4a, 0xfe, 0x91, 0x53, 0xeb,
-	0xa5, 0x7d, 0x7f, 0x41, 0x3d, 0x08, 0x2f, 0xc0, 0xaf, 0xbb, 0x8c, 0x57, 0x52, 0xe2, 0xbf, 0x00,
-	0x0b, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0xf8, 0x0a, 0xfa, 0xcf, 0xa3, 0x11, 0x00, 0x00,
+	// 1449 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xce, 0x24, 0xdb, 0x4d, 0xf6, 0xb5, 0x88, 0x30, 0x0d, 0x25, 0xb8, 0xe9, 0x76, 0x6b, 0xa4,
+	0x36, 0x2d, 0xb0, 0xd6, 0x26, 0xa4, 0x69, 0x55, 0x9a, 0x66, 0xa3, 0xb4, 0xa1, 0xa5, 0xbf, 0xf0,
+	0xf6, 0x87, 0x00, 0xa1, 0xd5, 0x24, 0x9e, 0x6c, 0x8c, 0xbc, 0xb6, 0x6b, 0x7b, 0x93, 0x6c, 0xab,
+	0x48, 0x08, 0x71, 0xe1, 0x82, 0x90, 0xb8, 0x23, 0xf1, 0x0f, 0x70, 0xe5, 0x5c, 0x71, 0xa9, 0x10,
+	0x87, 0x4a, 0x1c, 0x40, 0x42, 0x20, 0xd4, 0xf6, 0x7f, 0xe0, 0x8a, 0x3c, 0x7e, 0xf6, 0xfe, 0x74,
+	0xec, 0x5d, 0x7a, 0x6a, 0x66, 0x3a, 0xef, 0x9b, 0xef, 0x7b, 0xef, 0xcd, 0xcc, 0xe7, 0x85, 0x33,
+	0x5a, 0xb3, 0xce, 0x4d, 0x57, 0xb7, 0xcc, 0xdd, 0xe6, 0x43, 0x25, 0x1a, 0x28, 0x8e, 0x65, 0x18,
+	0xcc, 0xb6, 0x95, 0x07, 0x0d, 0xee, 0x34, 0x8b, 0xb6, 0x63, 0x79, 0x16, 0xcd, 0xb7, 0xaf, 0x2d,
+	0x46, 0x83, 0x22, 0xae, 0x95, 0xa6, 0x6a, 0x56, 0xcd, 0x12, 0x4b, 0x15, 0xff, 0xaf, 0x20, 0x4a,
+	0x9a, 0xa9, 0x59, 0x56, 0xcd, 0xe0, 0x0a, 0xb3, 0x75, 0x85, 0x99, 0xa6, 0xe5, 0x31, 0x4f, 0xb7,
+	0x4c, 0x17, 0xff, 0xf7, 0xcc, 0x86, 0xe5, 0xd6, 0x2d, 0x57, 0x59, 0x67, 0x2e, 0x0f, 0x36, 0x53,
+	0xb6, 0x4b, 0xeb, 0xdc, 0x63, 0x25, 0xc5, 0x66, 0x35, 0xdd, 0x14, 0x8b, 0x71, 0xed, 0xdb, 0x09,
+	0x5c, 0x6d, 0xe6, 0xb0, 0x7a, 0x08, 0xfc, 0x4e, 0xc2, 0x62, 0xfc, 0x17, 0x57, 0x2b, 0x09, 0xab,
+	0x5d, 0x8f, 0x79, 0xbc, 0xaa, 0x9b, 0x9b, 0xa1, 0xaa, 0xd9, 0x84, 0x80, 0x16, 0xf4, 0xb9, 0x84,
+	0x95, 0x35, 0x6e, 0x72, 0x57, 0x77, 0xab, 0xeb, 0x8e, 0xae, 0xd5, 0x78, 0x55, 0x63, 0x1e, 0xc3,
+	0xc8, 0x77, 0x13, 0x22, 0x0d, 0x7d, 0xdb, 0x8f, 0x45, 0xc5, 0xf2, 0x14, 0xd0, 0x8f, 0xfc, 0x04,
+	0xde, 0x16, 0x69, 0x50, 0xf9, 0x83, 0x06, 0x77, 0x3d, 0xf9, 0x53, 0x38, 0xdc, 0x31, 0xeb, 0xda,
+	0x96, 0xe9, 0x72, 0xba, 0x0a, 0xd9, 0x20, 0x5d, 0xd3, 0xa4, 0x40, 0x66, 0x0f, 0xce, 0x9d, 0x2c,
+	0xee, 0x5f, 0xdc, 0x62, 0x10, 0xbf, 0x92, 0x79, 0xf2, 0xf7, 0xf1, 0x11, 0x15, 0x63, 0xe5, 0x0a,
+	0x1c, 0x11, 0xe0, 0x6b, 0xdc, 0x53, 0x83, 0x75, 0xb8, 0x2d, 0x9d, 0x81, 0x1c, 0x46, 0x5e, 0xd5,
+	0xc4, 0x16, 0x39, 0xb5, 0x35, 0x41, 0x8f, 0x42, 0xce, 0xaa, 0xeb, 0x5e, 0x95, 0xd9, 0xb6, 0x3b,
+	0x3d, 0x5a, 0x20, 0xb3, 0x13, 0xea, 0x84, 0x3f, 0x51, 0xb6, 0x6d, 0x57, 0xbe, 0x0b, 0xf9, 0x2e,
+	0xd0, 0x95, 0xe6, 0xe5, 0xab, 0xb7, 0x4b, 0x0b, 0x0b, 0x21, 0xf8, 0x11, 0xc8, 0x72, 0xdd, 0x2e,
+	0x2d, 0x2c, 0x08, 0xe4, 0x8c, 0x8a, 0xa3, 0xfd, 0x61, 0x3f, 0x86, 0xa3, 0x21, 0xec, 0x75, 0xe6,
+	0x71, 0xd7, 0xfb, 0x80, 0xeb, 0xb5, 0x2d, 0x2f, 0x1d, 0xe1, 0x19, 0xc8, 0x6d, 0xea, 0x26, 0x33,
+	0xf4, 0x87, 0x5c, 0x43, 0xe4, 0xd6, 0x84, 0x7c, 0x16, 0x66, 0xfa, 0x43, 0x63, 0xb2, 0x8f, 0x40,
+	0x76, 0x4b, 0xcc, 0x84, 0x7c, 0x83, 0x91, 0xfc, 0x19, 0x1c, 0xef, 0x8c, 0xab, 0xf8, 0x6d, 0x76,
+	0xd5, 0xd4, 0xf8, 0xee, 0xcb, 0xa0, 0xb5, 0x0b, 0x85, 0x78, 0x78, 0xa4, 0x76, 0x07, 0xc0, 0x8d,
+	0x66, 0xb1, 0x17, 0x8a, 0x49, 0xbd, 0x80, 0x38, 0x9b, 0x96, 0x88, 0xc2, 0x9e, 0x68, 0xc3, 0x91,
+	0xff, 0x25, 0xf0, 0x46, 0x4f, 0x63, 0xe0, 0x8e, 0x6b, 0x30, 0x8e, 0x38, 0xb8, 0xdd, 0xa9, 0xa4,
+	0xed, 0xc2, 0x2e, 0x08, 0xf6, 0x09, 0xa3, 0xe9, 0x4d, 0x18, 0x77, 0x1b, 0xf5, 0x3a, 0x73, 0x9a,
+	0xd3, 0xd9, 0x74, 0xbc, 0x11, 0xa8, 0x12, 0x44, 0x85, 0x78, 0x08, 0x42, 0x2f, 0x42, 0x46, 0x34,
+	0xce, 0x78, 0x61, 0x6c, 0xf6, 0xe0, 0xdc, 0x5b, 0x49, 0x60, 0x65, 0x64, 0x44, 0x54, 0x11, 0x76,
+	0x2d, 0x33, 0x31, 0x3a, 0x99, 0x95, 0xf7, 0xf0, 0x44, 0x94, 0x0d, 0xa3, 0xeb, 0x44, 0x5c, 0x01,
+	0x68, 0xdd, 0x68, 0xd1, 0xa9, 0x0b, 0xae, 0xbf, 0xa2, 0x7f, 0xfd, 0x15, 0x83, 0xbb, 0x16, 0xaf,
+	0xbf, 0xe2, 0x6d, 0x56, 0xe3, 0x18, 0xab, 0xb6, 0x45, 0xee, 0xdf, 0xe4, 0x8f, 0xc3, 0xc4, 0xb7,
+	0xef, 0x8f, 0x89, 0xbf, 0xdf, 0x4a, 0xfc, 0x98, 0x90, 0xb8, 0x98, 0x24, 0x31, 0xa6, 0x84, 0xdd,
+	0x85, 0x58, 0xeb, 0x50, 0x36, 0x8a, 0x45, 0x4d, 0x52, 0x16, 0x60, 0xb5, 0x4b, 0xbb, 0x96, 0x99,
+	0x20, 0x93, 0xa3, 0xf2, 0x57, 0x04, 0xa6, 0xc3, 0x9d, 0xa3, 0x4e, 0x4b, 0x77, 0x1e, 0xa6, 0xe0,
+	0x80, 0x2e, 0x1a, 0x79, 0x54, 0x9c, 0xb3, 0x60, 0xd0, 0x76, 0xfc, 0xc6, 0xda, 0x8f, 0x5f, 0xe7,
+	0xe9, 0xc9, 0x74, 0x9f, 0x9e, 0xcf, 0xe1, 0xcd, 0x3e, 0x2c, 0x30, 0x97, 0x37, 0x20, 0xe7, 0x86,
+	0x93, 0x58, 0xcb, 0xd3, 0xa9, 0x4f, 0x0d, 0xe6, 0xaf, 0x85, 0xe0, 0x4b, 0x0e, 0x6e, 0x10, 0x95,
+	0xd7, 0x74, 0xd7, 0xe3, 0x0e, 0xd7, 0x56, 0xb9, 0x69, 0x45, 0xb7, 0x78, 0x82, 0xec, 0x2b, 0x7d,
+	0x0a, 0x30, 0x44, 0x6b, 0xc9, 0x5f, 0x10, 0x38, 0x16, 0x43, 0xa3, 0x75, 0x93, 0x69, 0x62, 0x66,
+	0x9a, 0x14, 0xc6, 0x66, 0x73, 0x2a, 0x8e, 0x5e, 0x5a, 0x0b, 0xc8, 0x27, 0xf0, 0x4a, 0xbc, 0xb5,
+	0xee, 0x5a, 0x06, 0xf7, 0xf8, 0xaa, 0x5a, 0xb9, 0xc7, 0x1d, 0x3f, 0x8f, 0xd1, 0x8b, 0x76, 0x19,
+	0x0a, 0xf1, 0x4b, 0x90, 0xe7, 0x09, 0x38, 0xa4, 0x39, 0x6e, 0x75, 0x1b, 0xe7, 0x05, 0xdb, 0x57,
+	0xd4, 0x83, 0x9a, 0xe3, 0x86, 0x4b, 0xe5, 0x6f, 0x08, 0x9c, 0x10, 0x38, 0xf7, 0x98, 0xa1, 0x6b,
+	0xcc, 0xe3, 0x6b, 0xc1, 0x43, 0xbc, 0x22, 0xde, 0xe1, 0x74, 0x89, 0xff, 0x10, 0x32, 0xfe, 0x7b,
+	0x8d, 0x82, 0x4b, 0x49, 0x1d, 0xd0, 0xb1, 0xc3, 0x2a, 0xf3, 0x18, 0x76, 0x82, 0x00, 0x91, 0xaf,
+	0x83, 0xbc, 0x1f, 0x1f, 0x54, 0x36, 0x05, 0x07, 0xb6, 0xfd, 0x05, 0x82, 0xcc, 0x84, 0x1a, 0x0c,
+	0xe8, 0x24, 0x8c, 0x71, 0xc7, 0x11, 0x3c, 0x72, 0xaa, 0xff, 0xa7, 0xbc, 0x84, 0xa5, 0xbc, 0xb5,
+	0x63, 0x72, 0xc7, 0xdd, 0xd2, 0xed, 0x3b, 0x0e, 0x33, 0xdd, 0x4d, 0xee, 0x84, 0xca, 0x8e, 0x01,
+	0x20, 0xaf, 0xaa, 0xde, 0x2b, 0x4d, 0x6e, 0x40, 0x3e, 0x2e, 0x1e, 0x99, 0x54, 0x60, 0xc2, 0xc3,
+	0xb9, 0x69, 0x92, 0x2e, 0x01, 0x3d, 0x60, 0x98, 0x80, 0x08, 0x48, 0x5e, 0xc6, 0xfa, 0x57, 0x36,
+	0xb6, 0xb8, 0xd6, 0x30, 0xb8, 0xb6, 0xaa, 0x56, 0xee, 0xda, 0x35, 0x87, 0x69, 0x3c, 0x25, 0xf1,
+	0x1d, 0x28, 0xc4, 0x23, 0x44, 0xd4, 0xc7, 0x1b, 0xc1, 0x14, 0x32, 0x9f, 0x4f, 0x3c, 0xbc, 0xbd,
+	0x68, 0xe1, 0x35, 0x88, 0x48, 0x51, 0xc6, 0x6f, 0x30, 0xdd, 0xf4, 0xb8, 0xc9, 0xcc, 0x0d, 0x7e,
+	0x5f, 0x37, 0x35, 0x6b, 0x27, 0x25, 0xf1, 0xaf, 0x09, 0xe4, 0xe3, 0x00, 0x90, 0xf7, 0x2d, 0xc8,
+	0xee, 0x88, 0x99, 0xb4, 0x09, 0xef, 0x81, 0x0a, 0x0d, 0x5c, 0x00, 0xe3, 0x9f, 0x67, 0xb6, 0xe1,
+	0xe9, 0xdb, 0x1c, 0x5f, 0x12, 0x1c, 0xcd, 0x3d, 0x9e, 0x82, 0x03, 0x82, 0x0b, 0xfd, 0x81, 0x40,
+	0x36, 0xf0, 0x7e, 0x74, 0x2e, 0xd5, 0x7b, 0xd1, 0x61, 0x3f, 0xa5, 0xf9, 0x81, 0x62, 0x02, 0x99,
+	0x72, 0xf1, 0xcb, 0xdf, 0x5e, 0x7c, 0x37, 0x3a, 0x4b, 0x4f, 0x2a, 0xa9, 0x1c, 0x3f, 0xfd, 0x89,
+	0xc0, 0x38, 0xbe, 0x51, 0xf4, 0xec, 0xc0, 0x8f, 0x5a, 0x40, 0x74, 0xd8, 0xc7, 0x50, 0xbe, 0x20,
+	0xc8, 0x2e, 0xd0, 0x79, 0x25, 0xdd, 0x17, 0x87, 0xf2, 0x28, 0x2a, 0xf9, 0x1e, 0xfd, 0x99, 0xc0,
+	0xab, 0x5d, 0x26, 0x97, 0x2e, 0x0d, 0xc8, 0xa4, 0xcb, 0x1d, 0x0f, 0xaf, 0x64, 0x51, 0x28, 0x29,
+	0x51, 0x25, 0x49, 0x49, 0x60, 0xb7, 0x95, 0x47, 0xc1, 0xbf, 0x7b, 0xf4, 0x47, 0x02, 0x80, 0x60,
+	0x65, 0xc3, 0x48, 0x59, 0x82, 0x1e, 0x87, 0x24, 0x2d, 0x0e, 0x1c, 0x87, 0xc4, 0x15, 0x41, 0xfc,
+	0x34, 0x3d, 0x95, 0xb2, 0x04, 0xf4, 0x57, 0x02, 0x87, 0xda, 0x9d, 0x3a, 0xbd, 0x90, 0x36, 0x67,
+	0x7d, 0x3e, 0x1d, 0xa4, 0xf7, 0x87, 0x0b, 0x46, 0xf2, 0x65, 0x41, 0xfe, 0x02, 0x3d, 0x9f, 0x44,
+	0xde, 0x10, 0xd1, 0xd5, 0xc0, 0xbc, 0x74, 0x74, 0xd1, 0x5f, 0x04, 0x26, 0xbb, 0x1d, 0x3e, 0xbd,
+	0x34, 0x18, 0xab, 0x9e, 0x4f, 0x0f, 0x69, 0x79, 0x78, 0x00, 0x94, 0x76, 0x45, 0x48, 0x5b, 0xa6,
+	0x4b, 0x29, 0xa5, 0x85, 0x5f, 0xd9, 0x1a, 0xdf, 0xed, 0xd0, 0xf7, 0x84, 0x40, 0x2e, 0x72, 0x4f,
+	0xf4, 0x5c, 0x5a, 0x5e, 0xdd, 0xe6, 0x51, 0x3a, 0x3f, 0x44, 0xe4, 0xa0, 0x52, 0x5a, 0xbf, 0x14,
+	0xb4, 0x4b, 0x50, 0x1e, 0x09, 0x55, 0x7b, 0xf4, 0x17, 0x02, 0x93, 0xdd, 0xee, 0x8a, 0xa6, 0x6b,
+	0xa0, 0x18, 0x6f, 0x28, 0x5d, 0x1c, 0x32, 0x1a, 0x95, 0x9d, 0x17, 0xca, 0xe6, 0x69, 0x29, 0xf1,
+	0xf0, 0x44, 0x08, 0x55, 0x74, 0x7d, 0xbf, 0x13, 0x38, 0xdc, 0xc7, 0x85, 0xa5, 0x6c, 0xbd, 0x78,
+	0x8b, 0x27, 0x2d, 0x0f, 0x0f, 0x80, 0xaa, 0x2e, 0x0a, 0x55, 0x8b, 0x74, 0x21, 0x49, 0x95, 0x85,
+	0x20, 0xd5, 0x76, 0xbf, 0x48, 0xff, 0x24, 0xf0, 0x5a, 0x8f, 0x59, 0xa1, 0xe9, 0x32, 0x1d, 0xe7,
+	0xb8, 0xa4, 0xa5, 0x61, 0xc3, 0x51, 0xd3, 0x9a, 0xd0, 0x54, 0xa6, 0x97, 0x12, 0x35, 0x85, 0x10,
+	0xd5, 0xd0, 0x57, 0x45, 0xbd, 0x58, 0xd5, 0xb5, 0x3d, 0xfa, 0x82, 0xc0, 0xe1, 0x3e, 0x86, 0x26,
+	0x65, 0xdd, 0xe2, 0xad, 0x99, 0xb4, 0x3c, 0x3c, 0x00, 0x6a, 0xbc, 0x26, 0x34, 0xae, 0xd2, 0x95,
+	0xc4, 0x73, 0x16, 0x82, 0x88, 0xc2, 0xa1, 0x07, 0xeb, 0x94, 0xe9, 0x17, 0xb1, 0xc7, 0x00, 0xa5,
+	0x2c, 0x62, 0x9c, 0x89, 0x93, 0x96, 0x86, 0x0d, 0x1f, 0xb4, 0x88, 0xf5, 0x16, 0x44, 0x35, 0x70,
+	0x6b, 0x9d, 0xea, 0xbe, 0x27, 0xf0, 0x7a, 0xdf, 0x4f, 0x05, 0x5a, 0x4e, 0x45, 0x71, 0xbf, 0xcf,
+	0x1e, 0x69, 0xe5, 0xff, 0x40, 0xe0, 0xaf, 0x04, 0x37, 0x9f, 0x3c, 0xcb, 0x93, 0xa7, 0xcf, 0xf2,
+	0xe4, 0x9f, 0x67, 0x79, 0xf2, 0xed, 0xf3, 0xfc, 0xc8, 0xd3, 0xe7, 0xf9, 0x91, 0x3f, 0x9e, 0xe7,
+	0x47, 0x3e, 0x79, 0xaf, 0xa6, 0x7b, 0x5b, 0x8d, 0xf5, 0xe2, 0x86, 0x55, 0x8f, 0xcb, 0xc2, 0xf6,
+	0xbc, 0xb2, 0x1b, 0xa5, 0xc2, 0x6b, 0xda, 0xdc, 0x5d, 0xcf, 0x8a, 0x9f, 0x39, 0xe7, 0xff, 0x1b,
+	0x00, 0xf0, 0x3f, 0x62, 0x71, 0xb3, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1112,6 +1407,12 @@ type QueryClient interface {
 	RegisteredDenoms(ctx context.Context, in *QueryRegisteredDenomsRequest, opts ...grpc.CallOption) (*QueryRegisteredDenomsResponse, error)
 	// Queries a list of obsolete DRS versions.
 	ObsoleteDRSVersions(ctx context.Context, in *QueryObsoleteDRSVersionsRequest, opts ...grpc.CallOption) (*QueryObsoleteDRSVersionsResponse, error)
+	// Queries the pending ownership transfer of a rollapp.
+	OwnershipTransfer(ctx context.Context, in *QueryOwnershipTransferRequest, opts ...grpc.CallOption) (*QueryOwnershipTransferResponse, error)
+	// ScheduledDRSUpgrade queries the pending DRS upgrade of a rollapp
+	ScheduledDRSUpgrade(ctx context.Context, in *QueryScheduledDRSUpgradeRequest, opts ...grpc.CallOption) (*QueryScheduledDRSUpgradeResponse, error)
+	// MaintenanceWindow queries the latest maintenance window of a rollapp
+	MaintenanceWindow(ctx context.Context, in *QueryMaintenanceWindowRequest, opts ...grpc.CallOption) (*QueryMaintenanceWindowResponse, error)
 	// Validates provided genesis bridge data against the hub.
 	ValidateGenesisBridge(ctx context.Context, in *QueryValidateGenesisBridgeRequest, opts ...grpc.CallOption) (*QueryValidateGenesisBridgeResponse, error)
 }
@@ -1205,6 +1506,33 @@ func (c *queryClient) ObsoleteDRSVersions(ctx context.Context, in *QueryObsolete
 	return out, nil
 }
 
+func (c *queryClient) OwnershipTransfer(ctx context.Context, in *QueryOwnershipTransferRequest, opts ...grpc.CallOption) (*QueryOwnershipTransferResponse, error) {
+	out := new(QueryOwnershipTransferResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Query/OwnershipTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledDRSUpgrade(ctx context.Context, in *QueryScheduledDRSUpgradeRequest, opts ...grpc.CallOption) (*QueryScheduledDRSUpgradeResponse, error) {
+	out := new(QueryScheduledDRSUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Query/ScheduledDRSUpgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MaintenanceWindow(ctx context.Context, in *QueryMaintenanceWindowRequest, opts ...grpc.CallOption) (*QueryMaintenanceWindowResponse, error) {
+	out := new(QueryMaintenanceWindowResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Query/MaintenanceWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ValidateGenesisBridge(ctx context.Context, in *QueryValidateGenesisBridgeRequest, opts ...grpc.CallOption) (*QueryValidateGenesisBridgeResponse, error) {
 	out := new(QueryValidateGenesisBridgeResponse)
 	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Query/ValidateGenesisBridge", in, out, opts...)
@@ -1234,6 +1562,12 @@ type QueryServer interface {
 	RegisteredDenoms(context.Context, *QueryRegisteredDenomsRequest) (*QueryRegisteredDenomsResponse, error)
 	// Queries a list of obsolete DRS versions.
 	ObsoleteDRSVersions(context.Context, *QueryObsoleteDRSVersionsRequest) (*QueryObsoleteDRSVersionsResponse, error)
+	// Queries the pending ownership transfer of a rollapp.
+	OwnershipTransfer(context.Context, *QueryOwnershipTransferRequest) (*QueryOwnershipTransferResponse, error)
+	// ScheduledDRSUpgrade queries the pending DRS upgrade of a rollapp
+	ScheduledDRSUpgrade(context.Context, *QueryScheduledDRSUpgradeRequest) (*QueryScheduledDRSUpgradeResponse, error)
+	// MaintenanceWindow queries the latest maintenance window of a rollapp
+	MaintenanceWindow(context.Context, *QueryMaintenanceWindowRequest) (*QueryMaintenanceWindowResponse, error)
 	// Validates provided genesis bridge data against the hub.
 	ValidateGenesisBridge(context.Context, *QueryValidateGenesisBridgeRequest) (*QueryValidateGenesisBridgeResponse, error)
 }
@@ -1269,6 +1603,15 @@ func (*UnimplementedQueryServer) RegisteredDenoms(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) ObsoleteDRSVersions(ctx context.Context, req *QueryObsoleteDRSVersionsRequest) (*QueryObsoleteDRSVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ObsoleteDRSVersions not implemented")
 }
+func (*UnimplementedQueryServer) OwnershipTransfer(ctx context.Context, req *QueryOwnershipTransferRequest) (*QueryOwnershipTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OwnershipTransfer not implemented")
+}
+func (*UnimplementedQueryServer) ScheduledDRSUpgrade(ctx context.Context, req *QueryScheduledDRSUpgradeRequest) (*QueryScheduledDRSUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledDRSUpgrade not implemented")
+}
+func (*UnimplementedQueryServer) MaintenanceWindow(ctx context.Context, req *QueryMaintenanceWindowRequest) (*QueryMaintenanceWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MaintenanceWindow not implemented")
+}
 func (*UnimplementedQueryServer) ValidateGenesisBridge(ctx context.Context, req *QueryValidateGenesisBridgeRequest) (*QueryValidateGenesisBridgeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidateGenesisBridge not implemented")
 }
@@ -1439,6 +1782,60 @@ func _Query_ObsoleteDRSVersions_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_OwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOwnershipTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Query/OwnershipTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OwnershipTransfer(ctx, req.(*QueryOwnershipTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledDRSUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledDRSUpgradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledDRSUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Query/ScheduledDRSUpgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledDRSUpgrade(ctx, req.(*QueryScheduledDRSUpgradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Query/MaintenanceWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MaintenanceWindow(ctx, req.(*QueryMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ValidateGenesisBridge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryValidateGenesisBridgeRequest)
 	if err := dec(in); err != nil {
@@ -1497,6 +1894,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ObsoleteDRSVersions",
 			Handler:    _Query_ObsoleteDRSVersions_Handler,
 		},
+		{
+			MethodName: "OwnershipTransfer",
+			Handler:    _Query_OwnershipTransfer_Handler,
+		},
+		{
+			MethodName: "ScheduledDRSUpgrade",
+			Handler:    _Query_ScheduledDRSUpgrade_Handler,
+		},
+		{
+			MethodName: "MaintenanceWindow",
+			Handler:    _Query_MaintenanceWindow_Handler,
+		},
 		{
 			MethodName: "ValidateGenesisBridge",
 			Handler:    _Query_ValidateGenesisBridge_Handler,
@@ -2245,30 +2654,229 @@ func (m *QueryValidateGenesisBridgeResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryOwnershipTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryOwnershipTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryOwnershipTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOwnershipTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOwnershipTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOwnershipTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Transfer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledDRSUpgradeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledDRSUpgradeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledDRSUpgradeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledDRSUpgradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledDRSUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledDRSUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMaintenanceWindowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMaintenanceWindowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMaintenanceWindowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMaintenanceWindowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMaintenanceWindowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMaintenanceWindowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Window.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = m.Params.Size()
@@ -2541,6 +3149,81 @@ func (m *QueryValidateGenesisBridgeResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryOwnershipTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOwnershipTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Transfer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryScheduledDRSUpgradeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledDRSUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Upgrade.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMaintenanceWindowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMaintenanceWindowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Window.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Active {
+		n += 2
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4455,6 +5138,521 @@ func (m *QueryValidateGenesisBridgeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryOwnershipTransferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOwnershipTransferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOwnershipTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOwnershipTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOwnershipTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOwnershipTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Transfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledDRSUpgradeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledDRSUpgradeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledDRSUpgradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledDRSUpgradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledDRSUpgradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledDRSUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMaintenanceWindowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMaintenanceWindowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMaintenanceWindowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMaintenanceWindowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMaintenanceWindowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMaintenanceWindowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"slices"
 	"strings"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -127,6 +128,16 @@ func (s RollappGenesisState) IsTransferEnabled() bool {
 	return s.TransferProofHeight != 0
 }
 
+// IsSunset returns true if the owner announced a shutdown of the rollapp
+func (r Rollapp) IsSunset() bool {
+	return r.Sunset != nil
+}
+
+// IsRetired returns true if the rollapp is sunset and its withdrawal window is over
+func (r Rollapp) IsRetired(t time.Time) bool {
+	return r.IsSunset() && !t.Before(r.Sunset.WithdrawalWindowEnd)
+}
+
 func (r Rollapp) AllImmutableFieldsAreSet() bool {
 	return r.InitialSequencer != "" && r.GenesisInfo.Launchable() && ValidateBasicMinSeqBondCoins(r.MinSequencerBond) == nil
 }
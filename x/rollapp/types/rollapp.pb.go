@@ -152,6 +152,9 @@ type Rollapp struct {
 	LivenessCountdownStartHeight int64 `protobuf:"varint,18,opt,name=liveness_countdown_start_height,json=livenessCountdownStartHeight,proto3" json:"liveness_countdown_start_height,omitempty"`
 	// Revisions is a list of all the rollapp revisions.
 	Revisions []Revision `protobuf:"bytes,19,rep,name=revisions,proto3" json:"revisions"`
+	// sunset is set once the owner announced the rollapp is shutting down. Nil
+	// means the rollapp is not sunset.
+	Sunset *RollappSunset `protobuf:"bytes,21,opt,name=sunset,proto3" json:"sunset,omitempty"`
 }
 
 func (m *Rollapp) Reset()         { *m = Rollapp{} }
@@ -285,6 +288,135 @@ func (m *Rollapp) GetRevisions() []Revision {
 	return nil
 }
 
+func (m *Rollapp) GetSunset() *RollappSunset {
+	if m != nil {
+		return m.Sunset
+	}
+	return nil
+}
+
+// ScheduledDRSUpgrade is a DRS upgrade the rollapp must perform at a given
+// rollapp height
+type ScheduledDRSUpgrade struct {
+	// rollapp_id is the unique identifier of the rollapp chain.
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// height is the first rollapp height that must run the new DRS version
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// drs_version is the DRS version the rollapp must run from height on
+	DrsVersion uint32 `protobuf:"varint,3,opt,name=drs_version,json=drsVersion,proto3" json:"drs_version,omitempty"`
+}
+
+func (m *ScheduledDRSUpgrade) Reset()         { *m = ScheduledDRSUpgrade{} }
+func (m *ScheduledDRSUpgrade) String() string { return proto.CompactTextString(m) }
+func (*ScheduledDRSUpgrade) ProtoMessage()    {}
+func (*ScheduledDRSUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4ef2bec3aea5528, []int{2}
+}
+func (m *ScheduledDRSUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledDRSUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledDRSUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledDRSUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledDRSUpgrade.Merge(m, src)
+}
+func (m *ScheduledDRSUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledDRSUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledDRSUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledDRSUpgrade proto.InternalMessageInfo
+
+func (m *ScheduledDRSUpgrade) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *ScheduledDRSUpgrade) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ScheduledDRSUpgrade) GetDrsVersion() uint32 {
+	if m != nil {
+		return m.DrsVersion
+	}
+	return 0
+}
+
+// RollappSunset describes a graceful shutdown of a rollapp announced by its
+// owner
+type RollappSunset struct {
+	// final_height is the last rollapp height the hub accepts states for
+	FinalHeight uint64 `protobuf:"varint,1,opt,name=final_height,json=finalHeight,proto3" json:"final_height,omitempty"`
+	// withdrawal_window_end is the time until which users can withdraw their
+	// funds back to the hub. Afterwards the rollapp is retired.
+	WithdrawalWindowEnd time.Time `protobuf:"bytes,2,opt,name=withdrawal_window_end,json=withdrawalWindowEnd,proto3,stdtime" json:"withdrawal_window_end"`
+}
+
+func (m *RollappSunset) Reset()         { *m = RollappSunset{} }
+func (m *RollappSunset) String() string { return proto.CompactTextString(m) }
+func (*RollappSunset) ProtoMessage()    {}
+func (*RollappSunset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4ef2bec3aea5528, []int{3}
+}
+func (m *RollappSunset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollappSunset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollappSunset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollappSunset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollappSunset.Merge(m, src)
+}
+func (m *RollappSunset) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollappSunset) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollappSunset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollappSunset proto.InternalMessageInfo
+
+func (m *RollappSunset) GetFinalHeight() uint64 {
+	if m != nil {
+		return m.FinalHeight
+	}
+	return 0
+}
+
+func (m *RollappSunset) GetWithdrawalWindowEnd() time.Time {
+	if m != nil {
+		return m.WithdrawalWindowEnd
+	}
+	return time.Time{}
+}
+
 // Revision is a representation of the rollapp revision.
 type Revision struct {
 	// Number is the revision number of the rollapp. Always start with 0 revision.
@@ -297,7 +429,7 @@ func (m *Revision) Reset()         { *m = Revision{} }
 func (m *Revision) String() string { return proto.CompactTextString(m) }
 func (*Revision) ProtoMessage()    {}
 func (*Revision) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4ef2bec3aea5528, []int{2}
+	return fileDescriptor_d4ef2bec3aea5528, []int{4}
 }
 func (m *Revision) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -351,13 +483,15 @@ type RollappSummary struct {
 	LatestFinalizedStateIndex *StateInfoIndex `protobuf:"bytes,3,opt,name=latestFinalizedStateIndex,proto3" json:"latestFinalizedStateIndex,omitempty"`
 	LatestHeight              uint64          `protobuf:"varint,4,opt,name=latestHeight,proto3" json:"latestHeight,omitempty"`
 	LatestFinalizedHeight     uint64          `protobuf:"varint,5,opt,name=latestFinalizedHeight,proto3" json:"latestFinalizedHeight,omitempty"`
+	// retired is true if the rollapp was sunset and its withdrawal window is over
+	Retired bool `protobuf:"varint,6,opt,name=retired,proto3" json:"retired,omitempty"`
 }
 
 func (m *RollappSummary) Reset()         { *m = RollappSummary{} }
 func (m *RollappSummary) String() string { return proto.CompactTextString(m) }
 func (*RollappSummary) ProtoMessage()    {}
 func (*RollappSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4ef2bec3aea5528, []int{3}
+	return fileDescriptor_d4ef2bec3aea5528, []int{5}
 }
 func (m *RollappSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -421,12 +555,98 @@ func (m *RollappSummary) GetLatestFinalizedHeight() uint64 {
 	return 0
 }
 
+func (m *RollappSummary) GetRetired() bool {
+	if m != nil {
+		return m.Retired
+	}
+	return false
+}
+
+// OwnershipTransfer is a pending transfer of the rollapp ownership. It is
+// proposed by the current owner and takes effect only once the new owner
+// accepts it, before the expiry.
+type OwnershipTransfer struct {
+	// rollapp_id is the unique identifier of the rollapp chain.
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// current_owner is the bech32-encoded address of the owner who proposed the
+	// transfer
+	CurrentOwner string `protobuf:"bytes,2,opt,name=current_owner,json=currentOwner,proto3" json:"current_owner,omitempty"`
+	// new_owner is the bech32-encoded address of the proposed owner
+	NewOwner string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	// expiry is the time after which the transfer can no longer be accepted
+	Expiry time.Time `protobuf:"bytes,4,opt,name=expiry,proto3,stdtime" json:"expiry"`
+}
+
+func (m *OwnershipTransfer) Reset()         { *m = OwnershipTransfer{} }
+func (m *OwnershipTransfer) String() string { return proto.CompactTextString(m) }
+func (*OwnershipTransfer) ProtoMessage()    {}
+func (*OwnershipTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4ef2bec3aea5528, []int{6}
+}
+func (m *OwnershipTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OwnershipTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OwnershipTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OwnershipTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnershipTransfer.Merge(m, src)
+}
+func (m *OwnershipTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *OwnershipTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnershipTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OwnershipTransfer proto.InternalMessageInfo
+
+func (m *OwnershipTransfer) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *OwnershipTransfer) GetCurrentOwner() string {
+	if m != nil {
+		return m.CurrentOwner
+	}
+	return ""
+}
+
+func (m *OwnershipTransfer) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
+func (m *OwnershipTransfer) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterEnum("dymensionxyz.dymension.rollapp.Rollapp_VMType", Rollapp_VMType_name, Rollapp_VMType_value)
 	proto.RegisterType((*RollappGenesisState)(nil), "dymensionxyz.dymension.rollapp.RollappGenesisState")
 	proto.RegisterType((*Rollapp)(nil), "dymensionxyz.dymension.rollapp.Rollapp")
+	proto.RegisterType((*ScheduledDRSUpgrade)(nil), "dymensionxyz.dymension.rollapp.ScheduledDRSUpgrade")
+	proto.RegisterType((*RollappSunset)(nil), "dymensionxyz.dymension.rollapp.RollappSunset")
 	proto.RegisterType((*Revision)(nil), "dymensionxyz.dymension.rollapp.Revision")
 	proto.RegisterType((*RollappSummary)(nil), "dymensionxyz.dymension.rollapp.RollappSummary")
+	proto.RegisterType((*OwnershipTransfer)(nil), "dymensionxyz.dymension.rollapp.OwnershipTransfer")
 }
 
 func init() {
@@ -434,62 +654,74 @@ func init() {
 }
 
 var fileDescriptor_d4ef2bec3aea5528 = []byte{
-	// 873 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xc1, 0x6e, 0xdb, 0x46,
-	0x10, 0x15, 0x2d, 0xc6, 0xa2, 0x47, 0x8a, 0xcd, 0xac, 0x9c, 0x82, 0x16, 0x12, 0x49, 0xd5, 0x89,
-	0x40, 0x12, 0x12, 0xb6, 0x73, 0xea, 0xad, 0x0a, 0xdc, 0xc4, 0x6e, 0x54, 0x14, 0x94, 0x93, 0x02,
-	0x39, 0x94, 0xa0, 0xc4, 0x15, 0xb5, 0x28, 0xb9, 0xcb, 0x72, 0x57, 0x8a, 0x95, 0xaf, 0xc8, 0xa9,
-	0x1f, 0xd1, 0x2f, 0xc9, 0x31, 0xe8, 0xa9, 0x27, 0xa7, 0xb0, 0xff, 0xa0, 0x5f, 0x50, 0x70, 0xb9,
-	0x94, 0x94, 0x3a, 0xa9, 0x82, 0x9e, 0xa8, 0x99, 0x37, 0xf3, 0x76, 0x66, 0x76, 0xde, 0x0a, 0x1e,
-	0x86, 0x8b, 0x04, 0x53, 0x4e, 0x18, 0xbd, 0x58, 0xbc, 0x71, 0x97, 0x86, 0x9b, 0xb1, 0x38, 0x0e,
-	0xd2, 0xb4, 0xfc, 0x3a, 0x69, 0xc6, 0x04, 0x43, 0xed, 0xf5, 0x68, 0x67, 0x69, 0x38, 0x2a, 0xaa,
-	0xb5, 0x1f, 0xb1, 0x88, 0xc9, 0x50, 0x37, 0xff, 0x55, 0x64, 0xb5, 0x3a, 0x11, 0x63, 0x51, 0x8c,
-	0x5d, 0x69, 0x8d, 0x66, 0x13, 0x57, 0x90, 0x04, 0x73, 0x11, 0x24, 0x8a, 0xb6, 0xe5, 0x6e, 0x28,
-	0x82, 0x8b, 0x40, 0x60, 0x9f, 0xd0, 0x49, 0xc9, 0xf8, 0x68, 0x43, 0x42, 0x82, 0x45, 0x10, 0x06,
-	0x22, 0x50, 0xe1, 0xed, 0x31, 0xe3, 0x09, 0xe3, 0xee, 0x28, 0xe0, 0xd8, 0x9d, 0x1f, 0x8e, 0xb0,
-	0x08, 0x0e, 0xdd, 0x31, 0x23, 0x54, 0xe1, 0x87, 0x1b, 0xe8, 0x22, 0x4c, 0x31, 0x27, 0x7c, 0xad,
-	0x82, 0xde, 0x0b, 0x68, 0x7a, 0x05, 0xfa, 0xb4, 0x00, 0x87, 0x79, 0x8d, 0xe8, 0x08, 0xee, 0x8a,
-	0x2c, 0xa0, 0x7c, 0x82, 0x33, 0x3f, 0xcd, 0x18, 0x9b, 0xf8, 0x53, 0x4c, 0xa2, 0xa9, 0xb0, 0xaa,
-	0x5d, 0xcd, 0xd6, 0xbd, 0x66, 0x09, 0xfe, 0x98, 0x63, 0xcf, 0x24, 0x74, 0xa6, 0x1b, 0x9a, 0xb9,
-	0x75, 0xa6, 0x1b, 0x5b, 0x66, 0xb5, 0x77, 0x59, 0x83, 0x9a, 0xe2, 0x45, 0xf7, 0x01, 0x54, 0x01,
-	0x3e, 0x09, 0x2d, 0xad, 0xab, 0xd9, 0x3b, 0xde, 0x8e, 0xf2, 0x9c, 0x86, 0x68, 0x1f, 0x6e, 0xb1,
-	0xd7, 0x14, 0x67, 0xd6, 0x96, 0x44, 0x0a, 0x03, 0xfd, 0x0c, 0xb7, 0xcb, 0x6a, 0xe5, 0xd4, 0xac,
-	0x5a, 0x57, 0xb3, 0xeb, 0x47, 0xc7, 0xce, 0x7f, 0xdf, 0x9c, 0xf3, 0x89, 0x66, 0xfa, 0xfa, 0xbb,
-	0xcb, 0x4e, 0xc5, 0x6b, 0x44, 0xeb, 0x0d, 0xde, 0x07, 0x18, 0x4f, 0x03, 0x4a, 0x71, 0x9c, 0x17,
-	0x65, 0x14, 0x45, 0x29, 0xcf, 0x69, 0x88, 0xbe, 0x07, 0xa3, 0x9c, 0xbd, 0x55, 0x97, 0x27, 0xbb,
-	0x5f, 0x78, 0xf2, 0x40, 0xa5, 0x79, 0x4b, 0x02, 0x74, 0x0e, 0x8d, 0xf5, 0xc9, 0x5b, 0x0d, 0x49,
-	0xf8, 0x60, 0x13, 0xa1, 0xea, 0xe1, 0x94, 0x4e, 0x98, 0x6a, 0xa1, 0x1e, 0xad, 0x5c, 0xe8, 0x01,
-	0xdc, 0x21, 0x94, 0x08, 0x12, 0xc4, 0x3e, 0xc7, 0xbf, 0xce, 0x30, 0x1d, 0xe3, 0xcc, 0xba, 0x2d,
-	0x1b, 0x31, 0x15, 0x30, 0x2c, 0xfd, 0xe8, 0x37, 0x0d, 0x50, 0x42, 0xe8, 0x2a, 0xd2, 0x1f, 0x31,
-	0x1a, 0x5a, 0xfb, 0xdd, 0xaa, 0x5d, 0x3f, 0x3a, 0x70, 0x8a, 0xbd, 0x72, 0xf2, 0xbd, 0x72, 0xd4,
-	0x5e, 0x39, 0x4f, 0x18, 0xa1, 0xfd, 0x41, 0x7e, 0xee, 0xdf, 0x97, 0x9d, 0x83, 0x45, 0x90, 0xc4,
-	0xdf, 0xf4, 0x6e, 0x52, 0xf4, 0x7e, 0xff, 0xd0, 0xb1, 0x23, 0x22, 0xa6, 0xb3, 0x91, 0x33, 0x66,
-	0x89, 0xab, 0x36, 0xb4, 0xf8, 0x3c, 0xe2, 0xe1, 0x2f, 0xae, 0x58, 0xa4, 0x98, 0x4b, 0x36, 0xee,
-	0x99, 0x09, 0xa1, 0xcb, 0xa2, 0xfa, 0x8c, 0x86, 0xe8, 0x29, 0xd4, 0xe6, 0x89, 0x9f, 0xc7, 0x58,
-	0xbb, 0x5d, 0xcd, 0xde, 0x3d, 0x72, 0xbe, 0x70, 0xce, 0xce, 0xcb, 0xc1, 0xf9, 0x22, 0xc5, 0xde,
-	0xf6, 0x3c, 0xc9, 0xbf, 0xa8, 0x05, 0x46, 0x1c, 0xcc, 0xe8, 0x78, 0x8a, 0x43, 0x6b, 0xaf, 0xab,
-	0xd9, 0x86, 0xb7, 0xb4, 0xd1, 0x33, 0xd8, 0x4b, 0x33, 0xec, 0x17, 0xb6, 0x9f, 0xab, 0xd6, 0x32,
-	0xe5, 0x1d, 0xb4, 0x9c, 0x42, 0xd2, 0x4e, 0x29, 0x69, 0xe7, 0xbc, 0x94, 0x74, 0x5f, 0x7f, 0xfb,
-	0xa1, 0xa3, 0x79, 0xb7, 0xd3, 0x0c, 0x3f, 0x97, 0x79, 0x39, 0x92, 0xeb, 0x22, 0x26, 0xf3, 0xfc,
-	0x16, 0xb8, 0x8f, 0xe7, 0x98, 0x8a, 0x52, 0x17, 0x77, 0xba, 0x9a, 0x5d, 0xf5, 0x9a, 0x25, 0x78,
-	0x92, 0x63, 0x85, 0x2e, 0xd0, 0x09, 0x74, 0x96, 0x39, 0x63, 0x36, 0xa3, 0x22, 0x64, 0xaf, 0x69,
-	0xbe, 0xd5, 0xd9, 0x32, 0x1b, 0xc9, 0xec, 0x7b, 0x65, 0xd8, 0x93, 0x32, 0x6a, 0x98, 0x07, 0x29,
-	0x9a, 0xe7, 0xb0, 0x93, 0xe1, 0x39, 0xc9, 0x67, 0xc1, 0xad, 0xa6, 0xbc, 0x38, 0x7b, 0xe3, 0xac,
-	0x54, 0x82, 0xda, 0x9f, 0x15, 0x41, 0xef, 0x21, 0x6c, 0x17, 0x03, 0x44, 0x7b, 0x50, 0x7f, 0x41,
-	0x79, 0x8a, 0xc7, 0x64, 0x42, 0x70, 0x68, 0x56, 0x50, 0x0d, 0xaa, 0x27, 0x2f, 0x07, 0xa6, 0x86,
-	0x0c, 0xd0, 0x7f, 0xfa, 0x76, 0x38, 0x90, 0xa2, 0xae, 0x9a, 0xb5, 0x33, 0xdd, 0xd8, 0x31, 0xe1,
-	0x4c, 0x37, 0xc0, 0xac, 0xf7, 0x4e, 0xc0, 0x28, 0xc9, 0xd1, 0x57, 0xb0, 0x4d, 0x67, 0xc9, 0x08,
-	0x67, 0x56, 0x53, 0xbe, 0x0e, 0xca, 0x42, 0x5f, 0x43, 0xe3, 0xa3, 0x2e, 0xf7, 0x25, 0x5a, 0xe7,
-	0xab, 0xa6, 0x7a, 0x7f, 0x6c, 0xc1, 0xae, 0xba, 0xd0, 0xe1, 0x2c, 0x49, 0x82, 0x6c, 0x81, 0xee,
-	0xc1, 0xea, 0x71, 0xb8, 0xf9, 0x5a, 0xbc, 0x02, 0x33, 0x0e, 0x04, 0xe6, 0x42, 0xca, 0xf8, 0x94,
-	0x86, 0xf8, 0x42, 0x3e, 0x1c, 0xf5, 0xcd, 0x8b, 0xa3, 0x32, 0x26, 0x4c, 0x66, 0x79, 0x37, 0x78,
-	0x50, 0x0c, 0x07, 0x85, 0xef, 0x3b, 0x42, 0x83, 0x98, 0xbc, 0xc1, 0xe1, 0xda, 0x21, 0xd5, 0xff,
-	0x75, 0xc8, 0xe7, 0x09, 0x51, 0x0f, 0x1a, 0x05, 0x58, 0x8c, 0xc2, 0xd2, 0xe5, 0x74, 0x3e, 0xf2,
-	0xa1, 0xc7, 0x70, 0xf7, 0x5f, 0x04, 0x2a, 0xf8, 0x96, 0x0c, 0xfe, 0x34, 0xd8, 0xff, 0xe1, 0xdd,
-	0x55, 0x5b, 0x7b, 0x7f, 0xd5, 0xd6, 0xfe, 0xba, 0x6a, 0x6b, 0x6f, 0xaf, 0xdb, 0x95, 0xf7, 0xd7,
-	0xed, 0xca, 0x9f, 0xd7, 0xed, 0xca, 0xab, 0xc7, 0x6b, 0x4a, 0xfd, 0xcc, 0x7f, 0xc5, 0xfc, 0xd8,
-	0xbd, 0x58, 0xfe, 0x61, 0x48, 0xed, 0x8e, 0xb6, 0xa5, 0x3a, 0x8e, 0xff, 0x09, 0x00, 0x00, 0xff,
-	0xff, 0x89, 0x4e, 0x0e, 0xd0, 0x64, 0x07, 0x00, 0x00,
+	// 1069 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x73, 0xda, 0x46,
+	0x14, 0xb7, 0x80, 0x80, 0xfc, 0x00, 0x1b, 0x2f, 0x76, 0x47, 0x76, 0x13, 0xa0, 0xf4, 0xc2, 0x4c,
+	0x62, 0x69, 0x6c, 0xe7, 0xd4, 0xe9, 0xa5, 0x4e, 0x69, 0x62, 0x37, 0x6e, 0x3b, 0xc2, 0x76, 0x3a,
+	0x39, 0x54, 0x23, 0xd0, 0x02, 0x3b, 0x95, 0x56, 0xea, 0xae, 0x00, 0x93, 0xcf, 0xd0, 0x43, 0x4e,
+	0xfd, 0x06, 0xbd, 0xf4, 0xd8, 0x4f, 0x91, 0x63, 0x8e, 0x3d, 0x25, 0x1d, 0xfb, 0xda, 0x53, 0x3f,
+	0x41, 0x67, 0x57, 0x2b, 0xc0, 0x75, 0x52, 0xdc, 0x9c, 0xc4, 0xfb, 0xf7, 0xdb, 0xf7, 0x7e, 0xfb,
+	0xde, 0x5b, 0xe0, 0x81, 0x37, 0x0d, 0x30, 0xe5, 0x24, 0xa4, 0x17, 0xd3, 0x17, 0xd6, 0x4c, 0xb0,
+	0x58, 0xe8, 0xfb, 0x6e, 0x14, 0xa5, 0x5f, 0x33, 0x62, 0x61, 0x1c, 0xa2, 0xda, 0xa2, 0xb7, 0x39,
+	0x13, 0x4c, 0xe5, 0xb5, 0xb3, 0x39, 0x08, 0x07, 0xa1, 0x74, 0xb5, 0xc4, 0xaf, 0x24, 0x6a, 0xa7,
+	0x3e, 0x08, 0xc3, 0x81, 0x8f, 0x2d, 0x29, 0x75, 0x47, 0x7d, 0x2b, 0x26, 0x01, 0xe6, 0xb1, 0x1b,
+	0x28, 0xd8, 0x1d, 0x6b, 0x49, 0x12, 0x3c, 0x76, 0x63, 0xec, 0x10, 0xda, 0x4f, 0x11, 0x77, 0x97,
+	0x04, 0x04, 0x38, 0x76, 0x3d, 0x37, 0x76, 0x95, 0x7b, 0xad, 0x17, 0xf2, 0x20, 0xe4, 0x56, 0xd7,
+	0xe5, 0xd8, 0x1a, 0xef, 0x75, 0x71, 0xec, 0xee, 0x59, 0xbd, 0x90, 0x50, 0x65, 0xdf, 0x5b, 0x02,
+	0x37, 0xc0, 0x14, 0x73, 0xc2, 0x17, 0x32, 0x68, 0x9e, 0x41, 0xd5, 0x4e, 0xac, 0x8f, 0x13, 0x63,
+	0x47, 0xe4, 0x88, 0xf6, 0x61, 0x2b, 0x66, 0x2e, 0xe5, 0x7d, 0xcc, 0x9c, 0x88, 0x85, 0x61, 0xdf,
+	0x19, 0x62, 0x32, 0x18, 0xc6, 0x46, 0xb6, 0xa1, 0xb5, 0x72, 0x76, 0x35, 0x35, 0x7e, 0x27, 0x6c,
+	0x4f, 0xa4, 0xe9, 0x38, 0xa7, 0x6b, 0x95, 0xcc, 0x71, 0x4e, 0xcf, 0x54, 0xb2, 0xcd, 0x5f, 0x75,
+	0x28, 0x28, 0x5c, 0x74, 0x0f, 0x40, 0x25, 0xe0, 0x10, 0xcf, 0xd0, 0x1a, 0x5a, 0x6b, 0xd5, 0x5e,
+	0x55, 0x9a, 0x23, 0x0f, 0x6d, 0xc2, 0x9d, 0x70, 0x42, 0x31, 0x33, 0x32, 0xd2, 0x92, 0x08, 0xe8,
+	0x07, 0x28, 0xa7, 0xd9, 0x4a, 0xd6, 0x8c, 0x42, 0x43, 0x6b, 0x15, 0xf7, 0x0f, 0xcc, 0xff, 0xbe,
+	0x39, 0xf3, 0x1d, 0xc5, 0x1c, 0xe6, 0x5e, 0xbd, 0xa9, 0xaf, 0xd8, 0xa5, 0xc1, 0x62, 0x81, 0xf7,
+	0x00, 0x7a, 0x43, 0x97, 0x52, 0xec, 0x8b, 0xa4, 0xf4, 0x24, 0x29, 0xa5, 0x39, 0xf2, 0xd0, 0xd7,
+	0xa0, 0xa7, 0xdc, 0x1b, 0x45, 0x79, 0xb2, 0x75, 0xcb, 0x93, 0x4f, 0x54, 0x98, 0x3d, 0x03, 0x40,
+	0xa7, 0x50, 0x5a, 0x64, 0xde, 0x28, 0x49, 0xc0, 0xfb, 0xcb, 0x00, 0x55, 0x0d, 0x47, 0xb4, 0x1f,
+	0xaa, 0x12, 0x8a, 0x83, 0xb9, 0x0a, 0xdd, 0x87, 0x0d, 0x42, 0x49, 0x4c, 0x5c, 0xdf, 0xe1, 0xf8,
+	0xa7, 0x11, 0xa6, 0x3d, 0xcc, 0x8c, 0xb2, 0x2c, 0xa4, 0xa2, 0x0c, 0x9d, 0x54, 0x8f, 0x7e, 0xd1,
+	0x00, 0x05, 0x84, 0xce, 0x3d, 0x9d, 0x6e, 0x48, 0x3d, 0x63, 0xb3, 0x91, 0x6d, 0x15, 0xf7, 0xb7,
+	0xcd, 0xa4, 0xaf, 0x4c, 0xd1, 0x57, 0xa6, 0xea, 0x2b, 0xf3, 0x51, 0x48, 0xe8, 0xe1, 0x89, 0x38,
+	0xf7, 0xef, 0x37, 0xf5, 0xed, 0xa9, 0x1b, 0xf8, 0x9f, 0x35, 0x6f, 0x42, 0x34, 0x7f, 0x7b, 0x5b,
+	0x6f, 0x0d, 0x48, 0x3c, 0x1c, 0x75, 0xcd, 0x5e, 0x18, 0x58, 0xaa, 0x43, 0x93, 0xcf, 0x2e, 0xf7,
+	0x7e, 0xb4, 0xe2, 0x69, 0x84, 0xb9, 0x44, 0xe3, 0x76, 0x25, 0x20, 0x74, 0x96, 0xd4, 0x61, 0x48,
+	0x3d, 0xf4, 0x18, 0x0a, 0xe3, 0xc0, 0x11, 0x3e, 0xc6, 0x5a, 0x43, 0x6b, 0xad, 0xed, 0x9b, 0xb7,
+	0xe4, 0xd9, 0x3c, 0x3f, 0x39, 0x9d, 0x46, 0xd8, 0xce, 0x8f, 0x03, 0xf1, 0x45, 0x3b, 0xa0, 0xfb,
+	0xee, 0x88, 0xf6, 0x86, 0xd8, 0x33, 0xd6, 0x1b, 0x5a, 0x4b, 0xb7, 0x67, 0x32, 0x7a, 0x02, 0xeb,
+	0x11, 0xc3, 0x4e, 0x22, 0x3b, 0x62, 0x6a, 0x8d, 0x8a, 0xbc, 0x83, 0x1d, 0x33, 0x19, 0x69, 0x33,
+	0x1d, 0x69, 0xf3, 0x34, 0x1d, 0xe9, 0xc3, 0xdc, 0xcb, 0xb7, 0x75, 0xcd, 0x2e, 0x47, 0x0c, 0x3f,
+	0x95, 0x71, 0xc2, 0x22, 0xe6, 0xc2, 0x27, 0x63, 0x71, 0x0b, 0xdc, 0xc1, 0x63, 0x4c, 0xe3, 0x74,
+	0x2e, 0x36, 0x1a, 0x5a, 0x2b, 0x6b, 0x57, 0x53, 0x63, 0x5b, 0xd8, 0x92, 0xb9, 0x40, 0x6d, 0xa8,
+	0xcf, 0x62, 0x7a, 0xe1, 0x88, 0xc6, 0x5e, 0x38, 0xa1, 0xa2, 0xab, 0xd9, 0x2c, 0x1a, 0xc9, 0xe8,
+	0xbb, 0xa9, 0xdb, 0xa3, 0xd4, 0xab, 0x23, 0x9c, 0x14, 0xcc, 0x53, 0x58, 0x65, 0x78, 0x4c, 0x04,
+	0x17, 0xdc, 0xa8, 0xca, 0x8b, 0x6b, 0x2d, 0xe5, 0x4a, 0x05, 0xa8, 0xfe, 0x99, 0x03, 0xa0, 0x36,
+	0xe4, 0xf9, 0x88, 0x72, 0x1c, 0x1b, 0x5b, 0x92, 0x89, 0xdd, 0x5b, 0xd2, 0xde, 0x91, 0x41, 0xb6,
+	0x0a, 0x6e, 0x3e, 0x80, 0x7c, 0x72, 0x0f, 0x68, 0x1d, 0x8a, 0x67, 0x94, 0x47, 0xb8, 0x47, 0xfa,
+	0x04, 0x7b, 0x95, 0x15, 0x54, 0x80, 0x6c, 0xfb, 0xfc, 0xa4, 0xa2, 0x21, 0x1d, 0x72, 0xcf, 0xbe,
+	0xe8, 0x9c, 0xc8, 0xdd, 0x90, 0xad, 0x14, 0x8e, 0x73, 0xfa, 0x6a, 0x05, 0x8e, 0x73, 0x3a, 0x54,
+	0x8a, 0xcd, 0x00, 0xaa, 0x1d, 0x71, 0x45, 0x23, 0x1f, 0x7b, 0x5f, 0xda, 0x9d, 0xb3, 0x68, 0xc0,
+	0x5c, 0x0f, 0x2f, 0x5b, 0x19, 0x1f, 0x41, 0x5e, 0x11, 0x97, 0x91, 0xeb, 0x48, 0x49, 0xa8, 0x0e,
+	0x45, 0x8f, 0x71, 0x67, 0x8c, 0x99, 0x48, 0x5d, 0xee, 0xaa, 0xb2, 0x0d, 0x1e, 0xe3, 0xe7, 0x89,
+	0xa6, 0xf9, 0xb3, 0x06, 0xe5, 0x6b, 0x85, 0xa0, 0x4f, 0xa0, 0xd4, 0x27, 0xd4, 0xf5, 0xd3, 0x9b,
+	0xd0, 0x24, 0x60, 0x51, 0xea, 0x14, 0xf1, 0xdf, 0xc3, 0xd6, 0x84, 0xc4, 0x43, 0x8f, 0xb9, 0x13,
+	0xd7, 0x77, 0x26, 0x84, 0x7a, 0xe1, 0xc4, 0xc1, 0xd4, 0x33, 0x32, 0x4b, 0x7b, 0x48, 0x17, 0xb4,
+	0xcb, 0x3e, 0xaa, 0xce, 0x21, 0x9e, 0x49, 0x84, 0x36, 0xf5, 0x9a, 0x6d, 0xd0, 0xd3, 0x1b, 0x12,
+	0x35, 0xd1, 0x51, 0xd0, 0xc5, 0xcc, 0xa8, 0x26, 0x35, 0x25, 0x92, 0x48, 0xf0, 0x5a, 0xab, 0x6c,
+	0x26, 0x09, 0xf2, 0x79, 0x67, 0x34, 0xff, 0xca, 0xc0, 0xda, 0xac, 0xaa, 0x20, 0x70, 0xd9, 0x14,
+	0xdd, 0x85, 0x39, 0x5d, 0x37, 0xf9, 0x7b, 0x0e, 0x15, 0xdf, 0x8d, 0x31, 0x8f, 0xe5, 0x2e, 0x3c,
+	0xa2, 0x1e, 0xbe, 0x50, 0xc5, 0x2c, 0x9d, 0x3e, 0x15, 0xd1, 0x0f, 0x65, 0x94, 0x7d, 0x03, 0x07,
+	0xf9, 0xb0, 0x9d, 0xe8, 0xbe, 0x12, 0x14, 0x92, 0x17, 0xd8, 0x5b, 0x38, 0x24, 0xfb, 0x41, 0x87,
+	0xbc, 0x1f, 0x10, 0x35, 0xa1, 0x94, 0x18, 0x13, 0x2a, 0x8c, 0x9c, 0x64, 0xe7, 0x9a, 0x0e, 0x3d,
+	0x84, 0xad, 0x7f, 0x01, 0x28, 0xe7, 0x3b, 0xd2, 0xf9, 0xdd, 0x46, 0x64, 0x40, 0x81, 0xe1, 0x98,
+	0x30, 0xec, 0x19, 0x79, 0xb9, 0x4e, 0x52, 0xb1, 0xf9, 0xbb, 0x06, 0x1b, 0xdf, 0x8a, 0x47, 0x8a,
+	0x0f, 0x49, 0x74, 0xaa, 0x1e, 0xc2, 0x65, 0x2d, 0xfb, 0x29, 0x94, 0x7b, 0x23, 0xc6, 0xc4, 0xc6,
+	0x58, 0x7c, 0xed, 0x4a, 0x4a, 0x29, 0xf1, 0xd0, 0xc7, 0xb0, 0x4a, 0xf1, 0x44, 0x39, 0x64, 0xa5,
+	0x83, 0x4e, 0xf1, 0x24, 0x31, 0x7e, 0x0e, 0x79, 0x7c, 0x11, 0x11, 0x36, 0x35, 0x72, 0xff, 0xa3,
+	0xef, 0x54, 0xcc, 0xe1, 0x37, 0xaf, 0x2e, 0x6b, 0xda, 0xeb, 0xcb, 0x9a, 0xf6, 0xe7, 0x65, 0x4d,
+	0x7b, 0x79, 0x55, 0x5b, 0x79, 0x7d, 0x55, 0x5b, 0xf9, 0xe3, 0xaa, 0xb6, 0xf2, 0xfc, 0xe1, 0xc2,
+	0xf6, 0x7e, 0xcf, 0xff, 0x87, 0xf1, 0x81, 0x75, 0x31, 0xfb, 0x13, 0x21, 0xf7, 0x79, 0x37, 0x2f,
+	0x4f, 0x3d, 0xf8, 0x67, 0x00, 0xd4, 0x45, 0x9a, 0x78, 0x78, 0x09, 0x00, 0x00,
 }
 
 func (m *RollappGenesisState) Marshal() (dAtA []byte, err error) {
@@ -540,6 +772,20 @@ func (m *Rollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Sunset != nil {
+		{
+			size, err := m.Sunset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRollapp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
 	if len(m.MinSequencerBond) > 0 {
 		for iNdEx := len(m.MinSequencerBond) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -587,12 +833,12 @@ func (m *Rollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x88
 	}
 	if m.PreLaunchTime != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.PreLaunchTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PreLaunchTime):])
-		if err1 != nil {
-			return 0, err1
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.PreLaunchTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PreLaunchTime):])
+		if err2 != nil {
+			return 0, err2
 		}
-		i -= n1
-		i = encodeVarintRollapp(dAtA, i, uint64(n1))
+		i -= n2
+		i = encodeVarintRollapp(dAtA, i, uint64(n2))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -676,6 +922,82 @@ func (m *Rollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScheduledDRSUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledDRSUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledDRSUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DrsVersion != 0 {
+		i = encodeVarintRollapp(dAtA, i, uint64(m.DrsVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintRollapp(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintRollapp(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RollappSunset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollappSunset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollappSunset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.WithdrawalWindowEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WithdrawalWindowEnd):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintRollapp(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	if m.FinalHeight != 0 {
+		i = encodeVarintRollapp(dAtA, i, uint64(m.FinalHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Revision) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -733,6 +1055,16 @@ func (m *RollappSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Retired {
+		i--
+		if m.Retired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.LatestFinalizedHeight != 0 {
 		i = encodeVarintRollapp(dAtA, i, uint64(m.LatestFinalizedHeight))
 		i--
@@ -777,6 +1109,58 @@ func (m *RollappSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OwnershipTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OwnershipTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwnershipTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintRollapp(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x22
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintRollapp(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CurrentOwner) > 0 {
+		i -= len(m.CurrentOwner)
+		copy(dAtA[i:], m.CurrentOwner)
+		i = encodeVarintRollapp(dAtA, i, uint64(len(m.CurrentOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintRollapp(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRollapp(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRollapp(v)
 	base := offset
@@ -858,6 +1242,43 @@ func (m *Rollapp) Size() (n int) {
 			n += 2 + l + sovRollapp(uint64(l))
 		}
 	}
+	if m.Sunset != nil {
+		l = m.Sunset.Size()
+		n += 2 + l + sovRollapp(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledDRSUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovRollapp(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovRollapp(uint64(m.Height))
+	}
+	if m.DrsVersion != 0 {
+		n += 1 + sovRollapp(uint64(m.DrsVersion))
+	}
+	return n
+}
+
+func (m *RollappSunset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FinalHeight != 0 {
+		n += 1 + sovRollapp(uint64(m.FinalHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WithdrawalWindowEnd)
+	n += 1 + l + sovRollapp(uint64(l))
 	return n
 }
 
@@ -900,6 +1321,32 @@ func (m *RollappSummary) Size() (n int) {
 	if m.LatestFinalizedHeight != 0 {
 		n += 1 + sovRollapp(uint64(m.LatestFinalizedHeight))
 	}
+	if m.Retired {
+		n += 2
+	}
+	return n
+}
+
+func (m *OwnershipTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovRollapp(uint64(l))
+	}
+	l = len(m.CurrentOwner)
+	if l > 0 {
+		n += 1 + l + sovRollapp(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovRollapp(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovRollapp(uint64(l))
 	return n
 }
 
@@ -1418,6 +1865,264 @@ func (m *Rollapp) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sunset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sunset == nil {
+				m.Sunset = &RollappSunset{}
+			}
+			if err := m.Sunset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollapp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledDRSUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollapp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledDRSUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledDRSUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrsVersion", wireType)
+			}
+			m.DrsVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DrsVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollapp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollappSunset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollapp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollappSunset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollappSunset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalHeight", wireType)
+			}
+			m.FinalHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalWindowEnd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.WithdrawalWindowEnd, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRollapp(dAtA[iNdEx:])
@@ -1698,6 +2403,205 @@ func (m *RollappSummary) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Retired = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollapp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OwnershipTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollapp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OwnershipTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OwnershipTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRollapp(dAtA[iNdEx:])
@@ -550,6 +550,9 @@ var xxx_messageInfo_MsgUpdateStateResponse proto.InternalMessageInfo
 
 // MsgTransferOwnership transfers the ownership of a rollapp chain to a new
 // owner.
+// MsgTransferOwnership proposes a transfer of the rollapp ownership. The
+// ownership does not change until the new owner accepts it with
+// MsgAcceptOwnership.
 type MsgTransferOwnership struct {
 	// current_owner is the bech32-encoded address of the current owner
 	CurrentOwner string `protobuf:"bytes,1,opt,name=current_owner,json=currentOwner,proto3" json:"current_owner,omitempty"`
@@ -649,36 +652,26 @@ func (m *MsgTransferOwnershipResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgTransferOwnershipResponse proto.InternalMessageInfo
 
-// MsgAddApp adds an app to the rollapp.
-type MsgAddApp struct {
-	// creator is the bech32-encoded address of the app creator
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	// name is the unique App's name
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// rollapp_id is the id of the Rollapp the App belongs to
-	RollappId string `protobuf:"bytes,3,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
-	// description is the description of the App
-	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	// image is the url to the App image
-	Image string `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
-	// url is the URL to the App's website
-	Url string `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
-	// order is the order of the App in the Rollapp
-	Order int32 `protobuf:"varint,7,opt,name=order,proto3" json:"order,omitempty"`
+// MsgAcceptOwnership accepts a pending transfer of the rollapp ownership.
+type MsgAcceptOwnership struct {
+	// new_owner is the bech32-encoded address of the new owner
+	NewOwner string `protobuf:"bytes,1,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	// rollapp_id is the unique identifier of the rollapp chain.
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
 }
 
-func (m *MsgAddApp) Reset()         { *m = MsgAddApp{} }
-func (m *MsgAddApp) String() string { return proto.CompactTextString(m) }
-func (*MsgAddApp) ProtoMessage()    {}
-func (*MsgAddApp) Descriptor() ([]byte, []int) {
+func (m *MsgAcceptOwnership) Reset()         { *m = MsgAcceptOwnership{} }
+func (m *MsgAcceptOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptOwnership) ProtoMessage()    {}
+func (*MsgAcceptOwnership) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1a86300fb8647ecb, []int{10}
 }
-func (m *MsgAddApp) XXX_Unmarshal(b []byte) error {
+func (m *MsgAcceptOwnership) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddApp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAcceptOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddApp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAcceptOwnership.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -688,82 +681,47 @@ func (m *MsgAddApp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MsgAddApp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddApp.Merge(m, src)
+func (m *MsgAcceptOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptOwnership.Merge(m, src)
 }
-func (m *MsgAddApp) XXX_Size() int {
+func (m *MsgAcceptOwnership) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddApp) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddApp.DiscardUnknown(m)
+func (m *MsgAcceptOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptOwnership.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddApp proto.InternalMessageInfo
-
-func (m *MsgAddApp) GetCreator() string {
-	if m != nil {
-		return m.Creator
-	}
-	return ""
-}
+var xxx_messageInfo_MsgAcceptOwnership proto.InternalMessageInfo
 
-func (m *MsgAddApp) GetName() string {
+func (m *MsgAcceptOwnership) GetNewOwner() string {
 	if m != nil {
-		return m.Name
+		return m.NewOwner
 	}
 	return ""
 }
 
-func (m *MsgAddApp) GetRollappId() string {
+func (m *MsgAcceptOwnership) GetRollappId() string {
 	if m != nil {
 		return m.RollappId
 	}
 	return ""
 }
 
-func (m *MsgAddApp) GetDescription() string {
-	if m != nil {
-		return m.Description
-	}
-	return ""
-}
-
-func (m *MsgAddApp) GetImage() string {
-	if m != nil {
-		return m.Image
-	}
-	return ""
-}
-
-func (m *MsgAddApp) GetUrl() string {
-	if m != nil {
-		return m.Url
-	}
-	return ""
-}
-
-func (m *MsgAddApp) GetOrder() int32 {
-	if m != nil {
-		return m.Order
-	}
-	return 0
-}
-
-type MsgAddAppResponse struct {
+type MsgAcceptOwnershipResponse struct {
 }
 
-func (m *MsgAddAppResponse) Reset()         { *m = MsgAddAppResponse{} }
-func (m *MsgAddAppResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAddAppResponse) ProtoMessage()    {}
-func (*MsgAddAppResponse) Descriptor() ([]byte, []int) {
+func (m *MsgAcceptOwnershipResponse) Reset()         { *m = MsgAcceptOwnershipResponse{} }
+func (m *MsgAcceptOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptOwnershipResponse) ProtoMessage()    {}
+func (*MsgAcceptOwnershipResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1a86300fb8647ecb, []int{11}
 }
-func (m *MsgAddAppResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAcceptOwnershipResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddAppResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAcceptOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddAppResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAcceptOwnershipResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -773,50 +731,39 @@ func (m *MsgAddAppResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgAddAppResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddAppResponse.Merge(m, src)
+func (m *MsgAcceptOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptOwnershipResponse.Merge(m, src)
 }
-func (m *MsgAddAppResponse) XXX_Size() int {
+func (m *MsgAcceptOwnershipResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddAppResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddAppResponse.DiscardUnknown(m)
+func (m *MsgAcceptOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptOwnershipResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddAppResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgAcceptOwnershipResponse proto.InternalMessageInfo
 
-// MsgUpdateApp updates the app information.
-type MsgUpdateApp struct {
-	// creator is the bech32-encoded address of the app owner
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	// id is the unique App's id in the Rollapp
-	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	// name is the unique App's name (immutable)
-	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	// rollapp_id is the id of the Rollapp the App belongs to
-	RollappId string `protobuf:"bytes,4,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
-	// description is the description of the App
-	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	// image is the url to the App image
-	Image string `protobuf:"bytes,6,opt,name=image,proto3" json:"image,omitempty"`
-	// url is the URL to the App's website
-	Url string `protobuf:"bytes,7,opt,name=url,proto3" json:"url,omitempty"`
-	// order is the order of the App in the Rollapp
-	Order int32 `protobuf:"varint,8,opt,name=order,proto3" json:"order,omitempty"`
+// MsgCancelOwnershipTransfer cancels a pending transfer of the rollapp
+// ownership.
+type MsgCancelOwnershipTransfer struct {
+	// owner is the bech32-encoded address of the current owner
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// rollapp_id is the unique identifier of the rollapp chain.
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
 }
 
-func (m *MsgUpdateApp) Reset()         { *m = MsgUpdateApp{} }
-func (m *MsgUpdateApp) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateApp) ProtoMessage()    {}
-func (*MsgUpdateApp) Descriptor() ([]byte, []int) {
+func (m *MsgCancelOwnershipTransfer) Reset()         { *m = MsgCancelOwnershipTransfer{} }
+func (m *MsgCancelOwnershipTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelOwnershipTransfer) ProtoMessage()    {}
+func (*MsgCancelOwnershipTransfer) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1a86300fb8647ecb, []int{12}
 }
-func (m *MsgUpdateApp) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelOwnershipTransfer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateApp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelOwnershipTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateApp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelOwnershipTransfer.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -826,89 +773,47 @@ func (m *MsgUpdateApp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateApp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateApp.Merge(m, src)
+func (m *MsgCancelOwnershipTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelOwnershipTransfer.Merge(m, src)
 }
-func (m *MsgUpdateApp) XXX_Size() int {
+func (m *MsgCancelOwnershipTransfer) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateApp) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateApp.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MsgUpdateApp proto.InternalMessageInfo
-
-func (m *MsgUpdateApp) GetCreator() string {
-	if m != nil {
-		return m.Creator
-	}
-	return ""
+func (m *MsgCancelOwnershipTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelOwnershipTransfer.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateApp) GetId() uint64 {
-	if m != nil {
-		return m.Id
-	}
-	return 0
-}
+var xxx_messageInfo_MsgCancelOwnershipTransfer proto.InternalMessageInfo
 
-func (m *MsgUpdateApp) GetName() string {
+func (m *MsgCancelOwnershipTransfer) GetOwner() string {
 	if m != nil {
-		return m.Name
+		return m.Owner
 	}
 	return ""
 }
 
-func (m *MsgUpdateApp) GetRollappId() string {
+func (m *MsgCancelOwnershipTransfer) GetRollappId() string {
 	if m != nil {
 		return m.RollappId
 	}
 	return ""
 }
 
-func (m *MsgUpdateApp) GetDescription() string {
-	if m != nil {
-		return m.Description
-	}
-	return ""
-}
-
-func (m *MsgUpdateApp) GetImage() string {
-	if m != nil {
-		return m.Image
-	}
-	return ""
-}
-
-func (m *MsgUpdateApp) GetUrl() string {
-	if m != nil {
-		return m.Url
-	}
-	return ""
-}
-
-func (m *MsgUpdateApp) GetOrder() int32 {
-	if m != nil {
-		return m.Order
-	}
-	return 0
-}
-
-type MsgUpdateAppResponse struct {
+type MsgCancelOwnershipTransferResponse struct {
 }
 
-func (m *MsgUpdateAppResponse) Reset()         { *m = MsgUpdateAppResponse{} }
-func (m *MsgUpdateAppResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateAppResponse) ProtoMessage()    {}
-func (*MsgUpdateAppResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCancelOwnershipTransferResponse) Reset()         { *m = MsgCancelOwnershipTransferResponse{} }
+func (m *MsgCancelOwnershipTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelOwnershipTransferResponse) ProtoMessage()    {}
+func (*MsgCancelOwnershipTransferResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1a86300fb8647ecb, []int{13}
 }
-func (m *MsgUpdateAppResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelOwnershipTransferResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateAppResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelOwnershipTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateAppResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelOwnershipTransferResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -918,40 +823,42 @@ func (m *MsgUpdateAppResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateAppResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateAppResponse.Merge(m, src)
+func (m *MsgCancelOwnershipTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelOwnershipTransferResponse.Merge(m, src)
 }
-func (m *MsgUpdateAppResponse) XXX_Size() int {
+func (m *MsgCancelOwnershipTransferResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateAppResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateAppResponse.DiscardUnknown(m)
+func (m *MsgCancelOwnershipTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelOwnershipTransferResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateAppResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelOwnershipTransferResponse proto.InternalMessageInfo
 
-// MsgRemoveApp removes an app from the rollapp.
-type MsgRemoveApp struct {
-	// creator is the bech32-encoded address of the app owner
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	// id is the unique App's id in the Rollapp
-	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	// rollapp_id is the id of the Rollapp the App belongs to
-	RollappId string `protobuf:"bytes,3,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+// MsgSunsetRollapp announces a graceful shutdown of the rollapp. The hub accepts
+// states up to final_height and users get a withdrawal window to move their
+// funds back to the hub.
+type MsgSunsetRollapp struct {
+	// owner is the bech32-encoded address of the rollapp owner
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// rollapp_id is the unique identifier of the rollapp chain
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// final_height is the last rollapp height that is going to be produced
+	FinalHeight uint64 `protobuf:"varint,3,opt,name=final_height,json=finalHeight,proto3" json:"final_height,omitempty"`
 }
 
-func (m *MsgRemoveApp) Reset()         { *m = MsgRemoveApp{} }
-func (m *MsgRemoveApp) String() string { return proto.CompactTextString(m) }
-func (*MsgRemoveApp) ProtoMessage()    {}
-func (*MsgRemoveApp) Descriptor() ([]byte, []int) {
+func (m *MsgSunsetRollapp) Reset()         { *m = MsgSunsetRollapp{} }
+func (m *MsgSunsetRollapp) String() string { return proto.CompactTextString(m) }
+func (*MsgSunsetRollapp) ProtoMessage()    {}
+func (*MsgSunsetRollapp) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1a86300fb8647ecb, []int{14}
 }
-func (m *MsgRemoveApp) XXX_Unmarshal(b []byte) error {
+func (m *MsgSunsetRollapp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRemoveApp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSunsetRollapp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRemoveApp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSunsetRollapp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -961,54 +868,54 @@ func (m *MsgRemoveApp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *MsgRemoveApp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRemoveApp.Merge(m, src)
+func (m *MsgSunsetRollapp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSunsetRollapp.Merge(m, src)
 }
-func (m *MsgRemoveApp) XXX_Size() int {
+func (m *MsgSunsetRollapp) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRemoveApp) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRemoveApp.DiscardUnknown(m)
+func (m *MsgSunsetRollapp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSunsetRollapp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRemoveApp proto.InternalMessageInfo
+var xxx_messageInfo_MsgSunsetRollapp proto.InternalMessageInfo
 
-func (m *MsgRemoveApp) GetCreator() string {
+func (m *MsgSunsetRollapp) GetOwner() string {
 	if m != nil {
-		return m.Creator
+		return m.Owner
 	}
 	return ""
 }
 
-func (m *MsgRemoveApp) GetId() uint64 {
+func (m *MsgSunsetRollapp) GetRollappId() string {
 	if m != nil {
-		return m.Id
+		return m.RollappId
 	}
-	return 0
+	return ""
 }
 
-func (m *MsgRemoveApp) GetRollappId() string {
+func (m *MsgSunsetRollapp) GetFinalHeight() uint64 {
 	if m != nil {
-		return m.RollappId
+		return m.FinalHeight
 	}
-	return ""
+	return 0
 }
 
-type MsgRemoveAppResponse struct {
+type MsgSunsetRollappResponse struct {
 }
 
-func (m *MsgRemoveAppResponse) Reset()         { *m = MsgRemoveAppResponse{} }
-func (m *MsgRemoveAppResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRemoveAppResponse) ProtoMessage()    {}
-func (*MsgRemoveAppResponse) Descriptor() ([]byte, []int) {
+func (m *MsgSunsetRollappResponse) Reset()         { *m = MsgSunsetRollappResponse{} }
+func (m *MsgSunsetRollappResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSunsetRollappResponse) ProtoMessage()    {}
+func (*MsgSunsetRollappResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1a86300fb8647ecb, []int{15}
 }
-func (m *MsgRemoveAppResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSunsetRollappResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRemoveAppResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSunsetRollappResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRemoveAppResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSunsetRollappResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1018,39 +925,44 @@ func (m *MsgRemoveAppResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MsgRemoveAppResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRemoveAppResponse.Merge(m, src)
+func (m *MsgSunsetRollappResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSunsetRollappResponse.Merge(m, src)
 }
-func (m *MsgRemoveAppResponse) XXX_Size() int {
+func (m *MsgSunsetRollappResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRemoveAppResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRemoveAppResponse.DiscardUnknown(m)
+func (m *MsgSunsetRollappResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSunsetRollappResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRemoveAppResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSunsetRollappResponse proto.InternalMessageInfo
 
-// MsgMarkObsoleteRollapps marks specified versions as obsolete as well as
-// all corresponding rollapps. Must be called by the governance.
-type MsgMarkObsoleteRollapps struct {
-	// Authority is the authority address.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// DrsVersions is a list of DRS versions that will be marked obsolete.
-	DrsVersions []uint32 `protobuf:"varint,2,rep,packed,name=drs_versions,json=drsVersions,proto3" json:"drs_versions,omitempty"`
+// MsgScheduleDRSUpgrade schedules a DRS upgrade of the rollapp at a rollapp
+// height. States past that height reporting another DRS version are rejected.
+// Scheduling again replaces the pending upgrade.
+type MsgScheduleDRSUpgrade struct {
+	// signer is either the gov module account or the rollapp owner
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// rollapp_id is the unique identifier of the rollapp chain
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// height is the first rollapp height that must run the new DRS version
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// drs_version is the DRS version the rollapp must run from height on
+	DrsVersion uint32 `protobuf:"varint,4,opt,name=drs_version,json=drsVersion,proto3" json:"drs_version,omitempty"`
 }
 
-func (m *MsgMarkObsoleteRollapps) Reset()         { *m = MsgMarkObsoleteRollapps{} }
-func (m *MsgMarkObsoleteRollapps) String() string { return proto.CompactTextString(m) }
-func (*MsgMarkObsoleteRollapps) ProtoMessage()    {}
-func (*MsgMarkObsoleteRollapps) Descriptor() ([]byte, []int) {
+func (m *MsgScheduleDRSUpgrade) Reset()         { *m = MsgScheduleDRSUpgrade{} }
+func (m *MsgScheduleDRSUpgrade) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleDRSUpgrade) ProtoMessage()    {}
+func (*MsgScheduleDRSUpgrade) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1a86300fb8647ecb, []int{16}
 }
-func (m *MsgMarkObsoleteRollapps) XXX_Unmarshal(b []byte) error {
+func (m *MsgScheduleDRSUpgrade) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMarkObsoleteRollapps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgScheduleDRSUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMarkObsoleteRollapps.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgScheduleDRSUpgrade.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1060,47 +972,61 @@ func (m *MsgMarkObsoleteRollapps) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgMarkObsoleteRollapps) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMarkObsoleteRollapps.Merge(m, src)
+func (m *MsgScheduleDRSUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleDRSUpgrade.Merge(m, src)
 }
-func (m *MsgMarkObsoleteRollapps) XXX_Size() int {
+func (m *MsgScheduleDRSUpgrade) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMarkObsoleteRollapps) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMarkObsoleteRollapps.DiscardUnknown(m)
+func (m *MsgScheduleDRSUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleDRSUpgrade.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMarkObsoleteRollapps proto.InternalMessageInfo
+var xxx_messageInfo_MsgScheduleDRSUpgrade proto.InternalMessageInfo
 
-func (m *MsgMarkObsoleteRollapps) GetAuthority() string {
+func (m *MsgScheduleDRSUpgrade) GetSigner() string {
 	if m != nil {
-		return m.Authority
+		return m.Signer
 	}
 	return ""
 }
 
-func (m *MsgMarkObsoleteRollapps) GetDrsVersions() []uint32 {
+func (m *MsgScheduleDRSUpgrade) GetRollappId() string {
 	if m != nil {
-		return m.DrsVersions
+		return m.RollappId
 	}
-	return nil
+	return ""
 }
 
-type MsgMarkObsoleteRollappsResponse struct {
+func (m *MsgScheduleDRSUpgrade) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *MsgMarkObsoleteRollappsResponse) Reset()         { *m = MsgMarkObsoleteRollappsResponse{} }
-func (m *MsgMarkObsoleteRollappsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgMarkObsoleteRollappsResponse) ProtoMessage()    {}
-func (*MsgMarkObsoleteRollappsResponse) Descriptor() ([]byte, []int) {
+func (m *MsgScheduleDRSUpgrade) GetDrsVersion() uint32 {
+	if m != nil {
+		return m.DrsVersion
+	}
+	return 0
+}
+
+type MsgScheduleDRSUpgradeResponse struct {
+}
+
+func (m *MsgScheduleDRSUpgradeResponse) Reset()         { *m = MsgScheduleDRSUpgradeResponse{} }
+func (m *MsgScheduleDRSUpgradeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleDRSUpgradeResponse) ProtoMessage()    {}
+func (*MsgScheduleDRSUpgradeResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1a86300fb8647ecb, []int{17}
 }
-func (m *MsgMarkObsoleteRollappsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgScheduleDRSUpgradeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMarkObsoleteRollappsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgScheduleDRSUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMarkObsoleteRollappsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgScheduleDRSUpgradeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1110,1115 +1036,1328 @@ func (m *MsgMarkObsoleteRollappsResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *MsgMarkObsoleteRollappsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMarkObsoleteRollappsResponse.Merge(m, src)
+func (m *MsgScheduleDRSUpgradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleDRSUpgradeResponse.Merge(m, src)
 }
-func (m *MsgMarkObsoleteRollappsResponse) XXX_Size() int {
+func (m *MsgScheduleDRSUpgradeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMarkObsoleteRollappsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMarkObsoleteRollappsResponse.DiscardUnknown(m)
+func (m *MsgScheduleDRSUpgradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleDRSUpgradeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMarkObsoleteRollappsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgScheduleDRSUpgradeResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgCreateRollapp)(nil), "dymensionxyz.dymension.rollapp.MsgCreateRollapp")
-	proto.RegisterType((*MsgCreateRollappResponse)(nil), "dymensionxyz.dymension.rollapp.MsgCreateRollappResponse")
-	proto.RegisterType((*MsgUpdateRollappInformation)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateRollappInformation")
-	proto.RegisterType((*MsgUpdateRollappInformationResponse)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateRollappInformationResponse")
-	proto.RegisterType((*MsgUpdateState)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateState")
-	proto.RegisterType((*MsgUpdateStateResponse)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateStateResponse")
-	proto.RegisterType((*MsgTransferOwnership)(nil), "dymensionxyz.dymension.rollapp.MsgTransferOwnership")
-	proto.RegisterType((*MsgTransferOwnershipResponse)(nil), "dymensionxyz.dymension.rollapp.MsgTransferOwnershipResponse")
-	proto.RegisterType((*MsgAddApp)(nil), "dymensionxyz.dymension.rollapp.MsgAddApp")
-	proto.RegisterType((*MsgAddAppResponse)(nil), "dymensionxyz.dymension.rollapp.MsgAddAppResponse")
-	proto.RegisterType((*MsgUpdateApp)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateApp")
-	proto.RegisterType((*MsgUpdateAppResponse)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateAppResponse")
-	proto.RegisterType((*MsgRemoveApp)(nil), "dymensionxyz.dymension.rollapp.MsgRemoveApp")
-	proto.RegisterType((*MsgRemoveAppResponse)(nil), "dymensionxyz.dymension.rollapp.MsgRemoveAppResponse")
-	proto.RegisterType((*MsgMarkObsoleteRollapps)(nil), "dymensionxyz.dymension.rollapp.MsgMarkObsoleteRollapps")
-	proto.RegisterType((*MsgMarkObsoleteRollappsResponse)(nil), "dymensionxyz.dymension.rollapp.MsgMarkObsoleteRollappsResponse")
+// MsgDeclareMaintenanceWindow declares, in advance, a range of hub heights
+// during which the rollapp is not slashed for liveness.
+type MsgDeclareMaintenanceWindow struct {
+	// creator is either the rollapp owner or the current proposer
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// rollapp_id is the unique identifier of the rollapp chain
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// start_height is the first hub height of the window
+	StartHeight int64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the first hub height after the window
+	EndHeight int64 `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("dymensionxyz/dymension/rollapp/tx.proto", fileDescriptor_1a86300fb8647ecb)
+func (m *MsgDeclareMaintenanceWindow) Reset()         { *m = MsgDeclareMaintenanceWindow{} }
+func (m *MsgDeclareMaintenanceWindow) String() string { return proto.CompactTextString(m) }
+func (*MsgDeclareMaintenanceWindow) ProtoMessage()    {}
+func (*MsgDeclareMaintenanceWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a86300fb8647ecb, []int{18}
 }
-
-var fileDescriptor_1a86300fb8647ecb = []byte{
-	// 1250 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4d, 0x73, 0xdb, 0x44,
-	0x18, 0x8e, 0x62, 0xc7, 0xb1, 0xd7, 0x4e, 0xea, 0x2e, 0x99, 0x56, 0x71, 0x8b, 0x9b, 0xba, 0x03,
-	0xa4, 0x5f, 0x72, 0xd3, 0x96, 0xc2, 0x04, 0x66, 0x98, 0x38, 0x99, 0x69, 0x0b, 0x63, 0x52, 0x94,
-	0xd0, 0x03, 0x17, 0xcf, 0xda, 0xda, 0x28, 0x6a, 0x2d, 0xad, 0xd9, 0x95, 0x9d, 0x18, 0x2e, 0x1d,
-	0x2e, 0x1c, 0xb8, 0xf4, 0x07, 0x30, 0x03, 0x3f, 0xa1, 0x07, 0xae, 0x5c, 0x99, 0x1e, 0x3b, 0x9c,
-	0xe0, 0xd2, 0x61, 0x92, 0x43, 0xef, 0x1c, 0x39, 0x31, 0xbb, 0x5a, 0xad, 0xed, 0xf8, 0x43, 0x72,
-	0xe0, 0x24, 0xed, 0xbb, 0xcf, 0xfb, 0xfd, 0xec, 0xab, 0x15, 0x78, 0xcf, 0xea, 0xba, 0xd8, 0x63,
-	0x0e, 0xf1, 0x0e, 0xbb, 0xdf, 0x94, 0xd5, 0xa2, 0x4c, 0x49, 0xb3, 0x89, 0x5a, 0xad, 0xb2, 0x7f,
-	0x68, 0xb4, 0x28, 0xf1, 0x09, 0x2c, 0xf6, 0x03, 0x0d, 0xb5, 0x30, 0x24, 0xb0, 0x70, 0xbe, 0x41,
-	0x98, 0x4b, 0x58, 0xd9, 0x65, 0x76, 0xb9, 0xb3, 0xc6, 0x1f, 0x81, 0x62, 0xe1, 0xfd, 0x08, 0x0f,
-	0xf5, 0x26, 0x69, 0x3c, 0xad, 0x59, 0x98, 0x35, 0xa8, 0xd3, 0xf2, 0x09, 0x95, 0x6a, 0x37, 0x22,
-	0xd4, 0xe4, 0x53, 0xa2, 0x6f, 0x46, 0xa0, 0x5d, 0xec, 0x23, 0x0b, 0xf9, 0x48, 0xc2, 0xd7, 0x22,
-	0xe0, 0x36, 0xf6, 0x30, 0x73, 0x58, 0xcd, 0xf1, 0xf6, 0x88, 0x54, 0xb9, 0x1e, 0xa1, 0xd2, 0x42,
-	0x14, 0xb9, 0x4c, 0x82, 0x97, 0x6c, 0x62, 0x13, 0xf1, 0x5a, 0xe6, 0x6f, 0x52, 0xba, 0x1c, 0x94,
-	0xa8, 0x16, 0x6c, 0x04, 0x0b, 0xb9, 0x55, 0x94, 0xd5, 0xab, 0x23, 0x86, 0xcb, 0x9d, 0xb5, 0x3a,
-	0xf6, 0xd1, 0x5a, 0xb9, 0x41, 0x1c, 0x2f, 0xd8, 0x2f, 0xfd, 0xa4, 0x81, 0x33, 0x55, 0x66, 0x7f,
-	0xd9, 0xb2, 0x90, 0x8f, 0x1f, 0x09, 0x57, 0xf0, 0x1e, 0xc8, 0xa0, 0xb6, 0xbf, 0x4f, 0xa8, 0xe3,
-	0x77, 0x75, 0x6d, 0x45, 0x5b, 0xcd, 0x54, 0xf4, 0xdf, 0x7f, 0xb9, 0xb9, 0x24, 0x0d, 0x6f, 0x58,
-	0x16, 0xc5, 0x8c, 0xed, 0xf8, 0xd4, 0xf1, 0x6c, 0xb3, 0x07, 0x85, 0x5b, 0x20, 0x15, 0x04, 0xab,
-	0xcf, 0xae, 0x68, 0xab, 0xd9, 0xdb, 0xef, 0x1a, 0x93, 0x5b, 0x6b, 0x04, 0xfe, 0x2a, 0xc9, 0x97,
-	0xaf, 0x2f, 0xcd, 0x98, 0x52, 0x77, 0x7d, 0xf1, 0xbb, 0x37, 0x2f, 0xae, 0xf5, 0xac, 0x96, 0x96,
-	0xc1, 0xf9, 0x13, 0x01, 0x9a, 0x98, 0xb5, 0x88, 0xc7, 0x70, 0xe9, 0x9f, 0x04, 0xc8, 0x57, 0x99,
-	0xbd, 0x49, 0x31, 0xf2, 0xb1, 0x19, 0x18, 0x85, 0x3a, 0x98, 0x6f, 0x70, 0x01, 0xa1, 0x41, 0xec,
-	0x66, 0xb8, 0x84, 0x6f, 0x03, 0x20, 0x3d, 0xd7, 0x1c, 0x4b, 0xc4, 0x98, 0x31, 0x33, 0x52, 0xf2,
-	0xd0, 0x82, 0xd7, 0xc1, 0x59, 0xc7, 0x73, 0x7c, 0x07, 0x35, 0x6b, 0x0c, 0x7f, 0xdd, 0xc6, 0x5e,
-	0x03, 0x53, 0x3d, 0x2b, 0x50, 0x79, 0xb9, 0xb1, 0x13, 0xca, 0xe1, 0x13, 0x00, 0x5d, 0xc7, 0xeb,
-	0x01, 0x6b, 0x75, 0xe2, 0x59, 0x7a, 0x5e, 0xe4, 0xbd, 0x6c, 0xc8, 0x4a, 0xf1, 0xa2, 0x1b, 0xb2,
-	0xe8, 0xc6, 0x26, 0x71, 0xbc, 0xca, 0x65, 0x9e, 0xea, 0xdf, 0xaf, 0x2f, 0x2d, 0x77, 0x91, 0xdb,
-	0x5c, 0x2f, 0x0d, 0x9b, 0x28, 0x99, 0x79, 0xd7, 0xf1, 0x94, 0x9f, 0x0a, 0xf1, 0x2c, 0xb8, 0x04,
-	0xe6, 0x50, 0xd3, 0x41, 0x4c, 0xcf, 0x89, 0x60, 0x82, 0x05, 0xfc, 0x0c, 0xa4, 0x43, 0xf2, 0xe9,
-	0x0b, 0xc2, 0x6f, 0x39, 0xaa, 0xde, 0xb2, 0x44, 0x55, 0xa9, 0x66, 0x2a, 0x03, 0x70, 0x17, 0xe4,
-	0xfa, 0xa9, 0xa9, 0x2f, 0x0a, 0x83, 0xd7, 0xa3, 0x0c, 0xde, 0x0f, 0x74, 0x1e, 0x7a, 0x7b, 0x44,
-	0x74, 0x51, 0x33, 0xb3, 0x76, 0x4f, 0x04, 0xef, 0x83, 0xf9, 0x8e, 0x5b, 0xf3, 0xbb, 0x2d, 0xac,
-	0x9f, 0x59, 0xd1, 0x56, 0x17, 0x6f, 0x1b, 0x31, 0x23, 0x34, 0x1e, 0x57, 0x77, 0xbb, 0x2d, 0x6c,
-	0xa6, 0x3a, 0x2e, 0x7f, 0xae, 0xe7, 0x38, 0x27, 0xc2, 0x3e, 0x7e, 0x9a, 0x4c, 0x27, 0xf2, 0xd9,
-	0x52, 0x01, 0xe8, 0x27, 0x7b, 0xaf, 0x88, 0xf1, 0x73, 0x02, 0x5c, 0x50, 0xa4, 0x91, 0x9b, 0x3c,
-	0x22, 0xea, 0x22, 0xdf, 0x21, 0x1e, 0xaf, 0x28, 0x39, 0xf0, 0x70, 0xc8, 0x90, 0x60, 0x71, 0x2a,
-	0x7e, 0x24, 0xa6, 0xe2, 0xc7, 0x7c, 0x1c, 0x7e, 0x68, 0xd3, 0xf2, 0xe3, 0x8b, 0x3e, 0x26, 0xcc,
-	0x9d, 0x8a, 0x09, 0xb2, 0x79, 0xe3, 0xf9, 0x90, 0xfa, 0x3f, 0xf8, 0xb0, 0x0e, 0x78, 0x1b, 0x83,
-	0x62, 0x97, 0xde, 0x01, 0x57, 0x26, 0x74, 0x48, 0x75, 0xf2, 0xd7, 0x59, 0xb0, 0xa8, 0x70, 0x3b,
-	0x3e, 0xf2, 0xf1, 0x84, 0x03, 0x7e, 0x11, 0xf4, 0xda, 0x35, 0xdc, 0xbf, 0x15, 0x90, 0x65, 0x3e,
-	0xa2, 0xfe, 0x03, 0xec, 0xd8, 0xfb, 0xbe, 0xe8, 0x5c, 0xd2, 0xec, 0x17, 0x71, 0x7d, 0xaf, 0xed,
-	0x56, 0xf8, 0x77, 0x83, 0xe9, 0x49, 0xb1, 0xdf, 0x13, 0xc0, 0x73, 0x20, 0xb5, 0xb5, 0xf1, 0x08,
-	0xf9, 0xfb, 0xa2, 0xc8, 0x19, 0x53, 0xae, 0xe0, 0x03, 0x90, 0xa8, 0x6c, 0x31, 0xd9, 0xdb, 0x5b,
-	0x51, 0x25, 0x12, 0xc6, 0xb6, 0xd4, 0x47, 0x29, 0x9c, 0x7e, 0xdc, 0x04, 0x84, 0x20, 0xd9, 0x44,
-	0xcc, 0xd7, 0xd3, 0x2b, 0xda, 0x6a, 0xda, 0x14, 0xef, 0xf0, 0x2a, 0xc8, 0x87, 0xa4, 0xa4, 0xb8,
-	0xe3, 0x70, 0x5b, 0x7a, 0x46, 0x84, 0x76, 0x86, 0x86, 0xac, 0x0f, 0xc4, 0x43, 0xa7, 0x24, 0x95,
-	0x9f, 0x2f, 0xe9, 0xe0, 0xdc, 0x60, 0xf9, 0x54, 0x65, 0x7f, 0xd0, 0xc0, 0x52, 0x95, 0xd9, 0xbb,
-	0x14, 0x79, 0x6c, 0x0f, 0xd3, 0x6d, 0xde, 0x15, 0xb6, 0xef, 0xb4, 0xe0, 0x15, 0xb0, 0xd0, 0x68,
-	0x53, 0x8a, 0x3d, 0xbf, 0xd6, 0x7f, 0x48, 0x72, 0x52, 0x28, 0x80, 0xf0, 0x02, 0xc8, 0x78, 0xf8,
-	0x40, 0x02, 0x82, 0x52, 0xa7, 0x3d, 0x7c, 0xb0, 0x3d, 0xe2, 0x20, 0x25, 0x4e, 0x34, 0x62, 0x1d,
-	0xf2, 0x38, 0x07, 0x7d, 0x94, 0x8a, 0xe0, 0xe2, 0xa8, 0x60, 0x54, 0xb4, 0xbf, 0x69, 0x20, 0x53,
-	0x65, 0xf6, 0x86, 0x65, 0x6d, 0x4c, 0x9c, 0xf1, 0x10, 0x24, 0x3d, 0xe4, 0x62, 0x19, 0x92, 0x78,
-	0x8f, 0x08, 0x87, 0xf3, 0x22, 0xbc, 0x24, 0xf0, 0xe2, 0x26, 0xc5, 0x7e, 0xbf, 0x88, 0x8f, 0x0b,
-	0xc7, 0x45, 0x36, 0x96, 0x8d, 0x0f, 0x16, 0x30, 0x0f, 0x12, 0x6d, 0xda, 0x14, 0x47, 0x23, 0x63,
-	0xf2, 0x57, 0x31, 0x56, 0xa8, 0x85, 0xa9, 0xe0, 0xc2, 0x9c, 0x19, 0x2c, 0x06, 0xdb, 0x52, 0x7a,
-	0x0b, 0x9c, 0x55, 0x79, 0xa8, 0xec, 0xfe, 0xd4, 0x40, 0x4e, 0xb5, 0x69, 0x72, 0x82, 0x8b, 0x60,
-	0x56, 0x0e, 0xa7, 0xa4, 0x39, 0xeb, 0x58, 0x2a, 0xe1, 0xc4, 0xd8, 0x84, 0x93, 0x11, 0x09, 0xcf,
-	0x4d, 0x48, 0x38, 0x35, 0x22, 0xe1, 0xf9, 0x11, 0x09, 0xa7, 0xc7, 0x27, 0x7c, 0x4e, 0xd0, 0x4c,
-	0xa5, 0xa6, 0x72, 0xc6, 0x22, 0x65, 0x13, 0xbb, 0xa4, 0x33, 0x65, 0xca, 0x11, 0xf4, 0x1a, 0xe5,
-	0x5e, 0xb9, 0x51, 0xee, 0x9f, 0x88, 0x6b, 0x45, 0x15, 0xd1, 0xa7, 0xdb, 0x75, 0x46, 0x9a, 0x58,
-	0x4d, 0x21, 0xc6, 0xc7, 0xc0, 0x89, 0xfb, 0x4f, 0xff, 0x2d, 0xe7, 0x32, 0xc8, 0x59, 0x94, 0xd5,
-	0x3a, 0x98, 0xf2, 0x43, 0xc7, 0xef, 0x3a, 0x89, 0xd5, 0x05, 0x33, 0x6b, 0x51, 0xf6, 0x58, 0x8a,
-	0x86, 0xae, 0x30, 0x97, 0xc1, 0xa5, 0x31, 0xbe, 0xc2, 0x70, 0x6e, 0x3f, 0xcb, 0x80, 0x44, 0x95,
-	0xd9, 0xf0, 0x10, 0xe4, 0x06, 0xee, 0x62, 0x91, 0x93, 0xfc, 0xc4, 0xdd, 0xa8, 0xf0, 0xc1, 0x94,
-	0x0a, 0x61, 0x04, 0xf0, 0x5b, 0xb0, 0x30, 0x78, 0x91, 0xba, 0x15, 0xc3, 0xd2, 0x80, 0x46, 0xe1,
-	0xc3, 0x69, 0x35, 0x94, 0xf3, 0x1f, 0x35, 0xa0, 0x8f, 0xfd, 0x5a, 0x7f, 0x14, 0x3b, 0xa5, 0x61,
-	0xe5, 0xc2, 0xe6, 0x7f, 0x50, 0x56, 0xe1, 0xb5, 0x41, 0xb6, 0xff, 0x0b, 0x64, 0xc4, 0xb6, 0x29,
-	0xf0, 0x85, 0x7b, 0xd3, 0xe1, 0x95, 0xdb, 0xef, 0x35, 0x70, 0x76, 0x78, 0x3e, 0xdf, 0x8d, 0x61,
-	0x6d, 0x48, 0xab, 0xf0, 0xf1, 0x69, 0xb4, 0x54, 0x24, 0x7b, 0x20, 0x25, 0x47, 0xef, 0xd5, 0x18,
-	0x76, 0x02, 0x68, 0x61, 0x2d, 0x36, 0x54, 0xf9, 0x21, 0x20, 0xd3, 0x1b, 0x82, 0x37, 0x62, 0x97,
-	0x8d, 0x7b, 0xbb, 0x3b, 0x0d, 0xba, 0xdf, 0x61, 0x6f, 0x04, 0xc5, 0x71, 0xa8, 0xd0, 0xb1, 0x1c,
-	0x0e, 0xcd, 0x1d, 0xf8, 0x9c, 0x7f, 0x76, 0x47, 0x4d, 0x9d, 0x38, 0x07, 0x77, 0x94, 0x62, 0xe1,
-	0x93, 0x53, 0x2a, 0x86, 0x21, 0x15, 0xe6, 0x9e, 0xbd, 0x79, 0x71, 0x4d, 0xab, 0x7c, 0xfe, 0xf2,
-	0xa8, 0xa8, 0xbd, 0x3a, 0x2a, 0x6a, 0x7f, 0x1d, 0x15, 0xb5, 0xe7, 0xc7, 0xc5, 0x99, 0x57, 0xc7,
-	0xc5, 0x99, 0x3f, 0x8e, 0x8b, 0x33, 0x5f, 0xdd, 0xb5, 0x1d, 0x7f, 0xbf, 0x5d, 0x37, 0x1a, 0xc4,
-	0x2d, 0x8f, 0xf9, 0x5b, 0xed, 0xdc, 0x29, 0x1f, 0xf6, 0xfe, 0xed, 0xbb, 0x2d, 0xcc, 0xea, 0x29,
-	0xf1, 0x87, 0x79, 0xe7, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd4, 0xab, 0x96, 0x87, 0x0a, 0x10,
-	0x00, 0x00,
+func (m *MsgDeclareMaintenanceWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeclareMaintenanceWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeclareMaintenanceWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeclareMaintenanceWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeclareMaintenanceWindow.Merge(m, src)
+}
+func (m *MsgDeclareMaintenanceWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeclareMaintenanceWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeclareMaintenanceWindow.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgDeclareMaintenanceWindow proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgDeclareMaintenanceWindow) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams is used for updating module params.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	CreateRollapp(ctx context.Context, in *MsgCreateRollapp, opts ...grpc.CallOption) (*MsgCreateRollappResponse, error)
-	UpdateRollappInformation(ctx context.Context, in *MsgUpdateRollappInformation, opts ...grpc.CallOption) (*MsgUpdateRollappInformationResponse, error)
-	UpdateState(ctx context.Context, in *MsgUpdateState, opts ...grpc.CallOption) (*MsgUpdateStateResponse, error)
-	TransferOwnership(ctx context.Context, in *MsgTransferOwnership, opts ...grpc.CallOption) (*MsgTransferOwnershipResponse, error)
-	AddApp(ctx context.Context, in *MsgAddApp, opts ...grpc.CallOption) (*MsgAddAppResponse, error)
-	UpdateApp(ctx context.Context, in *MsgUpdateApp, opts ...grpc.CallOption) (*MsgUpdateAppResponse, error)
-	RemoveApp(ctx context.Context, in *MsgRemoveApp, opts ...grpc.CallOption) (*MsgRemoveAppResponse, error)
-	MarkObsoleteRollapps(ctx context.Context, in *MsgMarkObsoleteRollapps, opts ...grpc.CallOption) (*MsgMarkObsoleteRollappsResponse, error)
+func (m *MsgDeclareMaintenanceWindow) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgDeclareMaintenanceWindow) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgDeclareMaintenanceWindow) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+type MsgDeclareMaintenanceWindowResponse struct {
+}
+
+func (m *MsgDeclareMaintenanceWindowResponse) Reset()         { *m = MsgDeclareMaintenanceWindowResponse{} }
+func (m *MsgDeclareMaintenanceWindowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeclareMaintenanceWindowResponse) ProtoMessage()    {}
+func (*MsgDeclareMaintenanceWindowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a86300fb8647ecb, []int{19}
+}
+func (m *MsgDeclareMaintenanceWindowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeclareMaintenanceWindowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeclareMaintenanceWindowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgDeclareMaintenanceWindowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeclareMaintenanceWindowResponse.Merge(m, src)
+}
+func (m *MsgDeclareMaintenanceWindowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeclareMaintenanceWindowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeclareMaintenanceWindowResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateRollapp(ctx context.Context, in *MsgCreateRollapp, opts ...grpc.CallOption) (*MsgCreateRollappResponse, error) {
-	out := new(MsgCreateRollappResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/CreateRollapp", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDeclareMaintenanceWindowResponse proto.InternalMessageInfo
+
+// MsgAddApp adds an app to the rollapp.
+type MsgAddApp struct {
+	// creator is the bech32-encoded address of the app creator
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// name is the unique App's name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// rollapp_id is the id of the Rollapp the App belongs to
+	RollappId string `protobuf:"bytes,3,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// description is the description of the App
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// image is the url to the App image
+	Image string `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
+	// url is the URL to the App's website
+	Url string `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	// order is the order of the App in the Rollapp
+	Order int32 `protobuf:"varint,7,opt,name=order,proto3" json:"order,omitempty"`
+}
+
+func (m *MsgAddApp) Reset()         { *m = MsgAddApp{} }
+func (m *MsgAddApp) String() string { return proto.CompactTextString(m) }
+func (*MsgAddApp) ProtoMessage()    {}
+func (*MsgAddApp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a86300fb8647ecb, []int{20}
+}
+func (m *MsgAddApp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddApp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddApp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgAddApp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddApp.Merge(m, src)
+}
+func (m *MsgAddApp) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddApp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddApp.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateRollappInformation(ctx context.Context, in *MsgUpdateRollappInformation, opts ...grpc.CallOption) (*MsgUpdateRollappInformationResponse, error) {
-	out := new(MsgUpdateRollappInformationResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/UpdateRollappInformation", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAddApp proto.InternalMessageInfo
+
+func (m *MsgAddApp) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateState(ctx context.Context, in *MsgUpdateState, opts ...grpc.CallOption) (*MsgUpdateStateResponse, error) {
-	out := new(MsgUpdateStateResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/UpdateState", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddApp) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) TransferOwnership(ctx context.Context, in *MsgTransferOwnership, opts ...grpc.CallOption) (*MsgTransferOwnershipResponse, error) {
-	out := new(MsgTransferOwnershipResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/TransferOwnership", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddApp) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) AddApp(ctx context.Context, in *MsgAddApp, opts ...grpc.CallOption) (*MsgAddAppResponse, error) {
-	out := new(MsgAddAppResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/AddApp", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddApp) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateApp(ctx context.Context, in *MsgUpdateApp, opts ...grpc.CallOption) (*MsgUpdateAppResponse, error) {
-	out := new(MsgUpdateAppResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/UpdateApp", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddApp) GetImage() string {
+	if m != nil {
+		return m.Image
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RemoveApp(ctx context.Context, in *MsgRemoveApp, opts ...grpc.CallOption) (*MsgRemoveAppResponse, error) {
-	out := new(MsgRemoveAppResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/RemoveApp", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddApp) GetUrl() string {
+	if m != nil {
+		return m.Url
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) MarkObsoleteRollapps(ctx context.Context, in *MsgMarkObsoleteRollapps, opts ...grpc.CallOption) (*MsgMarkObsoleteRollappsResponse, error) {
-	out := new(MsgMarkObsoleteRollappsResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/MarkObsoleteRollapps", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddApp) GetOrder() int32 {
+	if m != nil {
+		return m.Order
 	}
-	return out, nil
+	return 0
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams is used for updating module params.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	CreateRollapp(context.Context, *MsgCreateRollapp) (*MsgCreateRollappResponse, error)
-	UpdateRollappInformation(context.Context, *MsgUpdateRollappInformation) (*MsgUpdateRollappInformationResponse, error)
-	UpdateState(context.Context, *MsgUpdateState) (*MsgUpdateStateResponse, error)
-	TransferOwnership(context.Context, *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error)
-	AddApp(context.Context, *MsgAddApp) (*MsgAddAppResponse, error)
-	UpdateApp(context.Context, *MsgUpdateApp) (*MsgUpdateAppResponse, error)
-	RemoveApp(context.Context, *MsgRemoveApp) (*MsgRemoveAppResponse, error)
-	MarkObsoleteRollapps(context.Context, *MsgMarkObsoleteRollapps) (*MsgMarkObsoleteRollappsResponse, error)
+type MsgAddAppResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgAddAppResponse) Reset()         { *m = MsgAddAppResponse{} }
+func (m *MsgAddAppResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddAppResponse) ProtoMessage()    {}
+func (*MsgAddAppResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a86300fb8647ecb, []int{21}
 }
-
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgAddAppResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CreateRollapp(ctx context.Context, req *MsgCreateRollapp) (*MsgCreateRollappResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateRollapp not implemented")
+func (m *MsgAddAppResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAppResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateRollappInformation(ctx context.Context, req *MsgUpdateRollappInformation) (*MsgUpdateRollappInformationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRollappInformation not implemented")
+func (m *MsgAddAppResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAppResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateState(ctx context.Context, req *MsgUpdateState) (*MsgUpdateStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateState not implemented")
+func (m *MsgAddAppResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) TransferOwnership(ctx context.Context, req *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
+func (m *MsgAddAppResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAppResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) AddApp(ctx context.Context, req *MsgAddApp) (*MsgAddAppResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddApp not implemented")
+
+var xxx_messageInfo_MsgAddAppResponse proto.InternalMessageInfo
+
+// MsgUpdateApp updates the app information.
+type MsgUpdateApp struct {
+	// creator is the bech32-encoded address of the app owner
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// id is the unique App's id in the Rollapp
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// name is the unique App's name (immutable)
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// rollapp_id is the id of the Rollapp the App belongs to
+	RollappId string `protobuf:"bytes,4,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// description is the description of the App
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// image is the url to the App image
+	Image string `protobuf:"bytes,6,opt,name=image,proto3" json:"image,omitempty"`
+	// url is the URL to the App's website
+	Url string `protobuf:"bytes,7,opt,name=url,proto3" json:"url,omitempty"`
+	// order is the order of the App in the Rollapp
+	Order int32 `protobuf:"varint,8,opt,name=order,proto3" json:"order,omitempty"`
 }
-func (*UnimplementedMsgServer) UpdateApp(ctx context.Context, req *MsgUpdateApp) (*MsgUpdateAppResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateApp not implemented")
+
+func (m *MsgUpdateApp) Reset()         { *m = MsgUpdateApp{} }
+func (m *MsgUpdateApp) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateApp) ProtoMessage()    {}
+func (*MsgUpdateApp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a86300fb8647ecb, []int{22}
 }
-func (*UnimplementedMsgServer) RemoveApp(ctx context.Context, req *MsgRemoveApp) (*MsgRemoveAppResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveApp not implemented")
+func (m *MsgUpdateApp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) MarkObsoleteRollapps(ctx context.Context, req *MsgMarkObsoleteRollapps) (*MsgMarkObsoleteRollappsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MarkObsoleteRollapps not implemented")
+func (m *MsgUpdateApp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateApp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgUpdateApp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateApp.Merge(m, src)
 }
-
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgUpdateApp) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateApp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateApp.DiscardUnknown(m)
 }
 
-func _Msg_CreateRollapp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateRollapp)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateRollapp(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/CreateRollapp",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateRollapp(ctx, req.(*MsgCreateRollapp))
+var xxx_messageInfo_MsgUpdateApp proto.InternalMessageInfo
+
+func (m *MsgUpdateApp) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateRollappInformation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateRollappInformation)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateRollappInformation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/UpdateRollappInformation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateRollappInformation(ctx, req.(*MsgUpdateRollappInformation))
+func (m *MsgUpdateApp) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_UpdateState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateState)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateState(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/UpdateState",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateState(ctx, req.(*MsgUpdateState))
+func (m *MsgUpdateApp) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTransferOwnership)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).TransferOwnership(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/TransferOwnership",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).TransferOwnership(ctx, req.(*MsgTransferOwnership))
+func (m *MsgUpdateApp) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_AddApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddApp)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddApp(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/AddApp",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddApp(ctx, req.(*MsgAddApp))
+func (m *MsgUpdateApp) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateApp)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateApp(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/UpdateApp",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateApp(ctx, req.(*MsgUpdateApp))
+func (m *MsgUpdateApp) GetImage() string {
+	if m != nil {
+		return m.Image
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RemoveApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveApp)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveApp(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/RemoveApp",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveApp(ctx, req.(*MsgRemoveApp))
+func (m *MsgUpdateApp) GetUrl() string {
+	if m != nil {
+		return m.Url
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_MarkObsoleteRollapps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMarkObsoleteRollapps)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MarkObsoleteRollapps(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/MarkObsoleteRollapps",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MarkObsoleteRollapps(ctx, req.(*MsgMarkObsoleteRollapps))
+func (m *MsgUpdateApp) GetOrder() int32 {
+	if m != nil {
+		return m.Order
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dymensionxyz.dymension.rollapp.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "CreateRollapp",
-			Handler:    _Msg_CreateRollapp_Handler,
-		},
-		{
-			MethodName: "UpdateRollappInformation",
-			Handler:    _Msg_UpdateRollappInformation_Handler,
-		},
-		{
-			MethodName: "UpdateState",
-			Handler:    _Msg_UpdateState_Handler,
-		},
-		{
-			MethodName: "TransferOwnership",
-			Handler:    _Msg_TransferOwnership_Handler,
-		},
-		{
-			MethodName: "AddApp",
-			Handler:    _Msg_AddApp_Handler,
-		},
-		{
-			MethodName: "UpdateApp",
-			Handler:    _Msg_UpdateApp_Handler,
-		},
-		{
-			MethodName: "RemoveApp",
-			Handler:    _Msg_RemoveApp_Handler,
-		},
-		{
-			MethodName: "MarkObsoleteRollapps",
-			Handler:    _Msg_MarkObsoleteRollapps_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dymensionxyz/dymension/rollapp/tx.proto",
+type MsgUpdateAppResponse struct {
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateAppResponse) Reset()         { *m = MsgUpdateAppResponse{} }
+func (m *MsgUpdateAppResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAppResponse) ProtoMessage()    {}
+func (*MsgUpdateAppResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a86300fb8647ecb, []int{23}
+}
+func (m *MsgUpdateAppResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAppResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAppResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateAppResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAppResponse.Merge(m, src)
+}
+func (m *MsgUpdateAppResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAppResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAppResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateAppResponse proto.InternalMessageInfo
+
+// MsgRemoveApp removes an app from the rollapp.
+type MsgRemoveApp struct {
+	// creator is the bech32-encoded address of the app owner
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// id is the unique App's id in the Rollapp
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// rollapp_id is the id of the Rollapp the App belongs to
+	RollappId string `protobuf:"bytes,3,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRemoveApp) Reset()         { *m = MsgRemoveApp{} }
+func (m *MsgRemoveApp) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveApp) ProtoMessage()    {}
+func (*MsgRemoveApp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a86300fb8647ecb, []int{24}
+}
+func (m *MsgRemoveApp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveApp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveApp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRemoveApp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveApp.Merge(m, src)
+}
+func (m *MsgRemoveApp) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveApp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveApp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveApp proto.InternalMessageInfo
+
+func (m *MsgRemoveApp) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveApp) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveApp) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgRemoveAppResponse struct {
 }
 
-func (m *MsgCreateRollapp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveAppResponse) Reset()         { *m = MsgRemoveAppResponse{} }
+func (m *MsgRemoveAppResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAppResponse) ProtoMessage()    {}
+func (*MsgRemoveAppResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a86300fb8647ecb, []int{25}
+}
+func (m *MsgRemoveAppResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAppResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAppResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRemoveAppResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAppResponse.Merge(m, src)
+}
+func (m *MsgRemoveAppResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAppResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAppResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateRollapp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRemoveAppResponse proto.InternalMessageInfo
+
+// MsgMarkObsoleteRollapps marks specified versions as obsolete as well as
+// all corresponding rollapps. Must be called by the governance.
+type MsgMarkObsoleteRollapps struct {
+	// Authority is the authority address.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// DrsVersions is a list of DRS versions that will be marked obsolete.
+	DrsVersions []uint32 `protobuf:"varint,2,rep,packed,name=drs_versions,json=drsVersions,proto3" json:"drs_versions,omitempty"`
 }
 
-func (m *MsgCreateRollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.MinSequencerBond.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgMarkObsoleteRollapps) Reset()         { *m = MsgMarkObsoleteRollapps{} }
+func (m *MsgMarkObsoleteRollapps) String() string { return proto.CompactTextString(m) }
+func (*MsgMarkObsoleteRollapps) ProtoMessage()    {}
+func (*MsgMarkObsoleteRollapps) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a86300fb8647ecb, []int{26}
+}
+func (m *MsgMarkObsoleteRollapps) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMarkObsoleteRollapps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMarkObsoleteRollapps.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x82
-	if m.VmType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.VmType))
-		i--
-		dAtA[i] = 0x78
+}
+func (m *MsgMarkObsoleteRollapps) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarkObsoleteRollapps.Merge(m, src)
+}
+func (m *MsgMarkObsoleteRollapps) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMarkObsoleteRollapps) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarkObsoleteRollapps.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMarkObsoleteRollapps proto.InternalMessageInfo
+
+func (m *MsgMarkObsoleteRollapps) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if m.GenesisInfo != nil {
-		{
-			size, err := m.GenesisInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x72
+	return ""
+}
+
+func (m *MsgMarkObsoleteRollapps) GetDrsVersions() []uint32 {
+	if m != nil {
+		return m.DrsVersions
 	}
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+	return nil
+}
+
+type MsgMarkObsoleteRollappsResponse struct {
+}
+
+func (m *MsgMarkObsoleteRollappsResponse) Reset()         { *m = MsgMarkObsoleteRollappsResponse{} }
+func (m *MsgMarkObsoleteRollappsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMarkObsoleteRollappsResponse) ProtoMessage()    {}
+func (*MsgMarkObsoleteRollappsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a86300fb8647ecb, []int{27}
+}
+func (m *MsgMarkObsoleteRollappsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMarkObsoleteRollappsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMarkObsoleteRollappsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x6a
+		return b[:n], nil
 	}
-	if len(m.Alias) > 0 {
-		i -= len(m.Alias)
-		copy(dAtA[i:], m.Alias)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Alias)))
-		i--
-		dAtA[i] = 0x62
+}
+func (m *MsgMarkObsoleteRollappsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarkObsoleteRollappsResponse.Merge(m, src)
+}
+func (m *MsgMarkObsoleteRollappsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMarkObsoleteRollappsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarkObsoleteRollappsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMarkObsoleteRollappsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreateRollapp)(nil), "dymensionxyz.dymension.rollapp.MsgCreateRollapp")
+	proto.RegisterType((*MsgCreateRollappResponse)(nil), "dymensionxyz.dymension.rollapp.MsgCreateRollappResponse")
+	proto.RegisterType((*MsgUpdateRollappInformation)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateRollappInformation")
+	proto.RegisterType((*MsgUpdateRollappInformationResponse)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateRollappInformationResponse")
+	proto.RegisterType((*MsgUpdateState)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateState")
+	proto.RegisterType((*MsgUpdateStateResponse)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateStateResponse")
+	proto.RegisterType((*MsgTransferOwnership)(nil), "dymensionxyz.dymension.rollapp.MsgTransferOwnership")
+	proto.RegisterType((*MsgTransferOwnershipResponse)(nil), "dymensionxyz.dymension.rollapp.MsgTransferOwnershipResponse")
+	proto.RegisterType((*MsgAcceptOwnership)(nil), "dymensionxyz.dymension.rollapp.MsgAcceptOwnership")
+	proto.RegisterType((*MsgAcceptOwnershipResponse)(nil), "dymensionxyz.dymension.rollapp.MsgAcceptOwnershipResponse")
+	proto.RegisterType((*MsgCancelOwnershipTransfer)(nil), "dymensionxyz.dymension.rollapp.MsgCancelOwnershipTransfer")
+	proto.RegisterType((*MsgCancelOwnershipTransferResponse)(nil), "dymensionxyz.dymension.rollapp.MsgCancelOwnershipTransferResponse")
+	proto.RegisterType((*MsgSunsetRollapp)(nil), "dymensionxyz.dymension.rollapp.MsgSunsetRollapp")
+	proto.RegisterType((*MsgSunsetRollappResponse)(nil), "dymensionxyz.dymension.rollapp.MsgSunsetRollappResponse")
+	proto.RegisterType((*MsgScheduleDRSUpgrade)(nil), "dymensionxyz.dymension.rollapp.MsgScheduleDRSUpgrade")
+	proto.RegisterType((*MsgScheduleDRSUpgradeResponse)(nil), "dymensionxyz.dymension.rollapp.MsgScheduleDRSUpgradeResponse")
+	proto.RegisterType((*MsgDeclareMaintenanceWindow)(nil), "dymensionxyz.dymension.rollapp.MsgDeclareMaintenanceWindow")
+	proto.RegisterType((*MsgDeclareMaintenanceWindowResponse)(nil), "dymensionxyz.dymension.rollapp.MsgDeclareMaintenanceWindowResponse")
+	proto.RegisterType((*MsgAddApp)(nil), "dymensionxyz.dymension.rollapp.MsgAddApp")
+	proto.RegisterType((*MsgAddAppResponse)(nil), "dymensionxyz.dymension.rollapp.MsgAddAppResponse")
+	proto.RegisterType((*MsgUpdateApp)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateApp")
+	proto.RegisterType((*MsgUpdateAppResponse)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateAppResponse")
+	proto.RegisterType((*MsgRemoveApp)(nil), "dymensionxyz.dymension.rollapp.MsgRemoveApp")
+	proto.RegisterType((*MsgRemoveAppResponse)(nil), "dymensionxyz.dymension.rollapp.MsgRemoveAppResponse")
+	proto.RegisterType((*MsgMarkObsoleteRollapps)(nil), "dymensionxyz.dymension.rollapp.MsgMarkObsoleteRollapps")
+	proto.RegisterType((*MsgMarkObsoleteRollappsResponse)(nil), "dymensionxyz.dymension.rollapp.MsgMarkObsoleteRollappsResponse")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/rollapp/tx.proto", fileDescriptor_1a86300fb8647ecb)
+}
+
+var fileDescriptor_1a86300fb8647ecb = []byte{
+	// 1546 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4d, 0x6c, 0x1b, 0x45,
+	0x14, 0xce, 0xc6, 0x8e, 0x13, 0x3f, 0x3b, 0x89, 0xbb, 0x84, 0x74, 0xb3, 0x6d, 0x9d, 0xc4, 0xe5,
+	0x27, 0xfd, 0xb3, 0x9b, 0x34, 0x2d, 0x55, 0x00, 0xa1, 0x38, 0x91, 0xda, 0x82, 0x4c, 0xcb, 0xa6,
+	0x2d, 0x12, 0x12, 0x32, 0x1b, 0xef, 0x64, 0xb3, 0xad, 0x77, 0xd6, 0xec, 0xac, 0x9d, 0x04, 0x2e,
+	0xc0, 0x05, 0x09, 0x2e, 0x3d, 0x70, 0x41, 0x42, 0x82, 0x13, 0xe7, 0x1e, 0xb8, 0x72, 0x45, 0xe5,
+	0x56, 0x71, 0x82, 0x4b, 0x85, 0xda, 0x43, 0xef, 0x1c, 0x39, 0xa1, 0x99, 0x9d, 0x1d, 0x7b, 0xfd,
+	0xbb, 0x76, 0x39, 0x79, 0x67, 0xe6, 0x7d, 0xef, 0x7d, 0xef, 0xcd, 0x7b, 0x33, 0x6f, 0x0c, 0xaf,
+	0x1b, 0x47, 0x36, 0xc2, 0xc4, 0x72, 0xf0, 0xe1, 0xd1, 0x67, 0x05, 0x31, 0x28, 0xb8, 0x4e, 0xb5,
+	0xaa, 0xd7, 0x6a, 0x05, 0xef, 0x30, 0x5f, 0x73, 0x1d, 0xcf, 0x91, 0xb3, 0xad, 0x82, 0x79, 0x31,
+	0xc8, 0x73, 0x41, 0xf5, 0x78, 0xc5, 0x21, 0xb6, 0x43, 0x0a, 0x36, 0x31, 0x0b, 0x8d, 0x55, 0xfa,
+	0xe3, 0x03, 0xd5, 0xcb, 0x03, 0x2c, 0xec, 0x56, 0x9d, 0xca, 0xfd, 0xb2, 0x81, 0x48, 0xc5, 0xb5,
+	0x6a, 0x9e, 0xe3, 0x72, 0xd8, 0xf9, 0x01, 0x30, 0xfe, 0xcb, 0xa5, 0x2f, 0x0c, 0x90, 0xb6, 0x91,
+	0xa7, 0x1b, 0xba, 0xa7, 0x73, 0xf1, 0xd5, 0x01, 0xe2, 0x26, 0xc2, 0x88, 0x58, 0xa4, 0x6c, 0xe1,
+	0x3d, 0x87, 0x43, 0xce, 0x0d, 0x80, 0xd4, 0x74, 0x57, 0xb7, 0x09, 0x17, 0x9e, 0x33, 0x1d, 0xd3,
+	0x61, 0x9f, 0x05, 0xfa, 0xc5, 0x67, 0x17, 0xfc, 0x10, 0x95, 0xfd, 0x05, 0x7f, 0xc0, 0x97, 0xb2,
+	0x3c, 0x7a, 0xbb, 0x3a, 0x41, 0x85, 0xc6, 0xea, 0x2e, 0xf2, 0xf4, 0xd5, 0x42, 0xc5, 0xb1, 0xb0,
+	0xbf, 0x9e, 0xfb, 0x51, 0x82, 0xd9, 0x12, 0x31, 0xef, 0xd4, 0x0c, 0xdd, 0x43, 0xb7, 0x98, 0x29,
+	0xf9, 0x0a, 0x24, 0xf5, 0xba, 0xb7, 0xef, 0xb8, 0x96, 0x77, 0xa4, 0x48, 0x4b, 0xd2, 0x4a, 0xb2,
+	0xa8, 0xfc, 0xf1, 0xcb, 0x85, 0x39, 0xae, 0x78, 0xd3, 0x30, 0x5c, 0x44, 0xc8, 0x8e, 0xe7, 0x5a,
+	0xd8, 0xd4, 0x9a, 0xa2, 0xf2, 0x36, 0x24, 0x7c, 0xb2, 0xca, 0xf8, 0x92, 0xb4, 0x92, 0x5a, 0x7b,
+	0x2d, 0xdf, 0x7f, 0x6b, 0xf3, 0xbe, 0xbd, 0x62, 0xfc, 0xd1, 0x93, 0xc5, 0x31, 0x8d, 0x63, 0x37,
+	0x66, 0xbe, 0x7a, 0xfe, 0xf0, 0x6c, 0x53, 0x6b, 0x6e, 0x01, 0x8e, 0xb7, 0x11, 0xd4, 0x10, 0xa9,
+	0x39, 0x98, 0xa0, 0xdc, 0xbf, 0x31, 0xc8, 0x94, 0x88, 0xb9, 0xe5, 0x22, 0xdd, 0x43, 0x9a, 0xaf,
+	0x54, 0x56, 0x60, 0xb2, 0x42, 0x27, 0x1c, 0xd7, 0xe7, 0xae, 0x05, 0x43, 0xf9, 0x14, 0x00, 0xb7,
+	0x5c, 0xb6, 0x0c, 0xc6, 0x31, 0xa9, 0x25, 0xf9, 0xcc, 0x0d, 0x43, 0x3e, 0x07, 0xc7, 0x2c, 0x6c,
+	0x79, 0x96, 0x5e, 0x2d, 0x13, 0xf4, 0x69, 0x1d, 0xe1, 0x0a, 0x72, 0x95, 0x14, 0x93, 0xca, 0xf0,
+	0x85, 0x9d, 0x60, 0x5e, 0xbe, 0x07, 0xb2, 0x6d, 0xe1, 0xa6, 0x60, 0x79, 0xd7, 0xc1, 0x86, 0x92,
+	0x61, 0x7e, 0x2f, 0xe4, 0x79, 0xa4, 0x68, 0xd0, 0xf3, 0x3c, 0xe8, 0xf9, 0x2d, 0xc7, 0xc2, 0xc5,
+	0x65, 0xea, 0xea, 0x3f, 0x4f, 0x16, 0x17, 0x8e, 0x74, 0xbb, 0xba, 0x91, 0xeb, 0x54, 0x91, 0xd3,
+	0x32, 0xb6, 0x85, 0x85, 0x9d, 0xa2, 0x83, 0x0d, 0x79, 0x0e, 0x26, 0xf4, 0xaa, 0xa5, 0x13, 0x25,
+	0xcd, 0xc8, 0xf8, 0x03, 0xf9, 0x3d, 0x98, 0x0a, 0x92, 0x4f, 0x99, 0x66, 0x76, 0x0b, 0x83, 0xe2,
+	0xcd, 0x43, 0x54, 0xe2, 0x30, 0x4d, 0x28, 0x90, 0x6f, 0x43, 0xba, 0x35, 0x35, 0x95, 0x19, 0xa6,
+	0xf0, 0xdc, 0x20, 0x85, 0xd7, 0x7c, 0xcc, 0x0d, 0xbc, 0xe7, 0xb0, 0x5d, 0x94, 0xb4, 0x94, 0xd9,
+	0x9c, 0x92, 0xaf, 0xc1, 0x64, 0xc3, 0x2e, 0x7b, 0x47, 0x35, 0xa4, 0xcc, 0x2e, 0x49, 0x2b, 0x33,
+	0x6b, 0xf9, 0x88, 0x0c, 0xf3, 0x77, 0x4b, 0xb7, 0x8f, 0x6a, 0x48, 0x4b, 0x34, 0x6c, 0xfa, 0xbb,
+	0x91, 0xa6, 0x39, 0x11, 0xec, 0xe3, 0xbb, 0xf1, 0xa9, 0x58, 0x26, 0x95, 0x53, 0x41, 0x69, 0xdf,
+	0x7b, 0x91, 0x18, 0x3f, 0xc5, 0xe0, 0x84, 0x48, 0x1a, 0xbe, 0x48, 0x19, 0xb9, 0xb6, 0xee, 0x59,
+	0x0e, 0xa6, 0x11, 0x75, 0x0e, 0x30, 0x0a, 0x32, 0xc4, 0x1f, 0x8c, 0x94, 0x1f, 0xb1, 0xa1, 0xf2,
+	0x63, 0x32, 0x4a, 0x7e, 0x48, 0xc3, 0xe6, 0xc7, 0x07, 0x2d, 0x99, 0x30, 0x31, 0x52, 0x26, 0xf0,
+	0xcd, 0xeb, 0x9d, 0x0f, 0x89, 0xff, 0x23, 0x1f, 0x36, 0x80, 0x6e, 0xa3, 0x1f, 0xec, 0xdc, 0xab,
+	0x70, 0xba, 0xcf, 0x0e, 0x89, 0x9d, 0xfc, 0x75, 0x1c, 0x66, 0x84, 0xdc, 0x8e, 0xa7, 0x7b, 0xa8,
+	0x4f, 0x81, 0x9f, 0x84, 0xe6, 0x76, 0x75, 0xee, 0xdf, 0x12, 0xa4, 0x88, 0xa7, 0xbb, 0xde, 0x75,
+	0x64, 0x99, 0xfb, 0x1e, 0xdb, 0xb9, 0xb8, 0xd6, 0x3a, 0x45, 0xf1, 0xb8, 0x6e, 0x17, 0xe9, 0xbd,
+	0x41, 0x94, 0x38, 0x5b, 0x6f, 0x4e, 0xc8, 0xf3, 0x90, 0xd8, 0xde, 0xbc, 0xa5, 0x7b, 0xfb, 0x2c,
+	0xc8, 0x49, 0x8d, 0x8f, 0xe4, 0xeb, 0x10, 0x2b, 0x6e, 0x13, 0xbe, 0xb7, 0x17, 0x07, 0x85, 0x88,
+	0x29, 0xdb, 0x16, 0x97, 0x52, 0x70, 0xfa, 0x51, 0x15, 0xb2, 0x0c, 0xf1, 0xaa, 0x4e, 0x3c, 0x65,
+	0x6a, 0x49, 0x5a, 0x99, 0xd2, 0xd8, 0xb7, 0x7c, 0x06, 0x32, 0x41, 0x52, 0xba, 0xa8, 0x61, 0x51,
+	0x5d, 0x4a, 0x92, 0x51, 0x9b, 0x75, 0x83, 0xac, 0xf7, 0xa7, 0x3b, 0xaa, 0x24, 0x91, 0x99, 0xcc,
+	0x29, 0x30, 0x1f, 0x0e, 0x9f, 0x88, 0xec, 0xb7, 0x12, 0xcc, 0x95, 0x88, 0x79, 0xdb, 0xd5, 0x31,
+	0xd9, 0x43, 0xee, 0x4d, 0xba, 0x2b, 0x64, 0xdf, 0xaa, 0xc9, 0xa7, 0x61, 0xba, 0x52, 0x77, 0x5d,
+	0x84, 0xbd, 0x72, 0x6b, 0x91, 0xa4, 0xf9, 0x24, 0x13, 0x94, 0x4f, 0x40, 0x12, 0xa3, 0x03, 0x2e,
+	0xe0, 0x87, 0x7a, 0x0a, 0xa3, 0x83, 0x9b, 0x5d, 0x0a, 0x29, 0xd6, 0xb6, 0x11, 0x1b, 0x32, 0xe5,
+	0x19, 0xb6, 0x91, 0xcb, 0xc2, 0xc9, 0x6e, 0x64, 0x04, 0xdb, 0x4f, 0x40, 0x2e, 0x11, 0x73, 0xb3,
+	0x52, 0x41, 0x35, 0xaf, 0x49, 0x35, 0xc4, 0x42, 0xea, 0xcb, 0xa2, 0x3d, 0x1d, 0xf8, 0x3d, 0x23,
+	0xe0, 0xb9, 0x93, 0xa0, 0x76, 0x5a, 0x10, 0xf6, 0x3f, 0x66, 0xab, 0x5b, 0x3a, 0xae, 0xa0, 0xaa,
+	0x58, 0x0d, 0xe8, 0x8e, 0x74, 0x9e, 0x84, 0xaa, 0xe1, 0x15, 0xc8, 0xf5, 0x56, 0x2f, 0x48, 0x34,
+	0xd8, 0x75, 0xb7, 0x53, 0xc7, 0x04, 0x79, 0xc1, 0x75, 0x37, 0xd2, 0x51, 0xb6, 0x0c, 0xe9, 0x3d,
+	0x0b, 0xeb, 0xd5, 0xf2, 0x7e, 0xa8, 0x16, 0xd8, 0x9c, 0x5f, 0x0b, 0x21, 0x76, 0xfe, 0x51, 0x1b,
+	0xb2, 0x2b, 0x38, 0x7d, 0x27, 0xc1, 0xcb, 0x74, 0xb1, 0xb2, 0x8f, 0x8c, 0x7a, 0x15, 0x6d, 0x6b,
+	0x3b, 0x77, 0x6a, 0xa6, 0xab, 0x1b, 0x88, 0xd6, 0x0b, 0xb1, 0xcc, 0x26, 0x35, 0x3e, 0x1a, 0xc4,
+	0x6d, 0x1e, 0x12, 0x21, 0x56, 0x7c, 0x24, 0x2f, 0x42, 0xca, 0x70, 0x49, 0xb9, 0x81, 0x5c, 0x56,
+	0x03, 0xb4, 0x3c, 0xa7, 0x35, 0x30, 0x5c, 0x72, 0xd7, 0x9f, 0xd9, 0x48, 0x51, 0xc6, 0xdc, 0x48,
+	0x6e, 0x11, 0x4e, 0x75, 0x65, 0x25, 0x78, 0xff, 0x2c, 0xb1, 0x2b, 0x62, 0x1b, 0x55, 0xaa, 0xba,
+	0x8b, 0x4a, 0xba, 0x85, 0x3d, 0x84, 0x69, 0xfc, 0x3f, 0xb4, 0xb0, 0xe1, 0x1c, 0x8c, 0xde, 0x46,
+	0x2c, 0x43, 0x9a, 0x9d, 0x29, 0xad, 0xb1, 0x8d, 0x85, 0xcf, 0x99, 0x53, 0x00, 0x08, 0x1b, 0x81,
+	0x40, 0x9c, 0x09, 0x24, 0x11, 0x36, 0x78, 0xe8, 0x43, 0x75, 0xcc, 0x0f, 0xca, 0x5e, 0x3c, 0x85,
+	0x3f, 0xbf, 0x49, 0x90, 0xa4, 0xf9, 0x6b, 0x18, 0x9b, 0x7d, 0x9b, 0x20, 0x19, 0xe2, 0x58, 0xb7,
+	0x11, 0xe7, 0xcd, 0xbe, 0x07, 0xd4, 0x2b, 0x3d, 0x38, 0x83, 0x2e, 0x3a, 0x88, 0x7c, 0x52, 0x6b,
+	0x9d, 0xa2, 0x49, 0x68, 0xd9, 0xba, 0x89, 0xf8, 0xc9, 0xe8, 0x0f, 0xe4, 0x0c, 0xc4, 0xea, 0x6e,
+	0x95, 0xdd, 0x1d, 0x49, 0x8d, 0x7e, 0x52, 0x39, 0xc7, 0x35, 0x90, 0xcb, 0x0e, 0xcb, 0x09, 0xcd,
+	0x1f, 0xb4, 0xf9, 0xfb, 0x12, 0x1c, 0x13, 0x7e, 0x08, 0xef, 0xfe, 0x92, 0x20, 0x2d, 0xce, 0xb1,
+	0xfe, 0x0e, 0xce, 0xc0, 0x38, 0xdf, 0x96, 0xb8, 0x36, 0x6e, 0x19, 0xc2, 0xe1, 0x58, 0x4f, 0x87,
+	0xe3, 0x03, 0x1c, 0x9e, 0xe8, 0xe3, 0x70, 0xa2, 0x8b, 0xc3, 0x93, 0x5d, 0x1c, 0x9e, 0xea, 0xed,
+	0xf0, 0x3c, 0xcc, 0xb5, 0xba, 0x26, 0x7c, 0x46, 0xcc, 0x65, 0x0d, 0xd9, 0x4e, 0x63, 0x48, 0x97,
+	0x07, 0x9c, 0xbf, 0xdd, 0xcc, 0x0b, 0x33, 0xc2, 0xfc, 0x3d, 0xd6, 0x77, 0x97, 0x74, 0xf7, 0xfe,
+	0xcd, 0x5d, 0xe2, 0x54, 0x91, 0xb8, 0xa6, 0x09, 0xbd, 0x27, 0xdb, 0x1e, 0x08, 0xad, 0xcf, 0x80,
+	0x65, 0x48, 0xb7, 0x14, 0x2a, 0x7d, 0x0c, 0xc4, 0x56, 0xa6, 0xb5, 0x54, 0xb3, 0x52, 0x3b, 0x7b,
+	0xfc, 0x65, 0x58, 0xec, 0x61, 0x2b, 0xa0, 0xb3, 0xf6, 0xfb, 0x0c, 0xc4, 0x4a, 0xc4, 0x94, 0x0f,
+	0x21, 0x1d, 0x7a, 0xac, 0x0c, 0x6c, 0x75, 0xda, 0x1e, 0x0f, 0xea, 0x1b, 0x43, 0x02, 0x02, 0x06,
+	0xf2, 0xe7, 0x30, 0x1d, 0x7e, 0x69, 0x5c, 0x8c, 0xa0, 0x29, 0x84, 0x50, 0xaf, 0x0e, 0x8b, 0x10,
+	0xc6, 0x7f, 0x90, 0x40, 0xe9, 0xd9, 0xce, 0xbe, 0x19, 0xd9, 0xa5, 0x4e, 0xb0, 0xba, 0xf5, 0x02,
+	0x60, 0x41, 0xaf, 0x0e, 0xa9, 0xd6, 0x16, 0x2d, 0x1f, 0x59, 0x27, 0x93, 0x57, 0xaf, 0x0c, 0x27,
+	0x2f, 0xcc, 0x7e, 0x2d, 0xc1, 0xb1, 0xce, 0x06, 0x66, 0x3d, 0x82, 0xb6, 0x0e, 0x94, 0xfa, 0xd6,
+	0x28, 0x28, 0xc1, 0xe4, 0x4b, 0x09, 0x66, 0xdb, 0xbb, 0x93, 0xb5, 0x08, 0x1a, 0xdb, 0x30, 0xea,
+	0xc6, 0xf0, 0x18, 0xc1, 0xe1, 0x7b, 0x09, 0x8e, 0xf7, 0xea, 0x50, 0xa2, 0xe8, 0xed, 0x81, 0x55,
+	0x8b, 0xa3, 0x63, 0x5b, 0x8b, 0x27, 0xdc, 0xb7, 0x44, 0x29, 0x9e, 0x10, 0x42, 0xbd, 0x3a, 0x2c,
+	0x42, 0x18, 0xff, 0x46, 0x02, 0xb9, 0x4b, 0x83, 0x72, 0x39, 0x8a, 0xc2, 0x0e, 0x98, 0xfa, 0xf6,
+	0x48, 0xb0, 0x50, 0x25, 0xf7, 0xec, 0x3a, 0xa2, 0x54, 0x72, 0x2f, 0xb0, 0xba, 0xf5, 0x02, 0x60,
+	0x41, 0x6f, 0x0f, 0x12, 0xbc, 0x87, 0x38, 0x13, 0x25, 0x15, 0x99, 0xa8, 0xba, 0x1a, 0x59, 0x54,
+	0xd8, 0x71, 0x20, 0xd9, 0xbc, 0xcd, 0xcf, 0x47, 0xae, 0x7f, 0x6a, 0x6d, 0x7d, 0x18, 0xe9, 0x56,
+	0x83, 0xcd, 0xbb, 0x34, 0x8a, 0x41, 0x21, 0xad, 0xae, 0x0f, 0x23, 0x2d, 0x0c, 0x3e, 0xa0, 0x0f,
+	0xac, 0x6e, 0xd7, 0x67, 0x94, 0x1b, 0xa8, 0x1b, 0x50, 0x7d, 0x67, 0x44, 0x60, 0x40, 0x49, 0x9d,
+	0xf8, 0xe2, 0xf9, 0xc3, 0xb3, 0x52, 0xf1, 0xfd, 0x47, 0x4f, 0xb3, 0xd2, 0xe3, 0xa7, 0x59, 0xe9,
+	0xef, 0xa7, 0x59, 0xe9, 0xc1, 0xb3, 0xec, 0xd8, 0xe3, 0x67, 0xd9, 0xb1, 0x3f, 0x9f, 0x65, 0xc7,
+	0x3e, 0x5a, 0x37, 0x2d, 0x6f, 0xbf, 0xbe, 0x9b, 0xaf, 0x38, 0x76, 0xa1, 0xc7, 0xff, 0x92, 0x8d,
+	0x4b, 0x85, 0xc3, 0xe6, 0xbf, 0xb8, 0x47, 0x35, 0x44, 0x76, 0x13, 0xec, 0xbf, 0xc4, 0x4b, 0xff,
+	0x0d, 0x00, 0x06, 0x1c, 0xef, 0x13, 0xf4, 0x15, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams is used for updating module params.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	CreateRollapp(ctx context.Context, in *MsgCreateRollapp, opts ...grpc.CallOption) (*MsgCreateRollappResponse, error)
+	UpdateRollappInformation(ctx context.Context, in *MsgUpdateRollappInformation, opts ...grpc.CallOption) (*MsgUpdateRollappInformationResponse, error)
+	UpdateState(ctx context.Context, in *MsgUpdateState, opts ...grpc.CallOption) (*MsgUpdateStateResponse, error)
+	TransferOwnership(ctx context.Context, in *MsgTransferOwnership, opts ...grpc.CallOption) (*MsgTransferOwnershipResponse, error)
+	AcceptOwnership(ctx context.Context, in *MsgAcceptOwnership, opts ...grpc.CallOption) (*MsgAcceptOwnershipResponse, error)
+	CancelOwnershipTransfer(ctx context.Context, in *MsgCancelOwnershipTransfer, opts ...grpc.CallOption) (*MsgCancelOwnershipTransferResponse, error)
+	SunsetRollapp(ctx context.Context, in *MsgSunsetRollapp, opts ...grpc.CallOption) (*MsgSunsetRollappResponse, error)
+	ScheduleDRSUpgrade(ctx context.Context, in *MsgScheduleDRSUpgrade, opts ...grpc.CallOption) (*MsgScheduleDRSUpgradeResponse, error)
+	DeclareMaintenanceWindow(ctx context.Context, in *MsgDeclareMaintenanceWindow, opts ...grpc.CallOption) (*MsgDeclareMaintenanceWindowResponse, error)
+	AddApp(ctx context.Context, in *MsgAddApp, opts ...grpc.CallOption) (*MsgAddAppResponse, error)
+	UpdateApp(ctx context.Context, in *MsgUpdateApp, opts ...grpc.CallOption) (*MsgUpdateAppResponse, error)
+	RemoveApp(ctx context.Context, in *MsgRemoveApp, opts ...grpc.CallOption) (*MsgRemoveAppResponse, error)
+	MarkObsoleteRollapps(ctx context.Context, in *MsgMarkObsoleteRollapps, opts ...grpc.CallOption) (*MsgMarkObsoleteRollappsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.InitialSequencer) > 0 {
-		i -= len(m.InitialSequencer)
-		copy(dAtA[i:], m.InitialSequencer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InitialSequencer)))
-		i--
-		dAtA[i] = 0x5a
+	return out, nil
+}
+
+func (c *msgClient) CreateRollapp(ctx context.Context, in *MsgCreateRollapp, opts ...grpc.CallOption) (*MsgCreateRollappResponse, error) {
+	out := new(MsgCreateRollappResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/CreateRollapp", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *msgClient) UpdateRollappInformation(ctx context.Context, in *MsgUpdateRollappInformation, opts ...grpc.CallOption) (*MsgUpdateRollappInformationResponse, error) {
+	out := new(MsgUpdateRollappInformationResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/UpdateRollappInformation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) UpdateState(ctx context.Context, in *MsgUpdateState, opts ...grpc.CallOption) (*MsgUpdateStateResponse, error) {
+	out := new(MsgUpdateStateResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/UpdateState", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCreateRollappResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) TransferOwnership(ctx context.Context, in *MsgTransferOwnership, opts ...grpc.CallOption) (*MsgTransferOwnershipResponse, error) {
+	out := new(MsgTransferOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/TransferOwnership", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreateRollappResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AcceptOwnership(ctx context.Context, in *MsgAcceptOwnership, opts ...grpc.CallOption) (*MsgAcceptOwnershipResponse, error) {
+	out := new(MsgAcceptOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/AcceptOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateRollappResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) CancelOwnershipTransfer(ctx context.Context, in *MsgCancelOwnershipTransfer, opts ...grpc.CallOption) (*MsgCancelOwnershipTransferResponse, error) {
+	out := new(MsgCancelOwnershipTransferResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/CancelOwnershipTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateRollappInformation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SunsetRollapp(ctx context.Context, in *MsgSunsetRollapp, opts ...grpc.CallOption) (*MsgSunsetRollappResponse, error) {
+	out := new(MsgSunsetRollappResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/SunsetRollapp", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateRollappInformation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ScheduleDRSUpgrade(ctx context.Context, in *MsgScheduleDRSUpgrade, opts ...grpc.CallOption) (*MsgScheduleDRSUpgradeResponse, error) {
+	out := new(MsgScheduleDRSUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/ScheduleDRSUpgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateRollappInformation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MinSequencerBond != nil {
-		{
-			size, err := m.MinSequencerBond.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.GenesisInfo != nil {
-		{
-			size, err := m.GenesisInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.InitialSequencer) > 0 {
-		i -= len(m.InitialSequencer)
-		copy(dAtA[i:], m.InitialSequencer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InitialSequencer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) DeclareMaintenanceWindow(ctx context.Context, in *MsgDeclareMaintenanceWindow, opts ...grpc.CallOption) (*MsgDeclareMaintenanceWindowResponse, error) {
+	out := new(MsgDeclareMaintenanceWindowResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/DeclareMaintenanceWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateRollappInformationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddApp(ctx context.Context, in *MsgAddApp, opts ...grpc.CallOption) (*MsgAddAppResponse, error) {
+	out := new(MsgAddAppResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/AddApp", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateRollappInformationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateApp(ctx context.Context, in *MsgUpdateApp, opts ...grpc.CallOption) (*MsgUpdateAppResponse, error) {
+	out := new(MsgUpdateAppResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/UpdateApp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateRollappInformationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) RemoveApp(ctx context.Context, in *MsgRemoveApp, opts ...grpc.CallOption) (*MsgRemoveAppResponse, error) {
+	out := new(MsgRemoveAppResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/RemoveApp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MarkObsoleteRollapps(ctx context.Context, in *MsgMarkObsoleteRollapps, opts ...grpc.CallOption) (*MsgMarkObsoleteRollappsResponse, error) {
+	out := new(MsgMarkObsoleteRollappsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/MarkObsoleteRollapps", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams is used for updating module params.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	CreateRollapp(context.Context, *MsgCreateRollapp) (*MsgCreateRollappResponse, error)
+	UpdateRollappInformation(context.Context, *MsgUpdateRollappInformation) (*MsgUpdateRollappInformationResponse, error)
+	UpdateState(context.Context, *MsgUpdateState) (*MsgUpdateStateResponse, error)
+	TransferOwnership(context.Context, *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error)
+	AcceptOwnership(context.Context, *MsgAcceptOwnership) (*MsgAcceptOwnershipResponse, error)
+	CancelOwnershipTransfer(context.Context, *MsgCancelOwnershipTransfer) (*MsgCancelOwnershipTransferResponse, error)
+	SunsetRollapp(context.Context, *MsgSunsetRollapp) (*MsgSunsetRollappResponse, error)
+	ScheduleDRSUpgrade(context.Context, *MsgScheduleDRSUpgrade) (*MsgScheduleDRSUpgradeResponse, error)
+	DeclareMaintenanceWindow(context.Context, *MsgDeclareMaintenanceWindow) (*MsgDeclareMaintenanceWindowResponse, error)
+	AddApp(context.Context, *MsgAddApp) (*MsgAddAppResponse, error)
+	UpdateApp(context.Context, *MsgUpdateApp) (*MsgUpdateAppResponse, error)
+	RemoveApp(context.Context, *MsgRemoveApp) (*MsgRemoveAppResponse, error)
+	MarkObsoleteRollapps(context.Context, *MsgMarkObsoleteRollapps) (*MsgMarkObsoleteRollappsResponse, error)
 }
 
-func (m *MsgUpdateState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RollappRevision != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RollappRevision))
-		i--
-		dAtA[i] = 0x48
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) CreateRollapp(ctx context.Context, req *MsgCreateRollapp) (*MsgCreateRollappResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRollapp not implemented")
+}
+func (*UnimplementedMsgServer) UpdateRollappInformation(ctx context.Context, req *MsgUpdateRollappInformation) (*MsgUpdateRollappInformationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRollappInformation not implemented")
+}
+func (*UnimplementedMsgServer) UpdateState(ctx context.Context, req *MsgUpdateState) (*MsgUpdateStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateState not implemented")
+}
+func (*UnimplementedMsgServer) TransferOwnership(ctx context.Context, req *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
+}
+func (*UnimplementedMsgServer) AcceptOwnership(ctx context.Context, req *MsgAcceptOwnership) (*MsgAcceptOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptOwnership not implemented")
+}
+func (*UnimplementedMsgServer) CancelOwnershipTransfer(ctx context.Context, req *MsgCancelOwnershipTransfer) (*MsgCancelOwnershipTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOwnershipTransfer not implemented")
+}
+func (*UnimplementedMsgServer) SunsetRollapp(ctx context.Context, req *MsgSunsetRollapp) (*MsgSunsetRollappResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SunsetRollapp not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleDRSUpgrade(ctx context.Context, req *MsgScheduleDRSUpgrade) (*MsgScheduleDRSUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleDRSUpgrade not implemented")
+}
+func (*UnimplementedMsgServer) DeclareMaintenanceWindow(ctx context.Context, req *MsgDeclareMaintenanceWindow) (*MsgDeclareMaintenanceWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclareMaintenanceWindow not implemented")
+}
+func (*UnimplementedMsgServer) AddApp(ctx context.Context, req *MsgAddApp) (*MsgAddAppResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddApp not implemented")
+}
+func (*UnimplementedMsgServer) UpdateApp(ctx context.Context, req *MsgUpdateApp) (*MsgUpdateAppResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateApp not implemented")
+}
+func (*UnimplementedMsgServer) RemoveApp(ctx context.Context, req *MsgRemoveApp) (*MsgRemoveAppResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveApp not implemented")
+}
+func (*UnimplementedMsgServer) MarkObsoleteRollapps(ctx context.Context, req *MsgMarkObsoleteRollapps) (*MsgMarkObsoleteRollappsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkObsoleteRollapps not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Last {
-		i--
-		if m.Last {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	{
-		size, err := m.BDs.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/UpdateParams",
 	}
-	i--
-	dAtA[i] = 0x3a
-	if len(m.DAPath) > 0 {
-		i -= len(m.DAPath)
-		copy(dAtA[i:], m.DAPath)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DAPath)))
-		i--
-		dAtA[i] = 0x2a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
 	}
-	if m.NumBlocks != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.NumBlocks))
-		i--
-		dAtA[i] = 0x20
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateRollapp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateRollapp)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.StartHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(MsgServer).CreateRollapp(ctx, in)
 	}
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/CreateRollapp",
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateRollapp(ctx, req.(*MsgCreateRollapp))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateRollappInformation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRollappInformation)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateStateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRollappInformation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/UpdateRollappInformation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRollappInformation(ctx, req.(*MsgUpdateRollappInformation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgTransferOwnership) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateState)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgTransferOwnership) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgTransferOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateState(ctx, in)
 	}
-	if len(m.NewOwner) > 0 {
-		i -= len(m.NewOwner)
-		copy(dAtA[i:], m.NewOwner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/UpdateState",
 	}
-	if len(m.CurrentOwner) > 0 {
-		i -= len(m.CurrentOwner)
-		copy(dAtA[i:], m.CurrentOwner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CurrentOwner)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateState(ctx, req.(*MsgUpdateState))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgTransferOwnershipResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferOwnership)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgTransferOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgTransferOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).TransferOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/TransferOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferOwnership(ctx, req.(*MsgTransferOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddApp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_AcceptOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptOwnership)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/AcceptOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptOwnership(ctx, req.(*MsgAcceptOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddApp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_CancelOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelOwnershipTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/CancelOwnershipTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelOwnershipTransfer(ctx, req.(*MsgCancelOwnershipTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddApp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Order != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Order))
-		i--
-		dAtA[i] = 0x38
+func _Msg_SunsetRollapp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSunsetRollapp)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Url) > 0 {
-		i -= len(m.Url)
-		copy(dAtA[i:], m.Url)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
-		i--
-		dAtA[i] = 0x32
+	if interceptor == nil {
+		return srv.(MsgServer).SunsetRollapp(ctx, in)
 	}
-	if len(m.Image) > 0 {
-		i -= len(m.Image)
-		copy(dAtA[i:], m.Image)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Image)))
-		i--
-		dAtA[i] = 0x2a
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/SunsetRollapp",
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x22
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SunsetRollapp(ctx, req.(*MsgSunsetRollapp))
 	}
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0x1a
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleDRSUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleDRSUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleDRSUpgrade(ctx, in)
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/ScheduleDRSUpgrade",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleDRSUpgrade(ctx, req.(*MsgScheduleDRSUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddAppResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DeclareMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeclareMaintenanceWindow)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).DeclareMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/DeclareMaintenanceWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeclareMaintenanceWindow(ctx, req.(*MsgDeclareMaintenanceWindow))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddAppResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_AddApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddApp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/AddApp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddApp(ctx, req.(*MsgAddApp))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddAppResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_UpdateApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateApp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/UpdateApp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateApp(ctx, req.(*MsgUpdateApp))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateApp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RemoveApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveApp)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/RemoveApp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveApp(ctx, req.(*MsgRemoveApp))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateApp) MarshalTo(dAtA []byte) (int, error) {
+func _Msg_MarkObsoleteRollapps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMarkObsoleteRollapps)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MarkObsoleteRollapps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/MarkObsoleteRollapps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MarkObsoleteRollapps(ctx, req.(*MsgMarkObsoleteRollapps))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dymensionxyz.dymension.rollapp.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "CreateRollapp",
+			Handler:    _Msg_CreateRollapp_Handler,
+		},
+		{
+			MethodName: "UpdateRollappInformation",
+			Handler:    _Msg_UpdateRollappInformation_Handler,
+		},
+		{
+			MethodName: "UpdateState",
+			Handler:    _Msg_UpdateState_Handler,
+		},
+		{
+			MethodName: "TransferOwnership",
+			Handler:    _Msg_TransferOwnership_Handler,
+		},
+		{
+			MethodName: "AcceptOwnership",
+			Handler:    _Msg_AcceptOwnership_Handler,
+		},
+		{
+			MethodName: "CancelOwnershipTransfer",
+			Handler:    _Msg_CancelOwnershipTransfer_Handler,
+		},
+		{
+			MethodName: "SunsetRollapp",
+			Handler:    _Msg_SunsetRollapp_Handler,
+		},
+		{
+			MethodName: "ScheduleDRSUpgrade",
+			Handler:    _Msg_ScheduleDRSUpgrade_Handler,
+		},
+		{
+			MethodName: "DeclareMaintenanceWindow",
+			Handler:    _Msg_DeclareMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "AddApp",
+			Handler:    _Msg_AddApp_Handler,
+		},
+		{
+			MethodName: "UpdateApp",
+			Handler:    _Msg_UpdateApp_Handler,
+		},
+		{
+			MethodName: "RemoveApp",
+			Handler:    _Msg_RemoveApp_Handler,
+		},
+		{
+			MethodName: "MarkObsoleteRollapps",
+			Handler:    _Msg_MarkObsoleteRollapps_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dymensionxyz/dymension/rollapp/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateApp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Order != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Order))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.Url) > 0 {
-		i -= len(m.Url)
-		copy(dAtA[i:], m.Url)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Image) > 0 {
-		i -= len(m.Image)
-		copy(dAtA[i:], m.Image)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Image)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateAppResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2228,12 +2367,12 @@ func (m *MsgUpdateAppResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateAppResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateAppResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2241,7 +2380,7 @@ func (m *MsgUpdateAppResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveApp) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateRollapp) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2251,27 +2390,77 @@ func (m *MsgRemoveApp) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveApp) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateRollapp) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveApp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateRollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.MinSequencerBond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.VmType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VmType))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.GenesisInfo != nil {
+		{
+			size, err := m.GenesisInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Alias) > 0 {
+		i -= len(m.Alias)
+		copy(dAtA[i:], m.Alias)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Alias)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.InitialSequencer) > 0 {
+		i -= len(m.InitialSequencer)
+		copy(dAtA[i:], m.InitialSequencer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InitialSequencer)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if len(m.RollappId) > 0 {
 		i -= len(m.RollappId)
 		copy(dAtA[i:], m.RollappId)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
 		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
@@ -2283,7 +2472,7 @@ func (m *MsgRemoveApp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveAppResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateRollappResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2293,12 +2482,12 @@ func (m *MsgRemoveAppResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveAppResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateRollappResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveAppResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateRollappResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2306,7 +2495,7 @@ func (m *MsgRemoveAppResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgMarkObsoleteRollapps) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateRollappInformation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2316,45 +2505,77 @@ func (m *MsgMarkObsoleteRollapps) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgMarkObsoleteRollapps) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateRollappInformation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMarkObsoleteRollapps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateRollappInformation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DrsVersions) > 0 {
-		dAtA10 := make([]byte, len(m.DrsVersions)*10)
-		var j9 int
-		for _, num := range m.DrsVersions {
-			for num >= 1<<7 {
-				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j9++
+	if m.MinSequencerBond != nil {
+		{
+			size, err := m.MinSequencerBond.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			dAtA10[j9] = uint8(num)
-			j9++
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-		i -= j9
-		copy(dAtA[i:], dAtA10[:j9])
-		i = encodeVarintTx(dAtA, i, uint64(j9))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x3a
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if m.GenesisInfo != nil {
+		{
+			size, err := m.GenesisInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.InitialSequencer) > 0 {
+		i -= len(m.InitialSequencer)
+		copy(dAtA[i:], m.InitialSequencer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InitialSequencer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgMarkObsoleteRollappsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateRollappInformationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2364,12 +2585,12 @@ func (m *MsgMarkObsoleteRollappsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgMarkObsoleteRollappsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateRollappInformationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMarkObsoleteRollappsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateRollappInformationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2377,362 +2598,2149 @@ func (m *MsgMarkObsoleteRollappsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgUpdateState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateRollapp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.RollappRevision != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RollappRevision))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Last {
+		i--
+		if m.Last {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.InitialSequencer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.BDs.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.Alias)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.DAPath) > 0 {
+		i -= len(m.DAPath)
+		copy(dAtA[i:], m.DAPath)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DAPath)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.NumBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NumBlocks))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.GenesisInfo != nil {
-		l = m.GenesisInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.StartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.VmType != 0 {
-		n += 1 + sovTx(uint64(m.VmType))
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.MinSequencerBond.Size()
-	n += 2 + l + sovTx(uint64(l))
-	return n
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateRollappResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateRollappInformation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.InitialSequencer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.GenesisInfo != nil {
-		l = m.GenesisInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.MinSequencerBond != nil {
-		l = m.MinSequencerBond.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgUpdateStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateRollappInformationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgTransferOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.StartHeight != 0 {
-		n += 1 + sovTx(uint64(m.StartHeight))
-	}
-	if m.NumBlocks != 0 {
-		n += 1 + sovTx(uint64(m.NumBlocks))
-	}
-	l = len(m.DAPath)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.BDs.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Last {
-		n += 2
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.RollappRevision != 0 {
-		n += 1 + sovTx(uint64(m.RollappRevision))
+	if len(m.CurrentOwner) > 0 {
+		i -= len(m.CurrentOwner)
+		copy(dAtA[i:], m.CurrentOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CurrentOwner)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgTransferOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgTransferOwnership) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.CurrentOwner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.NewOwner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgTransferOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgTransferOwnershipResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgTransferOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddApp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Image)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Url)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Order != 0 {
-		n += 1 + sovTx(uint64(m.Order))
+func (m *MsgAcceptOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddAppResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgAcceptOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateApp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAcceptOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Image)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Url)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Order != 0 {
-		n += 1 + sovTx(uint64(m.Order))
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateAppResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAcceptOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveApp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelOwnershipTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelOwnershipTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOwnershipTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveAppResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelOwnershipTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgMarkObsoleteRollapps) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCancelOwnershipTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOwnershipTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSunsetRollapp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSunsetRollapp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSunsetRollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FinalHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FinalHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSunsetRollappResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSunsetRollappResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSunsetRollappResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleDRSUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleDRSUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleDRSUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DrsVersion != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DrsVersion))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleDRSUpgradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleDRSUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleDRSUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeclareMaintenanceWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeclareMaintenanceWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeclareMaintenanceWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeclareMaintenanceWindowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeclareMaintenanceWindowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeclareMaintenanceWindowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddApp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddApp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddApp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Order != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Order))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Image) > 0 {
+		i -= len(m.Image)
+		copy(dAtA[i:], m.Image)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Image)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAppResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAppResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAppResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateApp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateApp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateApp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Order != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Order))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Image) > 0 {
+		i -= len(m.Image)
+		copy(dAtA[i:], m.Image)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Image)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAppResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAppResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAppResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveApp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveApp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveApp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAppResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAppResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAppResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMarkObsoleteRollapps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarkObsoleteRollapps) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarkObsoleteRollapps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DrsVersions) > 0 {
+		dAtA10 := make([]byte, len(m.DrsVersions)*10)
+		var j9 int
+		for _, num := range m.DrsVersions {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintTx(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMarkObsoleteRollappsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarkObsoleteRollappsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarkObsoleteRollappsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateRollapp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InitialSequencer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Alias)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GenesisInfo != nil {
+		l = m.GenesisInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.VmType != 0 {
+		n += 1 + sovTx(uint64(m.VmType))
+	}
+	l = m.MinSequencerBond.Size()
+	n += 2 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateRollappResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateRollappInformation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InitialSequencer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GenesisInfo != nil {
+		l = m.GenesisInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MinSequencerBond != nil {
+		l = m.MinSequencerBond.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateRollappInformationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	if m.NumBlocks != 0 {
+		n += 1 + sovTx(uint64(m.NumBlocks))
+	}
+	l = len(m.DAPath)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.BDs.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Last {
+		n += 2
+	}
+	if m.RollappRevision != 0 {
+		n += 1 + sovTx(uint64(m.RollappRevision))
+	}
+	return n
+}
+
+func (m *MsgUpdateStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrentOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelOwnershipTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelOwnershipTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSunsetRollapp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FinalHeight != 0 {
+		n += 1 + sovTx(uint64(m.FinalHeight))
+	}
+	return n
+}
+
+func (m *MsgSunsetRollappResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgScheduleDRSUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if m.DrsVersion != 0 {
+		n += 1 + sovTx(uint64(m.DrsVersion))
+	}
+	return n
+}
+
+func (m *MsgScheduleDRSUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeclareMaintenanceWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovTx(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *MsgDeclareMaintenanceWindowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddApp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Image)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Order != 0 {
+		n += 1 + sovTx(uint64(m.Order))
+	}
+	return n
+}
+
+func (m *MsgAddAppResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateApp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Image)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if len(m.DrsVersions) > 0 {
-		l = 0
-		for _, e := range m.DrsVersions {
-			l += sovTx(uint64(e))
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Order != 0 {
+		n += 1 + sovTx(uint64(m.Order))
+	}
+	return n
+}
+
+func (m *MsgUpdateAppResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveApp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveAppResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMarkObsoleteRollapps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DrsVersions) > 0 {
+		l = 0
+		for _, e := range m.DrsVersions {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgMarkObsoleteRollappsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateRollapp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRollapp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRollapp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialSequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialSequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Alias = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &RollappMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GenesisInfo == nil {
+				m.GenesisInfo = &GenesisInfo{}
+			}
+			if err := m.GenesisInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VmType", wireType)
+			}
+			m.VmType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VmType |= Rollapp_VMType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSequencerBond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSequencerBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateRollappResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRollappResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRollappResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRollappInformation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRollappInformation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRollappInformation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialSequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialSequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &RollappMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GenesisInfo == nil {
+				m.GenesisInfo = &GenesisInfo{}
+			}
+			if err := m.GenesisInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSequencerBond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinSequencerBond == nil {
+				m.MinSequencerBond = &types.Coin{}
+			}
+			if err := m.MinSequencerBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRollappInformationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRollappInformationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRollappInformationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		n += 1 + sovTx(uint64(l)) + l
 	}
-	return n
-}
 
-func (m *MsgMarkObsoleteRollappsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2755,15 +4763,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2791,11 +4799,113 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBlocks", wireType)
+			}
+			m.NumBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DAPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DAPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BDs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2822,10 +4932,49 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Last", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Last = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappRevision", wireType)
+			}
+			m.RollappRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RollappRevision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2847,7 +4996,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2870,10 +5019,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2897,7 +5046,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateRollapp) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2920,79 +5069,15 @@ func (m *MsgCreateRollapp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateRollapp: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferOwnership: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateRollapp: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
+			return fmt.Errorf("proto: MsgTransferOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialSequencer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3020,11 +5105,11 @@ func (m *MsgCreateRollapp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitialSequencer = string(dAtA[iNdEx:postIndex])
+			m.CurrentOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3052,104 +5137,13 @@ func (m *MsgCreateRollapp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Alias = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Metadata == nil {
-				m.Metadata = &RollappMetadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.GenesisInfo == nil {
-				m.GenesisInfo = &GenesisInfo{}
-			}
-			if err := m.GenesisInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmType", wireType)
-			}
-			m.VmType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VmType |= Rollapp_VMType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 16:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSequencerBond", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3159,24 +5153,23 @@ func (m *MsgCreateRollapp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinSequencerBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3199,7 +5192,7 @@ func (m *MsgCreateRollapp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateRollappResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3222,10 +5215,10 @@ func (m *MsgCreateRollappResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateRollappResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferOwnershipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateRollappResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3249,7 +5242,7 @@ func (m *MsgCreateRollappResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRollappInformation) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptOwnership) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3272,15 +5265,15 @@ func (m *MsgUpdateRollappInformation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRollappInformation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptOwnership: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRollappInformation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3308,7 +5301,7 @@ func (m *MsgUpdateRollappInformation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3342,79 +5335,111 @@ func (m *MsgUpdateRollappInformation) Unmarshal(dAtA []byte) error {
 			}
 			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialSequencer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitialSequencer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptOwnershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptOwnershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &RollappMetadata{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelOwnershipTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOwnershipTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOwnershipTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3424,33 +5449,29 @@ func (m *MsgUpdateRollappInformation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GenesisInfo == nil {
-				m.GenesisInfo = &GenesisInfo{}
-			}
-			if err := m.GenesisInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSequencerBond", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3460,27 +5481,23 @@ func (m *MsgUpdateRollappInformation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MinSequencerBond == nil {
-				m.MinSequencerBond = &types.Coin{}
-			}
-			if err := m.MinSequencerBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3503,7 +5520,7 @@ func (m *MsgUpdateRollappInformation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRollappInformationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelOwnershipTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3526,10 +5543,10 @@ func (m *MsgUpdateRollappInformationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRollappInformationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelOwnershipTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRollappInformationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelOwnershipTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3553,7 +5570,7 @@ func (m *MsgUpdateRollappInformationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
+func (m *MsgSunsetRollapp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3576,15 +5593,15 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateState: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSunsetRollapp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSunsetRollapp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3612,7 +5629,7 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3648,9 +5665,9 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalHeight", wireType)
 			}
-			m.StartHeight = 0
+			m.FinalHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3660,33 +5677,114 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= uint64(b&0x7F) << shift
+				m.FinalHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumBlocks", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.NumBlocks = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumBlocks |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 5:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSunsetRollappResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSunsetRollappResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSunsetRollappResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleDRSUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleDRSUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleDRSUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DAPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3714,13 +5812,13 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DAPath = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BDs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3730,30 +5828,29 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Last", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var v int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3763,17 +5860,16 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Last = bool(v != 0)
-		case 9:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappRevision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DrsVersion", wireType)
 			}
-			m.RollappRevision = 0
+			m.DrsVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3783,7 +5879,7 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RollappRevision |= uint64(b&0x7F) << shift
+				m.DrsVersion |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3809,7 +5905,7 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateStateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleDRSUpgradeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3832,10 +5928,10 @@ func (m *MsgUpdateStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleDRSUpgradeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleDRSUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3859,7 +5955,7 @@ func (m *MsgUpdateStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
+func (m *MsgDeclareMaintenanceWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3882,15 +5978,15 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferOwnership: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeclareMaintenanceWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeclareMaintenanceWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3918,11 +6014,11 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentOwner = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3950,13 +6046,13 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			var stringLen uint64
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3966,24 +6062,30 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4005,7 +6107,7 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeclareMaintenanceWindowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4028,10 +6130,10 @@ func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferOwnershipResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeclareMaintenanceWindowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeclareMaintenanceWindowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
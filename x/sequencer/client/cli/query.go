@@ -27,6 +27,13 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdGetProposerByRollapp())
 	cmd.AddCommand(CmdGetNextProposerByRollapp())
 	cmd.AddCommand(CmdGetAllProposers())
+	cmd.AddCommand(CmdShowDelegations())
+	cmd.AddCommand(CmdShowUndelegations())
+	cmd.AddCommand(CmdShowUnbondings())
+	cmd.AddCommand(CmdShowKeyRotations())
+	cmd.AddCommand(CmdShowReputation())
+	cmd.AddCommand(CmdShowLatestSnapshot())
+	cmd.AddCommand(CmdShowProposerSelection())
 
 	return cmd
 }
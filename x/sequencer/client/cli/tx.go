@@ -35,6 +35,12 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdDecreaseBond())
 	cmd.AddCommand(CmdKickProposer())
 	cmd.AddCommand(CmdUpdateOptInStatus())
+	cmd.AddCommand(CmdDelegate())
+	cmd.AddCommand(CmdUndelegate())
+	cmd.AddCommand(CmdUpdateCommission())
+	cmd.AddCommand(CmdDistributeRewards())
+	cmd.AddCommand(CmdUpdateProposerSelection())
+	cmd.AddCommand(CmdRotateSequencerKey())
 
 	return cmd
 }
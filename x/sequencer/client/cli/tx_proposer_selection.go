@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func CmdUpdateProposerSelection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-proposer-selection [rollapp-id] [algo]",
+		Short:   "Set the proposer selection algorithm of a rollapp (rollapp owner only)",
+		Example: "update-proposer-selection rollapp_1234-1 PROPOSER_SELECTION_ROUND_ROBIN --from owner",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			algo, ok := types.ProposerSelectionAlgo_value[strings.ToUpper(args[1])]
+			if !ok {
+				return fmt.Errorf("unknown proposer selection algo: %s", args[1])
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateProposerSelection(clientCtx.GetFromAddress().String(), args[0], types.ProposerSelectionAlgo(algo))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,6 +1,7 @@
 package sequencer
 
 import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dymensionxyz/dymension/v3/x/sequencer/keeper"
 	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
@@ -14,6 +15,10 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState types.GenesisState)
 		if err := k.SetSequencerByDymintAddr(ctx, elem.MustProposerAddr(), elem.Address); err != nil {
 			panic(err)
 		}
+		// snapshot entries are derived from the metadata
+		if err := k.IndexSnapshots(ctx, elem, nil); err != nil {
+			panic(err)
+		}
 	}
 
 	for _, s := range genState.NoticeQueue {
@@ -27,6 +32,52 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.GenesisSuccessors {
 		k.SetSuccessor(ctx, elem.RollappId, elem.Address)
 	}
+
+	for _, elem := range genState.Delegations {
+		if err := k.SetDelegation(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	for _, elem := range genState.Undelegations {
+		if err := k.SetUndelegation(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	for _, elem := range genState.ProposerSelections {
+		if err := k.SetProposerSelection(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	for _, elem := range genState.Unbondings {
+		if err := k.SetUnbonding(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	for _, elem := range genState.KeyRotations {
+		if err := k.SetKeyRotation(ctx, elem); err != nil {
+			panic(err)
+		}
+		// both keys must be attributed to the sequencer
+		for _, pk := range []*codectypes.Any{elem.PreviousDymintPubKey, elem.DymintPubKey} {
+			pkAddr, err := types.PubKeyAddr(pk)
+			if err != nil {
+				panic(err)
+			}
+			if err := k.SetSequencerByDymintAddr(ctx, pkAddr, elem.Sequencer); err != nil {
+				panic(err)
+			}
+		}
+	}
+	for _, elem := range genState.Reputations {
+		if err := k.SetReputation(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	for _, elem := range genState.RewardPools {
+		if err := k.SetRewardPool(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
 }
 
 func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
@@ -58,5 +109,47 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 		genesis.NoticeQueue = append(genesis.NoticeQueue, seq.Address)
 	}
 
+	delegations, err := k.AllDelegations(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.Delegations = delegations
+
+	undelegations, err := k.AllUndelegations(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.Undelegations = undelegations
+
+	selections, err := k.AllProposerSelections(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.ProposerSelections = selections
+
+	unbondings, err := k.AllUnbondings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.Unbondings = unbondings
+
+	rotations, err := k.AllKeyRotations(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.KeyRotations = rotations
+
+	reputations, err := k.AllReputations(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.Reputations = reputations
+
+	pools, err := k.AllRewardPools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.RewardPools = pools
+
 	return &genesis
 }
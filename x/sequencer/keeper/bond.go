@@ -15,8 +15,11 @@ type UnbondBlocker interface {
 
 // TryUnbond will try to either partially or totally unbond a sequencer.
 // The sequencer may not be allowed to unbond, based on certain conditions.
-// A partial unbonding refunds tokens, but doesn't allow the remaining bond to fall below a threshold.
-// A total unbond refunds all tokens and changes status to unbonded.
+// Only the self bond can be unbonded: delegated tokens are withdrawn by the delegators.
+// The unbonded tokens enter the unbonding queue, where they can still be slashed, and are refunded
+// after the unbonding period.
+// A partial unbonding doesn't allow the remaining bond to fall below a threshold.
+// A total unbond queues the whole self bond and changes status to unbonded.
 func (k Keeper) TryUnbond(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coin) error {
 	if k.IsProposer(ctx, *seq) || k.IsSuccessor(ctx, *seq) {
 		return types.ErrUnbondProposerOrSuccessor
@@ -26,28 +29,55 @@ func (k Keeper) TryUnbond(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coin) e
 			return errorsmod.Wrap(err, "other module")
 		}
 	}
-	bond := seq.TokensCoin()
-	minBond := k.rollappKeeper.MinBond(ctx, seq.RollappId)
-	maxReduction, _ := bond.SafeSub(minBond)
-	isPartial := !amt.IsEqual(bond)
-	if isPartial && maxReduction.IsLT(amt) {
+	selfBond, err := k.SelfBond(ctx, *seq)
+	if err != nil {
+		return errorsmod.Wrap(err, "self bond")
+	}
+	if selfBond.IsLT(amt) {
 		return errorsmod.Wrapf(types.ErrUnbondNotAllowed,
-			"attempted reduction: %s, max reduction: %s",
-			amt, ucoin.NonNegative(maxReduction),
+			"attempted reduction: %s, self bond: %s", amt, selfBond,
 		)
 	}
-	if err := k.refund(ctx, seq, amt); err != nil {
-		return errorsmod.Wrap(err, "refund")
+	isPartial := !amt.IsEqual(selfBond)
+	if isPartial {
+		bond := seq.TokensCoin()
+		minBond := k.rollappKeeper.MinBond(ctx, seq.RollappId)
+		maxReduction, _ := bond.SafeSub(minBond)
+		if maxReduction.IsLT(amt) {
+			return errorsmod.Wrapf(types.ErrUnbondNotAllowed,
+				"attempted reduction: %s, max reduction: %s",
+				amt, ucoin.NonNegative(maxReduction),
+			)
+		}
+		minSelfBond := k.minSelfBond(ctx, seq.RollappId)
+		if selfBond.Sub(amt).IsLT(minSelfBond) {
+			return errorsmod.Wrapf(types.ErrUnbondNotAllowed,
+				"attempted reduction: %s, min self bond: %s", amt, minSelfBond,
+			)
+		}
+	}
+	// rewards collected so far are split by the old bond
+	if _, err := k.accrueRewards(ctx, *seq); err != nil {
+		return errorsmod.Wrap(err, "accrue rewards")
 	}
-	if seq.Tokens.IsZero() {
-		k.unbond(ctx, seq)
+	if err := k.startUnbonding(ctx, seq, amt); err != nil {
+		return errorsmod.Wrap(err, "start unbonding")
+	}
+	if !isPartial {
+		if err := k.unbond(ctx, seq); err != nil {
+			return errorsmod.Wrap(err, "unbond")
+		}
 	}
 	return nil
 }
 
 // set unbonded status and clear proposer/successor if necessary
-func (k Keeper) unbond(ctx sdk.Context, seq *types.Sequencer) {
+// The snapshots of the sequencer are no longer served.
+func (k Keeper) unbond(ctx sdk.Context, seq *types.Sequencer) error {
 	seq.Status = types.Unbonded
+	if err := k.removeSnapshots(ctx, *seq); err != nil {
+		return errorsmod.Wrap(err, "remove snapshots")
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -55,4 +85,5 @@ func (k Keeper) unbond(ctx sdk.Context, seq *types.Sequencer) {
 			sdk.NewAttribute(types.AttributeKeySequencer, seq.Address),
 		),
 	)
+	return nil
 }
@@ -0,0 +1,453 @@
+package keeper
+
+import (
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// rewardsPerTokenScale is the factor applied to the rewards per token, bonds are large
+// compared to rewards and the rewards per token would otherwise lose too much precision
+var rewardsPerTokenScale = math.NewIntWithDecimal(1, 18)
+
+func (k Keeper) SetDelegation(ctx sdk.Context, d types.Delegation) error {
+	return k.delegations.Set(ctx, collections.Join(d.Sequencer, d.Delegator), d)
+}
+
+func (k Keeper) GetDelegation(ctx sdk.Context, seqAddr, delegator string) (types.Delegation, bool, error) {
+	d, err := k.delegations.Get(ctx, collections.Join(seqAddr, delegator))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Delegation{}, false, nil
+	}
+	if err != nil {
+		return types.Delegation{}, false, err
+	}
+	return d, true, nil
+}
+
+// setOrRemoveDelegation removes empty delegations from the store
+func (k Keeper) setOrRemoveDelegation(ctx sdk.Context, d types.Delegation) error {
+	if d.Amount.IsZero() {
+		return k.delegations.Remove(ctx, collections.Join(d.Sequencer, d.Delegator))
+	}
+	return k.SetDelegation(ctx, d)
+}
+
+func (k Keeper) SequencerDelegations(ctx sdk.Context, seqAddr string) ([]types.Delegation, error) {
+	iter, err := k.delegations.Iterate(ctx, collections.NewPrefixedPairRange[string, string](seqAddr))
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+func (k Keeper) SetRewardPool(ctx sdk.Context, p types.RewardPool) error {
+	return k.rewardPools.Set(ctx, p.Sequencer, p)
+}
+
+// GetRewardPool returns the reward accounting of the sequencer, which is empty if nothing was accrued yet
+func (k Keeper) GetRewardPool(ctx sdk.Context, seqAddr string) (types.RewardPool, error) {
+	p, err := k.rewardPools.Get(ctx, seqAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.RewardPool{Sequencer: seqAddr}, nil
+	}
+	return p, err
+}
+
+func (k Keeper) AllRewardPools(ctx sdk.Context) ([]types.RewardPool, error) {
+	iter, err := k.rewardPools.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+func (k Keeper) AllDelegations(ctx sdk.Context) ([]types.Delegation, error) {
+	iter, err := k.delegations.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// DelegatedTokens is the part of the sequencer bond which belongs to delegators
+func (k Keeper) DelegatedTokens(ctx sdk.Context, seqAddr string) (sdk.Coin, error) {
+	dels, err := k.SequencerDelegations(ctx, seqAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	total := sdk.NewCoin(commontypes.DYMCoin.Denom, math.ZeroInt())
+	for _, d := range dels {
+		total = total.Add(d.Amount)
+	}
+	return total, nil
+}
+
+// SelfBond is the part of the sequencer bond which belongs to the sequencer
+func (k Keeper) SelfBond(ctx sdk.Context, seq types.Sequencer) (sdk.Coin, error) {
+	delegated, err := k.DelegatedTokens(ctx, seq.Address)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return seq.TokensCoin().Sub(delegated), nil
+}
+
+// SetUndelegation adds to the queue entry with the same key if there is one
+func (k Keeper) SetUndelegation(ctx sdk.Context, u types.Undelegation) error {
+	return k.undelegations.add(ctx, collections.Join3(u.CompletionTime, u.Delegator, u.Sequencer), u)
+}
+
+func (k Keeper) AllUndelegations(ctx sdk.Context) ([]types.Undelegation, error) {
+	return k.undelegations.all(ctx)
+}
+
+func (k Keeper) DelegatorUndelegations(ctx sdk.Context, delegator string) ([]types.Undelegation, error) {
+	all, err := k.AllUndelegations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var ret []types.Undelegation
+	for _, u := range all {
+		if u.Delegator == delegator {
+			ret = append(ret, u)
+		}
+	}
+	return ret, nil
+}
+
+// delegate adds the delegator tokens to the sequencer bond
+func (k Keeper) delegate(ctx sdk.Context, delegator sdk.AccAddress, seq *types.Sequencer, amt sdk.Coin) error {
+	if !seq.Bonded() {
+		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "sequencer not bonded")
+	}
+	if !seq.DelegationEnabled() {
+		return types.ErrDelegationNotEnabled
+	}
+	if err := validBondDenom(amt); err != nil {
+		return err
+	}
+
+	// rewards collected so far belong to the current delegations
+	pool, err := k.accrueRewards(ctx, *seq)
+	if err != nil {
+		return errorsmod.Wrap(err, "accrue rewards")
+	}
+
+	d, ok, err := k.GetDelegation(ctx, seq.Address, delegator.String())
+	if err != nil {
+		return errorsmod.Wrap(err, "get delegation")
+	}
+	if !ok {
+		d = types.Delegation{
+			Delegator:   delegator.String(),
+			Sequencer:   seq.Address,
+			Amount:      sdk.NewCoin(amt.Denom, math.ZeroInt()),
+			RewardIndex: pool.RewardsPerToken,
+		}
+	}
+	if err := k.payDelegationRewards(ctx, &pool, &d); err != nil {
+		return errorsmod.Wrap(err, "pay rewards")
+	}
+	if err := k.SetRewardPool(ctx, pool); err != nil {
+		return errorsmod.Wrap(err, "set reward pool")
+	}
+	d.Amount = d.Amount.Add(amt)
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delegator, types.ModuleName, sdk.NewCoins(amt)); err != nil {
+		return errorsmod.Wrap(err, "send to module")
+	}
+	seq.SetTokensCoin(seq.TokensCoin().Add(amt))
+
+	if err := k.SetDelegation(ctx, d); err != nil {
+		return errorsmod.Wrap(err, "set delegation")
+	}
+
+	return uevent.EmitTypedEvent(ctx, &types.EventDelegated{
+		Delegator: d.Delegator,
+		Sequencer: seq.Address,
+		Amount:    amt,
+	})
+}
+
+// undelegate removes the tokens from the sequencer bond and puts them in the undelegation queue.
+// The tokens can still be slashed until the completion time. Returns the completion time.
+func (k Keeper) undelegate(ctx sdk.Context, delegator sdk.AccAddress, seq *types.Sequencer, amt sdk.Coin) (time.Time, error) {
+	d, ok, err := k.GetDelegation(ctx, seq.Address, delegator.String())
+	if err != nil {
+		return time.Time{}, errorsmod.Wrap(err, "get delegation")
+	}
+	if !ok {
+		return time.Time{}, types.ErrDelegationNotFound
+	}
+	if err := validBondDenom(amt); err != nil {
+		return time.Time{}, err
+	}
+	if d.Amount.IsLT(amt) {
+		return time.Time{}, errorsmod.Wrapf(gerrc.ErrOutOfRange, "delegated: %s: requested: %s", d.Amount, amt)
+	}
+
+	pool, err := k.accrueRewards(ctx, *seq)
+	if err != nil {
+		return time.Time{}, errorsmod.Wrap(err, "accrue rewards")
+	}
+	if err := k.payDelegationRewards(ctx, &pool, &d); err != nil {
+		return time.Time{}, errorsmod.Wrap(err, "pay rewards")
+	}
+	if err := k.SetRewardPool(ctx, pool); err != nil {
+		return time.Time{}, errorsmod.Wrap(err, "set reward pool")
+	}
+
+	d.Amount = d.Amount.Sub(amt)
+	if err := k.setOrRemoveDelegation(ctx, d); err != nil {
+		return time.Time{}, errorsmod.Wrap(err, "set delegation")
+	}
+	seq.SetTokensCoin(seq.TokensCoin().Sub(amt))
+
+	completion := ctx.BlockTime().Add(k.GetParams(ctx).DelegationUnbondingPeriod)
+	err = k.SetUndelegation(ctx, types.Undelegation{
+		Delegator:      d.Delegator,
+		Sequencer:      seq.Address,
+		Amount:         amt,
+		CompletionTime: completion,
+	})
+	if err != nil {
+		return time.Time{}, errorsmod.Wrap(err, "set undelegation")
+	}
+
+	return completion, uevent.EmitTypedEvent(ctx, &types.EventUndelegated{
+		Delegator: d.Delegator,
+		Sequencer: seq.Address,
+		Amount:    amt,
+	})
+}
+
+// validCommissionChange checks the commission change against the params. It is not bound while the
+// sequencer has no delegations, since nobody is exposed to the new rate yet.
+func (k Keeper) validCommissionChange(ctx sdk.Context, seq types.Sequencer, rate math.LegacyDec) error {
+	delegated, err := k.DelegatedTokens(ctx, seq.Address)
+	if err != nil {
+		return errorsmod.Wrap(err, "delegated tokens")
+	}
+	if delegated.IsZero() {
+		return nil
+	}
+	params := k.GetParams(ctx)
+	if next := seq.CommissionUpdateTime.Add(params.CommissionChangeInterval); ctx.BlockTime().Before(next) {
+		return errorsmod.Wrapf(types.ErrCommissionChangeTooSoon, "next change: %s", next)
+	}
+	if change := rate.Sub(seq.Commission()).Abs(); change.GT(params.MaxCommissionChangeRate) {
+		return errorsmod.Wrapf(types.ErrCommissionChangeTooLarge, "change: %s: max: %s", change, params.MaxCommissionChangeRate)
+	}
+	return nil
+}
+
+// CompleteUndelegations returns the tokens of all undelegations whose completion time is not after now.
+// Each undelegation is completed in its own cache context, a failed one is logged and stays in the queue.
+func (k Keeper) CompleteUndelegations(ctx sdk.Context, now time.Time) error {
+	matured, err := k.undelegations.matured(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range matured {
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.completeUndelegation(cacheCtx, kv.Key, kv.Value); err != nil {
+			k.Logger(ctx).Error("Complete undelegation.", "delegator", kv.Value.Delegator, "sequencer", kv.Value.Sequencer, "err", err)
+			continue
+		}
+		write()
+	}
+	return nil
+}
+
+func (k Keeper) completeUndelegation(ctx sdk.Context, key collections.Triple[time.Time, string, string], u types.Undelegation) error {
+	if !u.Amount.IsZero() {
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.MustAccAddressFromBech32(u.Delegator), sdk.NewCoins(u.Amount))
+		if err != nil {
+			return errorsmod.Wrapf(err, "refund undelegation: delegator: %s", u.Delegator)
+		}
+	}
+	return k.undelegations.remove(ctx, key)
+}
+
+// slashDelegations reduces the delegations of the sequencer pro rata to the slashed part of the bond.
+// The delegations lose at most amt in total, the remainder of amt is taken from the self bond.
+// The rewards owed to the delegations are paid before they are reduced.
+// Tokens in the undelegation queue are slashed by the same fraction and burned.
+func (k Keeper) slashDelegations(ctx sdk.Context, seq types.Sequencer, amt sdk.Coin) error {
+	total := seq.TokensCoin()
+	if total.IsZero() || amt.IsZero() {
+		return nil
+	}
+	frac := math.LegacyNewDecFromInt(amt.Amount).QuoInt(total.Amount)
+
+	pool, err := k.accrueRewards(ctx, seq)
+	if err != nil {
+		return errorsmod.Wrap(err, "accrue rewards")
+	}
+	dels, err := k.SequencerDelegations(ctx, seq.Address)
+	if err != nil {
+		return errorsmod.Wrap(err, "sequencer delegations")
+	}
+	remaining := amt.Amount
+	for _, d := range dels {
+		if err := k.payDelegationRewards(ctx, &pool, &d); err != nil {
+			return errorsmod.Wrap(err, "pay rewards")
+		}
+		// round up so that the self bond never becomes negative
+		cut := math.MinInt(math.MinInt(frac.MulInt(d.Amount.Amount).Ceil().TruncateInt(), d.Amount.Amount), remaining)
+		remaining = remaining.Sub(cut)
+		d.Amount.Amount = d.Amount.Amount.Sub(cut)
+		if err := k.setOrRemoveDelegation(ctx, d); err != nil {
+			return errorsmod.Wrap(err, "set delegation")
+		}
+	}
+	if err := k.SetRewardPool(ctx, pool); err != nil {
+		return errorsmod.Wrap(err, "set reward pool")
+	}
+
+	burn, err := k.undelegations.slash(ctx, seq.Address, frac)
+	if err != nil {
+		return errorsmod.Wrap(err, "slash undelegations")
+	}
+	if burn.IsZero() {
+		return nil
+	}
+	return errorsmod.Wrap(k.bankKeeper.BurnCoins(ctx, types.ModuleName, burn), "burn undelegations")
+}
+
+// distributeRewards accrues the new rewards of the sequencer reward pool and pays every delegation
+// what it is owed.
+func (k Keeper) distributeRewards(ctx sdk.Context, seq types.Sequencer) error {
+	pool, err := k.accrueRewards(ctx, seq)
+	if err != nil {
+		return errorsmod.Wrap(err, "accrue rewards")
+	}
+	dels, err := k.SequencerDelegations(ctx, seq.Address)
+	if err != nil {
+		return errorsmod.Wrap(err, "sequencer delegations")
+	}
+	for _, d := range dels {
+		if err := k.payDelegationRewards(ctx, &pool, &d); err != nil {
+			return errorsmod.Wrapf(err, "pay rewards: delegator: %s", d.Delegator)
+		}
+		if err := k.SetDelegation(ctx, d); err != nil {
+			return errorsmod.Wrap(err, "set delegation")
+		}
+	}
+	return errorsmod.Wrap(k.SetRewardPool(ctx, pool), "set reward pool")
+}
+
+// accrueRewards splits the part of the sequencer reward pool balance which was not accrued yet. The
+// commission is taken first, then the rest is split pro rata between the delegations and the self bond.
+// The delegations part is added to the rewards per token and stays in the pool until paid. The sequencer
+// receives the commission, the self bond share and any rounding dust.
+// It must be called before the sequencer bond or a delegation changes, so that the rewards collected
+// until then are split by the old amounts. The pool is saved and returned.
+func (k Keeper) accrueRewards(ctx sdk.Context, seq types.Sequencer) (types.RewardPool, error) {
+	pool, err := k.GetRewardPool(ctx, seq.Address)
+	if err != nil {
+		return types.RewardPool{}, errorsmod.Wrap(err, "get reward pool")
+	}
+	poolAddr := types.RewardPoolAddr(seq.Address)
+	accrued, err := k.accruedRewards(ctx, poolAddr, pool.Outstanding)
+	if err != nil {
+		return types.RewardPool{}, err
+	}
+	if accrued.IsZero() {
+		return pool, nil
+	}
+
+	delegated, err := k.DelegatedTokens(ctx, seq.Address)
+	if err != nil {
+		return types.RewardPool{}, errorsmod.Wrap(err, "delegated tokens")
+	}
+
+	commission := sdk.NewCoins()
+	owed := sdk.NewCoins()
+	total := seq.TokensCoin().Amount
+	for _, c := range accrued {
+		comm := math.LegacyNewDecFromInt(c.Amount).Mul(seq.Commission()).TruncateInt()
+		commission = commission.Add(sdk.NewCoin(c.Denom, comm))
+		if !total.IsPositive() || !delegated.IsPositive() {
+			continue
+		}
+		perToken := math.LegacyNewDecFromInt(c.Amount.Sub(comm).Mul(rewardsPerTokenScale)).QuoInt(total)
+		pool.RewardsPerToken = pool.RewardsPerToken.Add(sdk.NewDecCoinFromDec(c.Denom, perToken))
+		// round up so that the sum of the truncated payouts never exceeds the outstanding rewards
+		delegatorsPart := perToken.MulInt(delegated.Amount).QuoRoundUp(math.LegacyNewDecFromInt(rewardsPerTokenScale))
+		owed = owed.Add(sdk.NewCoin(c.Denom, delegatorsPart.Ceil().TruncateInt()))
+	}
+	pool.Outstanding = pool.Outstanding.Add(owed...)
+	if err := k.SetRewardPool(ctx, pool); err != nil {
+		return types.RewardPool{}, errorsmod.Wrap(err, "set reward pool")
+	}
+
+	if left := accrued.Sub(owed...); !left.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, poolAddr, seq.AccAddr(), left); err != nil {
+			return types.RewardPool{}, errorsmod.Wrap(err, "pay sequencer")
+		}
+	}
+
+	return pool, uevent.EmitTypedEvent(ctx, &types.EventRewardsDistributed{
+		Sequencer:  seq.Address,
+		Commission: commission,
+		Delegators: owed,
+	})
+}
+
+// accruedRewards returns the reward pool balance above the outstanding rewards, in the bond denom and the
+// reward denoms only. Anyone can send other denoms to the pool, they are ignored so that they do not make
+// accruing more expensive. Outstanding rewards in a denom removed from the params stay in the pool until paid.
+func (k Keeper) accruedRewards(ctx sdk.Context, poolAddr sdk.AccAddress, outstanding sdk.Coins) (sdk.Coins, error) {
+	denoms := append([]string{commontypes.DYMCoin.Denom}, k.GetParams(ctx).RewardDenoms...)
+	accrued := sdk.NewCoins()
+	for _, denom := range denoms {
+		balance := k.bankKeeper.GetBalance(ctx, poolAddr, denom)
+		owed := outstanding.AmountOf(denom)
+		if balance.Amount.LT(owed) {
+			return nil, errorsmod.Wrapf(gerrc.ErrInternal, "reward pool balance below outstanding rewards: denom: %s", denom)
+		}
+		accrued = accrued.Add(sdk.NewCoin(denom, balance.Amount.Sub(owed)))
+	}
+	return accrued, nil
+}
+
+// payDelegationRewards pays the delegation its amount times the increase of the rewards per token since
+// its last payout. The delegation and the pool are not saved.
+func (k Keeper) payDelegationRewards(ctx sdk.Context, pool *types.RewardPool, d *types.Delegation) error {
+	increase, neg := pool.RewardsPerToken.SafeSub(d.RewardIndex)
+	if neg {
+		return errorsmod.Wrapf(gerrc.ErrInternal, "reward index above rewards per token: delegator: %s", d.Delegator)
+	}
+	owed, _ := increase.
+		MulDecTruncate(math.LegacyNewDecFromInt(d.Amount.Amount)).
+		QuoDecTruncate(math.LegacyNewDecFromInt(rewardsPerTokenScale)).
+		TruncateDecimal()
+	d.RewardIndex = pool.RewardsPerToken
+	if owed.IsZero() {
+		return nil
+	}
+	outstanding, neg := pool.Outstanding.SafeSub(owed...)
+	if neg {
+		return errorsmod.Wrapf(gerrc.ErrInternal, "owed rewards exceed outstanding: owed: %s: outstanding: %s", owed, pool.Outstanding)
+	}
+	pool.Outstanding = outstanding
+	if err := k.bankKeeper.SendCoins(ctx, types.RewardPoolAddr(d.Sequencer), sdk.MustAccAddressFromBech32(d.Delegator), owed); err != nil {
+		return errorsmod.Wrap(err, "send")
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventDelegationRewardsPaid{
+		Delegator: d.Delegator,
+		Sequencer: d.Sequencer,
+		Amount:    owed,
+	})
+}
@@ -26,7 +26,12 @@ func (k Keeper) TryKickProposer(ctx sdk.Context, kicker types.Sequencer) error {
 	}
 
 	// clear the proposer
-	k.abruptRemoveProposer(ctx, ra)
+	if err := k.abruptRemoveProposer(ctx, ra); err != nil {
+		return errorsmod.Wrap(err, "remove proposer")
+	}
+	if err := k.updateReputation(ctx, proposer.Address, func(r *types.Reputation) { r.Kicks++ }); err != nil {
+		return errorsmod.Wrap(err, "update reputation")
+	}
 
 	// This will call hard fork on the rollapp, which will also optOut all sequencers
 	err := k.hooks.AfterKickProposer(ctx, proposer)
@@ -69,9 +74,13 @@ func (k Keeper) SlashLiveness(ctx sdk.Context, rollappID string) error {
 	if err != nil {
 		return errorsmod.Wrap(err, "slash")
 	}
+	before := seq.GetPenalty()
 	k.increasePenaltyDowntime(ctx, &seq)
 	k.SetSequencer(ctx, seq)
-	return nil
+	return errorsmod.Wrap(k.updateReputation(ctx, seq.Address, func(r *types.Reputation) {
+		r.LivenessSlashes++
+		r.DishonorAdded += seq.GetPenalty() - before
+	}), "update reputation")
 }
 
 func (k Keeper) livenessSlash(ctx sdk.Context, seq *types.Sequencer) error {
@@ -96,6 +105,7 @@ func (k Keeper) increasePenaltyDowntime(ctx sdk.Context, seq *types.Sequencer) {
 
 // Takes an optional rewardee addr who will receive some bounty
 // Currently there is no dishonor penalty (anyway we slash 100%)
+// Tokens of the sequencer in the unbonding queue are burned too.
 func (k Keeper) PunishSequencer(ctx sdk.Context, seqAddr string, rewardee *sdk.AccAddress) error {
 	var (
 		rewardMul = math.LegacyZeroDec()
@@ -112,6 +122,10 @@ func (k Keeper) PunishSequencer(ctx sdk.Context, seqAddr string, rewardee *sdk.A
 		addr = *rewardee
 	}
 
+	if err := k.slashUnbondings(ctx, seq.Address, math.LegacyOneDec()); err != nil {
+		return errorsmod.Wrap(err, "slash unbondings")
+	}
+
 	err = k.slash(ctx, &seq, seq.TokensCoin(), rewardMul, addr)
 	if err != nil {
 		return errorsmod.Wrap(err, "slash")
@@ -120,7 +134,53 @@ func (k Keeper) PunishSequencer(ctx sdk.Context, seqAddr string, rewardee *sdk.A
 	return nil
 }
 
+// PunishEquivocation punishes a sequencer which signed two conflicting rollapp headers, rewarding the
+// submitter of the evidence. If the sequencer is the proposer, it is removed and the rollapp is forked to
+// the latest height, as for a kick. Otherwise, it is opted out.
+func (k Keeper) PunishEquivocation(ctx sdk.Context, seqAddr string, rewardee sdk.AccAddress) error {
+	if err := k.PunishSequencer(ctx, seqAddr, &rewardee); err != nil {
+		return errorsmod.Wrap(err, "punish sequencer")
+	}
+
+	seq, err := k.RealSequencer(ctx, seqAddr)
+	if err != nil {
+		return err
+	}
+
+	if k.IsProposer(ctx, seq) {
+		if err := k.abruptRemoveProposer(ctx, seq.RollappId); err != nil {
+			return errorsmod.Wrap(err, "remove proposer")
+		}
+		// This will call hard fork on the rollapp, which will also optOut all sequencers
+		if err := k.hooks.AfterKickProposer(ctx, seq); err != nil {
+			return errorsmod.Wrap(err, "kick proposer callbacks")
+		}
+		return nil
+	}
+
+	if err := seq.SetOptedIn(ctx, false); err != nil {
+		return errorsmod.Wrap(err, "set opted in")
+	}
+	k.SetSequencer(ctx, seq)
+	return nil
+}
+
+// slash takes amt from the sequencer bond. Delegators lose their share pro rata.
+// Tokens in the unbonding queue are slashed by the same fraction.
 func (k Keeper) slash(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coin, rewardMul math.LegacyDec, rewardee sdk.AccAddress) error {
+	// a slashed sequencer is not trusted to serve snapshots, until it advertises them again
+	if err := k.removeSnapshots(ctx, *seq); err != nil {
+		return errorsmod.Wrap(err, "remove snapshots")
+	}
+	if tokens := seq.TokensCoin(); tokens.IsPositive() {
+		frac := math.LegacyNewDecFromInt(amt.Amount).QuoInt(tokens.Amount)
+		if err := k.slashUnbondings(ctx, seq.Address, frac); err != nil {
+			return errorsmod.Wrap(err, "slash unbondings")
+		}
+	}
+	if err := k.slashDelegations(ctx, *seq, amt); err != nil {
+		return errorsmod.Wrap(err, "slash delegations")
+	}
 	rewardCoin := ucoin.MulDec(rewardMul, amt)[0]
 	if !rewardCoin.IsZero() {
 		err := k.sendFromModule(ctx, seq, rewardCoin, rewardee)
@@ -72,6 +72,30 @@ func (s *SequencerTestSuite) TestPunishSequencer() {
 	})
 }
 
+// A non proposer is opted out, while the proposer is removed
+func (s *SequencerTestSuite) TestPunishEquivocation() {
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, bob, bond)
+	s.Require().True(s.k().IsProposer(s.Ctx, s.seq(alice)))
+	s.submitAFewRollappStates(ra.RollappId)
+	rewardee := pkAcc(randomTMPubKey())
+
+	err := s.k().PunishEquivocation(s.Ctx, s.seq(bob).Address, rewardee)
+	s.Require().NoError(err)
+	s.Require().True(s.seq(bob).TokensCoin().IsZero())
+	s.Require().False(s.seq(bob).OptedIn)
+	s.Require().True(s.k().IsProposer(s.Ctx, s.seq(alice)))
+	s.Require().False(s.App.BankKeeper.GetAllBalances(s.Ctx, rewardee).IsZero())
+
+	err = s.k().PunishEquivocation(s.Ctx, s.seq(alice).Address, rewardee)
+	s.Require().NoError(err)
+	s.Require().True(s.seq(alice).TokensCoin().IsZero())
+	s.Require().False(s.k().IsProposer(s.Ctx, s.seq(alice)))
+	s.Require().True(s.k().IsProposer(s.Ctx, s.k().SentinelSequencer(s.Ctx)))
+	s.requireInvariants()
+}
+
 // a full flow 'e2e' to make sure things are sensible
 // There are many many different scenarios that could be tested
 // Here pick one which might be typical/realistic
@@ -5,6 +5,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+	"github.com/dymensionxyz/sdk-utils/utils/ucoin"
 )
 
 func validBondDenom(c sdk.Coin) error {
@@ -25,6 +26,25 @@ func (k Keeper) sufficientBond(ctx sdk.Context, rollapp string, c sdk.Coin) erro
 	return nil
 }
 
+// minSelfBond is the part of the rollapp min bond which the sequencer must provide itself
+func (k Keeper) minSelfBond(ctx sdk.Context, rollapp string) sdk.Coin {
+	minBond := k.rollappKeeper.MinBond(ctx, rollapp)
+	return ucoin.MulDec(k.GetParams(ctx).MinSelfBondFraction, minBond)[0]
+}
+
+// sufficientSelfBond checks the bond provided by the sequencer on creation. Delegations can make up
+// for the rest of the min bond later.
+func (k Keeper) sufficientSelfBond(ctx sdk.Context, rollapp string, c sdk.Coin) error {
+	if err := validBondDenom(c); err != nil {
+		return err
+	}
+	minSelfBond := k.minSelfBond(ctx, rollapp)
+	if c.IsLT(minSelfBond) {
+		return errorsmod.Wrapf(types.ErrInsufficientBond, "min self bond: %s: given: %s", minSelfBond.Amount, c.Amount)
+	}
+	return nil
+}
+
 func (k Keeper) Kickable(ctx sdk.Context, proposer types.Sequencer) bool {
 	kickThreshold := k.GetParams(ctx).PenaltyKickThreshold()
 	return !proposer.Sentinel() && kickThreshold <= proposer.GetPenalty()
@@ -35,11 +55,6 @@ func (k Keeper) burn(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coin) error
 	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amt))
 }
 
-// Refund reduces the sequencer token balance by amt and refunds amt to the addr
-func (k Keeper) refund(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coin) error {
-	return errorsmod.Wrap(k.sendFromModule(ctx, seq, amt, seq.AccAddr()), "send tokens")
-}
-
 func (k Keeper) sendFromModule(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coin, recipient sdk.AccAddress) error {
 	seq.SetTokensCoin(seq.TokensCoin().Sub(amt))
 	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(amt))
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (k Keeper) Delegations(c context.Context, req *types.QueryDelegationsRequest) (*types.QueryDelegationsResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	seq, err := k.RealSequencer(ctx, req.Sequencer)
+	if err != nil {
+		return nil, err
+	}
+
+	dels, err := k.SequencerDelegations(ctx, seq.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegationsResponse{
+		Delegations: dels,
+		RewardPool:  types.RewardPoolAddr(seq.Address).String(),
+	}, nil
+}
+
+func (k Keeper) Undelegations(c context.Context, req *types.QueryUndelegationsRequest) (*types.QueryUndelegationsResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	undels, err := k.DelegatorUndelegations(ctx, req.Delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryUndelegationsResponse{Undelegations: undels}, nil
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (k Keeper) Reputation(c context.Context, req *types.QueryReputationRequest) (*types.QueryReputationResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	seq, err := k.RealSequencer(ctx, req.Sequencer)
+	if err != nil {
+		return nil, err
+	}
+	r, err := k.GetReputation(ctx, seq.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryReputationResponse{Reputation: r, Dishonor: seq.GetPenalty()}, nil
+}
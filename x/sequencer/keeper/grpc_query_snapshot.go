@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (k Keeper) LatestSnapshot(c context.Context, req *types.QueryLatestSnapshotRequest) (*types.QueryLatestSnapshotResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res, err := k.LatestVerifiedSnapshot(ctx, req.RollappId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
@@ -35,10 +35,14 @@ func (hook rollappHook) BeforeUpdateState(ctx sdk.Context, seqAddr, rollappId st
 	return nil
 }
 
-// AfterUpdateState checks if rotation is completed and the nextProposer is changed
+// AfterUpdateState checks if rotation is completed and the nextProposer is changed.
+// It also applies the sequencer key rotations which became effective.
 func (hook rollappHook) AfterUpdateState(ctx sdk.Context, stateInfo *rollapptypes.StateInfoMeta) error {
+	if err := hook.k.applyKeyRotations(ctx, stateInfo.Rollapp, stateInfo.GetLatestHeight()); err != nil {
+		return errorsmod.Wrap(err, "apply key rotations")
+	}
 	proposer := hook.k.GetProposer(ctx, stateInfo.Rollapp)
-	return hook.k.afterStateUpdate(ctx, proposer, stateInfo.Sequencer != stateInfo.NextProposer)
+	return hook.k.afterStateUpdate(ctx, proposer, stateInfo.NumBlocks, stateInfo.Sequencer != stateInfo.NextProposer)
 }
 
 // OnHardFork implements the RollappHooks interface
@@ -51,7 +55,9 @@ func (hook rollappHook) OnHardFork(ctx sdk.Context, rollappID string, _ uint64)
 	}
 
 	// clear current proposer and successor
-	hook.k.abruptRemoveProposer(ctx, rollappID)
+	if err := hook.k.abruptRemoveProposer(ctx, rollappID); err != nil {
+		return errorsmod.Wrap(err, "remove proposer")
+	}
 	hook.k.SetSuccessor(ctx, rollappID, types.SentinelSeqAddr)
 
 	return nil
@@ -141,6 +141,30 @@ func InvariantTokens(k Keeper) uinv.Func {
 		total := sdk.NewCoin(commontypes.DYMCoin.Denom, math.ZeroInt())
 		for _, seq := range k.AllSequencers(ctx) {
 			total = total.Add(seq.TokensCoin())
+
+			delegated, err := k.DelegatedTokens(ctx, seq.Address)
+			if err != nil {
+				return err
+			}
+			if seq.TokensCoin().IsLT(delegated) {
+				return fmt.Errorf("delegations exceed sequencer tokens: %s", seq.Address)
+			}
+		}
+		// tokens in the undelegation queue are still held by the module
+		undelegations, err := k.AllUndelegations(ctx)
+		if err != nil {
+			return err
+		}
+		for _, u := range undelegations {
+			total = total.Add(u.Amount)
+		}
+		// and so are tokens in the unbonding queue
+		unbondings, err := k.AllUnbondings(ctx)
+		if err != nil {
+			return err
+		}
+		for _, u := range unbondings {
+			total = total.Add(u.Amount)
 		}
 		// check module balance is equal
 		moduleAcc := k.accountK.GetModuleAccount(ctx, types.ModuleName)
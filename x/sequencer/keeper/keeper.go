@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/log"
@@ -26,6 +27,21 @@ type Keeper struct {
 	hooks          types.Hooks
 
 	dymintProposerAddrToAccAddr collections.Map[[]byte, string]
+	// (sequencer, delegator) -> delegation
+	delegations collections.Map[collections.Pair[string, string], types.Delegation]
+	// (completion time, delegator, sequencer) -> undelegation
+	undelegations tokenQueue[collections.Triple[time.Time, string, string], types.Undelegation]
+	// rollapp -> proposer selection, absent means the default
+	proposerSelections collections.Map[string, types.ProposerSelection]
+	// (completion time, sequencer) -> unbonding entry
+	unbondings tokenQueue[collections.Pair[time.Time, string], types.UnbondingEntry]
+	// (sequencer, effective height) -> key rotation
+	keyRotations collections.Map[collections.Pair[string, uint64], types.KeyRotation]
+	reputations  collections.Map[string, types.Reputation]
+	// snapshots indexes the snapshots advertised in the sequencers metadata by <rollapp, height, sequencer>
+	snapshots collections.Map[collections.Triple[string, uint64, string], types.SnapshotEntry]
+	// sequencer -> reward accounting of the delegations
+	rewardPools collections.Map[string, types.RewardPool]
 }
 
 func NewKeeper(
@@ -59,6 +75,72 @@ func NewKeeper(
 			collections.BytesKey,
 			collections.StringValue,
 		),
+		delegations: collections.NewMap(
+			sb,
+			types.DelegationsKeyPrefix,
+			"delegations",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			collcompat.ProtoValue[types.Delegation](cdc),
+		),
+		undelegations: newTokenQueue(
+			sb,
+			types.UndelegationQueueKeyPrefix,
+			types.UndelegationsBySequencerKeyPrefix,
+			"undelegations",
+			collections.TripleKeyCodec(sdk.TimeKey, collections.StringKey, collections.StringKey),
+			collcompat.ProtoValue[types.Undelegation](cdc),
+			func(u types.Undelegation) string { return u.Sequencer },
+			func(u *types.Undelegation) *sdk.Coin { return &u.Amount },
+			func(t time.Time) collections.Triple[time.Time, string, string] {
+				return collections.Join3(t.Add(1), "", "")
+			},
+		),
+		proposerSelections: collections.NewMap(
+			sb,
+			types.ProposerSelectionKeyPrefix,
+			"proposerSelections",
+			collections.StringKey,
+			collcompat.ProtoValue[types.ProposerSelection](cdc),
+		),
+		unbondings: newTokenQueue(
+			sb,
+			types.UnbondingQueueKeyPrefix,
+			types.UnbondingsBySequencerKeyPrefix,
+			"unbondings",
+			collections.PairKeyCodec(sdk.TimeKey, collections.StringKey),
+			collcompat.ProtoValue[types.UnbondingEntry](cdc),
+			func(u types.UnbondingEntry) string { return u.Sequencer },
+			func(u *types.UnbondingEntry) *sdk.Coin { return &u.Amount },
+			func(t time.Time) collections.Pair[time.Time, string] { return collections.Join(t.Add(1), "") },
+		),
+		keyRotations: collections.NewMap(
+			sb,
+			types.KeyRotationsKeyPrefix,
+			"keyRotations",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			collcompat.ProtoValue[types.KeyRotation](cdc),
+		),
+		reputations: collections.NewMap(
+			sb,
+			types.ReputationsKeyPrefix,
+			"reputations",
+			collections.StringKey,
+			collcompat.ProtoValue[types.Reputation](cdc),
+		),
+		snapshots: collections.NewMap(
+			sb,
+			types.SnapshotsKeyPrefix,
+			"snapshots",
+			collections.TripleKeyCodec(collections.StringKey, collections.Uint64Key, collections.StringKey),
+			collcompat.ProtoValue[types.SnapshotEntry](cdc),
+		),
+		rewardPools: collections.NewMap(
+			sb,
+			types.RewardPoolsKeyPrefix,
+			"rewardPools",
+			collections.StringKey,
+			collcompat.ProtoValue[types.RewardPool](cdc),
+		),
 	}
 }
 
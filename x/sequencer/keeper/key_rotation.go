@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"bytes"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (k Keeper) SetKeyRotation(ctx sdk.Context, r types.KeyRotation) error {
+	return k.keyRotations.Set(ctx, collections.Join(r.Sequencer, r.EffectiveHeight), r)
+}
+
+// SequencerKeyRotations returns the key rotations of the sequencer, by ascending effective height
+func (k Keeper) SequencerKeyRotations(ctx sdk.Context, seqAddr string) ([]types.KeyRotation, error) {
+	iter, err := k.keyRotations.Iterate(ctx, collections.NewPrefixedPairRange[string, uint64](seqAddr))
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+func (k Keeper) AllKeyRotations(ctx sdk.Context) ([]types.KeyRotation, error) {
+	iter, err := k.keyRotations.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// dymintPubKeyAtHeight returns the key which the sequencer uses to sign rollapp height h
+func (k Keeper) dymintPubKeyAtHeight(ctx sdk.Context, seq types.Sequencer, h uint64) (*codectypes.Any, error) {
+	rotations, err := k.SequencerKeyRotations(ctx, seq.Address)
+	if err != nil {
+		return nil, err
+	}
+	if len(rotations) == 0 {
+		return seq.DymintPubKey, nil
+	}
+	ret := rotations[0].PreviousDymintPubKey
+	for _, r := range rotations {
+		if h < r.EffectiveHeight {
+			break
+		}
+		ret = r.DymintPubKey
+	}
+	return ret, nil
+}
+
+// SequencerAtHeight returns the sequencer with the dymint key it uses to sign rollapp height h,
+// which may differ from the current key if the sequencer rotated its key.
+func (k Keeper) SequencerAtHeight(ctx sdk.Context, addr string, h uint64) (types.Sequencer, error) {
+	seq, err := k.RealSequencer(ctx, addr)
+	if err != nil {
+		return types.Sequencer{}, err
+	}
+	pk, err := k.dymintPubKeyAtHeight(ctx, seq, h)
+	if err != nil {
+		return types.Sequencer{}, errorsmod.Wrap(err, "dymint pub key at height")
+	}
+	seq.DymintPubKey = pk
+	return seq, nil
+}
+
+// rotateKey schedules the new key for the sequencer, from the effective rollapp height on.
+// Both keys stay indexed to the sequencer, so headers signed by either can be attributed to it.
+func (k Keeper) rotateKey(ctx sdk.Context, seq *types.Sequencer, pk *codectypes.Any, effectiveHeight uint64) error {
+	if !seq.Bonded() {
+		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "sequencer not bonded")
+	}
+
+	latest, _ := k.rollappKeeper.GetLatestHeight(ctx, seq.RollappId)
+	if effectiveHeight <= latest {
+		return errorsmod.Wrapf(gerrc.ErrOutOfRange, "effective height must be after latest rollapp height: latest: %d", latest)
+	}
+
+	rotations, err := k.SequencerKeyRotations(ctx, seq.Address)
+	if err != nil {
+		return errorsmod.Wrap(err, "sequencer key rotations")
+	}
+	if len(rotations) != 0 && latest+1 < rotations[len(rotations)-1].EffectiveHeight {
+		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "key rotation pending")
+	}
+
+	pkAddr, err := types.PubKeyAddr(pk)
+	if err != nil {
+		return errorsmod.Wrap(err, "pub key addr")
+	}
+	if _, err := k.SequencerByDymintAddr(ctx, pkAddr); err == nil {
+		return gerrc.ErrAlreadyExists.Wrap("pub key in use")
+	}
+
+	err = k.SetKeyRotation(ctx, types.KeyRotation{
+		Sequencer:            seq.Address,
+		EffectiveHeight:      effectiveHeight,
+		PreviousDymintPubKey: seq.DymintPubKey,
+		DymintPubKey:         pk,
+	})
+	if err != nil {
+		return errorsmod.Wrap(err, "set key rotation")
+	}
+	if err := k.SetSequencerByDymintAddr(ctx, pkAddr, seq.Address); err != nil {
+		return errorsmod.Wrap(err, "set sequencer by dymint addr")
+	}
+	if err := k.applyKeyRotation(ctx, seq, latest); err != nil {
+		return errorsmod.Wrap(err, "apply key rotation")
+	}
+
+	return uevent.EmitTypedEvent(ctx, &types.EventRotateSequencerKey{
+		Sequencer:       seq.Address,
+		Rollapp:         seq.RollappId,
+		EffectiveHeight: effectiveHeight,
+		NewProposerAddr: pkAddr,
+	})
+}
+
+// applyKeyRotation sets the key of the sequencer to the one signing the block after the latest height
+func (k Keeper) applyKeyRotation(ctx sdk.Context, seq *types.Sequencer, latest uint64) error {
+	pk, err := k.dymintPubKeyAtHeight(ctx, *seq, latest+1)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(pk.GetValue(), seq.DymintPubKey.GetValue()) {
+		return nil
+	}
+	seq.DymintPubKey = pk
+	return nil
+}
+
+// applyKeyRotations updates the keys of the rollapp sequencers which rotated their key, once the rollapp
+// reached the effective height
+func (k Keeper) applyKeyRotations(ctx sdk.Context, rollapp string, latest uint64) error {
+	for _, seq := range k.RollappSequencers(ctx, rollapp) {
+		before := seq.DymintPubKey
+		if err := k.applyKeyRotation(ctx, &seq, latest); err != nil {
+			return errorsmod.Wrapf(err, "sequencer: %s", seq.Address)
+		}
+		if seq.DymintPubKey != before {
+			k.SetSequencer(ctx, seq)
+		}
+	}
+	return nil
+}
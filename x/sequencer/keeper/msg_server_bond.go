@@ -22,6 +22,11 @@ func (k msgServer) IncreaseBond(goCtx context.Context, msg *types.MsgIncreaseBon
 		return nil, err
 	}
 
+	// rewards collected so far are split by the old bond
+	if _, err := k.accrueRewards(ctx, seq); err != nil {
+		return nil, errorsmod.Wrap(err, "accrue rewards")
+	}
+
 	// charge the user and modify the sequencer object
 	if err := k.sendToModule(ctx, &seq, msg.AddAmount); err != nil {
 		return nil, err
@@ -51,7 +56,7 @@ func (k msgServer) DecreaseBond(goCtx context.Context, msg *types.MsgDecreaseBon
 	}
 	k.SetSequencer(ctx, seq)
 
-	return &types.MsgDecreaseBondResponse{}, nil
+	return &types.MsgDecreaseBondResponse{CompletionTime: k.UnbondingCompletionTime(ctx)}, nil
 }
 
 func (k msgServer) Unbond(goCtx context.Context, msg *types.MsgUnbond) (*types.MsgUnbondResponse, error) {
@@ -93,11 +98,20 @@ func (k msgServer) Unbond(goCtx context.Context, msg *types.MsgUnbond) (*types.M
 
 	}
 
-	err = k.TryUnbond(ctx, &seq, seq.TokensCoin())
+	selfBond, err := k.SelfBond(ctx, seq)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "self bond")
+	}
+	err = k.TryUnbond(ctx, &seq, selfBond)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "try unbond")
 	}
 	k.SetSequencer(ctx, seq)
 
-	return &types.MsgUnbondResponse{}, nil
+	completion := k.UnbondingCompletionTime(ctx)
+	return &types.MsgUnbondResponse{
+		CompletionTime: &types.MsgUnbondResponse_UnbondingCompletionTime{
+			UnbondingCompletionTime: &completion,
+		},
+	}, nil
 }
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	"cosmossdk.io/math"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
@@ -71,14 +73,25 @@ func (s *SequencerTestSuite) TestDecreaseBondBasic() {
 		DecreaseAmount: bond,
 	}
 	s.k().SetProposer(s.Ctx, ra.RollappId, pkAddr(randomTMPubKey())) // make not proposer so it's allowed
+	total := expect
 	for range 2 {
-		_, err := s.msgServer.DecreaseBond(s.Ctx, m)
+		res, err := s.msgServer.DecreaseBond(s.Ctx, m)
 		s.Require().NoError(err)
+		s.Require().True(res.CompletionTime.After(s.Ctx.BlockTime()))
 		expect = expect.Sub(bond)
 		seq = s.k().GetSequencer(s.Ctx, seq.Address)
 		s.Require().True(expect.Equal(seq.TokensCoin()))
-		s.Require().True(expect.Equal(s.moduleBalance()))
+		// the decreased amount is held until the unbonding period is over
+		s.Require().True(total.Equal(s.moduleBalance()))
 	}
+	unbondings, err := s.k().SequencerUnbondings(s.Ctx, seq.Address)
+	s.Require().NoError(err)
+	s.Require().Len(unbondings, 1)
+	s.Require().True(unbondings[0].Amount.IsEqual(ucoin.SimpleMul(bond, 2)))
+
+	s.Require().NoError(s.k().CompleteUnbondings(s.Ctx, unbondings[0].CompletionTime))
+	s.Require().True(expect.Equal(s.moduleBalance()))
+	s.requireInvariants()
 }
 
 func (s *SequencerTestSuite) TestDecreaseBondRestrictions() {
@@ -136,12 +149,58 @@ func (s *SequencerTestSuite) TestUnbondBasic() {
 	m := &types.MsgUnbond{
 		Creator: seq.Address,
 	}
-	_, err := s.msgServer.Unbond(s.Ctx, m)
+	res, err := s.msgServer.Unbond(s.Ctx, m)
 	s.Require().NoError(err)
 	seq = s.k().GetSequencer(s.Ctx, seq.Address)
 	s.Require().Equal(types.Unbonded, seq.Status)
-	s.Require().True(s.moduleBalance().IsZero())
 	s.Require().True(seq.TokensCoin().IsZero())
+	s.Require().True(expect.Equal(s.moduleBalance()))
+
+	// not released before the completion time
+	completion := *res.GetUnbondingCompletionTime()
+	s.Require().NoError(s.k().CompleteUnbondings(s.Ctx, completion.Add(-time.Second)))
+	s.Require().True(expect.Equal(s.moduleBalance()))
+
+	s.Require().NoError(s.k().CompleteUnbondings(s.Ctx, completion))
+	s.Require().True(s.moduleBalance().IsZero())
+	s.requireInvariants()
+}
+
+func (s *SequencerTestSuite) TestSlashUnbonding() {
+	ra := s.createRollapp()
+	seq := s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, ucoin.SimpleMul(bond, 4))
+	s.k().SetProposer(s.Ctx, ra.RollappId, pkAddr(randomTMPubKey())) // make not proposer so it's allowed
+
+	_, err := s.msgServer.DecreaseBond(s.Ctx, types.NewMsgDecreaseBond(seq.Address, ucoin.SimpleMul(bond, 2)))
+	s.Require().NoError(err)
+	ra2 := s.createRollapp()
+	other := s.createSequencerWithBond(s.Ctx, ra2.RollappId, bob, ucoin.SimpleMul(bond, 2))
+	s.k().SetProposer(s.Ctx, ra2.RollappId, pkAddr(randomTMPubKey()))
+	_, err = s.msgServer.DecreaseBond(s.Ctx, types.NewMsgDecreaseBond(other.Address, bond))
+	s.Require().NoError(err)
+
+	// a liveness slash of the bond also slashes the queued tokens by the same fraction
+	s.k().SetProposer(s.Ctx, ra.RollappId, seq.Address)
+	s.Require().NoError(s.k().SlashLiveness(s.Ctx, ra.RollappId))
+	mul := s.k().GetParams(s.Ctx).LivenessSlashMinMultiplier
+	expect := ucoin.MulDec(math.LegacyOneDec().Sub(mul), ucoin.SimpleMul(bond, 2))[0]
+	unbondings, err := s.k().SequencerUnbondings(s.Ctx, seq.Address)
+	s.Require().NoError(err)
+	s.Require().True(expect.Equal(unbondings[0].Amount))
+	// the queued tokens of other sequencers are untouched
+	unbondings, err = s.k().SequencerUnbondings(s.Ctx, other.Address)
+	s.Require().NoError(err)
+	s.Require().Len(unbondings, 1)
+	s.Require().True(bond.Equal(unbondings[0].Amount))
+	s.requireInvariants()
+
+	// the sequencer can be punished after unbonding
+	s.k().SetProposer(s.Ctx, ra.RollappId, pkAddr(randomTMPubKey()))
+	_, err = s.msgServer.Unbond(s.Ctx, types.NewMsgUnbond(seq.Address))
+	s.Require().NoError(err)
+	s.Require().NoError(s.k().PunishSequencer(s.Ctx, seq.Address, nil))
+	s.Require().True(ucoin.SimpleMul(bond, 2).IsEqual(s.moduleBalance()))
+	s.requireInvariants()
 }
 
 func (s *SequencerTestSuite) TestUnbondRestrictions() {
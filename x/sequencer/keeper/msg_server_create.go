@@ -36,7 +36,7 @@ func (k msgServer) CreateSequencer(goCtx context.Context, msg *types.MsgCreateSe
 		return nil, gerrc.ErrAlreadyExists.Wrap("pub key in use")
 	}
 
-	if err := k.sufficientBond(ctx, msg.RollappId, msg.Bond); err != nil {
+	if err := k.sufficientSelfBond(ctx, msg.RollappId, msg.Bond); err != nil {
 		return nil, err
 	}
 
@@ -85,7 +85,8 @@ func (k msgServer) CreateSequencer(goCtx context.Context, msg *types.MsgCreateSe
 	seq.Address = msg.Creator
 	seq.Status = types.Bonded
 	seq.Metadata = msg.Metadata
-	seq.OptedIn = true
+	// without the full min bond, the sequencer needs delegations before it can opt in
+	seq.OptedIn = k.sufficientBond(ctx, msg.RollappId, msg.Bond) == nil
 	seq.SetWhitelistedRelayers(msg.WhitelistedRelayers)
 
 	if err := k.sendToModule(ctx, seq, msg.Bond); err != nil {
@@ -93,6 +94,9 @@ func (k msgServer) CreateSequencer(goCtx context.Context, msg *types.MsgCreateSe
 	}
 
 	k.SetSequencer(ctx, *seq)
+	if err := k.IndexSnapshots(ctx, *seq, nil); err != nil {
+		return nil, errorsmod.Wrap(err, "index snapshots")
+	}
 	if err := k.SetSequencerByDymintAddr(ctx, pkAddr, seq.Address); err != nil {
 		return nil, errorsmod.Wrapf(err, "set sequencer by dymint addr: %s: proposer hash: %x", seq.Address, pkAddr)
 	}
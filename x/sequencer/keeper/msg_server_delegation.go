@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (k msgServer) Delegate(goCtx context.Context, msg *types.MsgDelegate) (*types.MsgDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	seq, err := k.RealSequencer(ctx, msg.Sequencer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.delegate(ctx, sdk.MustAccAddressFromBech32(msg.Delegator), &seq, msg.Amount); err != nil {
+		return nil, errorsmod.Wrap(err, "delegate")
+	}
+	k.SetSequencer(ctx, seq)
+
+	return &types.MsgDelegateResponse{}, nil
+}
+
+// Undelegate does not check the remaining bond: like slashing, it can bring the bond below the minimum.
+func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (*types.MsgUndelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	seq, err := k.RealSequencer(ctx, msg.Sequencer)
+	if err != nil {
+		return nil, err
+	}
+
+	completion, err := k.undelegate(ctx, sdk.MustAccAddressFromBech32(msg.Delegator), &seq, msg.Amount)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "undelegate")
+	}
+	k.SetSequencer(ctx, seq)
+
+	return &types.MsgUndelegateResponse{CompletionTime: completion}, nil
+}
+
+// UpdateCommission sets the commission and points the reward address to the reward pool, which
+// is required for accepting delegations. While there are delegations, the rate can only move by the
+// max change rate, once per change interval.
+func (k msgServer) UpdateCommission(goCtx context.Context, msg *types.MsgUpdateCommission) (*types.MsgUpdateCommissionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	seq, err := k.RealSequencer(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.validCommissionChange(ctx, seq, msg.CommissionRate); err != nil {
+		return nil, err
+	}
+
+	// pay out what was collected under the old rate
+	if err := k.distributeRewards(ctx, seq); err != nil {
+		return nil, errorsmod.Wrap(err, "distribute rewards")
+	}
+
+	rate := msg.CommissionRate
+	seq.CommissionRate = &rate
+	seq.CommissionUpdateTime = ctx.BlockTime()
+	seq.RewardAddr = types.RewardPoolAddr(seq.Address).String()
+	k.SetSequencer(ctx, seq)
+
+	return &types.MsgUpdateCommissionResponse{}, nil
+}
+
+func (k msgServer) DistributeRewards(goCtx context.Context, msg *types.MsgDistributeRewards) (*types.MsgDistributeRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	seq, err := k.RealSequencer(ctx, msg.Sequencer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.distributeRewards(ctx, seq); err != nil {
+		return nil, errorsmod.Wrap(err, "distribute rewards")
+	}
+
+	return &types.MsgDistributeRewardsResponse{}, nil
+}
@@ -0,0 +1,284 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/utest"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (s *SequencerTestSuite) requireInvariants() {
+	msg, broken := keeper.AllInvariants(*s.k())(s.Ctx)
+	s.Require().False(broken, msg)
+}
+
+func (s *SequencerTestSuite) setRewardDenoms(denoms ...string) {
+	params := s.k().GetParams(s.Ctx)
+	params.RewardDenoms = denoms
+	s.k().SetParams(s.Ctx, params)
+}
+
+func (s *SequencerTestSuite) TestDelegateAndUndelegate() {
+	ra := s.createRollapp()
+	seq := s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	s.fundSequencer(bob, bond)
+
+	// delegations are only accepted once the reward pool is used
+	_, err := s.msgServer.Delegate(s.Ctx, types.NewMsgDelegate(pkAddr(bob), seq.Address, bond))
+	utest.IsErr(s.Require(), err, types.ErrDelegationNotEnabled)
+
+	_, err = s.msgServer.UpdateCommission(s.Ctx, types.NewMsgUpdateCommission(seq.Address, math.LegacyMustNewDecFromStr("0.1")))
+	s.Require().NoError(err)
+	seq = s.seq(alice)
+	s.Require().Equal(types.RewardPoolAddr(seq.Address).String(), seq.RewardAddr)
+
+	_, err = s.msgServer.Delegate(s.Ctx, types.NewMsgDelegate(pkAddr(bob), seq.Address, bond))
+	s.Require().NoError(err)
+	seq = s.seq(alice)
+	s.Require().True(bond.Add(bond).IsEqual(seq.TokensCoin()))
+	s.Require().True(bond.Add(bond).IsEqual(s.moduleBalance()))
+
+	// the reward address cannot move away from the pool while there are delegations
+	_, err = s.msgServer.UpdateRewardAddress(s.Ctx, &types.MsgUpdateRewardAddress{Creator: seq.Address, RewardAddr: seq.Address})
+	utest.IsErr(s.Require(), err, gerrc.ErrFailedPrecondition)
+
+	// the delegator cannot take out more than delegated
+	_, err = s.msgServer.Undelegate(s.Ctx, types.NewMsgUndelegate(pkAddr(bob), seq.Address, bond.Add(bond)))
+	utest.IsErr(s.Require(), err, gerrc.ErrOutOfRange)
+
+	half := bond
+	half.Amount = half.Amount.QuoRaw(2)
+	res, err := s.msgServer.Undelegate(s.Ctx, types.NewMsgUndelegate(pkAddr(bob), seq.Address, half))
+	s.Require().NoError(err)
+	s.Require().Equal(s.Ctx.BlockTime().Add(s.k().GetParams(s.Ctx).DelegationUnbondingPeriod), res.CompletionTime)
+	seq = s.seq(alice)
+	s.Require().True(bond.Add(half).IsEqual(seq.TokensCoin()))
+	s.requireInvariants()
+
+	// not returned before the completion time
+	s.Require().NoError(s.k().CompleteUndelegations(s.Ctx, res.CompletionTime.Add(-1)))
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, pkAcc(bob), bond.Denom).IsZero())
+
+	s.Require().NoError(s.k().CompleteUndelegations(s.Ctx, res.CompletionTime))
+	s.Require().True(half.Equal(s.App.BankKeeper.GetBalance(s.Ctx, pkAcc(bob), bond.Denom)))
+	undels, err := s.k().DelegatorUndelegations(s.Ctx, pkAddr(bob))
+	s.Require().NoError(err)
+	s.Require().Empty(undels)
+	s.requireInvariants()
+}
+
+func (s *SequencerTestSuite) TestCompleteUndelegationsSkipsFailed() {
+	now := s.Ctx.BlockTime()
+	err := bankutil.FundModuleAccount(s.Ctx, s.App.BankKeeper, types.ModuleName, sdk.NewCoins(bond))
+	s.Require().NoError(err)
+
+	// the module does not hold enough for bob
+	s.Require().NoError(s.k().SetUndelegation(s.Ctx, types.Undelegation{
+		Delegator: pkAddr(bob), Sequencer: pkAddr(alice), Amount: bond.Add(bond), CompletionTime: now,
+	}))
+	s.Require().NoError(s.k().SetUndelegation(s.Ctx, types.Undelegation{
+		Delegator: pkAddr(david), Sequencer: pkAddr(alice), Amount: bond, CompletionTime: now,
+	}))
+
+	s.Require().NoError(s.k().CompleteUndelegations(s.Ctx, now))
+	s.Require().True(bond.Equal(s.App.BankKeeper.GetBalance(s.Ctx, pkAcc(david), bond.Denom)))
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, pkAcc(bob), bond.Denom).IsZero())
+	undels, err := s.k().AllUndelegations(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(undels, 1)
+	s.Require().Equal(pkAddr(bob), undels[0].Delegator)
+}
+
+func (s *SequencerTestSuite) TestDecreaseBondWithDelegations() {
+	ra := s.createRollapp()
+	seq := s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, charlie, bond) // alice is proposer, so charlie can unbond
+	seq = s.seq(charlie)
+
+	_, err := s.msgServer.UpdateCommission(s.Ctx, types.NewMsgUpdateCommission(seq.Address, math.LegacyZeroDec()))
+	s.Require().NoError(err)
+	s.fundSequencer(bob, bond)
+	_, err = s.msgServer.Delegate(s.Ctx, types.NewMsgDelegate(pkAddr(bob), seq.Address, bond))
+	s.Require().NoError(err)
+
+	half := bond
+	half.Amount = half.Amount.QuoRaw(2)
+
+	// by default the sequencer must provide the whole min bond itself
+	_, err = s.msgServer.DecreaseBond(s.Ctx, types.NewMsgDecreaseBond(seq.Address, half))
+	utest.IsErr(s.Require(), err, types.ErrUnbondNotAllowed)
+
+	params := s.k().GetParams(s.Ctx)
+	params.MinSelfBondFraction = math.LegacyMustNewDecFromStr("0.5")
+	s.k().SetParams(s.Ctx, params)
+
+	_, err = s.msgServer.DecreaseBond(s.Ctx, types.NewMsgDecreaseBond(seq.Address, half))
+	s.Require().NoError(err)
+	selfBond, err := s.k().SelfBond(s.Ctx, s.seq(charlie))
+	s.Require().NoError(err)
+	s.Require().True(half.Equal(selfBond))
+
+	// a total unbond only returns the self bond
+	_, err = s.msgServer.Unbond(s.Ctx, types.NewMsgUnbond(seq.Address))
+	s.Require().NoError(err)
+	seq = s.seq(charlie)
+	s.Require().False(seq.Bonded())
+	s.Require().True(bond.Equal(seq.TokensCoin()))
+	s.requireInvariants()
+}
+
+func (s *SequencerTestSuite) TestDistributeRewards() {
+	s.setRewardDenoms("foo")
+	ra := s.createRollapp()
+	seq := s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	_, err := s.msgServer.UpdateCommission(s.Ctx, types.NewMsgUpdateCommission(seq.Address, math.LegacyMustNewDecFromStr("0.1")))
+	s.Require().NoError(err)
+
+	// bob has half of the bond
+	s.fundSequencer(bob, bond)
+	_, err = s.msgServer.Delegate(s.Ctx, types.NewMsgDelegate(pkAddr(bob), seq.Address, bond))
+	s.Require().NoError(err)
+
+	// bar is not a reward denom
+	dust := sdk.NewCoin("bar", math.NewInt(1000))
+	rewards := sdk.NewCoins(sdk.NewCoin("foo", math.NewInt(1000)), dust)
+	err = bankutil.FundAccount(s.Ctx, s.App.BankKeeper, types.RewardPoolAddr(seq.Address), rewards)
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.DistributeRewards(s.Ctx, types.NewMsgDistributeRewards(pkAddr(bob), seq.Address))
+	s.Require().NoError(err)
+
+	// 100 commission, the rest split in half
+	s.Require().Equal(math.NewInt(450), s.App.BankKeeper.GetBalance(s.Ctx, pkAcc(bob), "foo").Amount)
+	s.Require().Equal(math.NewInt(550), s.App.BankKeeper.GetBalance(s.Ctx, pkAcc(alice), "foo").Amount)
+	s.Require().Equal(sdk.NewCoins(dust), s.App.BankKeeper.GetAllBalances(s.Ctx, types.RewardPoolAddr(seq.Address)))
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, pkAcc(bob), "bar").IsZero())
+}
+
+func (s *SequencerTestSuite) TestUpdateCommissionBounds() {
+	ra := s.createRollapp()
+	seq := s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	params := s.k().GetParams(s.Ctx)
+
+	// without delegations the rate is not bound
+	_, err := s.msgServer.UpdateCommission(s.Ctx, types.NewMsgUpdateCommission(seq.Address, math.LegacyZeroDec()))
+	s.Require().NoError(err)
+	_, err = s.msgServer.UpdateCommission(s.Ctx, types.NewMsgUpdateCommission(seq.Address, math.LegacyMustNewDecFromStr("0.1")))
+	s.Require().NoError(err)
+
+	s.fundSequencer(bob, bond)
+	_, err = s.msgServer.Delegate(s.Ctx, types.NewMsgDelegate(pkAddr(bob), seq.Address, bond))
+	s.Require().NoError(err)
+
+	small := math.LegacyMustNewDecFromStr("0.1").Add(params.MaxCommissionChangeRate)
+	_, err = s.msgServer.UpdateCommission(s.Ctx, types.NewMsgUpdateCommission(seq.Address, small))
+	utest.IsErr(s.Require(), err, types.ErrCommissionChangeTooSoon)
+
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(params.CommissionChangeInterval))
+	_, err = s.msgServer.UpdateCommission(s.Ctx, types.NewMsgUpdateCommission(seq.Address, math.LegacyOneDec()))
+	utest.IsErr(s.Require(), err, types.ErrCommissionChangeTooLarge)
+	_, err = s.msgServer.UpdateCommission(s.Ctx, types.NewMsgUpdateCommission(seq.Address, small))
+	s.Require().NoError(err)
+	s.Require().Equal(small, s.seq(alice).Commission())
+	s.Require().Equal(s.Ctx.BlockTime(), s.seq(alice).CommissionUpdateTime)
+
+	// the interval starts again from the last change
+	_, err = s.msgServer.UpdateCommission(s.Ctx, types.NewMsgUpdateCommission(seq.Address, math.LegacyMustNewDecFromStr("0.1")))
+	utest.IsErr(s.Require(), err, types.ErrCommissionChangeTooSoon)
+}
+
+func (s *SequencerTestSuite) TestDelegationOnlyEarnsLaterRewards() {
+	s.setRewardDenoms("foo")
+	ra := s.createRollapp()
+	seq := s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	_, err := s.msgServer.UpdateCommission(s.Ctx, types.NewMsgUpdateCommission(seq.Address, math.LegacyMustNewDecFromStr("0.1")))
+	s.Require().NoError(err)
+
+	s.fundSequencer(bob, bond)
+	_, err = s.msgServer.Delegate(s.Ctx, types.NewMsgDelegate(pkAddr(bob), seq.Address, bond))
+	s.Require().NoError(err)
+
+	fund := func() {
+		rewards := sdk.NewCoins(sdk.NewCoin("foo", math.NewInt(1200)))
+		err := bankutil.FundAccount(s.Ctx, s.App.BankKeeper, types.RewardPoolAddr(seq.Address), rewards)
+		s.Require().NoError(err)
+	}
+	balance := func(addr string) math.Int {
+		return s.App.BankKeeper.GetBalance(s.Ctx, sdk.MustAccAddressFromBech32(addr), "foo").Amount
+	}
+
+	// david delegates right before the payout of rewards collected while only alice and bob were bonded
+	fund()
+	s.fundSequencer(david, bond)
+	_, err = s.msgServer.Delegate(s.Ctx, types.NewMsgDelegate(pkAddr(david), seq.Address, bond))
+	s.Require().NoError(err)
+	_, err = s.msgServer.DistributeRewards(s.Ctx, types.NewMsgDistributeRewards(pkAddr(bob), seq.Address))
+	s.Require().NoError(err)
+
+	// 120 commission, the rest split in half between alice and bob
+	s.Require().True(balance(pkAddr(david)).IsZero())
+	s.Require().Equal(math.NewInt(540), balance(pkAddr(bob)))
+	s.Require().Equal(math.NewInt(660), balance(pkAddr(alice)))
+
+	// the next rewards are split in thirds, undelegating pays what is owed
+	fund()
+	_, err = s.msgServer.Undelegate(s.Ctx, types.NewMsgUndelegate(pkAddr(david), seq.Address, bond))
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(360), balance(pkAddr(david)))
+	s.Require().Equal(math.NewInt(540), balance(pkAddr(bob)))
+
+	_, err = s.msgServer.DistributeRewards(s.Ctx, types.NewMsgDistributeRewards(pkAddr(bob), seq.Address))
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(900), balance(pkAddr(bob)))
+	s.Require().Equal(math.NewInt(1140), balance(pkAddr(alice)))
+	s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, types.RewardPoolAddr(seq.Address)).IsZero())
+	s.requireInvariants()
+}
+
+func (s *SequencerTestSuite) TestSlashDelegationsProRata() {
+	ra := s.createRollapp()
+	seq := s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	_, err := s.msgServer.UpdateCommission(s.Ctx, types.NewMsgUpdateCommission(seq.Address, math.LegacyZeroDec()))
+	s.Require().NoError(err)
+
+	s.fundSequencer(bob, bond)
+	_, err = s.msgServer.Delegate(s.Ctx, types.NewMsgDelegate(pkAddr(bob), seq.Address, bond))
+	s.Require().NoError(err)
+	s.fundSequencer(david, bond)
+	_, err = s.msgServer.Delegate(s.Ctx, types.NewMsgDelegate(pkAddr(david), seq.Address, bond))
+	s.Require().NoError(err)
+	// david's tokens are still slashable while in the queue
+	_, err = s.msgServer.Undelegate(s.Ctx, types.NewMsgUndelegate(pkAddr(david), seq.Address, bond))
+	s.Require().NoError(err)
+
+	// alice and bob own half each
+	before := s.seq(alice).TokensCoin()
+	s.Require().NoError(s.k().SlashLiveness(s.Ctx, ra.RollappId))
+	slashed := before.Sub(s.seq(alice).TokensCoin())
+	s.Require().True(slashed.IsPositive())
+
+	d, ok, err := s.k().GetDelegation(s.Ctx, seq.Address, pkAddr(bob))
+	s.Require().NoError(err)
+	s.Require().True(ok)
+	s.Require().Equal(bond.Amount.Sub(slashed.Amount.QuoRaw(2)), d.Amount.Amount)
+
+	undels, err := s.k().DelegatorUndelegations(s.Ctx, pkAddr(david))
+	s.Require().NoError(err)
+	s.Require().Len(undels, 1)
+	s.Require().Equal(bond.Amount.Sub(slashed.Amount.QuoRaw(2)), undels[0].Amount.Amount)
+	s.requireInvariants()
+
+	// punishing takes everything
+	s.Require().NoError(s.k().PunishSequencer(s.Ctx, seq.Address, nil))
+	_, ok, err = s.k().GetDelegation(s.Ctx, seq.Address, pkAddr(bob))
+	s.Require().NoError(err)
+	s.Require().False(ok)
+	undels, err = s.k().DelegatorUndelegations(s.Ctx, pkAddr(david))
+	s.Require().NoError(err)
+	s.Require().True(undels[0].Amount.IsZero())
+	s.requireInvariants()
+}
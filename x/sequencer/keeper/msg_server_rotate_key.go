@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// RotateSequencerKey schedules a new dymint key for the sequencer. The sequencer keeps its bond and status.
+func (k msgServer) RotateSequencerKey(goCtx context.Context, msg *types.MsgRotateSequencerKey) (*types.MsgRotateSequencerKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	seq, err := k.RealSequencer(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.rotateKey(ctx, &seq, msg.NewDymintPubKey, msg.EffectiveHeight); err != nil {
+		return nil, errorsmod.Wrap(err, "rotate key")
+	}
+	k.SetSequencer(ctx, seq)
+
+	return &types.MsgRotateSequencerKeyResponse{}, nil
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/utest"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (s *SequencerTestSuite) TestRotateSequencerKey() {
+	ra := s.createRollapp()
+	seq := s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, bob, bond)
+	s.Require().True(s.k().IsProposer(s.Ctx, seq))
+	s.submitAFewRollappStates(ra.RollappId)
+	latest, _ := s.raK().GetLatestHeight(s.Ctx, ra.RollappId)
+
+	newPk := randomTMPubKey()
+	m, err := types.NewMsgRotateSequencerKey(seq.Address, newPk, latest)
+	s.Require().NoError(err)
+	_, err = s.msgServer.RotateSequencerKey(s.Ctx, m)
+	utest.IsErr(s.Require(), err, gerrc.ErrOutOfRange)
+
+	m, err = types.NewMsgRotateSequencerKey(seq.Address, bob, latest+5)
+	s.Require().NoError(err)
+	_, err = s.msgServer.RotateSequencerKey(s.Ctx, m)
+	utest.IsErr(s.Require(), err, gerrc.ErrAlreadyExists)
+
+	m, err = types.NewMsgRotateSequencerKey(seq.Address, newPk, latest+5)
+	s.Require().NoError(err)
+	_, err = s.msgServer.RotateSequencerKey(s.Ctx, m)
+	s.Require().NoError(err)
+
+	// only one pending rotation at a time
+	m, err = types.NewMsgRotateSequencerKey(seq.Address, randomTMPubKey(), latest+6)
+	s.Require().NoError(err)
+	_, err = s.msgServer.RotateSequencerKey(s.Ctx, m)
+	utest.IsErr(s.Require(), err, gerrc.ErrFailedPrecondition)
+
+	// the rollapp has not reached the effective height yet
+	s.Require().Equal(alice.Address().Bytes(), s.seq(alice).MustProposerAddr())
+	before, err := s.k().SequencerAtHeight(s.Ctx, seq.Address, latest+4)
+	s.Require().NoError(err)
+	s.Require().Equal(alice.Address().Bytes(), before.MustProposerAddr())
+	after, err := s.k().SequencerAtHeight(s.Ctx, seq.Address, latest+5)
+	s.Require().NoError(err)
+	s.Require().Equal(newPk.Address().Bytes(), after.MustProposerAddr())
+
+	// both keys are attributed to the sequencer
+	for _, pk := range []cryptotypes.PubKey{alice, newPk} {
+		got, err := s.k().SequencerByDymintAddr(s.Ctx, pk.Address())
+		s.Require().NoError(err)
+		s.Require().Equal(seq.Address, got.Address)
+	}
+
+	// the rollapp passes the effective height
+	_, err = s.PostStateUpdate(s.Ctx, ra.RollappId, seq.Address, latest+1, 10)
+	s.Require().NoError(err)
+	s.Require().Equal(newPk.Address().Bytes(), s.seq(alice).MustProposerAddr())
+	rotations, err := s.k().SequencerKeyRotations(s.Ctx, seq.Address)
+	s.Require().NoError(err)
+	s.Require().Len(rotations, 1)
+	s.requireInvariants()
+}
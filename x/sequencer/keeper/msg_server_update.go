@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
@@ -30,7 +31,11 @@ func (k msgServer) UpdateSequencerInformation(
 		return nil, err
 	}
 
+	prev := seq.Metadata.Snapshots
 	seq.Metadata = msg.Metadata
+	if err := k.IndexSnapshots(ctx, seq, prev); err != nil {
+		return nil, errorsmod.Wrap(err, "index snapshots")
+	}
 
 	if err := uevent.EmitTypedEvent(ctx, &seq); err != nil {
 		return nil, fmt.Errorf("emit event: %w", err)
@@ -56,6 +61,12 @@ func (k msgServer) UpdateOptInStatus(goCtx context.Context,
 sequencers can only be proposer at most once`)
 	}
 
+	if msg.OptedIn {
+		if err := k.sufficientBond(ctx, seq.RollappId, seq.TokensCoin()); err != nil {
+			return nil, errorsmod.Wrap(err, "opt in")
+		}
+	}
+
 	if err := seq.SetOptedIn(ctx, msg.OptedIn); err != nil {
 		return nil, err
 	}
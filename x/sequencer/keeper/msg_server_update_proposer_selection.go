@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// UpdateProposerSelection sets the algorithm used the next time a proposer or successor is chosen.
+// It does not affect the current proposer.
+func (k msgServer) UpdateProposerSelection(goCtx context.Context, msg *types.MsgUpdateProposerSelection) (*types.MsgUpdateProposerSelectionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rollapp, found := k.rollappKeeper.GetRollapp(ctx, msg.RollappId)
+	if !found {
+		return nil, rollapptypes.ErrRollappNotFound
+	}
+	if rollapp.Owner != msg.Owner {
+		return nil, types.ErrUnauthorizedSigner
+	}
+
+	sel, err := k.GetProposerSelection(ctx, msg.RollappId)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "get proposer selection")
+	}
+	sel.Algo = msg.Algo
+	if err := k.SetProposerSelection(ctx, sel); err != nil {
+		return nil, errorsmod.Wrap(err, "set proposer selection")
+	}
+
+	return &types.MsgUpdateProposerSelectionResponse{}, uevent.EmitTypedEvent(ctx, &types.EventUpdateProposerSelection{
+		Rollapp: msg.RollappId,
+		Algo:    msg.Algo,
+	})
+}
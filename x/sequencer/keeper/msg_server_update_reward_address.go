@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 
 	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
@@ -17,6 +18,17 @@ func (k msgServer) UpdateRewardAddress(goCtx context.Context, msg *types.MsgUpda
 	if err != nil {
 		return nil, err
 	}
+
+	if seq.DelegationEnabled() && seq.RewardAddr != msg.RewardAddr {
+		delegated, err := k.DelegatedTokens(ctx, seq.Address)
+		if err != nil {
+			return nil, fmt.Errorf("delegated tokens: %w", err)
+		}
+		if !delegated.IsZero() {
+			return nil, gerrc.ErrFailedPrecondition.Wrap("reward address must stay the reward pool while there are delegations")
+		}
+	}
+
 	defer func() {
 		k.SetSequencer(ctx, seq)
 	}()
@@ -11,24 +11,36 @@ import (
 )
 
 // when the proposer did a state update
-func (k Keeper) afterStateUpdate(ctx sdk.Context, prop types.Sequencer, last bool) error {
+func (k Keeper) afterStateUpdate(ctx sdk.Context, prop types.Sequencer, numBlocks uint64, last bool) error {
+	before := prop.GetPenalty()
 	k.reducePenaltyUptime(ctx, &prop)
 	k.SetSequencer(ctx, prop)
+	err := k.updateReputation(ctx, prop.Address, func(r *types.Reputation) {
+		r.StateUpdates++
+		r.BlocksCovered += numBlocks
+		r.DishonorRemoved += before - prop.GetPenalty()
+	})
+	if err != nil {
+		return errorsmod.Wrap(err, "update reputation")
+	}
 	if last {
 		return k.OnProposerLastBlock(ctx, prop)
 	}
 	return nil
 }
 
-func (k Keeper) abruptRemoveProposer(ctx sdk.Context, rollapp string) {
+func (k Keeper) abruptRemoveProposer(ctx sdk.Context, rollapp string) error {
 	proposer := k.GetProposer(ctx, rollapp)
 	if proposer.Sentinel() {
-		return
+		return nil
 	}
 	k.removeFromNoticeQueue(ctx, proposer)
-	k.unbond(ctx, &proposer)
+	if err := k.unbond(ctx, &proposer); err != nil {
+		return errorsmod.Wrap(err, "unbond")
+	}
 	k.SetSequencer(ctx, proposer)
 	k.SetProposer(ctx, rollapp, types.SentinelSeqAddr)
+	return nil
 }
 
 // OptOutAllSequencers : change every sequencer of the rollapp to be opted out.
@@ -62,7 +74,7 @@ func (k Keeper) ChooseProposerAfterSentinel(ctx sdk.Context, rollapp string) err
 		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "proposer is not sentinel")
 	}
 
-	successor, err := ProposerChoiceAlgo(k.RollappPotentialProposers(ctx, rollapp))
+	successor, err := k.chooseProposer(ctx, rollapp, k.RollappPotentialProposers(ctx, rollapp))
 	if err != nil {
 		return err
 	}
@@ -70,6 +82,9 @@ func (k Keeper) ChooseProposerAfterSentinel(ctx sdk.Context, rollapp string) err
 		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "no valid proposer found")
 	}
 	k.SetProposer(ctx, rollapp, successor.Address)
+	if err := k.updateReputation(ctx, successor.Address, func(r *types.Reputation) { r.ProposerTenures++ }); err != nil {
+		return errorsmod.Wrap(err, "update reputation")
+	}
 
 	err = k.hooks.AfterSetRealProposer(ctx, rollapp, successor)
 	if err != nil {
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"slices"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// GetProposerSelection returns the default (highest bond) if the rollapp never set one
+func (k Keeper) GetProposerSelection(ctx sdk.Context, rollapp string) (types.ProposerSelection, error) {
+	sel, err := k.proposerSelections.Get(ctx, rollapp)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ProposerSelection{RollappId: rollapp, Algo: types.HighestBond}, nil
+	}
+	return sel, err
+}
+
+func (k Keeper) SetProposerSelection(ctx sdk.Context, sel types.ProposerSelection) error {
+	return k.proposerSelections.Set(ctx, sel.RollappId, sel)
+}
+
+func (k Keeper) AllProposerSelections(ctx sdk.Context) ([]types.ProposerSelection, error) {
+	iter, err := k.proposerSelections.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// chooseProposer picks from seqs with the algorithm selected by the rollapp.
+// Requires sentinel to be passed in, as last resort.
+func (k Keeper) chooseProposer(ctx sdk.Context, rollapp string, seqs []types.Sequencer) (types.Sequencer, error) {
+	sel, err := k.GetProposerSelection(ctx, rollapp)
+	if err != nil {
+		return types.Sequencer{}, errorsmod.Wrap(err, "get proposer selection")
+	}
+
+	switch sel.Algo {
+	case types.BondWeightedRandom:
+		return BondWeightedRandomChoiceAlgo(seqs, selectionEntropy(ctx, rollapp))
+	case types.RoundRobin:
+		chosen, err := RoundRobinChoiceAlgo(seqs, sel.LastSelected)
+		if err != nil || chosen.Sentinel() {
+			return chosen, err
+		}
+		sel.LastSelected = chosen.Address
+		return chosen, errorsmod.Wrap(k.SetProposerSelection(ctx, sel), "set proposer selection")
+	case types.DishonorDiscountedBond:
+		return DishonorDiscountedChoiceAlgo(seqs, k.GetParams(ctx).PenaltyKickThreshold())
+	default:
+		return ProposerChoiceAlgo(seqs)
+	}
+}
+
+// selectionEntropy derives a seed from the block hash. The rollapp id separates choices made in the same block.
+// This is not unbiasable randomness: the hub block proposer knows the header hash in advance and can grind it,
+// e.g. by reordering or dropping transactions, to bias the choice. It only prevents the rollapp sequencers
+// from predicting the choice, rollapps which need stronger guarantees should use a deterministic algorithm.
+func selectionEntropy(ctx sdk.Context, rollapp string) []byte {
+	h := sha256.New()
+	h.Write(ctx.HeaderHash())
+	h.Write(binary.BigEndian.AppendUint64(nil, uint64(ctx.BlockHeight())))
+	h.Write([]byte(rollapp))
+	return h.Sum(nil)
+}
+
+// splitSentinel separates the real sequencers, sorted by address, from the sentinel
+func splitSentinel(seqs []types.Sequencer) ([]types.Sequencer, types.Sequencer, error) {
+	i := slices.IndexFunc(seqs, types.Sequencer.Sentinel)
+	if i < 0 {
+		return nil, types.Sequencer{}, gerrc.ErrInternal.Wrap("seqs must at least include sentinel")
+	}
+	sentinel := seqs[i]
+	cands := slices.DeleteFunc(slices.Clone(seqs), types.Sequencer.Sentinel)
+	slices.SortFunc(cands, func(a, b types.Sequencer) int {
+		return strings.Compare(a.Address, b.Address)
+	})
+	return cands, sentinel, nil
+}
+
+// BondWeightedRandomChoiceAlgo : choose randomly, with a probability proportional to the bond
+// Requires sentinel to be passed in, as last resort.
+func BondWeightedRandomChoiceAlgo(seqs []types.Sequencer, entropy []byte) (types.Sequencer, error) {
+	cands, sentinel, err := splitSentinel(seqs)
+	if err != nil {
+		return types.Sequencer{}, err
+	}
+	total := math.ZeroInt()
+	for _, seq := range cands {
+		total = total.Add(seq.TokensCoin().Amount)
+	}
+	if !total.IsPositive() {
+		if len(cands) == 0 {
+			return sentinel, nil
+		}
+		return cands[0], nil
+	}
+	r := math.NewIntFromBigInt(new(big.Int).Mod(new(big.Int).SetBytes(entropy), total.BigInt()))
+	cum := math.ZeroInt()
+	for _, seq := range cands {
+		cum = cum.Add(seq.TokensCoin().Amount)
+		if r.LT(cum) {
+			return seq, nil
+		}
+	}
+	return types.Sequencer{}, gerrc.ErrInternal.Wrap("weighted choice out of range")
+}
+
+// RoundRobinChoiceAlgo : choose the next sequencer in address order after the last one chosen
+// Requires sentinel to be passed in, as last resort.
+func RoundRobinChoiceAlgo(seqs []types.Sequencer, last string) (types.Sequencer, error) {
+	cands, sentinel, err := splitSentinel(seqs)
+	if err != nil {
+		return types.Sequencer{}, err
+	}
+	if len(cands) == 0 {
+		return sentinel, nil
+	}
+	for _, seq := range cands {
+		if last < seq.Address {
+			return seq, nil
+		}
+	}
+	return cands[0], nil
+}
+
+// DishonorDiscountedChoiceAlgo : choose the one with most bond, after scaling the bond down linearly
+// with the dishonor. A sequencer at the kick threshold has a score of zero.
+// Requires sentinel to be passed in, as last resort.
+func DishonorDiscountedChoiceAlgo(seqs []types.Sequencer, kickThreshold uint64) (types.Sequencer, error) {
+	if !slices.ContainsFunc(seqs, types.Sequencer.Sentinel) {
+		return types.Sequencer{}, gerrc.ErrInternal.Wrap("seqs must at least include sentinel")
+	}
+	score := func(seq types.Sequencer) math.Int {
+		tokens := seq.TokensCoin().Amount
+		if kickThreshold == 0 {
+			return tokens
+		}
+		honor := kickThreshold - min(seq.GetPenalty(), kickThreshold)
+		return tokens.Mul(math.NewIntFromUint64(honor)).Quo(math.NewIntFromUint64(kickThreshold))
+	}
+	best := 0
+	bestScore := score(seqs[0])
+	for i := 1; i < len(seqs); i++ {
+		// strict, to stay stable like the default algo
+		if s := score(seqs[i]); bestScore.LT(s) {
+			best, bestScore = i, s
+		}
+	}
+	return seqs[best], nil
+}
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/sdk-utils/utils/ucoin"
+	"github.com/dymensionxyz/sdk-utils/utils/utest"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func selectionTestSeqs() []types.Sequencer {
+	return []types.Sequencer{
+		{Address: "2", Tokens: sdk.NewCoins(ucoin.SimpleMul(bond, 3))},
+		{Address: "0", Tokens: sdk.NewCoins(ucoin.SimpleMul(bond, 1))},
+		{Address: "1", Tokens: sdk.NewCoins(ucoin.SimpleMul(bond, 2)), Dishonor: 800},
+		{Address: types.SentinelSeqAddr, Tokens: sdk.Coins{ucoin.SimpleMul(bond, 0)}},
+	}
+}
+
+func TestRoundRobinChoiceAlgo(t *testing.T) {
+	for _, tc := range []struct {
+		last string
+		want string
+	}{
+		{"", "0"},
+		{"0", "1"},
+		{"1", "2"},
+		{"2", "0"}, // wraps
+	} {
+		got, err := keeper.RoundRobinChoiceAlgo(selectionTestSeqs(), tc.last)
+		require.NoError(t, err)
+		require.Equal(t, tc.want, got.Address)
+	}
+
+	got, err := keeper.RoundRobinChoiceAlgo(selectionTestSeqs()[3:], "")
+	require.NoError(t, err)
+	require.True(t, got.Sentinel())
+}
+
+func TestBondWeightedRandomChoiceAlgo(t *testing.T) {
+	// in address order, the cumulative bonds are 1, 3, 6 (in units of bond)
+	for _, tc := range []struct {
+		r    int64
+		want string
+	}{
+		{0, "0"},
+		{1, "1"},
+		{3, "2"},
+		{6, "0"}, // wraps with the modulo
+	} {
+		entropy := ucoin.SimpleMul(bond, tc.r).Amount.BigInt().Bytes()
+		got, err := keeper.BondWeightedRandomChoiceAlgo(selectionTestSeqs(), entropy)
+		require.NoError(t, err)
+		require.Equal(t, tc.want, got.Address, "r: %d", tc.r)
+	}
+
+	_, err := keeper.BondWeightedRandomChoiceAlgo(selectionTestSeqs()[:3], nil)
+	require.Error(t, err)
+}
+
+func TestDishonorDiscountedChoiceAlgo(t *testing.T) {
+	// 2 has the most bond
+	got, err := keeper.DishonorDiscountedChoiceAlgo(selectionTestSeqs(), 900)
+	require.NoError(t, err)
+	require.Equal(t, "2", got.Address)
+
+	// with a lot of dishonor, 2 falls behind 1
+	seqs := selectionTestSeqs()
+	seqs[0].Dishonor = 700
+	seqs[2].Dishonor = 0
+	got, err = keeper.DishonorDiscountedChoiceAlgo(seqs, 900)
+	require.NoError(t, err)
+	require.Equal(t, "1", got.Address)
+}
+
+func (s *SequencerTestSuite) TestUpdateProposerSelection() {
+	ra := s.createRollapp()
+
+	_, err := s.msgServer.UpdateProposerSelection(s.Ctx, types.NewMsgUpdateProposerSelection(pkAddr(alice), ra.RollappId, types.RoundRobin))
+	utest.IsErr(s.Require(), err, types.ErrUnauthorizedSigner)
+
+	_, err = s.msgServer.UpdateProposerSelection(s.Ctx, types.NewMsgUpdateProposerSelection(ra.Owner, ra.RollappId, types.RoundRobin))
+	s.Require().NoError(err)
+
+	// the proposer choice goes through the round robin
+	seq := s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	s.Require().True(s.k().IsProposer(s.Ctx, seq))
+	sel, err := s.k().GetProposerSelection(s.Ctx, ra.RollappId)
+	s.Require().NoError(err)
+	s.Require().Equal(types.RoundRobin, sel.Algo)
+	s.Require().Equal(seq.Address, sel.LastSelected)
+}
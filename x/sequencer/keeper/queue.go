@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/codec"
+	"cosmossdk.io/collections/indexes"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// tokenQueue holds tokens in the module until their completion time, like undelegated or unbonded tokens.
+// The keys must start with the completion time. Queued tokens can still be slashed, so the entries are
+// indexed by sequencer.
+type tokenQueue[K, V any] struct {
+	entries *collections.IndexedMap[K, V, tokenQueueIndexes[K, V]]
+	// amount gives access to the queued tokens of an entry
+	amount func(*V) *sdk.Coin
+	// after returns the smallest key with a completion time after t
+	after func(t time.Time) K
+}
+
+type tokenQueueIndexes[K, V any] struct {
+	Sequencer *indexes.Multi[string, K, V]
+}
+
+func (i tokenQueueIndexes[K, V]) IndexesList() []collections.Index[K, V] {
+	return []collections.Index[K, V]{i.Sequencer}
+}
+
+func newTokenQueue[K, V any](
+	sb *collections.SchemaBuilder,
+	prefix collections.Prefix,
+	bySequencerPrefix collections.Prefix,
+	name string,
+	keyCodec codec.KeyCodec[K],
+	valueCodec codec.ValueCodec[V],
+	sequencer func(V) string,
+	amount func(*V) *sdk.Coin,
+	after func(time.Time) K,
+) tokenQueue[K, V] {
+	return tokenQueue[K, V]{
+		entries: collections.NewIndexedMap(
+			sb,
+			prefix,
+			name,
+			keyCodec,
+			valueCodec,
+			tokenQueueIndexes[K, V]{
+				Sequencer: indexes.NewMulti(
+					sb,
+					bySequencerPrefix,
+					name+"BySequencer",
+					collections.StringKey,
+					keyCodec,
+					func(_ K, v V) (string, error) {
+						return sequencer(v), nil
+					},
+				),
+			},
+		),
+		amount: amount,
+		after:  after,
+	}
+}
+
+// add adds to the entry with the same key if there is one
+func (q tokenQueue[K, V]) add(ctx sdk.Context, key K, v V) error {
+	existing, err := q.entries.Get(ctx, key)
+	if err == nil {
+		*q.amount(&v) = q.amount(&v).Add(*q.amount(&existing))
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	return q.entries.Set(ctx, key, v)
+}
+
+func (q tokenQueue[K, V]) all(ctx sdk.Context) ([]V, error) {
+	iter, err := q.entries.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+func (q tokenQueue[K, V]) bySequencer(ctx sdk.Context, seqAddr string) ([]collections.KeyValue[K, V], error) {
+	iter, err := q.entries.Indexes.Sequencer.MatchExact(ctx, seqAddr)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := iter.PrimaryKeys()
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]collections.KeyValue[K, V], 0, len(keys))
+	for _, key := range keys {
+		v, err := q.entries.Get(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, collections.KeyValue[K, V]{Key: key, Value: v})
+	}
+	return ret, nil
+}
+
+// matured returns the entries whose completion time is not after now
+func (q tokenQueue[K, V]) matured(ctx sdk.Context, now time.Time) ([]collections.KeyValue[K, V], error) {
+	rng := new(collections.Range[K]).EndExclusive(q.after(now))
+	iter, err := q.entries.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	return iter.KeyValues()
+}
+
+func (q tokenQueue[K, V]) remove(ctx sdk.Context, key K) error {
+	return q.entries.Remove(ctx, key)
+}
+
+// slash reduces the queued entries of the sequencer by frac and returns the cut, which is still held by
+// the module
+func (q tokenQueue[K, V]) slash(ctx sdk.Context, seqAddr string, frac math.LegacyDec) (sdk.Coins, error) {
+	if !frac.IsPositive() {
+		return nil, nil
+	}
+	entries, err := q.bySequencer(ctx, seqAddr)
+	if err != nil {
+		return nil, err
+	}
+	var cut sdk.Coins
+	for _, kv := range entries {
+		amt := q.amount(&kv.Value)
+		c := math.MinInt(frac.MulInt(amt.Amount).TruncateInt(), amt.Amount)
+		amt.Amount = amt.Amount.Sub(c)
+		cut = cut.Add(sdk.NewCoin(amt.Denom, c))
+		if err := q.entries.Set(ctx, kv.Key, kv.Value); err != nil {
+			return nil, err
+		}
+	}
+	return cut, nil
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (k Keeper) SetReputation(ctx sdk.Context, r types.Reputation) error {
+	return k.reputations.Set(ctx, r.Sequencer, r)
+}
+
+// GetReputation returns the performance record of the sequencer, which is empty if nothing was recorded yet
+func (k Keeper) GetReputation(ctx sdk.Context, seqAddr string) (types.Reputation, error) {
+	r, err := k.reputations.Get(ctx, seqAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Reputation{Sequencer: seqAddr}, nil
+	}
+	return r, err
+}
+
+func (k Keeper) AllReputations(ctx sdk.Context) ([]types.Reputation, error) {
+	iter, err := k.reputations.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// updateReputation applies f to the performance record of the sequencer. Nothing is recorded for the sentinel.
+func (k Keeper) updateReputation(ctx sdk.Context, seqAddr string, f func(*types.Reputation)) error {
+	if seqAddr == types.SentinelSeqAddr {
+		return nil
+	}
+	r, err := k.GetReputation(ctx, seqAddr)
+	if err != nil {
+		return err
+	}
+	f(&r)
+	return k.SetReputation(ctx, r)
+}
@@ -0,0 +1,42 @@
+package keeper_test
+
+import (
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// The performance record follows state updates, liveness slashes, kicks and proposer changes
+func (s *SequencerTestSuite) TestReputation() {
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, bob, bond)
+	s.Require().True(s.k().IsProposer(s.Ctx, s.seq(alice)))
+	s.submitAFewRollappStates(ra.RollappId)
+
+	res, err := s.queryClient.Reputation(s.Ctx, &types.QueryReputationRequest{Sequencer: pkAddr(alice)})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), res.Reputation.ProposerTenures)
+	s.Require().Equal(uint64(1), res.Reputation.StateUpdates)
+	s.Require().Equal(uint64(10), res.Reputation.BlocksCovered)
+
+	// alice goes down until she can be kicked
+	for !s.k().Kickable(s.Ctx, s.seq(alice)) {
+		err := s.k().SlashLiveness(s.Ctx, ra.RollappId)
+		s.Require().NoError(err)
+	}
+	res, err = s.queryClient.Reputation(s.Ctx, &types.QueryReputationRequest{Sequencer: pkAddr(alice)})
+	s.Require().NoError(err)
+	s.Require().NotZero(res.Reputation.LivenessSlashes)
+	s.Require().Equal(res.Dishonor, res.Reputation.DishonorAdded)
+
+	_, err = s.msgServer.KickProposer(s.Ctx, &types.MsgKickProposer{Creator: pkAddr(bob)})
+	s.Require().NoError(err)
+	s.Require().True(s.k().IsProposer(s.Ctx, s.seq(bob)))
+
+	res, err = s.queryClient.Reputation(s.Ctx, &types.QueryReputationRequest{Sequencer: pkAddr(alice)})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), res.Reputation.Kicks)
+
+	res, err = s.queryClient.Reputation(s.Ctx, &types.QueryReputationRequest{Sequencer: pkAddr(bob)})
+	s.Require().NoError(err)
+	s.Require().Equal(types.Reputation{Sequencer: pkAddr(bob), ProposerTenures: 1}, res.Reputation)
+}
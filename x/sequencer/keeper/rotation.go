@@ -86,6 +86,9 @@ func (k Keeper) OnProposerLastBlock(ctx sdk.Context, proposer types.Sequencer) e
 	successor := k.GetSuccessor(ctx, rollapp)
 	k.SetSuccessor(ctx, rollapp, types.SentinelSeqAddr) // clear successor
 	k.SetProposer(ctx, rollapp, successor.Address)
+	if err := k.updateReputation(ctx, successor.Address, func(r *types.Reputation) { r.ProposerTenures++ }); err != nil {
+		return errorsmod.Wrap(err, "update reputation")
+	}
 
 	// if successor is sentinel, prepare new revision for the rollapp
 	if successor.Sentinel() {
@@ -112,7 +115,7 @@ func (k Keeper) OnProposerLastBlock(ctx sdk.Context, proposer types.Sequencer) e
 // called when a proposer has finished their notice period.
 func (k Keeper) setSuccessorForRotatingRollapp(ctx sdk.Context, rollapp string) error {
 	seqs := k.RollappPotentialProposers(ctx, rollapp)
-	successor, err := ProposerChoiceAlgo(seqs)
+	successor, err := k.chooseProposer(ctx, rollapp, seqs)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// IndexSnapshots replaces the snapshot entries of the sequencer by the snapshots of its metadata.
+// Only the snapshots of bonded sequencers are indexed.
+func (k Keeper) IndexSnapshots(ctx sdk.Context, seq types.Sequencer, prev []*types.SnapshotInfo) error {
+	if err := k.removeSnapshotEntries(ctx, seq, prev); err != nil {
+		return err
+	}
+	if !seq.Bonded() {
+		return nil
+	}
+	for _, s := range seq.Metadata.Snapshots {
+		if s == nil || s.Height == 0 {
+			continue
+		}
+		err := k.snapshots.Set(ctx, collections.Join3(seq.RollappId, s.Height, seq.Address), types.SnapshotEntry{
+			RollappId:   seq.RollappId,
+			Sequencer:   seq.Address,
+			SnapshotUrl: s.SnapshotUrl,
+			Height:      s.Height,
+			Checksum:    s.Checksum,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeSnapshots removes the snapshot entries of the sequencer
+func (k Keeper) removeSnapshots(ctx sdk.Context, seq types.Sequencer) error {
+	return k.removeSnapshotEntries(ctx, seq, seq.Metadata.Snapshots)
+}
+
+func (k Keeper) removeSnapshotEntries(ctx sdk.Context, seq types.Sequencer, snapshots []*types.SnapshotInfo) error {
+	for _, s := range snapshots {
+		if s == nil {
+			continue
+		}
+		if err := k.snapshots.Remove(ctx, collections.Join3(seq.RollappId, s.Height, seq.Address)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LatestVerifiedSnapshot returns the highest snapshot of the rollapp whose height is covered by a finalized
+// state, along with the state root at that height. The snapshot must be advertised by the sequencer which
+// produced that state.
+func (k Keeper) LatestVerifiedSnapshot(ctx sdk.Context, rollapp string) (types.QueryLatestSnapshotResponse, error) {
+	rng := new(collections.Range[collections.Triple[string, uint64, string]]).
+		Prefix(collections.TriplePrefix[string, uint64, string](rollapp)).
+		Descending()
+	iter, err := k.snapshots.Iterate(ctx, rng)
+	if err != nil {
+		return types.QueryLatestSnapshotResponse{}, err
+	}
+	defer iter.Close() // nolint: errcheck
+
+	for ; iter.Valid(); iter.Next() {
+		entry, err := iter.Value()
+		if err != nil {
+			return types.QueryLatestSnapshotResponse{}, err
+		}
+		sInfo, err := k.rollappKeeper.FindStateInfoByHeight(ctx, rollapp, entry.Height)
+		if err != nil || sInfo.Status != commontypes.Status_FINALIZED || sInfo.Sequencer != entry.Sequencer {
+			continue
+		}
+		bd, ok := sInfo.GetBlockDescriptor(entry.Height)
+		if !ok {
+			continue
+		}
+		return types.QueryLatestSnapshotResponse{
+			Snapshot:       entry,
+			StateInfoIndex: sInfo.GetIndex().Index,
+			StateRoot:      bd.StateRoot,
+		}, nil
+	}
+	return types.QueryLatestSnapshotResponse{}, gerrc.ErrNotFound.Wrap("no finalized snapshot")
+}
@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/utest"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// Only snapshots covered by a finalized state are returned, the highest first
+func (s *SequencerTestSuite) TestLatestSnapshot() {
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, bob, bond)
+
+	advertise := func(pk cryptotypes.PubKey, heights ...uint64) {
+		seq := s.seq(pk)
+		md := seq.Metadata
+		md.EvmRpcs = nil
+		md.Snapshots = nil
+		for _, h := range heights {
+			md.Snapshots = append(md.Snapshots, &types.SnapshotInfo{SnapshotUrl: "url", Height: h, Checksum: "checksum"})
+		}
+		_, err := s.msgServer.UpdateSequencerInformation(s.Ctx, &types.MsgUpdateSequencerInformation{
+			Creator:  seq.Address,
+			Metadata: md,
+		})
+		s.Require().NoError(err)
+	}
+	query := func() (*types.QueryLatestSnapshotResponse, error) {
+		return s.queryClient.LatestSnapshot(s.Ctx, &types.QueryLatestSnapshotRequest{RollappId: ra.RollappId})
+	}
+
+	advertise(alice, 3, 5)
+	advertise(bob, 8, 50)
+
+	// nothing finalized yet
+	s.submitAFewRollappStates(ra.RollappId)
+	_, err := query()
+	utest.IsErr(s.Require(), err, gerrc.ErrNotFound)
+
+	sInfo, ok := s.App.RollappKeeper.GetLatestStateInfo(s.Ctx, ra.RollappId)
+	s.Require().True(ok)
+	sInfo.Status = commontypes.Status_FINALIZED
+	s.App.RollappKeeper.SetStateInfo(s.Ctx, sInfo)
+
+	// alice produced the states, bob cannot vouch for them and his snapshot at 50 is not covered by a state
+	s.Require().Equal(pkAddr(alice), sInfo.Sequencer)
+	res, err := query()
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(5), res.Snapshot.Height)
+	s.Require().Equal(pkAddr(alice), res.Snapshot.Sequencer)
+	s.Require().Equal(sInfo.StateInfoIndex.Index, res.StateInfoIndex)
+	bd, _ := sInfo.GetBlockDescriptor(5)
+	s.Require().Equal(bd.StateRoot, res.StateRoot)
+
+	// alice replaces her snapshots
+	advertise(alice, 3, 8)
+	res, err = query()
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(8), res.Snapshot.Height)
+	s.Require().Equal(pkAddr(alice), res.Snapshot.Sequencer)
+
+	// the snapshots of a slashed sequencer are dropped
+	s.Require().NoError(s.k().PunishSequencer(s.Ctx, pkAddr(alice), nil))
+	_, err = query()
+	utest.IsErr(s.Require(), err, gerrc.ErrNotFound)
+}
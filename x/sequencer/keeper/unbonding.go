@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// SetUnbonding adds to the queue entry with the same key if there is one
+func (k Keeper) SetUnbonding(ctx sdk.Context, u types.UnbondingEntry) error {
+	return k.unbondings.add(ctx, collections.Join(u.CompletionTime, u.Sequencer), u)
+}
+
+func (k Keeper) AllUnbondings(ctx sdk.Context) ([]types.UnbondingEntry, error) {
+	return k.unbondings.all(ctx)
+}
+
+func (k Keeper) SequencerUnbondings(ctx sdk.Context, seqAddr string) ([]types.UnbondingEntry, error) {
+	entries, err := k.unbondings.bySequencer(ctx, seqAddr)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]types.UnbondingEntry, 0, len(entries))
+	for _, kv := range entries {
+		ret = append(ret, kv.Value)
+	}
+	return ret, nil
+}
+
+// UnbondingCompletionTime is the time at which tokens unbonded now will be returned
+func (k Keeper) UnbondingCompletionTime(ctx sdk.Context) time.Time {
+	return ctx.BlockTime().Add(k.GetParams(ctx).UnbondingPeriod)
+}
+
+// startUnbonding removes amt from the sequencer bond and puts it in the unbonding queue.
+// The tokens can still be slashed until the completion time.
+func (k Keeper) startUnbonding(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coin) error {
+	seq.SetTokensCoin(seq.TokensCoin().Sub(amt))
+	completion := k.UnbondingCompletionTime(ctx)
+	err := k.SetUnbonding(ctx, types.UnbondingEntry{
+		Sequencer:      seq.Address,
+		Amount:         amt,
+		CompletionTime: completion,
+	})
+	if err != nil {
+		return errorsmod.Wrap(err, "set unbonding")
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventUnbondingStarted{
+		Sequencer:      seq.Address,
+		Amount:         amt,
+		CompletionTime: completion,
+	})
+}
+
+// CompleteUnbondings returns the tokens of all unbonding entries whose completion time is not after now.
+// Each entry is completed in its own cache context, a failed one is logged and stays in the queue.
+func (k Keeper) CompleteUnbondings(ctx sdk.Context, now time.Time) error {
+	matured, err := k.unbondings.matured(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range matured {
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.completeUnbonding(cacheCtx, kv.Key, kv.Value); err != nil {
+			k.Logger(ctx).Error("Complete unbonding.", "sequencer", kv.Value.Sequencer, "err", err)
+			continue
+		}
+		write()
+	}
+	return nil
+}
+
+func (k Keeper) completeUnbonding(ctx sdk.Context, key collections.Pair[time.Time, string], u types.UnbondingEntry) error {
+	if err := k.unbondings.remove(ctx, key); err != nil {
+		return err
+	}
+	if u.Amount.IsZero() {
+		return nil
+	}
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.MustAccAddressFromBech32(u.Sequencer), sdk.NewCoins(u.Amount))
+	if err != nil {
+		return errorsmod.Wrapf(err, "refund unbonding: sequencer: %s", u.Sequencer)
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventUnbondingCompleted{
+		Sequencer: u.Sequencer,
+		Amount:    u.Amount,
+	})
+}
+
+// slashUnbondings reduces the queued unbonding entries of the sequencer by frac and burns the cut
+func (k Keeper) slashUnbondings(ctx sdk.Context, seqAddr string, frac math.LegacyDec) error {
+	burn, err := k.unbondings.slash(ctx, seqAddr, frac)
+	if err != nil {
+		return err
+	}
+	if burn.IsZero() {
+		return nil
+	}
+	return errorsmod.Wrap(k.bankKeeper.BurnCoins(ctx, types.ModuleName, burn), "burn unbondings")
+}
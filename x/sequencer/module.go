@@ -29,6 +29,7 @@ var (
 
 	_ appmodule.AppModule       = AppModule{}
 	_ appmodule.HasBeginBlocker = (*AppModule)(nil)
+	_ appmodule.HasEndBlocker   = (*AppModule)(nil)
 )
 
 // ----------------------------------------------------------------------------
@@ -169,3 +170,19 @@ func (am AppModule) BeginBlock(goCtx context.Context) error {
 
 	return nil
 }
+
+// EndBlock executes all ABCI EndBlock logic respective to the capability module.
+func (am AppModule) EndBlock(goCtx context.Context) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := am.keeper.CompleteUndelegations(ctx, ctx.BlockTime())
+	if err != nil {
+		ctx.Logger().Error("CompleteUndelegations", "err", err)
+		return err
+	}
+	err = am.keeper.CompleteUnbondings(ctx, ctx.BlockTime())
+	if err != nil {
+		ctx.Logger().Error("CompleteUnbondings", "err", err)
+		return err
+	}
+	return nil
+}
@@ -20,6 +20,12 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateOptInStatus{}, "sequencer/UpdateOtpInStatus", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "sequencer/UpdateParams", nil)
 	cdc.RegisterConcrete(&MsgPunishSequencer{}, "sequencer/PunishSequencer", nil)
+	cdc.RegisterConcrete(&MsgDelegate{}, "sequencer/Delegate", nil)
+	cdc.RegisterConcrete(&MsgUndelegate{}, "sequencer/Undelegate", nil)
+	cdc.RegisterConcrete(&MsgUpdateCommission{}, "sequencer/UpdateCommission", nil)
+	cdc.RegisterConcrete(&MsgDistributeRewards{}, "sequencer/DistributeRewards", nil)
+	cdc.RegisterConcrete(&MsgUpdateProposerSelection{}, "sequencer/UpdateProposerSelection", nil)
+	cdc.RegisterConcrete(&MsgRotateSequencerKey{}, "sequencer/RotateSequencerKey", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -34,6 +40,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateOptInStatus{},
 		&MsgUpdateParams{},
 		&MsgPunishSequencer{},
+		&MsgDelegate{},
+		&MsgUndelegate{},
+		&MsgUpdateCommission{},
+		&MsgDistributeRewards{},
+		&MsgUpdateProposerSelection{},
+		&MsgRotateSequencerKey{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,59 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+// RewardPoolAddr returns the address which collects the rewards of a sequencer accepting delegations.
+// The funds are split between the sequencer and the delegators when distributed.
+func RewardPoolAddr(seqAddr string) sdk.AccAddress {
+	return address.Module(ModuleName, []byte("reward_pool/"+seqAddr))
+}
+
+func (d Delegation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(d.Delegator); err != nil {
+		return errorsmod.Wrap(ErrInvalidAddr, "delegator")
+	}
+	if _, err := sdk.AccAddressFromBech32(d.Sequencer); err != nil {
+		return errorsmod.Wrap(ErrInvalidAddr, "sequencer")
+	}
+	if !d.Amount.IsValid() || d.Amount.IsZero() {
+		return errorsmod.Wrap(ErrInvalidCoins, "amount")
+	}
+	if !d.RewardIndex.IsValid() {
+		return errorsmod.Wrap(ErrInvalidCoins, "reward index")
+	}
+	return nil
+}
+
+func (p RewardPool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(p.Sequencer); err != nil {
+		return errorsmod.Wrap(ErrInvalidAddr, "sequencer")
+	}
+	if !p.RewardsPerToken.IsValid() {
+		return errorsmod.Wrap(ErrInvalidCoins, "rewards per token")
+	}
+	if !p.Outstanding.IsValid() {
+		return errorsmod.Wrap(ErrInvalidCoins, "outstanding")
+	}
+	return nil
+}
+
+func (u Undelegation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(u.Delegator); err != nil {
+		return errorsmod.Wrap(ErrInvalidAddr, "delegator")
+	}
+	if _, err := sdk.AccAddressFromBech32(u.Sequencer); err != nil {
+		return errorsmod.Wrap(ErrInvalidAddr, "sequencer")
+	}
+	if !u.Amount.IsValid() {
+		return errorsmod.Wrap(ErrInvalidCoins, "amount")
+	}
+	if u.CompletionTime.IsZero() {
+		return gerrc.ErrInvalidArgument.Wrap("completion time")
+	}
+	return nil
+}
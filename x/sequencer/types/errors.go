@@ -25,4 +25,9 @@ var (
 	ErrInvalidPubKey             = gerrc.ErrInvalidArgument.Wrap("pubkey")
 	ErrUnknownRequest            = gerrc.ErrInvalidArgument.Wrap("unknown request")
 	ErrInvalidFeeDenom           = gerrc.ErrInvalidArgument.Wrap("invalid fee denom")
+	ErrDelegationNotEnabled      = gerrc.ErrFailedPrecondition.Wrap("sequencer does not accept delegations")
+	ErrDelegationNotFound        = gerrc.ErrNotFound.Wrap("delegation")
+	ErrCommissionChangeTooLarge  = gerrc.ErrOutOfRange.Wrap("commission change above max change rate")
+	ErrCommissionChangeTooSoon   = gerrc.ErrFailedPrecondition.Wrap("commission changed within the change interval")
+	ErrUnauthorizedSigner        = gerrc.ErrPermissionDenied.Wrap("unauthorized signer")
 )
@@ -17,6 +17,8 @@ type RollappKeeper interface {
 	SetRollappAsLaunched(ctx sdk.Context, rollapp *rollapptypes.Rollapp) error
 	HardForkToLatest(ctx sdk.Context, rollappId string) error
 	ForkLatestAllowed(ctx sdk.Context, rollappId string) bool
+	GetLatestHeight(ctx sdk.Context, rollappId string) (uint64, bool)
+	FindStateInfoByHeight(ctx sdk.Context, rollappId string, height uint64) (*rollapptypes.StateInfo, error)
 }
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -29,6 +31,8 @@ type BankKeeper interface {
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	BurnCoins(ctx context.Context, name string, amt sdk.Coins) error
 }
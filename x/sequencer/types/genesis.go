@@ -42,6 +42,87 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	delegationIndexMap := make(map[string]struct{})
+	for _, d := range gs.Delegations {
+		if err := d.ValidateBasic(); err != nil {
+			return err
+		}
+		if _, ok := sequencerIndexMap[string(SequencerKey(d.Sequencer))]; !ok {
+			return fmt.Errorf("delegation to non-existent sequencer")
+		}
+		key := d.Sequencer + KeySeparator + d.Delegator
+		if _, ok := delegationIndexMap[key]; ok {
+			return fmt.Errorf("duplicated delegation")
+		}
+		delegationIndexMap[key] = struct{}{}
+	}
+
+	for _, u := range gs.Undelegations {
+		if err := u.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	rotationIndexMap := make(map[string]struct{})
+	for _, r := range gs.KeyRotations {
+		if err := r.ValidateBasic(); err != nil {
+			return err
+		}
+		if _, ok := sequencerIndexMap[string(SequencerKey(r.Sequencer))]; !ok {
+			return fmt.Errorf("key rotation of non-existent sequencer")
+		}
+		key := fmt.Sprintf("%s/%d", r.Sequencer, r.EffectiveHeight)
+		if _, ok := rotationIndexMap[key]; ok {
+			return fmt.Errorf("duplicated key rotation")
+		}
+		rotationIndexMap[key] = struct{}{}
+	}
+
+	for _, u := range gs.Unbondings {
+		if err := u.ValidateBasic(); err != nil {
+			return err
+		}
+		if _, ok := sequencerIndexMap[string(SequencerKey(u.Sequencer))]; !ok {
+			return fmt.Errorf("unbonding entry of non-existent sequencer")
+		}
+	}
+
+	reputationIndexMap := make(map[string]struct{})
+	for _, r := range gs.Reputations {
+		if _, ok := sequencerIndexMap[string(SequencerKey(r.Sequencer))]; !ok {
+			return fmt.Errorf("reputation of non-existent sequencer")
+		}
+		if _, ok := reputationIndexMap[r.Sequencer]; ok {
+			return fmt.Errorf("duplicated reputation")
+		}
+		reputationIndexMap[r.Sequencer] = struct{}{}
+	}
+
+	poolIndexMap := make(map[string]struct{})
+	for _, p := range gs.RewardPools {
+		if err := p.ValidateBasic(); err != nil {
+			return err
+		}
+		if _, ok := sequencerIndexMap[string(SequencerKey(p.Sequencer))]; !ok {
+			return fmt.Errorf("reward pool of non-existent sequencer")
+		}
+		if _, ok := poolIndexMap[p.Sequencer]; ok {
+			return fmt.Errorf("duplicated reward pool")
+		}
+		poolIndexMap[p.Sequencer] = struct{}{}
+	}
+
+	selectionIndexMap := make(map[string]struct{})
+	for _, sel := range gs.ProposerSelections {
+		if err := sel.ValidateBasic(); err != nil {
+			return err
+		}
+		if _, ok := selectionIndexMap[sel.RollappId]; ok {
+			return fmt.Errorf("duplicated proposer selection for %s", sel.RollappId)
+		}
+		selectionIndexMap[sel.RollappId] = struct{}{}
+	}
+
 	return gs.Params.ValidateBasic()
 }
 
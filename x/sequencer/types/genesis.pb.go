@@ -34,6 +34,22 @@ type GenesisState struct {
 	GenesisSuccessors []GenesisProposer `protobuf:"bytes,5,rep,name=genesisSuccessors,proto3" json:"genesisSuccessors"`
 	// list of sequencers in the notice queue
 	NoticeQueue []string `protobuf:"bytes,4,rep,name=noticeQueue,proto3" json:"noticeQueue,omitempty"`
+	// delegations is a list of all delegations
+	Delegations []Delegation `protobuf:"bytes,6,rep,name=delegations,proto3" json:"delegations"`
+	// undelegations is a list of all entries in the undelegation queue
+	Undelegations []Undelegation `protobuf:"bytes,7,rep,name=undelegations,proto3" json:"undelegations"`
+	// proposer_selections is the list of rollapps not using the default
+	// proposer selection
+	ProposerSelections []ProposerSelection `protobuf:"bytes,8,rep,name=proposer_selections,json=proposerSelections,proto3" json:"proposer_selections"`
+	// unbondings is a list of all entries in the sequencer unbonding queue
+	Unbondings []UnbondingEntry `protobuf:"bytes,9,rep,name=unbondings,proto3" json:"unbondings"`
+	// key_rotations is a list of all dymint key rotations
+	KeyRotations []KeyRotation `protobuf:"bytes,10,rep,name=key_rotations,json=keyRotations,proto3" json:"key_rotations"`
+	// Reputations is the performance record of the sequencers
+	Reputations []Reputation `protobuf:"bytes,11,rep,name=reputations,proto3" json:"reputations"`
+	// reward_pools is the reward accounting of the sequencers accepting
+	// delegations
+	RewardPools []RewardPool `protobuf:"bytes,12,rep,name=reward_pools,json=rewardPools,proto3" json:"reward_pools"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -104,6 +120,55 @@ func (m *GenesisState) GetNoticeQueue() []string {
 	return nil
 }
 
+func (m *GenesisState) GetDelegations() []Delegation {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUndelegations() []Undelegation {
+	if m != nil {
+		return m.Undelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProposerSelections() []ProposerSelection {
+	if m != nil {
+		return m.ProposerSelections
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUnbondings() []UnbondingEntry {
+	if m != nil {
+		return m.Unbondings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetKeyRotations() []KeyRotation {
+	if m != nil {
+		return m.KeyRotations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReputations() []Reputation {
+	if m != nil {
+		return m.Reputations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardPools() []RewardPool {
+	if m != nil {
+		return m.RewardPools
+	}
+	return nil
+}
+
 type GenesisProposer struct {
 	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	RollappId string `protobuf:"bytes,2,opt,name=rollappId,proto3" json:"rollappId,omitempty"`
@@ -166,30 +231,42 @@ func init() {
 }
 
 var fileDescriptor_3115db717b16c2af = []byte{
-	// 353 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xc1, 0x4e, 0xc2, 0x40,
-	0x10, 0x86, 0x5b, 0x8a, 0x18, 0x16, 0x8d, 0xba, 0xf1, 0xb0, 0x21, 0xa6, 0x36, 0x9c, 0x9a, 0x18,
-	0x5b, 0x85, 0xc4, 0x07, 0xe0, 0x20, 0x21, 0xf1, 0x80, 0x70, 0x30, 0xf1, 0x56, 0xda, 0x49, 0x6d,
-	0x02, 0xdd, 0xba, 0xb3, 0x35, 0xe0, 0x53, 0xf8, 0x02, 0xbe, 0x0f, 0x47, 0x8e, 0x9e, 0x8c, 0x81,
-	0x17, 0x31, 0xb6, 0xa5, 0x80, 0xc4, 0xf4, 0xe0, 0x6d, 0x66, 0xfa, 0xff, 0xdf, 0x3f, 0xe9, 0x0e,
-	0xb1, 0xbc, 0xe9, 0x18, 0x42, 0x0c, 0x78, 0x38, 0x99, 0xbe, 0xda, 0x79, 0x63, 0x23, 0x3c, 0xc7,
-	0x10, 0xba, 0x20, 0x6c, 0x1f, 0x42, 0xc0, 0x00, 0xad, 0x48, 0x70, 0xc9, 0xa9, 0xb1, 0xa9, 0x5f,
-	0x9b, 0xad, 0x5c, 0x5f, 0x3f, 0xf5, 0xb9, 0xcf, 0x13, 0xb1, 0xfd, 0x53, 0xa5, 0xbe, 0xfa, 0x65,
-	0x61, 0x4e, 0xe4, 0x08, 0x67, 0x9c, 0xc5, 0xd4, 0xaf, 0x0a, 0xe5, 0x79, 0x95, 0x3a, 0x1a, 0xef,
-	0x1a, 0x39, 0xe8, 0xa4, 0xab, 0x0e, 0xa4, 0x23, 0x81, 0xde, 0x92, 0x4a, 0x8a, 0x64, 0xaa, 0xa1,
-	0x9a, 0xb5, 0xa6, 0x69, 0x15, 0xad, 0x6e, 0xf5, 0x12, 0x7d, 0xbb, 0x3c, 0xfb, 0x3c, 0x57, 0xfa,
-	0x99, 0x9b, 0x3e, 0x90, 0xc3, 0x5c, 0x71, 0x17, 0xa0, 0x64, 0x25, 0x43, 0x33, 0x6b, 0xcd, 0x8b,
-	0x62, 0xdc, 0x60, 0x55, 0x65, 0xc4, 0x6d, 0x0e, 0x75, 0xc9, 0x71, 0xf6, 0x6f, 0x7b, 0x82, 0x47,
-	0x1c, 0x41, 0x20, 0xd3, 0x12, 0xf6, 0x75, 0x31, 0xbb, 0xb3, 0xed, 0xcc, 0x12, 0x76, 0x80, 0x14,
-	0xc8, 0x49, 0x36, 0x1b, 0xc4, 0xae, 0x0b, 0x88, 0x5c, 0x20, 0xdb, 0xfb, 0x5f, 0xca, 0x2e, 0x91,
-	0x1a, 0xa4, 0x16, 0x72, 0x19, 0xb8, 0x70, 0x1f, 0x43, 0x0c, 0xac, 0x6c, 0x68, 0x66, 0xb5, 0xbf,
-	0x39, 0x6a, 0x74, 0xc9, 0xd1, 0x2f, 0x1a, 0x65, 0x64, 0xdf, 0xf1, 0x3c, 0x01, 0x98, 0x3e, 0x51,
-	0xb5, 0xbf, 0x6a, 0xe9, 0x19, 0xa9, 0x0a, 0x3e, 0x1a, 0x39, 0x51, 0xd4, 0xf5, 0x58, 0x29, 0xf9,
-	0xb6, 0x1e, 0xb4, 0x7b, 0xb3, 0x85, 0xae, 0xce, 0x17, 0xba, 0xfa, 0xb5, 0xd0, 0xd5, 0xb7, 0xa5,
-	0xae, 0xcc, 0x97, 0xba, 0xf2, 0xb1, 0xd4, 0x95, 0xc7, 0x1b, 0x3f, 0x90, 0x4f, 0xf1, 0xd0, 0x72,
-	0xf9, 0xd8, 0xfe, 0xe3, 0x82, 0x5e, 0x5a, 0xf6, 0x64, 0xe3, 0x8c, 0xe4, 0x34, 0x02, 0x1c, 0x56,
-	0x92, 0x1b, 0x6a, 0x7d, 0x07, 0x00, 0x00, 0xff, 0xff, 0x74, 0x53, 0x26, 0xab, 0x0e, 0x03, 0x00,
-	0x00,
+	// 556 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0x80, 0xdb, 0x6d, 0x6c, 0xab, 0xdb, 0x0a, 0x30, 0x1c, 0xac, 0x0a, 0x85, 0x6a, 0xa7, 0x4a,
+	0xb0, 0x64, 0x5b, 0x25, 0x24, 0xae, 0x13, 0x30, 0x4d, 0x70, 0x28, 0x2d, 0x03, 0xb4, 0x4b, 0x95,
+	0x26, 0x4f, 0x21, 0x2c, 0xb5, 0x83, 0x5f, 0x02, 0x0b, 0xbf, 0x82, 0x33, 0xbf, 0x68, 0xc7, 0x1d,
+	0x39, 0x21, 0xd4, 0xfe, 0x11, 0xd4, 0xd4, 0x4d, 0xdc, 0x56, 0xc8, 0x95, 0xb8, 0x39, 0x2f, 0xef,
+	0xfb, 0xde, 0x4b, 0x9e, 0x6d, 0x62, 0xfb, 0xd9, 0x18, 0x38, 0x86, 0x82, 0x5f, 0x67, 0xdf, 0x9d,
+	0xe2, 0xc1, 0x41, 0xf8, 0x92, 0x02, 0xf7, 0x40, 0x3a, 0x01, 0x70, 0xc0, 0x10, 0xed, 0x58, 0x8a,
+	0x44, 0xd0, 0xb6, 0x9e, 0x5f, 0xc2, 0x76, 0x91, 0xdf, 0x7a, 0x18, 0x88, 0x40, 0xe4, 0xc9, 0xce,
+	0x6c, 0x35, 0xe7, 0x5a, 0x87, 0xc6, 0x3a, 0xb1, 0x2b, 0xdd, 0xb1, 0x2a, 0xd3, 0x3a, 0x32, 0xa6,
+	0x17, 0x2b, 0x45, 0x1c, 0x1b, 0x09, 0x1f, 0x22, 0x08, 0xdc, 0x64, 0xd6, 0xed, 0x1c, 0x79, 0x6e,
+	0xee, 0x49, 0x8a, 0x58, 0x20, 0xc8, 0x21, 0x42, 0x04, 0x9e, 0x86, 0x9a, 0xfb, 0x4b, 0xf9, 0x48,
+	0x70, 0x3f, 0xe4, 0x81, 0x22, 0xba, 0x46, 0xe2, 0x0a, 0xb2, 0xa1, 0x14, 0x89, 0xde, 0xa1, 0xf9,
+	0xa3, 0x24, 0xc4, 0xa9, 0x8e, 0x1c, 0xfc, 0xdc, 0x27, 0x8d, 0xb3, 0xf9, 0xc8, 0x06, 0x89, 0x9b,
+	0x00, 0x7d, 0x45, 0x76, 0xe7, 0xbf, 0x96, 0x55, 0xdb, 0xd5, 0x4e, 0xfd, 0xa4, 0x63, 0x9b, 0x46,
+	0x68, 0xf7, 0xf2, 0xfc, 0xd3, 0x9d, 0x9b, 0xdf, 0x8f, 0x2b, 0x7d, 0x45, 0xd3, 0x0f, 0xa4, 0x59,
+	0x64, 0xbc, 0x09, 0x31, 0x61, 0x5b, 0xed, 0xed, 0x4e, 0xfd, 0xe4, 0x89, 0x59, 0x37, 0x58, 0xac,
+	0x94, 0x71, 0xd9, 0x43, 0x3d, 0x72, 0x4f, 0xed, 0xb1, 0x9e, 0xfa, 0xdd, 0xc8, 0xb6, 0x73, 0xf7,
+	0xb1, 0xd9, 0x7d, 0xb6, 0x4c, 0xaa, 0x0a, 0x6b, 0x42, 0x0a, 0xe4, 0xbe, 0x8a, 0x0d, 0x52, 0xcf,
+	0x03, 0x44, 0x21, 0x91, 0xdd, 0xf9, 0xbf, 0x2a, 0xeb, 0x46, 0xda, 0x26, 0x75, 0x2e, 0x92, 0xd0,
+	0x83, 0xb7, 0x29, 0xa4, 0xc0, 0x76, 0xda, 0xdb, 0x9d, 0x5a, 0x5f, 0x0f, 0xd1, 0x77, 0xa4, 0x5e,
+	0x6e, 0x44, 0x64, 0xbb, 0x79, 0x0b, 0x4f, 0xcd, 0x2d, 0xbc, 0x28, 0x20, 0x55, 0x5d, 0xd7, 0xd0,
+	0x4b, 0xd2, 0x4c, 0xb9, 0xee, 0xdd, 0xcb, 0xbd, 0xb6, 0xd9, 0x7b, 0xc1, 0xfd, 0x55, 0xf3, 0xb2,
+	0x8a, 0x7e, 0x26, 0x0f, 0xd6, 0xcf, 0x01, 0xb2, 0xfd, 0xbc, 0x42, 0x77, 0x83, 0xdd, 0xa4, 0xe0,
+	0xc1, 0x82, 0x55, 0x65, 0x68, 0xbc, 0xfa, 0x02, 0xe9, 0x7b, 0x42, 0x8a, 0x83, 0x83, 0xac, 0x96,
+	0x97, 0x38, 0xda, 0xe4, 0x23, 0x14, 0xf3, 0x92, 0x27, 0x32, 0x53, 0x7e, 0xcd, 0x44, 0x3f, 0x92,
+	0xa6, 0x7e, 0xbc, 0x90, 0x91, 0x5c, 0x7d, 0x68, 0x56, 0xbf, 0x86, 0xac, 0xaf, 0x28, 0xe5, 0x6d,
+	0x5c, 0x95, 0x21, 0x9c, 0xcd, 0xb3, 0x3c, 0x83, 0xc8, 0xea, 0x9b, 0xce, 0xb3, 0x5f, 0x40, 0x8b,
+	0x79, 0x6a, 0x1a, 0x7a, 0x41, 0x1a, 0x12, 0xbe, 0xb9, 0xd2, 0x1f, 0xc6, 0x42, 0x44, 0xc8, 0x1a,
+	0x9b, 0x6b, 0x67, 0x54, 0x4f, 0x88, 0xa8, 0xd4, 0x2e, 0x22, 0x78, 0x70, 0x4e, 0xee, 0xae, 0x6c,
+	0x65, 0xca, 0xc8, 0x9e, 0xeb, 0xfb, 0x12, 0x70, 0x7e, 0x3f, 0xd4, 0xfa, 0x8b, 0x47, 0xfa, 0x88,
+	0xd4, 0xa4, 0x88, 0x22, 0x37, 0x8e, 0xcf, 0x7d, 0xb6, 0x95, 0xbf, 0x2b, 0x03, 0xa7, 0xbd, 0x9b,
+	0x89, 0x55, 0xbd, 0x9d, 0x58, 0xd5, 0x3f, 0x13, 0xab, 0xfa, 0x63, 0x6a, 0x55, 0x6e, 0xa7, 0x56,
+	0xe5, 0xd7, 0xd4, 0xaa, 0x5c, 0x3e, 0x0b, 0xc2, 0xe4, 0x53, 0x3a, 0xb2, 0x3d, 0x31, 0x76, 0xfe,
+	0x71, 0x7f, 0x7d, 0xed, 0x3a, 0xd7, 0xda, 0x25, 0x96, 0x64, 0x31, 0xe0, 0x68, 0x37, 0xbf, 0xc0,
+	0xba, 0x7f, 0x07, 0x00, 0xeb, 0xf7, 0x46, 0xda, 0x93, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -212,6 +289,104 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RewardPools) > 0 {
+		for iNdEx := len(m.RewardPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Reputations) > 0 {
+		for iNdEx := len(m.Reputations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reputations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.KeyRotations) > 0 {
+		for iNdEx := len(m.KeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Unbondings) > 0 {
+		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ProposerSelections) > 0 {
+		for iNdEx := len(m.ProposerSelections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposerSelections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Undelegations) > 0 {
+		for iNdEx := len(m.Undelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Undelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.GenesisSuccessors) > 0 {
 		for iNdEx := len(m.GenesisSuccessors) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -356,6 +531,48 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Undelegations) > 0 {
+		for _, e := range m.Undelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProposerSelections) > 0 {
+		for _, e := range m.ProposerSelections {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Unbondings) > 0 {
+		for _, e := range m.Unbondings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.KeyRotations) > 0 {
+		for _, e := range m.KeyRotations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Reputations) > 0 {
+		for _, e := range m.Reputations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardPools) > 0 {
+		for _, e := range m.RewardPools {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -578,6 +795,244 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, Delegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Undelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Undelegations = append(m.Undelegations, Undelegation{})
+			if err := m.Undelegations[len(m.Undelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSelections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerSelections = append(m.ProposerSelections, ProposerSelection{})
+			if err := m.ProposerSelections[len(m.ProposerSelections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unbondings = append(m.Unbondings, UnbondingEntry{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyRotations = append(m.KeyRotations, KeyRotation{})
+			if err := m.KeyRotations[len(m.KeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputations = append(m.Reputations, Reputation{})
+			if err := m.Reputations[len(m.Reputations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPools = append(m.RewardPools, RewardPool{})
+			if err := m.RewardPools[len(m.RewardPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,71 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+var (
+	_ sdk.Msg                            = &MsgRotateSequencerKey{}
+	_ codectypes.UnpackInterfacesMessage = (*MsgRotateSequencerKey)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*KeyRotation)(nil)
+)
+
+func NewMsgRotateSequencerKey(creator string, pubkey cryptotypes.PubKey, effectiveHeight uint64) (*MsgRotateSequencerKey, error) {
+	pkAny, err := codectypes.NewAnyWithValue(pubkey)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgRotateSequencerKey{
+		Creator:         creator,
+		NewDymintPubKey: pkAny,
+		EffectiveHeight: effectiveHeight,
+	}, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgRotateSequencerKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.NewDymintPubKey, &pubKey)
+}
+
+func (msg *MsgRotateSequencerKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid creator address (%s)", err)
+	}
+	if err := validateDymintPubKey(msg.NewDymintPubKey); err != nil {
+		return err
+	}
+	if msg.EffectiveHeight == 0 {
+		return gerrc.ErrInvalidArgument.Wrap("effective height must be positive")
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r KeyRotation) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	if err := unpacker.UnpackAny(r.PreviousDymintPubKey, &pubKey); err != nil {
+		return err
+	}
+	return unpacker.UnpackAny(r.DymintPubKey, &pubKey)
+}
+
+func (r KeyRotation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(r.Sequencer); err != nil {
+		return errorsmod.Wrap(ErrInvalidAddr, "sequencer")
+	}
+	if r.EffectiveHeight == 0 {
+		return gerrc.ErrInvalidArgument.Wrap("effective height must be positive")
+	}
+	if _, err := PubKey(r.PreviousDymintPubKey); err != nil {
+		return errorsmod.Wrap(ErrInvalidPubKey, "previous")
+	}
+	if _, err := PubKey(r.DymintPubKey); err != nil {
+		return errorsmod.Wrap(ErrInvalidPubKey, "new")
+	}
+	return nil
+}
@@ -0,0 +1,490 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/sequencer/key_rotation.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// KeyRotation is a change of the dymint key of a sequencer, effective from a
+// rollapp height. Headers below the height are signed with the previous key,
+// headers from the height on with the new key.
+type KeyRotation struct {
+	// Sequencer is the bech32-encoded address of the sequencer
+	Sequencer string `protobuf:"bytes,1,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+	// EffectiveHeight is the first rollapp height signed with the new key
+	EffectiveHeight uint64 `protobuf:"varint,2,opt,name=effective_height,json=effectiveHeight,proto3" json:"effective_height,omitempty"`
+	// PreviousDymintPubKey is the key used before the effective height
+	PreviousDymintPubKey *types.Any `protobuf:"bytes,3,opt,name=previous_dymint_pub_key,json=previousDymintPubKey,proto3" json:"previous_dymint_pub_key,omitempty"`
+	// DymintPubKey is the key used from the effective height
+	DymintPubKey *types.Any `protobuf:"bytes,4,opt,name=dymint_pub_key,json=dymintPubKey,proto3" json:"dymint_pub_key,omitempty"`
+}
+
+func (m *KeyRotation) Reset()         { *m = KeyRotation{} }
+func (m *KeyRotation) String() string { return proto.CompactTextString(m) }
+func (*KeyRotation) ProtoMessage()    {}
+func (*KeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4533df3b66f4ea12, []int{0}
+}
+func (m *KeyRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyRotation.Merge(m, src)
+}
+func (m *KeyRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyRotation proto.InternalMessageInfo
+
+func (m *KeyRotation) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
+	}
+	return ""
+}
+
+func (m *KeyRotation) GetEffectiveHeight() uint64 {
+	if m != nil {
+		return m.EffectiveHeight
+	}
+	return 0
+}
+
+func (m *KeyRotation) GetPreviousDymintPubKey() *types.Any {
+	if m != nil {
+		return m.PreviousDymintPubKey
+	}
+	return nil
+}
+
+func (m *KeyRotation) GetDymintPubKey() *types.Any {
+	if m != nil {
+		return m.DymintPubKey
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*KeyRotation)(nil), "dymensionxyz.dymension.sequencer.KeyRotation")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/sequencer/key_rotation.proto", fileDescriptor_4533df3b66f4ea12)
+}
+
+var fileDescriptor_4533df3b66f4ea12 = []byte{
+	// 317 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xc1, 0x4a, 0xf3, 0x40,
+	0x14, 0x85, 0x3b, 0xfd, 0xcb, 0x0f, 0x4d, 0x45, 0x25, 0x14, 0x4c, 0x8b, 0x84, 0xe0, 0xaa, 0x2e,
+	0x9c, 0x01, 0x0b, 0xee, 0x2d, 0x2e, 0x04, 0x37, 0xa5, 0xb8, 0x72, 0x33, 0x34, 0xe9, 0x6d, 0x3a,
+	0xd4, 0xcc, 0x8d, 0x99, 0x99, 0xd2, 0xf1, 0x29, 0x04, 0x5f, 0xc5, 0x87, 0x10, 0x57, 0x5d, 0xba,
+	0x94, 0xf6, 0x45, 0xc4, 0xa4, 0x8d, 0x41, 0x70, 0xe3, 0xf2, 0x9c, 0xe4, 0x7c, 0xf3, 0xc1, 0x75,
+	0xfa, 0x13, 0x9b, 0x80, 0x54, 0x02, 0xe5, 0xd2, 0x3e, 0xb2, 0x32, 0x30, 0x05, 0x0f, 0x06, 0x64,
+	0x04, 0x19, 0x9b, 0x83, 0xe5, 0x19, 0xea, 0xb1, 0x16, 0x28, 0x69, 0x9a, 0xa1, 0x46, 0x37, 0xa8,
+	0x8e, 0x68, 0x19, 0x68, 0x39, 0xea, 0x76, 0x22, 0x54, 0x09, 0x2a, 0x9e, 0xff, 0xcf, 0x8a, 0x50,
+	0x8c, 0xbb, 0x9d, 0x18, 0x31, 0xbe, 0x07, 0x96, 0xa7, 0xd0, 0x4c, 0xd9, 0x58, 0xda, 0xe2, 0xd3,
+	0xc9, 0x73, 0xdd, 0x69, 0xdd, 0x80, 0x1d, 0x6d, 0x5f, 0x73, 0x8f, 0x9d, 0x66, 0x89, 0xf4, 0x48,
+	0x40, 0x7a, 0xcd, 0xd1, 0x77, 0xe1, 0x9e, 0x3a, 0x87, 0x30, 0x9d, 0x42, 0xa4, 0xc5, 0x02, 0xf8,
+	0x0c, 0x44, 0x3c, 0xd3, 0x5e, 0x3d, 0x20, 0xbd, 0xc6, 0xe8, 0xa0, 0xec, 0xaf, 0xf3, 0xda, 0x05,
+	0xe7, 0x28, 0xcd, 0x60, 0x21, 0xd0, 0x28, 0x3e, 0xb1, 0x89, 0x90, 0x9a, 0xa7, 0x26, 0xe4, 0x73,
+	0xb0, 0xde, 0xbf, 0x80, 0xf4, 0x5a, 0xe7, 0x6d, 0x5a, 0x58, 0xd1, 0x9d, 0x15, 0xbd, 0x94, 0x76,
+	0xe0, 0xbd, 0xbd, 0x9c, 0xb5, 0xb7, 0xf2, 0x51, 0x66, 0x53, 0x8d, 0x74, 0x68, 0xc2, 0x2f, 0xc3,
+	0xf6, 0x0e, 0x77, 0x95, 0xd3, 0x8a, 0xd6, 0xbd, 0x75, 0xf6, 0x7f, 0xd0, 0x1b, 0x7f, 0xa2, 0xef,
+	0x4d, 0x2a, 0xd4, 0xc1, 0xf0, 0x75, 0xed, 0x93, 0xd5, 0xda, 0x27, 0x1f, 0x6b, 0x9f, 0x3c, 0x6d,
+	0xfc, 0xda, 0x6a, 0xe3, 0xd7, 0xde, 0x37, 0x7e, 0xed, 0xee, 0x22, 0x16, 0x7a, 0x66, 0x42, 0x1a,
+	0x61, 0xc2, 0x7e, 0xb9, 0xe3, 0xa2, 0xcf, 0x96, 0x95, 0x63, 0x6a, 0x9b, 0x82, 0x0a, 0xff, 0xe7,
+	0x1e, 0xfd, 0xcf, 0x01, 0x00, 0x78, 0x33, 0xf3, 0xa3, 0xfd, 0x01, 0x00, 0x00,
+}
+
+func (m *KeyRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DymintPubKey != nil {
+		{
+			size, err := m.DymintPubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintKeyRotation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PreviousDymintPubKey != nil {
+		{
+			size, err := m.PreviousDymintPubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintKeyRotation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EffectiveHeight != 0 {
+		i = encodeVarintKeyRotation(dAtA, i, uint64(m.EffectiveHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintKeyRotation(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintKeyRotation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovKeyRotation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *KeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovKeyRotation(uint64(l))
+	}
+	if m.EffectiveHeight != 0 {
+		n += 1 + sovKeyRotation(uint64(m.EffectiveHeight))
+	}
+	if m.PreviousDymintPubKey != nil {
+		l = m.PreviousDymintPubKey.Size()
+		n += 1 + l + sovKeyRotation(uint64(l))
+	}
+	if m.DymintPubKey != nil {
+		l = m.DymintPubKey.Size()
+		n += 1 + l + sovKeyRotation(uint64(l))
+	}
+	return n
+}
+
+func sovKeyRotation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozKeyRotation(x uint64) (n int) {
+	return sovKeyRotation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *KeyRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyRotation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyRotation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyRotation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyRotation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveHeight", wireType)
+			}
+			m.EffectiveHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyRotation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousDymintPubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyRotation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyRotation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyRotation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreviousDymintPubKey == nil {
+				m.PreviousDymintPubKey = &types.Any{}
+			}
+			if err := m.PreviousDymintPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DymintPubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyRotation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyRotation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyRotation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DymintPubKey == nil {
+				m.DymintPubKey = &types.Any{}
+			}
+			if err := m.DymintPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyRotation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeyRotation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipKeyRotation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowKeyRotation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowKeyRotation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowKeyRotation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthKeyRotation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupKeyRotation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthKeyRotation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthKeyRotation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowKeyRotation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupKeyRotation = fmt.Errorf("proto: unexpected end of group")
+)
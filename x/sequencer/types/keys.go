@@ -55,6 +55,19 @@ var (
 
 	DymintProposerAddrToAccAddrKeyPrefix = collections.NewPrefix([]byte{0x43})
 
+	DelegationsKeyPrefix       = collections.NewPrefix([]byte{0x44}) // prefix/seqAddr/delegatorAddr
+	UndelegationQueueKeyPrefix = collections.NewPrefix([]byte{0x45}) // prefix/completionTime/delegatorAddr/seqAddr
+	ProposerSelectionKeyPrefix = collections.NewPrefix([]byte{0x46}) // prefix/rollappId
+	UnbondingQueueKeyPrefix    = collections.NewPrefix([]byte{0x47}) // prefix/completionTime/seqAddr
+	KeyRotationsKeyPrefix      = collections.NewPrefix([]byte{0x48}) // prefix/seqAddr/effectiveHeight
+	ReputationsKeyPrefix       = collections.NewPrefix([]byte{0x49}) // prefix/seqAddr
+	SnapshotsKeyPrefix         = collections.NewPrefix([]byte{0x4a}) // prefix/rollappId/height/seqAddr
+	RewardPoolsKeyPrefix       = collections.NewPrefix([]byte{0x4b}) // prefix/seqAddr
+	// UndelegationsBySequencerKeyPrefix indexes the undelegation queue by sequencer
+	UndelegationsBySequencerKeyPrefix = collections.NewPrefix([]byte{0x4c}) // prefix/seqAddr/completionTime/delegatorAddr/seqAddr
+	// UnbondingsBySequencerKeyPrefix indexes the unbonding queue by sequencer
+	UnbondingsBySequencerKeyPrefix = collections.NewPrefix([]byte{0x4d}) // prefix/seqAddr/completionTime/seqAddr
+
 	// These keys were already used on mainnet. Don't reuse
 	_ = []byte{0xa3}
 	_ = []byte{0x41}
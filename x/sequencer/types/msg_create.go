@@ -57,33 +57,41 @@ func NewMsgCreateSequencer(
 	}, nil
 }
 
-func (msg *MsgCreateSequencer) ValidateBasic() error {
-	_, err := sdk.AccAddressFromBech32(msg.Creator)
-	if err != nil {
-		return errorsmod.Wrapf(ErrInvalidAddr, "invalid creator address (%s)", err)
-	}
-
+// validateDymintPubKey checks that pkAny holds an ed25519 pubkey
+func validateDymintPubKey(pkAny *codectypes.Any) error {
 	// public key also checked by the application logic
-	if msg.DymintPubKey == nil {
+	if pkAny == nil {
 		return errorsmod.Wrap(ErrInvalidPubKey, "sequencer pubkey is required")
 	}
 
 	// check it is a pubkey
-	if _, err = codectypes.NewAnyWithValue(msg.DymintPubKey); err != nil {
+	if _, err := codectypes.NewAnyWithValue(pkAny); err != nil {
 		return errorsmod.Wrapf(ErrInvalidPubKey, "invalid sequencer pubkey(%s)", err)
 	}
 
 	// cast to cryptotypes.PubKey type
-	pk, ok := msg.DymintPubKey.GetCachedValue().(cryptotypes.PubKey)
+	pk, ok := pkAny.GetCachedValue().(cryptotypes.PubKey)
 	if !ok {
 		return errorsmod.WithType(ErrInvalidPubKey, pk)
 	}
 
-	_, err = edwards.ParsePubKey(edwards.Edwards(), pk.Bytes())
+	_, err := edwards.ParsePubKey(edwards.Edwards(), pk.Bytes())
 	// err means the pubkey validation failed
 	if err != nil {
 		return errorsmod.Wrapf(ErrInvalidPubKey, "%s", err)
 	}
+	return nil
+}
+
+func (msg *MsgCreateSequencer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid creator address (%s)", err)
+	}
+
+	if err = validateDymintPubKey(msg.DymintPubKey); err != nil {
+		return err
+	}
 
 	if err = msg.Metadata.Validate(); err != nil {
 		return errorsmod.Wrap(ErrInvalidMetadata, err.Error())
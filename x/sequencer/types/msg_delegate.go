@@ -0,0 +1,86 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/sdk-utils/utils/uparam"
+)
+
+var (
+	_ sdk.Msg = &MsgDelegate{}
+	_ sdk.Msg = &MsgUndelegate{}
+	_ sdk.Msg = &MsgUpdateCommission{}
+	_ sdk.Msg = &MsgDistributeRewards{}
+)
+
+func NewMsgDelegate(delegator, sequencer string, amount sdk.Coin) *MsgDelegate {
+	return &MsgDelegate{
+		Delegator: delegator,
+		Sequencer: sequencer,
+		Amount:    amount,
+	}
+}
+
+func (msg *MsgDelegate) ValidateBasic() error {
+	return validateDelegationMsg(msg.Delegator, msg.Sequencer, msg.Amount)
+}
+
+func NewMsgUndelegate(delegator, sequencer string, amount sdk.Coin) *MsgUndelegate {
+	return &MsgUndelegate{
+		Delegator: delegator,
+		Sequencer: sequencer,
+		Amount:    amount,
+	}
+}
+
+func (msg *MsgUndelegate) ValidateBasic() error {
+	return validateDelegationMsg(msg.Delegator, msg.Sequencer, msg.Amount)
+}
+
+func validateDelegationMsg(delegator, sequencer string, amount sdk.Coin) error {
+	if _, err := sdk.AccAddressFromBech32(delegator); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid delegator address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(sequencer); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid sequencer address (%s)", err)
+	}
+	if !(amount.IsValid() && amount.IsPositive()) {
+		return errorsmod.Wrapf(ErrInvalidCoins, "invalid amount: %s", amount.String())
+	}
+	return nil
+}
+
+func NewMsgUpdateCommission(creator string, rate math.LegacyDec) *MsgUpdateCommission {
+	return &MsgUpdateCommission{
+		Creator:        creator,
+		CommissionRate: rate,
+	}
+}
+
+func (msg *MsgUpdateCommission) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid creator address (%s)", err)
+	}
+	if err := uparam.ValidateZeroToOneDec(msg.CommissionRate); err != nil {
+		return errorsmod.Wrap(err, "commission rate")
+	}
+	return nil
+}
+
+func NewMsgDistributeRewards(creator, sequencer string) *MsgDistributeRewards {
+	return &MsgDistributeRewards{
+		Creator:   creator,
+		Sequencer: sequencer,
+	}
+}
+
+func (msg *MsgDistributeRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid creator address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sequencer); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid sequencer address (%s)", err)
+	}
+	return nil
+}
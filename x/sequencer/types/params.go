@@ -22,6 +22,19 @@ var (
 	DefaultDishonorStateUpdate   = uint64(1)
 	DefaultDishonorLiveness      = uint64(300)
 	DefaultDishonorKickThreshold = uint64(900)
+
+	// DefaultDelegationUnbondingPeriod is the time undelegated tokens stay in the queue
+	DefaultDelegationUnbondingPeriod = time.Hour * 24 * 21 // 3 weeks
+	// DefaultMinSelfBondFraction requires the sequencer to provide the whole min bond itself
+	DefaultMinSelfBondFraction = math.LegacyOneDec()
+	// DefaultUnbondingPeriod is the time unbonded sequencer tokens stay in the queue
+	DefaultUnbondingPeriod = time.Hour * 24 * 21 // 3 weeks
+	// DefaultMaxCommissionChangeRate is the most the commission rate can move in one update
+	DefaultMaxCommissionChangeRate = math.LegacyMustNewDecFromStr("0.01")
+	// DefaultCommissionChangeInterval is the minimum time between two commission rate updates
+	DefaultCommissionChangeInterval = time.Hour * 24 // 1 day
+	// DefaultRewardDenoms only accrues the bond denom in the reward pools
+	DefaultRewardDenoms []string
 )
 
 // NewParams creates a new Params instance
@@ -29,6 +42,12 @@ func NewParams(noticePeriod time.Duration, livenessSlashMul math.LegacyDec, live
 	dishonorStateUpdate uint64,
 	dishonorLiveness uint64,
 	dishonorKickThreshold uint64,
+	delegationUnbondingPeriod time.Duration,
+	minSelfBondFraction math.LegacyDec,
+	unbondingPeriod time.Duration,
+	maxCommissionChangeRate math.LegacyDec,
+	commissionChangeInterval time.Duration,
+	rewardDenoms []string,
 ) Params {
 	return Params{
 		NoticePeriod:               noticePeriod,
@@ -37,12 +56,18 @@ func NewParams(noticePeriod time.Duration, livenessSlashMul math.LegacyDec, live
 		DishonorStateUpdate:        dishonorStateUpdate,
 		DishonorLiveness:           dishonorLiveness,
 		DishonorKickThreshold:      dishonorKickThreshold,
+		DelegationUnbondingPeriod:  delegationUnbondingPeriod,
+		MinSelfBondFraction:        minSelfBondFraction,
+		UnbondingPeriod:            unbondingPeriod,
+		MaxCommissionChangeRate:    maxCommissionChangeRate,
+		CommissionChangeInterval:   commissionChangeInterval,
+		RewardDenoms:               rewardDenoms,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultNoticePeriod, DefaultLivenessSlashMultiplier, DefaultLivenessSlashMinAbsolute, DefaultDishonorStateUpdate, DefaultDishonorLiveness, DefaultDishonorKickThreshold)
+	return NewParams(DefaultNoticePeriod, DefaultLivenessSlashMultiplier, DefaultLivenessSlashMinAbsolute, DefaultDishonorStateUpdate, DefaultDishonorLiveness, DefaultDishonorKickThreshold, DefaultDelegationUnbondingPeriod, DefaultMinSelfBondFraction, DefaultUnbondingPeriod, DefaultMaxCommissionChangeRate, DefaultCommissionChangeInterval, DefaultRewardDenoms)
 }
 
 func validateTime(v time.Duration) error {
@@ -57,6 +82,23 @@ func validateLivenessSlashMultiplier(v math.LegacyDec) error {
 	return uparam.ValidateZeroToOneDec(v)
 }
 
+func validateRewardDenoms(denoms []string) error {
+	seen := make(map[string]struct{}, len(denoms))
+	for _, d := range denoms {
+		if err := sdk.ValidateDenom(d); err != nil {
+			return err
+		}
+		if d == commontypes.DYMCoin.Denom {
+			return fmt.Errorf("reward denoms must not contain the bond denom: %s", d)
+		}
+		if _, ok := seen[d]; ok {
+			return fmt.Errorf("duplicate reward denom: %s", d)
+		}
+		seen[d] = struct{}{}
+	}
+	return nil
+}
+
 // ValidateBasic validates the set of params
 func (p Params) ValidateBasic() error {
 	if err := validateTime(p.NoticePeriod); err != nil {
@@ -81,6 +123,30 @@ func (p Params) ValidateBasic() error {
 		return err
 	}
 
+	if err := validateTime(p.DelegationUnbondingPeriod); err != nil {
+		return err
+	}
+
+	if err := uparam.ValidateZeroToOneDec(p.MinSelfBondFraction); err != nil {
+		return err
+	}
+
+	if err := validateTime(p.UnbondingPeriod); err != nil {
+		return err
+	}
+
+	if err := uparam.ValidateZeroToOneDec(p.MaxCommissionChangeRate); err != nil {
+		return err
+	}
+
+	if err := validateTime(p.CommissionChangeInterval); err != nil {
+		return err
+	}
+
+	if err := validateRewardDenoms(p.RewardDenoms); err != nil {
+		return err
+	}
+
 	return nil
 }
 
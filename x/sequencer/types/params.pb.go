@@ -41,12 +41,32 @@ type Params struct {
 	LivenessSlashMinMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=liveness_slash_min_multiplier,json=livenessSlashMinMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"liveness_slash_min_multiplier" yaml:"liveness_slash_multiplier"`
 	// liveness_slash_min_absolute is the absolute minimum to slash for liveness
 	LivenessSlashMinAbsolute types.Coin `protobuf:"bytes,6,opt,name=liveness_slash_min_absolute,json=livenessSlashMinAbsolute,proto3" json:"liveness_slash_min_absolute,omitempty"`
-	// how many penalty points a sequencer gains on liveness events (+penalty)
+	// how much dishonor a sequencer gains on liveness events (+dishonor)
 	DishonorLiveness uint64 `protobuf:"varint,7,opt,name=dishonor_liveness,json=dishonorLiveness,proto3" json:"dishonor_liveness,omitempty"`
-	// how many penalty points a sequencer loses on state updates (-penalty)
+	// how much honor a sequencer gains on state updates (-dishonor)
 	DishonorStateUpdate uint64 `protobuf:"varint,8,opt,name=dishonor_state_update,json=dishonorStateUpdate,proto3" json:"dishonor_state_update,omitempty"`
-	// the minimum penalty at which a sequencer can be kicked (allowed if x <= penalty)
+	// the minimum dishonor at which a sequencer can be kicked (<=)
 	DishonorKickThreshold uint64 `protobuf:"varint,9,opt,name=dishonor_kick_threshold,json=dishonorKickThreshold,proto3" json:"dishonor_kick_threshold,omitempty"`
+	// delegation_unbonding_period is the time delegated tokens stay locked in
+	// the undelegation queue before being returned to the delegator. Slashing
+	// still applies during this period.
+	DelegationUnbondingPeriod time.Duration `protobuf:"bytes,10,opt,name=delegation_unbonding_period,json=delegationUnbondingPeriod,proto3,stdduration" json:"delegation_unbonding_period"`
+	// min_self_bond_fraction is the fraction of the rollapp min bond which must
+	// be provided by the sequencer itself. The rest can come from delegations.
+	MinSelfBondFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=min_self_bond_fraction,json=minSelfBondFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_self_bond_fraction"`
+	// unbonding_period is the time unbonded or decreased sequencer bond stays
+	// locked in the unbonding queue before being returned to the sequencer.
+	// Slashing still applies during this period.
+	UnbondingPeriod time.Duration `protobuf:"bytes,12,opt,name=unbonding_period,json=unbondingPeriod,proto3,stdduration" json:"unbonding_period"`
+	// max_commission_change_rate is the most the commission rate of a sequencer
+	// can move in one update, in either direction. The first rate is not bound.
+	MaxCommissionChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,13,opt,name=max_commission_change_rate,json=maxCommissionChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission_change_rate"`
+	// commission_change_interval is the minimum time between two commission
+	// rate updates of a sequencer.
+	CommissionChangeInterval time.Duration `protobuf:"bytes,14,opt,name=commission_change_interval,json=commissionChangeInterval,proto3,stdduration" json:"commission_change_interval"`
+	// reward_denoms are the denoms accrued by the sequencer reward pools in
+	// addition to the bond denom. Other balances of the pools are ignored.
+	RewardDenoms []string `protobuf:"bytes,15,rep,name=reward_denoms,json=rewardDenoms,proto3" json:"reward_denoms,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -116,6 +136,34 @@ func (m *Params) GetDishonorKickThreshold() uint64 {
 	return 0
 }
 
+func (m *Params) GetDelegationUnbondingPeriod() time.Duration {
+	if m != nil {
+		return m.DelegationUnbondingPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetUnbondingPeriod() time.Duration {
+	if m != nil {
+		return m.UnbondingPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetCommissionChangeInterval() time.Duration {
+	if m != nil {
+		return m.CommissionChangeInterval
+	}
+	return 0
+}
+
+func (m *Params) GetRewardDenoms() []string {
+	if m != nil {
+		return m.RewardDenoms
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.sequencer.Params")
 }
@@ -125,41 +173,51 @@ func init() {
 }
 
 var fileDescriptor_599b0eefba99ee26 = []byte{
-	// 530 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x73, 0xad, 0x09, 0xae, 0x01, 0x29, 0x18, 0x10, 0x6e, 0x2a, 0x6c, 0x2b, 0x12, 0x52,
-	0x24, 0xc8, 0x9d, 0xda, 0x4a, 0x1d, 0xba, 0x11, 0x32, 0xa0, 0xd0, 0x4a, 0x51, 0x4a, 0x17, 0x16,
-	0xeb, 0x6c, 0x1f, 0xf6, 0x29, 0xb6, 0xcf, 0xf8, 0xce, 0x51, 0xcd, 0x17, 0x60, 0x65, 0xcc, 0xd8,
-	0x0f, 0xc0, 0xc8, 0x87, 0xe8, 0x58, 0x31, 0x21, 0x86, 0x80, 0x92, 0x05, 0x31, 0xf2, 0x09, 0x90,
-	0xff, 0xaa, 0xaa, 0xa0, 0x5b, 0x9e, 0x7b, 0x7e, 0xcf, 0x93, 0xf7, 0x5e, 0xf9, 0x94, 0x81, 0x9b,
-	0x85, 0x24, 0xe2, 0x94, 0x45, 0x67, 0xd9, 0x07, 0xd4, 0x08, 0xc4, 0xc9, 0xfb, 0x94, 0x44, 0x0e,
-	0x49, 0x50, 0x8c, 0x13, 0x1c, 0x72, 0x18, 0x27, 0x4c, 0x30, 0xd5, 0xbc, 0x8a, 0xc3, 0x46, 0xc0,
-	0x06, 0xef, 0x3e, 0xf4, 0x98, 0xc7, 0x0a, 0x18, 0xe5, 0xbf, 0xca, 0x5c, 0x77, 0xdb, 0x61, 0x3c,
-	0x64, 0xdc, 0x2a, 0x8d, 0x52, 0x54, 0x96, 0x5e, 0x2a, 0x64, 0x63, 0x4e, 0xd0, 0x7c, 0xd7, 0x26,
-	0x02, 0xef, 0x22, 0x87, 0xd1, 0xa8, 0xf6, 0x3d, 0xc6, 0xbc, 0x80, 0xa0, 0x42, 0xd9, 0xe9, 0x3b,
-	0xe4, 0xa6, 0x09, 0x16, 0xf9, 0x9f, 0x16, 0x27, 0xbd, 0xcf, 0x92, 0xd2, 0x9e, 0x14, 0x33, 0xaa,
-	0xaf, 0x94, 0x7b, 0x11, 0x13, 0xd4, 0x21, 0x56, 0x4c, 0x12, 0xca, 0x5c, 0x6d, 0xd3, 0x04, 0xfd,
-	0x3b, 0x7b, 0xdb, 0xb0, 0xac, 0x80, 0x75, 0x05, 0x1c, 0x55, 0x15, 0x43, 0xf9, 0x62, 0x69, 0xb4,
-	0x16, 0x3f, 0x0c, 0x30, 0xbd, 0x5b, 0x26, 0x27, 0x45, 0x50, 0x5d, 0x00, 0xe5, 0x49, 0x40, 0xe7,
-	0x24, 0x22, 0x9c, 0x5b, 0x3c, 0xc0, 0xdc, 0xb7, 0x42, 0x1a, 0x59, 0x61, 0x1a, 0x08, 0x1a, 0x07,
-	0x94, 0x24, 0x9a, 0x64, 0x82, 0xfe, 0xd6, 0xf0, 0x34, 0xcf, 0x7f, 0x5f, 0x1a, 0x3b, 0xe5, 0x25,
-	0xb8, 0x3b, 0x83, 0x94, 0xa1, 0x10, 0x0b, 0x1f, 0x1e, 0x11, 0x0f, 0x3b, 0xd9, 0x88, 0x38, 0x7f,
-	0x96, 0x86, 0x99, 0xe1, 0x30, 0x38, 0xec, 0x5d, 0x6f, 0x6c, 0xda, 0x7a, 0x5f, 0xbf, 0x0c, 0x94,
-	0x6a, 0x2b, 0x23, 0xe2, 0x4c, 0xbb, 0x35, 0x79, 0x92, 0x83, 0xc7, 0x34, 0x3a, 0x6e, 0x50, 0xf5,
-	0x23, 0x50, 0x76, 0xfe, 0x31, 0x1a, 0xb6, 0x39, 0x0b, 0x52, 0x41, 0xb4, 0x76, 0x75, 0xe7, 0xaa,
-	0x2e, 0x5f, 0x2b, 0xac, 0xd6, 0x0a, 0x5f, 0x32, 0x1a, 0x0d, 0x07, 0xf9, 0xcc, 0xbf, 0x97, 0xc6,
-	0xd3, 0x1b, 0x5a, 0x9e, 0xb3, 0x90, 0x0a, 0x12, 0xc6, 0x22, 0x9b, 0x6a, 0xd7, 0x67, 0x79, 0x51,
-	0x31, 0xea, 0x33, 0xe5, 0xbe, 0x4b, 0xb9, 0xcf, 0x22, 0x96, 0x58, 0x35, 0xa4, 0xdd, 0x36, 0x41,
-	0x5f, 0x9a, 0x76, 0x6a, 0xe3, 0xa8, 0x3a, 0x57, 0xf7, 0x94, 0x47, 0x0d, 0xcc, 0x05, 0x16, 0xc4,
-	0x4a, 0x63, 0x17, 0x0b, 0xa2, 0xc9, 0x45, 0xe0, 0x41, 0x6d, 0x9e, 0xe4, 0xde, 0x69, 0x61, 0xa9,
-	0x07, 0xca, 0xe3, 0x26, 0x33, 0xa3, 0xce, 0xcc, 0x12, 0x7e, 0x42, 0xb8, 0xcf, 0x02, 0x57, 0xdb,
-	0x2a, 0x52, 0x4d, 0xe5, 0x6b, 0xea, 0xcc, 0xde, 0xd4, 0xe6, 0xa1, 0xbc, 0x38, 0x37, 0x5a, 0xbf,
-	0xce, 0x0d, 0x30, 0x96, 0x64, 0xd0, 0xd9, 0x18, 0x4b, 0xf2, 0xad, 0x4e, 0x7b, 0x2c, 0xc9, 0x1b,
-	0x9d, 0xcd, 0xe1, 0xe4, 0x62, 0xa5, 0x83, 0xcb, 0x95, 0x0e, 0x7e, 0xae, 0x74, 0xf0, 0x69, 0xad,
-	0xb7, 0x2e, 0xd7, 0x7a, 0xeb, 0xdb, 0x5a, 0x6f, 0xbd, 0x3d, 0xf0, 0xa8, 0xf0, 0x53, 0x1b, 0x3a,
-	0x2c, 0x44, 0xff, 0x79, 0x15, 0xf3, 0x7d, 0x74, 0x76, 0xe5, 0x69, 0x88, 0x2c, 0x26, 0xdc, 0x6e,
-	0x17, 0x9f, 0xd5, 0xfe, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9c, 0xe4, 0xe4, 0x9d, 0x4b, 0x03,
-	0x00, 0x00,
+	// 698 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xbf, 0x6e, 0x13, 0x4b,
+	0x14, 0xc6, 0xbd, 0x89, 0xaf, 0xaf, 0x33, 0x49, 0x6e, 0x7c, 0x37, 0xf7, 0x92, 0x8d, 0x23, 0x6c,
+	0x2b, 0x08, 0xc9, 0x12, 0x64, 0x57, 0x49, 0xa4, 0x14, 0xe9, 0x70, 0x2c, 0x04, 0x21, 0x41, 0x91,
+	0x43, 0x1a, 0x9a, 0xd5, 0x78, 0xf6, 0x78, 0x3d, 0xf2, 0xce, 0x8c, 0xd9, 0x99, 0x35, 0x36, 0x2f,
+	0x40, 0x4b, 0x99, 0x32, 0x0f, 0xc1, 0x0b, 0xd0, 0xa5, 0x8c, 0xa8, 0x10, 0x85, 0x41, 0x49, 0x83,
+	0x28, 0x79, 0x02, 0xb4, 0x7f, 0x89, 0x1c, 0x40, 0x16, 0x9d, 0xcf, 0x7c, 0xdf, 0xf9, 0xed, 0x39,
+	0x73, 0x8e, 0x07, 0x6d, 0x38, 0x23, 0x06, 0x5c, 0x52, 0xc1, 0x87, 0xa3, 0x57, 0x56, 0x16, 0x58,
+	0x12, 0x5e, 0x04, 0xc0, 0x09, 0xf8, 0x56, 0x1f, 0xfb, 0x98, 0x49, 0xb3, 0xef, 0x0b, 0x25, 0xf4,
+	0xda, 0x75, 0xbb, 0x99, 0x05, 0x66, 0x66, 0x2f, 0xff, 0xe7, 0x0a, 0x57, 0x44, 0x66, 0x2b, 0xfc,
+	0x15, 0xe7, 0x95, 0x57, 0x89, 0x90, 0x4c, 0x48, 0x3b, 0x16, 0xe2, 0x20, 0x91, 0x2a, 0x71, 0x64,
+	0xb5, 0xb1, 0x04, 0x6b, 0xb0, 0xd9, 0x06, 0x85, 0x37, 0x2d, 0x22, 0x28, 0x4f, 0x75, 0x57, 0x08,
+	0xd7, 0x03, 0x2b, 0x8a, 0xda, 0x41, 0xc7, 0x72, 0x02, 0x1f, 0xab, 0xf0, 0xa3, 0xd1, 0xc9, 0xfa,
+	0xbb, 0x22, 0x2a, 0x1c, 0x45, 0x35, 0xea, 0x8f, 0xd0, 0x22, 0x17, 0x8a, 0x12, 0xb0, 0xfb, 0xe0,
+	0x53, 0xe1, 0x18, 0xb3, 0x35, 0xad, 0x3e, 0xbf, 0xb5, 0x6a, 0xc6, 0x08, 0x33, 0x45, 0x98, 0xcd,
+	0x04, 0xd1, 0x28, 0x9e, 0x8f, 0xab, 0xb9, 0xd3, 0x4f, 0x55, 0xad, 0xb5, 0x10, 0x67, 0x1e, 0x45,
+	0x89, 0xfa, 0xa9, 0x86, 0x6e, 0x7b, 0x74, 0x00, 0x1c, 0xa4, 0xb4, 0xa5, 0x87, 0x65, 0xd7, 0x66,
+	0x94, 0xdb, 0x2c, 0xf0, 0x14, 0xed, 0x7b, 0x14, 0x7c, 0x23, 0x5f, 0xd3, 0xea, 0x73, 0x8d, 0x93,
+	0x30, 0xff, 0xe3, 0xb8, 0xba, 0x16, 0x37, 0x21, 0x9d, 0x9e, 0x49, 0x85, 0xc5, 0xb0, 0xea, 0x9a,
+	0x07, 0xe0, 0x62, 0x32, 0x6a, 0x02, 0xf9, 0x36, 0xae, 0xd6, 0x46, 0x98, 0x79, 0xbb, 0xeb, 0x93,
+	0xc4, 0x8c, 0xb6, 0xfe, 0xfe, 0xed, 0x06, 0x4a, 0x6e, 0xa5, 0x09, 0xa4, 0x55, 0x4e, 0x9d, 0xc7,
+	0xa1, 0xf1, 0x90, 0xf2, 0xc3, 0xcc, 0xaa, 0xbf, 0xd6, 0xd0, 0xda, 0x4f, 0x4a, 0xc3, 0x6d, 0x29,
+	0xbc, 0x40, 0x81, 0x51, 0x48, 0x7a, 0x4e, 0x70, 0xe1, 0xb5, 0x9a, 0xc9, 0xb5, 0x9a, 0x7b, 0x82,
+	0xf2, 0xc6, 0x46, 0x58, 0xf3, 0xd7, 0x71, 0xf5, 0xee, 0x6f, 0x28, 0xf7, 0x05, 0xa3, 0x0a, 0x58,
+	0x5f, 0x8d, 0x5a, 0xc6, 0x64, 0x2d, 0x0f, 0x12, 0x8f, 0x7e, 0x0f, 0xfd, 0xeb, 0x50, 0xd9, 0x15,
+	0x5c, 0xf8, 0x76, 0x6a, 0x32, 0xfe, 0xae, 0x69, 0xf5, 0x7c, 0xab, 0x94, 0x0a, 0x07, 0xc9, 0xb9,
+	0xbe, 0x85, 0xfe, 0xcf, 0xcc, 0x52, 0x61, 0x05, 0x76, 0xd0, 0x77, 0xb0, 0x02, 0xa3, 0x18, 0x25,
+	0x2c, 0xa7, 0xe2, 0x71, 0xa8, 0x9d, 0x44, 0x92, 0xbe, 0x83, 0x56, 0xb2, 0x9c, 0x1e, 0x25, 0x3d,
+	0x5b, 0x75, 0x7d, 0x90, 0x5d, 0xe1, 0x39, 0xc6, 0x5c, 0x94, 0x95, 0x21, 0x9f, 0x50, 0xd2, 0x7b,
+	0x96, 0x8a, 0x3a, 0x41, 0x6b, 0x0e, 0x78, 0xe0, 0x46, 0x33, 0xb6, 0x03, 0xde, 0x16, 0xdc, 0xa1,
+	0xdc, 0x4d, 0xb7, 0x02, 0x4d, 0xbf, 0x15, 0xab, 0x3f, 0x38, 0x27, 0x29, 0x26, 0x59, 0x91, 0x0e,
+	0xba, 0x15, 0xde, 0x98, 0x04, 0xaf, 0x63, 0x87, 0x8a, 0xdd, 0xf1, 0x31, 0x09, 0x8d, 0xc6, 0x7c,
+	0xb4, 0x1a, 0x9b, 0x53, 0xac, 0xc6, 0xc4, 0xd8, 0x97, 0x19, 0xe5, 0xc7, 0xe0, 0x75, 0x1a, 0x82,
+	0x3b, 0x0f, 0x13, 0x9a, 0xfe, 0x14, 0x95, 0x6e, 0x74, 0xb0, 0x30, 0x7d, 0x07, 0x4b, 0xc1, 0x44,
+	0xdd, 0x1c, 0x95, 0x19, 0x1e, 0xda, 0x44, 0x30, 0x46, 0x65, 0xf8, 0xe7, 0xb5, 0x49, 0x17, 0x73,
+	0x17, 0x6c, 0x3f, 0x9c, 0xc6, 0xe2, 0x9f, 0xd6, 0xbe, 0xc2, 0xf0, 0x70, 0x2f, 0x63, 0xee, 0x45,
+	0xc8, 0x56, 0x38, 0x44, 0x8c, 0xca, 0x37, 0xbf, 0x45, 0xb9, 0x02, 0x7f, 0x80, 0x3d, 0xe3, 0x9f,
+	0xe9, 0x3b, 0x31, 0xc8, 0x04, 0xfe, 0x71, 0x02, 0xd1, 0xef, 0xa0, 0x45, 0x1f, 0x5e, 0x62, 0xdf,
+	0xb1, 0x1d, 0xe0, 0x82, 0x49, 0x63, 0xa9, 0x36, 0x5b, 0x9f, 0x6b, 0x2d, 0xc4, 0x87, 0xcd, 0xe8,
+	0x6c, 0xb7, 0x78, 0x7a, 0x56, 0xcd, 0x7d, 0x39, 0xab, 0x6a, 0xfb, 0xf9, 0xa2, 0x56, 0x9a, 0xd9,
+	0xcf, 0x17, 0xff, 0x2a, 0x15, 0xf6, 0xf3, 0xc5, 0x99, 0xd2, 0x6c, 0xe3, 0xe8, 0xfc, 0xb2, 0xa2,
+	0x5d, 0x5c, 0x56, 0xb4, 0xcf, 0x97, 0x15, 0xed, 0xcd, 0x55, 0x25, 0x77, 0x71, 0x55, 0xc9, 0x7d,
+	0xb8, 0xaa, 0xe4, 0x9e, 0xef, 0xb8, 0x54, 0x75, 0x83, 0xb6, 0x49, 0x04, 0xb3, 0x7e, 0xf1, 0x54,
+	0x0e, 0xb6, 0xad, 0xe1, 0xb5, 0xf7, 0x52, 0x8d, 0xfa, 0x20, 0xdb, 0x85, 0xa8, 0x93, 0xed, 0xef,
+	0x03, 0x00, 0xfc, 0x8e, 0x23, 0x7d, 0x60, 0x05, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -199,6 +257,29 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.DishonorKickThreshold != that1.DishonorKickThreshold {
 		return false
 	}
+	if this.DelegationUnbondingPeriod != that1.DelegationUnbondingPeriod {
+		return false
+	}
+	if !this.MinSelfBondFraction.Equal(that1.MinSelfBondFraction) {
+		return false
+	}
+	if this.UnbondingPeriod != that1.UnbondingPeriod {
+		return false
+	}
+	if !this.MaxCommissionChangeRate.Equal(that1.MaxCommissionChangeRate) {
+		return false
+	}
+	if this.CommissionChangeInterval != that1.CommissionChangeInterval {
+		return false
+	}
+	if len(this.RewardDenoms) != len(that1.RewardDenoms) {
+		return false
+	}
+	for i := range this.RewardDenoms {
+		if this.RewardDenoms[i] != that1.RewardDenoms[i] {
+			return false
+		}
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -221,6 +302,59 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RewardDenoms) > 0 {
+		for iNdEx := len(m.RewardDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RewardDenoms[iNdEx])
+			copy(dAtA[i:], m.RewardDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.RewardDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CommissionChangeInterval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CommissionChangeInterval):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.MaxCommissionChangeRate.Size()
+		i -= size
+		if _, err := m.MaxCommissionChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.MinSelfBondFraction.Size()
+		i -= size
+		if _, err := m.MinSelfBondFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DelegationUnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DelegationUnbondingPeriod):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x52
 	if m.DishonorKickThreshold != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.DishonorKickThreshold))
 		i--
@@ -256,12 +390,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.NoticePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.NoticePeriod):])
-	if err2 != nil {
-		return 0, err2
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.NoticePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.NoticePeriod):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n2
-	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i -= n5
+	i = encodeVarintParams(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x1a
 	return len(dAtA) - i, nil
@@ -299,6 +433,22 @@ func (m *Params) Size() (n int) {
 	if m.DishonorKickThreshold != 0 {
 		n += 1 + sovParams(uint64(m.DishonorKickThreshold))
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DelegationUnbondingPeriod)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinSelfBondFraction.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxCommissionChangeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CommissionChangeInterval)
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.RewardDenoms) > 0 {
+		for _, s := range m.RewardDenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -494,6 +644,205 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationUnbondingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DelegationUnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfBondFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSelfBondFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommissionChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionChangeInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CommissionChangeInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardDenoms = append(m.RewardDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
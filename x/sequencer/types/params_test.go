@@ -5,6 +5,8 @@ import (
 
 	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 )
 
 func TestValidateBasic(t *testing.T) {
@@ -38,6 +40,24 @@ func TestValidateBasic(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"invalid min self bond fraction",
+			func() Params {
+				p := params
+				p.MinSelfBondFraction = math.LegacyNewDec(2)
+				return p
+			}(),
+			true,
+		},
+		{
+			"bond denom in reward denoms",
+			func() Params {
+				p := params
+				p.RewardDenoms = []string{commontypes.DYMCoin.Denom}
+				return p
+			}(),
+			true,
+		},
 	}
 
 	for _, tt := range tests {
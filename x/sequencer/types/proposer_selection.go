@@ -0,0 +1,41 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+var _ sdk.Msg = &MsgUpdateProposerSelection{}
+
+func ValidateProposerSelectionAlgo(algo ProposerSelectionAlgo) error {
+	if _, ok := ProposerSelectionAlgo_name[int32(algo)]; !ok {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "unknown proposer selection algo: %d", algo)
+	}
+	return nil
+}
+
+func (p ProposerSelection) ValidateBasic() error {
+	if p.RollappId == "" {
+		return gerrc.ErrInvalidArgument.Wrap("rollapp id")
+	}
+	return ValidateProposerSelectionAlgo(p.Algo)
+}
+
+func NewMsgUpdateProposerSelection(owner, rollappID string, algo ProposerSelectionAlgo) *MsgUpdateProposerSelection {
+	return &MsgUpdateProposerSelection{
+		Owner:     owner,
+		RollappId: rollappID,
+		Algo:      algo,
+	}
+}
+
+func (msg *MsgUpdateProposerSelection) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid owner address (%s)", err)
+	}
+	if msg.RollappId == "" {
+		return gerrc.ErrInvalidArgument.Wrap("rollapp id")
+	}
+	return ValidateProposerSelectionAlgo(msg.Algo)
+}
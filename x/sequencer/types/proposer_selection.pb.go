@@ -0,0 +1,466 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/sequencer/proposer_selection.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ProposerSelectionAlgo defines how the proposer (or successor) is chosen
+// among the opted in and bonded sequencers of a rollapp
+type ProposerSelectionAlgo int32
+
+const (
+	// PROPOSER_SELECTION_HIGHEST_BOND chooses the sequencer with the most bond
+	HighestBond ProposerSelectionAlgo = 0
+	// PROPOSER_SELECTION_BOND_WEIGHTED_RANDOM chooses randomly, with a
+	// probability proportional to the bond, using the block hash as entropy.
+	// The hub block proposer can grind the block hash to bias the choice.
+	BondWeightedRandom ProposerSelectionAlgo = 1
+	// PROPOSER_SELECTION_ROUND_ROBIN cycles through the sequencers in address
+	// order
+	RoundRobin ProposerSelectionAlgo = 2
+	// PROPOSER_SELECTION_DISHONOR_DISCOUNTED_BOND chooses the sequencer with the
+	// most bond, after discounting it by the dishonor relative to the kick
+	// threshold
+	DishonorDiscountedBond ProposerSelectionAlgo = 3
+)
+
+var ProposerSelectionAlgo_name = map[int32]string{
+	0: "PROPOSER_SELECTION_HIGHEST_BOND",
+	1: "PROPOSER_SELECTION_BOND_WEIGHTED_RANDOM",
+	2: "PROPOSER_SELECTION_ROUND_ROBIN",
+	3: "PROPOSER_SELECTION_DISHONOR_DISCOUNTED_BOND",
+}
+
+var ProposerSelectionAlgo_value = map[string]int32{
+	"PROPOSER_SELECTION_HIGHEST_BOND":             0,
+	"PROPOSER_SELECTION_BOND_WEIGHTED_RANDOM":     1,
+	"PROPOSER_SELECTION_ROUND_ROBIN":              2,
+	"PROPOSER_SELECTION_DISHONOR_DISCOUNTED_BOND": 3,
+}
+
+func (x ProposerSelectionAlgo) String() string {
+	return proto.EnumName(ProposerSelectionAlgo_name, int32(x))
+}
+
+func (ProposerSelectionAlgo) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9ad50eab91cd6076, []int{0}
+}
+
+// ProposerSelection is the proposer selection configuration of a rollapp
+type ProposerSelection struct {
+	RollappId string                `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	Algo      ProposerSelectionAlgo `protobuf:"varint,2,opt,name=algo,proto3,enum=dymensionxyz.dymension.sequencer.ProposerSelectionAlgo" json:"algo,omitempty"`
+	// LastSelected is the last sequencer chosen by round robin
+	LastSelected string `protobuf:"bytes,3,opt,name=last_selected,json=lastSelected,proto3" json:"last_selected,omitempty"`
+}
+
+func (m *ProposerSelection) Reset()         { *m = ProposerSelection{} }
+func (m *ProposerSelection) String() string { return proto.CompactTextString(m) }
+func (*ProposerSelection) ProtoMessage()    {}
+func (*ProposerSelection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ad50eab91cd6076, []int{0}
+}
+func (m *ProposerSelection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposerSelection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposerSelection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposerSelection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerSelection.Merge(m, src)
+}
+func (m *ProposerSelection) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposerSelection) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerSelection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposerSelection proto.InternalMessageInfo
+
+func (m *ProposerSelection) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *ProposerSelection) GetAlgo() ProposerSelectionAlgo {
+	if m != nil {
+		return m.Algo
+	}
+	return HighestBond
+}
+
+func (m *ProposerSelection) GetLastSelected() string {
+	if m != nil {
+		return m.LastSelected
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("dymensionxyz.dymension.sequencer.ProposerSelectionAlgo", ProposerSelectionAlgo_name, ProposerSelectionAlgo_value)
+	proto.RegisterType((*ProposerSelection)(nil), "dymensionxyz.dymension.sequencer.ProposerSelection")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/sequencer/proposer_selection.proto", fileDescriptor_9ad50eab91cd6076)
+}
+
+var fileDescriptor_9ad50eab91cd6076 = []byte{
+	// 436 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0x8b, 0xd3, 0x40,
+	0x14, 0xc6, 0x93, 0xee, 0x22, 0xec, 0xa8, 0x6b, 0x1d, 0x74, 0x29, 0x01, 0x63, 0xd0, 0x83, 0x8b,
+	0x42, 0x02, 0xbb, 0xa2, 0x78, 0xdc, 0x36, 0x61, 0x13, 0x56, 0x33, 0x65, 0xd2, 0x65, 0xc1, 0x4b,
+	0x68, 0x3b, 0x43, 0x3a, 0x90, 0xce, 0x8b, 0x99, 0xa9, 0x6c, 0xfd, 0x0b, 0xa4, 0x27, 0xaf, 0x1e,
+	0x7a, 0xd2, 0x3f, 0xc6, 0xe3, 0x1e, 0x3d, 0x4a, 0xfb, 0x8f, 0x48, 0x62, 0x2d, 0x0b, 0x46, 0xf6,
+	0x36, 0xef, 0xe3, 0xfd, 0xbe, 0xef, 0x0d, 0x7c, 0xe8, 0x0d, 0x9b, 0x4f, 0xb9, 0x54, 0x02, 0xe4,
+	0xe5, 0xfc, 0x93, 0xb7, 0x1d, 0x3c, 0xc5, 0x3f, 0xcc, 0xb8, 0x1c, 0xf3, 0xd2, 0x2b, 0x4a, 0x28,
+	0x40, 0xf1, 0x32, 0x55, 0x3c, 0xe7, 0x63, 0x2d, 0x40, 0xba, 0x45, 0x09, 0x1a, 0xb0, 0x73, 0x1d,
+	0x75, 0xb7, 0x83, 0xbb, 0x45, 0xad, 0x07, 0x19, 0x64, 0x50, 0x2f, 0x7b, 0xd5, 0xeb, 0x0f, 0xf7,
+	0xe4, 0xbb, 0x89, 0xee, 0xf7, 0x37, 0xa6, 0xc9, 0x5f, 0x4f, 0xfc, 0x08, 0xa1, 0x12, 0xf2, 0x7c,
+	0x58, 0x14, 0xa9, 0x60, 0x1d, 0xd3, 0x31, 0x0f, 0xf7, 0xe8, 0xde, 0x46, 0x89, 0x18, 0x3e, 0x43,
+	0xbb, 0xc3, 0x3c, 0x83, 0x4e, 0xcb, 0x31, 0x0f, 0xf7, 0x8f, 0x5e, 0xbb, 0x37, 0x65, 0xbb, 0xff,
+	0x24, 0x9c, 0xe4, 0x19, 0xd0, 0xda, 0x04, 0x3f, 0x45, 0x77, 0xf3, 0xa1, 0xd2, 0x9b, 0x1f, 0x71,
+	0xd6, 0xd9, 0xa9, 0xe3, 0xee, 0x54, 0x62, 0xb2, 0xd1, 0x9e, 0x7f, 0x6d, 0xa1, 0x87, 0x8d, 0x26,
+	0xf8, 0x25, 0x7a, 0xdc, 0xa7, 0xa4, 0x4f, 0x92, 0x80, 0xa6, 0x49, 0xf0, 0x36, 0xe8, 0x0d, 0x22,
+	0x12, 0xa7, 0x61, 0x74, 0x1a, 0x06, 0xc9, 0x20, 0xed, 0x92, 0xd8, 0x6f, 0x1b, 0xd6, 0xbd, 0xc5,
+	0xd2, 0xb9, 0x1d, 0x8a, 0x6c, 0xc2, 0x95, 0xee, 0x82, 0x64, 0xb8, 0x87, 0x9e, 0x35, 0x50, 0xd5,
+	0x76, 0x7a, 0x11, 0x44, 0xa7, 0xe1, 0x20, 0xf0, 0x53, 0x7a, 0x12, 0xfb, 0xe4, 0x5d, 0xdb, 0xb4,
+	0x0e, 0x16, 0x4b, 0x07, 0x57, 0xd8, 0x05, 0x17, 0xd9, 0x44, 0x73, 0x46, 0x87, 0x92, 0xc1, 0x14,
+	0x1f, 0x21, 0xbb, 0xc1, 0x84, 0x92, 0xf3, 0xd8, 0x4f, 0x29, 0xe9, 0x46, 0x71, 0xbb, 0x65, 0xed,
+	0x2f, 0x96, 0x0e, 0xa2, 0x30, 0x93, 0x8c, 0xc2, 0x48, 0x48, 0x7c, 0x86, 0x5e, 0x34, 0x30, 0x7e,
+	0x94, 0x84, 0x24, 0x26, 0xb4, 0x7a, 0xf4, 0xc8, 0x79, 0x5c, 0xc5, 0xd7, 0xa7, 0xef, 0x58, 0xd6,
+	0x62, 0xe9, 0x1c, 0xf8, 0x42, 0x4d, 0x40, 0x42, 0xe9, 0x0b, 0x35, 0x86, 0x99, 0xd4, 0x9c, 0x55,
+	0xe7, 0x58, 0xbb, 0x9f, 0xbf, 0xd9, 0x46, 0xb7, 0xff, 0x63, 0x65, 0x9b, 0x57, 0x2b, 0xdb, 0xfc,
+	0xb5, 0xb2, 0xcd, 0x2f, 0x6b, 0xdb, 0xb8, 0x5a, 0xdb, 0xc6, 0xcf, 0xb5, 0x6d, 0xbc, 0x7f, 0x95,
+	0x09, 0x3d, 0x99, 0x8d, 0xdc, 0x31, 0x4c, 0xbd, 0xff, 0x54, 0xeb, 0xe3, 0xb1, 0x77, 0x79, 0xad,
+	0x5f, 0x7a, 0x5e, 0x70, 0x35, 0xba, 0x55, 0x77, 0xe3, 0xf8, 0xf7, 0x00, 0x16, 0xd8, 0x0b, 0x53,
+	0x90, 0x02, 0x00, 0x00,
+}
+
+func (m *ProposerSelection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposerSelection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposerSelection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastSelected) > 0 {
+		i -= len(m.LastSelected)
+		copy(dAtA[i:], m.LastSelected)
+		i = encodeVarintProposerSelection(dAtA, i, uint64(len(m.LastSelected)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Algo != 0 {
+		i = encodeVarintProposerSelection(dAtA, i, uint64(m.Algo))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintProposerSelection(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposerSelection(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposerSelection(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ProposerSelection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovProposerSelection(uint64(l))
+	}
+	if m.Algo != 0 {
+		n += 1 + sovProposerSelection(uint64(m.Algo))
+	}
+	l = len(m.LastSelected)
+	if l > 0 {
+		n += 1 + l + sovProposerSelection(uint64(l))
+	}
+	return n
+}
+
+func sovProposerSelection(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposerSelection(x uint64) (n int) {
+	return sovProposerSelection(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ProposerSelection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposerSelection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposerSelection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposerSelection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposerSelection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposerSelection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposerSelection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Algo", wireType)
+			}
+			m.Algo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposerSelection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Algo |= ProposerSelectionAlgo(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSelected", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposerSelection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposerSelection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposerSelection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastSelected = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposerSelection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposerSelection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProposerSelection(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProposerSelection
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposerSelection
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposerSelection
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProposerSelection
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProposerSelection
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProposerSelection
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProposerSelection        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProposerSelection          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProposerSelection = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,581 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/sequencer/reputation.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Reputation is the performance record of a sequencer over its lifetime
+type Reputation struct {
+	// Sequencer is the bech32-encoded address of the sequencer
+	Sequencer string `protobuf:"bytes,1,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+	// StateUpdates is the number of state updates submitted as proposer
+	StateUpdates uint64 `protobuf:"varint,2,opt,name=state_updates,json=stateUpdates,proto3" json:"state_updates,omitempty"`
+	// BlocksCovered is the number of rollapp blocks in those state updates
+	BlocksCovered uint64 `protobuf:"varint,3,opt,name=blocks_covered,json=blocksCovered,proto3" json:"blocks_covered,omitempty"`
+	// LivenessSlashes is the number of times the sequencer was slashed for
+	// liveness
+	LivenessSlashes uint64 `protobuf:"varint,4,opt,name=liveness_slashes,json=livenessSlashes,proto3" json:"liveness_slashes,omitempty"`
+	// DishonorAdded is the total dishonor added for downtime
+	DishonorAdded uint64 `protobuf:"varint,5,opt,name=dishonor_added,json=dishonorAdded,proto3" json:"dishonor_added,omitempty"`
+	// DishonorRemoved is the total dishonor removed for uptime
+	DishonorRemoved uint64 `protobuf:"varint,6,opt,name=dishonor_removed,json=dishonorRemoved,proto3" json:"dishonor_removed,omitempty"`
+	// Kicks is the number of times the sequencer was kicked as proposer
+	Kicks uint64 `protobuf:"varint,7,opt,name=kicks,proto3" json:"kicks,omitempty"`
+	// ProposerTenures is the number of times the sequencer became proposer
+	ProposerTenures uint64 `protobuf:"varint,8,opt,name=proposer_tenures,json=proposerTenures,proto3" json:"proposer_tenures,omitempty"`
+}
+
+func (m *Reputation) Reset()         { *m = Reputation{} }
+func (m *Reputation) String() string { return proto.CompactTextString(m) }
+func (*Reputation) ProtoMessage()    {}
+func (*Reputation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d88cf06f233cab7a, []int{0}
+}
+func (m *Reputation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Reputation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Reputation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Reputation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Reputation.Merge(m, src)
+}
+func (m *Reputation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Reputation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Reputation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Reputation proto.InternalMessageInfo
+
+func (m *Reputation) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
+	}
+	return ""
+}
+
+func (m *Reputation) GetStateUpdates() uint64 {
+	if m != nil {
+		return m.StateUpdates
+	}
+	return 0
+}
+
+func (m *Reputation) GetBlocksCovered() uint64 {
+	if m != nil {
+		return m.BlocksCovered
+	}
+	return 0
+}
+
+func (m *Reputation) GetLivenessSlashes() uint64 {
+	if m != nil {
+		return m.LivenessSlashes
+	}
+	return 0
+}
+
+func (m *Reputation) GetDishonorAdded() uint64 {
+	if m != nil {
+		return m.DishonorAdded
+	}
+	return 0
+}
+
+func (m *Reputation) GetDishonorRemoved() uint64 {
+	if m != nil {
+		return m.DishonorRemoved
+	}
+	return 0
+}
+
+func (m *Reputation) GetKicks() uint64 {
+	if m != nil {
+		return m.Kicks
+	}
+	return 0
+}
+
+func (m *Reputation) GetProposerTenures() uint64 {
+	if m != nil {
+		return m.ProposerTenures
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Reputation)(nil), "dymensionxyz.dymension.sequencer.Reputation")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/sequencer/reputation.proto", fileDescriptor_d88cf06f233cab7a)
+}
+
+var fileDescriptor_d88cf06f233cab7a = []byte{
+	// 313 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0xd1, 0xc1, 0x4e, 0xc2, 0x40,
+	0x10, 0x06, 0x60, 0x8a, 0x80, 0xb2, 0x11, 0x35, 0x8d, 0x87, 0x3d, 0x98, 0x86, 0x68, 0x4c, 0xf0,
+	0xd2, 0xc6, 0x90, 0x78, 0x57, 0x5f, 0xc0, 0x54, 0xbd, 0x78, 0x69, 0x4a, 0x77, 0x22, 0x0d, 0xb0,
+	0xb3, 0xee, 0x6c, 0x1b, 0xf0, 0x29, 0x7c, 0x18, 0x1f, 0xc2, 0x23, 0x47, 0x8f, 0x86, 0xbe, 0x88,
+	0xa1, 0x9b, 0xae, 0x5c, 0x3c, 0xce, 0x37, 0x33, 0xff, 0xe5, 0x67, 0xd7, 0x62, 0xb5, 0x00, 0x49,
+	0x39, 0xca, 0xe5, 0xea, 0x3d, 0x72, 0x43, 0x44, 0xf0, 0x56, 0x80, 0xcc, 0x40, 0x47, 0x1a, 0x54,
+	0x61, 0x52, 0x93, 0xa3, 0x0c, 0x95, 0x46, 0x83, 0xfe, 0x70, 0xf7, 0x25, 0x74, 0x43, 0xe8, 0x5e,
+	0xce, 0x3f, 0xdb, 0x8c, 0xc5, 0xee, 0xcd, 0x3f, 0x63, 0x7d, 0xb7, 0xe3, 0xde, 0xd0, 0x1b, 0xf5,
+	0xe3, 0x3f, 0xf0, 0x2f, 0xd8, 0x80, 0x4c, 0x6a, 0x20, 0x29, 0x94, 0x48, 0x0d, 0x10, 0x6f, 0x0f,
+	0xbd, 0x51, 0x27, 0x3e, 0xac, 0xf1, 0xd9, 0x9a, 0x7f, 0xc9, 0x8e, 0x26, 0x73, 0xcc, 0x66, 0x94,
+	0x64, 0x58, 0x82, 0x06, 0xc1, 0xf7, 0xea, 0xab, 0x81, 0xd5, 0x7b, 0x8b, 0xfe, 0x15, 0x3b, 0x99,
+	0xe7, 0x25, 0x48, 0x20, 0x4a, 0x68, 0x9e, 0xd2, 0x14, 0x88, 0x77, 0xea, 0xc3, 0xe3, 0xc6, 0x1f,
+	0x2d, 0x6f, 0x13, 0x45, 0x4e, 0x53, 0x94, 0xa8, 0x93, 0x54, 0x08, 0x10, 0xbc, 0x6b, 0x13, 0x1b,
+	0xbd, 0x15, 0xc2, 0x26, 0xba, 0x33, 0x0d, 0x0b, 0x2c, 0x41, 0xf0, 0x9e, 0x4d, 0x6c, 0x3c, 0xb6,
+	0xec, 0x9f, 0xb2, 0xee, 0x2c, 0xcf, 0x66, 0xc4, 0xf7, 0xeb, 0xbd, 0x1d, 0xb6, 0x01, 0x4a, 0xa3,
+	0x42, 0x02, 0x9d, 0x18, 0x90, 0x85, 0x06, 0xe2, 0x07, 0x36, 0xa0, 0xf1, 0x27, 0xcb, 0x77, 0x0f,
+	0x5f, 0x9b, 0xc0, 0x5b, 0x6f, 0x02, 0xef, 0x67, 0x13, 0x78, 0x1f, 0x55, 0xd0, 0x5a, 0x57, 0x41,
+	0xeb, 0xbb, 0x0a, 0x5a, 0x2f, 0x37, 0xaf, 0xb9, 0x99, 0x16, 0x93, 0x30, 0xc3, 0x45, 0xf4, 0x4f,
+	0x61, 0xe5, 0x38, 0x5a, 0xee, 0xb4, 0x66, 0x56, 0x0a, 0x68, 0xd2, 0xab, 0x1b, 0x1b, 0xff, 0x0e,
+	0x00, 0x0b, 0x9c, 0xc7, 0xf2, 0xe6, 0x01, 0x00, 0x00,
+}
+
+func (m *Reputation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Reputation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Reputation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposerTenures != 0 {
+		i = encodeVarintReputation(dAtA, i, uint64(m.ProposerTenures))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Kicks != 0 {
+		i = encodeVarintReputation(dAtA, i, uint64(m.Kicks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DishonorRemoved != 0 {
+		i = encodeVarintReputation(dAtA, i, uint64(m.DishonorRemoved))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DishonorAdded != 0 {
+		i = encodeVarintReputation(dAtA, i, uint64(m.DishonorAdded))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LivenessSlashes != 0 {
+		i = encodeVarintReputation(dAtA, i, uint64(m.LivenessSlashes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlocksCovered != 0 {
+		i = encodeVarintReputation(dAtA, i, uint64(m.BlocksCovered))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StateUpdates != 0 {
+		i = encodeVarintReputation(dAtA, i, uint64(m.StateUpdates))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintReputation(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReputation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReputation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Reputation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovReputation(uint64(l))
+	}
+	if m.StateUpdates != 0 {
+		n += 1 + sovReputation(uint64(m.StateUpdates))
+	}
+	if m.BlocksCovered != 0 {
+		n += 1 + sovReputation(uint64(m.BlocksCovered))
+	}
+	if m.LivenessSlashes != 0 {
+		n += 1 + sovReputation(uint64(m.LivenessSlashes))
+	}
+	if m.DishonorAdded != 0 {
+		n += 1 + sovReputation(uint64(m.DishonorAdded))
+	}
+	if m.DishonorRemoved != 0 {
+		n += 1 + sovReputation(uint64(m.DishonorRemoved))
+	}
+	if m.Kicks != 0 {
+		n += 1 + sovReputation(uint64(m.Kicks))
+	}
+	if m.ProposerTenures != 0 {
+		n += 1 + sovReputation(uint64(m.ProposerTenures))
+	}
+	return n
+}
+
+func sovReputation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReputation(x uint64) (n int) {
+	return sovReputation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Reputation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReputation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Reputation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Reputation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateUpdates", wireType)
+			}
+			m.StateUpdates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StateUpdates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksCovered", wireType)
+			}
+			m.BlocksCovered = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksCovered |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessSlashes", wireType)
+			}
+			m.LivenessSlashes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LivenessSlashes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DishonorAdded", wireType)
+			}
+			m.DishonorAdded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DishonorAdded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DishonorRemoved", wireType)
+			}
+			m.DishonorRemoved = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DishonorRemoved |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kicks", wireType)
+			}
+			m.Kicks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kicks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerTenures", wireType)
+			}
+			m.ProposerTenures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposerTenures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReputation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReputation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReputation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReputation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReputation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReputation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReputation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReputation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReputation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReputation = fmt.Errorf("proto: unexpected end of group")
+)
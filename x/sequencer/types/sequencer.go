@@ -5,6 +5,7 @@ import (
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	comettypes "github.com/cometbft/cometbft/types"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -73,6 +74,20 @@ func (seq Sequencer) SetTokensCoin(c sdk.Coin) {
 	seq.Tokens[0] = c
 }
 
+// Commission returns the commission rate, which is zero if never set
+func (seq Sequencer) Commission() math.LegacyDec {
+	if seq.CommissionRate == nil || seq.CommissionRate.IsNil() {
+		return math.LegacyZeroDec()
+	}
+	return *seq.CommissionRate
+}
+
+// DelegationEnabled is true when the rewards are sent to the reward pool, so that they can be split
+// with the delegators.
+func (seq Sequencer) DelegationEnabled() bool {
+	return seq.RewardAddr == RewardPoolAddr(seq.Address).String()
+}
+
 func (seq Sequencer) AccAddr() sdk.AccAddress {
 	return sdk.MustAccAddressFromBech32(seq.Address)
 }
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
@@ -63,6 +64,12 @@ type Sequencer struct {
 	// how badly behaved sequencer is, can incur penalties (kicking) when high
 	// 0 is good/default, more is worse
 	Dishonor uint64 `protobuf:"varint,15,opt,name=dishonor,proto3" json:"dishonor,omitempty"`
+	// CommissionRate is the fraction of the delegation rewards kept by the
+	// sequencer before the remainder is split between the bond holders. Unset
+	// means zero.
+	CommissionRate *cosmossdk_io_math.LegacyDec `protobuf:"bytes,16,opt,name=commission_rate,json=commissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission_rate,omitempty"`
+	// CommissionUpdateTime is the time of the last commission rate update.
+	CommissionUpdateTime time.Time `protobuf:"bytes,17,opt,name=commission_update_time,json=commissionUpdateTime,proto3,stdtime" json:"commission_update_time"`
 }
 
 func (m *Sequencer) Reset()         { *m = Sequencer{} }
@@ -175,6 +182,13 @@ func (m *Sequencer) GetDishonor() uint64 {
 	return 0
 }
 
+func (m *Sequencer) GetCommissionUpdateTime() time.Time {
+	if m != nil {
+		return m.CommissionUpdateTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*Sequencer)(nil), "dymensionxyz.dymension.sequencer.Sequencer")
 }
@@ -184,44 +198,49 @@ func init() {
 }
 
 var fileDescriptor_997b8663a5fc0f58 = []byte{
-	// 588 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x41, 0x6f, 0xd3, 0x30,
-	0x18, 0x6d, 0x58, 0xd6, 0xa6, 0xee, 0x18, 0x95, 0xe9, 0xc1, 0xad, 0x50, 0x1a, 0x71, 0xca, 0x65,
-	0xce, 0xba, 0x49, 0x70, 0x5e, 0x39, 0xad, 0x08, 0x31, 0x65, 0x70, 0xe1, 0x52, 0xb9, 0xb1, 0xe9,
-	0xac, 0x35, 0x76, 0xb0, 0xdd, 0x6d, 0xe1, 0x57, 0xec, 0xc2, 0x9f, 0xe0, 0xcc, 0x8f, 0x98, 0x38,
-	0xed, 0xc8, 0x89, 0xa1, 0xf5, 0x8f, 0xa0, 0x24, 0x6e, 0x57, 0x40, 0xa8, 0xa7, 0xf8, 0xf9, 0xfb,
-	0xde, 0xe7, 0xbc, 0xf7, 0x3e, 0xb0, 0x4f, 0xf3, 0x94, 0x09, 0xcd, 0xa5, 0xb8, 0xca, 0x3f, 0x47,
-	0x2b, 0x10, 0x69, 0xf6, 0x69, 0xce, 0x44, 0xc2, 0xd4, 0xc3, 0x09, 0x67, 0x4a, 0x1a, 0x09, 0x83,
-	0x75, 0x06, 0x5e, 0x01, 0xbc, 0xea, 0xeb, 0x75, 0x13, 0xa9, 0x53, 0xa9, 0xc7, 0x65, 0x7f, 0x54,
-	0x81, 0x8a, 0xdc, 0xeb, 0x4e, 0xa5, 0x9c, 0xce, 0x58, 0x54, 0xa2, 0xc9, 0xfc, 0x63, 0x44, 0x44,
-	0x6e, 0x4b, 0x9d, 0xa9, 0x9c, 0xca, 0x8a, 0x52, 0x9c, 0xec, 0x6d, 0xff, 0x6f, 0x82, 0xe1, 0x29,
-	0xd3, 0x86, 0xa4, 0x99, 0x6d, 0xf0, 0xab, 0xf9, 0xd1, 0x84, 0x68, 0x16, 0x5d, 0x0c, 0x26, 0xcc,
-	0x90, 0x41, 0x94, 0x48, 0x2e, 0x6c, 0x3d, 0xda, 0x28, 0x30, 0x65, 0x86, 0x50, 0x62, 0x88, 0x25,
-	0xbc, 0xdc, 0x48, 0x90, 0x19, 0x53, 0xc4, 0x70, 0x31, 0x1d, 0x6b, 0x43, 0xcc, 0xdc, 0x6a, 0x7b,
-	0xfe, 0x65, 0x1b, 0x34, 0x4f, 0x97, 0x4d, 0x10, 0x81, 0x06, 0xa1, 0x54, 0x31, 0xad, 0x91, 0x13,
-	0x38, 0x61, 0x33, 0x5e, 0x42, 0x18, 0x83, 0x1d, 0x9a, 0xa7, 0x5c, 0x98, 0x93, 0xf9, 0xe4, 0x35,
-	0xcb, 0xd1, 0xa3, 0xc0, 0x09, 0x5b, 0x07, 0x1d, 0x5c, 0x29, 0xc5, 0x4b, 0xa5, 0xf8, 0x48, 0xe4,
-	0x43, 0xf4, 0xfd, 0xdb, 0x5e, 0xc7, 0x3a, 0x98, 0xa8, 0x3c, 0x33, 0x12, 0x57, 0xac, 0xf8, 0x8f,
-	0x19, 0xf0, 0x19, 0x68, 0x2a, 0x39, 0x9b, 0x91, 0x2c, 0x3b, 0xa6, 0x68, 0xab, 0x7c, 0xef, 0xe1,
-	0x02, 0xbe, 0x07, 0xde, 0x52, 0x24, 0x72, 0xcb, 0xd7, 0x0e, 0xf1, 0xa6, 0x14, 0xf1, 0x4a, 0xca,
-	0x1b, 0x4b, 0x1d, 0xba, 0x37, 0x3f, 0xfb, 0xb5, 0x78, 0x35, 0x0a, 0x1e, 0x83, 0x7a, 0x65, 0x00,
-	0x6a, 0x04, 0x4e, 0xb8, 0x7b, 0x30, 0xd8, 0x3c, 0xf4, 0xed, 0xd2, 0xba, 0xd3, 0x92, 0x18, 0xdb,
-	0x01, 0xb0, 0x0b, 0x3c, 0x99, 0x19, 0x46, 0xc7, 0x5c, 0xa0, 0xdd, 0xc0, 0x09, 0xbd, 0xb8, 0x51,
-	0xe2, 0x63, 0x01, 0x13, 0x50, 0x37, 0xf2, 0x9c, 0x09, 0x8d, 0xbc, 0x60, 0x2b, 0x6c, 0x1d, 0x74,
-	0xb1, 0xf5, 0xa3, 0x48, 0x1c, 0xdb, 0xc4, 0xf1, 0x2b, 0xc9, 0xc5, 0x70, 0xbf, 0xf8, 0xc1, 0xaf,
-	0x77, 0xfd, 0x70, 0xca, 0xcd, 0xd9, 0x7c, 0x82, 0x13, 0x99, 0xda, 0xf5, 0xb3, 0x9f, 0x3d, 0x4d,
-	0xcf, 0x23, 0x93, 0x67, 0x4c, 0x97, 0x04, 0x1d, 0xdb, 0xd1, 0x30, 0x06, 0x50, 0x48, 0xc3, 0x13,
-	0x36, 0xce, 0x98, 0xe2, 0x92, 0x8e, 0x8b, 0x35, 0x43, 0xad, 0xd2, 0xab, 0xde, 0x3f, 0xc9, 0xbc,
-	0x5b, 0xee, 0xe0, 0xd0, 0x2b, 0x5e, 0xbc, 0xbe, 0xeb, 0x3b, 0x71, 0xbb, 0xe2, 0x9f, 0x94, 0xf4,
-	0xa2, 0x01, 0xf6, 0x41, 0x4b, 0xb1, 0x4b, 0xa2, 0xe8, 0xb8, 0x48, 0x1e, 0xed, 0x94, 0xa9, 0x80,
-	0xea, 0xea, 0x88, 0x52, 0x05, 0x07, 0xa0, 0x73, 0x79, 0xc6, 0x0d, 0x9b, 0x71, 0x5d, 0x48, 0x57,
-	0x6c, 0x46, 0x72, 0xa6, 0x34, 0x7a, 0x1c, 0x6c, 0x85, 0xcd, 0xf8, 0xe9, 0x5a, 0x2d, 0xb6, 0x25,
-	0xd8, 0x03, 0x1e, 0xe5, 0xfa, 0x4c, 0x0a, 0xa9, 0xd0, 0x93, 0xc0, 0x09, 0xdd, 0x78, 0x85, 0x47,
-	0xae, 0xb7, 0xdd, 0xae, 0x8f, 0x5c, 0xaf, 0xde, 0x6e, 0x8c, 0x5c, 0xaf, 0xd9, 0x06, 0x23, 0xd7,
-	0x03, 0xed, 0xd6, 0xf0, 0xe4, 0xe6, 0xde, 0x77, 0x6e, 0xef, 0x7d, 0xe7, 0xd7, 0xbd, 0xef, 0x5c,
-	0x2f, 0xfc, 0xda, 0xed, 0xc2, 0xaf, 0xfd, 0x58, 0xf8, 0xb5, 0x0f, 0x2f, 0xd6, 0x7c, 0xfa, 0xcf,
-	0xd6, 0x5f, 0x1c, 0x46, 0x57, 0x6b, 0xab, 0x5f, 0x7a, 0x37, 0xa9, 0x97, 0x4e, 0x1c, 0xfe, 0x0e,
-	0x00, 0x00, 0xff, 0xff, 0xaa, 0xee, 0xfa, 0x07, 0x3d, 0x04, 0x00, 0x00,
+	// 670 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x18, 0x8d, 0x6f, 0x73, 0x13, 0x67, 0xd2, 0xdb, 0xe6, 0x0e, 0x11, 0x72, 0x02, 0x4a, 0x2c, 0x56,
+	0xde, 0x64, 0xdc, 0xb4, 0x12, 0xac, 0x1b, 0xba, 0x69, 0x00, 0x51, 0xb9, 0x94, 0x45, 0x37, 0xd1,
+	0xc4, 0x33, 0x24, 0xa3, 0xc6, 0x33, 0x66, 0x66, 0xd2, 0xd6, 0x3c, 0x45, 0x9f, 0x83, 0x75, 0x1f,
+	0xa2, 0xb0, 0xaa, 0x58, 0x21, 0x16, 0x2d, 0x6a, 0x5f, 0x04, 0xd9, 0x9e, 0xfc, 0x00, 0x42, 0x11,
+	0x2b, 0xfb, 0x9b, 0xef, 0x3b, 0xe7, 0xf3, 0x39, 0x67, 0x0c, 0xb6, 0x48, 0x12, 0x51, 0xae, 0x98,
+	0xe0, 0xe7, 0xc9, 0x07, 0x7f, 0x5e, 0xf8, 0x8a, 0xbe, 0x9f, 0x52, 0x1e, 0x52, 0xb9, 0x78, 0x43,
+	0xb1, 0x14, 0x5a, 0x40, 0x77, 0x19, 0x81, 0xe6, 0x05, 0x9a, 0xcf, 0x35, 0x1b, 0xa1, 0x50, 0x91,
+	0x50, 0x83, 0x6c, 0xde, 0xcf, 0x8b, 0x1c, 0xdc, 0x6c, 0x8c, 0x84, 0x18, 0x4d, 0xa8, 0x9f, 0x55,
+	0xc3, 0xe9, 0x3b, 0x1f, 0xf3, 0xc4, 0xb4, 0xea, 0x23, 0x31, 0x12, 0x39, 0x24, 0x7d, 0x33, 0xa7,
+	0xed, 0x5f, 0x01, 0x9a, 0x45, 0x54, 0x69, 0x1c, 0xc5, 0x66, 0xa0, 0x95, 0xf3, 0xfb, 0x43, 0xac,
+	0xa8, 0x7f, 0xda, 0x1d, 0x52, 0x8d, 0xbb, 0x7e, 0x28, 0x18, 0x37, 0x7d, 0x7f, 0xa5, 0xc0, 0x88,
+	0x6a, 0x4c, 0xb0, 0xc6, 0x06, 0xf0, 0x6c, 0x25, 0x40, 0xc4, 0x54, 0x62, 0xcd, 0xf8, 0x68, 0xa0,
+	0x34, 0xd6, 0x53, 0xa3, 0xed, 0xc9, 0xa7, 0x12, 0xa8, 0x1c, 0xce, 0x86, 0xa0, 0x03, 0xca, 0x98,
+	0x10, 0x49, 0x95, 0x72, 0x2c, 0xd7, 0xf2, 0x2a, 0xc1, 0xac, 0x84, 0x01, 0x58, 0x27, 0x49, 0xc4,
+	0xb8, 0x3e, 0x98, 0x0e, 0x5f, 0xd0, 0xc4, 0xf9, 0xc7, 0xb5, 0xbc, 0xea, 0x76, 0x1d, 0xe5, 0x4a,
+	0xd1, 0x4c, 0x29, 0xda, 0xe5, 0x49, 0xcf, 0xf9, 0x7c, 0xd9, 0xa9, 0x1b, 0x07, 0x43, 0x99, 0xc4,
+	0x5a, 0xa0, 0x1c, 0x15, 0xfc, 0xc4, 0x01, 0x1f, 0x83, 0x8a, 0x14, 0x93, 0x09, 0x8e, 0xe3, 0x7d,
+	0xe2, 0xac, 0x65, 0xfb, 0x16, 0x07, 0xf0, 0x08, 0xd8, 0x33, 0x91, 0x4e, 0x31, 0xdb, 0xb6, 0x83,
+	0x56, 0xa5, 0x88, 0xe6, 0x52, 0x5e, 0x19, 0x68, 0xaf, 0x78, 0x75, 0xd3, 0x2e, 0x04, 0x73, 0x2a,
+	0xb8, 0x0f, 0x4a, 0xb9, 0x01, 0x4e, 0xd9, 0xb5, 0xbc, 0x8d, 0xed, 0xee, 0x6a, 0xd2, 0xd7, 0x33,
+	0xeb, 0x0e, 0x33, 0x60, 0x60, 0x08, 0x60, 0x03, 0xd8, 0x22, 0xd6, 0x94, 0x0c, 0x18, 0x77, 0x36,
+	0x5c, 0xcb, 0xb3, 0x83, 0x72, 0x56, 0xef, 0x73, 0x18, 0x82, 0x92, 0x16, 0x27, 0x94, 0x2b, 0xc7,
+	0x76, 0xd7, 0xbc, 0xea, 0x76, 0x03, 0x19, 0x3f, 0xd2, 0xc4, 0x91, 0x49, 0x1c, 0x3d, 0x17, 0x8c,
+	0xf7, 0xb6, 0xd2, 0x0f, 0xfc, 0x78, 0xdb, 0xf6, 0x46, 0x4c, 0x8f, 0xa7, 0x43, 0x14, 0x8a, 0xc8,
+	0x5c, 0x3f, 0xf3, 0xe8, 0x28, 0x72, 0xe2, 0xeb, 0x24, 0xa6, 0x2a, 0x03, 0xa8, 0xc0, 0x50, 0xc3,
+	0x00, 0x40, 0x2e, 0x34, 0x0b, 0xe9, 0x20, 0xa6, 0x92, 0x09, 0x32, 0x48, 0xaf, 0x99, 0x53, 0xcd,
+	0xbc, 0x6a, 0xfe, 0x96, 0xcc, 0x9b, 0xd9, 0x1d, 0xec, 0xd9, 0xe9, 0xc6, 0x8b, 0xdb, 0xb6, 0x15,
+	0xd4, 0x72, 0xfc, 0x41, 0x06, 0x4f, 0x07, 0x60, 0x1b, 0x54, 0x25, 0x3d, 0xc3, 0x92, 0x0c, 0xd2,
+	0xe4, 0x9d, 0xf5, 0x2c, 0x15, 0x90, 0x1f, 0xed, 0x12, 0x22, 0x61, 0x17, 0xd4, 0xcf, 0xc6, 0x4c,
+	0xd3, 0x09, 0x53, 0xa9, 0x74, 0x49, 0x27, 0x38, 0xa1, 0x52, 0x39, 0xff, 0xb9, 0x6b, 0x5e, 0x25,
+	0x78, 0xb0, 0xd4, 0x0b, 0x4c, 0x0b, 0x36, 0x81, 0x4d, 0x98, 0x1a, 0x0b, 0x2e, 0xa4, 0xb3, 0xe9,
+	0x5a, 0x5e, 0x31, 0x98, 0xd7, 0xf0, 0x2d, 0xd8, 0x0c, 0x45, 0x14, 0x31, 0x95, 0x7a, 0x3e, 0x90,
+	0x58, 0x53, 0xa7, 0x96, 0xee, 0xec, 0x75, 0xbe, 0xdd, 0xb4, 0x1f, 0xe5, 0x06, 0x28, 0x72, 0x82,
+	0x98, 0xf0, 0x23, 0xac, 0xc7, 0xe8, 0x25, 0x1d, 0xe1, 0x30, 0xd9, 0xa3, 0xe1, 0x97, 0xcb, 0x0e,
+	0x30, 0x9e, 0xee, 0xd1, 0x30, 0xd8, 0x58, 0xb0, 0x04, 0x58, 0x53, 0x78, 0x0c, 0x1e, 0x2e, 0xf1,
+	0x4e, 0x63, 0x82, 0x35, 0xcd, 0xfd, 0xf9, 0xff, 0x2f, 0xfc, 0xa9, 0x2f, 0x38, 0x8e, 0x32, 0x8a,
+	0x74, 0xa8, 0x5f, 0xb4, 0xff, 0xad, 0x95, 0xfa, 0x45, 0xbb, 0x54, 0x2b, 0xf7, 0x8b, 0x76, 0xa5,
+	0x06, 0xfa, 0x45, 0x1b, 0xd4, 0xaa, 0xbd, 0x83, 0xab, 0xbb, 0x96, 0x75, 0x7d, 0xd7, 0xb2, 0xbe,
+	0xdf, 0xb5, 0xac, 0x8b, 0xfb, 0x56, 0xe1, 0xfa, 0xbe, 0x55, 0xf8, 0x7a, 0xdf, 0x2a, 0x1c, 0x3f,
+	0x5d, 0xca, 0xf6, 0x0f, 0x7f, 0xea, 0xe9, 0x8e, 0x7f, 0xbe, 0xf4, 0xbb, 0x66, 0x79, 0x0f, 0x4b,
+	0xd9, 0xd7, 0xed, 0xfc, 0x18, 0x00, 0xee, 0x21, 0xd0, 0xdc, 0xf1, 0x04, 0x00, 0x00,
 }
 
 func (m *Sequencer) Marshal() (dAtA []byte, err error) {
@@ -244,6 +263,30 @@ func (m *Sequencer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CommissionUpdateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CommissionUpdateTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSequencer(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.CommissionRate != nil {
+		{
+			size := m.CommissionRate.Size()
+			i -= size
+			if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintSequencer(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	if m.Dishonor != 0 {
 		i = encodeVarintSequencer(dAtA, i, uint64(m.Dishonor))
 		i--
@@ -275,12 +318,12 @@ func (m *Sequencer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x62
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NoticePeriodTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NoticePeriodTime):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NoticePeriodTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NoticePeriodTime):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintSequencer(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintSequencer(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x5a
 	if len(m.Tokens) > 0 {
@@ -399,6 +442,12 @@ func (m *Sequencer) Size() (n int) {
 	if m.Dishonor != 0 {
 		n += 1 + sovSequencer(uint64(m.Dishonor))
 	}
+	if m.CommissionRate != nil {
+		l = m.CommissionRate.Size()
+		n += 2 + l + sovSequencer(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CommissionUpdateTime)
+	n += 2 + l + sovSequencer(uint64(l))
 	return n
 }
 
@@ -759,6 +808,75 @@ func (m *Sequencer) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequencer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.CommissionRate = &v
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequencer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CommissionUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSequencer(dAtA[iNdEx:])
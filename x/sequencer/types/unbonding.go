@@ -0,0 +1,20 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+func (u UnbondingEntry) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(u.Sequencer); err != nil {
+		return errorsmod.Wrap(ErrInvalidAddr, "sequencer")
+	}
+	if !u.Amount.IsValid() {
+		return errorsmod.Wrap(ErrInvalidCoins, "amount")
+	}
+	if u.CompletionTime.IsZero() {
+		return gerrc.ErrInvalidArgument.Wrap("completion time")
+	}
+	return nil
+}
@@ -0,0 +1,442 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/sequencer/unbonding.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// UnbondingEntry is a part of a sequencer self bond which was unbonded or
+// decreased. The tokens are held by the module until the completion time and
+// can still be slashed.
+type UnbondingEntry struct {
+	// Sequencer is the bech32-encoded address of the sequencer
+	Sequencer string `protobuf:"bytes,1,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+	// Amount is the amount which will be returned to the sequencer
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// CompletionTime is the time at which the tokens are returned
+	CompletionTime time.Time `protobuf:"bytes,3,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+}
+
+func (m *UnbondingEntry) Reset()         { *m = UnbondingEntry{} }
+func (m *UnbondingEntry) String() string { return proto.CompactTextString(m) }
+func (*UnbondingEntry) ProtoMessage()    {}
+func (*UnbondingEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_875b33f7887a43fc, []int{0}
+}
+func (m *UnbondingEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingEntry.Merge(m, src)
+}
+func (m *UnbondingEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingEntry proto.InternalMessageInfo
+
+func (m *UnbondingEntry) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
+	}
+	return ""
+}
+
+func (m *UnbondingEntry) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *UnbondingEntry) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*UnbondingEntry)(nil), "dymensionxyz.dymension.sequencer.UnbondingEntry")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/sequencer/unbonding.proto", fileDescriptor_875b33f7887a43fc)
+}
+
+var fileDescriptor_875b33f7887a43fc = []byte{
+	// 309 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcf, 0x4a, 0x33, 0x31,
+	0x14, 0xc5, 0x27, 0xdf, 0x27, 0xc5, 0x8e, 0x50, 0x61, 0x70, 0x51, 0x8b, 0xa4, 0xc5, 0x55, 0x57,
+	0x89, 0xb5, 0xa0, 0xfb, 0x8a, 0x4b, 0x41, 0x06, 0xdd, 0xb8, 0x91, 0xc9, 0x34, 0xc6, 0x40, 0x93,
+	0x3b, 0x4e, 0x32, 0xa5, 0xe3, 0x53, 0xf4, 0x69, 0x7c, 0x86, 0x2e, 0xbb, 0x74, 0xa5, 0x32, 0xf3,
+	0x22, 0x32, 0x7f, 0xdb, 0x8d, 0xbb, 0x9c, 0xdc, 0x73, 0xee, 0xfd, 0xc1, 0x71, 0x2f, 0xe6, 0xa9,
+	0xe2, 0xda, 0x48, 0xd0, 0xab, 0xf4, 0x9d, 0xb6, 0x82, 0x1a, 0xfe, 0x96, 0x70, 0x1d, 0xf2, 0x98,
+	0x26, 0x9a, 0x81, 0x9e, 0x4b, 0x2d, 0x48, 0x14, 0x83, 0x05, 0x6f, 0xb4, 0x9f, 0x20, 0xad, 0x20,
+	0x6d, 0x62, 0x70, 0x22, 0x40, 0x40, 0x69, 0xa6, 0xc5, 0xab, 0xca, 0x0d, 0x70, 0x08, 0x46, 0x81,
+	0xa1, 0x2c, 0x30, 0x9c, 0x2e, 0x27, 0x8c, 0xdb, 0x60, 0x42, 0x43, 0x90, 0xba, 0x9e, 0x0f, 0x05,
+	0x80, 0x58, 0x70, 0x5a, 0x2a, 0x96, 0xbc, 0x50, 0x2b, 0x15, 0x37, 0x36, 0x50, 0x51, 0x65, 0x38,
+	0xff, 0x40, 0x6e, 0xef, 0xb1, 0x81, 0xb9, 0xd5, 0x36, 0x4e, 0xbd, 0x33, 0xb7, 0xdb, 0x9e, 0xed,
+	0xa3, 0x11, 0x1a, 0x77, 0xfd, 0xdd, 0x87, 0x77, 0xed, 0x76, 0x02, 0x05, 0x89, 0xb6, 0xfd, 0x7f,
+	0x23, 0x34, 0x3e, 0xba, 0x3c, 0x25, 0x15, 0x02, 0x29, 0x10, 0x48, 0x8d, 0x40, 0x6e, 0x40, 0xea,
+	0xd9, 0xc1, 0xe6, 0x6b, 0xe8, 0xf8, 0xb5, 0xdd, 0xbb, 0x73, 0x8f, 0x43, 0x50, 0xd1, 0x82, 0x5b,
+	0x09, 0xfa, 0xb9, 0xe0, 0xe8, 0xff, 0x2f, 0x37, 0x0c, 0x48, 0x05, 0x49, 0x1a, 0x48, 0xf2, 0xd0,
+	0x40, 0xce, 0x0e, 0x8b, 0x15, 0xeb, 0xef, 0x21, 0xf2, 0x7b, 0xbb, 0x70, 0x31, 0x9e, 0xdd, 0x6f,
+	0x32, 0x8c, 0xb6, 0x19, 0x46, 0x3f, 0x19, 0x46, 0xeb, 0x1c, 0x3b, 0xdb, 0x1c, 0x3b, 0x9f, 0x39,
+	0x76, 0x9e, 0xae, 0x84, 0xb4, 0xaf, 0x09, 0x23, 0x21, 0x28, 0xfa, 0x47, 0x11, 0xcb, 0x29, 0x5d,
+	0xed, 0xb5, 0x61, 0xd3, 0x88, 0x1b, 0xd6, 0x29, 0xef, 0x4f, 0x7f, 0x07, 0x00, 0xcf, 0xa1, 0x51,
+	0x2b, 0xbe, 0x01, 0x00, 0x00,
+}
+
+func (m *UnbondingEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintUnbonding(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUnbonding(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintUnbonding(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintUnbonding(dAtA []byte, offset int, v uint64) int {
+	offset -= sovUnbonding(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UnbondingEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovUnbonding(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovUnbonding(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovUnbonding(uint64(l))
+	return n
+}
+
+func sovUnbonding(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozUnbonding(x uint64) (n int) {
+	return sovUnbonding(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UnbondingEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUnbonding
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUnbonding(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipUnbonding(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowUnbonding
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthUnbonding
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupUnbonding
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthUnbonding
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthUnbonding        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowUnbonding          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupUnbonding = fmt.Errorf("proto: unexpected end of group")
+)